@@ -18,15 +18,27 @@ import (
 
 // API is the interface of the installer client
 type API interface {
+	/*
+	   AbortLogsUpload Agent API to abort a logs upload and delete the chunks that were received.*/
+	AbortLogsUpload(ctx context.Context, params *AbortLogsUploadParams) (*AbortLogsUploadNoContent, error)
 	/*
 	   CancelInstallation Cancels an ongoing installation.*/
 	CancelInstallation(ctx context.Context, params *CancelInstallationParams) (*CancelInstallationAccepted, error)
 	/*
 	   CompleteInstallation Agent API to mark a finalizing installation as complete.*/
 	CompleteInstallation(ctx context.Context, params *CompleteInstallationParams) (*CompleteInstallationAccepted, error)
+	/*
+	   CompleteLogsUpload Agent API to complete a logs upload once all of its chunks were received. The chunks are assembled into the logs archive, which is then handled like logs that were uploaded at once.*/
+	CompleteLogsUpload(ctx context.Context, params *CompleteLogsUploadParams) (*CompleteLogsUploadNoContent, error)
+	/*
+	   CreateLogsUpload Agent API to start a resumable upload of a logs archive, which is then uploaded in chunks.*/
+	CreateLogsUpload(ctx context.Context, params *CreateLogsUploadParams) (*CreateLogsUploadCreated, error)
 	/*
 	   DeregisterCluster Deletes an OpenShift cluster definition.*/
 	DeregisterCluster(ctx context.Context, params *DeregisterClusterParams) (*DeregisterClusterNoContent, error)
+	/*
+	   DeregisterDeclaredHost Deletes a declared host and its discovery image.*/
+	DeregisterDeclaredHost(ctx context.Context, params *DeregisterDeclaredHostParams) (*DeregisterDeclaredHostNoContent, error)
 	/*
 	   DeregisterHost Deregisters an OpenShift host.*/
 	DeregisterHost(ctx context.Context, params *DeregisterHostParams) (*DeregisterHostNoContent, error)
@@ -42,24 +54,48 @@ type API interface {
 	/*
 	   DownloadClusterISOHeaders Downloads the OpenShift per-cluster Discovery ISO Headers only.*/
 	DownloadClusterISOHeaders(ctx context.Context, params *DownloadClusterISOHeadersParams) (*DownloadClusterISOHeadersOK, error)
+	/*
+	   DownloadClusterISOSignature Downloads the detached signature of the SHA-256 digest of the OpenShift per-cluster Discovery image, signed with the image signing key of the service. The checksum of streamed images is computed when their signature is first downloaded.*/
+	DownloadClusterISOSignature(ctx context.Context, params *DownloadClusterISOSignatureParams, writer io.Writer) (*DownloadClusterISOSignatureOK, error)
 	/*
 	   DownloadClusterKubeconfig Downloads the kubeconfig file for this cluster.*/
 	DownloadClusterKubeconfig(ctx context.Context, params *DownloadClusterKubeconfigParams, writer io.Writer) (*DownloadClusterKubeconfigOK, error)
 	/*
 	   DownloadClusterLogs Download cluster logs.*/
 	DownloadClusterLogs(ctx context.Context, params *DownloadClusterLogsParams, writer io.Writer) (*DownloadClusterLogsOK, error)
+	/*
+	   DownloadClusterPxeArtifact Downloads the OpenShift per-cluster network boot artifacts and the iPXE script that boots them.*/
+	DownloadClusterPxeArtifact(ctx context.Context, params *DownloadClusterPxeArtifactParams, writer io.Writer) (*DownloadClusterPxeArtifactOK, error)
+	/*
+	   DownloadClusterSupportBundle Downloads an archive of the cluster, its hosts, events, validations history, install config, manifests, operators and logs to be attached to a support case. Pull secrets, SSH keys and passwords are redacted from the records of the archive, while the log archives are included as is and flagged as not redacted.*/
+	DownloadClusterSupportBundle(ctx context.Context, params *DownloadClusterSupportBundleParams, writer io.Writer) (*DownloadClusterSupportBundleOK, error)
+	/*
+	   DownloadDeclaredHostISO Downloads the Discovery ISO of a declared host.*/
+	DownloadDeclaredHostISO(ctx context.Context, params *DownloadDeclaredHostISOParams, writer io.Writer) (*DownloadDeclaredHostISOOK, error)
 	/*
 	   DownloadHostIgnition Downloads the customized ignition file for this host*/
 	DownloadHostIgnition(ctx context.Context, params *DownloadHostIgnitionParams, writer io.Writer) (*DownloadHostIgnitionOK, error)
 	/*
 	   DownloadHostLogs Download host logs.*/
 	DownloadHostLogs(ctx context.Context, params *DownloadHostLogsParams, writer io.Writer) (*DownloadHostLogsOK, error)
+	/*
+	   DownloadImageSigningKey Downloads the PEM encoded public key that the signatures of the discovery images are verified with.*/
+	DownloadImageSigningKey(ctx context.Context, params *DownloadImageSigningKeyParams, writer io.Writer) (*DownloadImageSigningKeyOK, error)
+	/*
+	   DownloadLogsArchiveEntry Downloads a single file, or a range of its lines, from a stored host or controller logs archive.*/
+	DownloadLogsArchiveEntry(ctx context.Context, params *DownloadLogsArchiveEntryParams, writer io.Writer) (*DownloadLogsArchiveEntryOK, error)
 	/*
 	   EnableHost Enables a host for inclusion in the cluster.*/
 	EnableHost(ctx context.Context, params *EnableHostParams) (*EnableHostOK, error)
 	/*
 	   GenerateClusterISO Creates a new OpenShift per-cluster Discovery ISO.*/
 	GenerateClusterISO(ctx context.Context, params *GenerateClusterISOParams) (*GenerateClusterISOCreated, error)
+	/*
+	   GenerateDeclaredHostISO Creates a Discovery ISO for a single declared host. The ISO only carries the network configuration and the hostname of the host, and binds the host that boots it to the declared host.*/
+	GenerateDeclaredHostISO(ctx context.Context, params *GenerateDeclaredHostISOParams) (*GenerateDeclaredHostISOCreated, error)
+	/*
+	   GetArchivedCluster Retrieves a cluster that was archived after its installation, together with its hosts, monitored operators and events.*/
+	GetArchivedCluster(ctx context.Context, params *GetArchivedClusterParams) (*GetArchivedClusterOK, error)
 	/*
 	   GetCluster Retrieves the details of the OpenShift cluster.*/
 	GetCluster(ctx context.Context, params *GetClusterParams) (*GetClusterOK, error)
@@ -72,6 +108,9 @@ type API interface {
 	/*
 	   GetClusterInstallConfig Get the cluster's install config YAML.*/
 	GetClusterInstallConfig(ctx context.Context, params *GetClusterInstallConfigParams) (*GetClusterInstallConfigOK, error)
+	/*
+	   GetClusterLogsAnalysis Retrieves the known failure signatures that were found in the uploaded logs of the cluster and its hosts.*/
+	GetClusterLogsAnalysis(ctx context.Context, params *GetClusterLogsAnalysisParams) (*GetClusterLogsAnalysisOK, error)
 	/*
 	   GetCredentials Get the cluster admin credentials.*/
 	GetCredentials(ctx context.Context, params *GetCredentialsParams) (*GetCredentialsOK, error)
@@ -90,9 +129,17 @@ type API interface {
 	/*
 	   GetHostIgnition Get the customized ignition file for this host*/
 	GetHostIgnition(ctx context.Context, params *GetHostIgnitionParams) (*GetHostIgnitionOK, error)
+	/*
+	   GetHostIgnitionPreview Preview the ignition configs a host will boot with, before the installation starts.
+	   Renders the effective discovery ignition and the projected ignition of the host's role, along with the changes each layer applied. Secrets are redacted.
+	   */
+	GetHostIgnitionPreview(ctx context.Context, params *GetHostIgnitionPreviewParams) (*GetHostIgnitionPreviewOK, error)
 	/*
 	   GetHostRequirements Get minimum host requirements.*/
 	GetHostRequirements(ctx context.Context, params *GetHostRequirementsParams) (*GetHostRequirementsOK, error)
+	/*
+	   GetLogsUpload Agent API to retrieve a logs upload with the byte ranges that were received, in order to resume it.*/
+	GetLogsUpload(ctx context.Context, params *GetLogsUploadParams) (*GetLogsUploadOK, error)
 	/*
 	   GetNextSteps Retrieves the next operations that the host agent needs to perform.*/
 	GetNextSteps(ctx context.Context, params *GetNextStepsParams) (*GetNextStepsOK, error)
@@ -102,6 +149,9 @@ type API interface {
 	/*
 	   GetPresignedForClusterFiles Retrieves a pre-signed S3 URL for downloading cluster files.*/
 	GetPresignedForClusterFiles(ctx context.Context, params *GetPresignedForClusterFilesParams) (*GetPresignedForClusterFilesOK, error)
+	/*
+	   GetQuota Retrieves the usage and limits of an organization or a user.*/
+	GetQuota(ctx context.Context, params *GetQuotaParams) (*GetQuotaOK, error)
 	/*
 	   InstallCluster Installs the OpenShift cluster.*/
 	InstallCluster(ctx context.Context, params *InstallClusterParams) (*InstallClusterAccepted, error)
@@ -114,9 +164,18 @@ type API interface {
 	/*
 	   ListClusters Retrieves the list of OpenShift clusters.*/
 	ListClusters(ctx context.Context, params *ListClustersParams) (*ListClustersOK, error)
+	/*
+	   ListDeclaredHosts Retrieves the list of declared hosts of the cluster.*/
+	ListDeclaredHosts(ctx context.Context, params *ListDeclaredHostsParams) (*ListDeclaredHostsOK, error)
 	/*
 	   ListHosts Retrieves the list of OpenShift hosts.*/
 	ListHosts(ctx context.Context, params *ListHostsParams) (*ListHostsOK, error)
+	/*
+	   ListLogsArchiveEntries Lists the files inside a stored host or controller logs archive.*/
+	ListLogsArchiveEntries(ctx context.Context, params *ListLogsArchiveEntriesParams) (*ListLogsArchiveEntriesOK, error)
+	/*
+	   ListQuotas Lists the usage and limits of the organizations and users that own clusters or have limits of their own.*/
+	ListQuotas(ctx context.Context, params *ListQuotasParams) (*ListQuotasOK, error)
 	/*
 	   PostStepReply Posts the result of the operations from the host agent.*/
 	PostStepReply(ctx context.Context, params *PostStepReplyParams) (*PostStepReplyNoContent, error)
@@ -126,6 +185,9 @@ type API interface {
 	/*
 	   RegisterCluster Creates a new OpenShift cluster definition.*/
 	RegisterCluster(ctx context.Context, params *RegisterClusterParams) (*RegisterClusterCreated, error)
+	/*
+	   RegisterDeclaredHost Declares a host before it boots, so that a discovery image can be generated for it alone.*/
+	RegisterDeclaredHost(ctx context.Context, params *RegisterDeclaredHostParams) (*RegisterDeclaredHostCreated, error)
 	/*
 	   RegisterHost Registers a new OpenShift host.*/
 	RegisterHost(ctx context.Context, params *RegisterHostParams) (*RegisterHostCreated, error)
@@ -140,6 +202,12 @@ type API interface {
 
 	   Reset failed host validation.  It may be performed on any host validation with persistent validation result.*/
 	ResetHostValidation(ctx context.Context, params *ResetHostValidationParams) (*ResetHostValidationOK, error)
+	/*
+	   RestoreArchivedCluster Restores an archived cluster with its hosts, monitored operators and events, and deletes the archive.*/
+	RestoreArchivedCluster(ctx context.Context, params *RestoreArchivedClusterParams) (*RestoreArchivedClusterCreated, error)
+	/*
+	   SearchLogsArchive Searches the files of a stored host or controller logs archive for lines that match a regular expression.*/
+	SearchLogsArchive(ctx context.Context, params *SearchLogsArchiveParams) (*SearchLogsArchiveOK, error)
 	/*
 	   UpdateCluster Updates an OpenShift cluster definition.*/
 	UpdateCluster(ctx context.Context, params *UpdateClusterParams) (*UpdateClusterCreated, error)
@@ -164,6 +232,9 @@ type API interface {
 	/*
 	   UpdateHostLogsProgress Update log collection state and progress.*/
 	UpdateHostLogsProgress(ctx context.Context, params *UpdateHostLogsProgressParams) (*UpdateHostLogsProgressNoContent, error)
+	/*
+	   UpdateQuotaLimits Sets the limits of an organization or a user. Limits that are not set fall back to the default limits of the service.*/
+	UpdateQuotaLimits(ctx context.Context, params *UpdateQuotaLimitsParams) (*UpdateQuotaLimitsOK, error)
 	/*
 	   UploadClusterIngressCert Transfer the ingress certificate for the cluster.*/
 	UploadClusterIngressCert(ctx context.Context, params *UploadClusterIngressCertParams) (*UploadClusterIngressCertCreated, error)
@@ -173,6 +244,9 @@ type API interface {
 	/*
 	   UploadLogs Agent API to upload logs.*/
 	UploadLogs(ctx context.Context, params *UploadLogsParams) (*UploadLogsNoContent, error)
+	/*
+	   UploadLogsChunk Agent API to upload a chunk of a logs archive. Chunk N holds the bytes of the archive starting at N times the chunk size of the upload. A chunk that was already received is replaced.*/
+	UploadLogsChunk(ctx context.Context, params *UploadLogsChunkParams) (*UploadLogsChunkNoContent, error)
 }
 
 // New creates a new installer API client.
@@ -193,6 +267,31 @@ type Client struct {
 	authInfo  runtime.ClientAuthInfoWriter
 }
 
+/*
+AbortLogsUpload Agent API to abort a logs upload and delete the chunks that were received.
+*/
+func (a *Client) AbortLogsUpload(ctx context.Context, params *AbortLogsUploadParams) (*AbortLogsUploadNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "AbortLogsUpload",
+		Method:             "DELETE",
+		PathPattern:        "/clusters/{cluster_id}/logs/uploads/{upload_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &AbortLogsUploadReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*AbortLogsUploadNoContent), nil
+
+}
+
 /*
 CancelInstallation Cancels an ongoing installation.
 */
@@ -243,6 +342,56 @@ func (a *Client) CompleteInstallation(ctx context.Context, params *CompleteInsta
 
 }
 
+/*
+CompleteLogsUpload Agent API to complete a logs upload once all of its chunks were received. The chunks are assembled into the logs archive, which is then handled like logs that were uploaded at once.
+*/
+func (a *Client) CompleteLogsUpload(ctx context.Context, params *CompleteLogsUploadParams) (*CompleteLogsUploadNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "CompleteLogsUpload",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/logs/uploads/{upload_id}/actions/complete",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CompleteLogsUploadReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CompleteLogsUploadNoContent), nil
+
+}
+
+/*
+CreateLogsUpload Agent API to start a resumable upload of a logs archive, which is then uploaded in chunks.
+*/
+func (a *Client) CreateLogsUpload(ctx context.Context, params *CreateLogsUploadParams) (*CreateLogsUploadCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "CreateLogsUpload",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/logs/uploads",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateLogsUploadReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CreateLogsUploadCreated), nil
+
+}
+
 /*
 DeregisterCluster Deletes an OpenShift cluster definition.
 */
@@ -268,6 +417,31 @@ func (a *Client) DeregisterCluster(ctx context.Context, params *DeregisterCluste
 
 }
 
+/*
+DeregisterDeclaredHost Deletes a declared host and its discovery image.
+*/
+func (a *Client) DeregisterDeclaredHost(ctx context.Context, params *DeregisterDeclaredHostParams) (*DeregisterDeclaredHostNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeregisterDeclaredHost",
+		Method:             "DELETE",
+		PathPattern:        "/clusters/{cluster_id}/declared-hosts/{declared_host_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeregisterDeclaredHostReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeregisterDeclaredHostNoContent), nil
+
+}
+
 /*
 DeregisterHost Deregisters an OpenShift host.
 */
@@ -393,6 +567,31 @@ func (a *Client) DownloadClusterISOHeaders(ctx context.Context, params *Download
 
 }
 
+/*
+DownloadClusterISOSignature Downloads the detached signature of the SHA-256 digest of the OpenShift per-cluster Discovery image, signed with the image signing key of the service. The checksum of streamed images is computed when their signature is first downloaded.
+*/
+func (a *Client) DownloadClusterISOSignature(ctx context.Context, params *DownloadClusterISOSignatureParams, writer io.Writer) (*DownloadClusterISOSignatureOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DownloadClusterISOSignature",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/downloads/image-signature",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DownloadClusterISOSignatureReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DownloadClusterISOSignatureOK), nil
+
+}
+
 /*
 DownloadClusterKubeconfig Downloads the kubeconfig file for this cluster.
 */
@@ -443,6 +642,81 @@ func (a *Client) DownloadClusterLogs(ctx context.Context, params *DownloadCluste
 
 }
 
+/*
+DownloadClusterPxeArtifact Downloads the OpenShift per-cluster network boot artifacts and the iPXE script that boots them.
+*/
+func (a *Client) DownloadClusterPxeArtifact(ctx context.Context, params *DownloadClusterPxeArtifactParams, writer io.Writer) (*DownloadClusterPxeArtifactOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DownloadClusterPxeArtifact",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/downloads/pxe-artifacts",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DownloadClusterPxeArtifactReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DownloadClusterPxeArtifactOK), nil
+
+}
+
+/*
+DownloadClusterSupportBundle Downloads an archive of the cluster, its hosts, events, validations history, install config, manifests, operators and logs to be attached to a support case. Pull secrets, SSH keys and passwords are redacted from the records of the archive, while the log archives are included as is and flagged as not redacted.
+*/
+func (a *Client) DownloadClusterSupportBundle(ctx context.Context, params *DownloadClusterSupportBundleParams, writer io.Writer) (*DownloadClusterSupportBundleOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DownloadClusterSupportBundle",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/support-bundle",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DownloadClusterSupportBundleReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DownloadClusterSupportBundleOK), nil
+
+}
+
+/*
+DownloadDeclaredHostISO Downloads the Discovery ISO of a declared host.
+*/
+func (a *Client) DownloadDeclaredHostISO(ctx context.Context, params *DownloadDeclaredHostISOParams, writer io.Writer) (*DownloadDeclaredHostISOOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DownloadDeclaredHostISO",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/declared-hosts/{declared_host_id}/downloads/image",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DownloadDeclaredHostISOReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DownloadDeclaredHostISOOK), nil
+
+}
+
 /*
 DownloadHostIgnition Downloads the customized ignition file for this host
 */
@@ -493,6 +767,56 @@ func (a *Client) DownloadHostLogs(ctx context.Context, params *DownloadHostLogsP
 
 }
 
+/*
+DownloadImageSigningKey Downloads the PEM encoded public key that the signatures of the discovery images are verified with.
+*/
+func (a *Client) DownloadImageSigningKey(ctx context.Context, params *DownloadImageSigningKeyParams, writer io.Writer) (*DownloadImageSigningKeyOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DownloadImageSigningKey",
+		Method:             "GET",
+		PathPattern:        "/image-signing-key",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DownloadImageSigningKeyReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DownloadImageSigningKeyOK), nil
+
+}
+
+/*
+DownloadLogsArchiveEntry Downloads a single file, or a range of its lines, from a stored host or controller logs archive.
+*/
+func (a *Client) DownloadLogsArchiveEntry(ctx context.Context, params *DownloadLogsArchiveEntryParams, writer io.Writer) (*DownloadLogsArchiveEntryOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DownloadLogsArchiveEntry",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/logs/entries/download",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DownloadLogsArchiveEntryReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DownloadLogsArchiveEntryOK), nil
+
+}
+
 /*
 EnableHost Enables a host for inclusion in the cluster.
 */
@@ -543,6 +867,56 @@ func (a *Client) GenerateClusterISO(ctx context.Context, params *GenerateCluster
 
 }
 
+/*
+GenerateDeclaredHostISO Creates a Discovery ISO for a single declared host. The ISO only carries the network configuration and the hostname of the host, and binds the host that boots it to the declared host.
+*/
+func (a *Client) GenerateDeclaredHostISO(ctx context.Context, params *GenerateDeclaredHostISOParams) (*GenerateDeclaredHostISOCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GenerateDeclaredHostISO",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/declared-hosts/{declared_host_id}/downloads/image",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GenerateDeclaredHostISOReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GenerateDeclaredHostISOCreated), nil
+
+}
+
+/*
+GetArchivedCluster Retrieves a cluster that was archived after its installation, together with its hosts, monitored operators and events.
+*/
+func (a *Client) GetArchivedCluster(ctx context.Context, params *GetArchivedClusterParams) (*GetArchivedClusterOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetArchivedCluster",
+		Method:             "GET",
+		PathPattern:        "/archived-clusters/{cluster_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetArchivedClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetArchivedClusterOK), nil
+
+}
+
 /*
 GetCluster Retrieves the details of the OpenShift cluster.
 */
@@ -643,6 +1017,31 @@ func (a *Client) GetClusterInstallConfig(ctx context.Context, params *GetCluster
 
 }
 
+/*
+GetClusterLogsAnalysis Retrieves the known failure signatures that were found in the uploaded logs of the cluster and its hosts.
+*/
+func (a *Client) GetClusterLogsAnalysis(ctx context.Context, params *GetClusterLogsAnalysisParams) (*GetClusterLogsAnalysisOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterLogsAnalysis",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/logs/analysis",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetClusterLogsAnalysisReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterLogsAnalysisOK), nil
+
+}
+
 /*
 GetCredentials Get the cluster admin credentials.
 */
@@ -771,6 +1170,33 @@ func (a *Client) GetHostIgnition(ctx context.Context, params *GetHostIgnitionPar
 
 }
 
+/*
+GetHostIgnitionPreview Preview the ignition configs a host will boot with, before the installation starts.
+Renders the effective discovery ignition and the projected ignition of the host's role, along with the changes each layer applied. Secrets are redacted.
+
+*/
+func (a *Client) GetHostIgnitionPreview(ctx context.Context, params *GetHostIgnitionPreviewParams) (*GetHostIgnitionPreviewOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetHostIgnitionPreview",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/ignition-preview",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetHostIgnitionPreviewReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetHostIgnitionPreviewOK), nil
+
+}
+
 /*
 GetHostRequirements Get minimum host requirements.
 */
@@ -796,6 +1222,31 @@ func (a *Client) GetHostRequirements(ctx context.Context, params *GetHostRequire
 
 }
 
+/*
+GetLogsUpload Agent API to retrieve a logs upload with the byte ranges that were received, in order to resume it.
+*/
+func (a *Client) GetLogsUpload(ctx context.Context, params *GetLogsUploadParams) (*GetLogsUploadOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetLogsUpload",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/logs/uploads/{upload_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetLogsUploadReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetLogsUploadOK), nil
+
+}
+
 /*
 GetNextSteps Retrieves the next operations that the host agent needs to perform.
 */
@@ -871,6 +1322,31 @@ func (a *Client) GetPresignedForClusterFiles(ctx context.Context, params *GetPre
 
 }
 
+/*
+GetQuota Retrieves the usage and limits of an organization or a user.
+*/
+func (a *Client) GetQuota(ctx context.Context, params *GetQuotaParams) (*GetQuotaOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetQuota",
+		Method:             "GET",
+		PathPattern:        "/quotas/{scope}/{owner}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetQuotaReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetQuotaOK), nil
+
+}
+
 /*
 InstallCluster Installs the OpenShift cluster.
 */
@@ -971,6 +1447,31 @@ func (a *Client) ListClusters(ctx context.Context, params *ListClustersParams) (
 
 }
 
+/*
+ListDeclaredHosts Retrieves the list of declared hosts of the cluster.
+*/
+func (a *Client) ListDeclaredHosts(ctx context.Context, params *ListDeclaredHostsParams) (*ListDeclaredHostsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListDeclaredHosts",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/declared-hosts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListDeclaredHostsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListDeclaredHostsOK), nil
+
+}
+
 /*
 ListHosts Retrieves the list of OpenShift hosts.
 */
@@ -996,6 +1497,56 @@ func (a *Client) ListHosts(ctx context.Context, params *ListHostsParams) (*ListH
 
 }
 
+/*
+ListLogsArchiveEntries Lists the files inside a stored host or controller logs archive.
+*/
+func (a *Client) ListLogsArchiveEntries(ctx context.Context, params *ListLogsArchiveEntriesParams) (*ListLogsArchiveEntriesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListLogsArchiveEntries",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/logs/entries",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListLogsArchiveEntriesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListLogsArchiveEntriesOK), nil
+
+}
+
+/*
+ListQuotas Lists the usage and limits of the organizations and users that own clusters or have limits of their own.
+*/
+func (a *Client) ListQuotas(ctx context.Context, params *ListQuotasParams) (*ListQuotasOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListQuotas",
+		Method:             "GET",
+		PathPattern:        "/quotas",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListQuotasReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListQuotasOK), nil
+
+}
+
 /*
 PostStepReply Posts the result of the operations from the host agent.
 */
@@ -1071,6 +1622,31 @@ func (a *Client) RegisterCluster(ctx context.Context, params *RegisterClusterPar
 
 }
 
+/*
+RegisterDeclaredHost Declares a host before it boots, so that a discovery image can be generated for it alone.
+*/
+func (a *Client) RegisterDeclaredHost(ctx context.Context, params *RegisterDeclaredHostParams) (*RegisterDeclaredHostCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RegisterDeclaredHost",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/declared-hosts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RegisterDeclaredHostReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RegisterDeclaredHostCreated), nil
+
+}
+
 /*
 RegisterHost Registers a new OpenShift host.
 */
@@ -1173,6 +1749,56 @@ func (a *Client) ResetHostValidation(ctx context.Context, params *ResetHostValid
 
 }
 
+/*
+RestoreArchivedCluster Restores an archived cluster with its hosts, monitored operators and events, and deletes the archive.
+*/
+func (a *Client) RestoreArchivedCluster(ctx context.Context, params *RestoreArchivedClusterParams) (*RestoreArchivedClusterCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RestoreArchivedCluster",
+		Method:             "POST",
+		PathPattern:        "/archived-clusters/{cluster_id}/actions/restore",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RestoreArchivedClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RestoreArchivedClusterCreated), nil
+
+}
+
+/*
+SearchLogsArchive Searches the files of a stored host or controller logs archive for lines that match a regular expression.
+*/
+func (a *Client) SearchLogsArchive(ctx context.Context, params *SearchLogsArchiveParams) (*SearchLogsArchiveOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "SearchLogsArchive",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/logs/search",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &SearchLogsArchiveReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SearchLogsArchiveOK), nil
+
+}
+
 /*
 UpdateCluster Updates an OpenShift cluster definition.
 */
@@ -1373,6 +1999,31 @@ func (a *Client) UpdateHostLogsProgress(ctx context.Context, params *UpdateHostL
 
 }
 
+/*
+UpdateQuotaLimits Sets the limits of an organization or a user. Limits that are not set fall back to the default limits of the service.
+*/
+func (a *Client) UpdateQuotaLimits(ctx context.Context, params *UpdateQuotaLimitsParams) (*UpdateQuotaLimitsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateQuotaLimits",
+		Method:             "PUT",
+		PathPattern:        "/quotas/{scope}/{owner}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateQuotaLimitsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateQuotaLimitsOK), nil
+
+}
+
 /*
 UploadClusterIngressCert Transfer the ingress certificate for the cluster.
 */
@@ -1447,3 +2098,28 @@ func (a *Client) UploadLogs(ctx context.Context, params *UploadLogsParams) (*Upl
 	return result.(*UploadLogsNoContent), nil
 
 }
+
+/*
+UploadLogsChunk Agent API to upload a chunk of a logs archive. Chunk N holds the bytes of the archive starting at N times the chunk size of the upload. A chunk that was already received is replaced.
+*/
+func (a *Client) UploadLogsChunk(ctx context.Context, params *UploadLogsChunkParams) (*UploadLogsChunkNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UploadLogsChunk",
+		Method:             "PUT",
+		PathPattern:        "/clusters/{cluster_id}/logs/uploads/{upload_id}/chunks/{chunk_number}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"multipart/form-data"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UploadLogsChunkReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UploadLogsChunkNoContent), nil
+
+}
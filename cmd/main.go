@@ -20,6 +20,7 @@ import (
 	"github.com/openshift/assisted-service/internal/bminventory"
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
+	"github.com/openshift/assisted-service/internal/clusterarchive"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/connectivity"
 	"github.com/openshift/assisted-service/internal/controller/controllers"
@@ -34,6 +35,8 @@ import (
 	"github.com/openshift/assisted-service/internal/imgexpirer"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/isoeditor"
+	"github.com/openshift/assisted-service/internal/loganalysis"
+	"github.com/openshift/assisted-service/internal/logsupload"
 	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/migrations"
@@ -41,6 +44,7 @@ import (
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/handler"
+	"github.com/openshift/assisted-service/internal/quota"
 	"github.com/openshift/assisted-service/internal/spec"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/internal/versions"
@@ -51,6 +55,7 @@ import (
 	dbPkg "github.com/openshift/assisted-service/pkg/db"
 	"github.com/openshift/assisted-service/pkg/executer"
 	"github.com/openshift/assisted-service/pkg/generator"
+	"github.com/openshift/assisted-service/pkg/hostbinding"
 	"github.com/openshift/assisted-service/pkg/k8sclient"
 	"github.com/openshift/assisted-service/pkg/leader"
 	logconfig "github.com/openshift/assisted-service/pkg/log"
@@ -84,6 +89,8 @@ const (
 	deployment_type_ocp    = "ocp"
 	storage_filesystem     = "filesystem"
 	storage_s3             = "s3"
+	storage_azure          = "azure"
+	storage_gcs            = "gcs"
 )
 
 var Options struct {
@@ -97,6 +104,9 @@ var Options struct {
 	GCConfig                    garbagecollector.Config
 	ClusterStateMonitorInterval time.Duration `envconfig:"CLUSTER_MONITOR_INTERVAL" default:"10s"`
 	S3Config                    s3wrapper.Config
+	AzureStorageConfig          s3wrapper.AzureConfig
+	GCSConfig                   s3wrapper.GCSConfig
+	StorageEncryptionConfig     s3wrapper.EncryptionConfig
 	HostStateMonitorInterval    time.Duration `envconfig:"HOST_MONITOR_INTERVAL" default:"8s"`
 	Versions                    versions.Versions
 	OpenshiftVersions           string        `envconfig:"OPENSHIFT_VERSIONS"`
@@ -117,6 +127,9 @@ var Options struct {
 	EnableKubeAPIDay2Cluster    bool `envconfig:"ENABLE_KUBE_API_DAY2" default:"false"`
 	InfraEnvConfig              controllers.InfraEnvConfig
 	ISOEditorConfig             isoeditor.Config
+	LogAnalysisConfig           loganalysis.Config
+	LogsUploadConfig            logsupload.Config
+	QuotaConfig                 quota.Config
 	CheckClusterVersion         bool          `envconfig:"CHECK_CLUSTER_VERSION" default:"false"`
 	DeletionWorkerInterval      time.Duration `envconfig:"DELETION_WORKER_INTERVAL" default:"1h"`
 	DeregisterWorkerInterval    time.Duration `envconfig:"DEREGISTER_WORKER_INTERVAL" default:"1h"`
@@ -214,6 +227,7 @@ func main() {
 
 	Options.InstructionConfig.ReleaseImageMirror = Options.ReleaseImageMirror
 	Options.InstructionConfig.CheckClusterVersion = Options.CheckClusterVersion
+	Options.HostConfig.AgentDockerImage = Options.InstructionConfig.AgentImage
 	Options.OperatorsConfig.CheckClusterVersion = Options.CheckClusterVersion
 	Options.GeneratorConfig.ReleaseImageMirror = Options.ReleaseImageMirror
 
@@ -234,13 +248,27 @@ func main() {
 	installConfigBuilder := installcfg.NewInstallConfigBuilder(log.WithField("pkg", "installcfg"), mirrorRegistriesBuilder)
 	isoEditorFactory := isoeditor.NewFactory(Options.ISOEditorConfig, staticNetworkConfig)
 
-	var objectHandler = createStorageClient(Options.DeployTarget, Options.Storage, &Options.S3Config,
-		Options.WorkDir, log, versionHandler, isoEditorFactory, metricsManager, Options.FileSystemUsageThreshold)
+	var objectHandler = createStorageClient(Options.DeployTarget, Options.Storage, &Options.S3Config, &Options.AzureStorageConfig,
+		&Options.GCSConfig, Options.WorkDir, log, versionHandler, isoEditorFactory, metricsManager, Options.FileSystemUsageThreshold, Options.BMConfig.ServiceBaseURL)
+	fsClient, isFilesystem := objectHandler.(*s3wrapper.FSClientDecorator)
+	if Options.BMConfig.StreamDiscoveryISOs && !isFilesystem {
+		log.Fatal("Streaming discovery ISOs is only supported with filesystem storage")
+	}
+	var encryptedClient *s3wrapper.EncryptedClient
+	if Options.StorageEncryptionConfig.Enabled() {
+		kms, err := Options.StorageEncryptionConfig.NewKMS()
+		failOnError(err, "Failed to create the KMS of the storage encryption")
+		encryptedClient = s3wrapper.NewEncryptedClient(objectHandler, db, kms, Options.StorageEncryptionConfig.KeyRotationInterval,
+			log.WithField("pkg", "storage-encryption"))
+		objectHandler = encryptedClient
+	}
 	createS3Bucket(objectHandler, log)
 
 	manifestsApi := manifests.NewManifestsAPI(db, log.WithField("pkg", "manifests"), objectHandler)
 	operatorsManager := operators.NewManager(log, manifestsApi, Options.OperatorsConfig, objectHandler)
 	hwValidator := hardware.NewValidator(log.WithField("pkg", "validators"), Options.HWValidatorConfig, operatorsManager)
+	logAnalyzer, err := loganalysis.NewAnalyzer(log.WithField("pkg", "loganalysis"), Options.LogAnalysisConfig)
+	failOnError(err, "failed to create log analyzer")
 	connectivityValidator := connectivity.NewValidator(log.WithField("pkg", "validators"))
 	instructionApi := hostcommands.NewInstructionManager(log.WithField("pkg", "instructions"), db, hwValidator,
 		releaseHandler, Options.InstructionConfig, connectivityValidator, eventsHandler, versionHandler)
@@ -332,8 +360,24 @@ func main() {
 		crdUtils = controllers.NewDummyCRDUtils()
 	}
 
+	logsUploadApi := logsupload.NewManager(Options.LogsUploadConfig, log.WithField("pkg", "logs-upload"), db, objectHandler)
+	quotaApi := quota.NewManager(Options.QuotaConfig, log.WithField("pkg", "quota"), db)
+	clusterArchiveApi := clusterarchive.NewManager(log.WithField("pkg", "cluster-archive"), db, objectHandler)
+
+	gc := garbagecollector.NewGarbageCollectors(Options.GCConfig, db, log.WithField("pkg", "garbage_collector"), hostApi, clusterApi, objectHandler, lead,
+		logsUploadApi, clusterArchiveApi)
+
+	// Abandoned logs uploads are always deleted, since their chunks are not deleted otherwise until their cluster is
+	logsUploadDeletionWorker := thread.New(
+		log.WithField("garbagecollector", "Logs Upload Deletion Worker"),
+		"Logs Upload Deletion Worker",
+		Options.DeletionWorkerInterval,
+		gc.DeleteAbandonedLogsUploads)
+
+	logsUploadDeletionWorker.Start()
+	defer logsUploadDeletionWorker.Stop()
+
 	if Options.EnableDeregisterInactiveGC || Options.EnableDeletedUnregisteredGC {
-		gc := garbagecollector.NewGarbageCollectors(Options.GCConfig, db, log.WithField("pkg", "garbage_collector"), hostApi, clusterApi, objectHandler, lead)
 
 		// In operator-deployment, ClusterDeployment is responsible for managing the lifetime of the cluster resource.
 		if !Options.EnableKubeAPI && Options.EnableDeregisterInactiveGC {
@@ -347,6 +391,17 @@ func main() {
 			defer deregisterWorker.Stop()
 		}
 
+		if !Options.EnableKubeAPI && Options.GCConfig.ArchiveInstalledAfter > 0 {
+			archiveWorker := thread.New(
+				log.WithField("garbagecollector", "Archive Worker"),
+				"Archive Worker",
+				Options.DeregisterWorkerInterval,
+				gc.ArchiveInstalledClusters)
+
+			archiveWorker.Start()
+			defer archiveWorker.Stop()
+		}
+
 		if Options.EnableDeletedUnregisteredGC {
 			deletionWorker := thread.New(
 				log.WithField("garbagecollector", "Deletion Worker"),
@@ -361,14 +416,26 @@ func main() {
 
 	bm := bminventory.NewBareMetalInventory(db, log.WithField("pkg", "Inventory"), hostApi, clusterApi, Options.BMConfig,
 		generator, eventsHandler, objectHandler, metricsManager, usageManager, operatorsManager, authHandler, ocpClient, ocmClient,
-		lead, pullSecretValidator, versionHandler, isoEditorFactory, crdUtils, ignitionBuilder, hwValidator, dnsApi, installConfigBuilder, staticNetworkConfig)
+		lead, pullSecretValidator, versionHandler, isoEditorFactory, crdUtils, ignitionBuilder, hwValidator, dnsApi, installConfigBuilder, staticNetworkConfig,
+		logAnalyzer, logsUploadApi, quotaApi, clusterArchiveApi)
 
 	events := events.NewApi(eventsHandler, logrus.WithField("pkg", "eventsApi"))
-	expirer := imgexpirer.NewManager(objectHandler, eventsHandler, Options.BMConfig.ImageExpirationTime, lead, Options.EnableKubeAPI)
+	expirer := imgexpirer.NewManager(db, objectHandler, eventsHandler, quotaApi, Options.BMConfig.ImageExpirationTime, lead, Options.EnableKubeAPI)
 	imageExpirationMonitor := thread.New(
 		log.WithField("pkg", "image-expiration-monitor"), "Image Expiration Monitor", Options.ImageExpirationInterval, expirer.ExpirationTask)
 	imageExpirationMonitor.Start()
 	defer imageExpirationMonitor.Stop()
+
+	if encryptedClient != nil {
+		reencryptionMonitor := thread.New(
+			log.WithField("pkg", "storage-reencryption"), "Storage Re-encryption", Options.StorageEncryptionConfig.ReencryptionInterval, func() {
+				if lead.IsLeader() {
+					encryptedClient.ReencryptObjects(requestid.ToContext(context.Background(), requestid.NewID()))
+				}
+			})
+		reencryptionMonitor.Start()
+		defer reencryptionMonitor.Stop()
+	}
 	assistedServiceISO := assistedserviceiso.NewAssistedServiceISOApi(objectHandler, authHandler, logrus.WithField("pkg", "assistedserviceiso"), pullSecretValidator, Options.AssistedServiceISOConfig)
 
 	//Set inner handler chain. Inner handlers requires access to the Route
@@ -419,8 +486,12 @@ func main() {
 	h = app.WithMetricsResponderMiddleware(h)
 	apiEnabler := NewApiEnabler(h, log)
 	h = app.WithHealthMiddleware(apiEnabler)
+	if isFilesystem {
+		h = fsClient.SignedURLMiddleware(h)
+	}
 	h = requestid.Middleware(h)
 	h = spec.WithSpecMiddleware(h)
+	h = hostbinding.Middleware(h)
 
 	go func() {
 		// Upload ISOs with a leader lock if we're running with multiple replicas
@@ -585,8 +656,9 @@ func createS3Bucket(objectHandler s3wrapper.API, log logrus.FieldLogger) {
 	}
 }
 
-func createStorageClient(deployTarget string, storage string, s3cfg *s3wrapper.Config, fsWorkDir string,
-	log logrus.FieldLogger, versionsHandler versions.Handler, isoEditorFactory isoeditor.Factory, metricsAPI metrics.API, fsThreshold int) s3wrapper.API {
+func createStorageClient(deployTarget string, storage string, s3cfg *s3wrapper.Config, azureCfg *s3wrapper.AzureConfig,
+	gcsCfg *s3wrapper.GCSConfig, fsWorkDir string, log logrus.FieldLogger, versionsHandler versions.Handler, isoEditorFactory isoeditor.Factory, metricsAPI metrics.API, fsThreshold int,
+	serviceBaseURL string) s3wrapper.API {
 	var storageClient s3wrapper.API
 	if storage != "" {
 		switch storage {
@@ -596,10 +668,22 @@ func createStorageClient(deployTarget string, storage string, s3cfg *s3wrapper.C
 				log.Fatal("failed to create S3 client")
 			}
 		case storage_filesystem:
-			storageClient = s3wrapper.NewFSClient(fsWorkDir, log, versionsHandler, isoEditorFactory, metricsAPI, fsThreshold)
+			storageClient = s3wrapper.NewFSClient(fsWorkDir, log, versionsHandler, isoEditorFactory, metricsAPI, fsThreshold, serviceBaseURL)
 			if storageClient == nil {
 				log.Fatal("failed to create filesystem client")
 			}
+		case storage_azure:
+			azureClient := s3wrapper.NewAzureClient(azureCfg, log, versionsHandler, isoEditorFactory)
+			if azureClient == nil {
+				log.Fatal("failed to create Azure storage client")
+			}
+			storageClient = azureClient
+		case storage_gcs:
+			gcsClient := s3wrapper.NewGCSClient(gcsCfg, log, versionsHandler, isoEditorFactory)
+			if gcsClient == nil {
+				log.Fatal("failed to create GCS client")
+			}
+			storageClient = gcsClient
 		default:
 			log.Fatalf("unsupported storage client: %s", storage)
 		}
@@ -612,7 +696,7 @@ func createStorageClient(deployTarget string, storage string, s3cfg *s3wrapper.C
 				log.Fatal("failed to create S3 client")
 			}
 		case deployment_type_onprem, deployment_type_ocp:
-			storageClient = s3wrapper.NewFSClient(fsWorkDir, log, versionsHandler, isoEditorFactory, metricsAPI, fsThreshold)
+			storageClient = s3wrapper.NewFSClient(fsWorkDir, log, versionsHandler, isoEditorFactory, metricsAPI, fsThreshold, serviceBaseURL)
 			if storageClient == nil {
 				log.Fatal("failed to create S3 filesystem client")
 			}
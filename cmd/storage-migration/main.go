@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	"github.com/kelseyhightower/envconfig"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/storagemigration"
+	dbPkg "github.com/openshift/assisted-service/pkg/db"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+// storageOptions selects the storage that objects are migrated from or to. Its configuration is read with the SOURCE_
+// or TARGET_ prefix, e.g. SOURCE_STORAGE=filesystem, SOURCE_STORAGE_DIR=/data, TARGET_STORAGE=s3 and TARGET_S3_BUCKET,
+// and falls back to the environment variables of the service.
+type storageOptions struct {
+	Storage string `envconfig:"STORAGE" required:"true"`
+	Dir     string `envconfig:"STORAGE_DIR" default:"/data"`
+}
+
+var Options struct {
+	DBConfig dbPkg.Config
+}
+
+func newStorageClient(prefix string, log logrus.FieldLogger) (s3wrapper.API, error) {
+	var options storageOptions
+	if err := envconfig.Process(prefix, &options); err != nil {
+		return nil, err
+	}
+	switch options.Storage {
+	case "s3":
+		var cfg s3wrapper.Config
+		if err := envconfig.Process(prefix, &cfg); err != nil {
+			return nil, err
+		}
+		if client := s3wrapper.NewS3Client(&cfg, log, nil, nil); client != nil {
+			return client, nil
+		}
+	case "filesystem":
+		metricsManager := metrics.NewMetricsManager(prometheus.NewRegistry(), nil)
+		return s3wrapper.NewFSClient(options.Dir, log, nil, nil, metricsManager, 100, ""), nil
+	case "azure":
+		var cfg s3wrapper.AzureConfig
+		if err := envconfig.Process(prefix, &cfg); err != nil {
+			return nil, err
+		}
+		if client := s3wrapper.NewAzureClient(&cfg, log, nil, nil); client != nil {
+			return client, nil
+		}
+	case "gcs":
+		var cfg s3wrapper.GCSConfig
+		if err := envconfig.Process(prefix, &cfg); err != nil {
+			return nil, err
+		}
+		if client := s3wrapper.NewGCSClient(&cfg, log, nil, nil); client != nil {
+			return client, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported storage %s", options.Storage)
+	}
+	return nil, fmt.Errorf("failed to create %s storage client", options.Storage)
+}
+
+func printReport(report *storagemigration.Report) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CLUSTER\tOBJECT\tSIZE\tSTATUS\tERROR")
+	for _, object := range report.Objects {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", object.ClusterID, object.ObjectName, object.SizeBytes, object.Status, object.Error)
+	}
+	w.Flush()
+	fmt.Printf("\n%d objects, %d bytes: %d copied, %d already migrated, %d pending, %d failed\n", len(report.Objects), report.TotalBytes,
+		report.Count(storagemigration.StatusCopied), report.Count(storagemigration.StatusMigrated),
+		report.Count(storagemigration.StatusPending), report.Count(storagemigration.StatusFailed))
+}
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "report the objects that would be migrated without copying them")
+	stateFile := flag.String("state-file", "storage-migration-state.json", "file that records the migrated objects to resume the migration")
+	flag.Parse()
+
+	log := logrus.New()
+	log.SetReportCaller(true)
+	if err := envconfig.Process(common.EnvConfigPrefix, &Options); err != nil {
+		log.WithError(err).Fatal("Failed to process config")
+	}
+
+	source, err := newStorageClient("SOURCE", log.WithField("storage", "source"))
+	if err != nil {
+		log.WithError(err).Fatal("Failed to create the source storage client")
+	}
+	target, err := newStorageClient("TARGET", log.WithField("storage", "target"))
+	if err != nil {
+		log.WithError(err).Fatal("Failed to create the target storage client")
+	}
+	if !*dryRun {
+		if err = target.CreateBucket(); err != nil {
+			log.WithError(err).Fatal("Failed to create the bucket of the target storage")
+		}
+	}
+
+	db, err := gorm.Open("postgres", fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=disable",
+		Options.DBConfig.Host, Options.DBConfig.Port, Options.DBConfig.User, Options.DBConfig.Name, Options.DBConfig.Pass))
+	if err != nil {
+		log.WithError(err).Fatal("Failed to connect to DB")
+	}
+	defer db.Close()
+	// Deleted clusters are not migrated
+	var clusters []*common.Cluster
+	if err = db.Select("id, image_content_hash").Find(&clusters).Error; err != nil {
+		log.WithError(err).Fatal("Failed to list clusters")
+	}
+
+	migrator, err := storagemigration.NewMigrator(log, source, target, *stateFile)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to create migrator")
+	}
+	report, err := migrator.Migrate(context.Background(), clusters, *dryRun)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to migrate objects")
+	}
+	printReport(report)
+	if report.Count(storagemigration.StatusFailed) > 0 {
+		os.Exit(1)
+	}
+}
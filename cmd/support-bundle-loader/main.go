@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	"github.com/kelseyhightower/envconfig"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/supportbundle"
+	dbPkg "github.com/openshift/assisted-service/pkg/db"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+var Options struct {
+	DBConfig dbPkg.Config
+}
+
+func main() {
+	bundleFile := flag.String("bundle", "", "support bundle to load")
+	storageDir := flag.String("storage-dir", "", "directory of a filesystem storage to store the log archives of the bundle in")
+	flag.Parse()
+
+	log := logrus.New()
+	log.SetReportCaller(true)
+	if *bundleFile == "" {
+		log.Fatal("The support bundle must be set with -bundle")
+	}
+	if err := envconfig.Process(common.EnvConfigPrefix, &Options); err != nil {
+		log.WithError(err).Fatal("Failed to process config")
+	}
+
+	var objectHandler s3wrapper.API
+	if *storageDir != "" {
+		metricsManager := metrics.NewMetricsManager(prometheus.NewRegistry(), nil)
+		objectHandler = s3wrapper.NewFSClient(*storageDir, log, nil, nil, metricsManager, 100, "")
+	}
+
+	db, err := gorm.Open("postgres", fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=disable",
+		Options.DBConfig.Host, Options.DBConfig.Port, Options.DBConfig.User, Options.DBConfig.Name, Options.DBConfig.Pass))
+	if err != nil {
+		log.WithError(err).Fatal("Failed to connect to DB")
+	}
+	defer db.Close()
+	if err = common.AutoMigrate(db); err != nil {
+		log.WithError(err).Fatal("Failed to migrate the DB")
+	}
+
+	file, err := os.Open(*bundleFile)
+	if err != nil {
+		log.WithError(err).Fatalf("Failed to open %s", *bundleFile)
+	}
+	defer file.Close()
+	bundle, err := supportbundle.Read(context.Background(), file, objectHandler)
+	if err != nil {
+		log.WithError(err).Fatalf("Failed to read %s", *bundleFile)
+	}
+	if err = supportbundle.Load(db, bundle); err != nil {
+		log.WithError(err).Fatalf("Failed to load %s", *bundleFile)
+	}
+	fmt.Printf("Loaded cluster %s with %d hosts and %d events, collected at %s\n", bundle.Cluster.ID, len(bundle.Hosts),
+		len(bundle.Events), bundle.Metadata.CreatedAt)
+}
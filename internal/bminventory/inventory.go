@@ -1,11 +1,14 @@
 package bminventory
 
 import (
+	"bytes"
 	"context"
 
 	// #nosec
 	"crypto/md5"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,11 +16,15 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"path"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"text/template"
 	"time"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -27,6 +34,7 @@ import (
 	"github.com/kennygrant/sanitize"
 	clusterPkg "github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
+	"github.com/openshift/assisted-service/internal/clusterarchive"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/dns"
@@ -40,10 +48,15 @@ import (
 	"github.com/openshift/assisted-service/internal/ignition"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/isoeditor"
+	"github.com/openshift/assisted-service/internal/loganalysis"
+	"github.com/openshift/assisted-service/internal/logarchive"
+	"github.com/openshift/assisted-service/internal/logsupload"
 	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/quota"
+	"github.com/openshift/assisted-service/internal/supportbundle"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
@@ -52,6 +65,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/conversions"
 	"github.com/openshift/assisted-service/pkg/filemiddleware"
 	"github.com/openshift/assisted-service/pkg/generator"
+	"github.com/openshift/assisted-service/pkg/hostbinding"
 	"github.com/openshift/assisted-service/pkg/k8sclient"
 	"github.com/openshift/assisted-service/pkg/leader"
 	logutil "github.com/openshift/assisted-service/pkg/log"
@@ -101,6 +115,12 @@ type Config struct {
 	DefaultServiceNetworkCidr       string            `envconfig:"SERVICE_NETWORK_CIDR" default:"172.30.0.0/16"`
 	ISOImageType                    string            `envconfig:"ISO_IMAGE_TYPE" default:"full-iso"`
 	IPv6Support                     bool              `envconfig:"IPV6_SUPPORT" default:"true"`
+	// Full and minimal ISOs are generated while they are downloaded rather than stored, only supported with filesystem storage
+	StreamDiscoveryISOs bool `envconfig:"STREAM_DISCOVERY_ISOS" default:"false"`
+	// EC private key the checksums of discovery images are signed with, image signing is disabled when empty
+	ImageSigningKeyPEM string `envconfig:"IMAGE_SIGNING_KEY_PEM" default:""`
+	// Limits the amount of uncompressed data that is read from a logs archive to list or search it
+	LogsArchiveMaxReadBytes int64 `envconfig:"LOGS_ARCHIVE_MAX_READ_BYTES" default:"2147483648"`
 }
 
 const minimalOpenShiftVersionForSingleNode = "4.8.0-0.0"
@@ -163,6 +183,10 @@ type bareMetalInventory struct {
 	hwValidator          hardware.Validator
 	installConfigBuilder installcfg.InstallConfigBuilder
 	staticNetworkConfig  staticnetworkconfig.StaticNetworkConfig
+	logAnalyzer          loganalysis.Analyzer
+	logsUploadApi        logsupload.API
+	quotaApi             quota.API
+	clusterArchiveApi    clusterarchive.API
 }
 
 func NewBareMetalInventory(
@@ -190,6 +214,10 @@ func NewBareMetalInventory(
 	dnsApi dns.DNSApi,
 	installConfigBuilder installcfg.InstallConfigBuilder,
 	staticNetworkConfig staticnetworkconfig.StaticNetworkConfig,
+	logAnalyzer loganalysis.Analyzer,
+	logsUploadApi logsupload.API,
+	quotaApi quota.API,
+	clusterArchiveApi clusterarchive.API,
 ) *bareMetalInventory {
 	return &bareMetalInventory{
 		db:                   db,
@@ -216,6 +244,10 @@ func NewBareMetalInventory(
 		hwValidator:          hwValidator,
 		installConfigBuilder: installConfigBuilder,
 		staticNetworkConfig:  staticNetworkConfig,
+		logAnalyzer:          logAnalyzer,
+		logsUploadApi:        logsUploadApi,
+		quotaApi:             quotaApi,
+		clusterArchiveApi:    clusterArchiveApi,
 	}
 }
 
@@ -265,13 +297,13 @@ func (b *bareMetalInventory) UpdateDiscoveryIgnitionInternal(ctx context.Context
 		return err
 	}
 
-	_, err = ignition.ParseToLatest([]byte(params.DiscoveryIgnitionParams.Config))
+	config, err := ignition.ValidateDiscoveryIgnitionOverride(params.DiscoveryIgnitionParams.Config)
 	if err != nil {
-		log.WithError(err).Errorf("Failed to parse ignition config patch %s", params.DiscoveryIgnitionParams)
+		log.WithError(err).Errorf("Failed to validate ignition config patch %s", params.DiscoveryIgnitionParams)
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
 
-	err = b.db.Model(&common.Cluster{}).Where(identity.AddUserFilter(ctx, "id = ?"), params.ClusterID).Update("ignition_config_overrides", params.DiscoveryIgnitionParams.Config).Error
+	err = b.db.Model(&common.Cluster{}).Where(identity.AddUserFilter(ctx, "id = ?"), params.ClusterID).Update("ignition_config_overrides", config).Error
 	if err != nil {
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
@@ -279,7 +311,7 @@ func (b *bareMetalInventory) UpdateDiscoveryIgnitionInternal(ctx context.Context
 	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, "Custom discovery ignition config was applied to the cluster", time.Now())
 	log.Infof("Custom discovery ignition config was applied to cluster %s", params.ClusterID)
 
-	existed, err := b.objectHandler.DeleteObject(ctx, getImageName(*c.ID))
+	existed, err := b.releaseClusterImage(ctx, c)
 	if err != nil {
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
@@ -317,10 +349,96 @@ func (b *bareMetalInventory) setDefaultRegisterClusterParams(_ context.Context,
 	if params.NewClusterParams.Hyperthreading == nil {
 		params.NewClusterParams.Hyperthreading = swag.String(models.ClusterHyperthreadingAll)
 	}
+	if len(params.NewClusterParams.ClusterNetworks) == 0 {
+		params.NewClusterParams.ClusterNetworks = []*models.ClusterNetwork{{
+			Cidr:       swag.StringValue(params.NewClusterParams.ClusterNetworkCidr),
+			HostPrefix: params.NewClusterParams.ClusterNetworkHostPrefix,
+		}}
+	}
+	if len(params.NewClusterParams.ServiceNetworks) == 0 {
+		params.NewClusterParams.ServiceNetworks = []*models.ServiceNetwork{{
+			Cidr: swag.StringValue(params.NewClusterParams.ServiceNetworkCidr),
+		}}
+	}
 
 	return params
 }
 
+// setPrimaryNetworksParams copies the first network of each of the network lists to the matching single network
+// CIDR param. The first network is the primary one, and the single network CIDR fields are kept in sync with it.
+func setPrimaryNetworksParams(params *models.ClusterCreateParams) error {
+	if len(params.ClusterNetworks) > 0 {
+		primary := params.ClusterNetworks[0]
+		if params.ClusterNetworkCidr != nil && *params.ClusterNetworkCidr != primary.Cidr {
+			return errors.Errorf("Cluster network CIDR %s is different than the first cluster network %s", *params.ClusterNetworkCidr, primary.Cidr)
+		}
+		if params.ClusterNetworkHostPrefix != 0 && params.ClusterNetworkHostPrefix != primary.HostPrefix {
+			return errors.Errorf("Cluster network host prefix %d is different than the host prefix of the first cluster network %d",
+				params.ClusterNetworkHostPrefix, primary.HostPrefix)
+		}
+		params.ClusterNetworkCidr = swag.String(primary.Cidr)
+		params.ClusterNetworkHostPrefix = primary.HostPrefix
+	}
+	if len(params.ServiceNetworks) > 0 {
+		primary := params.ServiceNetworks[0]
+		if params.ServiceNetworkCidr != nil && *params.ServiceNetworkCidr != primary.Cidr {
+			return errors.Errorf("Service network CIDR %s is different than the first service network %s", *params.ServiceNetworkCidr, primary.Cidr)
+		}
+		params.ServiceNetworkCidr = swag.String(primary.Cidr)
+	}
+	return nil
+}
+
+func validateRegisterClusterNetworks(ipV6Supported bool, params *models.ClusterCreateParams) error {
+	machineCidrs := make([]string, 0, len(params.MachineNetworks))
+	for _, n := range params.MachineNetworks {
+		machineCidrs = append(machineCidrs, n.Cidr)
+	}
+	clusterCidrs := make([]string, 0, len(params.ClusterNetworks))
+	for _, n := range params.ClusterNetworks {
+		clusterCidrs = append(clusterCidrs, n.Cidr)
+	}
+	serviceCidrs := make([]string, 0, len(params.ServiceNetworks))
+	for _, n := range params.ServiceNetworks {
+		serviceCidrs = append(serviceCidrs, n.Cidr)
+	}
+	for _, cidr := range append(append(machineCidrs, clusterCidrs...), serviceCidrs...) {
+		if err := validations.ValidateIPAddressFamily(ipV6Supported, swag.String(cidr)); err != nil {
+			return err
+		}
+	}
+
+	numberOfHosts := 0
+	if swag.StringValue(params.HighAvailabilityMode) == models.ClusterHighAvailabilityModeNone {
+		numberOfHosts = 1
+	}
+	if err := network.VerifyClusterNetworks(params.ClusterNetworks, numberOfHosts); err != nil {
+		return err
+	}
+	if err := network.VerifyServiceNetworks(params.ServiceNetworks); err != nil {
+		return err
+	}
+	userManagedNetworking := swag.BoolValue(params.UserManagedNetworking)
+	if len(params.MachineNetworks) > 0 {
+		if userManagedNetworking && swag.StringValue(params.HighAvailabilityMode) != models.ClusterHighAvailabilityModeNone {
+			if err := network.VerifyUserManagedMachineNetworks(params.MachineNetworks); err != nil {
+				return err
+			}
+		} else {
+			if err := network.VerifyMachineNetworks(params.MachineNetworks); err != nil {
+				return err
+			}
+			if err := validations.ValidateVipDHCPAllocationWithIPv6(swag.BoolValue(params.VipDhcpAllocation), machineCidrs[0]); err != nil {
+				return err
+			}
+		}
+	}
+	if err := network.VerifyDualStackNetworks(machineCidrs, clusterCidrs, serviceCidrs); err != nil {
+		return err
+	}
+	return network.VerifyDualStackCIDRsNotOverlap(machineCidrs, clusterCidrs, serviceCidrs, userManagedNetworking)
+}
+
 func (b *bareMetalInventory) RegisterClusterInternal(
 	ctx context.Context,
 	kubeKey *types.NamespacedName,
@@ -365,6 +483,18 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	if swag.StringValue(params.NewClusterParams.AdditionalTrustBundle) != "" {
+		if err = validations.ValidateAdditionalTrustBundle(*params.NewClusterParams.AdditionalTrustBundle); err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
+	networksSet := len(params.NewClusterParams.ClusterNetworks) > 0 || len(params.NewClusterParams.ServiceNetworks) > 0 ||
+		len(params.NewClusterParams.MachineNetworks) > 0
+	if err = setPrimaryNetworksParams(params.NewClusterParams); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
 	params = b.setDefaultRegisterClusterParams(ctx, params)
 
 	if swag.StringValue(params.NewClusterParams.HighAvailabilityMode) == models.ClusterHighAvailabilityModeNone {
@@ -390,6 +520,12 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 		}
 	}
 
+	if networksSet {
+		if err = validateRegisterClusterNetworks(b.IPv6Support, params.NewClusterParams); err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
 	if params.NewClusterParams.AdditionalNtpSource == nil {
 		params.NewClusterParams.AdditionalNtpSource = &b.Config.DefaultNTPSource
 	} else {
@@ -437,6 +573,9 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 			OpenshiftVersion:         *openshiftVersion.ReleaseVersion,
 			OcpReleaseImage:          *openshiftVersion.ReleaseImage,
 			ServiceNetworkCidr:       swag.StringValue(params.NewClusterParams.ServiceNetworkCidr),
+			ClusterNetworks:          params.NewClusterParams.ClusterNetworks,
+			ServiceNetworks:          params.NewClusterParams.ServiceNetworks,
+			MachineNetworks:          params.NewClusterParams.MachineNetworks,
 			SSHPublicKey:             params.NewClusterParams.SSHPublicKey,
 			UpdatedAt:                strfmt.DateTime{},
 			UserName:                 ocm.UserNameFromContext(ctx),
@@ -445,6 +584,7 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 			HTTPProxy:                swag.StringValue(params.NewClusterParams.HTTPProxy),
 			HTTPSProxy:               swag.StringValue(params.NewClusterParams.HTTPSProxy),
 			NoProxy:                  swag.StringValue(params.NewClusterParams.NoProxy),
+			AdditionalTrustBundle:    swag.StringValue(params.NewClusterParams.AdditionalTrustBundle),
 			VipDhcpAllocation:        params.NewClusterParams.VipDhcpAllocation,
 			UserManagedNetworking:    params.NewClusterParams.UserManagedNetworking,
 			AdditionalNtpSource:      swag.StringValue(params.NewClusterParams.AdditionalNtpSource),
@@ -455,6 +595,10 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 		KubeKeyName:      kubeKey.Name,
 		KubeKeyNamespace: kubeKey.Namespace,
 	}
+	if len(params.NewClusterParams.MachineNetworks) > 0 {
+		cluster.MachineNetworkCidr = params.NewClusterParams.MachineNetworks[0].Cidr
+		cluster.MachineNetworkCidrUpdatedAt = time.Now()
+	}
 
 	proxyHash, err := computeClusterProxyHash(params.NewClusterParams.HTTPProxy,
 		params.NewClusterParams.HTTPSProxy,
@@ -493,9 +637,14 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 
 	b.setDefaultUsage(&cluster.Cluster)
 
-	err = b.clusterApi.RegisterCluster(ctx, &cluster)
+	err = b.quotaApi.CheckCluster(ctx, func() error {
+		if registerErr := b.clusterApi.RegisterCluster(ctx, &cluster); registerErr != nil {
+			return common.NewApiError(http.StatusInternalServerError, registerErr)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, common.NewApiError(http.StatusInternalServerError, err)
+		return nil, err
 	}
 
 	if b.ocmClient != nil && b.ocmClient.Config.WithAMSSubscriptions {
@@ -626,10 +775,15 @@ func (b *bareMetalInventory) RegisterAddHostsClusterInternal(ctx context.Context
 	}
 
 	// After registering the cluster, its status should be 'ClusterStatusAddingHosts'
-	err = b.clusterApi.RegisterAddHostsCluster(ctx, &newCluster)
+	err = b.quotaApi.CheckCluster(ctx, func() error {
+		if registerErr := b.clusterApi.RegisterAddHostsCluster(ctx, &newCluster); registerErr != nil {
+			log.Errorf("failed to register cluster %s ", clusterName)
+			return common.NewApiError(http.StatusInternalServerError, registerErr)
+		}
+		return nil
+	})
 	if err != nil {
-		log.Errorf("failed to register cluster %s ", clusterName)
-		return nil, common.NewApiError(http.StatusInternalServerError, err)
+		return nil, err
 	}
 
 	b.metricApi.ClusterRegistered(newCluster.OpenshiftVersion, *newCluster.ID, newCluster.EmailDomain)
@@ -725,7 +879,11 @@ func (b *bareMetalInventory) DownloadClusterISO(ctx context.Context, params inst
 		return common.NewApiError(http.StatusNotFound, err)
 	}
 
-	imgName := getImageName(*cluster.ID)
+	if b.streamsImage(cluster.ImageInfo.Type) {
+		return b.streamClusterISO(ctx, &cluster, params.HTTPRequest)
+	}
+
+	imgName := getImageName(&cluster)
 	exists, err := b.objectHandler.DoesObjectExist(ctx, imgName)
 	if err != nil {
 		log.WithError(err).Errorf("Failed to get ISO for cluster %s", cluster.ID.String())
@@ -752,9 +910,33 @@ func (b *bareMetalInventory) DownloadClusterISO(ctx context.Context, params inst
 	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo,
 		fmt.Sprintf(`Started image download (image type is "%s")`, cluster.ImageInfo.Type), time.Now())
 
-	return filemiddleware.NewResponder(installer.NewDownloadClusterISOOK().WithPayload(reader),
-		fmt.Sprintf("cluster-%s-discovery.iso", params.ClusterID.String()),
-		contentLength)
+	return filemiddleware.NewResponderWithContentType(installer.NewDownloadClusterISOOK().WithPayload(reader),
+		getImageDownloadName(&cluster), getImageContentType(cluster.ImageInfo.Type), contentLength)
+}
+
+// streamClusterISO serves the ISO of the cluster while generating it, honoring the Range header of the request
+func (b *bareMetalInventory) streamClusterISO(ctx context.Context, cluster *common.Cluster, request *http.Request) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	stream, _, err := b.openClusterISOStream(ctx, cluster)
+	if err != nil {
+		if _, ok := err.(common.NotFound); ok {
+			b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError,
+				"Failed to download image: the image was not found (perhaps it expired) - please generate the image and try again", time.Now())
+			return installer.NewDownloadClusterISONotFound().
+				WithPayload(common.GenerateError(http.StatusNotFound, errors.New("The image was not found "+
+					"(perhaps it expired) - please generate the image and try again")))
+		}
+		log.WithError(err).Errorf("Failed to stream ISO for cluster %s", cluster.ID.String())
+		b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError,
+			"Failed to download image: error fetching from storage backend", time.Now())
+		return installer.NewDownloadClusterISOInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+	b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityInfo,
+		fmt.Sprintf(`Started image download (image type is "%s")`, cluster.ImageInfo.Type), time.Now())
+
+	return filemiddleware.NewRangeResponder(stream, getImageDownloadName(cluster), getImageContentType(cluster.ImageInfo.Type),
+		time.Time(cluster.ImageInfo.CreatedAt), request)
 }
 
 func (b *bareMetalInventory) DownloadClusterISOHeaders(ctx context.Context, params installer.DownloadClusterISOHeadersParams) middleware.Responder {
@@ -766,7 +948,22 @@ func (b *bareMetalInventory) DownloadClusterISOHeaders(ctx context.Context, para
 		return common.NewApiError(http.StatusNotFound, err)
 	}
 
-	imgName := getImageName(*cluster.ID)
+	if b.streamsImage(cluster.ImageInfo.Type) {
+		stream, imgSize, err := b.openClusterISOStream(ctx, &cluster)
+		if err != nil {
+			if _, ok := err.(common.NotFound); ok {
+				return installer.NewDownloadClusterISOHeadersNotFound().
+					WithPayload(common.GenerateError(http.StatusNotFound, errors.New("The image was not found")))
+			}
+			log.WithError(err).Errorf("Failed to stream ISO for cluster %s", cluster.ID.String())
+			return installer.NewDownloadClusterISOHeadersInternalServerError().
+				WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+		}
+		stream.Close()
+		return installer.NewDownloadClusterISOHeadersOK().WithContentLength(imgSize)
+	}
+
+	imgName := getImageName(&cluster)
 	exists, err := b.objectHandler.DoesObjectExist(ctx, imgName)
 	if err != nil {
 		log.WithError(err).Errorf("Failed to get ISO for cluster %s", cluster.ID.String())
@@ -789,7 +986,17 @@ func (b *bareMetalInventory) DownloadClusterISOHeaders(ctx context.Context, para
 
 func (b *bareMetalInventory) updateImageInfoPostUpload(ctx context.Context, cluster *common.Cluster, clusterProxyHash string, imageType models.ImageType, generated bool) error {
 	updates := map[string]interface{}{}
-	imgName := getImageName(*cluster.ID)
+	imgName := getImageName(cluster)
+	if imageType == models.ImageTypePxe {
+		imgName = getPXEArtifactName(*cluster.ID, s3wrapper.PXEInitrd)
+	} else if b.streamsImage(imageType) {
+		// A streamed ISO has the size of its base ISO
+		baseISOName, err := b.getBaseISOName(cluster, imageType)
+		if err != nil {
+			return errors.Wrap(err, "Failed to generate image: error getting base ISO")
+		}
+		imgName = baseISOName
+	}
 	imgSize, err := b.objectHandler.GetObjectSizeBytes(ctx, imgName)
 	if err != nil {
 		return errors.New("Failed to generate image: error fetching size")
@@ -800,8 +1007,13 @@ func (b *bareMetalInventory) updateImageInfoPostUpload(ctx context.Context, clus
 	// Presigned URL only works with AWS S3 because Scality is not exposed
 	if generated {
 		downloadURL := ""
-		if b.objectHandler.IsAwsS3() {
-			downloadURL, err = b.objectHandler.GeneratePresignedDownloadURL(ctx, imgName, imgName, b.Config.ImageExpirationTime)
+		if imageType == models.ImageTypePxe {
+			downloadURL, err = b.getPXEArtifactURL(ctx, *cluster.ID, getPXEArtifactName(*cluster.ID, s3wrapper.PXEIPXEScript), s3wrapper.PXEIPXEScript)
+			if err != nil {
+				return errors.Wrap(err, "Failed to generate image: error generating iPXE script URL")
+			}
+		} else if b.objectHandler.IsAwsS3() {
+			downloadURL, err = b.objectHandler.GeneratePresignedDownloadURL(ctx, imgName, getImageDownloadName(cluster), b.Config.ImageExpirationTime)
 			if err != nil {
 				return errors.New("Failed to generate image: error generating URL")
 			}
@@ -825,6 +1037,19 @@ func (b *bareMetalInventory) updateImageInfoPostUpload(ctx context.Context, clus
 		cluster.ImageGenerated = true
 	}
 
+	if generated {
+		updates["image_content_hash"] = cluster.ImageContentHash
+		updates["image_checksum"] = cluster.ImageInfo.Checksum
+		// A streamed image is not stored
+		storedBytes := imgSize
+		if b.streamsImage(imageType) {
+			storedBytes = 0
+		}
+		if err = b.quotaApi.Record(ctx, cluster, quota.DiscoveryImage, quota.KindImage, imgName, storedBytes); err != nil {
+			return err
+		}
+	}
+
 	if cluster.ProxyHash != clusterProxyHash {
 		updates["proxy_hash"] = clusterProxyHash
 		cluster.ProxyHash = clusterProxyHash
@@ -849,6 +1074,24 @@ func (b *bareMetalInventory) GenerateClusterISO(ctx context.Context, params inst
 	return installer.NewGenerateClusterISOCreated().WithPayload(&c.Cluster)
 }
 
+// generateStaticIPPoolConfig renders the static network config of the hosts of the static IP pool, keeping the addresses
+// that were allocated for previous images
+func (b *bareMetalInventory) generateStaticIPPoolConfig(db *gorm.DB, params installer.GenerateClusterISOParams) error {
+	allocations, err := common.GetStaticIPAllocations(db, params.ClusterID)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	staticNetworkConfig, allocations, err := b.staticNetworkConfig.GenerateStaticIPPoolConfig(params.ImageCreateParams.StaticIPPool, allocations)
+	if err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err = common.SetStaticIPAllocations(db, params.ClusterID, allocations); err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	params.ImageCreateParams.StaticNetworkConfig = staticNetworkConfig
+	return nil
+}
+
 func (b *bareMetalInventory) GenerateClusterISOInternal(ctx context.Context, params installer.GenerateClusterISOParams) (*common.Cluster, error) {
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("prepare image for cluster %s", params.ClusterID)
@@ -867,6 +1110,28 @@ func (b *bareMetalInventory) GenerateClusterISOInternal(ctx context.Context, par
 		}
 	}
 
+	if params.ImageCreateParams.StaticIPPool != nil {
+		if params.ImageCreateParams.StaticNetworkConfig != nil {
+			err := errors.New("Static IP pool cannot be set together with static network config")
+			log.Error(err)
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+		if err := b.staticNetworkConfig.ValidateStaticIPPool(params.ImageCreateParams.StaticIPPool); err != nil {
+			log.Error(err)
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
+	if err := validations.ValidateKernelArguments(params.ImageCreateParams.KernelArguments); err != nil {
+		log.Error(err)
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	kernelArguments, err := formatKernelArgumentsForDB(params.ImageCreateParams.KernelArguments)
+	if err != nil {
+		log.WithError(err).Error("failed to format kernel arguments")
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
 	// set the default value for REST API case, in case it was not provided in the request
 	if params.ImageCreateParams.ImageType == "" {
 		params.ImageCreateParams.ImageType = models.ImageType(b.Config.ISOImageType)
@@ -927,16 +1192,23 @@ func (b *bareMetalInventory) GenerateClusterISOInternal(ctx context.Context, par
 		return nil, common.NewApiError(http.StatusInternalServerError, errors.New(msg))
 	}
 
+	if params.ImageCreateParams.StaticIPPool != nil {
+		if err = b.generateStaticIPPoolConfig(tx, params); err != nil {
+			log.WithError(err).Errorf("failed to generate static network config from the static IP pool of cluster %s", params.ClusterID)
+			return nil, err
+		}
+	}
+
 	staticNetworkConfig := b.staticNetworkConfig.FormatStaticNetworkConfigForDB(params.ImageCreateParams.StaticNetworkConfig)
 
 	var imageExists bool
 	if cluster.ImageInfo.SSHPublicKey == params.ImageCreateParams.SSHPublicKey &&
 		cluster.ProxyHash == clusterProxyHash &&
 		cluster.ImageInfo.StaticNetworkConfig == staticNetworkConfig &&
+		cluster.ImageInfo.KernelArguments == kernelArguments &&
 		cluster.ImageGenerated &&
 		cluster.ImageInfo.Type == params.ImageCreateParams.ImageType {
-		imgName := getImageName(params.ClusterID)
-		imageExists, err = b.objectHandler.UpdateObjectTimestamp(ctx, imgName)
+		imageExists, err = b.updateImageTimestamp(ctx, cluster, params.ImageCreateParams.ImageType)
 		if err != nil {
 			log.WithError(err).Errorf("failed to contact storage backend")
 			b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError,
@@ -945,16 +1217,30 @@ func (b *bareMetalInventory) GenerateClusterISOInternal(ctx context.Context, par
 		}
 	}
 
+	imageStored := false
+	if !imageExists {
+		if err = b.quotaApi.ReserveObject(ctx, cluster, quota.DiscoveryImage, quota.KindImage, 0); err != nil {
+			return nil, err
+		}
+		defer func() {
+			if !imageStored {
+				b.releaseClusterObject(ctx, cluster, quota.DiscoveryImage)
+			}
+		}()
+	}
+
 	updates := map[string]interface{}{}
 	updates["image_ssh_public_key"] = params.ImageCreateParams.SSHPublicKey
 	updates["image_created_at"] = strfmt.DateTime(now)
 	updates["image_expires_at"] = strfmt.DateTime(now.Add(b.Config.ImageExpirationTime))
 	updates["image_static_network_config"] = staticNetworkConfig
+	updates["image_kernel_arguments"] = kernelArguments
 	if !imageExists {
 		// set image-generated indicator to false before the attempt to genearate the image in order to have an explicit
 		// state of the image creation based on the cluster parameters which will be committed to the DB
 		updates["image_generated"] = false
 		updates["image_download_url"] = ""
+		updates["image_checksum"] = ""
 	}
 	dbReply := tx.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Updates(updates)
 	if dbReply.Error != nil {
@@ -995,6 +1281,7 @@ func (b *bareMetalInventory) GenerateClusterISOInternal(ctx context.Context, par
 	if err != nil {
 		return nil, err
 	}
+	imageStored = true
 
 	return b.GetClusterInternal(ctx, installer.GetClusterParams{ClusterID: *cluster.ID})
 }
@@ -1012,31 +1299,29 @@ func (b *bareMetalInventory) createAndUploadNewImage(ctx context.Context, log lo
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	if err = b.objectHandler.Upload(ctx, []byte(ignitionConfig), fmt.Sprintf("%s/discovery.ign", cluster.ID)); err != nil {
+	if err = b.objectHandler.Upload(ctx, []byte(ignitionConfig), getDiscoveryIgnitionName(*cluster.ID)); err != nil {
 		log.WithError(err).Errorf("Upload discovery ignition failed for cluster %s", cluster.ID)
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	objectPrefix := fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, cluster.ID.String())
-
-	if params.ImageCreateParams.ImageType == models.ImageTypeMinimalIso {
-		if err := b.generateClusterMinimalISO(ctx, log, cluster, ignitionConfig, objectPrefix); err != nil {
-			log.WithError(err).Errorf("Failed to generate minimal ISO for cluster %s", cluster.ID)
-			b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError, "Failed to generate minimal ISO", time.Now())
-			return common.NewApiError(http.StatusInternalServerError, err)
-		}
-	} else {
-		baseISOName, err := b.objectHandler.GetBaseIsoObject(cluster.OpenshiftVersion)
-		if err != nil {
-			log.WithError(err).Errorf("Failed to get source object name for cluster %s with ocp version %s", cluster.ID, cluster.OpenshiftVersion)
+	cluster.ImageContentHash = ""
+	if params.ImageCreateParams.ImageType == models.ImageTypePxe {
+		if err := b.generateClusterPXEArtifacts(ctx, log, cluster, ignitionConfig); err != nil {
+			log.WithError(err).Errorf("Failed to generate network boot artifacts for cluster %s", cluster.ID)
+			b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError, "Failed to generate network boot artifacts", time.Now())
 			return common.NewApiError(http.StatusInternalServerError, err)
 		}
-
-		if err := b.objectHandler.UploadISO(ctx, ignitionConfig, baseISOName, objectPrefix); err != nil {
-			log.WithError(err).Errorf("Upload ISO failed for cluster %s", cluster.ID)
-			b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError, "Failed to upload image", time.Now())
+	} else if b.streamsImage(params.ImageCreateParams.ImageType) {
+		// The ISO is generated from the uploaded discovery ignition and RAM disk when it is downloaded, its checksum
+		// is computed when its signature is first downloaded
+		if err := b.uploadDiscoveryRAMDisk(ctx, cluster); err != nil {
+			log.WithError(err).Errorf("Upload discovery RAM disk failed for cluster %s", cluster.ID)
 			return common.NewApiError(http.StatusInternalServerError, err)
 		}
+		log.Infof("Image of cluster %s will be streamed from its base ISO", cluster.ID)
+		cluster.ImageInfo.Checksum = ""
+	} else if err := b.generateCachedClusterISO(ctx, log, cluster, ignitionConfig); err != nil {
+		return err
 	}
 
 	if err := b.updateImageInfoPostUpload(ctx, cluster, clusterProxyHash, params.ImageCreateParams.ImageType, true); err != nil {
@@ -1071,19 +1356,85 @@ func (b *bareMetalInventory) getIgnitionConfigForLogging(cluster *common.Cluster
 	return msg
 }
 
-func (b *bareMetalInventory) generateClusterMinimalISO(ctx context.Context, log logrus.FieldLogger,
-	cluster *common.Cluster, ignitionConfig, objectPrefix string) error {
-
-	baseISOName, err := b.objectHandler.GetMinimalIsoObjectName(cluster.OpenshiftVersion)
+// generateCachedClusterISO generates the ISO of the cluster unless an identical ISO is already cached, in which case
+// the cluster references it instead
+func (b *bareMetalInventory) generateCachedClusterISO(ctx context.Context, log logrus.FieldLogger, cluster *common.Cluster, ignitionConfig string) error {
+	imageType := cluster.ImageInfo.Type
+	ramDisk := ""
+	if imageType == models.ImageTypeMinimalIso {
+		// The minimal ISO RAM disk holds the static network config and the proxy settings
+		ramDisk = strings.Join([]string{cluster.ImageInfo.StaticNetworkConfig, cluster.HTTPProxy, cluster.HTTPSProxy, cluster.NoProxy}, "\n")
+	}
+	baseISOName, err := b.getBaseISOName(cluster, imageType)
 	if err != nil {
 		log.WithError(err).Errorf("Failed to get source object name for cluster %s with ocp version %s", cluster.ID, cluster.OpenshiftVersion)
-		return err
+		if imageType == models.ImageTypeMinimalIso {
+			b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError, "Failed to generate minimal ISO", time.Now())
+		}
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	kernelArguments, err := getKernelArguments(cluster)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to parse kernel arguments of cluster %s", cluster.ID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	cluster.ImageContentHash = computeImageContentHash(baseISOName, imageType, ignitionConfig, ramDisk, cluster.ImageInfo.KernelArguments)
+	objectPrefix := fmt.Sprintf(s3wrapper.DiscoveryImageCacheTemplate, cluster.ImageContentHash)
+	// Refreshing the timestamp of the cached image also keeps it from expiring while the cluster uses it
+	cached, err := b.objectHandler.UpdateObjectTimestamp(ctx, getImageName(cluster))
+	if err != nil {
+		log.WithError(err).Errorf("Failed to look up cached image %s for cluster %s", objectPrefix, cluster.ID)
+		b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError, "Failed to generate image: error contacting storage backend", time.Now())
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	b.metricApi.DiscoveryImageCacheLookup(imageType, cached)
+	checksum := ""
+	if cached {
+		log.Infof("Re-using cached image %s for cluster %s", objectPrefix, cluster.ID)
+	} else if imageType == models.ImageTypeMinimalIso {
+		if checksum, err = b.generateClusterMinimalISO(ctx, log, cluster, ignitionConfig, baseISOName, objectPrefix, kernelArguments); err != nil {
+			log.WithError(err).Errorf("Failed to generate minimal ISO for cluster %s", cluster.ID)
+			b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError, "Failed to generate minimal ISO", time.Now())
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	} else if isDiskImage(imageType) || len(kernelArguments) > 0 {
+		// Uploading the ISO only embeds the ignition config, so the ISO editor embeds the kernel arguments
+		if checksum, err = b.generateClusterImageFromFullISO(ctx, log, cluster, ignitionConfig, baseISOName, kernelArguments); err != nil {
+			log.WithError(err).Errorf("Failed to generate %s image for cluster %s", imageType, cluster.ID)
+			msg := "Failed to upload image"
+			if isDiskImage(imageType) {
+				msg = "Failed to generate disk image"
+			}
+			b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError, msg, time.Now())
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	} else if err = b.objectHandler.UploadISO(ctx, ignitionConfig, baseISOName, objectPrefix); err != nil {
+		log.WithError(err).Errorf("Upload ISO failed for cluster %s", cluster.ID)
+		b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError, "Failed to upload image", time.Now())
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	// Cached images have their checksum stored next to them, and uploaded ISOs are read back to compute it
+	if checksum == "" {
+		if checksum, err = s3wrapper.GetChecksum(ctx, log, b.objectHandler, getImageName(cluster), false); err != nil {
+			log.WithError(err).Errorf("Failed to get the checksum of image %s for cluster %s", getImageName(cluster), cluster.ID)
+			b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError, "Failed to compute image checksum", time.Now())
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
 	}
+	cluster.ImageInfo.Checksum = checksum
+	return nil
+}
 
+// generateClusterMinimalISO uploads the minimal ISO of the cluster and returns its checksum
+func (b *bareMetalInventory) generateClusterMinimalISO(ctx context.Context, log logrus.FieldLogger,
+	cluster *common.Cluster, ignitionConfig, baseISOName, objectPrefix string, kernelArguments models.KernelArguments) (string, error) {
 	isoPath, err := s3wrapper.GetFile(ctx, b.objectHandler, baseISOName, b.ISOCacheDir, true)
 	if err != nil {
 		log.WithError(err).Errorf("Failed to download minimal ISO template %s", baseISOName)
-		return err
+		return "", err
 	}
 
 	var clusterISOPath string
@@ -1095,3182 +1446,4638 @@ func (b *bareMetalInventory) generateClusterMinimalISO(ctx context.Context, log
 			HTTPSProxy: cluster.HTTPSProxy,
 			NoProxy:    cluster.NoProxy,
 		}
-		clusterISOPath, createError = editor.CreateClusterMinimalISO(ignitionConfig, cluster.ImageInfo.StaticNetworkConfig, &clusterProxyInfo)
+		clusterISOPath, createError = editor.CreateClusterMinimalISO(ignitionConfig, cluster.ImageInfo.StaticNetworkConfig, &clusterProxyInfo, kernelArguments)
 		return createError
 	})
 
 	if err != nil {
 		log.WithError(err).Errorf("Failed to create minimal discovery ISO cluster %s with iso file %s", cluster.ID, isoPath)
-		return err
+		return "", err
 	}
 
 	log.Infof("Uploading minimal ISO for cluster %s", cluster.ID)
-	if err := b.objectHandler.UploadFile(ctx, clusterISOPath, fmt.Sprintf("%s.iso", objectPrefix)); err != nil {
+	checksum, err := b.uploadImageFile(ctx, clusterISOPath, fmt.Sprintf("%s.iso", objectPrefix))
+	if err != nil {
 		os.Remove(clusterISOPath)
 		log.WithError(err).Errorf("Failed to upload minimal discovery ISO for cluster %s", cluster.ID)
-		return err
+		return "", err
 	}
-	return os.Remove(clusterISOPath)
-}
-
-func getImageName(clusterID strfmt.UUID) string {
-	return fmt.Sprintf("%s.iso", fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, clusterID.String()))
+	return checksum, os.Remove(clusterISOPath)
 }
 
-func (b *bareMetalInventory) refreshAllHosts(ctx context.Context, cluster *common.Cluster) error {
-	err := b.setMajorityGroupForCluster(cluster.ID, b.db)
+// generateClusterImageFromFullISO uploads an image of the cluster generated from the full ISO by the ISO editor, which
+// is either a disk image in the format of the image type or a full ISO with kernel arguments, and returns its checksum
+func (b *bareMetalInventory) generateClusterImageFromFullISO(ctx context.Context, log logrus.FieldLogger,
+	cluster *common.Cluster, ignitionConfig, baseISOName string, kernelArguments models.KernelArguments) (string, error) {
+	isoPath, err := s3wrapper.GetFile(ctx, b.objectHandler, baseISOName, b.ISOCacheDir, true)
 	if err != nil {
-		return err
+		log.WithError(err).Errorf("Failed to download base ISO %s", baseISOName)
+		return "", err
 	}
-	for _, chost := range cluster.Hosts {
-		if swag.StringValue(chost.Status) != models.HostStatusKnown && swag.StringValue(chost.Kind) == models.HostKindHost {
-			return common.NewApiError(http.StatusBadRequest, errors.Errorf("Host %s is in status %s and not ready for install",
-				hostutil.GetHostnameForMsg(chost), swag.StringValue(chost.Status)))
-		}
 
-		err := b.hostApi.RefreshStatus(ctx, chost, b.db)
-		if err != nil {
-			return err
+	imageType := cluster.ImageInfo.Type
+	var imagePath string
+	err = b.isoEditorFactory.WithEditor(ctx, isoPath, log, func(editor isoeditor.Editor) error {
+		log.Infof("Creating %s image for cluster %s", imageType, cluster.ID)
+		var createError error
+		switch imageType {
+		case models.ImageTypeRawDisk:
+			imagePath, createError = editor.CreateClusterDiskImage(ignitionConfig, kernelArguments, isoeditor.DiskImageFormatRaw)
+		case models.ImageTypeQcow2:
+			imagePath, createError = editor.CreateClusterDiskImage(ignitionConfig, kernelArguments, isoeditor.DiskImageFormatQcow2)
+		default:
+			imagePath, createError = editor.CreateClusterFullISO(ignitionConfig, kernelArguments)
 		}
+		return createError
+	})
+	if err != nil {
+		log.WithError(err).Errorf("Failed to create %s image for cluster %s with iso file %s", imageType, cluster.ID, isoPath)
+		return "", err
 	}
-	return nil
-}
+	defer os.Remove(imagePath)
 
-func (b *bareMetalInventory) storeOpenshiftClusterID(ctx context.Context, clusterID string) (string, error) {
-	log := logutil.FromContext(ctx, b.log)
-	log.Debug("Downloading bootstrap ignition file")
-	reader, _, err := b.objectHandler.Download(ctx, fmt.Sprintf("%s/%s", clusterID, "bootstrap.ign"))
+	log.Infof("Uploading %s image for cluster %s", imageType, cluster.ID)
+	checksum, err := b.uploadImageFile(ctx, imagePath, getImageName(cluster))
 	if err != nil {
-		log.WithError(err).Error("Failed downloading bootstrap ignition file")
+		log.WithError(err).Errorf("Failed to upload %s image for cluster %s", imageType, cluster.ID)
 		return "", err
 	}
+	return checksum, nil
+}
 
-	var openshiftClusterID string
-	log.Debug("Extracting Openshift cluster ID from ignition file")
-	openshiftClusterID, err = ignition.ExtractClusterID(reader)
+// uploadImageFile uploads a generated image together with its checksum, and returns the checksum
+func (b *bareMetalInventory) uploadImageFile(ctx context.Context, imagePath, objectName string) (string, error) {
+	checksum, err := s3wrapper.FileSHA256Sum(imagePath)
 	if err != nil {
-		log.WithError(err).Error("Failed extracting Openshift cluster ID from ignition file")
 		return "", err
 	}
-	log.Debugf("Got OpenShift cluster ID of %s", openshiftClusterID)
-
-	log.Debugf("Storing Openshift cluster ID of cluster %s to DB", clusterID)
-	if err = b.db.Model(&common.Cluster{}).Where("id = ?", clusterID).Update(
-		"openshift_cluster_id", openshiftClusterID).Error; err != nil {
-		log.WithError(err).Errorf("Failed storing Openshift cluster ID of cluster %s to DB", clusterID)
+	if err = b.objectHandler.UploadFile(ctx, imagePath, objectName); err != nil {
 		return "", err
 	}
-
-	return openshiftClusterID, nil
+	if err = s3wrapper.UploadChecksum(ctx, b.objectHandler, objectName, checksum, false); err != nil {
+		return "", err
+	}
+	return checksum, nil
 }
 
-func (b *bareMetalInventory) InstallCluster(ctx context.Context, params installer.InstallClusterParams) middleware.Responder {
-	c, err := b.InstallClusterInternal(ctx, params)
+// releaseClusterImage stops the cluster from referencing its ISO and from being charged for it, and deletes the ISO
+// unless another cluster references it. Returns whether an image was deleted.
+func (b *bareMetalInventory) releaseClusterImage(ctx context.Context, cluster *common.Cluster) (bool, error) {
+	existed, err := b.deleteClusterImage(ctx, cluster)
 	if err != nil {
-		return common.GenerateErrorResponder(err)
+		return false, err
 	}
-	return installer.NewInstallClusterAccepted().WithPayload(&c.Cluster)
+	b.releaseClusterObject(ctx, cluster, quota.DiscoveryImage)
+	return existed, nil
 }
 
-func (b *bareMetalInventory) integrateWithAMSClusterPreInstallation(ctx context.Context, amsSubscriptionID, openshiftClusterID strfmt.UUID) error {
-	log := logutil.FromContext(ctx, b.log)
-	log.Infof("Updating AMS subscription %s with openshift cluster ID %s", amsSubscriptionID, openshiftClusterID)
-	if err := b.ocmClient.AccountsMgmt.UpdateSubscriptionOpenshiftClusterID(ctx, amsSubscriptionID, openshiftClusterID); err != nil {
-		log.WithError(err).Errorf("Failed to update AMS subscription with openshift cluster ID %s", openshiftClusterID)
-		return err
+func (b *bareMetalInventory) deleteClusterImage(ctx context.Context, cluster *common.Cluster) (bool, error) {
+	imgName := getImageName(cluster)
+	if cluster.ImageInfo != nil && b.streamsImage(cluster.ImageInfo.Type) {
+		// Streamed images are not stored, they are only marked to be generated again
+		if err := b.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Update("image_generated", false).Error; err != nil {
+			return false, err
+		}
+		return cluster.ImageGenerated, nil
 	}
-	return nil
-}
-
-func (b *bareMetalInventory) InstallClusterInternal(ctx context.Context, params installer.InstallClusterParams) (*common.Cluster, error) {
-	log := logutil.FromContext(ctx, b.log)
-	cluster := &common.Cluster{}
-	var err error
-
-	log.Infof("preparing for cluster %s installation", params.ClusterID)
-	if cluster, err = common.GetClusterFromDBWithoutDisabledHosts(b.db, params.ClusterID); err != nil {
-		return nil, common.NewApiError(http.StatusNotFound, err)
+	if cluster.ImageContentHash == "" {
+		return b.objectHandler.DeleteObject(ctx, imgName)
 	}
-	// auto select hosts roles if not selected yet.
-	err = b.db.Transaction(func(tx *gorm.DB) error {
-		for i := range cluster.Hosts {
-			if err = b.hostApi.AutoAssignRole(ctx, cluster.Hosts[i], tx); err != nil {
-				return err
-			}
-		}
-		return nil
-	})
+	if err := b.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Update("image_content_hash", "").Error; err != nil {
+		return false, err
+	}
+	references, err := common.CountImageReferences(b.db, cluster.ImageContentHash)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
-
-	if err = b.refreshAllHosts(ctx, cluster); err != nil {
-		return nil, err
+	if references > 0 {
+		logutil.FromContext(ctx, b.log).Infof("Keeping image %s since %d other clusters reference it", imgName, references)
+		return false, nil
 	}
-	if _, err = b.clusterApi.RefreshStatus(ctx, cluster, b.db); err != nil {
-		return nil, err
+	if _, err = b.objectHandler.DeleteObject(ctx, s3wrapper.ChecksumObjectName(imgName)); err != nil {
+		return false, err
 	}
+	return b.objectHandler.DeleteObject(ctx, imgName)
+}
 
-	// Reload again after refresh
-	if cluster, err = common.GetClusterFromDBWithoutDisabledHosts(b.db, params.ClusterID); err != nil {
-		return nil, common.NewApiError(http.StatusNotFound, err)
+// getImageName returns the name of the image object of the cluster, which is shared by the clusters with identical images
+func getImageName(cluster *common.Cluster) string {
+	imageType := getClusterImageType(cluster)
+	if cluster.ImageContentHash != "" {
+		return fmt.Sprintf("%s.%s", fmt.Sprintf(s3wrapper.DiscoveryImageCacheTemplate, cluster.ImageContentHash), getImageExtension(imageType))
 	}
-	// Verify cluster is ready to install
-	if ok, reason := b.clusterApi.IsReadyForInstallation(cluster); !ok {
-		return nil, common.NewApiError(http.StatusConflict,
-			errors.Errorf("Cluster is not ready for installation, %s", reason))
+	return getImageFileName(*cluster.ID, imageType)
+}
+
+// getImageFileName returns the name of the image of the cluster when downloaded
+func getImageFileName(clusterID strfmt.UUID, imageType models.ImageType) string {
+	return fmt.Sprintf("%s.%s", fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, clusterID.String()), getImageExtension(imageType))
+}
+
+func getClusterImageType(cluster *common.Cluster) models.ImageType {
+	if cluster.ImageInfo == nil {
+		return ""
 	}
+	return cluster.ImageInfo.Type
+}
 
-	// prepare cluster and hosts for installation
-	err = b.db.Transaction(func(tx *gorm.DB) error {
-		// in case host monitor already updated the state we need to use FOR UPDATE option
-		tx = transaction.AddForUpdateQueryOption(tx)
+// getImageDownloadName returns the file name the image of the cluster is downloaded as, whether it is stored, streamed or
+// downloaded from a presigned URL. Its extension is the one of the image type.
+func getImageDownloadName(cluster *common.Cluster) string {
+	return fmt.Sprintf("cluster-%s-discovery.%s", cluster.ID.String(), getImageExtension(getClusterImageType(cluster)))
+}
 
-		if err = b.clusterApi.PrepareForInstallation(ctx, cluster, tx); err != nil {
-			return err
-		}
+// getImageExtension returns the file extension of images of the given type
+func getImageExtension(imageType models.ImageType) string {
+	switch imageType {
+	case models.ImageTypeRawDisk:
+		return "img"
+	case models.ImageTypeQcow2:
+		return "qcow2"
+	default:
+		return "iso"
+	}
+}
 
-		if err = b.setBootstrapHost(ctx, *cluster, tx); err != nil {
-			return err
-		}
+// getImageContentType returns the media type of images of the given type
+func getImageContentType(imageType models.ImageType) string {
+	switch imageType {
+	case models.ImageTypeRawDisk:
+		return "application/octet-stream"
+	case models.ImageTypeQcow2:
+		return "application/x-qemu-disk"
+	default:
+		return "application/x-iso9660-image"
+	}
+}
+
+// isDiskImage returns whether images of the given type are written to a disk rather than booted from a CD
+func isDiskImage(imageType models.ImageType) bool {
+	return imageType == models.ImageTypeRawDisk || imageType == models.ImageTypeQcow2
+}
+
+// getDiscoveryIgnitionName returns the name of the discovery ignition object of the cluster
+func getDiscoveryIgnitionName(clusterID strfmt.UUID) string {
+	return fmt.Sprintf("%s/discovery.ign", clusterID)
+}
+
+// getDiscoveryRAMDiskName returns the name of the custom RAM disk object a streamed minimal ISO of the cluster is
+// generated with
+func getDiscoveryRAMDiskName(clusterID strfmt.UUID) string {
+	return fmt.Sprintf("%s/discovery-ramdisk.img", clusterID)
+}
+
+// uploadDiscoveryRAMDisk uploads the custom RAM disk of a streamed minimal ISO, so that the ISO keeps the proxy and
+// static network settings of the time its image was generated, like its discovery ignition
+func (b *bareMetalInventory) uploadDiscoveryRAMDisk(ctx context.Context, cluster *common.Cluster) error {
+	if cluster.ImageInfo.Type != models.ImageTypeMinimalIso {
 		return nil
-	})
+	}
+	clusterProxyInfo := isoeditor.ClusterProxyInfo{
+		HTTPProxy:  cluster.HTTPProxy,
+		HTTPSProxy: cluster.HTTPSProxy,
+		NoProxy:    cluster.NoProxy,
+	}
+	ramDisk, err := isoeditor.RAMDiskImageArchive(b.staticNetworkConfig, cluster.ImageInfo.StaticNetworkConfig, &clusterProxyInfo)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	return b.objectHandler.Upload(ctx, ramDisk, getDiscoveryRAMDiskName(*cluster.ID))
+}
 
-	if cluster, err = common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading); err != nil {
-		return nil, err
-	}
+// streamsImage returns whether images of the given type are generated while they are downloaded rather than stored.
+// Raw disk images are streamed as well, since they have the same content as the full ISO.
+func (b *bareMetalInventory) streamsImage(imageType models.ImageType) bool {
+	return b.Config.StreamDiscoveryISOs && imageType != models.ImageTypePxe && imageType != models.ImageTypeQcow2
+}
 
-	if err = b.clusterApi.GenerateAdditionalManifests(ctx, cluster); err != nil {
-		b.log.WithError(err).Errorf("Failed to generated additional cluster manifest")
-		return nil, common.NewApiError(http.StatusInternalServerError, errors.New("Failed to generated additional cluster manifest"))
+// getBaseISOName returns the name of the ISO object the image of the cluster is generated from
+func (b *bareMetalInventory) getBaseISOName(cluster *common.Cluster, imageType models.ImageType) (string, error) {
+	if imageType == models.ImageTypeMinimalIso {
+		return b.objectHandler.GetMinimalIsoObjectName(cluster.OpenshiftVersion)
 	}
+	return b.objectHandler.GetBaseIsoObject(cluster.OpenshiftVersion)
+}
 
-	// Delete previews installation log files from object storage (if exist).
-	if err := b.clusterApi.DeleteClusterLogs(ctx, cluster, b.objectHandler); err != nil {
-		log.WithError(err).Warnf("Failed deleting s3 logs of cluster %s", cluster.ID.String())
+// openClusterISOStream opens the ISO of the cluster generated from its base ISO, the discovery ignition uploaded
+// when the image was generated and, for minimal ISOs, the custom RAM disk uploaded along with it
+func (b *bareMetalInventory) openClusterISOStream(ctx context.Context, cluster *common.Cluster) (s3wrapper.ReadSeekCloser, int64, error) {
+	if !cluster.ImageGenerated {
+		return nil, 0, common.NotFound(getImageFileName(*cluster.ID, cluster.ImageInfo.Type))
 	}
+	return b.newClusterISOStream(ctx, cluster)
+}
 
-	go func() {
-		var err error
-		asyncCtx := requestid.ToContext(context.Background(), requestid.FromContext(ctx))
+// computeStreamedImageChecksum computes the checksum of the content the image of the cluster is streamed with and
+// stores it, unless the image was generated again in the meantime
+func (b *bareMetalInventory) computeStreamedImageChecksum(ctx context.Context, cluster *common.Cluster) (string, error) {
+	reader, _, err := b.newClusterISOStream(ctx, cluster)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	checksum, err := s3wrapper.SHA256Sum(reader)
+	if err != nil {
+		return "", err
+	}
+	err = b.db.Model(&common.Cluster{}).
+		Where("id = ? and image_created_at = ? and image_checksum = ''", cluster.ID.String(), cluster.ImageInfo.CreatedAt).
+		Update("image_checksum", checksum).Error
+	return checksum, err
+}
 
-		defer func() {
-			if err != nil {
-				log.WithError(err).Warn("Cluster installation initialization failed")
-				b.clusterApi.HandlePreInstallError(asyncCtx, cluster, err)
-			} else {
-				b.clusterApi.HandlePreInstallSuccess(asyncCtx, cluster)
-			}
-		}()
+// newClusterISOStream opens the ISO of the cluster, even before its image is marked as generated
+func (b *bareMetalInventory) newClusterISOStream(ctx context.Context, cluster *common.Cluster) (s3wrapper.ReadSeekCloser, int64, error) {
+	ignitionConfig, err := b.downloadObject(ctx, getDiscoveryIgnitionName(*cluster.ID))
+	if err != nil {
+		return nil, 0, err
+	}
 
-		if err = b.generateClusterInstallConfig(asyncCtx, *cluster); err != nil {
-			return
+	var ramDisk []byte
+	if cluster.ImageInfo.Type == models.ImageTypeMinimalIso {
+		if ramDisk, err = b.downloadObject(ctx, getDiscoveryRAMDiskName(*cluster.ID)); err != nil {
+			return nil, 0, err
 		}
-		log.Infof("generated ignition for cluster %s", cluster.ID.String())
+	}
 
-		log.Infof("Storing OpenShift cluster ID of cluster %s to DB", cluster.ID.String())
-		var openshiftClusterID string
-		if openshiftClusterID, err = b.storeOpenshiftClusterID(ctx, cluster.ID.String()); err != nil {
-			return
-		}
+	kernelArguments, err := getKernelArguments(cluster)
+	if err != nil {
+		return nil, 0, err
+	}
 
-		if b.ocmClient != nil && b.ocmClient.Config.WithAMSSubscriptions {
-			if err = b.integrateWithAMSClusterPreInstallation(asyncCtx, cluster.AmsSubscriptionID, strfmt.UUID(openshiftClusterID)); err != nil {
-				log.WithError(err).Errorf("Cluster %s failed to integrate with AMS on cluster pre installation", params.ClusterID)
-				return
-			}
-		}
-	}()
+	baseISOName, err := b.getBaseISOName(cluster, cluster.ImageInfo.Type)
+	if err != nil {
+		return nil, 0, err
+	}
+	return b.objectHandler.StreamISO(ctx, string(ignitionConfig), ramDisk, kernelArguments, baseISOName)
+}
 
-	log.Infof("Successfully prepared cluster <%s> for installation", params.ClusterID.String())
-	return cluster, nil
+// downloadObject returns the content of the given object
+func (b *bareMetalInventory) downloadObject(ctx context.Context, objectName string) ([]byte, error) {
+	reader, _, err := b.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
 }
 
-func (b *bareMetalInventory) InstallSingleDay2HostInternal(ctx context.Context, clusterId strfmt.UUID, hostId strfmt.UUID) error {
+// computeImageContentHash keys a generated ISO by everything its content is derived from
+func computeImageContentHash(baseISOName string, imageType models.ImageType, ignitionConfig, ramDisk, kernelArguments string) string {
+	h := sha256.New()
+	for _, part := range []string{baseISOName, string(imageType), ignitionConfig, ramDisk, kernelArguments} {
+		// Separate the parts so that their boundaries are part of the hash
+		_, _ = h.Write([]byte(part))
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
 
-	log := logutil.FromContext(ctx, b.log)
-	var err error
-	var cluster *common.Cluster
-	var h *common.Host
+// formatKernelArgumentsForDB returns the kernel argument operations in the JSON format they are stored in with the image info
+func formatKernelArgumentsForDB(kernelArguments models.KernelArguments) (string, error) {
+	if len(kernelArguments) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(kernelArguments)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
 
-	if cluster, err = common.GetClusterFromDB(b.db, clusterId, common.UseEagerLoading); err != nil {
-		return err
+// getKernelArguments returns the kernel argument operations applied to the image of the cluster
+func getKernelArguments(cluster *common.Cluster) (models.KernelArguments, error) {
+	if cluster.ImageInfo == nil || cluster.ImageInfo.KernelArguments == "" {
+		return nil, nil
 	}
-	if h, err = b.getHost(ctx, clusterId.String(), hostId.String()); err != nil {
-		return err
+	var kernelArguments models.KernelArguments
+	if err := json.Unmarshal([]byte(cluster.ImageInfo.KernelArguments), &kernelArguments); err != nil {
+		return nil, errors.Wrap(err, "failed to parse kernel arguments")
 	}
-	// auto select host roles if not selected yet.
-	err = b.db.Transaction(func(tx *gorm.DB) error {
-		if err = b.hostApi.AutoAssignRole(ctx, &h.Host, tx); err != nil {
-			return err
+	return kernelArguments, nil
+}
+
+func getPXEArtifactName(clusterID strfmt.UUID, artifact string) string {
+	return s3wrapper.PXEArtifactObjectName(fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, clusterID.String()), artifact)
+}
+
+// updateImageTimestamp refreshes the timestamps of the objects of the cluster image, returns false if any of them is missing
+func (b *bareMetalInventory) updateImageTimestamp(ctx context.Context, cluster *common.Cluster, imageType models.ImageType) (bool, error) {
+	objectNames := []string{getImageName(cluster)}
+	if imageType == models.ImageTypePxe {
+		objectNames = []string{getPXEArtifactName(*cluster.ID, s3wrapper.PXEInitrd), getPXEArtifactName(*cluster.ID, s3wrapper.PXEIPXEScript)}
+	} else if b.streamsImage(imageType) {
+		objectNames = []string{getDiscoveryIgnitionName(*cluster.ID)}
+		if imageType == models.ImageTypeMinimalIso {
+			objectNames = append(objectNames, getDiscoveryRAMDiskName(*cluster.ID))
 		}
-		return nil
-	})
+	}
+	for _, objectName := range objectNames {
+		exists, err := b.objectHandler.UpdateObjectTimestamp(ctx, objectName)
+		if err != nil || !exists {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// generateClusterPXEArtifacts uploads the cluster initrd, which is the base initrd with the discovery ignition and the custom
+// RAM disk appended, and the iPXE script that boots it together with the kernel and rootfs of the base ISO
+func (b *bareMetalInventory) generateClusterPXEArtifacts(ctx context.Context, log logrus.FieldLogger,
+	cluster *common.Cluster, ignitionConfig string) error {
+
+	baseISOName, err := b.objectHandler.GetBaseIsoObject(cluster.OpenshiftVersion)
 	if err != nil {
+		log.WithError(err).Errorf("Failed to get source object name for cluster %s with ocp version %s", cluster.ID, cluster.OpenshiftVersion)
 		return err
 	}
 
-	if err = b.setMajorityGroupForCluster(cluster.ID, b.db); err != nil {
+	if err = s3wrapper.UploadPXEArtifacts(ctx, log, b.objectHandler, b.isoEditorFactory, baseISOName, b.ISOCacheDir); err != nil {
+		log.WithError(err).Errorf("Failed to upload network boot artifacts of base ISO %s", baseISOName)
 		return err
 	}
-	if err = b.hostApi.RefreshStatus(ctx, &h.Host, b.db); err != nil {
+
+	baseInitrdPath, err := s3wrapper.GetFile(ctx, b.objectHandler, s3wrapper.PXEArtifactObjectName(baseISOName, s3wrapper.PXEInitrd), b.ISOCacheDir, false)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to download base initrd of ISO %s", baseISOName)
 		return err
 	}
 
-	txSuccess := false
-	tx := b.db.Begin()
-	defer func() {
-		if !txSuccess {
-			log.Error("InstallSingleDay2HostInternal failed")
-			tx.Rollback()
-		}
-		if r := recover(); r != nil {
-			log.Error("InstallSingleDay2HostInternal failed")
-			tx.Rollback()
-		}
-	}()
-
-	// in case host monitor already updated the state we need to use FOR UPDATE option
-	tx = transaction.AddForUpdateQueryOption(tx)
-
-	if cluster, err = common.GetClusterFromDB(tx, clusterId, common.UseEagerLoading); err != nil {
+	ignitionArchive, err := isoeditor.IgnitionImageArchive(ignitionConfig)
+	if err != nil {
+		return err
+	}
+	clusterProxyInfo := isoeditor.ClusterProxyInfo{
+		HTTPProxy:  cluster.HTTPProxy,
+		HTTPSProxy: cluster.HTTPSProxy,
+		NoProxy:    cluster.NoProxy,
+	}
+	ramDiskArchive, err := isoeditor.RAMDiskImageArchive(b.staticNetworkConfig, cluster.ImageInfo.StaticNetworkConfig, &clusterProxyInfo)
+	if err != nil {
 		return err
 	}
 
-	// move host to installing
-	err = b.createAndUploadNodeIgnition(ctx, cluster, &h.Host)
+	baseInitrd, err := os.Open(baseInitrdPath)
 	if err != nil {
-		log.Errorf("Failed to upload ignition for host %s", h.RequestedHostname)
 		return err
 	}
-	if installErr := b.hostApi.Install(ctx, &h.Host, tx); installErr != nil {
-		log.Errorf("Failed to move host %s to installing", h.RequestedHostname)
-		return installErr
+	defer baseInitrd.Close()
+
+	log.Infof("Uploading network boot artifacts for cluster %s", cluster.ID)
+	initrd := io.MultiReader(baseInitrd, bytes.NewReader(ignitionArchive), bytes.NewReader(ramDiskArchive))
+	if err = b.objectHandler.UploadStream(ctx, initrd, getPXEArtifactName(*cluster.ID, s3wrapper.PXEInitrd)); err != nil {
+		log.WithError(err).Errorf("Failed to upload initrd for cluster %s", cluster.ID)
+		return err
 	}
 
-	err = tx.Commit().Error
+	kernelArguments, err := getKernelArguments(cluster)
 	if err != nil {
-		log.Error(err)
 		return err
 	}
-	txSuccess = true
-
-	return nil
+	script, err := b.formatIPXEScript(ctx, *cluster.ID, baseISOName, kernelArguments)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to format iPXE script for cluster %s", cluster.ID)
+		return err
+	}
+	return b.objectHandler.Upload(ctx, []byte(script), getPXEArtifactName(*cluster.ID, s3wrapper.PXEIPXEScript))
 }
 
-func (b *bareMetalInventory) InstallHosts(ctx context.Context, params installer.InstallHostsParams) middleware.Responder {
-	log := logutil.FromContext(ctx, b.log)
-	cluster := &common.Cluster{}
-	var err error
+const ipxeScriptFormat = `#!ipxe
+initrd --name initrd {{.InitrdURL}}
+kernel {{.KernelURL}} {{.KernelArguments}}
+boot
+`
 
-	if cluster, err = common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading); err != nil {
-		return common.GenerateErrorResponder(err)
+func (b *bareMetalInventory) formatIPXEScript(ctx context.Context, clusterID strfmt.UUID, baseISOName string, kernelArguments models.KernelArguments) (string, error) {
+	kernelURL, err := b.getPXEArtifactURL(ctx, clusterID, s3wrapper.PXEArtifactObjectName(baseISOName, s3wrapper.PXEKernel), s3wrapper.PXEKernel)
+	if err != nil {
+		return "", err
+	}
+	initrdURL, err := b.getPXEArtifactURL(ctx, clusterID, getPXEArtifactName(clusterID, s3wrapper.PXEInitrd), s3wrapper.PXEInitrd)
+	if err != nil {
+		return "", err
+	}
+	rootFSURL, err := b.getPXEArtifactURL(ctx, clusterID, s3wrapper.PXEArtifactObjectName(baseISOName, s3wrapper.PXERootFS), s3wrapper.PXERootFS)
+	if err != nil {
+		return "", err
 	}
 
-	// auto select hosts roles if not selected yet.
-	err = b.db.Transaction(func(tx *gorm.DB) error {
-		for i := range cluster.Hosts {
-			if swag.StringValue(cluster.Hosts[i].Status) != models.HostStatusKnown {
-				continue
-			}
-			if err = b.hostApi.AutoAssignRole(ctx, cluster.Hosts[i], tx); err != nil {
-				return err
-			}
-		}
-		return nil
-	})
-
+	tmpl, err := template.New("ipxeScript").Parse(ipxeScriptFormat)
 	if err != nil {
-		return common.GenerateErrorResponder(err)
+		return "", err
+	}
+	buf := &bytes.Buffer{}
+	args := isoeditor.ApplyKernelArguments([]string{"initrd=initrd", "coreos.live.rootfs_url=" + rootFSURL, "ignition.firstboot",
+		"ignition.platform.id=metal", "random.trust_cpu=on"}, kernelArguments)
+	if err = tmpl.Execute(buf, map[string]string{"KernelURL": kernelURL, "InitrdURL": initrdURL, "KernelArguments": strings.Join(args, " ")}); err != nil {
+		return "", err
 	}
+	return buf.String(), nil
+}
 
-	if err = b.refreshAllHosts(ctx, cluster); err != nil {
-		return common.GenerateErrorResponder(err)
+// getPXEArtifactURL returns an authenticated URL of a network boot artifact, which is presigned with AWS S3 and otherwise
+// points to the pxe-artifacts download endpoint
+func (b *bareMetalInventory) getPXEArtifactURL(ctx context.Context, clusterID strfmt.UUID, objectName, fileName string) (string, error) {
+	if b.objectHandler.IsAwsS3() {
+		return b.objectHandler.GeneratePresignedDownloadURL(ctx, objectName, fileName, b.Config.ImageExpirationTime)
 	}
 
-	txSuccess := false
-	tx := b.db.Begin()
-	defer func() {
-		if !txSuccess {
-			log.Error("InstallHosts failed")
-			tx.Rollback()
-		}
-		if r := recover(); r != nil {
-			log.Error("InstallHosts failed")
-			tx.Rollback()
-		}
-	}()
+	builder := &installer.DownloadClusterPxeArtifactURL{ClusterID: clusterID, FileName: fileName}
+	artifactURL, err := builder.Build()
+	if err != nil {
+		return "", err
+	}
+	downloadURL := fmt.Sprintf("%s%s", b.Config.ServiceBaseURL, artifactURL.RequestURI())
+	if b.authHandler.AuthType() == auth.TypeLocal {
+		return gencrypto.SignURL(downloadURL, clusterID.String())
+	}
+	return downloadURL, nil
+}
 
-	// in case host monitor already updated the state we need to use FOR UPDATE option
-	tx = transaction.AddForUpdateQueryOption(tx)
+func (b *bareMetalInventory) DownloadClusterPxeArtifact(ctx context.Context, params installer.DownloadClusterPxeArtifactParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster common.Cluster
 
-	if cluster, err = common.GetClusterFromDB(tx, params.ClusterID, common.UseEagerLoading); err != nil {
-		return common.GenerateErrorResponder(err)
+	if err := b.db.First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusNotFound, err)
 	}
 
-	// move hosts to installing
-	for i := range cluster.Hosts {
-		if swag.StringValue(cluster.Hosts[i].Status) != models.HostStatusKnown {
-			continue
-		}
-		err = b.createAndUploadNodeIgnition(ctx, cluster, cluster.Hosts[i])
+	if cluster.ImageInfo.Type != models.ImageTypePxe || !cluster.ImageGenerated {
+		return common.NewApiError(http.StatusNotFound, errors.New("Network boot artifacts were not generated for this cluster"))
+	}
+
+	objectName := getPXEArtifactName(params.ClusterID, params.FileName)
+	if params.FileName == s3wrapper.PXEKernel || params.FileName == s3wrapper.PXERootFS {
+		baseISOName, err := b.objectHandler.GetBaseIsoObject(cluster.OpenshiftVersion)
 		if err != nil {
-			log.Errorf("Failed to upload ignition for host %s", cluster.Hosts[i].RequestedHostname)
-			continue
-		}
-		if installErr := b.hostApi.Install(ctx, cluster.Hosts[i], tx); installErr != nil {
-			// we just logs the error, each host install is independent
-			log.Errorf("Failed to move host %s to installing", cluster.Hosts[i].RequestedHostname)
+			log.WithError(err).Errorf("Failed to get source object name for cluster %s with ocp version %s", cluster.ID, cluster.OpenshiftVersion)
+			return common.NewApiError(http.StatusInternalServerError, err)
 		}
+		objectName = s3wrapper.PXEArtifactObjectName(baseISOName, params.FileName)
 	}
 
-	err = tx.Commit().Error
+	exists, err := b.objectHandler.DoesObjectExist(ctx, objectName)
 	if err != nil {
-		log.Error(err)
-		return common.NewApiError(http.StatusInternalServerError, errors.New("DB error, failed to commit transaction"))
+		log.WithError(err).Errorf("Failed to get network boot artifact %s for cluster %s", params.FileName, cluster.ID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if !exists {
+		return common.NewApiError(http.StatusNotFound, errors.New("The network boot artifact was not found "+
+			"(perhaps it expired) - please generate the image and try again"))
+	}
+	reader, contentLength, err := b.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to get network boot artifact %s for cluster %s", params.FileName, cluster.ID)
+		return common.NewApiError(http.StatusInternalServerError, err)
 	}
-	txSuccess = true
 
-	return installer.NewInstallHostsAccepted().WithPayload(&cluster.Cluster)
+	return filemiddleware.NewResponder(installer.NewDownloadClusterPxeArtifactOK().WithPayload(reader),
+		fmt.Sprintf("cluster-%s-%s", params.ClusterID.String(), params.FileName), contentLength)
 }
 
-func (b *bareMetalInventory) setBootstrapHost(ctx context.Context, cluster common.Cluster, db *gorm.DB) error {
+func (b *bareMetalInventory) DownloadClusterISOSignature(ctx context.Context, params installer.DownloadClusterISOSignatureParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
+	var cluster common.Cluster
 
-	// check if cluster already has bootstrap
-	for _, h := range cluster.Hosts {
-		if h.Bootstrap {
-			log.Infof("Bootstrap ID is %s", h.ID)
-			return nil
+	if err := b.db.First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusNotFound, err)
+	}
+
+	if b.Config.ImageSigningKeyPEM == "" {
+		return common.NewApiError(http.StatusNotFound, errors.New("Image signing is not configured"))
+	}
+
+	checksum := cluster.ImageInfo.Checksum
+	if cluster.ImageGenerated && checksum == "" && b.streamsImage(cluster.ImageInfo.Type) {
+		var err error
+		if checksum, err = b.computeStreamedImageChecksum(ctx, &cluster); err != nil {
+			log.WithError(err).Errorf("Failed to compute the checksum of the image of cluster %s", cluster.ID)
+			return common.NewApiError(http.StatusInternalServerError, err)
 		}
 	}
+	if !cluster.ImageGenerated || checksum == "" {
+		return common.NewApiError(http.StatusNotFound, errors.New("The checksum of the image was not computed - please generate the image and try again"))
+	}
 
-	masterNodesIds, err := b.clusterApi.GetMasterNodesIds(ctx, &cluster, db)
+	digest, err := hex.DecodeString(checksum)
 	if err != nil {
-		log.WithError(err).Errorf("failed to get cluster %s master node id's", cluster.ID)
-		return errors.Wrapf(err, "Failed to get cluster %s master node id's", cluster.ID)
-	}
-	if len(masterNodesIds) == 0 {
-		return errors.Errorf("Cluster have no master hosts that can operate as bootstrap")
+		log.WithError(err).Errorf("Invalid checksum of the image of cluster %s", cluster.ID)
+		return common.NewApiError(http.StatusInternalServerError, err)
 	}
-	bootstrapId := masterNodesIds[len(masterNodesIds)-1]
-	log.Infof("Bootstrap ID is %s", bootstrapId)
-	for i := range cluster.Hosts {
-		if cluster.Hosts[i].ID.String() == bootstrapId.String() {
-			err = b.hostApi.SetBootstrap(ctx, cluster.Hosts[i], true, db)
-			if err != nil {
-				log.WithError(err).Errorf("failed to update bootstrap host for cluster %s", cluster.ID)
-				return errors.Wrapf(err, "Failed to update bootstrap host for cluster %s", cluster.ID)
-			}
-		}
+	signature, err := gencrypto.SignDigest(digest, b.Config.ImageSigningKeyPEM)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to sign the checksum of the image of cluster %s", cluster.ID)
+		return common.NewApiError(http.StatusInternalServerError, err)
 	}
-	return nil
+
+	return filemiddleware.NewResponder(installer.NewDownloadClusterISOSignatureOK().WithPayload(ioutil.NopCloser(bytes.NewReader(signature))),
+		fmt.Sprintf("%s.sig", getImageDownloadName(&cluster)), int64(len(signature)))
 }
 
-func (b *bareMetalInventory) GetClusterInstallConfig(ctx context.Context, params installer.GetClusterInstallConfigParams) middleware.Responder {
-	c, err := b.getCluster(ctx, params.ClusterID.String())
+func (b *bareMetalInventory) DownloadImageSigningKey(ctx context.Context, params installer.DownloadImageSigningKeyParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	if b.Config.ImageSigningKeyPEM == "" {
+		return common.NewApiError(http.StatusNotFound, errors.New("Image signing is not configured"))
+	}
+	publicKey, err := gencrypto.PublicKeyPEM(b.Config.ImageSigningKeyPEM)
 	if err != nil {
-		return common.GenerateErrorResponder(err)
+		log.WithError(err).Error("Failed to get the public image signing key")
+		return common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	cfg, err := b.installConfigBuilder.GetInstallConfig(c, false, "")
-	if err != nil {
+	return filemiddleware.NewResponder(installer.NewDownloadImageSigningKeyOK().WithPayload(ioutil.NopCloser(strings.NewReader(publicKey))),
+		"image-signing-key.pem", int64(len(publicKey)))
+}
+
+func (b *bareMetalInventory) RegisterDeclaredHost(ctx context.Context, params installer.RegisterDeclaredHostParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	if _, err := common.GetClusterFromDB(b.db, params.ClusterID, common.SkipEagerLoading); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
 		return common.GenerateErrorResponder(err)
 	}
 
-	return installer.NewGetClusterInstallConfigOK().WithPayload(string(cfg))
-}
+	hostParams := params.NewDeclaredHostParams
+	if hostParams.Hostname != "" {
+		if err := hostutil.ValidateHostname(hostParams.Hostname); err != nil {
+			log.WithError(err).Errorf("invalid hostname %s of declared host", hostParams.Hostname)
+			return common.GenerateErrorResponder(err)
+		}
+	}
 
-func (b *bareMetalInventory) GetClusterDefaultConfig(_ context.Context, _ installer.GetClusterDefaultConfigParams) middleware.Responder {
-	body := models.ClusterDefaultConfig{}
+	staticNetworkConfig := ""
+	if hostParams.StaticNetworkConfig != nil {
+		staticNetworkConfigs := []*models.HostStaticNetworkConfig{hostParams.StaticNetworkConfig}
+		if err := b.staticNetworkConfig.ValidateStaticConfigParams(staticNetworkConfigs); err != nil {
+			log.Error(err)
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		staticNetworkConfig = b.staticNetworkConfig.FormatStaticNetworkConfigForDB(staticNetworkConfigs)
+	}
 
-	body.NtpSource = b.Config.DefaultNTPSource
-	body.ClusterNetworkCidr = b.Config.DefaultClusterNetworkCidr
-	body.ServiceNetworkCidr = b.Config.DefaultServiceNetworkCidr
-	body.ClusterNetworkHostPrefix = b.Config.DefaultClusterNetworkHostPrefix
+	role := hostParams.Role
+	if role == "" {
+		role = models.HostRoleUpdateParamsAutoAssign
+	}
+	declaredHost := &models.DeclaredHost{
+		ID:                  strfmt.UUID(uuid.New().String()),
+		ClusterID:           params.ClusterID,
+		CreatedAt:           strfmt.DateTime(time.Now()),
+		Hostname:            hostParams.Hostname,
+		Role:                role,
+		StaticNetworkConfig: staticNetworkConfig,
+	}
+	if err := b.db.Create(declaredHost).Error; err != nil {
+		log.WithError(err).Errorf("failed to declare host in cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
 
-	return installer.NewGetClusterDefaultConfigOK().WithPayload(&body)
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo,
+		fmt.Sprintf("Declared host %s", getDeclaredHostNameForMsg(declaredHost)), time.Now())
+	return installer.NewRegisterDeclaredHostCreated().WithPayload(declaredHost)
 }
 
-func (b *bareMetalInventory) UpdateClusterInstallConfig(ctx context.Context, params installer.UpdateClusterInstallConfigParams) middleware.Responder {
-	_, err := b.UpdateClusterInstallConfigInternal(ctx, params)
-	if err != nil {
+func (b *bareMetalInventory) ListDeclaredHosts(ctx context.Context, params installer.ListDeclaredHostsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	if _, err := common.GetClusterFromDB(b.db, params.ClusterID, common.SkipEagerLoading); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
 		return common.GenerateErrorResponder(err)
 	}
-	return installer.NewUpdateClusterInstallConfigCreated()
+
+	var declaredHosts models.DeclaredHostList
+	if err := b.db.Where("cluster_id = ?", params.ClusterID.String()).Order("created_at").Find(&declaredHosts).Error; err != nil {
+		log.WithError(err).Errorf("failed to get declared hosts of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return installer.NewListDeclaredHostsOK().WithPayload(declaredHosts)
 }
 
-func (b *bareMetalInventory) UpdateClusterInstallConfigInternal(ctx context.Context, params installer.UpdateClusterInstallConfigParams) (*common.Cluster, error) {
+func (b *bareMetalInventory) DeregisterDeclaredHost(ctx context.Context, params installer.DeregisterDeclaredHostParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
-	var cluster common.Cluster
-	query := "id = ?"
 
-	err := b.db.First(&cluster, query, params.ClusterID).Error
+	declaredHost, err := getDeclaredHost(b.db, params.ClusterID, params.DeclaredHostID)
 	if err != nil {
-		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
-		return nil, err
+		log.WithError(err).Errorf("failed to get declared host %s of cluster %s", params.DeclaredHostID, params.ClusterID)
+		return common.GenerateErrorResponder(err)
 	}
 
-	if err = b.installConfigBuilder.ValidateInstallConfigPatch(&cluster, params.InstallConfigParams); err != nil {
-		return nil, common.NewApiError(http.StatusBadRequest, err)
+	imgName := getDeclaredHostImageName(params.ClusterID, params.DeclaredHostID)
+	if _, err = b.objectHandler.DeleteObject(ctx, imgName); err != nil {
+		log.WithError(err).Errorf("failed to delete the image of declared host %s", params.DeclaredHostID)
+		return common.NewApiError(http.StatusInternalServerError, err)
 	}
-
-	err = b.db.Model(&common.Cluster{}).Where(query, params.ClusterID).Update("install_config_overrides", params.InstallConfigParams).Error
-	if err != nil {
-		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	if err = b.quotaApi.Release(ctx, imgName); err != nil {
+		log.WithError(err).Warnf("Failed to release the quota of the image of declared host %s", params.DeclaredHostID)
+	}
+	if err = b.db.Delete(&common.DeclaredHost{}, "id = ?", params.DeclaredHostID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to delete declared host %s", params.DeclaredHostID)
+		return common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, "Custom install config was applied to the cluster", time.Now())
-	log.Infof("Custom install config was applied to cluster %s", params.ClusterID)
-	return &cluster, nil
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo,
+		fmt.Sprintf("Deleted declared host %s", getDeclaredHostNameForMsg(&declaredHost.DeclaredHost)), time.Now())
+	return installer.NewDeregisterDeclaredHostNoContent()
 }
 
-func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, cluster common.Cluster) error {
+// GenerateDeclaredHostISO uploads a full ISO whose discovery ignition only carries the static network config and the
+// hostname of the declared host, and whose agent reaches the service through the binding URL of the declared host
+func (b *bareMetalInventory) GenerateDeclaredHostISO(ctx context.Context, params installer.GenerateDeclaredHostISOParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 
-	cfg, err := b.installConfigBuilder.GetInstallConfig(&cluster, b.Config.InstallRHCa, ignition.RedhatRootCA)
+	cluster, err := common.GetClusterFromDB(b.db, params.ClusterID, common.SkipEagerLoading)
 	if err != nil {
-		log.WithError(err).Errorf("failed to get install config for cluster %s", cluster.ID)
-		return errors.Wrapf(err, "failed to get install config for cluster %s", cluster.ID)
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+	if !cluster.PullSecretSet {
+		return common.NewApiError(http.StatusBadRequest, errors.New("Can't generate cluster ISO without pull secret"))
+	}
+	declaredHost, err := getDeclaredHost(b.db, params.ClusterID, params.DeclaredHostID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get declared host %s of cluster %s", params.DeclaredHostID, params.ClusterID)
+		return common.GenerateErrorResponder(err)
 	}
 
-	releaseImage, err := b.versionsHandler.GetReleaseImage(cluster.OpenshiftVersion)
-
+	// Every image gets a new binding token, the hosts booting the images generated before are no longer bound
+	if declaredHost.BindingToken, err = hostbinding.NewToken(); err != nil {
+		log.WithError(err).Errorf("failed to create the binding token of declared host %s", params.DeclaredHostID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	ignitionConfig, err := b.IgnitionBuilder.FormatDeclaredHostDiscoveryIgnitionFile(cluster, declaredHost, b.IgnitionConfig, false, b.authHandler.AuthType())
 	if err != nil {
-		log.WithError(err).Errorf("failed to get release image for cluster %s with openshift version %s", cluster.ID, cluster.OpenshiftVersion)
-		return errors.Wrapf(err, "failed to get release image for cluster %s with openshift version %s", cluster.ID, cluster.OpenshiftVersion)
+		log.WithError(err).Errorf("failed to format ignition config file for declared host %s", params.DeclaredHostID)
+		return common.NewApiError(http.StatusInternalServerError, err)
 	}
+	baseISOName, err := b.getBaseISOName(cluster, models.ImageTypeFullIso)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to get source object name for cluster %s with ocp version %s", cluster.ID, cluster.OpenshiftVersion)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	imgName := getDeclaredHostImageName(params.ClusterID, params.DeclaredHostID)
+	if err = b.quotaApi.ReserveObject(ctx, cluster, imgName, quota.KindImage, 0); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if err = b.objectHandler.UploadISO(ctx, ignitionConfig, baseISOName, strings.TrimSuffix(imgName, ".iso")); err != nil {
+		log.WithError(err).Errorf("Upload ISO failed for declared host %s", params.DeclaredHostID)
+		b.releaseClusterObject(ctx, cluster, imgName)
+		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError,
+			fmt.Sprintf("Failed to upload image of declared host %s", getDeclaredHostNameForMsg(&declaredHost.DeclaredHost)), time.Now())
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	b.recordStoredObject(ctx, cluster, imgName, quota.KindImage)
 
-	if err := b.generator.GenerateInstallConfig(ctx, cluster, cfg, releaseImage); err != nil {
-		msg := fmt.Sprintf("failed generating install config for cluster %s", cluster.ID)
-		log.WithError(err).Error(msg)
-		return errors.Wrap(err, msg)
+	downloadURL, err := b.getDeclaredHostImageURL(ctx, params.ClusterID, params.DeclaredHostID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to generate the image URL of declared host %s", params.DeclaredHostID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err = b.db.Model(&common.DeclaredHost{}).Where("id = ?", params.DeclaredHostID.String()).Updates(map[string]interface{}{
+		"image_download_url": downloadURL,
+		"binding_token":      declaredHost.BindingToken,
+	}).Error; err != nil {
+		log.WithError(err).Errorf("failed to update declared host %s", params.DeclaredHostID)
+		return common.NewApiError(http.StatusInternalServerError, err)
 	}
+	declaredHost.ImageDownloadURL = downloadURL
 
-	return nil
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo,
+		fmt.Sprintf("Generated image of declared host %s", getDeclaredHostNameForMsg(&declaredHost.DeclaredHost)), time.Now())
+	return installer.NewGenerateDeclaredHostISOCreated().WithPayload(&declaredHost.DeclaredHost)
 }
 
-func (b *bareMetalInventory) refreshClusterHosts(ctx context.Context, cluster *common.Cluster, tx *gorm.DB, log logrus.FieldLogger) error {
-	err := b.setMajorityGroupForCluster(cluster.ID, tx)
+func (b *bareMetalInventory) DownloadDeclaredHostISO(ctx context.Context, params installer.DownloadDeclaredHostISOParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	if _, err := getDeclaredHost(b.db, params.ClusterID, params.DeclaredHostID); err != nil {
+		log.WithError(err).Errorf("failed to get declared host %s of cluster %s", params.DeclaredHostID, params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	imgName := getDeclaredHostImageName(params.ClusterID, params.DeclaredHostID)
+	exists, err := b.objectHandler.DoesObjectExist(ctx, imgName)
 	if err != nil {
-		log.WithError(err).Errorf("Failed to set cluster %s majority groups", cluster.ID.String())
+		log.WithError(err).Errorf("Failed to get ISO of declared host %s", params.DeclaredHostID)
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
-
-	// Cluster is retrieved from DB to make sure we operate on the most recent information regarding monitored operators
-	// enabled for the cluster.
-	dbCluster, err := common.GetClusterFromDB(tx, *cluster.ID, common.UseEagerLoading)
+	if !exists {
+		return common.NewApiError(http.StatusNotFound, errors.New("The image was not found "+
+			"(perhaps it expired) - please generate the image and try again"))
+	}
+	reader, contentLength, err := b.objectHandler.Download(ctx, imgName)
 	if err != nil {
-		log.WithError(err).Errorf("not refreshing cluster hosts - failed to find cluster %s", *cluster.ID)
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return common.NewApiError(http.StatusNotFound, err)
-		}
+		log.WithError(err).Errorf("Failed to get ISO of declared host %s", params.DeclaredHostID)
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	for _, dbHost := range dbCluster.Hosts {
-		var err error
+	return filemiddleware.NewResponderWithContentType(installer.NewDownloadDeclaredHostISOOK().WithPayload(reader),
+		fmt.Sprintf("declared-host-%s-discovery.iso", params.DeclaredHostID), getImageContentType(models.ImageTypeFullIso), contentLength)
+}
 
-		// Refresh inventory - especially disk eligibility. The host requirements might have changed.
-		// dbHost object might be updated with the latest disk eligibility information.
-		err = b.refreshInventory(ctx, dbCluster, dbHost, tx)
-		if err != nil {
-			return err
-		}
+// getDeclaredHostImageURL returns the URL that the image of the declared host is downloaded from
+func (b *bareMetalInventory) getDeclaredHostImageURL(ctx context.Context, clusterID, declaredHostID strfmt.UUID) (string, error) {
+	if b.objectHandler.IsAwsS3() {
+		return b.objectHandler.GeneratePresignedDownloadURL(ctx, getDeclaredHostImageName(clusterID, declaredHostID),
+			fmt.Sprintf("declared-host-%s-discovery.iso", declaredHostID), b.Config.ImageExpirationTime)
+	}
+	imageURL, err := (&installer.DownloadDeclaredHostISOURL{ClusterID: clusterID, DeclaredHostID: declaredHostID}).Build()
+	if err != nil {
+		return "", err
+	}
+	downloadURL := fmt.Sprintf("%s%s", b.Config.ServiceBaseURL, imageURL.RequestURI())
+	if b.authHandler.AuthType() == auth.TypeLocal {
+		return gencrypto.SignURL(downloadURL, clusterID.String())
+	}
+	return downloadURL, nil
+}
 
-		if err = b.hostApi.RefreshStatus(ctx, dbHost, tx); err != nil {
-			log.WithError(err).Errorf("failed to refresh state of host %s cluster %s", *dbHost.ID, cluster.ID.String())
-			return common.NewApiError(http.StatusInternalServerError, err)
+// bindDeclaredHost binds the registering host to the declared host whose discovery image it booted, which is found by
+// the binding token of the image. A declared host is bound to a single host, and a host that registers for the first
+// time gets the hostname and the role of the declared host.
+func bindDeclaredHost(db *gorm.DB, cluster *common.Cluster, host *models.Host, bindingToken string, newHost bool) error {
+	var declaredHost common.DeclaredHost
+	if err := db.First(&declaredHost, "cluster_id = ? and binding_token = ?", cluster.ID.String(), bindingToken).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.NewApiError(http.StatusNotFound, errors.Errorf("No declared host of cluster %s has the discovery image of the host", cluster.ID))
+		}
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	// The declared host is only bound when no other host was bound to it meanwhile
+	reply := db.Model(&common.DeclaredHost{}).Where("id = ? and (host_id = '' or host_id = ?)", declaredHost.ID.String(), host.ID.String()).
+		Update("host_id", *host.ID)
+	if reply.Error != nil {
+		return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(reply.Error, "failed to bind declared host %s", declaredHost.ID))
+	}
+	if reply.RowsAffected == 0 {
+		return common.NewApiError(http.StatusConflict, errors.Errorf("Declared host %s is already bound to another host", declaredHost.ID))
+	}
+	if newHost {
+		host.RequestedHostname = declaredHost.Hostname
+		// Hosts of single node clusters are always masters
+		if !common.IsSingleNodeCluster(cluster) && declaredHost.Role != "" {
+			host.Role = models.HostRole(declaredHost.Role)
 		}
 	}
 	return nil
 }
 
-func (b *bareMetalInventory) refreshInventory(ctx context.Context, cluster *common.Cluster, host *models.Host, db *gorm.DB) error {
-	log := logutil.FromContext(ctx, b.log)
-	if host.Inventory != "" {
-		err := b.hostApi.RefreshInventory(ctx, cluster, host, db)
-		if err != nil {
-			log.WithError(err).Errorf("failed to update inventory of host %s cluster %s", host.ID, cluster.ID.String())
-			switch err := err.(type) {
-			case *common.ApiErrorResponse:
-				if err.StatusCode() != http.StatusConflict {
-					return err
-				}
-				// In RefreshInventory there is a precondition on host's status that on failure returns StatusConflict.
-				// In case of cluster update we don't want to fail the whole update if host can't be, according to
-				// business rules, updated. An example of such case is disabled host.
-				log.Infof("ignoring wrong status error (%v) for host %s in cluster %s", err, host.ID, cluster.ID.String())
-			default:
-				return common.NewApiError(http.StatusInternalServerError, err)
-			}
+func getDeclaredHost(db *gorm.DB, clusterID, declaredHostID strfmt.UUID) (*common.DeclaredHost, error) {
+	var declaredHost common.DeclaredHost
+	if err := db.First(&declaredHost, "id = ? and cluster_id = ?", declaredHostID.String(), clusterID.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("Declared host %s was not found in cluster %s", declaredHostID, clusterID))
 		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
-	return nil
+	return &declaredHost, nil
 }
 
-func (b *bareMetalInventory) noneHaModeClusterUpdateValidations(cluster *common.Cluster, params installer.UpdateClusterParams) error {
-	if swag.StringValue(cluster.HighAvailabilityMode) != models.ClusterHighAvailabilityModeNone {
-		return nil
-	}
+// getDeclaredHostImageName returns the name of the image of the declared host, which expires like the images of clusters
+func getDeclaredHostImageName(clusterID, declaredHostID strfmt.UUID) string {
+	return fmt.Sprintf("%s-declared-%s.iso", fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, clusterID.String()), declaredHostID.String())
+}
 
-	if len(params.ClusterUpdateParams.HostsRoles) > 0 {
-		return errors.Errorf("setting host role is not allowed in single node mode")
+func getDeclaredHostNameForMsg(declaredHost *models.DeclaredHost) string {
+	if declaredHost.Hostname != "" {
+		return declaredHost.Hostname
 	}
+	return declaredHost.ID.String()
+}
 
-	if params.ClusterUpdateParams.UserManagedNetworking != nil && !swag.BoolValue(params.ClusterUpdateParams.UserManagedNetworking) {
-		return errors.Errorf("disabling UserManagedNetworking is not allowed in single node mode")
+func (b *bareMetalInventory) refreshAllHosts(ctx context.Context, cluster *common.Cluster) error {
+	err := b.setMajorityGroupForCluster(cluster.ID, b.db)
+	if err != nil {
+		return err
 	}
+	for _, chost := range cluster.Hosts {
+		if swag.StringValue(chost.Status) != models.HostStatusKnown && swag.StringValue(chost.Kind) == models.HostKindHost {
+			return common.NewApiError(http.StatusBadRequest, errors.Errorf("Host %s is in status %s and not ready for install",
+				hostutil.GetHostnameForMsg(chost), swag.StringValue(chost.Status)))
+		}
 
+		err := b.hostApi.RefreshStatus(ctx, chost, b.db)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func (b *bareMetalInventory) validateAndUpdateClusterParams(ctx context.Context, params *installer.UpdateClusterParams) (installer.UpdateClusterParams, error) {
-
+func (b *bareMetalInventory) storeOpenshiftClusterID(ctx context.Context, clusterID string) (string, error) {
 	log := logutil.FromContext(ctx, b.log)
-
-	if swag.StringValue(params.ClusterUpdateParams.PullSecret) != "" {
-		if err := b.secretValidator.ValidatePullSecret(*params.ClusterUpdateParams.PullSecret, ocm.UserNameFromContext(ctx), b.authHandler); err != nil {
-			log.WithError(err).Errorf("Pull secret for cluster %s is invalid", params.ClusterID)
-			return installer.UpdateClusterParams{}, err
-		}
-		ps, errUpdate := b.updatePullSecret(*params.ClusterUpdateParams.PullSecret, log)
-		if errUpdate != nil {
-			return installer.UpdateClusterParams{}, errors.New("Failed to update Pull-secret with additional credentials")
-		}
-		params.ClusterUpdateParams.PullSecret = &ps
+	log.Debug("Downloading bootstrap ignition file")
+	reader, _, err := b.objectHandler.Download(ctx, fmt.Sprintf("%s/%s", clusterID, "bootstrap.ign"))
+	if err != nil {
+		log.WithError(err).Error("Failed downloading bootstrap ignition file")
+		return "", err
 	}
 
-	if swag.StringValue(params.ClusterUpdateParams.Name) != "" {
-		if err := validations.ValidateClusterNameFormat(*params.ClusterUpdateParams.Name); err != nil {
-			return installer.UpdateClusterParams{}, err
-		}
-	}
-
-	if err := validations.ValidateIPAddressFamily(b.IPv6Support, params.ClusterUpdateParams.ClusterNetworkCidr, params.ClusterUpdateParams.ServiceNetworkCidr,
-		params.ClusterUpdateParams.MachineNetworkCidr, params.ClusterUpdateParams.APIVip, params.ClusterUpdateParams.IngressVip); err != nil {
-		return installer.UpdateClusterParams{}, common.NewApiError(http.StatusBadRequest, err)
+	var openshiftClusterID string
+	log.Debug("Extracting Openshift cluster ID from ignition file")
+	openshiftClusterID, err = ignition.ExtractClusterID(reader)
+	if err != nil {
+		log.WithError(err).Error("Failed extracting Openshift cluster ID from ignition file")
+		return "", err
 	}
+	log.Debugf("Got OpenShift cluster ID of %s", openshiftClusterID)
 
-	if sshPublicKey := swag.StringValue(params.ClusterUpdateParams.SSHPublicKey); sshPublicKey != "" {
-		sshPublicKey = strings.TrimSpace(sshPublicKey)
-		if err := validations.ValidateSSHPublicKey(sshPublicKey); err != nil {
-			return installer.UpdateClusterParams{}, err
-		}
-		*params.ClusterUpdateParams.SSHPublicKey = sshPublicKey
+	log.Debugf("Storing Openshift cluster ID of cluster %s to DB", clusterID)
+	if err = b.db.Model(&common.Cluster{}).Where("id = ?", clusterID).Update(
+		"openshift_cluster_id", openshiftClusterID).Error; err != nil {
+		log.WithError(err).Errorf("Failed storing Openshift cluster ID of cluster %s to DB", clusterID)
+		return "", err
 	}
 
-	return *params, nil
+	return openshiftClusterID, nil
 }
 
-func (b *bareMetalInventory) validateAndUpdateProxyParams(ctx context.Context, params *installer.UpdateClusterParams, ocpVersion *string) (installer.UpdateClusterParams, error) {
-
-	log := logutil.FromContext(ctx, b.log)
-
-	if params.ClusterUpdateParams.HTTPProxy != nil &&
-		(params.ClusterUpdateParams.HTTPSProxy == nil || *params.ClusterUpdateParams.HTTPSProxy == "") {
-		params.ClusterUpdateParams.HTTPSProxy = params.ClusterUpdateParams.HTTPProxy
-	}
-
-	if err := validateProxySettings(params.ClusterUpdateParams.HTTPProxy,
-		params.ClusterUpdateParams.HTTPSProxy,
-		params.ClusterUpdateParams.NoProxy, ocpVersion); err != nil {
-		log.WithError(err).Errorf("Failed to validate Proxy settings")
-		return installer.UpdateClusterParams{}, err
+func (b *bareMetalInventory) InstallCluster(ctx context.Context, params installer.InstallClusterParams) middleware.Responder {
+	c, err := b.InstallClusterInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
 	}
-
-	return *params, nil
+	return installer.NewInstallClusterAccepted().WithPayload(&c.Cluster)
 }
 
-func (b *bareMetalInventory) UpdateCluster(ctx context.Context, params installer.UpdateClusterParams) middleware.Responder {
-	c, err := b.UpdateClusterInternal(ctx, params)
-	if err != nil {
-		return common.GenerateErrorResponder(err)
+func (b *bareMetalInventory) integrateWithAMSClusterPreInstallation(ctx context.Context, amsSubscriptionID, openshiftClusterID strfmt.UUID) error {
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("Updating AMS subscription %s with openshift cluster ID %s", amsSubscriptionID, openshiftClusterID)
+	if err := b.ocmClient.AccountsMgmt.UpdateSubscriptionOpenshiftClusterID(ctx, amsSubscriptionID, openshiftClusterID); err != nil {
+		log.WithError(err).Errorf("Failed to update AMS subscription with openshift cluster ID %s", openshiftClusterID)
+		return err
 	}
-	return installer.NewUpdateClusterCreated().WithPayload(&c.Cluster)
+	return nil
 }
 
-func (b *bareMetalInventory) UpdateClusterInternal(ctx context.Context, params installer.UpdateClusterParams) (*common.Cluster, error) {
+func (b *bareMetalInventory) InstallClusterInternal(ctx context.Context, params installer.InstallClusterParams) (*common.Cluster, error) {
 	log := logutil.FromContext(ctx, b.log)
-	var cluster *common.Cluster
+	cluster := &common.Cluster{}
 	var err error
-	log.Infof("update cluster %s with params: %+v", params.ClusterID, params.ClusterUpdateParams)
 
-	if params, err = b.validateAndUpdateClusterParams(ctx, &params); err != nil {
-		return nil, common.NewApiError(http.StatusBadRequest, err)
+	log.Infof("preparing for cluster %s installation", params.ClusterID)
+	if cluster, err = common.GetClusterFromDBWithoutDisabledHosts(b.db, params.ClusterID); err != nil {
+		return nil, common.NewApiError(http.StatusNotFound, err)
 	}
-
-	txSuccess := false
-	tx := b.db.Begin()
-	defer func() {
-		if !txSuccess {
-			log.Error("update cluster failed")
-			tx.Rollback()
-		}
-		if r := recover(); r != nil {
-			log.Error("update cluster failed")
-			tx.Rollback()
+	// auto select hosts roles if not selected yet.
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		for i := range cluster.Hosts {
+			if err = b.hostApi.AutoAssignRole(ctx, cluster.Hosts[i], tx); err != nil {
+				return err
+			}
 		}
-	}()
-
-	if tx.Error != nil {
-		log.WithError(tx.Error).Errorf("failed to start db transaction")
-		return nil, common.NewApiError(http.StatusInternalServerError,
-			errors.New("DB error, failed to start transaction"))
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// in case host monitor already updated the state we need to use FOR UPDATE option
-	tx = transaction.AddForUpdateQueryOption(tx)
-
-	if cluster, err = common.GetClusterFromDB(tx, params.ClusterID, common.UseEagerLoading); err != nil {
-		log.WithError(err).Errorf("failed to get cluster: %s", params.ClusterID)
-		return nil, common.NewApiError(http.StatusNotFound, err)
+	if err = b.refreshAllHosts(ctx, cluster); err != nil {
+		return nil, err
 	}
-
-	if params, err = b.validateAndUpdateProxyParams(ctx, &params, &cluster.OpenshiftVersion); err != nil {
-		return nil, common.NewApiError(http.StatusBadRequest, err)
+	if _, err = b.clusterApi.RefreshStatus(ctx, cluster, b.db); err != nil {
+		return nil, err
 	}
 
-	if err = b.clusterApi.VerifyClusterUpdatability(cluster); err != nil {
-		log.WithError(err).Errorf("cluster %s can't be updated in current state", params.ClusterID)
-		return nil, common.NewApiError(http.StatusConflict, err)
+	// Reload again after refresh
+	if cluster, err = common.GetClusterFromDBWithoutDisabledHosts(b.db, params.ClusterID); err != nil {
+		return nil, common.NewApiError(http.StatusNotFound, err)
 	}
-
-	if err = b.noneHaModeClusterUpdateValidations(cluster, params); err != nil {
-		log.WithError(err).Warnf("Unsupported update params in none ha mode")
-		return nil, common.NewApiError(http.StatusBadRequest, err)
+	// Verify cluster is ready to install
+	if ok, reason := b.clusterApi.IsReadyForInstallation(cluster); !ok {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("Cluster is not ready for installation, %s", reason))
 	}
 
-	if err = b.validateDNSDomain(*cluster, params, log); err != nil {
-		return nil, err
-	}
+	// prepare cluster and hosts for installation
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		// in case host monitor already updated the state we need to use FOR UPDATE option
+		tx = transaction.AddForUpdateQueryOption(tx)
 
-	usages, err := usage.Unmarshal(cluster.Cluster.FeatureUsage)
-	if err != nil {
-		log.WithError(err).Errorf("failed to read feature usage from cluster %s", params.ClusterID)
-		return nil, err
-	}
+		if err = b.clusterApi.PrepareForInstallation(ctx, cluster, tx); err != nil {
+			return err
+		}
 
-	err = b.updateClusterData(ctx, cluster, params, usages, tx, log)
+		if err = b.setBootstrapHost(ctx, *cluster, tx); err != nil {
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		log.WithError(err).Error("updateClusterData")
 		return nil, err
 	}
 
-	err = b.updateHostsData(ctx, params, usages, tx, log)
-	if err != nil {
+	if cluster, err = common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading); err != nil {
 		return nil, err
 	}
 
-	err = b.updateOperatorsData(ctx, cluster, params, usages, tx, log)
-	if err != nil {
-		return nil, err
+	if err = b.clusterApi.GenerateAdditionalManifests(ctx, cluster); err != nil {
+		b.log.WithError(err).Errorf("Failed to generated additional cluster manifest")
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.New("Failed to generated additional cluster manifest"))
 	}
 
-	err = b.updateHostsAndClusterStatus(ctx, cluster, tx, log)
-	if err != nil {
-		return nil, err
+	// Delete previews installation log files from object storage (if exist).
+	if err := b.clusterApi.DeleteClusterLogs(ctx, cluster, b.objectHandler); err != nil {
+		log.WithError(err).Warnf("Failed deleting s3 logs of cluster %s", cluster.ID.String())
 	}
 
-	b.usageApi.Save(tx, params.ClusterID, usages)
-
-	newClusterName := swag.StringValue(params.ClusterUpdateParams.Name)
-	if b.ocmClient != nil && b.ocmClient.Config.WithAMSSubscriptions && newClusterName != "" && newClusterName != cluster.Name {
-		if err = b.integrateWithAMSClusterUpdateName(ctx, cluster, *params.ClusterUpdateParams.Name); err != nil {
-			log.WithError(err).Errorf("Cluster %s failed to integrate with AMS on cluster update with new name %s", params.ClusterID, newClusterName)
-			return nil, err
-		}
-	}
+	go func() {
+		var err error
+		asyncCtx := requestid.ToContext(context.Background(), requestid.FromContext(ctx))
 
-	if err = tx.Commit().Error; err != nil {
-		log.Error(err)
-		return nil, common.NewApiError(http.StatusInternalServerError, errors.Errorf("DB error, failed to commit"))
-	}
-	txSuccess = true
+		defer func() {
+			if err != nil {
+				log.WithError(err).Warn("Cluster installation initialization failed")
+				b.clusterApi.HandlePreInstallError(asyncCtx, cluster, err)
+			} else {
+				b.clusterApi.HandlePreInstallSuccess(asyncCtx, cluster)
+			}
+		}()
 
-	if proxySettingsChanged(params.ClusterUpdateParams, cluster) {
-		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, "Proxy settings changed", time.Now())
-	}
+		if err = b.generateClusterInstallConfig(asyncCtx, *cluster); err != nil {
+			return
+		}
+		log.Infof("generated ignition for cluster %s", cluster.ID.String())
 
-	if cluster, err = common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading); err != nil {
-		log.WithError(err).Errorf("failed to get cluster %s after update", params.ClusterID)
-		return nil, err
-	}
+		log.Infof("Storing OpenShift cluster ID of cluster %s to DB", cluster.ID.String())
+		var openshiftClusterID string
+		if openshiftClusterID, err = b.storeOpenshiftClusterID(ctx, cluster.ID.String()); err != nil {
+			return
+		}
 
-	cluster.HostNetworks = b.calculateHostNetworks(log, cluster)
-	for _, host := range cluster.Hosts {
-		if err := b.customizeHost(host); err != nil {
-			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		if b.ocmClient != nil && b.ocmClient.Config.WithAMSSubscriptions {
+			if err = b.integrateWithAMSClusterPreInstallation(asyncCtx, cluster.AmsSubscriptionID, strfmt.UUID(openshiftClusterID)); err != nil {
+				log.WithError(err).Errorf("Cluster %s failed to integrate with AMS on cluster pre installation", params.ClusterID)
+				return
+			}
 		}
-		// Clear this field as it is not needed to be sent via API
-		host.FreeAddresses = ""
-	}
+	}()
 
+	log.Infof("Successfully prepared cluster <%s> for installation", params.ClusterID.String())
 	return cluster, nil
 }
 
-func (b *bareMetalInventory) integrateWithAMSClusterUpdateName(ctx context.Context, cluster *common.Cluster, newClusterName string) error {
+func (b *bareMetalInventory) InstallSingleDay2HostInternal(ctx context.Context, clusterId strfmt.UUID, hostId strfmt.UUID) error {
+
 	log := logutil.FromContext(ctx, b.log)
-	log.Infof("Updating AMS subscription for cluster %s with new name %s", *cluster.ID, newClusterName)
-	if err := b.ocmClient.AccountsMgmt.UpdateSubscriptionDisplayName(ctx, cluster.AmsSubscriptionID, newClusterName); err != nil {
-		log.WithError(err).Errorf("Failed to update AMS subscription with the new cluster name %s for cluster %s", newClusterName, *cluster.ID)
+	var err error
+	var cluster *common.Cluster
+	var h *common.Host
+
+	if cluster, err = common.GetClusterFromDB(b.db, clusterId, common.UseEagerLoading); err != nil {
 		return err
 	}
-	return nil
-}
-
-func setMachineNetworkCIDRForUpdate(updates map[string]interface{}, machineNetworkCIDR string) {
-	updates["machine_network_cidr"] = machineNetworkCIDR
-	updates["machine_network_cidr_updated_at"] = time.Now()
-}
-
-func (b *bareMetalInventory) updateNonDhcpNetworkParams(updates map[string]interface{}, cluster *common.Cluster, params installer.UpdateClusterParams, log logrus.FieldLogger, _ *string) error {
-	apiVip := cluster.APIVip
-	ingressVip := cluster.IngressVip
-	if params.ClusterUpdateParams.APIVip != nil {
-		updates["api_vip"] = *params.ClusterUpdateParams.APIVip
-		apiVip = *params.ClusterUpdateParams.APIVip
+	if h, err = b.getHost(ctx, clusterId.String(), hostId.String()); err != nil {
+		return err
 	}
-	if params.ClusterUpdateParams.IngressVip != nil {
-		updates["ingress_vip"] = *params.ClusterUpdateParams.IngressVip
-		ingressVip = *params.ClusterUpdateParams.IngressVip
-	}
-	if params.ClusterUpdateParams.MachineNetworkCidr != nil {
-		err := errors.New("Setting Machine network CIDR is forbidden when cluster is not in vip-dhcp-allocation mode")
-		log.WithError(err).Warnf("Set Machine Network CIDR")
-		return common.NewApiError(http.StatusBadRequest, err)
+	// auto select host roles if not selected yet.
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		if err = b.hostApi.AutoAssignRole(ctx, &h.Host, tx); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	var err error
-	err = verifyParsableVIPs(apiVip, ingressVip)
-	if err != nil {
-		log.WithError(err).Errorf("Failed validating VIPs of cluster id=%s", params.ClusterID)
+	if err = b.setMajorityGroupForCluster(cluster.ID, b.db); err != nil {
 		return err
 	}
-
-	err = network.VerifyDifferentVipAddresses(apiVip, ingressVip)
-	if err != nil {
-		log.WithError(err).Errorf("VIP verification failed for cluster: %s", params.ClusterID)
-		return common.NewApiError(http.StatusBadRequest, err)
-	}
-	return nil
-}
-
-func verifyParsableVIPs(apiVip string, ingressVip string) error {
-	if apiVip != "" && net.ParseIP(apiVip) == nil {
-		return common.NewApiError(http.StatusBadRequest, errors.Errorf("Could not parse VIP ip %s", apiVip))
-	}
-	if ingressVip != "" && net.ParseIP(ingressVip) == nil {
-		return common.NewApiError(http.StatusBadRequest, errors.Errorf("Could not parse VIP ip %s", ingressVip))
-	}
-	return nil
-}
-
-func (b *bareMetalInventory) updateDhcpNetworkParams(updates map[string]interface{}, params installer.UpdateClusterParams, log logrus.FieldLogger, machineCidr *string) error {
-	if params.ClusterUpdateParams.APIVip != nil {
-		err := errors.New("Setting API VIP is forbidden when cluster is in vip-dhcp-allocation mode")
-		log.WithError(err).Warnf("Set API VIP")
-		return common.NewApiError(http.StatusBadRequest, err)
-	}
-	if params.ClusterUpdateParams.IngressVip != nil {
-		err := errors.New("Setting Ingress VIP is forbidden when cluster is in vip-dhcp-allocation mode")
-		log.WithError(err).Warnf("Set Ingress VIP")
-		return common.NewApiError(http.StatusBadRequest, err)
-	}
-	if params.ClusterUpdateParams.MachineNetworkCidr != nil &&
-		*machineCidr != swag.StringValue(params.ClusterUpdateParams.MachineNetworkCidr) {
-		*machineCidr = swag.StringValue(params.ClusterUpdateParams.MachineNetworkCidr)
-		setMachineNetworkCIDRForUpdate(updates, *machineCidr)
-		updates["api_vip"] = ""
-		updates["ingress_vip"] = ""
-		return network.VerifyMachineCIDR(swag.StringValue(params.ClusterUpdateParams.MachineNetworkCidr))
+	if err = b.hostApi.RefreshStatus(ctx, &h.Host, b.db); err != nil {
+		return err
 	}
-	return nil
-}
 
-func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *common.Cluster, params installer.UpdateClusterParams, usages map[string]models.Usage, db *gorm.DB, log logrus.FieldLogger) error {
-	var err error
-	updates := map[string]interface{}{}
-	optionalParam(params.ClusterUpdateParams.Name, "name", updates)
-	optionalParam(params.ClusterUpdateParams.BaseDNSDomain, "base_dns_domain", updates)
-	optionalParam(params.ClusterUpdateParams.HTTPProxy, "http_proxy", updates)
-	optionalParam(params.ClusterUpdateParams.HTTPSProxy, "https_proxy", updates)
-	optionalParam(params.ClusterUpdateParams.NoProxy, "no_proxy", updates)
-	optionalParam(params.ClusterUpdateParams.SSHPublicKey, "ssh_public_key", updates)
-	optionalParam(params.ClusterUpdateParams.Hyperthreading, "hyperthreading", updates)
+	txSuccess := false
+	tx := b.db.Begin()
+	defer func() {
+		if !txSuccess {
+			log.Error("InstallSingleDay2HostInternal failed")
+			tx.Rollback()
+		}
+		if r := recover(); r != nil {
+			log.Error("InstallSingleDay2HostInternal failed")
+			tx.Rollback()
+		}
+	}()
 
-	b.setProxyUsage(params.ClusterUpdateParams.HTTPProxy, params.ClusterUpdateParams.HTTPSProxy, params.ClusterUpdateParams.NoProxy, usages)
+	// in case host monitor already updated the state we need to use FOR UPDATE option
+	tx = transaction.AddForUpdateQueryOption(tx)
 
-	if err = b.updateNetworkParams(params, cluster, updates, usages, log); err != nil {
+	if cluster, err = common.GetClusterFromDB(tx, clusterId, common.UseEagerLoading); err != nil {
 		return err
 	}
 
-	if err = b.updateNtpSources(params, updates, usages, log); err != nil {
+	// move host to installing
+	err = b.createAndUploadNodeIgnition(ctx, cluster, &h.Host)
+	if err != nil {
+		log.Errorf("Failed to upload ignition for host %s", h.RequestedHostname)
 		return err
 	}
-
-	if params.ClusterUpdateParams.PullSecret != nil {
-		cluster.PullSecret = *params.ClusterUpdateParams.PullSecret
-		updates["pull_secret"] = *params.ClusterUpdateParams.PullSecret
-		if cluster.PullSecret != "" {
-			updates["pull_secret_set"] = true
-		} else {
-			updates["pull_secret_set"] = false
-		}
-	}
-
-	if params.ClusterUpdateParams.APIVipDNSName != nil {
-		if swag.StringValue(cluster.Kind) == models.ClusterKindAddHostsCluster {
-			log.Infof("Updating api vip to %s for day2 cluster %s", *params.ClusterUpdateParams.APIVipDNSName, cluster.ID)
-			updates["api_vip_dns_name"] = *params.ClusterUpdateParams.APIVipDNSName
-		} else {
-			msg := fmt.Sprintf("Can't update api vip to %s for day1 cluster %s", *params.ClusterUpdateParams.APIVipDNSName, cluster.ID)
-			log.Error(msg)
-			return common.NewApiError(http.StatusBadRequest, errors.Errorf(msg))
-		}
+	if installErr := b.hostApi.Install(ctx, &h.Host, tx); installErr != nil {
+		log.Errorf("Failed to move host %s to installing", h.RequestedHostname)
+		return installErr
 	}
 
-	dbReply := db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Updates(updates)
-	if dbReply.Error != nil {
-		return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to update cluster: %s", params.ClusterID))
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error(err)
+		return err
 	}
+	txSuccess = true
 
 	return nil
 }
 
-func (b *bareMetalInventory) updateNetworkParams(params installer.UpdateClusterParams, cluster *common.Cluster, updates map[string]interface{}, usages map[string]models.Usage, log logrus.FieldLogger) error {
+func (b *bareMetalInventory) InstallHosts(ctx context.Context, params installer.InstallHostsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	cluster := &common.Cluster{}
 	var err error
-	machineCidr := cluster.MachineNetworkCidr
-	serviceCidr := cluster.ServiceNetworkCidr
-	clusterCidr := cluster.ClusterNetworkCidr
-	hostNetworkPrefix := cluster.ClusterNetworkHostPrefix
-	vipDhcpAllocation := swag.BoolValue(cluster.VipDhcpAllocation)
-	userManagedNetworking := swag.BoolValue(cluster.UserManagedNetworking)
 
-	if params.ClusterUpdateParams.ClusterNetworkCidr != nil {
-		if err = network.VerifyClusterOrServiceCIDR(*params.ClusterUpdateParams.ClusterNetworkCidr); err != nil {
-			return common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "Cluster network CIDR"))
-		}
-		clusterCidr = *params.ClusterUpdateParams.ClusterNetworkCidr
-		updates["cluster_network_cidr"] = clusterCidr
-	}
-	if params.ClusterUpdateParams.ClusterNetworkHostPrefix != nil {
-		if err = network.VerifyNetworkHostPrefix(*params.ClusterUpdateParams.ClusterNetworkHostPrefix); err != nil {
-			return common.NewApiError(http.StatusBadRequest, err)
-		}
-		hostNetworkPrefix = *params.ClusterUpdateParams.ClusterNetworkHostPrefix
-		updates["cluster_network_host_prefix"] = hostNetworkPrefix
-	}
-	if clusterCidr != "" {
-		err = network.VerifyClusterCidrSize(int(hostNetworkPrefix), clusterCidr, len(cluster.Hosts))
-		if err != nil {
-			return common.NewApiError(http.StatusBadRequest, err)
-		}
-	}
-	if params.ClusterUpdateParams.ServiceNetworkCidr != nil {
-		if err = network.VerifyClusterOrServiceCIDR(*params.ClusterUpdateParams.ServiceNetworkCidr); err != nil {
-			return common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "Service network CIDR"))
-		}
-		serviceCidr = *params.ClusterUpdateParams.ServiceNetworkCidr
-		updates["service_network_cidr"] = serviceCidr
+	if cluster, err = common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading); err != nil {
+		return common.GenerateErrorResponder(err)
 	}
 
-	if params.ClusterUpdateParams.UserManagedNetworking != nil && swag.BoolValue(params.ClusterUpdateParams.UserManagedNetworking) != userManagedNetworking {
-		userManagedNetworking = swag.BoolValue(params.ClusterUpdateParams.UserManagedNetworking)
-		updates["user_managed_networking"] = userManagedNetworking
-		machineCidr = ""
-	}
-	if userManagedNetworking && !common.IsSingleNodeCluster(cluster) {
-		err, vipDhcpAllocation = setCommonUserNetworkManagedParams(params.ClusterUpdateParams, common.IsSingleNodeCluster(cluster), machineCidr, updates, log)
-		if err != nil {
-			return err
+	// auto select hosts roles if not selected yet.
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		for i := range cluster.Hosts {
+			if swag.StringValue(cluster.Hosts[i].Status) != models.HostStatusKnown {
+				continue
+			}
+			if err = b.hostApi.AutoAssignRole(ctx, cluster.Hosts[i], tx); err != nil {
+				return err
+			}
 		}
+		return nil
+	})
+
+	if err != nil {
+		return common.GenerateErrorResponder(err)
 	}
 
-	if params.ClusterUpdateParams.VipDhcpAllocation != nil && swag.BoolValue(params.ClusterUpdateParams.VipDhcpAllocation) != vipDhcpAllocation {
-		vipDhcpAllocation = swag.BoolValue(params.ClusterUpdateParams.VipDhcpAllocation)
-		updates["vip_dhcp_allocation"] = vipDhcpAllocation
-		updates["api_vip"] = ""
-		updates["ingress_vip"] = ""
-		machineCidr = ""
-		setMachineNetworkCIDRForUpdate(updates, machineCidr)
+	if err = b.refreshAllHosts(ctx, cluster); err != nil {
+		return common.GenerateErrorResponder(err)
 	}
-	if !userManagedNetworking {
-		if vipDhcpAllocation {
-			err = b.updateDhcpNetworkParams(updates, params, log, &machineCidr)
-		} else {
-			err = b.updateNonDhcpNetworkParams(updates, cluster, params, log, &machineCidr)
+
+	txSuccess := false
+	tx := b.db.Begin()
+	defer func() {
+		if !txSuccess {
+			log.Error("InstallHosts failed")
+			tx.Rollback()
 		}
-		if err != nil {
-			return err
+		if r := recover(); r != nil {
+			log.Error("InstallHosts failed")
+			tx.Rollback()
 		}
+	}()
+
+	// in case host monitor already updated the state we need to use FOR UPDATE option
+	tx = transaction.AddForUpdateQueryOption(tx)
+
+	if cluster, err = common.GetClusterFromDB(tx, params.ClusterID, common.UseEagerLoading); err != nil {
+		return common.GenerateErrorResponder(err)
 	}
 
-	if params.ClusterUpdateParams.MachineNetworkCidr != nil && common.IsSingleNodeCluster(cluster) {
-		machineCidr = swag.StringValue(params.ClusterUpdateParams.MachineNetworkCidr)
-		if err = network.VerifyMachineCIDR(machineCidr); err != nil {
-			log.WithError(err).Warningf("Given machine cidr %q is not valid", machineCidr)
-			return common.NewApiError(http.StatusBadRequest, err)
+	// move hosts to installing
+	for i := range cluster.Hosts {
+		if swag.StringValue(cluster.Hosts[i].Status) != models.HostStatusKnown {
+			continue
 		}
-		err, vipDhcpAllocation = setCommonUserNetworkManagedParams(params.ClusterUpdateParams, common.IsSingleNodeCluster(cluster), machineCidr, updates, log)
+		err = b.createAndUploadNodeIgnition(ctx, cluster, cluster.Hosts[i])
 		if err != nil {
-			return err
+			log.Errorf("Failed to upload ignition for host %s", cluster.Hosts[i].RequestedHostname)
+			continue
+		}
+		if installErr := b.hostApi.Install(ctx, cluster.Hosts[i], tx); installErr != nil {
+			// we just logs the error, each host install is independent
+			log.Errorf("Failed to move host %s to installing", cluster.Hosts[i].RequestedHostname)
 		}
 	}
 
-	if err = network.VerifyClusterCIDRsNotOverlap(machineCidr, clusterCidr, serviceCidr, userManagedNetworking); err != nil {
-		return common.NewApiError(http.StatusBadRequest, err)
-	}
-
-	if err = validations.ValidateVipDHCPAllocationWithIPv6(vipDhcpAllocation, machineCidr); err != nil {
-		return common.NewApiError(http.StatusBadRequest, err)
-	}
-
-	b.setUsage(vipDhcpAllocation, usage.VipDhcpAllocationUsage, nil, usages)
-	return nil
-}
-
-func setCommonUserNetworkManagedParams(params *models.ClusterUpdateParams, singleNodeCluster bool, machineCidr string, updates map[string]interface{}, log logrus.FieldLogger) (error, bool) {
-	err := validateUserManagedNetworkConflicts(params, singleNodeCluster, log)
+	err = tx.Commit().Error
 	if err != nil {
-		return err, false
+		log.Error(err)
+		return common.NewApiError(http.StatusInternalServerError, errors.New("DB error, failed to commit transaction"))
 	}
-	updates["vip_dhcp_allocation"] = false
-	updates["api_vip"] = ""
-	updates["ingress_vip"] = ""
+	txSuccess = true
 
-	setMachineNetworkCIDRForUpdate(updates, machineCidr)
-	return nil, false
+	return installer.NewInstallHostsAccepted().WithPayload(&cluster.Cluster)
 }
 
-func (b *bareMetalInventory) updateNtpSources(params installer.UpdateClusterParams, updates map[string]interface{}, usages map[string]models.Usage, log logrus.FieldLogger) error {
-	if params.ClusterUpdateParams.AdditionalNtpSource != nil {
-		ntpSource := swag.StringValue(params.ClusterUpdateParams.AdditionalNtpSource)
-		additionalNtpSourcesDefined := ntpSource != ""
+func (b *bareMetalInventory) setBootstrapHost(ctx context.Context, cluster common.Cluster, db *gorm.DB) error {
+	log := logutil.FromContext(ctx, b.log)
 
-		if additionalNtpSourcesDefined && !validations.ValidateAdditionalNTPSource(ntpSource) {
-			err := errors.Errorf("Invalid NTP source: %s", ntpSource)
-			log.WithError(err)
-			return common.NewApiError(http.StatusBadRequest, err)
+	// check if cluster already has bootstrap
+	for _, h := range cluster.Hosts {
+		if h.Bootstrap {
+			log.Infof("Bootstrap ID is %s", h.ID)
+			return nil
 		}
-		updates["additional_ntp_source"] = ntpSource
-
-		//if additional ntp sources are defined by the user, report usage of this feature
-		b.setUsage(additionalNtpSourcesDefined, usage.AdditionalNtpSourceUsage, &map[string]interface{}{
-			"source_count": len(strings.Split(ntpSource, ","))}, usages)
 	}
-	return nil
-}
 
-func validateUserManagedNetworkConflicts(params *models.ClusterUpdateParams, singleNodeCluster bool, log logrus.FieldLogger) error {
-	if params.VipDhcpAllocation != nil && swag.BoolValue(params.VipDhcpAllocation) {
-		err := errors.Errorf("VIP DHCP Allocation cannot be enabled with User Managed Networking")
-		log.WithError(err)
-		return common.NewApiError(http.StatusBadRequest, err)
-	}
-	if params.IngressVip != nil {
-		err := errors.Errorf("Ingress VIP cannot be set with User Managed Networking")
-		log.WithError(err)
-		return common.NewApiError(http.StatusBadRequest, err)
+	masterNodesIds, err := b.clusterApi.GetMasterNodesIds(ctx, &cluster, db)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s master node id's", cluster.ID)
+		return errors.Wrapf(err, "Failed to get cluster %s master node id's", cluster.ID)
 	}
-	if params.APIVip != nil {
-		err := errors.Errorf("API VIP cannot be set with User Managed Networking")
-		log.WithError(err)
-		return common.NewApiError(http.StatusBadRequest, err)
+	if len(masterNodesIds) == 0 {
+		return errors.Errorf("Cluster have no master hosts that can operate as bootstrap")
 	}
-	if params.MachineNetworkCidr != nil && !singleNodeCluster {
-		err := errors.Errorf("Machine Network CIDR cannot be set with User Managed Networking")
-		log.WithError(err)
-		return common.NewApiError(http.StatusBadRequest, err)
+	bootstrapId := masterNodesIds[len(masterNodesIds)-1]
+	log.Infof("Bootstrap ID is %s", bootstrapId)
+	for i := range cluster.Hosts {
+		if cluster.Hosts[i].ID.String() == bootstrapId.String() {
+			err = b.hostApi.SetBootstrap(ctx, cluster.Hosts[i], true, db)
+			if err != nil {
+				log.WithError(err).Errorf("failed to update bootstrap host for cluster %s", cluster.ID)
+				return errors.Wrapf(err, "Failed to update bootstrap host for cluster %s", cluster.ID)
+			}
+		}
 	}
 	return nil
 }
 
-func optionalParam(data *string, field string, updates map[string]interface{}) {
-	if data != nil {
-		updates[field] = swag.StringValue(data)
+func (b *bareMetalInventory) GetClusterInstallConfig(ctx context.Context, params installer.GetClusterInstallConfigParams) middleware.Responder {
+	c, err := b.getCluster(ctx, params.ClusterID.String())
+	if err != nil {
+		return common.GenerateErrorResponder(err)
 	}
-}
 
-func (b *bareMetalInventory) setUsage(enabled bool, name string, props *map[string]interface{}, usages map[string]models.Usage) {
-	if enabled {
-		b.usageApi.Add(usages, name, props)
-	} else {
-		b.usageApi.Remove(usages, name)
+	cfg, err := b.installConfigBuilder.GetInstallConfig(c, false, "")
+	if err != nil {
+		return common.GenerateErrorResponder(err)
 	}
+
+	return installer.NewGetClusterInstallConfigOK().WithPayload(string(cfg))
 }
 
-func (b *bareMetalInventory) setDefaultUsage(cluster *models.Cluster) {
-	usages := make(map[string]models.Usage)
-	b.setUsage(swag.BoolValue(cluster.VipDhcpAllocation), usage.VipDhcpAllocationUsage, nil, usages)
-	b.setUsage(cluster.AdditionalNtpSource != "", usage.AdditionalNtpSourceUsage, &map[string]interface{}{
-		"source_count": len(strings.Split(cluster.AdditionalNtpSource, ","))}, usages)
-	b.setUsage(swag.StringValue(cluster.HighAvailabilityMode) == models.ClusterHighAvailabilityModeNone,
-		usage.HighAvailabilityModeUsage, nil, usages)
-	b.setProxyUsage(&cluster.HTTPProxy, &cluster.HTTPProxy, &cluster.NoProxy, usages)
-	olmOperators := funk.Filter(cluster.MonitoredOperators, func(op *models.MonitoredOperator) bool {
-		return op != nil && op.OperatorType == models.OperatorTypeOlm
-	}).([]*models.MonitoredOperator)
-	b.setOperatorsUsage(olmOperators, []*models.MonitoredOperator{}, usages)
-	featusage, _ := json.Marshal(usages)
-	cluster.FeatureUsage = string(featusage)
+func (b *bareMetalInventory) GetClusterDefaultConfig(_ context.Context, _ installer.GetClusterDefaultConfigParams) middleware.Responder {
+	body := models.ClusterDefaultConfig{}
+
+	body.NtpSource = b.Config.DefaultNTPSource
+	body.ClusterNetworkCidr = b.Config.DefaultClusterNetworkCidr
+	body.ServiceNetworkCidr = b.Config.DefaultServiceNetworkCidr
+	body.ClusterNetworkHostPrefix = b.Config.DefaultClusterNetworkHostPrefix
+
+	return installer.NewGetClusterDefaultConfigOK().WithPayload(&body)
 }
 
-func (b *bareMetalInventory) setProxyUsage(httpProxy *string, httpsProxy *string, noProxy *string, usages map[string]models.Usage) {
-	props := map[string]interface{}{}
-	enabled := false
-	if swag.StringValue(httpProxy) != "" {
-		props["http_proxy"] = 1
-		enabled = true
+func (b *bareMetalInventory) UpdateClusterInstallConfig(ctx context.Context, params installer.UpdateClusterInstallConfigParams) middleware.Responder {
+	_, err := b.UpdateClusterInstallConfigInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
 	}
-	if swag.StringValue(httpsProxy) != "" {
-		props["https_proxy"] = 1
-		enabled = true
+	return installer.NewUpdateClusterInstallConfigCreated()
+}
+
+func (b *bareMetalInventory) UpdateClusterInstallConfigInternal(ctx context.Context, params installer.UpdateClusterInstallConfigParams) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster common.Cluster
+	query := "id = ?"
+
+	err := b.db.First(&cluster, query, params.ClusterID).Error
+	if err != nil {
+		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+		return nil, err
 	}
-	if swag.StringValue(noProxy) != "" {
-		props["no_proxy"] = 1
-		enabled = true
+
+	if err = b.installConfigBuilder.ValidateInstallConfigPatch(&cluster, params.InstallConfigParams); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
-	if enabled {
-		b.usageApi.Add(usages, usage.ProxyUsage, &props)
-	} else {
-		b.usageApi.Remove(usages, usage.ProxyUsage)
+
+	err = b.db.Model(&common.Cluster{}).Where(query, params.ClusterID).Update("install_config_overrides", params.InstallConfigParams).Error
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
+
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, "Custom install config was applied to the cluster", time.Now())
+	log.Infof("Custom install config was applied to cluster %s", params.ClusterID)
+	return &cluster, nil
 }
 
-func (b *bareMetalInventory) setOperatorsUsage(updateOLMOperators []*models.MonitoredOperator, removedOLMOperators []*models.MonitoredOperator, usages map[string]models.Usage) {
-	for _, operator := range updateOLMOperators {
-		b.usageApi.Add(usages, strings.ToUpper(operator.Name), nil)
+func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, cluster common.Cluster) error {
+	log := logutil.FromContext(ctx, b.log)
+
+	cfg, err := b.installConfigBuilder.GetInstallConfig(&cluster, b.Config.InstallRHCa, ignition.RedhatRootCA)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get install config for cluster %s", cluster.ID)
+		return errors.Wrapf(err, "failed to get install config for cluster %s", cluster.ID)
 	}
 
-	for _, operator := range removedOLMOperators {
-		b.usageApi.Remove(usages, strings.ToUpper(operator.Name))
+	releaseImage, err := b.versionsHandler.GetReleaseImage(cluster.OpenshiftVersion)
+
+	if err != nil {
+		log.WithError(err).Errorf("failed to get release image for cluster %s with openshift version %s", cluster.ID, cluster.OpenshiftVersion)
+		return errors.Wrapf(err, "failed to get release image for cluster %s with openshift version %s", cluster.ID, cluster.OpenshiftVersion)
+	}
+
+	if err := b.generator.GenerateInstallConfig(ctx, cluster, cfg, releaseImage); err != nil {
+		msg := fmt.Sprintf("failed generating install config for cluster %s", cluster.ID)
+		log.WithError(err).Error(msg)
+		return errors.Wrap(err, msg)
 	}
+
+	return nil
 }
 
-func (b *bareMetalInventory) updateHostRoles(ctx context.Context, params installer.UpdateClusterParams, db *gorm.DB, log logrus.FieldLogger) error {
-	for i := range params.ClusterUpdateParams.HostsRoles {
-		hostRole := params.ClusterUpdateParams.HostsRoles[i]
-		log.Infof("Update host %s to role: %s", hostRole.ID, hostRole.Role)
-		host, err := common.GetHostFromDB(db, params.ClusterID.String(), hostRole.ID.String())
-		if err != nil {
-			log.WithError(err).Errorf("failed to find host <%s> in cluster <%s>",
-				hostRole.ID, params.ClusterID)
+func (b *bareMetalInventory) refreshClusterHosts(ctx context.Context, cluster *common.Cluster, tx *gorm.DB, log logrus.FieldLogger) error {
+	err := b.setMajorityGroupForCluster(cluster.ID, tx)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to set cluster %s majority groups", cluster.ID.String())
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	// Cluster is retrieved from DB to make sure we operate on the most recent information regarding monitored operators
+	// enabled for the cluster.
+	dbCluster, err := common.GetClusterFromDB(tx, *cluster.ID, common.UseEagerLoading)
+	if err != nil {
+		log.WithError(err).Errorf("not refreshing cluster hosts - failed to find cluster %s", *cluster.ID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return common.NewApiError(http.StatusNotFound, err)
 		}
-		err = b.hostApi.UpdateRole(ctx, &host.Host, models.HostRole(hostRole.Role), db)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	for _, dbHost := range dbCluster.Hosts {
+		var err error
+
+		// Refresh inventory - especially disk eligibility. The host requirements might have changed.
+		// dbHost object might be updated with the latest disk eligibility information.
+		err = b.refreshInventory(ctx, dbCluster, dbHost, tx)
 		if err != nil {
-			log.WithError(err).Errorf("failed to set role <%s> host <%s> in cluster <%s>",
-				hostRole.Role, hostRole.ID,
-				params.ClusterID)
+			return err
+		}
+
+		if err = b.hostApi.RefreshStatus(ctx, dbHost, tx); err != nil {
+			log.WithError(err).Errorf("failed to refresh state of host %s cluster %s", *dbHost.ID, cluster.ID.String())
 			return common.NewApiError(http.StatusInternalServerError, err)
 		}
 	}
 	return nil
 }
 
-func (b *bareMetalInventory) updateHostNames(ctx context.Context, params installer.UpdateClusterParams, usages map[string]models.Usage, db *gorm.DB, log logrus.FieldLogger) error {
-	var host_count = 0
-	for i := range params.ClusterUpdateParams.HostsNames {
-		hostName := params.ClusterUpdateParams.HostsNames[i]
-		log.Infof("Update host %s to request hostname %s", hostName.ID,
-			hostName.Hostname)
-		host, err := common.GetHostFromDB(db, params.ClusterID.String(), hostName.ID.String())
-		if err != nil {
-			log.WithError(err).Errorf("failed to find host <%s> in cluster <%s>",
-				hostName.ID, params.ClusterID)
-			return common.NewApiError(http.StatusNotFound, err)
-		}
-		if err = hostutil.ValidateHostname(hostName.Hostname); err != nil {
-			log.WithError(err).Errorf("invalid hostname format: %s", err)
-			return err
-		}
-		err = b.hostApi.UpdateHostname(ctx, &host.Host, hostName.Hostname, db)
+func (b *bareMetalInventory) refreshInventory(ctx context.Context, cluster *common.Cluster, host *models.Host, db *gorm.DB) error {
+	log := logutil.FromContext(ctx, b.log)
+	if host.Inventory != "" {
+		err := b.hostApi.RefreshInventory(ctx, cluster, host, db)
 		if err != nil {
-			log.WithError(err).Errorf("failed to set hostname <%s> host <%s> in cluster <%s>",
-				hostName.Hostname, hostName.ID,
-				params.ClusterID)
-			return common.NewApiError(http.StatusConflict, err)
+			log.WithError(err).Errorf("failed to update inventory of host %s cluster %s", host.ID, cluster.ID.String())
+			switch err := err.(type) {
+			case *common.ApiErrorResponse:
+				if err.StatusCode() != http.StatusConflict {
+					return err
+				}
+				// In RefreshInventory there is a precondition on host's status that on failure returns StatusConflict.
+				// In case of cluster update we don't want to fail the whole update if host can't be, according to
+				// business rules, updated. An example of such case is disabled host.
+				log.Infof("ignoring wrong status error (%v) for host %s in cluster %s", err, host.ID, cluster.ID.String())
+			default:
+				return common.NewApiError(http.StatusInternalServerError, err)
+			}
 		}
-		host_count = host_count + 1
-		b.setUsage(true, usage.RequestedHostnameUsage,
-			&map[string]interface{}{"host_count": host_count}, usages)
 	}
 	return nil
 }
 
-func (b *bareMetalInventory) updateHostsDiskSelection(ctx context.Context, params installer.UpdateClusterParams, db *gorm.DB, log logrus.FieldLogger) error {
-	for i := range params.ClusterUpdateParams.DisksSelectedConfig {
-		disksConfig := params.ClusterUpdateParams.DisksSelectedConfig[i]
-		hostId := string(disksConfig.ID)
-		host, err := common.GetHostFromDB(db, params.ClusterID.String(), hostId)
-
-		if err != nil {
-			return common.NewApiError(http.StatusNotFound, err)
-		}
+func (b *bareMetalInventory) noneHaModeClusterUpdateValidations(cluster *common.Cluster, params installer.UpdateClusterParams) error {
+	if swag.StringValue(cluster.HighAvailabilityMode) != models.ClusterHighAvailabilityModeNone {
+		return nil
+	}
 
-		disksToInstallOn := funk.Filter(disksConfig.DisksConfig, func(diskConfigParams *models.DiskConfigParams) bool {
-			return models.DiskRoleInstall == diskConfigParams.Role
-		}).([]*models.DiskConfigParams)
+	if len(params.ClusterUpdateParams.HostsRoles) > 0 {
+		return errors.Errorf("setting host role is not allowed in single node mode")
+	}
+
+	if params.ClusterUpdateParams.UserManagedNetworking != nil && !swag.BoolValue(params.ClusterUpdateParams.UserManagedNetworking) {
+		return errors.Errorf("disabling UserManagedNetworking is not allowed in single node mode")
+	}
+
+	return nil
+}
+
+func (b *bareMetalInventory) validateAndUpdateClusterParams(ctx context.Context, params *installer.UpdateClusterParams) (installer.UpdateClusterParams, error) {
+
+	log := logutil.FromContext(ctx, b.log)
+
+	if swag.StringValue(params.ClusterUpdateParams.PullSecret) != "" {
+		if err := b.secretValidator.ValidatePullSecret(*params.ClusterUpdateParams.PullSecret, ocm.UserNameFromContext(ctx), b.authHandler); err != nil {
+			log.WithError(err).Errorf("Pull secret for cluster %s is invalid", params.ClusterID)
+			return installer.UpdateClusterParams{}, err
+		}
+		ps, errUpdate := b.updatePullSecret(*params.ClusterUpdateParams.PullSecret, log)
+		if errUpdate != nil {
+			return installer.UpdateClusterParams{}, errors.New("Failed to update Pull-secret with additional credentials")
+		}
+		params.ClusterUpdateParams.PullSecret = &ps
+	}
+
+	if swag.StringValue(params.ClusterUpdateParams.Name) != "" {
+		if err := validations.ValidateClusterNameFormat(*params.ClusterUpdateParams.Name); err != nil {
+			return installer.UpdateClusterParams{}, err
+		}
+	}
+
+	if swag.StringValue(params.ClusterUpdateParams.AdditionalTrustBundle) != "" {
+		if err := validations.ValidateAdditionalTrustBundle(*params.ClusterUpdateParams.AdditionalTrustBundle); err != nil {
+			return installer.UpdateClusterParams{}, err
+		}
+	}
+
+	if err := setPrimaryNetworksUpdateParams(params.ClusterUpdateParams); err != nil {
+		return installer.UpdateClusterParams{}, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if err := validations.ValidateIPAddressFamily(b.IPv6Support, params.ClusterUpdateParams.ClusterNetworkCidr, params.ClusterUpdateParams.ServiceNetworkCidr,
+		params.ClusterUpdateParams.MachineNetworkCidr, params.ClusterUpdateParams.APIVip, params.ClusterUpdateParams.IngressVip); err != nil {
+		return installer.UpdateClusterParams{}, common.NewApiError(http.StatusBadRequest, err)
+	}
+	for _, cidr := range getUpdateParamsNetworkCidrs(params.ClusterUpdateParams) {
+		if err := validations.ValidateIPAddressFamily(b.IPv6Support, swag.String(cidr)); err != nil {
+			return installer.UpdateClusterParams{}, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
+	if sshPublicKey := swag.StringValue(params.ClusterUpdateParams.SSHPublicKey); sshPublicKey != "" {
+		sshPublicKey = strings.TrimSpace(sshPublicKey)
+		if err := validations.ValidateSSHPublicKey(sshPublicKey); err != nil {
+			return installer.UpdateClusterParams{}, err
+		}
+		*params.ClusterUpdateParams.SSHPublicKey = sshPublicKey
+	}
+
+	return *params, nil
+}
+
+// setPrimaryNetworksUpdateParams copies the first network of each of the given network lists to the matching single
+// network CIDR param, so the rest of the update only has to handle the primary networks
+func setPrimaryNetworksUpdateParams(params *models.ClusterUpdateParams) error {
+	if params.ClusterNetworks != nil {
+		if len(params.ClusterNetworks) == 0 {
+			return errors.New("At least one cluster network is required")
+		}
+		primary := params.ClusterNetworks[0]
+		if params.ClusterNetworkCidr != nil && *params.ClusterNetworkCidr != primary.Cidr {
+			return errors.Errorf("Cluster network CIDR %s is different than the first cluster network %s", *params.ClusterNetworkCidr, primary.Cidr)
+		}
+		if params.ClusterNetworkHostPrefix != nil && *params.ClusterNetworkHostPrefix != primary.HostPrefix {
+			return errors.Errorf("Cluster network host prefix %d is different than the host prefix of the first cluster network %d",
+				*params.ClusterNetworkHostPrefix, primary.HostPrefix)
+		}
+		params.ClusterNetworkCidr = swag.String(primary.Cidr)
+		params.ClusterNetworkHostPrefix = swag.Int64(primary.HostPrefix)
+	}
+	if params.ServiceNetworks != nil {
+		if len(params.ServiceNetworks) == 0 {
+			return errors.New("At least one service network is required")
+		}
+		primary := params.ServiceNetworks[0]
+		if params.ServiceNetworkCidr != nil && *params.ServiceNetworkCidr != primary.Cidr {
+			return errors.Errorf("Service network CIDR %s is different than the first service network %s", *params.ServiceNetworkCidr, primary.Cidr)
+		}
+		params.ServiceNetworkCidr = swag.String(primary.Cidr)
+	}
+	if params.MachineNetworks != nil {
+		primaryCidr := ""
+		if len(params.MachineNetworks) > 0 {
+			primaryCidr = params.MachineNetworks[0].Cidr
+		}
+		if params.MachineNetworkCidr != nil && *params.MachineNetworkCidr != primaryCidr {
+			return errors.Errorf("Machine network CIDR %s is different than the first machine network %s", *params.MachineNetworkCidr, primaryCidr)
+		}
+		params.MachineNetworkCidr = swag.String(primaryCidr)
+	}
+	return nil
+}
+
+func getUpdateParamsNetworkCidrs(params *models.ClusterUpdateParams) []string {
+	ret := make([]string, 0)
+	for _, n := range params.ClusterNetworks {
+		ret = append(ret, n.Cidr)
+	}
+	for _, n := range params.ServiceNetworks {
+		ret = append(ret, n.Cidr)
+	}
+	for _, n := range params.MachineNetworks {
+		ret = append(ret, n.Cidr)
+	}
+	return ret
+}
+
+func (b *bareMetalInventory) validateAndUpdateProxyParams(ctx context.Context, params *installer.UpdateClusterParams, ocpVersion *string) (installer.UpdateClusterParams, error) {
+
+	log := logutil.FromContext(ctx, b.log)
+
+	if params.ClusterUpdateParams.HTTPProxy != nil &&
+		(params.ClusterUpdateParams.HTTPSProxy == nil || *params.ClusterUpdateParams.HTTPSProxy == "") {
+		params.ClusterUpdateParams.HTTPSProxy = params.ClusterUpdateParams.HTTPProxy
+	}
+
+	if err := validateProxySettings(params.ClusterUpdateParams.HTTPProxy,
+		params.ClusterUpdateParams.HTTPSProxy,
+		params.ClusterUpdateParams.NoProxy, ocpVersion); err != nil {
+		log.WithError(err).Errorf("Failed to validate Proxy settings")
+		return installer.UpdateClusterParams{}, err
+	}
+
+	return *params, nil
+}
+
+func (b *bareMetalInventory) UpdateCluster(ctx context.Context, params installer.UpdateClusterParams) middleware.Responder {
+	c, err := b.UpdateClusterInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewUpdateClusterCreated().WithPayload(&c.Cluster)
+}
+
+func (b *bareMetalInventory) UpdateClusterInternal(ctx context.Context, params installer.UpdateClusterParams) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster *common.Cluster
+	var err error
+	log.Infof("update cluster %s with params: %+v", params.ClusterID, params.ClusterUpdateParams)
+
+	if params, err = b.validateAndUpdateClusterParams(ctx, &params); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	txSuccess := false
+	tx := b.db.Begin()
+	defer func() {
+		if !txSuccess {
+			log.Error("update cluster failed")
+			tx.Rollback()
+		}
+		if r := recover(); r != nil {
+			log.Error("update cluster failed")
+			tx.Rollback()
+		}
+	}()
+
+	if tx.Error != nil {
+		log.WithError(tx.Error).Errorf("failed to start db transaction")
+		return nil, common.NewApiError(http.StatusInternalServerError,
+			errors.New("DB error, failed to start transaction"))
+	}
+
+	// in case host monitor already updated the state we need to use FOR UPDATE option
+	tx = transaction.AddForUpdateQueryOption(tx)
+
+	if cluster, err = common.GetClusterFromDB(tx, params.ClusterID, common.UseEagerLoading); err != nil {
+		log.WithError(err).Errorf("failed to get cluster: %s", params.ClusterID)
+		return nil, common.NewApiError(http.StatusNotFound, err)
+	}
+
+	if params, err = b.validateAndUpdateProxyParams(ctx, &params, &cluster.OpenshiftVersion); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if err = b.clusterApi.VerifyClusterUpdatability(cluster); err != nil {
+		log.WithError(err).Errorf("cluster %s can't be updated in current state", params.ClusterID)
+		return nil, common.NewApiError(http.StatusConflict, err)
+	}
+
+	if err = b.noneHaModeClusterUpdateValidations(cluster, params); err != nil {
+		log.WithError(err).Warnf("Unsupported update params in none ha mode")
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if err = b.validateDNSDomain(*cluster, params, log); err != nil {
+		return nil, err
+	}
+
+	usages, err := usage.Unmarshal(cluster.Cluster.FeatureUsage)
+	if err != nil {
+		log.WithError(err).Errorf("failed to read feature usage from cluster %s", params.ClusterID)
+		return nil, err
+	}
+
+	err = b.updateClusterData(ctx, cluster, params, usages, tx, log)
+	if err != nil {
+		log.WithError(err).Error("updateClusterData")
+		return nil, err
+	}
+
+	err = b.updateHostsData(ctx, params, usages, tx, log)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.updateOperatorsData(ctx, cluster, params, usages, tx, log)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.updateHostsAndClusterStatus(ctx, cluster, tx, log)
+	if err != nil {
+		return nil, err
+	}
+
+	b.usageApi.Save(tx, params.ClusterID, usages)
+
+	newClusterName := swag.StringValue(params.ClusterUpdateParams.Name)
+	if b.ocmClient != nil && b.ocmClient.Config.WithAMSSubscriptions && newClusterName != "" && newClusterName != cluster.Name {
+		if err = b.integrateWithAMSClusterUpdateName(ctx, cluster, *params.ClusterUpdateParams.Name); err != nil {
+			log.WithError(err).Errorf("Cluster %s failed to integrate with AMS on cluster update with new name %s", params.ClusterID, newClusterName)
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		log.Error(err)
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.Errorf("DB error, failed to commit"))
+	}
+	txSuccess = true
+
+	if proxySettingsChanged(params.ClusterUpdateParams, cluster) {
+		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, "Proxy settings changed", time.Now())
+	}
+
+	// The additional trust bundle is part of the discovery ignition, so the image must be generated again
+	if params.ClusterUpdateParams.AdditionalTrustBundle != nil && cluster.AdditionalTrustBundle != *params.ClusterUpdateParams.AdditionalTrustBundle {
+		var existed bool
+		if existed, err = b.releaseClusterImage(ctx, cluster); err != nil {
+			log.WithError(err).Errorf("failed to release the image of cluster %s", params.ClusterID)
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if existed {
+			b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo,
+				"Deleted image from backend because the additional trust bundle was updated. The image may be regenerated at any time.", time.Now())
+		}
+	}
+
+	if cluster, err = common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s after update", params.ClusterID)
+		return nil, err
+	}
+
+	cluster.HostNetworks = b.calculateHostNetworks(log, cluster)
+	for _, host := range cluster.Hosts {
+		if err := b.customizeHost(host); err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		// Clear this field as it is not needed to be sent via API
+		host.FreeAddresses = ""
+	}
+
+	return cluster, nil
+}
+
+func (b *bareMetalInventory) integrateWithAMSClusterUpdateName(ctx context.Context, cluster *common.Cluster, newClusterName string) error {
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("Updating AMS subscription for cluster %s with new name %s", *cluster.ID, newClusterName)
+	if err := b.ocmClient.AccountsMgmt.UpdateSubscriptionDisplayName(ctx, cluster.AmsSubscriptionID, newClusterName); err != nil {
+		log.WithError(err).Errorf("Failed to update AMS subscription with the new cluster name %s for cluster %s", newClusterName, *cluster.ID)
+		return err
+	}
+	return nil
+}
+
+func setMachineNetworkCIDRForUpdate(updates map[string]interface{}, machineNetworkCIDR string) {
+	updates["machine_network_cidr"] = machineNetworkCIDR
+	updates["machine_network_cidr_updated_at"] = time.Now()
+}
+
+func (b *bareMetalInventory) updateNonDhcpNetworkParams(updates map[string]interface{}, cluster *common.Cluster, params installer.UpdateClusterParams, log logrus.FieldLogger, _ *string) error {
+	apiVip := cluster.APIVip
+	ingressVip := cluster.IngressVip
+	if params.ClusterUpdateParams.APIVip != nil {
+		updates["api_vip"] = *params.ClusterUpdateParams.APIVip
+		apiVip = *params.ClusterUpdateParams.APIVip
+	}
+	if params.ClusterUpdateParams.IngressVip != nil {
+		updates["ingress_vip"] = *params.ClusterUpdateParams.IngressVip
+		ingressVip = *params.ClusterUpdateParams.IngressVip
+	}
+	if params.ClusterUpdateParams.MachineNetworkCidr != nil {
+		err := errors.New("Setting Machine network CIDR is forbidden when cluster is not in vip-dhcp-allocation mode")
+		log.WithError(err).Warnf("Set Machine Network CIDR")
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	var err error
+	err = verifyParsableVIPs(apiVip, ingressVip)
+	if err != nil {
+		log.WithError(err).Errorf("Failed validating VIPs of cluster id=%s", params.ClusterID)
+		return err
+	}
+
+	err = network.VerifyDifferentVipAddresses(apiVip, ingressVip)
+	if err != nil {
+		log.WithError(err).Errorf("VIP verification failed for cluster: %s", params.ClusterID)
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	return nil
+}
+
+func verifyParsableVIPs(apiVip string, ingressVip string) error {
+	if apiVip != "" && net.ParseIP(apiVip) == nil {
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf("Could not parse VIP ip %s", apiVip))
+	}
+	if ingressVip != "" && net.ParseIP(ingressVip) == nil {
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf("Could not parse VIP ip %s", ingressVip))
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) updateDhcpNetworkParams(updates map[string]interface{}, params installer.UpdateClusterParams, log logrus.FieldLogger, machineCidr *string) error {
+	if params.ClusterUpdateParams.APIVip != nil {
+		err := errors.New("Setting API VIP is forbidden when cluster is in vip-dhcp-allocation mode")
+		log.WithError(err).Warnf("Set API VIP")
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if params.ClusterUpdateParams.IngressVip != nil {
+		err := errors.New("Setting Ingress VIP is forbidden when cluster is in vip-dhcp-allocation mode")
+		log.WithError(err).Warnf("Set Ingress VIP")
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if params.ClusterUpdateParams.MachineNetworkCidr != nil &&
+		*machineCidr != swag.StringValue(params.ClusterUpdateParams.MachineNetworkCidr) {
+		*machineCidr = swag.StringValue(params.ClusterUpdateParams.MachineNetworkCidr)
+		setMachineNetworkCIDRForUpdate(updates, *machineCidr)
+		updates["api_vip"] = ""
+		updates["ingress_vip"] = ""
+		return network.VerifyMachineCIDR(swag.StringValue(params.ClusterUpdateParams.MachineNetworkCidr))
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *common.Cluster, params installer.UpdateClusterParams, usages map[string]models.Usage, db *gorm.DB, log logrus.FieldLogger) error {
+	var err error
+	updates := map[string]interface{}{}
+	optionalParam(params.ClusterUpdateParams.Name, "name", updates)
+	optionalParam(params.ClusterUpdateParams.BaseDNSDomain, "base_dns_domain", updates)
+	optionalParam(params.ClusterUpdateParams.HTTPProxy, "http_proxy", updates)
+	optionalParam(params.ClusterUpdateParams.HTTPSProxy, "https_proxy", updates)
+	optionalParam(params.ClusterUpdateParams.NoProxy, "no_proxy", updates)
+	optionalParam(params.ClusterUpdateParams.AdditionalTrustBundle, "additional_trust_bundle", updates)
+	optionalParam(params.ClusterUpdateParams.SSHPublicKey, "ssh_public_key", updates)
+	optionalParam(params.ClusterUpdateParams.Hyperthreading, "hyperthreading", updates)
+
+	b.setProxyUsage(params.ClusterUpdateParams.HTTPProxy, params.ClusterUpdateParams.HTTPSProxy, params.ClusterUpdateParams.NoProxy, usages)
+
+	if err = b.updateNetworkParams(params, cluster, updates, usages, db, log); err != nil {
+		return err
+	}
+
+	if err = b.updateNtpSources(params, updates, usages, log); err != nil {
+		return err
+	}
+
+	if params.ClusterUpdateParams.PullSecret != nil {
+		cluster.PullSecret = *params.ClusterUpdateParams.PullSecret
+		updates["pull_secret"] = *params.ClusterUpdateParams.PullSecret
+		if cluster.PullSecret != "" {
+			updates["pull_secret_set"] = true
+		} else {
+			updates["pull_secret_set"] = false
+		}
+	}
+
+	if params.ClusterUpdateParams.APIVipDNSName != nil {
+		if swag.StringValue(cluster.Kind) == models.ClusterKindAddHostsCluster {
+			log.Infof("Updating api vip to %s for day2 cluster %s", *params.ClusterUpdateParams.APIVipDNSName, cluster.ID)
+			updates["api_vip_dns_name"] = *params.ClusterUpdateParams.APIVipDNSName
+		} else {
+			msg := fmt.Sprintf("Can't update api vip to %s for day1 cluster %s", *params.ClusterUpdateParams.APIVipDNSName, cluster.ID)
+			log.Error(msg)
+			return common.NewApiError(http.StatusBadRequest, errors.Errorf(msg))
+		}
+	}
+
+	dbReply := db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Updates(updates)
+	if dbReply.Error != nil {
+		return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to update cluster: %s", params.ClusterID))
+	}
+
+	return nil
+}
+
+func (b *bareMetalInventory) updateNetworkParams(params installer.UpdateClusterParams, cluster *common.Cluster, updates map[string]interface{}, usages map[string]models.Usage, db *gorm.DB, log logrus.FieldLogger) error {
+	var err error
+	machineCidr := cluster.MachineNetworkCidr
+	serviceCidr := cluster.ServiceNetworkCidr
+	clusterCidr := cluster.ClusterNetworkCidr
+	hostNetworkPrefix := cluster.ClusterNetworkHostPrefix
+	vipDhcpAllocation := swag.BoolValue(cluster.VipDhcpAllocation)
+	userManagedNetworking := swag.BoolValue(cluster.UserManagedNetworking)
+
+	if params.ClusterUpdateParams.ClusterNetworkCidr != nil {
+		if err = network.VerifyClusterOrServiceCIDR(*params.ClusterUpdateParams.ClusterNetworkCidr); err != nil {
+			return common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "Cluster network CIDR"))
+		}
+		clusterCidr = *params.ClusterUpdateParams.ClusterNetworkCidr
+		updates["cluster_network_cidr"] = clusterCidr
+	}
+	if params.ClusterUpdateParams.ClusterNetworkHostPrefix != nil {
+		if err = network.VerifyNetworkHostPrefix(*params.ClusterUpdateParams.ClusterNetworkHostPrefix); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		hostNetworkPrefix = *params.ClusterUpdateParams.ClusterNetworkHostPrefix
+		updates["cluster_network_host_prefix"] = hostNetworkPrefix
+	}
+	if clusterCidr != "" {
+		err = network.VerifyClusterCidrSize(int(hostNetworkPrefix), clusterCidr, len(cluster.Hosts))
+		if err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+	if params.ClusterUpdateParams.ServiceNetworkCidr != nil {
+		if err = network.VerifyClusterOrServiceCIDR(*params.ClusterUpdateParams.ServiceNetworkCidr); err != nil {
+			return common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "Service network CIDR"))
+		}
+		serviceCidr = *params.ClusterUpdateParams.ServiceNetworkCidr
+		updates["service_network_cidr"] = serviceCidr
+	}
+
+	if params.ClusterUpdateParams.UserManagedNetworking != nil && swag.BoolValue(params.ClusterUpdateParams.UserManagedNetworking) != userManagedNetworking {
+		userManagedNetworking = swag.BoolValue(params.ClusterUpdateParams.UserManagedNetworking)
+		updates["user_managed_networking"] = userManagedNetworking
+		machineCidr = ""
+	}
+	if userManagedNetworking && !common.IsSingleNodeCluster(cluster) {
+		if params.ClusterUpdateParams.MachineNetworks != nil {
+			// The primary machine network was taken from the list of machine networks
+			machineCidr = swag.StringValue(params.ClusterUpdateParams.MachineNetworkCidr)
+		}
+		err, vipDhcpAllocation = setCommonUserNetworkManagedParams(params.ClusterUpdateParams, common.IsSingleNodeCluster(cluster), machineCidr, updates, log)
+		if err != nil {
+			return err
+		}
+	}
+
+	if params.ClusterUpdateParams.VipDhcpAllocation != nil && swag.BoolValue(params.ClusterUpdateParams.VipDhcpAllocation) != vipDhcpAllocation {
+		vipDhcpAllocation = swag.BoolValue(params.ClusterUpdateParams.VipDhcpAllocation)
+		updates["vip_dhcp_allocation"] = vipDhcpAllocation
+		updates["api_vip"] = ""
+		updates["ingress_vip"] = ""
+		machineCidr = ""
+		setMachineNetworkCIDRForUpdate(updates, machineCidr)
+	}
+	if !userManagedNetworking {
+		if vipDhcpAllocation {
+			err = b.updateDhcpNetworkParams(updates, params, log, &machineCidr)
+		} else {
+			err = b.updateNonDhcpNetworkParams(updates, cluster, params, log, &machineCidr)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if params.ClusterUpdateParams.MachineNetworkCidr != nil && common.IsSingleNodeCluster(cluster) {
+		machineCidr = swag.StringValue(params.ClusterUpdateParams.MachineNetworkCidr)
+		if err = network.VerifyMachineCIDR(machineCidr); err != nil {
+			log.WithError(err).Warningf("Given machine cidr %q is not valid", machineCidr)
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		err, vipDhcpAllocation = setCommonUserNetworkManagedParams(params.ClusterUpdateParams, common.IsSingleNodeCluster(cluster), machineCidr, updates, log)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = network.VerifyClusterCIDRsNotOverlap(machineCidr, clusterCidr, serviceCidr, userManagedNetworking); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if err = validations.ValidateVipDHCPAllocationWithIPv6(vipDhcpAllocation, machineCidr); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if err = b.updateNetworkLists(params, cluster, clusterCidr, hostNetworkPrefix, serviceCidr, machineCidr, userManagedNetworking, db); err != nil {
+		return err
+	}
+
+	b.setUsage(vipDhcpAllocation, usage.VipDhcpAllocationUsage, nil, usages)
+	return nil
+}
+
+// updateNetworkLists replaces the primary network of each of the network lists of the cluster with the updated single
+// network CIDR, or replaces the whole list when it is given, and stores the lists
+func (b *bareMetalInventory) updateNetworkLists(params installer.UpdateClusterParams, cluster *common.Cluster, clusterCidr string,
+	hostNetworkPrefix int64, serviceCidr, machineCidr string, userManagedNetworking bool, db *gorm.DB) error {
+	clusterNetworks := params.ClusterUpdateParams.ClusterNetworks
+	if clusterNetworks == nil {
+		clusterNetworks = []*models.ClusterNetwork{{Cidr: clusterCidr, HostPrefix: hostNetworkPrefix}}
+		if len(cluster.ClusterNetworks) > 1 {
+			clusterNetworks = append(clusterNetworks, cluster.ClusterNetworks[1:]...)
+		}
+	} else if err := network.VerifyClusterNetworks(clusterNetworks, len(cluster.Hosts)); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	serviceNetworks := params.ClusterUpdateParams.ServiceNetworks
+	if serviceNetworks == nil {
+		serviceNetworks = []*models.ServiceNetwork{{Cidr: serviceCidr}}
+		if len(cluster.ServiceNetworks) > 1 {
+			serviceNetworks = append(serviceNetworks, cluster.ServiceNetworks[1:]...)
+		}
+	} else if err := network.VerifyServiceNetworks(serviceNetworks); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	machineNetworks := params.ClusterUpdateParams.MachineNetworks
+	if machineNetworks == nil {
+		// A cleared primary machine network, e.g. due to a change of the networking mode, clears the others as well
+		if machineCidr != "" {
+			machineNetworks = []*models.MachineNetwork{{Cidr: machineCidr}}
+			if machineCidr == cluster.MachineNetworkCidr && len(cluster.MachineNetworks) > 1 {
+				machineNetworks = append(machineNetworks, cluster.MachineNetworks[1:]...)
+			}
+		}
+	} else if len(machineNetworks) > 0 {
+		verifyMachineNetworks := network.VerifyMachineNetworks
+		if userManagedNetworking && !common.IsSingleNodeCluster(cluster) {
+			verifyMachineNetworks = network.VerifyUserManagedMachineNetworks
+		}
+		if err := verifyMachineNetworks(machineNetworks); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
+	machineCidrs := make([]string, 0, len(machineNetworks))
+	for _, n := range machineNetworks {
+		machineCidrs = append(machineCidrs, n.Cidr)
+	}
+	clusterCidrs := make([]string, 0, len(clusterNetworks))
+	for _, n := range clusterNetworks {
+		clusterCidrs = append(clusterCidrs, n.Cidr)
+	}
+	serviceCidrs := make([]string, 0, len(serviceNetworks))
+	for _, n := range serviceNetworks {
+		serviceCidrs = append(serviceCidrs, n.Cidr)
+	}
+	if err := network.VerifyDualStackNetworks(machineCidrs, clusterCidrs, serviceCidrs); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err := network.VerifyDualStackCIDRsNotOverlap(machineCidrs, clusterCidrs, serviceCidrs, userManagedNetworking); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	cluster.ClusterNetworks, cluster.ServiceNetworks, cluster.MachineNetworks = clusterNetworks, serviceNetworks, machineNetworks
+	if err := common.SetNetworks(db, &cluster.Cluster, common.NetworkTables[:]...); err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
+func setCommonUserNetworkManagedParams(params *models.ClusterUpdateParams, singleNodeCluster bool, machineCidr string, updates map[string]interface{}, log logrus.FieldLogger) (error, bool) {
+	err := validateUserManagedNetworkConflicts(params, singleNodeCluster, log)
+	if err != nil {
+		return err, false
+	}
+	updates["vip_dhcp_allocation"] = false
+	updates["api_vip"] = ""
+	updates["ingress_vip"] = ""
+
+	setMachineNetworkCIDRForUpdate(updates, machineCidr)
+	return nil, false
+}
+
+func (b *bareMetalInventory) updateNtpSources(params installer.UpdateClusterParams, updates map[string]interface{}, usages map[string]models.Usage, log logrus.FieldLogger) error {
+	if params.ClusterUpdateParams.AdditionalNtpSource != nil {
+		ntpSource := swag.StringValue(params.ClusterUpdateParams.AdditionalNtpSource)
+		additionalNtpSourcesDefined := ntpSource != ""
+
+		if additionalNtpSourcesDefined && !validations.ValidateAdditionalNTPSource(ntpSource) {
+			err := errors.Errorf("Invalid NTP source: %s", ntpSource)
+			log.WithError(err)
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		updates["additional_ntp_source"] = ntpSource
+
+		//if additional ntp sources are defined by the user, report usage of this feature
+		b.setUsage(additionalNtpSourcesDefined, usage.AdditionalNtpSourceUsage, &map[string]interface{}{
+			"source_count": len(strings.Split(ntpSource, ","))}, usages)
+	}
+	return nil
+}
+
+func validateUserManagedNetworkConflicts(params *models.ClusterUpdateParams, singleNodeCluster bool, log logrus.FieldLogger) error {
+	if params.VipDhcpAllocation != nil && swag.BoolValue(params.VipDhcpAllocation) {
+		err := errors.Errorf("VIP DHCP Allocation cannot be enabled with User Managed Networking")
+		log.WithError(err)
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if params.IngressVip != nil {
+		err := errors.Errorf("Ingress VIP cannot be set with User Managed Networking")
+		log.WithError(err)
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if params.APIVip != nil {
+		err := errors.Errorf("API VIP cannot be set with User Managed Networking")
+		log.WithError(err)
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if params.MachineNetworkCidr != nil && params.MachineNetworks == nil && !singleNodeCluster {
+		err := errors.Errorf("Machine Network CIDR cannot be set with User Managed Networking")
+		log.WithError(err)
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	return nil
+}
+
+func optionalParam(data *string, field string, updates map[string]interface{}) {
+	if data != nil {
+		updates[field] = swag.StringValue(data)
+	}
+}
+
+func (b *bareMetalInventory) setUsage(enabled bool, name string, props *map[string]interface{}, usages map[string]models.Usage) {
+	if enabled {
+		b.usageApi.Add(usages, name, props)
+	} else {
+		b.usageApi.Remove(usages, name)
+	}
+}
+
+func (b *bareMetalInventory) setDefaultUsage(cluster *models.Cluster) {
+	usages := make(map[string]models.Usage)
+	b.setUsage(swag.BoolValue(cluster.VipDhcpAllocation), usage.VipDhcpAllocationUsage, nil, usages)
+	b.setUsage(cluster.AdditionalNtpSource != "", usage.AdditionalNtpSourceUsage, &map[string]interface{}{
+		"source_count": len(strings.Split(cluster.AdditionalNtpSource, ","))}, usages)
+	b.setUsage(swag.StringValue(cluster.HighAvailabilityMode) == models.ClusterHighAvailabilityModeNone,
+		usage.HighAvailabilityModeUsage, nil, usages)
+	b.setProxyUsage(&cluster.HTTPProxy, &cluster.HTTPProxy, &cluster.NoProxy, usages)
+	olmOperators := funk.Filter(cluster.MonitoredOperators, func(op *models.MonitoredOperator) bool {
+		return op != nil && op.OperatorType == models.OperatorTypeOlm
+	}).([]*models.MonitoredOperator)
+	b.setOperatorsUsage(olmOperators, []*models.MonitoredOperator{}, usages)
+	featusage, _ := json.Marshal(usages)
+	cluster.FeatureUsage = string(featusage)
+}
+
+func (b *bareMetalInventory) setProxyUsage(httpProxy *string, httpsProxy *string, noProxy *string, usages map[string]models.Usage) {
+	props := map[string]interface{}{}
+	enabled := false
+	if swag.StringValue(httpProxy) != "" {
+		props["http_proxy"] = 1
+		enabled = true
+	}
+	if swag.StringValue(httpsProxy) != "" {
+		props["https_proxy"] = 1
+		enabled = true
+	}
+	if swag.StringValue(noProxy) != "" {
+		props["no_proxy"] = 1
+		enabled = true
+	}
+	if enabled {
+		b.usageApi.Add(usages, usage.ProxyUsage, &props)
+	} else {
+		b.usageApi.Remove(usages, usage.ProxyUsage)
+	}
+}
+
+func (b *bareMetalInventory) setOperatorsUsage(updateOLMOperators []*models.MonitoredOperator, removedOLMOperators []*models.MonitoredOperator, usages map[string]models.Usage) {
+	for _, operator := range updateOLMOperators {
+		b.usageApi.Add(usages, strings.ToUpper(operator.Name), nil)
+	}
+
+	for _, operator := range removedOLMOperators {
+		b.usageApi.Remove(usages, strings.ToUpper(operator.Name))
+	}
+}
+
+func (b *bareMetalInventory) updateHostRoles(ctx context.Context, params installer.UpdateClusterParams, db *gorm.DB, log logrus.FieldLogger) error {
+	for i := range params.ClusterUpdateParams.HostsRoles {
+		hostRole := params.ClusterUpdateParams.HostsRoles[i]
+		log.Infof("Update host %s to role: %s", hostRole.ID, hostRole.Role)
+		host, err := common.GetHostFromDB(db, params.ClusterID.String(), hostRole.ID.String())
+		if err != nil {
+			log.WithError(err).Errorf("failed to find host <%s> in cluster <%s>",
+				hostRole.ID, params.ClusterID)
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		err = b.hostApi.UpdateRole(ctx, &host.Host, models.HostRole(hostRole.Role), db)
+		if err != nil {
+			log.WithError(err).Errorf("failed to set role <%s> host <%s> in cluster <%s>",
+				hostRole.Role, hostRole.ID,
+				params.ClusterID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) updateHostNames(ctx context.Context, params installer.UpdateClusterParams, usages map[string]models.Usage, db *gorm.DB, log logrus.FieldLogger) error {
+	var host_count = 0
+	for i := range params.ClusterUpdateParams.HostsNames {
+		hostName := params.ClusterUpdateParams.HostsNames[i]
+		log.Infof("Update host %s to request hostname %s", hostName.ID,
+			hostName.Hostname)
+		host, err := common.GetHostFromDB(db, params.ClusterID.String(), hostName.ID.String())
+		if err != nil {
+			log.WithError(err).Errorf("failed to find host <%s> in cluster <%s>",
+				hostName.ID, params.ClusterID)
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		if err = hostutil.ValidateHostname(hostName.Hostname); err != nil {
+			log.WithError(err).Errorf("invalid hostname format: %s", err)
+			return err
+		}
+		err = b.hostApi.UpdateHostname(ctx, &host.Host, hostName.Hostname, db)
+		if err != nil {
+			log.WithError(err).Errorf("failed to set hostname <%s> host <%s> in cluster <%s>",
+				hostName.Hostname, hostName.ID,
+				params.ClusterID)
+			return common.NewApiError(http.StatusConflict, err)
+		}
+		host_count = host_count + 1
+		b.setUsage(true, usage.RequestedHostnameUsage,
+			&map[string]interface{}{"host_count": host_count}, usages)
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) updateHostsDiskSelection(ctx context.Context, params installer.UpdateClusterParams, db *gorm.DB, log logrus.FieldLogger) error {
+	for i := range params.ClusterUpdateParams.DisksSelectedConfig {
+		disksConfig := params.ClusterUpdateParams.DisksSelectedConfig[i]
+		hostId := string(disksConfig.ID)
+		host, err := common.GetHostFromDB(db, params.ClusterID.String(), hostId)
+
+		if err != nil {
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+
+		disksToInstallOn := funk.Filter(disksConfig.DisksConfig, func(diskConfigParams *models.DiskConfigParams) bool {
+			return models.DiskRoleInstall == diskConfigParams.Role
+		}).([]*models.DiskConfigParams)
 
 		installationDiskId := ""
 
-		if len(disksToInstallOn) > 1 {
-			return common.NewApiError(http.StatusConflict, errors.New("duplicate setting of installation path by the user"))
-		} else if len(disksToInstallOn) == 1 {
-			installationDiskId = *disksToInstallOn[0].ID
+		if len(disksToInstallOn) > 1 {
+			return common.NewApiError(http.StatusConflict, errors.New("duplicate setting of installation path by the user"))
+		} else if len(disksToInstallOn) == 1 {
+			installationDiskId = *disksToInstallOn[0].ID
+		}
+
+		log.Infof("Update host %s to install from disk id %s", hostId, installationDiskId)
+		err = b.hostApi.UpdateInstallationDisk(ctx, db, &host.Host, installationDiskId)
+		if err != nil {
+			log.WithError(err).Errorf("failed to set installation disk path <%s> host <%s> in cluster <%s>",
+				installationDiskId,
+				hostId,
+				params.ClusterID)
+			return common.NewApiError(http.StatusConflict, err)
+		}
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) updateHostsMachineConfigPoolNames(ctx context.Context, params installer.UpdateClusterParams, db *gorm.DB, log logrus.FieldLogger) error {
+	for i := range params.ClusterUpdateParams.HostsMachineConfigPoolNames {
+		poolNameConfig := params.ClusterUpdateParams.HostsMachineConfigPoolNames[i]
+		log.Infof("Update host %s to machineConfigPoolName %s", poolNameConfig.ID,
+			poolNameConfig.MachineConfigPoolName)
+		host, err := common.GetHostFromDB(db, params.ClusterID.String(), poolNameConfig.ID.String())
+
+		if err != nil {
+			log.WithError(err).Errorf("failed to find host <%s> in cluster <%s>",
+				poolNameConfig.ID, params.ClusterID)
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		err = b.hostApi.UpdateMachineConfigPoolName(ctx, db, &host.Host, poolNameConfig.MachineConfigPoolName)
+		if err != nil {
+			log.WithError(err).Errorf("failed to set machine config pool name <%s> host <%s> in cluster <%s>",
+				poolNameConfig.MachineConfigPoolName, poolNameConfig.ID,
+				params.ClusterID)
+			return common.NewApiError(http.StatusConflict, err)
+		}
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) updateHostsData(ctx context.Context, params installer.UpdateClusterParams, usages map[string]models.Usage, db *gorm.DB, log logrus.FieldLogger) error {
+	if err := b.updateHostRoles(ctx, params, db, log); err != nil {
+		return err
+	}
+
+	if err := b.updateHostNames(ctx, params, usages, db, log); err != nil {
+		return err
+	}
+
+	if err := b.updateHostsDiskSelection(ctx, params, db, log); err != nil {
+		return err
+	}
+
+	if err := b.updateHostsMachineConfigPoolNames(ctx, params, db, log); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *bareMetalInventory) updateOperatorsData(_ context.Context, cluster *common.Cluster, params installer.UpdateClusterParams, usages map[string]models.Usage, db *gorm.DB, log logrus.FieldLogger) error {
+	if params.ClusterUpdateParams.OlmOperators == nil {
+		return nil
+	}
+
+	updateOLMOperators, err := b.getOLMOperators(params.ClusterUpdateParams.OlmOperators)
+	if err != nil {
+		return err
+	}
+
+	for _, updatedOperator := range updateOLMOperators {
+		updatedOperator.ClusterID = *cluster.ID
+		if err = db.Save(updatedOperator).Error; err != nil {
+			err = errors.Wrapf(err, "failed to update operator %s of cluster %s", updatedOperator.Name, params.ClusterID)
+			log.Error(err)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
+	// After we aligned to the new update OLM info, we need to delete the old OLMs remainders that are still connected to the cluster
+	var removedOLMOperators []*models.MonitoredOperator
+	for _, clusterOperator := range cluster.MonitoredOperators {
+		if clusterOperator.OperatorType != models.OperatorTypeOlm {
+			continue
 		}
 
-		log.Infof("Update host %s to install from disk id %s", hostId, installationDiskId)
-		err = b.hostApi.UpdateInstallationDisk(ctx, db, &host.Host, installationDiskId)
-		if err != nil {
-			log.WithError(err).Errorf("failed to set installation disk path <%s> host <%s> in cluster <%s>",
-				installationDiskId,
-				hostId,
-				params.ClusterID)
-			return common.NewApiError(http.StatusConflict, err)
+		if !operators.IsEnabled(updateOLMOperators, clusterOperator.Name) {
+			removedOLMOperators = append(removedOLMOperators, clusterOperator)
+			if err = db.Where("name = ? and cluster_id = ?", clusterOperator.Name, params.ClusterID).Delete(&models.MonitoredOperator{}).Error; err != nil {
+				err = errors.Wrapf(err, "failed to delete operator %s of cluster %s", clusterOperator.Name, params.ClusterID)
+				log.Error(err)
+				return common.NewApiError(http.StatusInternalServerError, err)
+			}
 		}
 	}
+	b.setOperatorsUsage(updateOLMOperators, removedOLMOperators, usages)
+
 	return nil
 }
 
-func (b *bareMetalInventory) updateHostsMachineConfigPoolNames(ctx context.Context, params installer.UpdateClusterParams, db *gorm.DB, log logrus.FieldLogger) error {
-	for i := range params.ClusterUpdateParams.HostsMachineConfigPoolNames {
-		poolNameConfig := params.ClusterUpdateParams.HostsMachineConfigPoolNames[i]
-		log.Infof("Update host %s to machineConfigPoolName %s", poolNameConfig.ID,
-			poolNameConfig.MachineConfigPoolName)
-		host, err := common.GetHostFromDB(db, params.ClusterID.String(), poolNameConfig.ID.String())
+func (b *bareMetalInventory) getOLMOperators(newOperators []*models.OperatorCreateParams) ([]*models.MonitoredOperator, error) {
+	monitoredOperators := make([]*models.MonitoredOperator, 0)
 
+	for _, newOperator := range newOperators {
+		operator, err := b.operatorManagerApi.GetOperatorByName(newOperator.Name)
 		if err != nil {
-			log.WithError(err).Errorf("failed to find host <%s> in cluster <%s>",
-				poolNameConfig.ID, params.ClusterID)
-			return common.NewApiError(http.StatusNotFound, err)
+			return nil, common.NewApiError(http.StatusBadRequest, err)
 		}
-		err = b.hostApi.UpdateMachineConfigPoolName(ctx, db, &host.Host, poolNameConfig.MachineConfigPoolName)
+		operator.Properties = newOperator.Properties
+
+		monitoredOperators = append(monitoredOperators, operator)
+	}
+
+	return b.operatorManagerApi.ResolveDependencies(monitoredOperators)
+}
+
+func (b *bareMetalInventory) updateHostsAndClusterStatus(ctx context.Context, cluster *common.Cluster, db *gorm.DB, log logrus.FieldLogger) error {
+	err := b.refreshClusterHosts(ctx, cluster, db, log)
+	if err != nil {
+		return err
+	}
+
+	if _, err = b.clusterApi.RefreshStatus(ctx, cluster, db); err != nil {
+		log.WithError(err).Errorf("failed to validate or update cluster %s state", cluster.ID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	return nil
+}
+
+func (b *bareMetalInventory) calculateHostNetworks(log logrus.FieldLogger, cluster *common.Cluster) []*models.HostNetwork {
+	cidrHostsMap := make(map[string]map[strfmt.UUID]bool)
+	for _, h := range cluster.Hosts {
+		if h.Inventory == "" {
+			continue
+		}
+		var inventory models.Inventory
+		err := json.Unmarshal([]byte(h.Inventory), &inventory)
 		if err != nil {
-			log.WithError(err).Errorf("failed to set machine config pool name <%s> host <%s> in cluster <%s>",
-				poolNameConfig.MachineConfigPoolName, poolNameConfig.ID,
-				params.ClusterID)
+			log.WithError(err).Warnf("Could not parse inventory of host %s", *h.ID)
+			continue
+		}
+		for _, intf := range inventory.Interfaces {
+			var addrRange []string
+			if b.Config.IPv6Support {
+				addrRange = append(intf.IPV4Addresses, intf.IPV6Addresses...)
+			} else {
+				addrRange = intf.IPV4Addresses
+			}
+			for _, address := range addrRange {
+				_, ipnet, err := net.ParseCIDR(address)
+				if err != nil {
+					log.WithError(err).Warnf("Could not parse CIDR %s", address)
+					continue
+				}
+				cidr := ipnet.String()
+				uuidSet, ok := cidrHostsMap[cidr]
+				if !ok {
+					uuidSet = make(map[strfmt.UUID]bool)
+				}
+				uuidSet[*h.ID] = true
+				cidrHostsMap[cidr] = uuidSet
+			}
+		}
+	}
+	ret := make([]*models.HostNetwork, 0)
+	for k, v := range cidrHostsMap {
+		slice := make([]strfmt.UUID, 0)
+		for k := range v {
+			slice = append(slice, k)
+		}
+		ret = append(ret, &models.HostNetwork{
+			Cidr:    k,
+			HostIds: slice,
+		})
+	}
+	return ret
+}
+
+func (b *bareMetalInventory) ListClusters(ctx context.Context, params installer.ListClustersParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	db := b.db
+	if swag.BoolValue(params.GetUnregisteredClusters) {
+		if !identity.IsAdmin(ctx) {
+			return installer.NewListClustersForbidden().WithPayload(common.GenerateInfraError(
+				http.StatusForbidden, errors.New("only admin users are allowed to get unregistered clusters")))
+		}
+		db = db.Unscoped()
+	}
+	var dbClusters []*common.Cluster
+	var clusters []*models.Cluster
+	whereCondition := identity.AddUserFilter(ctx, "")
+
+	if params.OpenshiftClusterID != nil {
+		whereCondition += fmt.Sprintf(" AND openshift_cluster_id = '%s'", *params.OpenshiftClusterID)
+	}
+
+	if len(params.AmsSubscriptionIds) > 0 {
+		whereCondition += fmt.Sprintf(" AND ams_subscription_id IN %s", common.ToSqlList(params.AmsSubscriptionIds))
+	}
+
+	dbClusters, err := common.GetClustersFromDBWhere(db, common.UseEagerLoading,
+		common.DeleteRecordsState(swag.BoolValue(params.GetUnregisteredClusters)), whereCondition)
+	if err != nil {
+		log.WithError(err).Error("Failed to list clusters in db")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	// we need to fetch Hosts association to allow AfterFind hook to run
+	for _, c := range dbClusters {
+		c.Hosts = []*models.Host{}
+		clusters = append(clusters, &c.Cluster)
+	}
+	return installer.NewListClustersOK().WithPayload(clusters)
+}
+
+func (b *bareMetalInventory) GetCluster(ctx context.Context, params installer.GetClusterParams) middleware.Responder {
+	c, err := b.GetClusterInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewGetClusterOK().WithPayload(&c.Cluster)
+}
+
+func (b *bareMetalInventory) GetClusterInternal(ctx context.Context, params installer.GetClusterParams) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	if swag.BoolValue(params.GetUnregisteredClusters) {
+		if !identity.IsAdmin(ctx) {
+			return nil, common.NewInfraError(http.StatusForbidden,
+				errors.New("only admin users are allowed to get unregistered clusters"))
+		}
+	}
+
+	cluster, err := common.GetClusterFromDBWhere(b.db, common.UseEagerLoading,
+		common.DeleteRecordsState(swag.BoolValue(params.GetUnregisteredClusters)), "id = ?", params.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster.HostNetworks = b.calculateHostNetworks(log, cluster)
+	for _, host := range cluster.Hosts {
+		if err := b.customizeHost(host); err != nil {
+			return nil, err
+		}
+		// Clear this field as it is not needed to be sent via API
+		host.FreeAddresses = ""
+	}
+	return cluster, nil
+}
+
+func (b *bareMetalInventory) RegisterHost(ctx context.Context, params installer.RegisterHostParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster common.Cluster
+	log.Infof("Register host: %+v", params)
+
+	txSuccess := false
+	tx := b.db.Begin()
+	tx = transaction.AddForUpdateQueryOption(tx)
+	defer func() {
+		if !txSuccess {
+			log.Error("RegisterHost failed")
+			tx.Rollback()
+		}
+		if r := recover(); r != nil {
+			log.Error("RegisterHost failed")
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.First(&cluster, "id = ?", params.ClusterID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster: %s", params.ClusterID.String())
+		return common.GenerateErrorResponder(err)
+	}
+
+	_, err := common.GetHostFromDB(tx, params.ClusterID.String(), params.NewHostParams.HostID.String())
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.WithError(err).Errorf("failed to get host %s in cluster: %s",
+			*params.NewHostParams.HostID, params.ClusterID.String())
+		return installer.NewRegisterHostInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	// In case host doesn't exists check if the cluster accept new hosts registration
+	newHost := err != nil && errors.Is(err, gorm.ErrRecordNotFound)
+	if newHost {
+		if err = b.clusterApi.AcceptRegistration(&cluster); err != nil {
+			log.WithError(err).Errorf("failed to register host <%s> to cluster %s due to: %s",
+				params.NewHostParams.HostID, params.ClusterID.String(), err.Error())
+			b.eventsHandler.AddEvent(ctx, params.ClusterID, params.NewHostParams.HostID, models.EventSeverityError,
+				err.Error(), time.Now())
 			return common.NewApiError(http.StatusConflict, err)
 		}
 	}
-	return nil
-}
 
-func (b *bareMetalInventory) updateHostsData(ctx context.Context, params installer.UpdateClusterParams, usages map[string]models.Usage, db *gorm.DB, log logrus.FieldLogger) error {
-	if err := b.updateHostRoles(ctx, params, db, log); err != nil {
-		return err
+	url := installer.GetHostURL{ClusterID: params.ClusterID, HostID: *params.NewHostParams.HostID}
+	kind := swag.String(models.HostKindHost)
+	if swag.StringValue(cluster.Kind) == models.ClusterKindAddHostsCluster {
+		kind = swag.String(models.HostKindAddToExistingClusterHost)
+	}
+
+	// We immediately set the role to master in single node clusters to have more strict (master) validations.
+	// Typically, the validations are "weak" because an auto-assign host has the potential to only be a worker,
+	// which has less strict hardware requirements. This early role assignment results in clearer, more early
+	// errors for the user in case of insufficient hardware. In the future, single-node clusters might support
+	// extra nodes (as workers). In that case, this line might need to be removed.
+	defaultRole := models.HostRoleAutoAssign
+	if common.IsSingleNodeCluster(&cluster) {
+		defaultRole = models.HostRoleMaster
+	}
+
+	host := &models.Host{
+		ID:                    params.NewHostParams.HostID,
+		Href:                  swag.String(url.String()),
+		Kind:                  kind,
+		ClusterID:             params.ClusterID,
+		CheckedInAt:           strfmt.DateTime(time.Now()),
+		DiscoveryAgentVersion: params.NewHostParams.DiscoveryAgentVersion,
+		UserName:              ocm.UserNameFromContext(ctx),
+		Role:                  defaultRole,
+	}
+
+	if bindingToken := hostbinding.FromContext(ctx); bindingToken != "" {
+		if err = bindDeclaredHost(tx, &cluster, host, bindingToken, newHost); err != nil {
+			log.WithError(err).Errorf("failed to bind host <%s> to a declared host", params.NewHostParams.HostID)
+			return common.GenerateErrorResponder(err)
+		}
+	}
+
+	if err = b.hostApi.RegisterHost(ctx, host, tx); err != nil {
+		log.WithError(err).Errorf("failed to register host <%s> cluster <%s>",
+			params.NewHostParams.HostID.String(), params.ClusterID.String())
+		uerr := errors.Wrap(err, fmt.Sprintf("Failed to register host %s", hostutil.GetHostnameForMsg(host)))
+		b.eventsHandler.AddEvent(ctx, params.ClusterID, params.NewHostParams.HostID, models.EventSeverityError,
+			uerr.Error(), time.Now())
+		return returnRegisterHostTransitionError(http.StatusBadRequest, err)
 	}
 
-	if err := b.updateHostNames(ctx, params, usages, db, log); err != nil {
-		return err
+	if err = b.customizeHost(host); err != nil {
+		b.eventsHandler.AddEvent(ctx, params.ClusterID, params.NewHostParams.HostID, models.EventSeverityError,
+			"Failed to register host: error setting host properties", time.Now())
+		return common.GenerateErrorResponder(err)
 	}
 
-	if err := b.updateHostsDiskSelection(ctx, params, db, log); err != nil {
-		return err
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, params.NewHostParams.HostID, models.EventSeverityInfo,
+		fmt.Sprintf("Host %s: registered to cluster", hostutil.GetHostnameForMsg(host)), time.Now())
+
+	hostRegistration := models.HostRegistrationResponse{
+		Host:                  *host,
+		NextStepRunnerCommand: b.generateNextStepRunnerCommand(ctx, &params),
 	}
 
-	if err := b.updateHostsMachineConfigPoolNames(ctx, params, db, log); err != nil {
-		return err
+	if err := tx.Commit().Error; err != nil {
+		log.Error(err)
+		return installer.NewRegisterHostInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
 
-	return nil
+	if err := b.crdUtils.CreateAgentCR(ctx, log, params.NewHostParams.HostID.String(), cluster.KubeKeyNamespace, cluster.KubeKeyName); err != nil {
+		log.WithError(err).Errorf("Fail to create Agent CR. Namespace: %s, Cluster: %s, HostID: %s", cluster.KubeKeyNamespace, cluster.KubeKeyName, params.NewHostParams.HostID.String())
+		return installer.NewRegisterHostInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	txSuccess = true
+
+	return installer.NewRegisterHostCreated().WithPayload(&hostRegistration)
 }
 
-func (b *bareMetalInventory) updateOperatorsData(_ context.Context, cluster *common.Cluster, params installer.UpdateClusterParams, usages map[string]models.Usage, db *gorm.DB, log logrus.FieldLogger) error {
-	if params.ClusterUpdateParams.OlmOperators == nil {
-		return nil
-	}
+func (b *bareMetalInventory) generateNextStepRunnerCommand(ctx context.Context, params *installer.RegisterHostParams) *models.HostRegistrationResponseAO1NextStepRunnerCommand {
 
-	updateOLMOperators, err := b.getOLMOperators(params.ClusterUpdateParams.OlmOperators)
-	if err != nil {
-		return err
+	currentImageTag := extractImageTag(b.AgentDockerImg)
+	if params.NewHostParams.DiscoveryAgentVersion != currentImageTag {
+		log := logutil.FromContext(ctx, b.log)
+		log.Infof("Host %s in cluster %s has outdated agent image %s, updating to %s",
+			params.NewHostParams.HostID.String(), params.ClusterID.String(), params.NewHostParams.DiscoveryAgentVersion, currentImageTag)
 	}
 
-	for _, updatedOperator := range updateOLMOperators {
-		updatedOperator.ClusterID = *cluster.ID
-		if err = db.Save(updatedOperator).Error; err != nil {
-			err = errors.Wrapf(err, "failed to update operator %s of cluster %s", updatedOperator.Name, params.ClusterID)
-			log.Error(err)
-			return common.NewApiError(http.StatusInternalServerError, err)
-		}
+	config := hostcommands.NextStepRunnerConfig{
+		ServiceBaseURL:       b.ServiceBaseURL,
+		ClusterID:            params.ClusterID.String(),
+		HostID:               params.NewHostParams.HostID.String(),
+		UseCustomCACert:      b.ServiceCACertPath != "",
+		NextStepRunnerImage:  b.AgentDockerImg,
+		SkipCertVerification: b.SkipCertVerification,
+	}
+	command, args := hostcommands.GetNextStepRunnerCommand(&config)
+	return &models.HostRegistrationResponseAO1NextStepRunnerCommand{
+		Command: command,
+		Args:    *args,
 	}
+}
 
-	// After we aligned to the new update OLM info, we need to delete the old OLMs remainders that are still connected to the cluster
-	var removedOLMOperators []*models.MonitoredOperator
-	for _, clusterOperator := range cluster.MonitoredOperators {
-		if clusterOperator.OperatorType != models.OperatorTypeOlm {
-			continue
-		}
+func extractImageTag(fullName string) string {
+	suffix := strings.Split(fullName, ":")
+	return suffix[len(suffix)-1]
+}
 
-		if !operators.IsEnabled(updateOLMOperators, clusterOperator.Name) {
-			removedOLMOperators = append(removedOLMOperators, clusterOperator)
-			if err = db.Where("name = ? and cluster_id = ?", clusterOperator.Name, params.ClusterID).Delete(&models.MonitoredOperator{}).Error; err != nil {
-				err = errors.Wrapf(err, "failed to delete operator %s of cluster %s", clusterOperator.Name, params.ClusterID)
-				log.Error(err)
-				return common.NewApiError(http.StatusInternalServerError, err)
-			}
-		}
+func returnRegisterHostTransitionError(
+	defaultCode int32,
+	err error) middleware.Responder {
+	if isRegisterHostForbiddenDueWrongBootOrder(err) {
+		return installer.NewRegisterHostForbidden().WithPayload(
+			&models.InfraError{
+				Code:    swag.Int32(http.StatusForbidden),
+				Message: swag.String(err.Error()),
+			})
 	}
-	b.setOperatorsUsage(updateOLMOperators, removedOLMOperators, usages)
+	return common.NewApiError(defaultCode, err)
+}
 
-	return nil
+func isRegisterHostForbiddenDueWrongBootOrder(err error) bool {
+	if serr, ok := err.(*common.ApiErrorResponse); ok {
+		return serr.StatusCode() == http.StatusForbidden
+	}
+	return false
 }
 
-func (b *bareMetalInventory) getOLMOperators(newOperators []*models.OperatorCreateParams) ([]*models.MonitoredOperator, error) {
-	monitoredOperators := make([]*models.MonitoredOperator, 0)
+func (b *bareMetalInventory) DeregisterHost(ctx context.Context, params installer.DeregisterHostParams) middleware.Responder {
+	if err := b.DeregisterHostInternal(ctx, params); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewDeregisterHostNoContent()
+}
 
-	for _, newOperator := range newOperators {
-		operator, err := b.operatorManagerApi.GetOperatorByName(newOperator.Name)
-		if err != nil {
-			return nil, common.NewApiError(http.StatusBadRequest, err)
-		}
-		operator.Properties = newOperator.Properties
+func (b *bareMetalInventory) DeregisterHostInternal(ctx context.Context, params installer.DeregisterHostParams) error {
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("Deregister host: %s cluster %s", params.HostID, params.ClusterID)
 
-		monitoredOperators = append(monitoredOperators, operator)
+	if err := b.db.Where("id = ? and cluster_id = ?", params.HostID, params.ClusterID).Delete(&common.Host{}).Error; err != nil {
+		// TODO: check error type
+		return common.NewApiError(http.StatusBadRequest, err)
 	}
 
-	return b.operatorManagerApi.ResolveDependencies(monitoredOperators)
+	// TODO: need to check that host can be deleted from the cluster
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityInfo,
+		fmt.Sprintf("Host %s: deregistered from cluster", params.HostID.String()), time.Now())
+	return nil
 }
 
-func (b *bareMetalInventory) updateHostsAndClusterStatus(ctx context.Context, cluster *common.Cluster, db *gorm.DB, log logrus.FieldLogger) error {
-	err := b.refreshClusterHosts(ctx, cluster, db, log)
+func (b *bareMetalInventory) GetHost(_ context.Context, params installer.GetHostParams) middleware.Responder {
+	// TODO: validate what is the error
+	host, err := common.GetHostFromDB(b.db, params.ClusterID.String(), params.HostID.String())
+
 	if err != nil {
-		return err
+		return installer.NewGetHostNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
 	}
 
-	if _, err = b.clusterApi.RefreshStatus(ctx, cluster, db); err != nil {
-		log.WithError(err).Errorf("failed to validate or update cluster %s state", cluster.ID)
-		return common.NewApiError(http.StatusInternalServerError, err)
+	if err := b.customizeHost(&host.Host); err != nil {
+		return common.GenerateErrorResponder(err)
 	}
 
-	return nil
+	// Clear this field as it is not needed to be sent via API
+	host.FreeAddresses = ""
+	return installer.NewGetHostOK().WithPayload(&host.Host)
 }
 
-func (b *bareMetalInventory) calculateHostNetworks(log logrus.FieldLogger, cluster *common.Cluster) []*models.HostNetwork {
-	cidrHostsMap := make(map[string]map[strfmt.UUID]bool)
-	for _, h := range cluster.Hosts {
-		if h.Inventory == "" {
-			continue
-		}
-		var inventory models.Inventory
-		err := json.Unmarshal([]byte(h.Inventory), &inventory)
-		if err != nil {
-			log.WithError(err).Warnf("Could not parse inventory of host %s", *h.ID)
-			continue
-		}
-		for _, intf := range inventory.Interfaces {
-			var addrRange []string
-			if b.Config.IPv6Support {
-				addrRange = append(intf.IPV4Addresses, intf.IPV6Addresses...)
-			} else {
-				addrRange = intf.IPV4Addresses
-			}
-			for _, address := range addrRange {
-				_, ipnet, err := net.ParseCIDR(address)
-				if err != nil {
-					log.WithError(err).Warnf("Could not parse CIDR %s", address)
-					continue
-				}
-				cidr := ipnet.String()
-				uuidSet, ok := cidrHostsMap[cidr]
-				if !ok {
-					uuidSet = make(map[strfmt.UUID]bool)
-				}
-				uuidSet[*h.ID] = true
-				cidrHostsMap[cidr] = uuidSet
-			}
-		}
+func (b *bareMetalInventory) GetHostById(hostId string) (*common.Host, error) {
+	host, err := common.GetHostByIdFromDB(b.db, hostId)
+	if err != nil {
+		return nil, err
 	}
-	ret := make([]*models.HostNetwork, 0)
-	for k, v := range cidrHostsMap {
-		slice := make([]strfmt.UUID, 0)
-		for k := range v {
-			slice = append(slice, k)
-		}
-		ret = append(ret, &models.HostNetwork{
-			Cidr:    k,
-			HostIds: slice,
-		})
+
+	if err = b.customizeHost(&host.Host); err != nil {
+		return nil, err
 	}
-	return ret
+
+	return host, nil
 }
 
-func (b *bareMetalInventory) ListClusters(ctx context.Context, params installer.ListClustersParams) middleware.Responder {
+func (b *bareMetalInventory) ListHosts(ctx context.Context, params installer.ListHostsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
-	db := b.db
-	if swag.BoolValue(params.GetUnregisteredClusters) {
-		if !identity.IsAdmin(ctx) {
-			return installer.NewListClustersForbidden().WithPayload(common.GenerateInfraError(
-				http.StatusForbidden, errors.New("only admin users are allowed to get unregistered clusters")))
-		}
-		db = db.Unscoped()
+	var hosts []*models.Host
+	if err := b.db.Find(&hosts, "cluster_id = ?", params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get list of hosts for cluster %s", params.ClusterID)
+		return installer.NewListHostsInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
-	var dbClusters []*common.Cluster
-	var clusters []*models.Cluster
-	whereCondition := identity.AddUserFilter(ctx, "")
 
-	if params.OpenshiftClusterID != nil {
-		whereCondition += fmt.Sprintf(" AND openshift_cluster_id = '%s'", *params.OpenshiftClusterID)
+	for _, host := range hosts {
+		if err := b.customizeHost(host); err != nil {
+			return common.GenerateErrorResponder(err)
+		}
+		// Clear this field as it is not needed to be sent via API
+		host.FreeAddresses = ""
 	}
 
-	if len(params.AmsSubscriptionIds) > 0 {
-		whereCondition += fmt.Sprintf(" AND ams_subscription_id IN %s", common.ToSqlList(params.AmsSubscriptionIds))
-	}
+	return installer.NewListHostsOK().WithPayload(hosts)
+}
 
-	dbClusters, err := common.GetClustersFromDBWhere(db, common.UseEagerLoading,
-		common.DeleteRecordsState(swag.BoolValue(params.GetUnregisteredClusters)), whereCondition)
-	if err != nil {
-		log.WithError(err).Error("Failed to list clusters in db")
-		return common.NewApiError(http.StatusInternalServerError, err)
-	}
+func (b *bareMetalInventory) UpdateHostInstallerArgsInternal(ctx context.Context, params installer.UpdateHostInstallerArgsParams) (*models.Host, error) {
 
-	// we need to fetch Hosts association to allow AfterFind hook to run
-	for _, c := range dbClusters {
-		c.Hosts = []*models.Host{}
-		clusters = append(clusters, &c.Cluster)
+	log := logutil.FromContext(ctx, b.log)
+
+	err := hostutil.ValidateInstallerArgs(params.InstallerArgsParams.Args)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
-	return installer.NewListClustersOK().WithPayload(clusters)
-}
 
-func (b *bareMetalInventory) GetCluster(ctx context.Context, params installer.GetClusterParams) middleware.Responder {
-	c, err := b.GetClusterInternal(ctx, params)
+	h, err := b.getHost(ctx, params.ClusterID.String(), params.HostID.String())
 	if err != nil {
-		return common.GenerateErrorResponder(err)
+		return nil, err
 	}
-	return installer.NewGetClusterOK().WithPayload(&c.Cluster)
-}
 
-func (b *bareMetalInventory) GetClusterInternal(ctx context.Context, params installer.GetClusterParams) (*common.Cluster, error) {
-	log := logutil.FromContext(ctx, b.log)
+	argsBytes, err := json.Marshal(params.InstallerArgsParams.Args)
+	if err != nil {
+		return nil, err
+	}
 
-	if swag.BoolValue(params.GetUnregisteredClusters) {
-		if !identity.IsAdmin(ctx) {
-			return nil, common.NewInfraError(http.StatusForbidden,
-				errors.New("only admin users are allowed to get unregistered clusters"))
-		}
+	err = b.db.Model(&common.Host{}).Where(identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), params.HostID, params.ClusterID).Update("installer_args", string(argsBytes)).Error
+	if err != nil {
+		log.WithError(err).Errorf("failed to update host %s", params.HostID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	cluster, err := common.GetClusterFromDBWhere(b.db, common.UseEagerLoading,
-		common.DeleteRecordsState(swag.BoolValue(params.GetUnregisteredClusters)), "id = ?", params.ClusterID)
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityInfo, fmt.Sprintf("Host %s: custom installer arguments were applied", hostutil.GetHostnameForMsg(&h.Host)), time.Now())
+	log.Infof("Custom installer arguments were applied to host %s in cluster %s", params.HostID, params.ClusterID)
+
+	h, err = b.getHost(ctx, params.ClusterID.String(), params.HostID.String())
 	if err != nil {
-		return nil, err
+		log.WithError(err).Errorf("failed to get host %s after update", params.HostID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	cluster.HostNetworks = b.calculateHostNetworks(log, cluster)
-	for _, host := range cluster.Hosts {
-		if err := b.customizeHost(host); err != nil {
-			return nil, err
-		}
-		// Clear this field as it is not needed to be sent via API
-		host.FreeAddresses = ""
+	return &h.Host, nil
+}
+
+func (b *bareMetalInventory) UpdateHostInstallerArgs(ctx context.Context, params installer.UpdateHostInstallerArgsParams) middleware.Responder {
+	updatedHost, err := b.UpdateHostInstallerArgsInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
 	}
-	return cluster, nil
+	return installer.NewUpdateHostInstallerArgsCreated().WithPayload(updatedHost)
 }
 
-func (b *bareMetalInventory) RegisterHost(ctx context.Context, params installer.RegisterHostParams) middleware.Responder {
+func (b *bareMetalInventory) GetNextSteps(ctx context.Context, params installer.GetNextStepsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
-	var cluster common.Cluster
-	log.Infof("Register host: %+v", params)
+	var steps models.Steps
 
 	txSuccess := false
 	tx := b.db.Begin()
-	tx = transaction.AddForUpdateQueryOption(tx)
 	defer func() {
 		if !txSuccess {
-			log.Error("RegisterHost failed")
+			log.Error("get next steps failed")
 			tx.Rollback()
 		}
 		if r := recover(); r != nil {
-			log.Error("RegisterHost failed")
+			log.Error("get next steps failed")
 			tx.Rollback()
 		}
 	}()
 
-	if err := tx.First(&cluster, "id = ?", params.ClusterID.String()).Error; err != nil {
-		log.WithError(err).Errorf("failed to get cluster: %s", params.ClusterID.String())
-		return common.GenerateErrorResponder(err)
+	if tx.Error != nil {
+		log.WithError(tx.Error).Errorf("failed to start db transaction")
+		return installer.NewUpdateClusterInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, errors.New("DB error, failed to start transaction")))
 	}
 
-	_, err := common.GetHostFromDB(tx, params.ClusterID.String(), params.NewHostParams.HostID.String())
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		log.WithError(err).Errorf("failed to get host %s in cluster: %s",
-			*params.NewHostParams.HostID, params.ClusterID.String())
-		return installer.NewRegisterHostInternalServerError().
-			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	//TODO check the error type
+	host, err := common.GetHostFromDB(tx, params.ClusterID.String(), params.HostID.String())
+	if err != nil {
+		log.WithError(err).Errorf("failed to find host: %s", params.HostID)
+		return installer.NewGetNextStepsNotFound().
+			WithPayload(common.GenerateError(http.StatusNotFound, err))
 	}
 
-	// In case host doesn't exists check if the cluster accept new hosts registration
-	if err != nil && errors.Is(err, gorm.ErrRecordNotFound) {
-		if err = b.clusterApi.AcceptRegistration(&cluster); err != nil {
-			log.WithError(err).Errorf("failed to register host <%s> to cluster %s due to: %s",
-				params.NewHostParams.HostID, params.ClusterID.String(), err.Error())
-			b.eventsHandler.AddEvent(ctx, params.ClusterID, params.NewHostParams.HostID, models.EventSeverityError,
-				err.Error(), time.Now())
-			return common.NewApiError(http.StatusConflict, err)
-		}
+	host.CheckedInAt = strfmt.DateTime(time.Now())
+	if err = tx.Model(&host).UpdateColumn("checked_in_at", host.CheckedInAt).Error; err != nil {
+		log.WithError(err).Errorf("failed to update host: %s", params.ClusterID)
+		return installer.NewGetNextStepsInternalServerError()
 	}
 
-	url := installer.GetHostURL{ClusterID: params.ClusterID, HostID: *params.NewHostParams.HostID}
-	kind := swag.String(models.HostKindHost)
-	if swag.StringValue(cluster.Kind) == models.ClusterKindAddHostsCluster {
-		kind = swag.String(models.HostKindAddToExistingClusterHost)
+	if err = tx.Commit().Error; err != nil {
+		log.Error(err)
+		return installer.NewGetNextStepsInternalServerError()
 	}
+	txSuccess = true
 
-	// We immediately set the role to master in single node clusters to have more strict (master) validations.
-	// Typically, the validations are "weak" because an auto-assign host has the potential to only be a worker,
-	// which has less strict hardware requirements. This early role assignment results in clearer, more early
-	// errors for the user in case of insufficient hardware. In the future, single-node clusters might support
-	// extra nodes (as workers). In that case, this line might need to be removed.
-	defaultRole := models.HostRoleAutoAssign
-	if common.IsSingleNodeCluster(&cluster) {
-		defaultRole = models.HostRoleMaster
+	steps, err = b.hostApi.GetNextSteps(ctx, &host.Host)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get steps for host %s cluster %s", params.HostID, params.ClusterID)
 	}
 
-	host := &models.Host{
-		ID:                    params.NewHostParams.HostID,
-		Href:                  swag.String(url.String()),
-		Kind:                  kind,
-		ClusterID:             params.ClusterID,
-		CheckedInAt:           strfmt.DateTime(time.Now()),
-		DiscoveryAgentVersion: params.NewHostParams.DiscoveryAgentVersion,
-		UserName:              ocm.UserNameFromContext(ctx),
-		Role:                  defaultRole,
+	return installer.NewGetNextStepsOK().WithPayload(&steps)
+}
+
+func shouldHandle(params installer.PostStepReplyParams) bool {
+	switch params.Reply.StepType {
+	case models.StepTypeInstallationDiskSpeedCheck, models.StepTypeContainerImageAvailability:
+		/*
+		   In case that the command sent 0 length output is should not be handled.  When disk speed check takes a long time,
+		   we don't want to run 2 such commands concurrently.  The prior running disk-speed-check, there is a verification
+		   that such command is not already running.  If it does, then the command returns immediately without running
+		   disk-speed-check.
+		   TODO Maybe do the same for other commands as well.
+		*/
+		return len(params.Reply.Output) > 0
 	}
+	return true
+}
 
-	if err = b.hostApi.RegisterHost(ctx, host, tx); err != nil {
-		log.WithError(err).Errorf("failed to register host <%s> cluster <%s>",
-			params.NewHostParams.HostID.String(), params.ClusterID.String())
-		uerr := errors.Wrap(err, fmt.Sprintf("Failed to register host %s", hostutil.GetHostnameForMsg(host)))
-		b.eventsHandler.AddEvent(ctx, params.ClusterID, params.NewHostParams.HostID, models.EventSeverityError,
-			uerr.Error(), time.Now())
-		return returnRegisterHostTransitionError(http.StatusBadRequest, err)
+func (b *bareMetalInventory) PostStepReply(ctx context.Context, params installer.PostStepReplyParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	host, err := common.GetHostFromDB(b.db, params.ClusterID.String(), params.HostID.String())
+
+	if err != nil {
+		log.WithError(err).Errorf("Failed to find host <%s> cluster <%s> step <%s> exit code %d stdout <%s> stderr <%s>",
+			params.HostID, params.ClusterID, params.Reply.StepID, params.Reply.ExitCode, params.Reply.Output, params.Reply.Error)
+		return installer.NewPostStepReplyNotFound().
+			WithPayload(common.GenerateError(http.StatusNotFound, err))
 	}
 
-	if err = b.customizeHost(host); err != nil {
-		b.eventsHandler.AddEvent(ctx, params.ClusterID, params.NewHostParams.HostID, models.EventSeverityError,
-			"Failed to register host: error setting host properties", time.Now())
-		return common.GenerateErrorResponder(err)
+	logReplyReceived(params, log)
+
+	if params.Reply.ExitCode != 0 {
+		handlingError := b.handleReplyError(params, ctx, log, &host.Host, params.Reply.ExitCode)
+		if handlingError != nil {
+			log.WithError(handlingError).Errorf("Failed handling reply error for host <%s> cluster <%s>", params.HostID, params.ClusterID)
+		}
+		return installer.NewPostStepReplyNoContent()
 	}
 
-	b.eventsHandler.AddEvent(ctx, params.ClusterID, params.NewHostParams.HostID, models.EventSeverityInfo,
-		fmt.Sprintf("Host %s: registered to cluster", hostutil.GetHostnameForMsg(host)), time.Now())
+	if !shouldHandle(params) {
+		return installer.NewPostStepReplyNoContent()
+	}
 
-	hostRegistration := models.HostRegistrationResponse{
-		Host:                  *host,
-		NextStepRunnerCommand: b.generateNextStepRunnerCommand(ctx, &params),
+	stepReply, err := filterReplyByType(params)
+	if err != nil {
+		log.WithError(err).Errorf("Failed decode <%s> reply for host <%s> cluster <%s>",
+			params.Reply.StepID, params.HostID, params.ClusterID)
+		return installer.NewPostStepReplyBadRequest().
+			WithPayload(common.GenerateError(http.StatusBadRequest, err))
 	}
 
-	if err := tx.Commit().Error; err != nil {
-		log.Error(err)
-		return installer.NewRegisterHostInternalServerError().
+	err = handleReplyByType(params, b, ctx, host.Host, stepReply)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to update step reply for host <%s> cluster <%s> step <%s>",
+			params.HostID, params.ClusterID, params.Reply.StepID)
+		return installer.NewPostStepReplyInternalServerError().
 			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
+	b.hostApi.RecordStepSuccess(ctx, &host.Host, params.Reply.StepType, params.Reply.StepID)
 
-	if err := b.crdUtils.CreateAgentCR(ctx, log, params.NewHostParams.HostID.String(), cluster.KubeKeyNamespace, cluster.KubeKeyName); err != nil {
-		log.WithError(err).Errorf("Fail to create Agent CR. Namespace: %s, Cluster: %s, HostID: %s", cluster.KubeKeyNamespace, cluster.KubeKeyName, params.NewHostParams.HostID.String())
-		return installer.NewRegisterHostInternalServerError().
-			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	return installer.NewPostStepReplyNoContent()
+}
+
+func (b *bareMetalInventory) handleReplyError(params installer.PostStepReplyParams, ctx context.Context, log logrus.FieldLogger, h *models.Host, exitCode int64) error {
+	if exitCode == MediaDisconnected {
+		if err := b.handleMediaDisconnection(params, ctx, log, h); err != nil {
+			return err
+		}
+	}
+
+	switch params.Reply.StepType {
+	case models.StepTypeInstall:
+		// Handle case of installation error due to an already running assisted-installer.
+		if params.Reply.ExitCode == ContainerAlreadyRunningExitCode && strings.Contains(params.Reply.Error, "the container name \"assisted-installer\" is already in use") {
+			log.Warnf("Install command failed due to an already running installation: %s", params.Reply.Error)
+			return nil
+		}
+		//if it's install step - need to move host to error
+		return b.hostApi.HandleInstallationFailure(ctx, h)
+	case models.StepTypeInstallationDiskSpeedCheck:
+		stepReply, err := filterReplyByType(params)
+		if err != nil {
+			return err
+		}
+		return b.processDiskSpeedCheckResponse(ctx, h, stepReply, exitCode)
+	case models.StepTypeUpgradeAgent:
+		return b.hostApi.UpdateAgentUpgradeStatus(ctx, h, &models.UpgradeAgentResponse{
+			AgentImage: b.AgentDockerImg,
+			Result:     models.UpgradeAgentResultFailure,
+		}, b.db)
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) handleMediaDisconnection(params installer.PostStepReplyParams, ctx context.Context, log logrus.FieldLogger, h *models.Host) error {
+	statusInfo := fmt.Sprintf("%s - %s", string(models.HostStageFailed),
+		"Cannot read from the media (ISO) - media was likely disconnected")
+
+	// Install command reports its status with a different API, directly from the assisted-installer.
+	// Just adding our diagnose to the existing error message.
+	if swag.StringValue(h.Status) == models.HostStatusError && h.StatusInfo != nil {
+		// Add the message only once
+		if strings.Contains(*h.StatusInfo, statusInfo) {
+			return nil
+		}
+
+		statusInfo = fmt.Sprintf("%s. %s", statusInfo, *h.StatusInfo)
+	} else if params.Reply.Error != "" {
+		statusInfo = fmt.Sprintf("%s. %s", statusInfo, params.Reply.Error)
+	}
+
+	_, err := hostutil.UpdateHostStatus(ctx, log, b.db, b.eventsHandler, h.ClusterID, *h.ID,
+		swag.StringValue(h.Status), models.HostStatusError, statusInfo)
+
+	return err
+}
+
+func (b *bareMetalInventory) updateFreeAddressesReport(ctx context.Context, host *models.Host, freeAddressesReport string) error {
+	var (
+		err           error
+		freeAddresses models.FreeNetworksAddresses
+	)
+	log := logutil.FromContext(ctx, b.log)
+	log.Debugf("Free addresses for host %s are: %s", host.ID.String(), freeAddresses)
+
+	if err = json.Unmarshal([]byte(freeAddressesReport), &freeAddresses); err != nil {
+		log.WithError(err).Warnf("Json unmarshal free addresses of host %s", host.ID.String())
+		return err
+	}
+	if len(freeAddresses) == 0 {
+		err = errors.Errorf("Free addresses for host %s is empty", host.ID.String())
+		log.WithError(err).Warn("Update free addresses")
+		return err
+	}
+	if err = b.db.Model(&common.Host{}).Where("id = ? and cluster_id = ?", host.ID.String(),
+		host.ClusterID.String()).UpdateColumn("free_addresses", freeAddressesReport).Error; err != nil {
+		log.WithError(err).Warnf("Update free addresses of host %s", host.ID.String())
+		return err
 	}
-
-	txSuccess = true
-
-	return installer.NewRegisterHostCreated().WithPayload(&hostRegistration)
+	// Gorm sets the number of changed rows in AffectedRows and not the number of matched rows.  Therefore, if the report hasn't changed
+	// from the previous report, the AffectedRows will be 0 but it will still be correct.  So no error reporting needed for AffectedRows == 0
+	return nil
 }
 
-func (b *bareMetalInventory) generateNextStepRunnerCommand(ctx context.Context, params *installer.RegisterHostParams) *models.HostRegistrationResponseAO1NextStepRunnerCommand {
-
-	currentImageTag := extractImageTag(b.AgentDockerImg)
-	if params.NewHostParams.DiscoveryAgentVersion != currentImageTag {
-		log := logutil.FromContext(ctx, b.log)
-		log.Infof("Host %s in cluster %s has outdated agent image %s, updating to %s",
-			params.NewHostParams.HostID.String(), params.ClusterID.String(), params.NewHostParams.DiscoveryAgentVersion, currentImageTag)
+func (b *bareMetalInventory) processDhcpAllocationResponse(ctx context.Context, host *models.Host, dhcpAllocationResponseStr string) error {
+	var (
+		err                   error
+		dhcpAllocationReponse models.DhcpAllocationResponse
+		cluster               common.Cluster
+	)
+	log := logutil.FromContext(ctx, b.log)
+	if err = b.db.Take(&cluster, "id = ?", host.ClusterID.String()).Error; err != nil {
+		log.WithError(err).Warnf("Get cluster %s", host.ClusterID.String())
+		return err
 	}
-
-	config := hostcommands.NextStepRunnerConfig{
-		ServiceBaseURL:       b.ServiceBaseURL,
-		ClusterID:            params.ClusterID.String(),
-		HostID:               params.NewHostParams.HostID.String(),
-		UseCustomCACert:      b.ServiceCACertPath != "",
-		NextStepRunnerImage:  b.AgentDockerImg,
-		SkipCertVerification: b.SkipCertVerification,
+	if !swag.BoolValue(cluster.VipDhcpAllocation) {
+		err = errors.Errorf("DHCP not enabled in cluster %s", host.ClusterID.String())
+		log.WithError(err).Warn("processDhcpAllocationResponse")
+		return err
 	}
-	command, args := hostcommands.GetNextStepRunnerCommand(&config)
-	return &models.HostRegistrationResponseAO1NextStepRunnerCommand{
-		Command: command,
-		Args:    *args,
+	if err = json.Unmarshal([]byte(dhcpAllocationResponseStr), &dhcpAllocationReponse); err != nil {
+		log.WithError(err).Warnf("Json unmarshal dhcp allocation from host %s", host.ID.String())
+		return err
+	}
+	apiVip := dhcpAllocationReponse.APIVipAddress.String()
+	ingressVip := dhcpAllocationReponse.IngressVipAddress.String()
+	isApiVipInMachineCIDR, err := network.IpInCidr(apiVip, cluster.MachineNetworkCidr)
+	if err != nil {
+		log.WithError(err).Warn("Ip in CIDR for API VIP")
+		return err
 	}
-}
-
-func extractImageTag(fullName string) string {
-	suffix := strings.Split(fullName, ":")
-	return suffix[len(suffix)-1]
-}
 
-func returnRegisterHostTransitionError(
-	defaultCode int32,
-	err error) middleware.Responder {
-	if isRegisterHostForbiddenDueWrongBootOrder(err) {
-		return installer.NewRegisterHostForbidden().WithPayload(
-			&models.InfraError{
-				Code:    swag.Int32(http.StatusForbidden),
-				Message: swag.String(err.Error()),
-			})
+	isIngressVipInMachineCIDR, err := network.IpInCidr(ingressVip, cluster.MachineNetworkCidr)
+	if err != nil {
+		log.WithError(err).Warn("Ip in CIDR for Ingress VIP")
+		return err
 	}
-	return common.NewApiError(defaultCode, err)
-}
 
-func isRegisterHostForbiddenDueWrongBootOrder(err error) bool {
-	if serr, ok := err.(*common.ApiErrorResponse); ok {
-		return serr.StatusCode() == http.StatusForbidden
+	if !(isApiVipInMachineCIDR && isIngressVipInMachineCIDR) {
+		err = errors.Errorf("At least of the IPs (%s, %s) is not in machine CIDR %s", apiVip, ingressVip, cluster.MachineNetworkCidr)
+		log.WithError(err).Warn("IP in CIDR")
+		return err
 	}
-	return false
-}
 
-func (b *bareMetalInventory) DeregisterHost(ctx context.Context, params installer.DeregisterHostParams) middleware.Responder {
-	if err := b.DeregisterHostInternal(ctx, params); err != nil {
-		return common.GenerateErrorResponder(err)
+	err = network.VerifyLease(dhcpAllocationReponse.APIVipLease)
+	if err != nil {
+		log.WithError(err).Warnf("API Vip not validated")
+		return err
 	}
-	return installer.NewDeregisterHostNoContent()
+	err = network.VerifyLease(dhcpAllocationReponse.IngressVipLease)
+	if err != nil {
+		log.WithError(err).Warnf("Ingress Vip not validated")
+		return err
+	}
+	return b.clusterApi.SetVipsData(ctx, &cluster, apiVip, ingressVip, dhcpAllocationReponse.APIVipLease, dhcpAllocationReponse.IngressVipLease, b.db)
 }
 
-func (b *bareMetalInventory) DeregisterHostInternal(ctx context.Context, params installer.DeregisterHostParams) error {
+func (b *bareMetalInventory) processNtpSynchronizerResponse(ctx context.Context, host *models.Host, ntpSynchronizerResponseStr string) error {
+	var ntpSynchronizerResponse models.NtpSynchronizationResponse
+
 	log := logutil.FromContext(ctx, b.log)
-	log.Infof("Deregister host: %s cluster %s", params.HostID, params.ClusterID)
 
-	if err := b.db.Where("id = ? and cluster_id = ?", params.HostID, params.ClusterID).Delete(&common.Host{}).Error; err != nil {
-		// TODO: check error type
-		return common.NewApiError(http.StatusBadRequest, err)
+	if err := json.Unmarshal([]byte(ntpSynchronizerResponseStr), &ntpSynchronizerResponse); err != nil {
+		log.WithError(err).Warnf("Json unmarshal ntp synchronizer response from host %s", host.ID.String())
+		return err
 	}
 
-	// TODO: need to check that host can be deleted from the cluster
-	b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityInfo,
-		fmt.Sprintf("Host %s: deregistered from cluster", params.HostID.String()), time.Now())
-	return nil
+	return b.hostApi.UpdateNTP(ctx, host, ntpSynchronizerResponse.NtpSources, b.db)
 }
 
-func (b *bareMetalInventory) GetHost(_ context.Context, params installer.GetHostParams) middleware.Responder {
-	// TODO: validate what is the error
-	host, err := common.GetHostFromDB(b.db, params.ClusterID.String(), params.HostID.String())
+func (b *bareMetalInventory) processDiskSpeedCheckResponse(ctx context.Context, h *models.Host, diskPerfCheckResponseStr string, exitCode int64) error {
+	var diskPerfCheckResponse models.DiskSpeedCheckResponse
 
-	if err != nil {
-		return installer.NewGetHostNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+	log := logutil.FromContext(ctx, b.log)
+
+	if err := json.Unmarshal([]byte(diskPerfCheckResponseStr), &diskPerfCheckResponse); err != nil {
+		log.WithError(err).Warnf("Json unmarshal FIO perf check response from host %s", h.ID.String())
+		return err
 	}
 
-	if err := b.customizeHost(&host.Host); err != nil {
-		return common.GenerateErrorResponder(err)
+	if exitCode == 0 {
+		b.metricApi.DiskSyncDuration(h.ClusterID, *h.ID, diskPerfCheckResponse.Path, diskPerfCheckResponse.IoSyncDuration)
+
+		thresholdMs, err := b.getInstallationDiskSpeedThresholdMs(ctx, h)
+		if err != nil {
+			log.WithError(err).Warnf("Error getting installation disk speed threshold for host %s", h.ID.String())
+			return err
+		}
+		if diskPerfCheckResponse.IoSyncDuration > thresholdMs {
+			// If the 99th percentile of fdatasync durations is more than 10ms, it's not fast enough for etcd.
+			// See: https://www.ibm.com/cloud/blog/using-fio-to-tell-whether-your-storage-is-fast-enough-for-etcd
+			msg := fmt.Sprintf("Host's disk %s is slower than the supported speed, and may cause degraded cluster performance (fdatasync duration: %d ms)",
+				diskPerfCheckResponse.Path, diskPerfCheckResponse.IoSyncDuration)
+			log.Warnf(msg)
+			b.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityWarning, msg, time.Now())
+		}
 	}
 
-	// Clear this field as it is not needed to be sent via API
-	host.FreeAddresses = ""
-	return installer.NewGetHostOK().WithPayload(&host.Host)
+	return b.hostApi.SetDiskSpeed(ctx, h, diskPerfCheckResponse.Path, diskPerfCheckResponse.IoSyncDuration, exitCode, nil)
 }
 
-func (b *bareMetalInventory) GetHostById(hostId string) (*common.Host, error) {
-	host, err := common.GetHostByIdFromDB(b.db, hostId)
+func (b *bareMetalInventory) getInstallationDiskSpeedThresholdMs(ctx context.Context, h *models.Host) (int64, error) {
+	cluster, err := common.GetClusterFromDB(b.db, h.ClusterID, common.UseEagerLoading)
 	if err != nil {
-		return nil, err
-	}
-
-	if err = b.customizeHost(&host.Host); err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	return host, nil
+	return b.hwValidator.GetInstallationDiskSpeedThresholdMs(ctx, cluster, h)
 }
 
-func (b *bareMetalInventory) ListHosts(ctx context.Context, params installer.ListHostsParams) middleware.Responder {
+func (b *bareMetalInventory) processImageAvailabilityResponse(ctx context.Context, host *models.Host, responseStr string) error {
+	var response models.ContainerImageAvailabilityResponse
+
 	log := logutil.FromContext(ctx, b.log)
-	var hosts []*models.Host
-	if err := b.db.Find(&hosts, "cluster_id = ?", params.ClusterID).Error; err != nil {
-		log.WithError(err).Errorf("failed to get list of hosts for cluster %s", params.ClusterID)
-		return installer.NewListHostsInternalServerError().
-			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+
+	if err := json.Unmarshal([]byte(responseStr), &response); err != nil {
+		log.WithError(err).Warnf("Json unmarshal %s image availability response from host %s", responseStr, host.ID.String())
+		return err
 	}
 
-	for _, host := range hosts {
-		if err := b.customizeHost(host); err != nil {
-			return common.GenerateErrorResponder(err)
+	for _, image := range response.Images {
+		if err := b.hostApi.UpdateImageStatus(ctx, host, image, b.db); err != nil {
+			return err
 		}
-		// Clear this field as it is not needed to be sent via API
-		host.FreeAddresses = ""
 	}
 
-	return installer.NewListHostsOK().WithPayload(hosts)
+	return nil
 }
 
-func (b *bareMetalInventory) UpdateHostInstallerArgsInternal(ctx context.Context, params installer.UpdateHostInstallerArgsParams) (*models.Host, error) {
+func (b *bareMetalInventory) processUpgradeAgentResponse(ctx context.Context, host *models.Host, responseStr string) error {
+	var response models.UpgradeAgentResponse
 
 	log := logutil.FromContext(ctx, b.log)
 
-	err := hostutil.ValidateInstallerArgs(params.InstallerArgsParams.Args)
-	if err != nil {
-		return nil, common.NewApiError(http.StatusBadRequest, err)
+	if err := json.Unmarshal([]byte(responseStr), &response); err != nil {
+		log.WithError(err).Warnf("Json unmarshal %s upgrade agent response from host %s", responseStr, host.ID.String())
+		return err
+	}
+	if response.AgentImage == "" {
+		response.AgentImage = b.AgentDockerImg
 	}
 
-	h, err := b.getHost(ctx, params.ClusterID.String(), params.HostID.String())
-	if err != nil {
-		return nil, err
+	return b.hostApi.UpdateAgentUpgradeStatus(ctx, host, &response, b.db)
+}
+
+func handleReplyByType(params installer.PostStepReplyParams, b *bareMetalInventory, ctx context.Context, host models.Host, stepReply string) error {
+	var err error
+	switch params.Reply.StepType {
+	case models.StepTypeInventory:
+		err = b.hostApi.UpdateInventory(ctx, &host, stepReply)
+	case models.StepTypeConnectivityCheck:
+		err = b.hostApi.UpdateConnectivityReport(ctx, &host, stepReply)
+	case models.StepTypeAPIVipConnectivityCheck:
+		err = b.hostApi.UpdateApiVipConnectivityReport(ctx, &host, stepReply)
+	case models.StepTypeFreeNetworkAddresses:
+		err = b.updateFreeAddressesReport(ctx, &host, stepReply)
+	case models.StepTypeDhcpLeaseAllocate:
+		err = b.processDhcpAllocationResponse(ctx, &host, stepReply)
+	case models.StepTypeNtpSynchronizer:
+		err = b.processNtpSynchronizerResponse(ctx, &host, stepReply)
+	case models.StepTypeContainerImageAvailability:
+		err = b.processImageAvailabilityResponse(ctx, &host, stepReply)
+	case models.StepTypeInstallationDiskSpeedCheck:
+		err = b.processDiskSpeedCheckResponse(ctx, &host, stepReply, 0)
+	case models.StepTypeUpgradeAgent:
+		err = b.processUpgradeAgentResponse(ctx, &host, stepReply)
 	}
+	return err
+}
 
-	argsBytes, err := json.Marshal(params.InstallerArgsParams.Args)
-	if err != nil {
-		return nil, err
+func logReplyReceived(params installer.PostStepReplyParams, log logrus.FieldLogger) {
+	message := fmt.Sprintf("Received step reply <%s> from cluster <%s> host <%s> exit-code <%d> stderr <%s>",
+		params.Reply.StepID, params.ClusterID, params.HostID, params.Reply.ExitCode, params.Reply.Error)
+	messageWithOutput := fmt.Sprintf("%s stdout <%s>", message, params.Reply.Output)
+
+	if params.Reply.ExitCode != 0 {
+		log.Error(messageWithOutput)
+		return
 	}
 
-	err = b.db.Model(&common.Host{}).Where(identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), params.HostID, params.ClusterID).Update("installer_args", string(argsBytes)).Error
-	if err != nil {
-		log.WithError(err).Errorf("failed to update host %s", params.HostID)
-		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	if params.Reply.StepType == models.StepTypeFreeNetworkAddresses {
+		log.Info(message)
+	} else {
+		log.Info(messageWithOutput)
 	}
+}
 
-	b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityInfo, fmt.Sprintf("Host %s: custom installer arguments were applied", hostutil.GetHostnameForMsg(&h.Host)), time.Now())
-	log.Infof("Custom installer arguments were applied to host %s in cluster %s", params.HostID, params.ClusterID)
+func filterReplyByType(params installer.PostStepReplyParams) (string, error) {
+	var stepReply string
+	var err error
 
-	h, err = b.getHost(ctx, params.ClusterID.String(), params.HostID.String())
-	if err != nil {
-		log.WithError(err).Errorf("failed to get host %s after update", params.HostID)
-		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	// To make sure we store only information defined in swagger we unmarshal and marshal the stepReplyParams.
+	switch params.Reply.StepType {
+	case models.StepTypeInventory:
+		stepReply, err = filterReply(&models.Inventory{}, params.Reply.Output)
+	case models.StepTypeConnectivityCheck:
+		stepReply, err = filterReply(&models.ConnectivityReport{}, params.Reply.Output)
+	case models.StepTypeAPIVipConnectivityCheck:
+		stepReply, err = filterReply(&models.APIVipConnectivityResponse{}, params.Reply.Output)
+	case models.StepTypeFreeNetworkAddresses:
+		stepReply, err = filterReply(&models.FreeNetworksAddresses{}, params.Reply.Output)
+	case models.StepTypeDhcpLeaseAllocate:
+		stepReply, err = filterReply(&models.DhcpAllocationResponse{}, params.Reply.Output)
+	case models.StepTypeNtpSynchronizer:
+		stepReply, err = filterReply(&models.NtpSynchronizationResponse{}, params.Reply.Output)
+	case models.StepTypeContainerImageAvailability:
+		stepReply, err = filterReply(&models.ContainerImageAvailabilityResponse{}, params.Reply.Output)
+	case models.StepTypeInstallationDiskSpeedCheck:
+		stepReply, err = filterReply(&models.DiskSpeedCheckResponse{}, params.Reply.Output)
+	case models.StepTypeUpgradeAgent:
+		stepReply, err = filterReply(&models.UpgradeAgentResponse{}, params.Reply.Output)
 	}
 
-	return &h.Host, nil
+	return stepReply, err
 }
 
-func (b *bareMetalInventory) UpdateHostInstallerArgs(ctx context.Context, params installer.UpdateHostInstallerArgsParams) middleware.Responder {
-	updatedHost, err := b.UpdateHostInstallerArgsInternal(ctx, params)
+// filterReply return only the expected parameters from the input.
+func filterReply(expected interface{}, input string) (string, error) {
+	if err := json.Unmarshal([]byte(input), expected); err != nil {
+		return "", err
+	}
+	reply, err := json.Marshal(expected)
 	if err != nil {
-		return common.GenerateErrorResponder(err)
+		return "", err
 	}
-	return installer.NewUpdateHostInstallerArgsCreated().WithPayload(updatedHost)
+	return string(reply), nil
 }
 
-func (b *bareMetalInventory) GetNextSteps(ctx context.Context, params installer.GetNextStepsParams) middleware.Responder {
+func (b *bareMetalInventory) DisableHost(ctx context.Context, params installer.DisableHostParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
-	var steps models.Steps
+	log.Info("disabling host: ", params.HostID)
 
 	txSuccess := false
 	tx := b.db.Begin()
+	tx = transaction.AddForUpdateQueryOption(tx)
+
 	defer func() {
 		if !txSuccess {
-			log.Error("get next steps failed")
+			log.Error("update cluster failed")
 			tx.Rollback()
 		}
 		if r := recover(); r != nil {
-			log.Error("get next steps failed")
+			log.Error("update cluster failed")
 			tx.Rollback()
 		}
 	}()
 
-	if tx.Error != nil {
-		log.WithError(tx.Error).Errorf("failed to start db transaction")
-		return installer.NewUpdateClusterInternalServerError().
-			WithPayload(common.GenerateError(http.StatusInternalServerError, errors.New("DB error, failed to start transaction")))
+	host, err := common.GetHostFromDB(b.db, params.ClusterID.String(), params.HostID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.WithError(err).Errorf("host %s not found", params.HostID)
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		log.WithError(err).Errorf("failed to get host %s", params.HostID)
+		msg := fmt.Sprintf("Failed to disable host %s: error fetching host from DB", params.HostID.String())
+		b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityError, msg, time.Now())
+		return common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	//TODO check the error type
-	host, err := common.GetHostFromDB(tx, params.ClusterID.String(), params.HostID.String())
-	if err != nil {
-		log.WithError(err).Errorf("failed to find host: %s", params.HostID)
-		return installer.NewGetNextStepsNotFound().
-			WithPayload(common.GenerateError(http.StatusNotFound, err))
+	if err = b.hostApi.DisableHost(ctx, &host.Host, tx); err != nil {
+		log.WithError(err).Errorf("failed to disable host <%s> from cluster <%s>", params.HostID, params.ClusterID)
+		msg := fmt.Sprintf("Failed to disable host %s: error disabling host in current status",
+			hostutil.GetHostnameForMsg(&host.Host))
+		b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityError, msg, time.Now())
+		return common.GenerateErrorResponderWithDefault(err, http.StatusConflict)
 	}
 
-	host.CheckedInAt = strfmt.DateTime(time.Now())
-	if err = tx.Model(&host).UpdateColumn("checked_in_at", host.CheckedInAt).Error; err != nil {
-		log.WithError(err).Errorf("failed to update host: %s", params.ClusterID)
-		return installer.NewGetNextStepsInternalServerError()
+	c, err := b.refreshHostAndClusterStatuses(ctx, "disable host", &params.HostID, &params.ClusterID, tx)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
 	}
 
 	if err = tx.Commit().Error; err != nil {
 		log.Error(err)
-		return installer.NewGetNextStepsInternalServerError()
+		return installer.NewResetClusterInternalServerError().WithPayload(
+			common.GenerateError(http.StatusInternalServerError, errors.New("DB error, failed to commit transaction")))
 	}
 	txSuccess = true
 
-	steps, err = b.hostApi.GetNextSteps(ctx, &host.Host)
-	if err != nil {
-		log.WithError(err).Errorf("failed to get steps for host %s cluster %s", params.HostID, params.ClusterID)
-	}
-
-	return installer.NewGetNextStepsOK().WithPayload(&steps)
-}
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityInfo,
+		fmt.Sprintf("Host %s disabled by user", hostutil.GetHostnameForMsg(&host.Host)), time.Now())
 
-func shouldHandle(params installer.PostStepReplyParams) bool {
-	switch params.Reply.StepType {
-	case models.StepTypeInstallationDiskSpeedCheck, models.StepTypeContainerImageAvailability:
-		/*
-		   In case that the command sent 0 length output is should not be handled.  When disk speed check takes a long time,
-		   we don't want to run 2 such commands concurrently.  The prior running disk-speed-check, there is a verification
-		   that such command is not already running.  If it does, then the command returns immediately without running
-		   disk-speed-check.
-		   TODO Maybe do the same for other commands as well.
-		*/
-		return len(params.Reply.Output) > 0
+	c, err = b.GetClusterInternal(ctx, installer.GetClusterParams{ClusterID: *c.ID})
+	if err != nil {
+		return common.GenerateErrorResponder(err)
 	}
-	return true
+	return installer.NewDisableHostOK().WithPayload(&c.Cluster)
 }
 
-func (b *bareMetalInventory) PostStepReply(ctx context.Context, params installer.PostStepReplyParams) middleware.Responder {
+func (b *bareMetalInventory) EnableHost(ctx context.Context, params installer.EnableHostParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
+	log.Info("enable host: ", params.HostID)
 
-	host, err := common.GetHostFromDB(b.db, params.ClusterID.String(), params.HostID.String())
-
-	if err != nil {
-		log.WithError(err).Errorf("Failed to find host <%s> cluster <%s> step <%s> exit code %d stdout <%s> stderr <%s>",
-			params.HostID, params.ClusterID, params.Reply.StepID, params.Reply.ExitCode, params.Reply.Output, params.Reply.Error)
-		return installer.NewPostStepReplyNotFound().
-			WithPayload(common.GenerateError(http.StatusNotFound, err))
-	}
+	txSuccess := false
+	tx := b.db.Begin()
+	tx = transaction.AddForUpdateQueryOption(tx)
 
-	logReplyReceived(params, log)
+	defer func() {
+		if !txSuccess {
+			log.Error("update cluster failed")
+			tx.Rollback()
+		}
+		if r := recover(); r != nil {
+			log.Error("update cluster failed")
+			tx.Rollback()
+		}
+	}()
 
-	if params.Reply.ExitCode != 0 {
-		handlingError := b.handleReplyError(params, ctx, log, &host.Host, params.Reply.ExitCode)
-		if handlingError != nil {
-			log.WithError(handlingError).Errorf("Failed handling reply error for host <%s> cluster <%s>", params.HostID, params.ClusterID)
+	host, err := common.GetHostFromDB(tx, params.ClusterID.String(), params.HostID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.WithError(err).Errorf("host %s not found", params.HostID)
+			return common.NewApiError(http.StatusNotFound, err)
 		}
-		return installer.NewPostStepReplyNoContent()
+		log.WithError(err).Errorf("failed to get host %s", params.HostID)
+		msg := fmt.Sprintf("Failed to enable host %s: error fetching host from DB", params.HostID)
+		b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityError, msg, time.Now())
+		return common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	if !shouldHandle(params) {
-		return installer.NewPostStepReplyNoContent()
+	if err = b.hostApi.EnableHost(ctx, &host.Host, tx); err != nil {
+		log.WithError(err).Errorf("failed to enable host <%s> from cluster <%s>", params.HostID, params.ClusterID)
+		msg := fmt.Sprintf("Failed to enable host %s: error disabling host in current status",
+			hostutil.GetHostnameForMsg(&host.Host))
+		b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityError, msg, time.Now())
+		return common.GenerateErrorResponderWithDefault(err, http.StatusConflict)
 	}
 
-	stepReply, err := filterReplyByType(params)
+	c, err := b.refreshHostAndClusterStatuses(ctx, "enable host", &params.HostID, &params.ClusterID, tx)
 	if err != nil {
-		log.WithError(err).Errorf("Failed decode <%s> reply for host <%s> cluster <%s>",
-			params.Reply.StepID, params.HostID, params.ClusterID)
-		return installer.NewPostStepReplyBadRequest().
-			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+		return common.GenerateErrorResponder(err)
 	}
 
-	err = handleReplyByType(params, b, ctx, host.Host, stepReply)
-	if err != nil {
-		log.WithError(err).Errorf("Failed to update step reply for host <%s> cluster <%s> step <%s>",
-			params.HostID, params.ClusterID, params.Reply.StepID)
-		return installer.NewPostStepReplyInternalServerError().
-			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	if err = tx.Commit().Error; err != nil {
+		log.Error(err)
+		return common.NewApiError(http.StatusInternalServerError, errors.New("DB error, failed to commit transaction"))
 	}
+	txSuccess = true
 
-	return installer.NewPostStepReplyNoContent()
-}
-
-func (b *bareMetalInventory) handleReplyError(params installer.PostStepReplyParams, ctx context.Context, log logrus.FieldLogger, h *models.Host, exitCode int64) error {
-	if exitCode == MediaDisconnected {
-		if err := b.handleMediaDisconnection(params, ctx, log, h); err != nil {
-			return err
-		}
-	}
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityInfo,
+		fmt.Sprintf("Host %s enabled by user", hostutil.GetHostnameForMsg(&host.Host)), time.Now())
 
-	switch params.Reply.StepType {
-	case models.StepTypeInstall:
-		// Handle case of installation error due to an already running assisted-installer.
-		if params.Reply.ExitCode == ContainerAlreadyRunningExitCode && strings.Contains(params.Reply.Error, "the container name \"assisted-installer\" is already in use") {
-			log.Warnf("Install command failed due to an already running installation: %s", params.Reply.Error)
-			return nil
-		}
-		//if it's install step - need to move host to error
-		return b.hostApi.HandleInstallationFailure(ctx, h)
-	case models.StepTypeInstallationDiskSpeedCheck:
-		stepReply, err := filterReplyByType(params)
-		if err != nil {
-			return err
-		}
-		return b.processDiskSpeedCheckResponse(ctx, h, stepReply, exitCode)
+	c, err = b.GetClusterInternal(ctx, installer.GetClusterParams{ClusterID: *c.ID})
+	if err != nil {
+		return common.GenerateErrorResponder(err)
 	}
-	return nil
+	return installer.NewEnableHostOK().WithPayload(&c.Cluster)
 }
 
-func (b *bareMetalInventory) handleMediaDisconnection(params installer.PostStepReplyParams, ctx context.Context, log logrus.FieldLogger, h *models.Host) error {
-	statusInfo := fmt.Sprintf("%s - %s", string(models.HostStageFailed),
-		"Cannot read from the media (ISO) - media was likely disconnected")
+func (b *bareMetalInventory) refreshHostAndClusterStatuses(
+	ctx context.Context,
+	eventName string,
+	hostID *strfmt.UUID,
+	clusterID *strfmt.UUID,
+	db *gorm.DB) (*common.Cluster, error) {
 
-	// Install command reports its status with a different API, directly from the assisted-installer.
-	// Just adding our diagnose to the existing error message.
-	if swag.StringValue(h.Status) == models.HostStatusError && h.StatusInfo != nil {
-		// Add the message only once
-		if strings.Contains(*h.StatusInfo, statusInfo) {
-			return nil
-		}
+	logger := logutil.FromContext(ctx, b.log)
 
-		statusInfo = fmt.Sprintf("%s. %s", statusInfo, *h.StatusInfo)
-	} else if params.Reply.Error != "" {
-		statusInfo = fmt.Sprintf("%s. %s", statusInfo, params.Reply.Error)
-	}
+	var err error
 
-	_, err := hostutil.UpdateHostStatus(ctx, log, b.db, b.eventsHandler, h.ClusterID, *h.ID,
-		swag.StringValue(h.Status), models.HostStatusError, statusInfo)
+	defer func() {
+		if err == nil {
+			return
+		}
+		logger.WithError(err).Errorf("%s:", eventName)
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			b.eventsHandler.AddEvent(
+				ctx,
+				*clusterID,
+				hostID,
+				models.EventSeverityError,
+				err.Error(),
+				time.Now())
+		}
+	}()
+	err = b.setMajorityGroupForCluster(clusterID, db)
+	if err != nil {
+		return nil, err
+	}
+	err = b.refreshHostStatus(ctx, hostID, clusterID, db)
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	c, err := b.refreshClusterStatus(ctx, clusterID, db)
+	return c, err
 }
 
-func (b *bareMetalInventory) updateFreeAddressesReport(ctx context.Context, host *models.Host, freeAddressesReport string) error {
-	var (
-		err           error
-		freeAddresses models.FreeNetworksAddresses
-	)
-	log := logutil.FromContext(ctx, b.log)
-	log.Debugf("Free addresses for host %s are: %s", host.ID.String(), freeAddresses)
+func (b *bareMetalInventory) refreshHostStatus(
+	ctx context.Context,
+	hostID *strfmt.UUID,
+	clusterID *strfmt.UUID,
+	db *gorm.DB) error {
 
-	if err = json.Unmarshal([]byte(freeAddressesReport), &freeAddresses); err != nil {
-		log.WithError(err).Warnf("Json unmarshal free addresses of host %s", host.ID.String())
-		return err
-	}
-	if len(freeAddresses) == 0 {
-		err = errors.Errorf("Free addresses for host %s is empty", host.ID.String())
-		log.WithError(err).Warn("Update free addresses")
+	h, err := common.GetHostFromDB(db, clusterID.String(), hostID.String())
+	if err != nil {
 		return err
 	}
-	if err = b.db.Model(&common.Host{}).Where("id = ? and cluster_id = ?", host.ID.String(),
-		host.ClusterID.String()).UpdateColumn("free_addresses", freeAddressesReport).Error; err != nil {
-		log.WithError(err).Warnf("Update free addresses of host %s", host.ID.String())
-		return err
+
+	if err := b.hostApi.RefreshStatus(ctx, &h.Host, db); err != nil {
+		return errors.Wrapf(err, "failed to refresh status of host: %s", h.ID)
 	}
-	// Gorm sets the number of changed rows in AffectedRows and not the number of matched rows.  Therefore, if the report hasn't changed
-	// from the previous report, the AffectedRows will be 0 but it will still be correct.  So no error reporting needed for AffectedRows == 0
+
 	return nil
 }
 
-func (b *bareMetalInventory) processDhcpAllocationResponse(ctx context.Context, host *models.Host, dhcpAllocationResponseStr string) error {
-	var (
-		err                   error
-		dhcpAllocationReponse models.DhcpAllocationResponse
-		cluster               common.Cluster
-	)
-	log := logutil.FromContext(ctx, b.log)
-	if err = b.db.Take(&cluster, "id = ?", host.ClusterID.String()).Error; err != nil {
-		log.WithError(err).Warnf("Get cluster %s", host.ClusterID.String())
-		return err
-	}
-	if !swag.BoolValue(cluster.VipDhcpAllocation) {
-		err = errors.Errorf("DHCP not enabled in cluster %s", host.ClusterID.String())
-		log.WithError(err).Warn("processDhcpAllocationResponse")
-		return err
-	}
-	if err = json.Unmarshal([]byte(dhcpAllocationResponseStr), &dhcpAllocationReponse); err != nil {
-		log.WithError(err).Warnf("Json unmarshal dhcp allocation from host %s", host.ID.String())
-		return err
-	}
-	apiVip := dhcpAllocationReponse.APIVipAddress.String()
-	ingressVip := dhcpAllocationReponse.IngressVipAddress.String()
-	isApiVipInMachineCIDR, err := network.IpInCidr(apiVip, cluster.MachineNetworkCidr)
+func (b *bareMetalInventory) setMajorityGroupForCluster(clusterID *strfmt.UUID, db *gorm.DB) error {
+	return b.clusterApi.SetConnectivityMajorityGroupsForCluster(*clusterID, db)
+}
+
+func (b *bareMetalInventory) refreshClusterStatus(
+	ctx context.Context,
+	clusterID *strfmt.UUID,
+	db *gorm.DB) (*common.Cluster, error) {
+
+	cluster, err := common.GetClusterFromDB(db, *clusterID, common.SkipEagerLoading)
 	if err != nil {
-		log.WithError(err).Warn("Ip in CIDR for API VIP")
-		return err
+		return nil, err
 	}
 
-	isIngressVipInMachineCIDR, err := network.IpInCidr(ingressVip, cluster.MachineNetworkCidr)
+	updatedCluster, err := b.clusterApi.RefreshStatus(ctx, cluster, db)
 	if err != nil {
-		log.WithError(err).Warn("Ip in CIDR for Ingress VIP")
-		return err
+		return nil, errors.Wrapf(err, "failed to refresh status of cluster: %s", cluster.ID)
 	}
 
-	if !(isApiVipInMachineCIDR && isIngressVipInMachineCIDR) {
-		err = errors.Errorf("At least of the IPs (%s, %s) is not in machine CIDR %s", apiVip, ingressVip, cluster.MachineNetworkCidr)
-		log.WithError(err).Warn("IP in CIDR")
-		return err
+	return updatedCluster, nil
+}
+
+func (b *bareMetalInventory) GetPresignedForClusterFiles(ctx context.Context, params installer.GetPresignedForClusterFilesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if !b.objectHandler.SupportsPresignedDownloadURLs() {
+		return common.NewApiError(http.StatusBadRequest, errors.New("Failed to generate presigned URL: invalid backend"))
+	}
+	var err error
+	fullFileName := fmt.Sprintf("%s/%s", params.ClusterID, params.FileName)
+	downloadFilename := params.FileName
+	if params.FileName == manifests.ManifestFolder {
+		if params.AdditionalName != nil {
+			additionalName := *params.AdditionalName
+			fullFileName = manifests.GetManifestObjectName(params.ClusterID, additionalName)
+			downloadFilename = additionalName[strings.LastIndex(additionalName, "/")+1:]
+		} else {
+			err = errors.New("Additional name must be provided for 'manifests' file name, prefaced with folder name, e.g.: openshift/99-openshift-xyz.yaml")
+			return common.GenerateErrorResponder(err)
+		}
 	}
 
-	err = network.VerifyLease(dhcpAllocationReponse.APIVipLease)
-	if err != nil {
-		log.WithError(err).Warnf("API Vip not validated")
-		return err
+	if params.FileName == "logs" {
+		if params.HostID != nil && swag.StringValue(params.LogsType) == "" {
+			logsType := string(models.LogsTypeHost)
+			params.LogsType = &logsType
+		}
+		fullFileName, downloadFilename, err = b.getLogFileForDownload(ctx, &params.ClusterID, params.HostID, swag.StringValue(params.LogsType))
+		if err != nil {
+			return common.GenerateErrorResponder(err)
+		}
+	} else if err = b.checkFileForDownload(ctx, params.ClusterID.String(), params.FileName); err != nil {
+		return common.GenerateErrorResponder(err)
 	}
-	err = network.VerifyLease(dhcpAllocationReponse.IngressVipLease)
+
+	duration, _ := time.ParseDuration("10m")
+	url, err := b.objectHandler.GeneratePresignedDownloadURL(ctx, fullFileName, downloadFilename, duration)
 	if err != nil {
-		log.WithError(err).Warnf("Ingress Vip not validated")
-		return err
+		log.WithError(err).Errorf("failed to generate presigned URL: %s from cluster: %s", params.FileName, params.ClusterID.String())
+		return common.GenerateErrorResponderWithDefault(err, http.StatusInternalServerError)
 	}
-	return b.clusterApi.SetVipsData(ctx, &cluster, apiVip, ingressVip, dhcpAllocationReponse.APIVipLease, dhcpAllocationReponse.IngressVipLease, b.db)
+	return installer.NewGetPresignedForClusterFilesOK().WithPayload(&models.Presigned{URL: &url})
 }
 
-func (b *bareMetalInventory) processNtpSynchronizerResponse(ctx context.Context, host *models.Host, ntpSynchronizerResponseStr string) error {
-	var ntpSynchronizerResponse models.NtpSynchronizationResponse
-
+func (b *bareMetalInventory) DownloadClusterFiles(ctx context.Context, params installer.DownloadClusterFilesParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
+	if err := b.checkFileForDownload(ctx, params.ClusterID.String(), params.FileName); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
 
-	if err := json.Unmarshal([]byte(ntpSynchronizerResponseStr), &ntpSynchronizerResponse); err != nil {
-		log.WithError(err).Warnf("Json unmarshal ntp synchronizer response from host %s", host.ID.String())
-		return err
+	respBody, contentLength, err := b.objectHandler.Download(ctx, fmt.Sprintf("%s/%s", params.ClusterID, params.FileName))
+	if err != nil {
+		log.WithError(err).Errorf("failed to download file %s from cluster: %s", params.FileName, params.ClusterID.String())
+		return common.GenerateErrorResponder(err)
 	}
 
-	return b.hostApi.UpdateNTP(ctx, host, ntpSynchronizerResponse.NtpSources, b.db)
+	return filemiddleware.NewResponder(installer.NewDownloadClusterFilesOK().WithPayload(respBody), params.FileName, contentLength)
 }
 
-func (b *bareMetalInventory) processDiskSpeedCheckResponse(ctx context.Context, h *models.Host, diskPerfCheckResponseStr string, exitCode int64) error {
-	var diskPerfCheckResponse models.DiskSpeedCheckResponse
+func (b *bareMetalInventory) DownloadClusterKubeconfig(ctx context.Context, params installer.DownloadClusterKubeconfigParams) middleware.Responder {
+	respBody, contentLength, err := b.DownloadClusterKubeconfigInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return filemiddleware.NewResponder(installer.NewDownloadClusterKubeconfigOK().WithPayload(respBody), constants.Kubeconfig, contentLength)
+}
 
-	log := logutil.FromContext(ctx, b.log)
+func (b *bareMetalInventory) DownloadClusterKubeconfigInternal(ctx context.Context, params installer.DownloadClusterKubeconfigParams) (io.ReadCloser, int64, error) {
+	if err := b.checkFileForDownload(ctx, params.ClusterID.String(), constants.Kubeconfig); err != nil {
+		return nil, 0, err
+	}
 
-	if err := json.Unmarshal([]byte(diskPerfCheckResponseStr), &diskPerfCheckResponse); err != nil {
-		log.WithError(err).Warnf("Json unmarshal FIO perf check response from host %s", h.ID.String())
-		return err
+	respBody, contentLength, err := b.objectHandler.Download(ctx, fmt.Sprintf("%s/%s", params.ClusterID, constants.Kubeconfig))
+	if err != nil {
+		return nil, 0, common.NewApiError(http.StatusConflict, err)
 	}
+	return respBody, contentLength, nil
+}
 
-	if exitCode == 0 {
-		b.metricApi.DiskSyncDuration(h.ClusterID, *h.ID, diskPerfCheckResponse.Path, diskPerfCheckResponse.IoSyncDuration)
+func (b *bareMetalInventory) getLogFileForDownload(ctx context.Context, clusterId *strfmt.UUID, hostId *strfmt.UUID, logsType string) (string, string, error) {
+	var fileName string
+	var downloadFileName string
+	c, err := b.getCluster(ctx, clusterId.String(), common.UseEagerLoading, common.IncludeDeletedRecords)
+	if err != nil {
+		return "", "", err
+	}
+	switch logsType {
+	case string(models.LogsTypeHost):
+		if hostId == nil {
+			return "", "", common.NewApiError(http.StatusBadRequest, errors.Errorf("Host ID must be provided for downloading host logs"))
+		}
 
-		thresholdMs, err := b.getInstallationDiskSpeedThresholdMs(ctx, h)
+		var hostObject *common.Host
+		hostObject, err = b.getHost(ctx, clusterId.String(), hostId.String())
 		if err != nil {
-			log.WithError(err).Warnf("Error getting installation disk speed threshold for host %s", h.ID.String())
-			return err
+			return "", "", err
 		}
-		if diskPerfCheckResponse.IoSyncDuration > thresholdMs {
-			// If the 99th percentile of fdatasync durations is more than 10ms, it's not fast enough for etcd.
-			// See: https://www.ibm.com/cloud/blog/using-fio-to-tell-whether-your-storage-is-fast-enough-for-etcd
-			msg := fmt.Sprintf("Host's disk %s is slower than the supported speed, and may cause degraded cluster performance (fdatasync duration: %d ms)",
-				diskPerfCheckResponse.Path, diskPerfCheckResponse.IoSyncDuration)
-			log.Warnf(msg)
-			b.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityWarning, msg, time.Now())
+		if hostObject.LogsCollectedAt == strfmt.DateTime(time.Time{}) {
+			return "", "", common.NewApiError(http.StatusConflict, errors.Errorf("Logs for host %s were not found", hostId))
+		}
+		fileName = b.getLogsFullName(clusterId.String(), hostObject.ID.String())
+		role := string(hostObject.Role)
+		if hostObject.Bootstrap {
+			role = string(models.HostRoleBootstrap)
+		}
+		downloadFileName = fmt.Sprintf("%s_%s_%s.tar.gz", sanitize.Name(c.Name), role, sanitize.Name(hostutil.GetHostnameForMsg(&hostObject.Host)))
+	case string(models.LogsTypeController):
+		if c.Cluster.ControllerLogsCollectedAt == strfmt.DateTime(time.Time{}) {
+			return "", "", common.NewApiError(http.StatusConflict, errors.Errorf("Controller Logs for cluster %s were not found", clusterId))
+		}
+		fileName = b.getLogsFullName(clusterId.String(), logsType)
+		downloadFileName = fmt.Sprintf("%s_%s_%s.tar.gz", sanitize.Name(c.Name), c.ID, logsType)
+	default:
+		fileName, err = b.prepareClusterLogs(ctx, c)
+		downloadFileName = fmt.Sprintf("%s_%s.tar", sanitize.Name(c.Name), c.ID)
+		if err != nil {
+			return "", "", common.NewApiError(http.StatusInternalServerError, err)
 		}
 	}
 
-	return b.hostApi.SetDiskSpeed(ctx, h, diskPerfCheckResponse.Path, diskPerfCheckResponse.IoSyncDuration, exitCode, nil)
-}
-
-func (b *bareMetalInventory) getInstallationDiskSpeedThresholdMs(ctx context.Context, h *models.Host) (int64, error) {
-	cluster, err := common.GetClusterFromDB(b.db, h.ClusterID, common.UseEagerLoading)
-	if err != nil {
-		return 0, err
-	}
-
-	return b.hwValidator.GetInstallationDiskSpeedThresholdMs(ctx, cluster, h)
+	return fileName, downloadFileName, nil
 }
 
-func (b *bareMetalInventory) processImageAvailabilityResponse(ctx context.Context, host *models.Host, responseStr string) error {
-	var response models.ContainerImageAvailabilityResponse
-
+func (b *bareMetalInventory) checkFileForDownload(ctx context.Context, clusterID, fileName string) error {
 	log := logutil.FromContext(ctx, b.log)
+	log.Infof("Checking cluster cluster file for download: %s for cluster %s", fileName, clusterID)
 
-	if err := json.Unmarshal([]byte(responseStr), &response); err != nil {
-		log.WithError(err).Warnf("Json unmarshal %s image availability response from host %s", responseStr, host.ID.String())
-		return err
+	if !funk.Contains(clusterPkg.S3FileNames, fileName) && fileName != manifests.ManifestFolder {
+		err := errors.Errorf("invalid cluster file %s", fileName)
+		log.WithError(err).Errorf("failed download file: %s from cluster: %s", fileName, clusterID)
+		return common.NewApiError(http.StatusBadRequest, err)
 	}
 
-	for _, image := range response.Images {
-		if err := b.hostApi.UpdateImageStatus(ctx, host, image, b.db); err != nil {
-			return err
-		}
+	cluster, err := b.getCluster(ctx, clusterID)
+	if err != nil {
+		return err
 	}
 
-	return nil
-}
-
-func handleReplyByType(params installer.PostStepReplyParams, b *bareMetalInventory, ctx context.Context, host models.Host, stepReply string) error {
-	var err error
-	switch params.Reply.StepType {
-	case models.StepTypeInventory:
-		err = b.hostApi.UpdateInventory(ctx, &host, stepReply)
-	case models.StepTypeConnectivityCheck:
-		err = b.hostApi.UpdateConnectivityReport(ctx, &host, stepReply)
-	case models.StepTypeAPIVipConnectivityCheck:
-		err = b.hostApi.UpdateApiVipConnectivityReport(ctx, &host, stepReply)
-	case models.StepTypeFreeNetworkAddresses:
-		err = b.updateFreeAddressesReport(ctx, &host, stepReply)
-	case models.StepTypeDhcpLeaseAllocate:
-		err = b.processDhcpAllocationResponse(ctx, &host, stepReply)
-	case models.StepTypeNtpSynchronizer:
-		err = b.processNtpSynchronizerResponse(ctx, &host, stepReply)
-	case models.StepTypeContainerImageAvailability:
-		err = b.processImageAvailabilityResponse(ctx, &host, stepReply)
-	case models.StepTypeInstallationDiskSpeedCheck:
-		err = b.processDiskSpeedCheckResponse(ctx, &host, stepReply, 0)
+	switch fileName {
+	case constants.Kubeconfig:
+		err = clusterPkg.CanDownloadKubeconfig(cluster)
+	case manifests.ManifestFolder, "discovery.ign":
+		// do nothing. manifests can be downloaded at any given cluster state
+	default:
+		err = clusterPkg.CanDownloadFiles(cluster)
 	}
-	return err
+	if err != nil {
+		log.WithError(err).Errorf("failed to get file for cluster %s in current state", clusterID)
+		return common.NewApiError(http.StatusConflict, err)
+	}
+	return nil
 }
 
-func logReplyReceived(params installer.PostStepReplyParams, log logrus.FieldLogger) {
-	message := fmt.Sprintf("Received step reply <%s> from cluster <%s> host <%s> exit-code <%d> stderr <%s>",
-		params.Reply.StepID, params.ClusterID, params.HostID, params.Reply.ExitCode, params.Reply.Error)
-	messageWithOutput := fmt.Sprintf("%s stdout <%s>", message, params.Reply.Output)
+func (b *bareMetalInventory) UpdateHostIgnitionInternal(ctx context.Context, params installer.UpdateHostIgnitionParams) (*models.Host, error) {
+	log := logutil.FromContext(ctx, b.log)
 
-	if params.Reply.ExitCode != 0 {
-		log.Error(messageWithOutput)
-		return
+	h, err := b.getHost(ctx, params.ClusterID.String(), params.HostID.String())
+	if err != nil {
+		return nil, err
 	}
 
-	if params.Reply.StepType == models.StepTypeFreeNetworkAddresses {
-		log.Info(message)
-	} else {
-		log.Info(messageWithOutput)
+	config := params.HostIgnitionParams.Config
+	if config != "" {
+		config, err = ignition.ValidateHostIgnitionOverride(config)
+		if err != nil {
+			log.WithError(err).Errorf("Failed to validate host ignition config patch %s", params.HostIgnitionParams)
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
 	}
-}
-
-func filterReplyByType(params installer.PostStepReplyParams) (string, error) {
-	var stepReply string
-	var err error
 
-	// To make sure we store only information defined in swagger we unmarshal and marshal the stepReplyParams.
-	switch params.Reply.StepType {
-	case models.StepTypeInventory:
-		stepReply, err = filterReply(&models.Inventory{}, params.Reply.Output)
-	case models.StepTypeConnectivityCheck:
-		stepReply, err = filterReply(&models.ConnectivityReport{}, params.Reply.Output)
-	case models.StepTypeAPIVipConnectivityCheck:
-		stepReply, err = filterReply(&models.APIVipConnectivityResponse{}, params.Reply.Output)
-	case models.StepTypeFreeNetworkAddresses:
-		stepReply, err = filterReply(&models.FreeNetworksAddresses{}, params.Reply.Output)
-	case models.StepTypeDhcpLeaseAllocate:
-		stepReply, err = filterReply(&models.DhcpAllocationResponse{}, params.Reply.Output)
-	case models.StepTypeNtpSynchronizer:
-		stepReply, err = filterReply(&models.NtpSynchronizationResponse{}, params.Reply.Output)
-	case models.StepTypeContainerImageAvailability:
-		stepReply, err = filterReply(&models.ContainerImageAvailabilityResponse{}, params.Reply.Output)
-	case models.StepTypeInstallationDiskSpeedCheck:
-		stepReply, err = filterReply(&models.DiskSpeedCheckResponse{}, params.Reply.Output)
+	err = b.db.Model(&common.Host{}).Where(identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), params.HostID, params.ClusterID).Update("ignition_config_overrides", config).Error
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	return stepReply, err
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityInfo, fmt.Sprintf("Host %s: custom discovery ignition config was applied", hostutil.GetHostnameForMsg(&h.Host)), time.Now())
+	log.Infof("Custom discovery ignition config was applied to host %s in cluster %s", params.HostID, params.ClusterID)
+	h, err = b.getHost(ctx, params.ClusterID.String(), params.HostID.String())
+	if err != nil {
+		log.WithError(err).Errorf("failed to get host %s after update", params.HostID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return &h.Host, nil
 }
 
-// filterReply return only the expected parameters from the input.
-func filterReply(expected interface{}, input string) (string, error) {
-	if err := json.Unmarshal([]byte(input), expected); err != nil {
-		return "", err
-	}
-	reply, err := json.Marshal(expected)
+func (b *bareMetalInventory) UpdateHostIgnition(ctx context.Context, params installer.UpdateHostIgnitionParams) middleware.Responder {
+	_, err := b.UpdateHostIgnitionInternal(ctx, params)
 	if err != nil {
-		return "", err
+		return common.GenerateErrorResponder(err)
 	}
-	return string(reply), nil
+	return installer.NewUpdateHostIgnitionCreated()
 }
 
-func (b *bareMetalInventory) DisableHost(ctx context.Context, params installer.DisableHostParams) middleware.Responder {
+func (b *bareMetalInventory) GetHostIgnition(ctx context.Context, params installer.GetHostIgnitionParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
-	log.Info("disabling host: ", params.HostID)
-
-	txSuccess := false
-	tx := b.db.Begin()
-	tx = transaction.AddForUpdateQueryOption(tx)
 
-	defer func() {
-		if !txSuccess {
-			log.Error("update cluster failed")
-			tx.Rollback()
-		}
-		if r := recover(); r != nil {
-			log.Error("update cluster failed")
-			tx.Rollback()
-		}
-	}()
+	_, respBody, _, err := b.downloadHostIgnition(ctx, params.ClusterID.String(), params.HostID.String())
+	if err != nil {
+		log.WithError(err).Errorf("failed to download host %s ignition", params.HostID)
+		return common.GenerateErrorResponder(err)
+	}
 
-	host, err := common.GetHostFromDB(b.db, params.ClusterID.String(), params.HostID.String())
+	respBytes, err := ioutil.ReadAll(respBody)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			log.WithError(err).Errorf("host %s not found", params.HostID)
-			return common.NewApiError(http.StatusNotFound, err)
-		}
-		log.WithError(err).Errorf("failed to get host %s", params.HostID)
-		msg := fmt.Sprintf("Failed to disable host %s: error fetching host from DB", params.HostID.String())
-		b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityError, msg, time.Now())
+		log.WithError(err).Errorf("failed to read ignition content for host %s", params.HostID)
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	if err = b.hostApi.DisableHost(ctx, &host.Host, tx); err != nil {
-		log.WithError(err).Errorf("failed to disable host <%s> from cluster <%s>", params.HostID, params.ClusterID)
-		msg := fmt.Sprintf("Failed to disable host %s: error disabling host in current status",
-			hostutil.GetHostnameForMsg(&host.Host))
-		b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityError, msg, time.Now())
-		return common.GenerateErrorResponderWithDefault(err, http.StatusConflict)
-	}
+	return installer.NewGetHostIgnitionOK().WithPayload(&models.HostIgnitionParams{Config: string(respBytes)})
+}
 
-	c, err := b.refreshHostAndClusterStatuses(ctx, "disable host", &params.HostID, &params.ClusterID, tx)
+func (b *bareMetalInventory) GetHostIgnitionPreview(ctx context.Context, params installer.GetHostIgnitionPreviewParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	c, err := b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading)
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
 
-	if err = tx.Commit().Error; err != nil {
-		log.Error(err)
-		return installer.NewResetClusterInternalServerError().WithPayload(
-			common.GenerateError(http.StatusInternalServerError, errors.New("DB error, failed to commit transaction")))
+	var host *models.Host
+	for i, h := range c.Hosts {
+		if *h.ID == params.HostID {
+			host = c.Hosts[i]
+			break
+		}
+	}
+	if host == nil {
+		err = errors.Errorf("host %s not found in cluster %s", params.HostID, params.ClusterID)
+		return common.NewApiError(http.StatusNotFound, err)
 	}
-	txSuccess = true
 
-	b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityInfo,
-		fmt.Sprintf("Host %s disabled by user", hostutil.GetHostnameForMsg(&host.Host)), time.Now())
+	allowedStatuses := []string{models.ClusterStatusInsufficient, models.ClusterStatusReady, models.ClusterStatusPendingForInput}
+	if !funk.ContainsString(allowedStatuses, swag.StringValue(c.Status)) {
+		err = errors.Errorf("Cluster %s is in %s state, ignition can be previewed only in one of %s, download the host ignition instead",
+			c.ID, swag.StringValue(c.Status), allowedStatuses)
+		return common.NewApiError(http.StatusConflict, err)
+	}
 
-	c, err = b.GetClusterInternal(ctx, installer.GetClusterParams{ClusterID: *c.ID})
+	preview, err := b.IgnitionBuilder.PreviewHostIgnition(c, host, b.IgnitionConfig, b.authHandler.AuthType())
 	if err != nil {
-		return common.GenerateErrorResponder(err)
+		log.WithError(err).Errorf("failed to preview ignition of host %s", params.HostID)
+		return common.NewApiError(http.StatusInternalServerError, err)
 	}
-	return installer.NewDisableHostOK().WithPayload(&c.Cluster)
+
+	return installer.NewGetHostIgnitionPreviewOK().WithPayload(preview)
 }
 
-func (b *bareMetalInventory) EnableHost(ctx context.Context, params installer.EnableHostParams) middleware.Responder {
+func (b *bareMetalInventory) DownloadHostIgnition(ctx context.Context, params installer.DownloadHostIgnitionParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
-	log.Info("enable host: ", params.HostID)
-
-	txSuccess := false
-	tx := b.db.Begin()
-	tx = transaction.AddForUpdateQueryOption(tx)
+	fileName, respBody, contentLength, err := b.downloadHostIgnition(ctx, params.ClusterID.String(), params.HostID.String())
+	if err != nil {
+		log.WithError(err).Errorf("failed to download host %s ignition", params.HostID)
+		return common.GenerateErrorResponder(err)
+	}
 
-	defer func() {
-		if !txSuccess {
-			log.Error("update cluster failed")
-			tx.Rollback()
-		}
-		if r := recover(); r != nil {
-			log.Error("update cluster failed")
-			tx.Rollback()
-		}
-	}()
+	return filemiddleware.NewResponder(installer.NewDownloadHostIgnitionOK().WithPayload(respBody), fileName, contentLength)
+}
 
-	host, err := common.GetHostFromDB(tx, params.ClusterID.String(), params.HostID.String())
+// downloadHostIgnition returns the ignition file name, the content as an io.ReadCloser, and the file content length
+func (b *bareMetalInventory) downloadHostIgnition(ctx context.Context, clusterID string, hostID string) (string, io.ReadCloser, int64, error) {
+	c, err := b.getCluster(ctx, clusterID, common.UseEagerLoading)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			log.WithError(err).Errorf("host %s not found", params.HostID)
-			return common.NewApiError(http.StatusNotFound, err)
-		}
-		log.WithError(err).Errorf("failed to get host %s", params.HostID)
-		msg := fmt.Sprintf("Failed to enable host %s: error fetching host from DB", params.HostID)
-		b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityError, msg, time.Now())
-		return common.NewApiError(http.StatusInternalServerError, err)
+		return "", nil, 0, err
 	}
 
-	if err = b.hostApi.EnableHost(ctx, &host.Host, tx); err != nil {
-		log.WithError(err).Errorf("failed to enable host <%s> from cluster <%s>", params.HostID, params.ClusterID)
-		msg := fmt.Sprintf("Failed to enable host %s: error disabling host in current status",
-			hostutil.GetHostnameForMsg(&host.Host))
-		b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityError, msg, time.Now())
-		return common.GenerateErrorResponderWithDefault(err, http.StatusConflict)
+	// check if host id is in cluster
+	var host *models.Host
+	for i, h := range c.Hosts {
+		if h.ID.String() == hostID {
+			host = c.Hosts[i]
+			break
+		}
+	}
+	if host == nil {
+		err = errors.Errorf("host %s not found in cluster %s", hostID, clusterID)
+		return "", nil, 0, common.NewApiError(http.StatusNotFound, err)
 	}
 
-	c, err := b.refreshHostAndClusterStatuses(ctx, "enable host", &params.HostID, &params.ClusterID, tx)
+	// check if cluster is in the correct state to download files
+	err = clusterPkg.CanDownloadFiles(c)
 	if err != nil {
-		return common.GenerateErrorResponder(err)
+		return "", nil, 0, common.NewApiError(http.StatusConflict, err)
 	}
 
-	if err = tx.Commit().Error; err != nil {
-		log.Error(err)
-		return common.NewApiError(http.StatusInternalServerError, errors.New("DB error, failed to commit transaction"))
+	fileName := hostutil.IgnitionFileName(host)
+	respBody, contentLength, err := b.objectHandler.Download(ctx, fmt.Sprintf("%s/%s", clusterID, fileName))
+	if err != nil {
+		return "", nil, 0, common.NewApiError(http.StatusInternalServerError, err)
 	}
-	txSuccess = true
 
-	b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityInfo,
-		fmt.Sprintf("Host %s enabled by user", hostutil.GetHostnameForMsg(&host.Host)), time.Now())
+	return fileName, respBody, contentLength, nil
+}
 
-	c, err = b.GetClusterInternal(ctx, installer.GetClusterParams{ClusterID: *c.ID})
+func (b *bareMetalInventory) GetCredentials(ctx context.Context, params installer.GetCredentialsParams) middleware.Responder {
+	c, err := b.GetCredentialsInternal(ctx, params)
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
-	return installer.NewEnableHostOK().WithPayload(&c.Cluster)
+	return installer.NewGetCredentialsOK().WithPayload(c)
 }
 
-func (b *bareMetalInventory) refreshHostAndClusterStatuses(
-	ctx context.Context,
-	eventName string,
-	hostID *strfmt.UUID,
-	clusterID *strfmt.UUID,
-	db *gorm.DB) (*common.Cluster, error) {
+func (b *bareMetalInventory) GetCredentialsInternal(ctx context.Context, params installer.GetCredentialsParams) (*models.Credentials, error) {
 
-	logger := logutil.FromContext(ctx, b.log)
+	log := logutil.FromContext(ctx, b.log)
+	var cluster common.Cluster
 
-	var err error
+	db := common.LoadTableFromDB(b.db, common.MonitoredOperatorsTable)
+	if err := db.First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+		return nil, err
+	}
+	if !b.clusterApi.IsOperatorAvailable(&cluster, operators.OperatorConsole.Name) {
+		err := errors.New("console-url isn't available yet, it will be once console operator is ready as part of cluster finalizing stage")
+		log.WithError(err)
+		return nil, common.NewApiError(http.StatusConflict, err)
+	}
+	objectName := fmt.Sprintf("%s/%s", params.ClusterID, "kubeadmin-password")
+	r, _, err := b.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to get clusters %s object", objectName)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	defer r.Close()
+	password, err := ioutil.ReadAll(r)
+	if err != nil {
+		log.WithError(errors.Errorf("%s", password)).Errorf("Failed to get clusters %s", objectName)
+		return nil, common.NewApiError(http.StatusConflict, errors.New(string(password)))
+	}
+	return &models.Credentials{
+		Username:   DefaultUser,
+		Password:   string(password),
+		ConsoleURL: common.GetConsoleUrl(cluster.Name, cluster.BaseDNSDomain),
+	}, nil
+}
 
-	defer func() {
-		if err == nil {
-			return
-		}
-		logger.WithError(err).Errorf("%s:", eventName)
-		if !errors.Is(err, gorm.ErrRecordNotFound) {
-			b.eventsHandler.AddEvent(
-				ctx,
-				*clusterID,
-				hostID,
-				models.EventSeverityError,
-				err.Error(),
-				time.Now())
-		}
-	}()
-	err = b.setMajorityGroupForCluster(clusterID, db)
+func (b *bareMetalInventory) UpdateHostInstallProgress(ctx context.Context, params installer.UpdateHostInstallProgressParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("Update host %s install progress", params.HostID)
+	host, err := common.GetHostFromDB(b.db, params.ClusterID.String(), params.HostID.String())
 	if err != nil {
-		return nil, err
+		log.WithError(err).Errorf("failed to find host %s", params.HostID)
+		return installer.NewUpdateHostInstallProgressNotFound().
+			WithPayload(common.GenerateError(http.StatusNotFound, err))
 	}
-	err = b.refreshHostStatus(ctx, hostID, clusterID, db)
-	if err != nil {
-		return nil, err
+
+	if params.HostProgress.CurrentStage != host.Progress.CurrentStage || params.HostProgress.ProgressInfo != host.Progress.ProgressInfo {
+		if err := b.hostApi.UpdateInstallProgress(ctx, &host.Host, params.HostProgress); err != nil {
+			log.WithError(err).Errorf("failed to update host %s progress", params.HostID)
+			return installer.NewUpdateHostInstallProgressInternalServerError().WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+		}
+
+		event := fmt.Sprintf("reached installation stage %s", params.HostProgress.CurrentStage)
+		if params.HostProgress.ProgressInfo != "" {
+			event += fmt.Sprintf(": %s", params.HostProgress.ProgressInfo)
+		}
+
+		log.Info(fmt.Sprintf("Host %s in cluster %s: %s", host.ID, host.ClusterID, event))
+		msg := fmt.Sprintf("Host %s: %s", hostutil.GetHostnameForMsg(&host.Host), event)
+		b.eventsHandler.AddEvent(ctx, host.ClusterID, host.ID, models.EventSeverityInfo, msg, time.Now())
 	}
 
-	c, err := b.refreshClusterStatus(ctx, clusterID, db)
-	return c, err
+	return installer.NewUpdateHostInstallProgressOK()
 }
 
-func (b *bareMetalInventory) refreshHostStatus(
-	ctx context.Context,
-	hostID *strfmt.UUID,
-	clusterID *strfmt.UUID,
-	db *gorm.DB) error {
+func (b *bareMetalInventory) UploadClusterIngressCert(ctx context.Context, params installer.UploadClusterIngressCertParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("UploadClusterIngressCert for cluster %s with params %s", params.ClusterID, params.IngressCertParams)
+	var cluster common.Cluster
 
-	h, err := common.GetHostFromDB(db, clusterID.String(), hostID.String())
-	if err != nil {
-		return err
+	if err := b.db.First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return installer.NewUploadClusterIngressCertNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+		} else {
+			return installer.NewUploadClusterIngressCertInternalServerError().
+				WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+		}
 	}
 
-	if err := b.hostApi.RefreshStatus(ctx, &h.Host, db); err != nil {
-		return errors.Wrapf(err, "failed to refresh status of host: %s", h.ID)
+	if err := b.clusterApi.UploadIngressCert(&cluster); err != nil {
+		return installer.NewUploadClusterIngressCertBadRequest().
+			WithPayload(common.GenerateError(http.StatusBadRequest, err))
 	}
 
-	return nil
-}
+	objectName := fmt.Sprintf("%s/%s", cluster.ID, constants.Kubeconfig)
+	exists, err := b.objectHandler.DoesObjectExist(ctx, objectName)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to upload ingress ca")
+		return installer.NewUploadClusterIngressCertInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
 
-func (b *bareMetalInventory) setMajorityGroupForCluster(clusterID *strfmt.UUID, db *gorm.DB) error {
-	return b.clusterApi.SetConnectivityMajorityGroupsForCluster(*clusterID, db)
-}
+	if exists {
+		log.Infof("Ingress ca for cluster %s already exists", cluster.ID)
+		return installer.NewUploadClusterIngressCertCreated()
+	}
 
-func (b *bareMetalInventory) refreshClusterStatus(
-	ctx context.Context,
-	clusterID *strfmt.UUID,
-	db *gorm.DB) (*common.Cluster, error) {
+	noingress := fmt.Sprintf("%s/%s-noingress", cluster.ID, constants.Kubeconfig)
+	resp, _, err := b.objectHandler.Download(ctx, noingress)
+	if err != nil {
+		return installer.NewUploadClusterIngressCertInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
 
-	cluster, err := common.GetClusterFromDB(db, *clusterID, common.SkipEagerLoading)
+	kubeconfigData, err := ioutil.ReadAll(resp)
 	if err != nil {
-		return nil, err
+		log.WithError(err).Infof("Failed to convert kubeconfig s3 response to io reader")
+		return installer.NewUploadClusterIngressCertInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
 
-	updatedCluster, err := b.clusterApi.RefreshStatus(ctx, cluster, db)
+	mergedKubeConfig, err := mergeIngressCaIntoKubeconfig(kubeconfigData, []byte(params.IngressCertParams), log)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to refresh status of cluster: %s", cluster.ID)
+		return installer.NewUploadClusterIngressCertInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
 
-	return updatedCluster, nil
+	if err := b.objectHandler.Upload(ctx, mergedKubeConfig, objectName); err != nil {
+		return installer.NewUploadClusterIngressCertInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, errors.Errorf("failed to upload %s to s3", objectName)))
+	}
+	return installer.NewUploadClusterIngressCertCreated()
 }
 
-func (b *bareMetalInventory) GetPresignedForClusterFiles(ctx context.Context, params installer.GetPresignedForClusterFilesParams) middleware.Responder {
-	log := logutil.FromContext(ctx, b.log)
-	// Presigned URL only works with AWS S3 because Scality is not exposed
-	if !b.objectHandler.IsAwsS3() {
-		return common.NewApiError(http.StatusBadRequest, errors.New("Failed to generate presigned URL: invalid backend"))
+// Merging given ingress ca certificate into kubeconfig
+// Code was taken from openshift installer
+func mergeIngressCaIntoKubeconfig(kubeconfigData []byte, ingressCa []byte, log logrus.FieldLogger) ([]byte, error) {
+
+	kconfig, err := clientcmd.Load(kubeconfigData)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to convert kubeconfig data")
+		return nil, err
 	}
-	var err error
-	fullFileName := fmt.Sprintf("%s/%s", params.ClusterID, params.FileName)
-	downloadFilename := params.FileName
-	if params.FileName == manifests.ManifestFolder {
-		if params.AdditionalName != nil {
-			additionalName := *params.AdditionalName
-			fullFileName = manifests.GetManifestObjectName(params.ClusterID, additionalName)
-			downloadFilename = additionalName[strings.LastIndex(additionalName, "/")+1:]
-		} else {
-			err = errors.New("Additional name must be provided for 'manifests' file name, prefaced with folder name, e.g.: openshift/99-openshift-xyz.yaml")
-			return common.GenerateErrorResponder(err)
-		}
+	if kconfig == nil || len(kconfig.Clusters) == 0 {
+		err = errors.Errorf("kubeconfig is missing expected data")
+		log.Error(err)
+		return nil, err
 	}
 
-	if params.FileName == "logs" {
-		if params.HostID != nil && swag.StringValue(params.LogsType) == "" {
-			logsType := string(models.LogsTypeHost)
-			params.LogsType = &logsType
+	for _, c := range kconfig.Clusters {
+		clusterCABytes := c.CertificateAuthorityData
+		if len(clusterCABytes) == 0 {
+			err = errors.Errorf("kubeconfig CertificateAuthorityData not found")
+			log.Errorf("%e, data %s", err, c.CertificateAuthorityData)
+			return nil, err
 		}
-		fullFileName, downloadFilename, err = b.getLogFileForDownload(ctx, &params.ClusterID, params.HostID, swag.StringValue(params.LogsType))
-		if err != nil {
-			return common.GenerateErrorResponder(err)
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(clusterCABytes) {
+			err = errors.Errorf("cluster CA found in kubeconfig not valid PEM format")
+			log.Errorf("%e, ca :%s", err, clusterCABytes)
+			return nil, err
 		}
-	} else if err = b.checkFileForDownload(ctx, params.ClusterID.String(), params.FileName); err != nil {
-		return common.GenerateErrorResponder(err)
+		if !certPool.AppendCertsFromPEM(ingressCa) {
+			err = errors.Errorf("given ingress-ca is not valid PEM format")
+			log.Errorf("%e %s", err, ingressCa)
+			return nil, err
+		}
+
+		newCA := append(ingressCa, clusterCABytes...)
+		c.CertificateAuthorityData = newCA
 	}
 
-	duration, _ := time.ParseDuration("10m")
-	url, err := b.objectHandler.GeneratePresignedDownloadURL(ctx, fullFileName, downloadFilename, duration)
+	kconfigAsByteArray, err := clientcmd.Write(*kconfig)
 	if err != nil {
-		log.WithError(err).Errorf("failed to generate presigned URL: %s from cluster: %s", params.FileName, params.ClusterID.String())
-		return common.NewApiError(http.StatusInternalServerError, err)
+		return nil, errors.Wrap(err, "failed to convert kubeconfig")
 	}
-	return installer.NewGetPresignedForClusterFilesOK().WithPayload(&models.Presigned{URL: &url})
+	return kconfigAsByteArray, nil
 }
 
-func (b *bareMetalInventory) DownloadClusterFiles(ctx context.Context, params installer.DownloadClusterFilesParams) middleware.Responder {
-	log := logutil.FromContext(ctx, b.log)
-	if err := b.checkFileForDownload(ctx, params.ClusterID.String(), params.FileName); err != nil {
-		return common.GenerateErrorResponder(err)
-	}
-
-	respBody, contentLength, err := b.objectHandler.Download(ctx, fmt.Sprintf("%s/%s", params.ClusterID, params.FileName))
-	if err != nil {
-		log.WithError(err).Errorf("failed to download file %s from cluster: %s", params.FileName, params.ClusterID.String())
-		return common.GenerateErrorResponder(err)
+func setPullSecret(cluster *common.Cluster, pullSecret string) {
+	cluster.PullSecret = pullSecret
+	if pullSecret != "" {
+		cluster.PullSecretSet = true
+	} else {
+		cluster.PullSecretSet = false
 	}
-
-	return filemiddleware.NewResponder(installer.NewDownloadClusterFilesOK().WithPayload(respBody), params.FileName, contentLength)
 }
 
-func (b *bareMetalInventory) DownloadClusterKubeconfig(ctx context.Context, params installer.DownloadClusterKubeconfigParams) middleware.Responder {
-	respBody, contentLength, err := b.DownloadClusterKubeconfigInternal(ctx, params)
+func (b *bareMetalInventory) CancelInstallation(ctx context.Context, params installer.CancelInstallationParams) middleware.Responder {
+	c, err := b.CancelInstallationInternal(ctx, params)
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
-	return filemiddleware.NewResponder(installer.NewDownloadClusterKubeconfigOK().WithPayload(respBody), constants.Kubeconfig, contentLength)
+	return installer.NewCancelInstallationAccepted().WithPayload(&c.Cluster)
 }
 
-func (b *bareMetalInventory) DownloadClusterKubeconfigInternal(ctx context.Context, params installer.DownloadClusterKubeconfigParams) (io.ReadCloser, int64, error) {
-	if err := b.checkFileForDownload(ctx, params.ClusterID.String(), constants.Kubeconfig); err != nil {
-		return nil, 0, err
-	}
+func (b *bareMetalInventory) CancelInstallationInternal(ctx context.Context, params installer.CancelInstallationParams) (*common.Cluster, error) {
 
-	respBody, contentLength, err := b.objectHandler.Download(ctx, fmt.Sprintf("%s/%s", params.ClusterID, constants.Kubeconfig))
-	if err != nil {
-		return nil, 0, common.NewApiError(http.StatusConflict, err)
-	}
-	return respBody, contentLength, nil
-}
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("canceling installation for cluster %s", params.ClusterID)
 
-func (b *bareMetalInventory) getLogFileForDownload(ctx context.Context, clusterId *strfmt.UUID, hostId *strfmt.UUID, logsType string) (string, string, error) {
-	var fileName string
-	var downloadFileName string
-	c, err := b.getCluster(ctx, clusterId.String(), common.UseEagerLoading, common.IncludeDeletedRecords)
-	if err != nil {
-		return "", "", err
-	}
-	switch logsType {
-	case string(models.LogsTypeHost):
-		if hostId == nil {
-			return "", "", common.NewApiError(http.StatusBadRequest, errors.Errorf("Host ID must be provided for downloading host logs"))
-		}
+	cluster := &common.Cluster{}
 
-		var hostObject *common.Host
-		hostObject, err = b.getHost(ctx, clusterId.String(), hostId.String())
-		if err != nil {
-			return "", "", err
-		}
-		if hostObject.LogsCollectedAt == strfmt.DateTime(time.Time{}) {
-			return "", "", common.NewApiError(http.StatusConflict, errors.Errorf("Logs for host %s were not found", hostId))
-		}
-		fileName = b.getLogsFullName(clusterId.String(), hostObject.ID.String())
-		role := string(hostObject.Role)
-		if hostObject.Bootstrap {
-			role = string(models.HostRoleBootstrap)
+	txSuccess := false
+	tx := b.db.Begin()
+	tx = transaction.AddForUpdateQueryOption(tx)
+	defer func() {
+		if !txSuccess {
+			log.Error("cancel installation failed")
+			tx.Rollback()
 		}
-		downloadFileName = fmt.Sprintf("%s_%s_%s.tar.gz", sanitize.Name(c.Name), role, sanitize.Name(hostutil.GetHostnameForMsg(&hostObject.Host)))
-	case string(models.LogsTypeController):
-		if c.Cluster.ControllerLogsCollectedAt == strfmt.DateTime(time.Time{}) {
-			return "", "", common.NewApiError(http.StatusConflict, errors.Errorf("Controller Logs for cluster %s were not found", clusterId))
+		if r := recover(); r != nil {
+			log.Error("cancel installation failed")
+			tx.Rollback()
 		}
-		fileName = b.getLogsFullName(clusterId.String(), logsType)
-		downloadFileName = fmt.Sprintf("%s_%s_%s.tar.gz", sanitize.Name(c.Name), c.ID, logsType)
-	default:
-		fileName, err = b.prepareClusterLogs(ctx, c)
-		downloadFileName = fmt.Sprintf("%s_%s.tar", sanitize.Name(c.Name), c.ID)
-		if err != nil {
-			return "", "", common.NewApiError(http.StatusInternalServerError, err)
+	}()
+
+	if tx.Error != nil {
+		msg := "Failed to cancel installation: error starting DB transaction"
+		log.WithError(tx.Error).Errorf(msg)
+		b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError, msg, time.Now())
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.New(msg))
+	}
+
+	var err error
+	if cluster, err = common.GetClusterFromDB(tx, params.ClusterID, common.UseEagerLoading); err != nil {
+		log.WithError(err).Errorf("Failed to cancel installation: could not find cluster %s", params.ClusterID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
 		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	return fileName, downloadFileName, nil
-}
-
-func (b *bareMetalInventory) checkFileForDownload(ctx context.Context, clusterID, fileName string) error {
-	log := logutil.FromContext(ctx, b.log)
-	log.Infof("Checking cluster cluster file for download: %s for cluster %s", fileName, clusterID)
-
-	if !funk.Contains(clusterPkg.S3FileNames, fileName) && fileName != manifests.ManifestFolder {
-		err := errors.Errorf("invalid cluster file %s", fileName)
-		log.WithError(err).Errorf("failed download file: %s from cluster: %s", fileName, clusterID)
-		return common.NewApiError(http.StatusBadRequest, err)
+	// cancellation is made by setting the cluster and and hosts states to error.
+	if err := b.clusterApi.CancelInstallation(ctx, cluster, "Installation was cancelled by user", tx); err != nil {
+		return nil, err
 	}
-
-	cluster, err := b.getCluster(ctx, clusterID)
-	if err != nil {
-		return err
+	for _, h := range cluster.Hosts {
+		if err := b.hostApi.CancelInstallation(ctx, h, "Installation was cancelled by user", tx); err != nil {
+			return nil, err
+		}
+		if err := b.customizeHost(h); err != nil {
+			return nil, err
+		}
 	}
 
-	switch fileName {
-	case constants.Kubeconfig:
-		err = clusterPkg.CanDownloadKubeconfig(cluster)
-	case manifests.ManifestFolder, "discovery.ign":
-		// do nothing. manifests can be downloaded at any given cluster state
-	default:
-		err = clusterPkg.CanDownloadFiles(cluster)
-	}
-	if err != nil {
-		log.WithError(err).Errorf("failed to get file for cluster %s in current state", clusterID)
-		return common.NewApiError(http.StatusConflict, err)
+	if err := tx.Commit().Error; err != nil {
+		log.Errorf("Failed to cancel installation: error committing DB transaction (%s)", err)
+		msg := "Failed to cancel installation: error committing DB transaction"
+		b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError, msg, time.Now())
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.New("DB error, failed to commit transaction"))
 	}
-	return nil
+	txSuccess = true
+
+	return cluster, nil
 }
 
-func (b *bareMetalInventory) UpdateHostIgnitionInternal(ctx context.Context, params installer.UpdateHostIgnitionParams) (*models.Host, error) {
+func (b *bareMetalInventory) ResetCluster(ctx context.Context, params installer.ResetClusterParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
+	log.Infof("resetting cluster %s", params.ClusterID)
 
-	h, err := b.getHost(ctx, params.ClusterID.String(), params.HostID.String())
-	if err != nil {
-		return nil, err
-	}
+	var cluster *common.Cluster
 
-	if params.HostIgnitionParams.Config != "" {
-		_, err = ignition.ParseToLatest([]byte(params.HostIgnitionParams.Config))
-		if err != nil {
-			log.WithError(err).Errorf("Failed to parse host ignition config patch %s", params.HostIgnitionParams)
-			return nil, common.NewApiError(http.StatusBadRequest, err)
+	txSuccess := false
+	tx := b.db.Begin()
+	tx = transaction.AddForUpdateQueryOption(tx)
+	defer func() {
+		if !txSuccess {
+			log.Error("reset cluster failed")
+			tx.Rollback()
 		}
-	}
+		if r := recover(); r != nil {
+			log.Error("reset cluster failed")
+			tx.Rollback()
+		}
+	}()
 
-	err = b.db.Model(&common.Host{}).Where(identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), params.HostID, params.ClusterID).Update("ignition_config_overrides", params.HostIgnitionParams.Config).Error
-	if err != nil {
-		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	if tx.Error != nil {
+		log.WithError(tx.Error).Errorf("failed to start db transaction")
+		return installer.NewResetClusterInternalServerError().WithPayload(
+			common.GenerateError(http.StatusInternalServerError, errors.New("DB error, failed to start transaction")))
 	}
 
-	b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityInfo, fmt.Sprintf("Host %s: custom discovery ignition config was applied", hostutil.GetHostnameForMsg(&h.Host)), time.Now())
-	log.Infof("Custom discovery ignition config was applied to host %s in cluster %s", params.HostID, params.ClusterID)
-	h, err = b.getHost(ctx, params.ClusterID.String(), params.HostID.String())
-	if err != nil {
-		log.WithError(err).Errorf("failed to get host %s after update", params.HostID)
-		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	var err error
+	if cluster, err = common.GetClusterFromDB(tx, params.ClusterID, common.UseEagerLoading); err != nil {
+		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return installer.NewResetClusterNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+		}
+		return installer.NewResetClusterInternalServerError().WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
-	return &h.Host, nil
-}
 
-func (b *bareMetalInventory) UpdateHostIgnition(ctx context.Context, params installer.UpdateHostIgnitionParams) middleware.Responder {
-	_, err := b.UpdateHostIgnitionInternal(ctx, params)
-	if err != nil {
+	if err := b.clusterApi.ResetCluster(ctx, cluster, "cluster was reset by user", tx); err != nil {
 		return common.GenerateErrorResponder(err)
 	}
-	return installer.NewUpdateHostIgnitionCreated()
-}
-
-func (b *bareMetalInventory) GetHostIgnition(ctx context.Context, params installer.GetHostIgnitionParams) middleware.Responder {
-	log := logutil.FromContext(ctx, b.log)
 
-	_, respBody, _, err := b.downloadHostIgnition(ctx, params.ClusterID.String(), params.HostID.String())
-	if err != nil {
-		log.WithError(err).Errorf("failed to download host %s ignition", params.HostID)
-		return common.GenerateErrorResponder(err)
+	for _, h := range cluster.Hosts {
+		if err := b.hostApi.ResetHost(ctx, h, "cluster was reset by user", tx); err != nil {
+			return common.GenerateErrorResponder(err)
+		}
+		if err := b.customizeHost(h); err != nil {
+			return installer.NewResetClusterInternalServerError().WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+		}
 	}
 
-	respBytes, err := ioutil.ReadAll(respBody)
-	if err != nil {
-		log.WithError(err).Errorf("failed to read ignition content for host %s", params.HostID)
+	if err := b.clusterApi.DeleteClusterFiles(ctx, cluster, b.objectHandler); err != nil {
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
+	if err := b.deleteDNSRecordSets(ctx, *cluster); err != nil {
+		log.Warnf("failed to delete DNS record sets for base domain: %s", cluster.BaseDNSDomain)
+	}
 
-	return installer.NewGetHostIgnitionOK().WithPayload(&models.HostIgnitionParams{Config: string(respBytes)})
-}
-
-func (b *bareMetalInventory) DownloadHostIgnition(ctx context.Context, params installer.DownloadHostIgnitionParams) middleware.Responder {
-	log := logutil.FromContext(ctx, b.log)
-	fileName, respBody, contentLength, err := b.downloadHostIgnition(ctx, params.ClusterID.String(), params.HostID.String())
-	if err != nil {
-		log.WithError(err).Errorf("failed to download host %s ignition", params.HostID)
-		return common.GenerateErrorResponder(err)
+	if err := tx.Commit().Error; err != nil {
+		log.Error(err)
+		return installer.NewResetClusterInternalServerError().WithPayload(
+			common.GenerateError(http.StatusInternalServerError, errors.New("DB error, failed to commit transaction")))
 	}
+	txSuccess = true
 
-	return filemiddleware.NewResponder(installer.NewDownloadHostIgnitionOK().WithPayload(respBody), fileName, contentLength)
+	return installer.NewResetClusterAccepted().WithPayload(&cluster.Cluster)
 }
 
-// downloadHostIgnition returns the ignition file name, the content as an io.ReadCloser, and the file content length
-func (b *bareMetalInventory) downloadHostIgnition(ctx context.Context, clusterID string, hostID string) (string, io.ReadCloser, int64, error) {
-	c, err := b.getCluster(ctx, clusterID, common.UseEagerLoading)
-	if err != nil {
-		return "", nil, 0, err
-	}
+func (b *bareMetalInventory) InstallHost(ctx context.Context, params installer.InstallHostParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var h *models.Host
+	var cluster *common.Cluster
+	var err error
 
-	// check if host id is in cluster
-	var host *models.Host
-	for i, h := range c.Hosts {
-		if h.ID.String() == hostID {
-			host = c.Hosts[i]
+	log.Info("Install single day2 host: ", params.HostID)
+	if cluster, err = common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	for i := range cluster.Hosts {
+		if *cluster.Hosts[i].ID == params.HostID {
+			h = cluster.Hosts[i]
 			break
 		}
 	}
-	if host == nil {
-		err = errors.Errorf("host %s not found in cluster %s", hostID, clusterID)
-		return "", nil, 0, common.NewApiError(http.StatusNotFound, err)
+	if h == nil {
+		log.WithError(err).Errorf("host %s not found", params.HostID)
+		return common.NewApiError(http.StatusNotFound, err)
 	}
 
-	// check if cluster is in the correct state to download files
-	err = clusterPkg.CanDownloadFiles(c)
-	if err != nil {
-		return "", nil, 0, common.NewApiError(http.StatusConflict, err)
+	if !hostutil.IsDay2Host(h) {
+		log.Errorf("InstallHost for host %s is forbidden: not a Day2 hosts", params.HostID.String())
+		return common.NewApiError(http.StatusConflict, fmt.Errorf("method only allowed when adding hosts to an existing cluster"))
 	}
 
-	fileName := hostutil.IgnitionFileName(host)
-	respBody, contentLength, err := b.objectHandler.Download(ctx, fmt.Sprintf("%s/%s", clusterID, fileName))
-	if err != nil {
-		return "", nil, 0, common.NewApiError(http.StatusInternalServerError, err)
+	if swag.StringValue(h.Status) != models.HostStatusKnown {
+		log.Errorf("Install host for host %s, state %s is forbidden: host not in Known state", params.HostID.String(), swag.StringValue(h.Status))
+		return common.NewApiError(http.StatusConflict, fmt.Errorf("cannot install host in state %s", swag.StringValue(h.Status)))
 	}
 
-	return fileName, respBody, contentLength, nil
-}
-
-func (b *bareMetalInventory) GetCredentials(ctx context.Context, params installer.GetCredentialsParams) middleware.Responder {
-	c, err := b.GetCredentialsInternal(ctx, params)
+	err = b.hostApi.AutoAssignRole(ctx, h, b.db)
 	if err != nil {
+		log.Errorf("Failed to update role for host %s", params.HostID)
 		return common.GenerateErrorResponder(err)
 	}
-	return installer.NewGetCredentialsOK().WithPayload(c)
-}
-
-func (b *bareMetalInventory) GetCredentialsInternal(ctx context.Context, params installer.GetCredentialsParams) (*models.Credentials, error) {
-
-	log := logutil.FromContext(ctx, b.log)
-	var cluster common.Cluster
 
-	db := common.LoadTableFromDB(b.db, common.MonitoredOperatorsTable)
-	if err := db.First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
-		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
-		return nil, err
-	}
-	if !b.clusterApi.IsOperatorAvailable(&cluster, operators.OperatorConsole.Name) {
-		err := errors.New("console-url isn't available yet, it will be once console operator is ready as part of cluster finalizing stage")
-		log.WithError(err)
-		return nil, common.NewApiError(http.StatusConflict, err)
-	}
-	objectName := fmt.Sprintf("%s/%s", params.ClusterID, "kubeadmin-password")
-	r, _, err := b.objectHandler.Download(ctx, objectName)
-	if err != nil {
-		log.WithError(err).Errorf("Failed to get clusters %s object", objectName)
-		return nil, common.NewApiError(http.StatusInternalServerError, err)
-	}
-	defer r.Close()
-	password, err := ioutil.ReadAll(r)
+	err = b.hostApi.RefreshStatus(ctx, h, b.db)
 	if err != nil {
-		log.WithError(errors.Errorf("%s", password)).Errorf("Failed to get clusters %s", objectName)
-		return nil, common.NewApiError(http.StatusConflict, errors.New(string(password)))
+		log.Errorf("Failed to refresh host %s", params.HostID)
+		return common.GenerateErrorResponder(err)
 	}
-	return &models.Credentials{
-		Username:   DefaultUser,
-		Password:   string(password),
-		ConsoleURL: common.GetConsoleUrl(cluster.Name, cluster.BaseDNSDomain),
-	}, nil
-}
 
-func (b *bareMetalInventory) UpdateHostInstallProgress(ctx context.Context, params installer.UpdateHostInstallProgressParams) middleware.Responder {
-	log := logutil.FromContext(ctx, b.log)
-	log.Infof("Update host %s install progress", params.HostID)
-	host, err := common.GetHostFromDB(b.db, params.ClusterID.String(), params.HostID.String())
+	if swag.StringValue(h.Status) != models.HostStatusKnown {
+		return common.NewApiError(http.StatusConflict, fmt.Errorf("cannot install host in state %s after refresh", swag.StringValue(h.Status)))
+	}
+	err = b.createAndUploadNodeIgnition(ctx, cluster, h)
 	if err != nil {
-		log.WithError(err).Errorf("failed to find host %s", params.HostID)
-		return installer.NewUpdateHostInstallProgressNotFound().
-			WithPayload(common.GenerateError(http.StatusNotFound, err))
+		log.Errorf("Failed to upload ignition for host %s", h.RequestedHostname)
+		return common.GenerateErrorResponder(err)
 	}
-
-	if params.HostProgress.CurrentStage != host.Progress.CurrentStage || params.HostProgress.ProgressInfo != host.Progress.ProgressInfo {
-		if err := b.hostApi.UpdateInstallProgress(ctx, &host.Host, params.HostProgress); err != nil {
-			log.WithError(err).Errorf("failed to update host %s progress", params.HostID)
-			return installer.NewUpdateHostInstallProgressInternalServerError().WithPayload(common.GenerateError(http.StatusInternalServerError, err))
-		}
-
-		event := fmt.Sprintf("reached installation stage %s", params.HostProgress.CurrentStage)
-		if params.HostProgress.ProgressInfo != "" {
-			event += fmt.Sprintf(": %s", params.HostProgress.ProgressInfo)
-		}
-
-		log.Info(fmt.Sprintf("Host %s in cluster %s: %s", host.ID, host.ClusterID, event))
-		msg := fmt.Sprintf("Host %s: %s", hostutil.GetHostnameForMsg(&host.Host), event)
-		b.eventsHandler.AddEvent(ctx, host.ClusterID, host.ID, models.EventSeverityInfo, msg, time.Now())
+	err = b.hostApi.Install(ctx, h, b.db)
+	if err != nil {
+		// we just logs the error, each host install is independent
+		log.Errorf("Failed to move host %s to installing", h.RequestedHostname)
+		return common.GenerateErrorResponder(err)
 	}
 
-	return installer.NewUpdateHostInstallProgressOK()
+	return installer.NewInstallHostAccepted().WithPayload(h)
 }
 
-func (b *bareMetalInventory) UploadClusterIngressCert(ctx context.Context, params installer.UploadClusterIngressCertParams) middleware.Responder {
+func (b *bareMetalInventory) ResetHost(ctx context.Context, params installer.ResetHostParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
-	log.Infof("UploadClusterIngressCert for cluster %s with params %s", params.ClusterID, params.IngressCertParams)
-	var cluster common.Cluster
-
-	if err := b.db.First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
-		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+	log.Info("Resetting host: ", params.HostID)
+	host, err := common.GetHostFromDB(b.db, params.ClusterID.String(), params.HostID.String())
+	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return installer.NewUploadClusterIngressCertNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
-		} else {
-			return installer.NewUploadClusterIngressCertInternalServerError().
-				WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+			log.WithError(err).Errorf("host %s not found", params.HostID)
+			return common.NewApiError(http.StatusNotFound, err)
 		}
+		log.WithError(err).Errorf("failed to get host %s", params.HostID)
+		msg := fmt.Sprintf("Failed to reset host %s: error fetching host from DB", params.HostID.String())
+		b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityError, msg, time.Now())
+		return common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	if err := b.clusterApi.UploadIngressCert(&cluster); err != nil {
-		return installer.NewUploadClusterIngressCertBadRequest().
-			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	if !hostutil.IsDay2Host(&host.Host) {
+		log.Errorf("ResetHost for host %s is forbidden: not a Day2 hosts", params.HostID.String())
+		return common.NewApiError(http.StatusConflict, fmt.Errorf("method only allowed when adding hosts to an existing cluster"))
 	}
 
-	objectName := fmt.Sprintf("%s/%s", cluster.ID, constants.Kubeconfig)
-	exists, err := b.objectHandler.DoesObjectExist(ctx, objectName)
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		if errResponse := b.hostApi.ResetHost(ctx, &host.Host, "host was reset by user", tx); errResponse != nil {
+			return errResponse
+		}
+		if err = b.customizeHost(&host.Host); err != nil {
+			return err
+		}
+		return nil
+	})
+
 	if err != nil {
-		log.WithError(err).Errorf("Failed to upload ingress ca")
-		return installer.NewUploadClusterIngressCertInternalServerError().
-			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+		return common.GenerateErrorResponder(err)
 	}
 
-	if exists {
-		log.Infof("Ingress ca for cluster %s already exists", cluster.ID)
-		return installer.NewUploadClusterIngressCertCreated()
-	}
+	return installer.NewResetHostOK().WithPayload(&host.Host)
+}
 
-	noingress := fmt.Sprintf("%s/%s-noingress", cluster.ID, constants.Kubeconfig)
-	resp, _, err := b.objectHandler.Download(ctx, noingress)
-	if err != nil {
-		return installer.NewUploadClusterIngressCertInternalServerError().
-			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
-	}
+func (b *bareMetalInventory) CompleteInstallation(ctx context.Context, params installer.CompleteInstallationParams) middleware.Responder {
+	// TODO: MGMT-4458
+	// This function can be removed once the controller will stop sending this request
+	// The service is already capable of completing the installation on its own
 
-	kubeconfigData, err := ioutil.ReadAll(resp)
-	if err != nil {
-		log.WithError(err).Infof("Failed to convert kubeconfig s3 response to io reader")
-		return installer.NewUploadClusterIngressCertInternalServerError().
-			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
-	}
+	log := logutil.FromContext(ctx, b.log)
 
-	mergedKubeConfig, err := mergeIngressCaIntoKubeconfig(kubeconfigData, []byte(params.IngressCertParams), log)
-	if err != nil {
-		return installer.NewUploadClusterIngressCertInternalServerError().
-			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	log.Infof("complete cluster %s installation", params.ClusterID)
+
+	var cluster *common.Cluster
+	var err error
+	if cluster, err = common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading); err != nil {
+		return common.GenerateErrorResponder(err)
 	}
 
-	if err := b.objectHandler.Upload(ctx, mergedKubeConfig, objectName); err != nil {
-		return installer.NewUploadClusterIngressCertInternalServerError().
-			WithPayload(common.GenerateError(http.StatusInternalServerError, errors.Errorf("failed to upload %s to s3", objectName)))
+	if !*params.CompletionParams.IsSuccess {
+		if _, err := b.clusterApi.CompleteInstallation(ctx, b.db, cluster, false, params.CompletionParams.ErrorInfo); err != nil {
+			log.WithError(err).Errorf("Failed to set complete cluster state on %s ", params.ClusterID.String())
+			return common.GenerateErrorResponder(err)
+		}
+	} else {
+		log.Warnf("Cluster %s tried to complete its installation using deprecated CompleteInstallation API. The service decides whether the cluster completed", params.ClusterID)
 	}
-	return installer.NewUploadClusterIngressCertCreated()
+
+	return installer.NewCompleteInstallationAccepted().WithPayload(&cluster.Cluster)
 }
 
-// Merging given ingress ca certificate into kubeconfig
-// Code was taken from openshift installer
-func mergeIngressCaIntoKubeconfig(kubeconfigData []byte, ingressCa []byte, log logrus.FieldLogger) ([]byte, error) {
+func (b *bareMetalInventory) deleteDNSRecordSets(ctx context.Context, cluster common.Cluster) error {
+	return b.dnsApi.DeleteDNSRecordSets(ctx, &cluster)
+}
 
-	kconfig, err := clientcmd.Load(kubeconfigData)
-	if err != nil {
-		log.WithError(err).Errorf("Failed to convert kubeconfig data")
-		return nil, err
+func (b *bareMetalInventory) validateDNSDomain(cluster common.Cluster, params installer.UpdateClusterParams, log logrus.FieldLogger) error {
+	clusterName := swag.StringValue(params.ClusterUpdateParams.Name)
+	if clusterName == "" {
+		clusterName = cluster.Name
 	}
-	if kconfig == nil || len(kconfig.Clusters) == 0 {
-		err = errors.Errorf("kubeconfig is missing expected data")
-		log.Error(err)
-		return nil, err
+	clusterBaseDomain := swag.StringValue(params.ClusterUpdateParams.BaseDNSDomain)
+	if clusterBaseDomain == "" {
+		clusterBaseDomain = cluster.BaseDNSDomain
 	}
-
-	for _, c := range kconfig.Clusters {
-		clusterCABytes := c.CertificateAuthorityData
-		if len(clusterCABytes) == 0 {
-			err = errors.Errorf("kubeconfig CertificateAuthorityData not found")
-			log.Errorf("%e, data %s", err, c.CertificateAuthorityData)
-			return nil, err
+	if clusterBaseDomain != "" {
+		if err := b.dnsApi.ValidateDNSName(clusterName, clusterBaseDomain); err != nil {
+			log.WithError(err).Errorf("Invalid cluster domain: %s.%s", clusterName, clusterBaseDomain)
+			return common.NewApiError(http.StatusBadRequest, err)
 		}
-		certPool := x509.NewCertPool()
-		if !certPool.AppendCertsFromPEM(clusterCABytes) {
-			err = errors.Errorf("cluster CA found in kubeconfig not valid PEM format")
-			log.Errorf("%e, ca :%s", err, clusterCABytes)
-			return nil, err
+	}
+	dnsDomain, err := b.dnsApi.GetDNSDomain(clusterName, clusterBaseDomain)
+	if err == nil && dnsDomain != nil {
+		// Cluster's baseDNSDomain is defined in config (BaseDNSDomains map)
+		if err = b.dnsApi.ValidateBaseDNS(dnsDomain); err != nil {
+			log.WithError(err).Errorf("Invalid base DNS domain: %s", clusterBaseDomain)
+			return common.NewApiError(http.StatusConflict, errors.New("Base DNS domain isn't configured properly"))
 		}
-		if !certPool.AppendCertsFromPEM(ingressCa) {
-			err = errors.Errorf("given ingress-ca is not valid PEM format")
-			log.Errorf("%e %s", err, ingressCa)
-			return nil, err
+		if err = b.dnsApi.ValidateDNSRecords(cluster, dnsDomain); err != nil {
+			log.WithError(err).Errorf("DNS records already exist for cluster: %s", params.ClusterID)
+			return common.NewApiError(http.StatusConflict,
+				errors.New("DNS records already exist for cluster - please change 'Cluster Name'"))
 		}
-
-		newCA := append(ingressCa, clusterCABytes...)
-		c.CertificateAuthorityData = newCA
 	}
+	return nil
+}
 
-	kconfigAsByteArray, err := clientcmd.Write(*kconfig)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to convert kubeconfig")
+func ipAsUint(ipStr string, log logrus.FieldLogger) uint64 {
+	parts := strings.Split(ipStr, ".")
+	if len(parts) != 4 {
+		log.Warnf("Invalid ip %s", ipStr)
+		return 0
 	}
-	return kconfigAsByteArray, nil
+	var result uint64 = 0
+	for _, p := range parts {
+		result = result << 8
+		converted, err := strconv.ParseUint(p, 10, 64)
+		if err != nil {
+			log.WithError(err).Warnf("Conversion of %s to uint", p)
+			return 0
+		}
+		result += converted
+	}
+	return result
 }
 
-func setPullSecret(cluster *common.Cluster, pullSecret string) {
-	cluster.PullSecret = pullSecret
-	if pullSecret != "" {
-		cluster.PullSecretSet = true
-	} else {
-		cluster.PullSecretSet = false
+func applyLimit(ret models.FreeAddressesList, limitParam *int64) models.FreeAddressesList {
+	if limitParam != nil && *limitParam >= 0 && *limitParam < int64(len(ret)) {
+		return ret[:*limitParam]
 	}
+	return ret
 }
 
-func (b *bareMetalInventory) CancelInstallation(ctx context.Context, params installer.CancelInstallationParams) middleware.Responder {
-	c, err := b.CancelInstallationInternal(ctx, params)
+func (b *bareMetalInventory) getFreeAddresses(_ context.Context, params installer.GetFreeAddressesParams, log logrus.FieldLogger) (models.FreeAddressesList, error) {
+	var hosts []*models.Host
+	err := b.db.Select("free_addresses").Find(&hosts, "cluster_id = ? and status in (?)", params.ClusterID.String(), []string{models.HostStatusInsufficient, models.HostStatusKnown}).Error
 	if err != nil {
-		return common.GenerateErrorResponder(err)
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "Error retreiving hosts for cluster %s", params.ClusterID.String()))
 	}
-	return installer.NewCancelInstallationAccepted().WithPayload(&c.Cluster)
-}
+	if len(hosts) == 0 {
+		return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("No hosts where found for cluster %s", params.ClusterID))
+	}
+	resultingSet := network.MakeFreeAddressesSet(hosts, params.Network, params.Prefix, log)
 
-func (b *bareMetalInventory) CancelInstallationInternal(ctx context.Context, params installer.CancelInstallationParams) (*common.Cluster, error) {
+	ret := models.FreeAddressesList{}
+	for a := range resultingSet {
+		ret = append(ret, a)
+	}
 
-	log := logutil.FromContext(ctx, b.log)
-	log.Infof("canceling installation for cluster %s", params.ClusterID)
+	// Sort addresses
+	sort.Slice(ret, func(i, j int) bool {
+		return ipAsUint(ret[i].String(), log) < ipAsUint(ret[j].String(), log)
+	})
 
-	cluster := &common.Cluster{}
+	ret = applyLimit(ret, params.Limit)
 
-	txSuccess := false
-	tx := b.db.Begin()
-	tx = transaction.AddForUpdateQueryOption(tx)
-	defer func() {
-		if !txSuccess {
-			log.Error("cancel installation failed")
-			tx.Rollback()
-		}
-		if r := recover(); r != nil {
-			log.Error("cancel installation failed")
-			tx.Rollback()
-		}
-	}()
+	return ret, nil
+}
 
-	if tx.Error != nil {
-		msg := "Failed to cancel installation: error starting DB transaction"
-		log.WithError(tx.Error).Errorf(msg)
-		b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError, msg, time.Now())
-		return nil, common.NewApiError(http.StatusInternalServerError, errors.New(msg))
+func (b *bareMetalInventory) GetFreeAddresses(ctx context.Context, params installer.GetFreeAddressesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	results, err := b.getFreeAddresses(ctx, params, log)
+	if err != nil {
+		log.WithError(err).Warn("GetFreeAddresses")
+		return common.GenerateErrorResponder(err)
 	}
+	return installer.NewGetFreeAddressesOK().WithPayload(results)
+}
 
+func (b *bareMetalInventory) UpdateClusterLogsProgress(ctx context.Context, params installer.UpdateClusterLogsProgressParams) middleware.Responder {
 	var err error
-	if cluster, err = common.GetClusterFromDB(tx, params.ClusterID, common.UseEagerLoading); err != nil {
-		log.WithError(err).Errorf("Failed to cancel installation: could not find cluster %s", params.ClusterID)
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, common.NewApiError(http.StatusNotFound, err)
-		}
-		return nil, common.NewApiError(http.StatusInternalServerError, err)
-	}
+	var currentCluster *common.Cluster
 
-	// cancellation is made by setting the cluster and and hosts states to error.
-	if err := b.clusterApi.CancelInstallation(ctx, cluster, "Installation was cancelled by user", tx); err != nil {
-		return nil, err
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("update log progress on %s cluster to %s", params.ClusterID, params.LogsProgressParams.LogsState)
+	currentCluster, err = b.getCluster(ctx, params.ClusterID.String())
+	if err == nil {
+		err = b.clusterApi.UpdateLogsProgress(ctx, currentCluster, string(params.LogsProgressParams.LogsState))
 	}
-	for _, h := range cluster.Hosts {
-		if err := b.hostApi.CancelInstallation(ctx, h, "Installation was cancelled by user", tx); err != nil {
-			return nil, err
-		}
-		if err := b.customizeHost(h); err != nil {
-			return nil, err
-		}
+	if err != nil {
+		b.log.WithError(err).Errorf("failed to update log progress %s on cluster %s", params.LogsProgressParams.LogsState, params.ClusterID.String())
+		return common.GenerateErrorResponder(err)
 	}
 
-	if err := tx.Commit().Error; err != nil {
-		log.Errorf("Failed to cancel installation: error committing DB transaction (%s)", err)
-		msg := "Failed to cancel installation: error committing DB transaction"
-		b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError, msg, time.Now())
-		return nil, common.NewApiError(http.StatusInternalServerError, errors.New("DB error, failed to commit transaction"))
+	return installer.NewUpdateClusterLogsProgressNoContent()
+}
+
+func (b *bareMetalInventory) UpdateHostLogsProgress(ctx context.Context, params installer.UpdateHostLogsProgressParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("update log progress on host %s on %s cluster to %s", params.HostID, params.ClusterID, params.LogsProgressParams.LogsState)
+	currentHost, err := b.getHost(ctx, params.ClusterID.String(), params.HostID.String())
+	if err == nil {
+		err = b.hostApi.UpdateLogsProgress(ctx, &currentHost.Host, string(params.LogsProgressParams.LogsState))
 	}
-	txSuccess = true
+	if err != nil {
+		b.log.WithError(err).Errorf("failed to update log progress %s on cluster %s host %s", params.LogsProgressParams.LogsState, params.ClusterID.String(), params.HostID.String())
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewUpdateHostLogsProgressNoContent()
+}
 
-	return cluster, nil
+func (b *bareMetalInventory) UploadLogs(ctx context.Context, params installer.UploadLogsParams) middleware.Responder {
+	err := b.uploadLogs(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewUploadLogsNoContent()
 }
 
-func (b *bareMetalInventory) ResetCluster(ctx context.Context, params installer.ResetClusterParams) middleware.Responder {
+func (b *bareMetalInventory) uploadLogs(ctx context.Context, params installer.UploadLogsParams) error {
 	log := logutil.FromContext(ctx, b.log)
-	log.Infof("resetting cluster %s", params.ClusterID)
-
-	var cluster *common.Cluster
+	log.Infof("Uploading logs from host %s in cluster %s", params.HostID, params.ClusterID)
 
-	txSuccess := false
-	tx := b.db.Begin()
-	tx = transaction.AddForUpdateQueryOption(tx)
 	defer func() {
-		if !txSuccess {
-			log.Error("reset cluster failed")
-			tx.Rollback()
-		}
-		if r := recover(); r != nil {
-			log.Error("reset cluster failed")
-			tx.Rollback()
+		// Closing file and removing all temporary files created by Multipart
+		params.Upfile.Close()
+		params.HTTPRequest.Body.Close()
+		err := params.HTTPRequest.MultipartForm.RemoveAll()
+		if err != nil {
+			log.WithError(err).Warnf("Failed to delete temporary files used for upload")
 		}
 	}()
 
-	if tx.Error != nil {
-		log.WithError(tx.Error).Errorf("failed to start db transaction")
-		return installer.NewResetClusterInternalServerError().WithPayload(
-			common.GenerateError(http.StatusInternalServerError, errors.New("DB error, failed to start transaction")))
+	var sizeBytes int64
+	if file, ok := params.Upfile.(*runtime.File); ok {
+		sizeBytes = file.Header.Size
 	}
+	return b.storeLogs(ctx, params.ClusterID, params.HostID, params.LogsType, sizeBytes, func(fileName string) ([]*models.LogFinding, error) {
+		return b.uploadAndAnalyzeLogs(ctx, params.Upfile, fileName)
+	})
+}
 
-	var err error
-	if cluster, err = common.GetClusterFromDB(tx, params.ClusterID, common.UseEagerLoading); err != nil {
-		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return installer.NewResetClusterNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
-		}
-		return installer.NewResetClusterInternalServerError().WithPayload(common.GenerateError(http.StatusInternalServerError, err))
-	}
+// logsStorer stores a logs archive as the given object, and returns the findings of its analysis
+type logsStorer func(fileName string) ([]*models.LogFinding, error)
 
-	if err := b.clusterApi.ResetCluster(ctx, cluster, "cluster was reset by user", tx); err != nil {
-		return common.GenerateErrorResponder(err)
+// storeLogs stores the logs archive of a cluster or of one of its hosts, and updates the progress of their collection.
+// The size of the archive is 0 when it is not known before it is stored.
+func (b *bareMetalInventory) storeLogs(ctx context.Context, clusterID strfmt.UUID, hostID *strfmt.UUID, logsType string, sizeBytes int64, store logsStorer) error {
+	log := logutil.FromContext(ctx, b.log)
+	currentCluster, err := b.getCluster(ctx, clusterID.String())
+	if err != nil {
+		return err
+	}
+	if logsType == string(models.LogsTypeHost) {
+		return b.uploadHostLogs(ctx, currentCluster, hostID.String(), sizeBytes, store)
 	}
 
-	for _, h := range cluster.Hosts {
-		if err := b.hostApi.ResetHost(ctx, h, "cluster was reset by user", tx); err != nil {
-			return common.GenerateErrorResponder(err)
+	fileName := b.getLogsFullName(clusterID.String(), logsType)
+	if err = b.quotaApi.ReserveObject(ctx, currentCluster, fileName, quota.KindLogs, sizeBytes); err != nil {
+		return err
+	}
+	log.Debugf("Start upload log file %s to bucket %s", fileName, b.S3Bucket)
+	findings, err := store(fileName)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to upload %s to s3", fileName)
+		b.releaseClusterObject(ctx, currentCluster, fileName)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	b.recordStoredObject(ctx, currentCluster, fileName, quota.KindLogs)
+	if logsType == string(models.LogsTypeController) {
+		b.saveLogFindings(ctx, *currentCluster.ID, nil, models.LogsTypeController, findings)
+		err = b.clusterApi.SetUploadControllerLogsAt(ctx, currentCluster, b.db)
+		if err != nil {
+			log.WithError(err).Errorf("Failed update cluster %s controller_logs_collected_at flag", clusterID)
+			return common.NewApiError(http.StatusInternalServerError, err)
 		}
-		if err := b.customizeHost(h); err != nil {
-			return installer.NewResetClusterInternalServerError().WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+		err = b.clusterApi.UpdateLogsProgress(ctx, currentCluster, string(models.LogsStateCollecting))
+		if err != nil {
+			log.WithError(err).Errorf("Failed update cluster %s log progress %s", clusterID, string(models.LogsStateCollecting))
+			return common.NewApiError(http.StatusInternalServerError, err)
 		}
 	}
 
-	if err := b.clusterApi.DeleteClusterFiles(ctx, cluster, b.objectHandler); err != nil {
-		return common.NewApiError(http.StatusInternalServerError, err)
+	log.Infof("Done uploading file %s", fileName)
+	return nil
+}
+
+func (b *bareMetalInventory) uploadHostLogs(ctx context.Context, cluster *common.Cluster, hostId string, sizeBytes int64, store logsStorer) error {
+	log := logutil.FromContext(ctx, b.log)
+	clusterId := cluster.ID.String()
+	currentHost, err := b.getHost(ctx, clusterId, hostId)
+	if err != nil {
+		return err
 	}
-	if err := b.deleteDNSRecordSets(ctx, *cluster); err != nil {
-		log.Warnf("failed to delete DNS record sets for base domain: %s", cluster.BaseDNSDomain)
+
+	fileName := b.getLogsFullName(clusterId, hostId)
+	if err = b.quotaApi.ReserveObject(ctx, cluster, fileName, quota.KindLogs, sizeBytes); err != nil {
+		return err
 	}
 
-	if err := tx.Commit().Error; err != nil {
-		log.Error(err)
-		return installer.NewResetClusterInternalServerError().WithPayload(
-			common.GenerateError(http.StatusInternalServerError, errors.New("DB error, failed to commit transaction")))
+	log.Debugf("Start upload log file %s to bucket %s", fileName, b.S3Bucket)
+	findings, err := store(fileName)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to upload %s to s3 for host %s", fileName, hostId)
+		b.releaseClusterObject(ctx, cluster, fileName)
+		return common.NewApiError(http.StatusInternalServerError, err)
 	}
-	txSuccess = true
+	b.recordStoredObject(ctx, cluster, fileName, quota.KindLogs)
+	b.saveLogFindings(ctx, currentHost.ClusterID, &currentHost.Host, models.LogsTypeHost, findings)
 
-	return installer.NewResetClusterAccepted().WithPayload(&cluster.Cluster)
+	err = b.hostApi.SetUploadLogsAt(ctx, &currentHost.Host, b.db)
+	if err != nil {
+		log.WithError(err).Errorf("Failed update host %s logs_collected_at flag", hostId)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	err = b.hostApi.UpdateLogsProgress(ctx, &currentHost.Host, string(models.LogsStateCollecting))
+	if err != nil {
+		log.WithError(err).Errorf("Failed update host %s log progress %s", hostId, string(models.LogsStateCollecting))
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return nil
 }
 
-func (b *bareMetalInventory) InstallHost(ctx context.Context, params installer.InstallHostParams) middleware.Responder {
+// recordStoredObject charges an object that was stored for the cluster to the owners of the cluster. A failure to
+// record the object is logged and does not fail the request that stored it.
+func (b *bareMetalInventory) recordStoredObject(ctx context.Context, cluster *common.Cluster, objectName string, kind quota.Kind) {
 	log := logutil.FromContext(ctx, b.log)
-	var h *models.Host
-	var cluster *common.Cluster
-	var err error
-
-	log.Info("Install single day2 host: ", params.HostID)
-	if cluster, err = common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading); err != nil {
-		return common.GenerateErrorResponder(err)
-	}
-	for i := range cluster.Hosts {
-		if *cluster.Hosts[i].ID == params.HostID {
-			h = cluster.Hosts[i]
-			break
-		}
+	sizeBytes, err := b.objectHandler.GetObjectSizeBytes(ctx, objectName)
+	if err == nil {
+		err = b.quotaApi.Record(ctx, cluster, objectName, kind, objectName, sizeBytes)
 	}
-	if h == nil {
-		log.WithError(err).Errorf("host %s not found", params.HostID)
-		return common.NewApiError(http.StatusNotFound, err)
+	if err != nil {
+		log.WithError(err).Warnf("Failed to record the size of %s of cluster %s", objectName, cluster.ID)
 	}
+}
 
-	if !hostutil.IsDay2Host(h) {
-		log.Errorf("InstallHost for host %s is forbidden: not a Day2 hosts", params.HostID.String())
-		return common.NewApiError(http.StatusConflict, fmt.Errorf("method only allowed when adding hosts to an existing cluster"))
+// releaseClusterObject stops charging the object under a name of the cluster, which failed to be stored or is no
+// longer referenced by the cluster. A failure to release the object is logged and does not fail the request.
+func (b *bareMetalInventory) releaseClusterObject(ctx context.Context, cluster *common.Cluster, name string) {
+	if err := b.quotaApi.ReleaseClusterObject(ctx, cluster, name); err != nil {
+		logutil.FromContext(ctx, b.log).WithError(err).Warnf("Failed to release the quota of %s of cluster %s", name, cluster.ID)
 	}
+}
 
-	if swag.StringValue(h.Status) != models.HostStatusKnown {
-		log.Errorf("Install host for host %s, state %s is forbidden: host not in Known state", params.HostID.String(), swag.StringValue(h.Status))
-		return common.NewApiError(http.StatusConflict, fmt.Errorf("cannot install host in state %s", swag.StringValue(h.Status)))
-	}
+// uploadAndAnalyzeLogs uploads a logs archive and scans it for known failure signatures while it is being uploaded.
+// A failure to analyze the logs is logged and does not fail the upload.
+func (b *bareMetalInventory) uploadAndAnalyzeLogs(ctx context.Context, upFile io.Reader, fileName string) ([]*models.LogFinding, error) {
+	log := logutil.FromContext(ctx, b.log)
 
-	err = b.hostApi.AutoAssignRole(ctx, h, b.db)
-	if err != nil {
-		log.Errorf("Failed to update role for host %s", params.HostID)
-		return common.GenerateErrorResponder(err)
+	type analysisResult struct {
+		findings []*models.LogFinding
+		err      error
 	}
+	pr, pw := io.Pipe()
+	resultChan := make(chan analysisResult, 1)
+	go func() {
+		findings, err := b.logAnalyzer.Analyze(pr)
+		// Consume whatever the analyzer did not read so the upload is never blocked
+		_, _ = io.Copy(ioutil.Discard, pr)
+		resultChan <- analysisResult{findings: findings, err: err}
+	}()
 
-	err = b.hostApi.RefreshStatus(ctx, h, b.db)
+	err := b.objectHandler.UploadStream(ctx, io.TeeReader(upFile, pw), fileName)
+	pw.CloseWithError(err)
+	result := <-resultChan
 	if err != nil {
-		log.Errorf("Failed to refresh host %s", params.HostID)
-		return common.GenerateErrorResponder(err)
+		return nil, err
 	}
+	if result.err != nil {
+		log.WithError(result.err).Warnf("Failed to analyze logs %s", fileName)
+		return nil, nil
+	}
+	return result.findings, nil
+}
 
-	if swag.StringValue(h.Status) != models.HostStatusKnown {
-		return common.NewApiError(http.StatusConflict, fmt.Errorf("cannot install host in state %s after refresh", swag.StringValue(h.Status)))
+// analyzeAndPublishLogs scans the logs archive of a completed upload for known failure signatures while it is being
+// verified, and stores it only once it was verified. A failure to analyze the logs is logged and does not fail the upload.
+func (b *bareMetalInventory) analyzeAndPublishLogs(ctx context.Context, archive logsupload.Archive, fileName string) ([]*models.LogFinding, error) {
+	log := logutil.FromContext(ctx, b.log)
+	findings, analysisErr := b.logAnalyzer.Analyze(archive)
+	// Read whatever the analyzer did not read, such that the whole archive is verified
+	if _, err := io.Copy(ioutil.Discard, archive); err != nil {
+		return nil, err
 	}
-	err = b.createAndUploadNodeIgnition(ctx, cluster, h)
-	if err != nil {
-		log.Errorf("Failed to upload ignition for host %s", h.RequestedHostname)
-		return common.GenerateErrorResponder(err)
+	if err := archive.Publish(fileName); err != nil {
+		return nil, err
 	}
-	err = b.hostApi.Install(ctx, h, b.db)
-	if err != nil {
-		// we just logs the error, each host install is independent
-		log.Errorf("Failed to move host %s to installing", h.RequestedHostname)
-		return common.GenerateErrorResponder(err)
+	if analysisErr != nil {
+		log.WithError(analysisErr).Warnf("Failed to analyze logs %s", fileName)
+		return nil, nil
 	}
-
-	return installer.NewInstallHostAccepted().WithPayload(h)
+	return findings, nil
 }
 
-func (b *bareMetalInventory) ResetHost(ctx context.Context, params installer.ResetHostParams) middleware.Responder {
+// saveLogFindings replaces the findings of the previous analysis of the same logs and adds an event for every finding
+func (b *bareMetalInventory) saveLogFindings(ctx context.Context, clusterID strfmt.UUID, host *models.Host, logsType models.LogsType, findings []*models.LogFinding) {
 	log := logutil.FromContext(ctx, b.log)
-	log.Info("Resetting host: ", params.HostID)
-	host, err := common.GetHostFromDB(b.db, params.ClusterID.String(), params.HostID.String())
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			log.WithError(err).Errorf("host %s not found", params.HostID)
-			return common.NewApiError(http.StatusNotFound, err)
-		}
-		log.WithError(err).Errorf("failed to get host %s", params.HostID)
-		msg := fmt.Sprintf("Failed to reset host %s: error fetching host from DB", params.HostID.String())
-		b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityError, msg, time.Now())
-		return common.NewApiError(http.StatusInternalServerError, err)
-	}
 
-	if !hostutil.IsDay2Host(&host.Host) {
-		log.Errorf("ResetHost for host %s is forbidden: not a Day2 hosts", params.HostID.String())
-		return common.NewApiError(http.StatusConflict, fmt.Errorf("method only allowed when adding hosts to an existing cluster"))
+	var hostID strfmt.UUID
+	var hostIDPtr *strfmt.UUID
+	if host != nil {
+		hostID = *host.ID
+		hostIDPtr = host.ID
 	}
 
-	err = b.db.Transaction(func(tx *gorm.DB) error {
-		if errResponse := b.hostApi.ResetHost(ctx, &host.Host, "host was reset by user", tx); errResponse != nil {
-			return errResponse
-		}
-		if err = b.customizeHost(&host.Host); err != nil {
+	now := strfmt.DateTime(time.Now())
+	err := b.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("cluster_id = ? and host_id = ? and logs_type = ?", clusterID.String(), hostID.String(), logsType).
+			Delete(&models.LogFinding{}).Error; err != nil {
 			return err
 		}
+		for _, finding := range findings {
+			finding.ID = strfmt.UUID(uuid.New().String())
+			finding.ClusterID = clusterID
+			finding.HostID = hostID
+			finding.LogsType = logsType
+			finding.AnalyzedAt = now
+			if err := tx.Create(finding).Error; err != nil {
+				return err
+			}
+		}
 		return nil
 	})
-
 	if err != nil {
-		return common.GenerateErrorResponder(err)
+		log.WithError(err).Warnf("Failed to save logs analysis of cluster %s", clusterID)
+		return
 	}
 
-	return installer.NewResetHostOK().WithPayload(&host.Host)
+	for _, finding := range findings {
+		var msg string
+		if host != nil {
+			msg = fmt.Sprintf("Host %s: logs analysis found a known failure: %s (%d matching lines, first in %s)",
+				hostutil.GetHostnameForMsg(host), finding.Description, finding.MatchCount, finding.File)
+		} else {
+			msg = fmt.Sprintf("Logs analysis of the %s logs found a known failure: %s (%d matching lines, first in %s)",
+				logsType, finding.Description, finding.MatchCount, finding.File)
+		}
+		b.eventsHandler.AddEvent(ctx, clusterID, hostIDPtr, finding.Severity, msg, time.Now())
+	}
 }
 
-func (b *bareMetalInventory) CompleteInstallation(ctx context.Context, params installer.CompleteInstallationParams) middleware.Responder {
-	// TODO: MGMT-4458
-	// This function can be removed once the controller will stop sending this request
-	// The service is already capable of completing the installation on its own
-
+func (b *bareMetalInventory) GetClusterLogsAnalysis(ctx context.Context, params installer.GetClusterLogsAnalysisParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 
-	log.Infof("complete cluster %s installation", params.ClusterID)
-
-	var cluster *common.Cluster
-	var err error
-	if cluster, err = common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading); err != nil {
+	if _, err := common.GetClusterFromDB(b.db, params.ClusterID, common.SkipEagerLoading); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
 		return common.GenerateErrorResponder(err)
 	}
 
-	if !*params.CompletionParams.IsSuccess {
-		if _, err := b.clusterApi.CompleteInstallation(ctx, b.db, cluster, false, params.CompletionParams.ErrorInfo); err != nil {
-			log.WithError(err).Errorf("Failed to set complete cluster state on %s ", params.ClusterID.String())
-			return common.GenerateErrorResponder(err)
+	query := b.db.Where("cluster_id = ?", params.ClusterID.String())
+	if params.HostID != nil {
+		query = query.Where("host_id = ?", params.HostID.String())
+	}
+	if logsType := swag.StringValue(params.LogsType); logsType != "" && logsType != string(models.LogsTypeAll) {
+		query = query.Where("logs_type = ?", logsType)
+	}
+
+	var findings models.LogFindingList
+	if err := query.Order("analyzed_at, host_id, signature_id").Find(&findings).Error; err != nil {
+		log.WithError(err).Errorf("failed to get logs analysis of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return installer.NewGetClusterLogsAnalysisOK().WithPayload(findings)
+}
+
+func (b *bareMetalInventory) DownloadClusterLogs(ctx context.Context, params installer.DownloadClusterLogsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("Downloading logs from cluster %s", params.ClusterID)
+	fileName, downloadFileName, err := b.getLogFileForDownload(ctx, &params.ClusterID, params.HostID, swag.StringValue(params.LogsType))
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	respBody, contentLength, err := b.objectHandler.Download(ctx, fileName)
+	if err != nil {
+		if _, ok := err.(common.NotFound); ok {
+			log.WithError(err).Warnf("File not found %s", fileName)
+			return common.NewApiError(http.StatusNotFound, errors.Errorf("Logs of type %s for cluster %s "+
+				"were not found", swag.StringValue(params.LogsType), params.ClusterID))
 		}
-	} else {
-		log.Warnf("Cluster %s tried to complete its installation using deprecated CompleteInstallation API. The service decides whether the cluster completed", params.ClusterID)
+		log.WithError(err).Errorf("failed to download file %s", fileName)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return filemiddleware.NewResponder(installer.NewDownloadClusterLogsOK().WithPayload(respBody), downloadFileName, contentLength)
+}
+
+func (b *bareMetalInventory) DownloadClusterSupportBundle(ctx context.Context, params installer.DownloadClusterSupportBundleParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	c, err := b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading, common.IncludeDeletedRecords)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	bundle, err := b.newSupportBundle(ctx, c)
+	if err != nil {
+		log.WithError(err).Errorf("failed to collect the support bundle of cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(err)
 	}
 
-	return installer.NewCompleteInstallationAccepted().WithPayload(&cluster.Cluster)
+	pr, pw := io.Pipe()
+	go func() {
+		err := supportbundle.Write(ctx, pw, bundle, b.objectHandler, log)
+		if err != nil {
+			log.WithError(err).Errorf("failed to write the support bundle of cluster %s", params.ClusterID)
+		}
+		pw.CloseWithError(err)
+	}()
+	go func() {
+		// Stop writing the bundle once the request is done, including when the client disconnected
+		<-ctx.Done()
+		pr.CloseWithError(ctx.Err())
+	}()
+	downloadFileName := fmt.Sprintf("%s_%s_support_bundle.tar.gz", sanitize.Name(c.Name), c.ID)
+	return filemiddleware.NewResponder(installer.NewDownloadClusterSupportBundleOK().WithPayload(pr), downloadFileName, 0)
 }
 
-func (b *bareMetalInventory) deleteDNSRecordSets(ctx context.Context, cluster common.Cluster) error {
-	return b.dnsApi.DeleteDNSRecordSets(ctx, &cluster)
-}
+// newSupportBundle collects the records, install config, manifests and log archives of a cluster into a support bundle
+func (b *bareMetalInventory) newSupportBundle(ctx context.Context, c *common.Cluster) (*supportbundle.Bundle, error) {
+	log := logutil.FromContext(ctx, b.log)
+	clusterEvents, err := b.eventsHandler.GetEvents(*c.ID, nil)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to get events of cluster %s", c.ID))
+	}
 
-func (b *bareMetalInventory) validateDNSDomain(cluster common.Cluster, params installer.UpdateClusterParams, log logrus.FieldLogger) error {
-	clusterName := swag.StringValue(params.ClusterUpdateParams.Name)
-	if clusterName == "" {
-		clusterName = cluster.Name
+	// The install config cannot be generated for clusters that are not fully configured yet
+	installConfig, err := b.installConfigBuilder.GetRedactedInstallConfig(c)
+	if err != nil {
+		log.WithError(err).Warnf("Failed to generate the install config of cluster %s for its support bundle", c.ID)
 	}
-	clusterBaseDomain := swag.StringValue(params.ClusterUpdateParams.BaseDNSDomain)
-	if clusterBaseDomain == "" {
-		clusterBaseDomain = cluster.BaseDNSDomain
+
+	files, err := b.objectHandler.ListObjectsByPrefix(ctx, path.Join(c.ID.String(), manifests.ManifestFolder))
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to list manifests of cluster %s", c.ID))
 	}
-	if clusterBaseDomain != "" {
-		if err := b.dnsApi.ValidateDNSName(clusterName, clusterBaseDomain); err != nil {
-			log.WithError(err).Errorf("Invalid cluster domain: %s.%s", clusterName, clusterBaseDomain)
-			return common.NewApiError(http.StatusBadRequest, err)
+	clusterManifests := models.ListManifests{}
+	for _, file := range files {
+		parts := strings.Split(strings.Trim(file, "/"), "/")
+		if len(parts) > 3 {
+			clusterManifests = append(clusterManifests, &models.Manifest{Folder: parts[2], FileName: path.Join(parts[3:]...)})
 		}
 	}
-	dnsDomain, err := b.dnsApi.GetDNSDomain(clusterName, clusterBaseDomain)
-	if err == nil && dnsDomain != nil {
-		// Cluster's baseDNSDomain is defined in config (BaseDNSDomains map)
-		if err = b.dnsApi.ValidateBaseDNS(dnsDomain); err != nil {
-			log.WithError(err).Errorf("Invalid base DNS domain: %s", clusterBaseDomain)
-			return common.NewApiError(http.StatusConflict, errors.New("Base DNS domain isn't configured properly"))
-		}
-		if err = b.dnsApi.ValidateDNSRecords(cluster, dnsDomain); err != nil {
-			log.WithError(err).Errorf("DNS records already exist for cluster: %s", params.ClusterID)
-			return common.NewApiError(http.StatusConflict,
-				errors.New("DNS records already exist for cluster - please change 'Cluster Name'"))
+
+	var logs []string
+	for _, h := range c.Hosts {
+		if h.LogsCollectedAt != strfmt.DateTime(time.Time{}) {
+			logs = append(logs, b.getLogsFullName(c.ID.String(), h.ID.String()))
 		}
 	}
-	return nil
+	if c.ControllerLogsCollectedAt != strfmt.DateTime(time.Time{}) {
+		logs = append(logs, b.getLogsFullName(c.ID.String(), string(models.LogsTypeController)))
+	}
+
+	bundle, err := supportbundle.New(c, clusterEvents, installConfig, clusterManifests, logs)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return bundle, nil
 }
 
-func ipAsUint(ipStr string, log logrus.FieldLogger) uint64 {
-	parts := strings.Split(ipStr, ".")
-	if len(parts) != 4 {
-		log.Warnf("Invalid ip %s", ipStr)
-		return 0
+// openLogsArchive returns the stored host or controller logs archive of a cluster
+func (b *bareMetalInventory) openLogsArchive(ctx context.Context, clusterID strfmt.UUID, hostID *strfmt.UUID, logsType string) (io.ReadCloser, error) {
+	log := logutil.FromContext(ctx, b.log)
+	fileName, _, err := b.getLogFileForDownload(ctx, &clusterID, hostID, logsType)
+	if err != nil {
+		return nil, err
 	}
-	var result uint64 = 0
-	for _, p := range parts {
-		result = result << 8
-		converted, err := strconv.ParseUint(p, 10, 64)
-		if err != nil {
-			log.WithError(err).Warnf("Conversion of %s to uint", p)
-			return 0
+	reader, _, err := b.objectHandler.Download(ctx, fileName)
+	if err != nil {
+		if _, ok := err.(common.NotFound); ok {
+			log.WithError(err).Warnf("File not found %s", fileName)
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("Logs of type %s for cluster %s "+
+				"were not found", logsType, clusterID))
 		}
-		result += converted
+		log.WithError(err).Errorf("failed to download file %s", fileName)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
-	return result
+	return reader, nil
 }
 
-func applyLimit(ret models.FreeAddressesList, limitParam *int64) models.FreeAddressesList {
-	if limitParam != nil && *limitParam >= 0 && *limitParam < int64(len(ret)) {
-		return ret[:*limitParam]
+func (b *bareMetalInventory) ListLogsArchiveEntries(ctx context.Context, params installer.ListLogsArchiveEntriesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	reader, err := b.openLogsArchive(ctx, params.ClusterID, params.HostID, params.LogsType)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
 	}
-	return ret
-}
+	defer reader.Close()
 
-func (b *bareMetalInventory) getFreeAddresses(_ context.Context, params installer.GetFreeAddressesParams, log logrus.FieldLogger) (models.FreeAddressesList, error) {
-	var hosts []*models.Host
-	err := b.db.Select("free_addresses").Find(&hosts, "cluster_id = ? and status in (?)", params.ClusterID.String(), []string{models.HostStatusInsufficient, models.HostStatusKnown}).Error
+	entries, truncated, err := logarchive.List(log, reader, b.Config.LogsArchiveMaxReadBytes)
 	if err != nil {
-		return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "Error retreiving hosts for cluster %s", params.ClusterID.String()))
-	}
-	if len(hosts) == 0 {
-		return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("No hosts where found for cluster %s", params.ClusterID))
+		log.WithError(err).Errorf("failed to list %s logs archive of cluster %s", params.LogsType, params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
 	}
-	resultingSet := network.MakeFreeAddressesSet(hosts, params.Network, params.Prefix, log)
 
-	ret := models.FreeAddressesList{}
-	for a := range resultingSet {
-		ret = append(ret, a)
+	payload := make(models.LogsArchiveEntryList, 0, len(entries))
+	for _, entry := range entries {
+		payload = append(payload, &models.LogsArchiveEntry{
+			Name:       entry.Name,
+			Size:       entry.Size,
+			ModifiedAt: strfmt.DateTime(entry.ModTime),
+		})
 	}
-
-	// Sort addresses
-	sort.Slice(ret, func(i, j int) bool {
-		return ipAsUint(ret[i].String(), log) < ipAsUint(ret[j].String(), log)
-	})
-
-	ret = applyLimit(ret, params.Limit)
-
-	return ret, nil
+	if truncated {
+		log.Warnf("Listed the %s logs archive of cluster %s up to %d bytes", params.LogsType, params.ClusterID, b.Config.LogsArchiveMaxReadBytes)
+	}
+	return installer.NewListLogsArchiveEntriesOK().WithPayload(payload).WithXLogsArchiveTruncated(truncated)
 }
 
-func (b *bareMetalInventory) GetFreeAddresses(ctx context.Context, params installer.GetFreeAddressesParams) middleware.Responder {
+func (b *bareMetalInventory) DownloadLogsArchiveEntry(ctx context.Context, params installer.DownloadLogsArchiveEntryParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
+	startLine := swag.Int64Value(params.StartLine)
+	endLine := swag.Int64Value(params.EndLine)
+	if params.StartLine != nil && params.EndLine != nil && endLine < startLine {
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf("end_line %d is before start_line %d", endLine, startLine))
+	}
 
-	results, err := b.getFreeAddresses(ctx, params, log)
+	reader, err := b.openLogsArchive(ctx, params.ClusterID, params.HostID, params.LogsType)
 	if err != nil {
-		log.WithError(err).Warn("GetFreeAddresses")
 		return common.GenerateErrorResponder(err)
 	}
-	return installer.NewGetFreeAddressesOK().WithPayload(results)
-}
 
-func (b *bareMetalInventory) UpdateClusterLogsProgress(ctx context.Context, params installer.UpdateClusterLogsProgressParams) middleware.Responder {
-	var err error
-	var currentCluster *common.Cluster
+	// The archive is closed by the extraction once the content is read
+	content, err := logarchive.Extract(log, reader, params.Name, startLine, endLine)
+	if err != nil {
+		if err == logarchive.ErrEntryNotFound {
+			return common.NewApiError(http.StatusNotFound, errors.Errorf("File %s was not found in the %s logs archive of cluster %s",
+				params.Name, params.LogsType, params.ClusterID))
+		}
+		log.WithError(err).Errorf("failed to extract %s from %s logs archive of cluster %s", params.Name, params.LogsType, params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return filemiddleware.NewResponderWithContentType(installer.NewDownloadLogsArchiveEntryOK().WithPayload(content),
+		path.Base(params.Name), "text/plain", 0)
+}
 
+func (b *bareMetalInventory) SearchLogsArchive(ctx context.Context, params installer.SearchLogsArchiveParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
-	log.Infof("update log progress on %s cluster to %s", params.ClusterID, params.LogsProgressParams.LogsState)
-	currentCluster, err = b.getCluster(ctx, params.ClusterID.String())
-	if err == nil {
-		err = b.clusterApi.UpdateLogsProgress(ctx, currentCluster, string(params.LogsProgressParams.LogsState))
+	regex, err := regexp.Compile(params.Pattern)
+	if err != nil {
+		return common.NewApiError(http.StatusBadRequest, errors.Wrapf(err, "invalid search pattern"))
 	}
+
+	reader, err := b.openLogsArchive(ctx, params.ClusterID, params.HostID, params.LogsType)
 	if err != nil {
-		b.log.WithError(err).Errorf("failed to update log progress %s on cluster %s", params.LogsProgressParams.LogsState, params.ClusterID.String())
 		return common.GenerateErrorResponder(err)
 	}
+	defer reader.Close()
 
-	return installer.NewUpdateClusterLogsProgressNoContent()
+	searchResult, err := logarchive.Search(log, reader, regex, int(swag.Int64Value(params.MaxResults)), b.Config.LogsArchiveMaxReadBytes)
+	if err != nil {
+		log.WithError(err).Errorf("failed to search %s logs archive of cluster %s", params.LogsType, params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	if searchResult.ArchiveTruncated {
+		log.Warnf("Searched the %s logs archive of cluster %s up to %d bytes", params.LogsType, params.ClusterID, b.Config.LogsArchiveMaxReadBytes)
+	}
+	result := &models.LogsSearchResult{
+		Matches:          make([]*models.LogsSearchMatch, 0, len(searchResult.Matches)),
+		Truncated:        searchResult.Truncated,
+		ArchiveTruncated: searchResult.ArchiveTruncated,
+	}
+	for _, match := range searchResult.Matches {
+		result.Matches = append(result.Matches, &models.LogsSearchMatch{
+			Name:       match.Name,
+			LineNumber: match.LineNumber,
+			Line:       match.Line,
+		})
+	}
+	return installer.NewSearchLogsArchiveOK().WithPayload(result)
 }
 
-func (b *bareMetalInventory) UpdateHostLogsProgress(ctx context.Context, params installer.UpdateHostLogsProgressParams) middleware.Responder {
+func (b *bareMetalInventory) CreateLogsUpload(ctx context.Context, params installer.CreateLogsUploadParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
-	log.Infof("update log progress on host %s on %s cluster to %s", params.HostID, params.ClusterID, params.LogsProgressParams.LogsState)
-	currentHost, err := b.getHost(ctx, params.ClusterID.String(), params.HostID.String())
-	if err == nil {
-		err = b.hostApi.UpdateLogsProgress(ctx, &currentHost.Host, string(params.LogsProgressParams.LogsState))
+	cluster, err := b.getCluster(ctx, params.ClusterID.String())
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	uploadParams := params.NewLogsUploadParams
+	fileName := b.getLogsFullName(params.ClusterID.String(), swag.StringValue(uploadParams.LogsType))
+	if swag.StringValue(uploadParams.LogsType) == string(models.LogsTypeHost) && uploadParams.HostID != "" {
+		if _, err = b.getHost(ctx, params.ClusterID.String(), uploadParams.HostID.String()); err != nil {
+			return common.GenerateErrorResponder(err)
+		}
+		fileName = b.getLogsFullName(params.ClusterID.String(), uploadParams.HostID.String())
 	}
+	if err = b.quotaApi.CheckObject(ctx, cluster, fileName, quota.KindLogs, swag.Int64Value(uploadParams.TotalSize)); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	upload, err := b.logsUploadApi.Create(ctx, params.ClusterID, uploadParams)
 	if err != nil {
-		b.log.WithError(err).Errorf("failed to update log progress %s on cluster %s host %s", params.LogsProgressParams.LogsState, params.ClusterID.String(), params.HostID.String())
+		log.WithError(err).Errorf("Failed to start logs upload for cluster %s", params.ClusterID)
 		return common.GenerateErrorResponder(err)
 	}
-	return installer.NewUpdateHostLogsProgressNoContent()
+	return installer.NewCreateLogsUploadCreated().WithPayload(upload)
 }
 
-func (b *bareMetalInventory) UploadLogs(ctx context.Context, params installer.UploadLogsParams) middleware.Responder {
-	err := b.uploadLogs(ctx, params)
+func (b *bareMetalInventory) GetLogsUpload(ctx context.Context, params installer.GetLogsUploadParams) middleware.Responder {
+	upload, err := b.logsUploadApi.Get(ctx, params.ClusterID, params.UploadID)
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
-	return installer.NewUploadLogsNoContent()
+	return installer.NewGetLogsUploadOK().WithPayload(upload)
 }
 
-func (b *bareMetalInventory) uploadLogs(ctx context.Context, params installer.UploadLogsParams) error {
-	log := logutil.FromContext(ctx, b.log)
-	log.Infof("Uploading logs from host %s in cluster %s", params.HostID, params.ClusterID)
+func (b *bareMetalInventory) AbortLogsUpload(ctx context.Context, params installer.AbortLogsUploadParams) middleware.Responder {
+	upload, err := b.logsUploadApi.Get(ctx, params.ClusterID, params.UploadID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if err = b.logsUploadApi.Delete(ctx, upload); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewAbortLogsUploadNoContent()
+}
 
+func (b *bareMetalInventory) UploadLogsChunk(ctx context.Context, params installer.UploadLogsChunkParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
 	defer func() {
 		// Closing file and removing all temporary files created by Multipart
 		params.Upfile.Close()
 		params.HTTPRequest.Body.Close()
-		err := params.HTTPRequest.MultipartForm.RemoveAll()
-		if err != nil {
+		if err := params.HTTPRequest.MultipartForm.RemoveAll(); err != nil {
 			log.WithError(err).Warnf("Failed to delete temporary files used for upload")
 		}
 	}()
 
-	if params.LogsType == string(models.LogsTypeHost) {
-		err := b.uploadHostLogs(ctx, params.ClusterID.String(), params.HostID.String(), params.Upfile)
-		if err != nil {
-			return err
-		}
-		return nil
-	}
-
-	currentCluster, err := b.getCluster(ctx, params.ClusterID.String())
-	if err != nil {
-		return err
-	}
-	fileName := b.getLogsFullName(params.ClusterID.String(), params.LogsType)
-	log.Debugf("Start upload log file %s to bucket %s", fileName, b.S3Bucket)
-	err = b.objectHandler.UploadStream(ctx, params.Upfile, fileName)
+	upload, err := b.logsUploadApi.Get(ctx, params.ClusterID, params.UploadID)
 	if err != nil {
-		log.WithError(err).Errorf("Failed to upload %s to s3", fileName)
-		return common.NewApiError(http.StatusInternalServerError, err)
+		return common.GenerateErrorResponder(err)
 	}
-	if params.LogsType == string(models.LogsTypeController) {
-		err = b.clusterApi.SetUploadControllerLogsAt(ctx, currentCluster, b.db)
-		if err != nil {
-			log.WithError(err).Errorf("Failed update cluster %s controller_logs_collected_at flag", params.ClusterID)
-			return common.NewApiError(http.StatusInternalServerError, err)
-		}
-		err = b.clusterApi.UpdateLogsProgress(ctx, currentCluster, string(models.LogsStateCollecting))
-		if err != nil {
-			log.WithError(err).Errorf("Failed update cluster %s log progress %s", params.ClusterID, string(models.LogsStateCollecting))
-			return common.NewApiError(http.StatusInternalServerError, err)
-		}
+	if err = b.logsUploadApi.UploadChunk(ctx, upload, params.ChunkNumber, params.Sha256, params.Upfile); err != nil {
+		return common.GenerateErrorResponder(err)
 	}
-
-	log.Infof("Done uploading file %s", fileName)
-	return nil
+	return installer.NewUploadLogsChunkNoContent()
 }
 
-func (b *bareMetalInventory) uploadHostLogs(ctx context.Context, clusterId string, hostId string, upFile io.ReadCloser) error {
+func (b *bareMetalInventory) CompleteLogsUpload(ctx context.Context, params installer.CompleteLogsUploadParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
-	currentHost, err := b.getHost(ctx, clusterId, hostId)
+	upload, err := b.logsUploadApi.Get(ctx, params.ClusterID, params.UploadID)
 	if err != nil {
-		return err
+		return common.GenerateErrorResponder(err)
 	}
-
-	fileName := b.getLogsFullName(clusterId, hostId)
-
-	log.Debugf("Start upload log file %s to bucket %s", fileName, b.S3Bucket)
-	err = b.objectHandler.UploadStream(ctx, upFile, fileName)
+	archive, err := b.logsUploadApi.Open(ctx, upload)
 	if err != nil {
-		log.WithError(err).Errorf("Failed to upload %s to s3 for host %s", fileName, hostId)
-		return common.NewApiError(http.StatusInternalServerError, err)
+		return common.GenerateErrorResponder(err)
 	}
+	defer archive.Close()
 
-	err = b.hostApi.SetUploadLogsAt(ctx, &currentHost.Host, b.db)
-	if err != nil {
-		log.WithError(err).Errorf("Failed update host %s logs_collected_at flag", hostId)
-		return common.NewApiError(http.StatusInternalServerError, err)
-	}
-	err = b.hostApi.UpdateLogsProgress(ctx, &currentHost.Host, string(models.LogsStateCollecting))
-	if err != nil {
-		log.WithError(err).Errorf("Failed update host %s log progress %s", hostId, string(models.LogsStateCollecting))
-		return common.NewApiError(http.StatusInternalServerError, err)
+	var hostID *strfmt.UUID
+	if upload.LogsType == models.LogsTypeHost {
+		hostID = &upload.HostID
 	}
-	return nil
-}
-
-func (b *bareMetalInventory) DownloadClusterLogs(ctx context.Context, params installer.DownloadClusterLogsParams) middleware.Responder {
-	log := logutil.FromContext(ctx, b.log)
-	log.Infof("Downloading logs from cluster %s", params.ClusterID)
-	fileName, downloadFileName, err := b.getLogFileForDownload(ctx, &params.ClusterID, params.HostID, swag.StringValue(params.LogsType))
+	log.Infof("Completing logs upload %s from host %s in cluster %s", upload.ID, upload.HostID, upload.ClusterID)
+	err = b.storeLogs(ctx, upload.ClusterID, hostID, string(upload.LogsType), upload.TotalSize, func(fileName string) ([]*models.LogFinding, error) {
+		return b.analyzeAndPublishLogs(ctx, archive, fileName)
+	})
 	if err != nil {
+		if verificationErr := archive.VerificationError(); verificationErr != nil {
+			return common.NewApiError(http.StatusBadRequest, verificationErr)
+		}
 		return common.GenerateErrorResponder(err)
 	}
-	respBody, contentLength, err := b.objectHandler.Download(ctx, fileName)
-	if err != nil {
-		if _, ok := err.(common.NotFound); ok {
-			log.WithError(err).Warnf("File not found %s", fileName)
-			return common.NewApiError(http.StatusNotFound, errors.Errorf("Logs of type %s for cluster %s "+
-				"were not found", swag.StringValue(params.LogsType), params.ClusterID))
-		}
-		log.WithError(err).Errorf("failed to download file %s", fileName)
-		return common.NewApiError(http.StatusInternalServerError, err)
+	if err = b.logsUploadApi.Delete(ctx, upload); err != nil {
+		log.WithError(err).Warnf("Failed to delete completed logs upload %s", upload.ID)
 	}
-	return filemiddleware.NewResponder(installer.NewDownloadClusterLogsOK().WithPayload(respBody), downloadFileName, contentLength)
+	return installer.NewCompleteLogsUploadNoContent()
 }
 
 func (b *bareMetalInventory) UploadHostLogs(ctx context.Context, params installer.UploadHostLogsParams) middleware.Responder {
@@ -4525,3 +6332,64 @@ func (b *bareMetalInventory) AddOpenshiftVersion(ctx context.Context, ocpRelease
 
 	return openshiftVersion, nil
 }
+
+func (b *bareMetalInventory) ListQuotas(ctx context.Context, params installer.ListQuotasParams) middleware.Responder {
+	if !identity.IsAdmin(ctx) {
+		return common.NewInfraError(http.StatusForbidden, errors.New("only admin users are allowed to list quotas"))
+	}
+	quotas, err := b.quotaApi.List(ctx, swag.StringValue(params.Scope))
+	if err != nil {
+		logutil.FromContext(ctx, b.log).WithError(err).Error("Failed to list quotas")
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewListQuotasOK().WithPayload(quotas)
+}
+
+func (b *bareMetalInventory) GetQuota(ctx context.Context, params installer.GetQuotaParams) middleware.Responder {
+	if !identity.IsAdmin(ctx) {
+		return common.NewInfraError(http.StatusForbidden, errors.New("only admin users are allowed to get quotas"))
+	}
+	ownerQuota, err := b.quotaApi.Get(ctx, params.Scope, params.Owner)
+	if err != nil {
+		logutil.FromContext(ctx, b.log).WithError(err).Errorf("Failed to get the quota of %s %s", params.Scope, params.Owner)
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewGetQuotaOK().WithPayload(ownerQuota)
+}
+
+func (b *bareMetalInventory) UpdateQuotaLimits(ctx context.Context, params installer.UpdateQuotaLimitsParams) middleware.Responder {
+	if !identity.IsAdmin(ctx) {
+		return common.NewInfraError(http.StatusForbidden, errors.New("only admin users are allowed to update quotas"))
+	}
+	ownerQuota, err := b.quotaApi.UpdateLimits(ctx, params.Scope, params.Owner, params.Limits)
+	if err != nil {
+		logutil.FromContext(ctx, b.log).WithError(err).Errorf("Failed to update the limits of %s %s", params.Scope, params.Owner)
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewUpdateQuotaLimitsOK().WithPayload(ownerQuota)
+}
+
+func (b *bareMetalInventory) GetArchivedCluster(ctx context.Context, params installer.GetArchivedClusterParams) middleware.Responder {
+	archived, err := b.clusterArchiveApi.Get(ctx, params.ClusterID)
+	if err != nil {
+		logutil.FromContext(ctx, b.log).WithError(err).Errorf("Failed to get archived cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+	// Archived clusters are not in the database, so they cannot be filtered by the query of the user
+	if !identity.IsAdmin(ctx) && archived.Cluster.UserName != ocm.UserNameFromContext(ctx) {
+		return common.NewApiError(http.StatusNotFound, errors.Errorf("Archived cluster %s was not found", params.ClusterID))
+	}
+	return installer.NewGetArchivedClusterOK().WithPayload(archived)
+}
+
+func (b *bareMetalInventory) RestoreArchivedCluster(ctx context.Context, params installer.RestoreArchivedClusterParams) middleware.Responder {
+	if !identity.IsAdmin(ctx) {
+		return common.NewInfraError(http.StatusForbidden, errors.New("only admin users are allowed to restore archived clusters"))
+	}
+	cluster, err := b.clusterArchiveApi.Restore(ctx, params.ClusterID)
+	if err != nil {
+		logutil.FromContext(ctx, b.log).WithError(err).Errorf("Failed to restore archived cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewRestoreArchivedClusterCreated().WithPayload(&cluster.Cluster)
+}
@@ -1,16 +1,24 @@
 package bminventory
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"mime/multipart"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strconv"
@@ -20,6 +28,8 @@ import (
 
 	ign_3_1 "github.com/coreos/ignition/v2/config/v3_1"
 	ign_3_1_types "github.com/coreos/ignition/v2/config/v3_1/types"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -33,6 +43,7 @@ import (
 	amgmtv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
+	"github.com/openshift/assisted-service/internal/clusterarchive"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/dns"
@@ -43,14 +54,19 @@ import (
 	"github.com/openshift/assisted-service/internal/ignition"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/isoeditor"
+	"github.com/openshift/assisted-service/internal/loganalysis"
+	"github.com/openshift/assisted-service/internal/logsupload"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/quota"
+	"github.com/openshift/assisted-service/internal/supportbundle"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
 	"github.com/openshift/assisted-service/pkg/filemiddleware"
 	"github.com/openshift/assisted-service/pkg/generator"
+	"github.com/openshift/assisted-service/pkg/hostbinding"
 	"github.com/openshift/assisted-service/pkg/k8sclient"
 	"github.com/openshift/assisted-service/pkg/ocm"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
@@ -62,7 +78,10 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
-const FakeServiceBaseURL = "http://192.168.11.22:12345"
+const (
+	FakeServiceBaseURL = "http://192.168.11.22:12345"
+	imageChecksum      = "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+)
 
 var (
 	ctrl                     *gomock.Controller
@@ -84,6 +103,9 @@ var (
 	mockIgnitionBuilder      *ignition.MockIgnitionBuilder
 	mockInstallConfigBuilder *installcfg.MockInstallConfigBuilder
 	mockStaticNetworkConfig  *staticnetworkconfig.MockStaticNetworkConfig
+	mockLogAnalyzer          *loganalysis.MockAnalyzer
+	mockLogsUpload           *logsupload.MockAPI
+	mockClusterArchive       *clusterarchive.MockAPI
 	secondDayWorkerIgnition  = []byte(`{
 		"ignition": {
 		  "version": "3.1.0",
@@ -161,6 +183,237 @@ func mockGetInstallConfigSuccess(mockInstallConfigBuilder *installcfg.MockInstal
 	mockInstallConfigBuilder.EXPECT().GetInstallConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte("some string"), nil).Times(1)
 }
 
+var _ = Describe("Declared hosts", func() {
+	var (
+		bm      *bareMetalInventory
+		cfg     Config
+		db      *gorm.DB
+		ctx     = context.Background()
+		dbName  string
+		cluster *common.Cluster
+	)
+
+	BeforeEach(func() {
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		db, dbName = common.PrepareTestDB()
+		cfg.ServiceBaseURL = FakeServiceBaseURL
+		bm = createInventory(db, cfg)
+		cluster = createCluster(db, models.ClusterStatusInsufficient)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	declareHost := func(params *models.DeclaredHostCreateParams) *models.DeclaredHost {
+		mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(1)
+		reply := bm.RegisterDeclaredHost(ctx, installer.RegisterDeclaredHostParams{ClusterID: *cluster.ID, NewDeclaredHostParams: params})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewRegisterDeclaredHostCreated()))
+		return reply.(*installer.RegisterDeclaredHostCreated).Payload
+	}
+
+	getDeclaredHostFromDB := func(declaredHostID strfmt.UUID) *common.DeclaredHost {
+		declaredHost, err := getDeclaredHost(db, *cluster.ID, declaredHostID)
+		Expect(err).NotTo(HaveOccurred())
+		return declaredHost
+	}
+
+	Context("RegisterDeclaredHost", func() {
+		It("declares a host with its static network config", func() {
+			staticNetworkConfig := &models.HostStaticNetworkConfig{NetworkYaml: "interfaces: []"}
+			mockStaticNetworkConfig.EXPECT().ValidateStaticConfigParams([]*models.HostStaticNetworkConfig{staticNetworkConfig}).Return(nil).Times(1)
+			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB([]*models.HostStaticNetworkConfig{staticNetworkConfig}).Return("formatted config").Times(1)
+
+			declaredHost := declareHost(&models.DeclaredHostCreateParams{
+				Hostname:            "worker-0",
+				Role:                models.HostRoleUpdateParamsWorker,
+				StaticNetworkConfig: staticNetworkConfig,
+			})
+			Expect(declaredHost.ClusterID).To(Equal(*cluster.ID))
+			Expect(declaredHost.StaticNetworkConfig).To(Equal("formatted config"))
+
+			reply := bm.ListDeclaredHosts(ctx, installer.ListDeclaredHostsParams{ClusterID: *cluster.ID})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewListDeclaredHostsOK()))
+			declaredHosts := reply.(*installer.ListDeclaredHostsOK).Payload
+			Expect(declaredHosts).To(HaveLen(1))
+			Expect(declaredHosts[0].ID).To(Equal(declaredHost.ID))
+			Expect(declaredHosts[0].Hostname).To(Equal("worker-0"))
+			Expect(declaredHosts[0].Role).To(Equal(models.HostRoleUpdateParamsWorker))
+		})
+
+		It("defaults to the auto-assign role", func() {
+			declaredHost := declareHost(&models.DeclaredHostCreateParams{})
+			Expect(declaredHost.Role).To(Equal(models.HostRoleUpdateParamsAutoAssign))
+		})
+
+		It("rejects an invalid hostname", func() {
+			verifyApiError(bm.RegisterDeclaredHost(ctx, installer.RegisterDeclaredHostParams{
+				ClusterID:             *cluster.ID,
+				NewDeclaredHostParams: &models.DeclaredHostCreateParams{Hostname: "Invalid_Hostname"},
+			}), http.StatusBadRequest)
+		})
+
+		It("rejects an invalid static network config", func() {
+			mockStaticNetworkConfig.EXPECT().ValidateStaticConfigParams(gomock.Any()).Return(errors.New("invalid network yaml")).Times(1)
+			verifyApiError(bm.RegisterDeclaredHost(ctx, installer.RegisterDeclaredHostParams{
+				ClusterID:             *cluster.ID,
+				NewDeclaredHostParams: &models.DeclaredHostCreateParams{StaticNetworkConfig: &models.HostStaticNetworkConfig{NetworkYaml: "invalid"}},
+			}), http.StatusBadRequest)
+		})
+
+		It("fails with a non-existing cluster", func() {
+			verifyApiError(bm.RegisterDeclaredHost(ctx, installer.RegisterDeclaredHostParams{
+				ClusterID:             strfmt.UUID(uuid.New().String()),
+				NewDeclaredHostParams: &models.DeclaredHostCreateParams{},
+			}), http.StatusNotFound)
+		})
+	})
+
+	Context("GenerateDeclaredHostISO", func() {
+		var declaredHost *models.DeclaredHost
+
+		BeforeEach(func() {
+			declaredHost = declareHost(&models.DeclaredHostCreateParams{Hostname: "worker-0"})
+		})
+
+		It("uploads a full ISO with the ignition of the declared host", func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Update("pull_secret_set", true).Error).ShouldNot(HaveOccurred())
+			var bindingToken string
+			mockIgnitionBuilder.EXPECT().FormatDeclaredHostDiscoveryIgnitionFile(gomock.Any(), gomock.Any(), bm.IgnitionConfig, false, bm.authHandler.AuthType()).
+				DoAndReturn(func(_ *common.Cluster, h *common.DeclaredHost, _ ignition.IgnitionConfig, _ bool, _ auth.AuthType) (string, error) {
+					Expect(h.ID).To(Equal(declaredHost.ID))
+					bindingToken = h.BindingToken
+					return discovery_ignition_3_1, nil
+				}).Times(1)
+			mockS3Client.EXPECT().GetBaseIsoObject(gomock.Any()).Return("rhcos", nil).Times(1)
+			mockS3Client.EXPECT().UploadISO(gomock.Any(), discovery_ignition_3_1, "rhcos",
+				fmt.Sprintf("%s-declared-%s", fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, cluster.ID), declaredHost.ID)).Return(nil).Times(1)
+			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), getDeclaredHostImageName(*cluster.ID, declaredHost.ID)).Return(int64(100), nil).Times(1)
+			mockS3Client.EXPECT().IsAwsS3().Return(false).Times(1)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo,
+				"Generated image of declared host worker-0", gomock.Any()).Times(1)
+
+			reply := bm.GenerateDeclaredHostISO(ctx, installer.GenerateDeclaredHostISOParams{ClusterID: *cluster.ID, DeclaredHostID: declaredHost.ID})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewGenerateDeclaredHostISOCreated()))
+			downloadURL := reply.(*installer.GenerateDeclaredHostISOCreated).Payload.ImageDownloadURL
+			Expect(downloadURL).To(Equal(fmt.Sprintf("%s/api/assisted-install/v1/clusters/%s/declared-hosts/%s/downloads/image",
+				FakeServiceBaseURL, cluster.ID, declaredHost.ID)))
+			updated := getDeclaredHostFromDB(declaredHost.ID)
+			Expect(updated.ImageDownloadURL).To(Equal(downloadURL))
+			Expect(bindingToken).NotTo(BeEmpty())
+			Expect(updated.BindingToken).To(Equal(bindingToken))
+		})
+
+		It("fails without a pull secret", func() {
+			verifyApiError(bm.GenerateDeclaredHostISO(ctx, installer.GenerateDeclaredHostISOParams{ClusterID: *cluster.ID, DeclaredHostID: declaredHost.ID}),
+				http.StatusBadRequest)
+		})
+
+		It("fails with a non-existing declared host", func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Update("pull_secret_set", true).Error).ShouldNot(HaveOccurred())
+			verifyApiError(bm.GenerateDeclaredHostISO(ctx, installer.GenerateDeclaredHostISOParams{ClusterID: *cluster.ID, DeclaredHostID: strfmt.UUID(uuid.New().String())}),
+				http.StatusNotFound)
+		})
+	})
+
+	Context("DownloadDeclaredHostISO", func() {
+		It("fails when the image was not generated", func() {
+			declaredHost := declareHost(&models.DeclaredHostCreateParams{})
+			mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), getDeclaredHostImageName(*cluster.ID, declaredHost.ID)).Return(false, nil).Times(1)
+			verifyApiError(bm.DownloadDeclaredHostISO(ctx, installer.DownloadDeclaredHostISOParams{ClusterID: *cluster.ID, DeclaredHostID: declaredHost.ID}),
+				http.StatusNotFound)
+		})
+	})
+
+	Context("DeregisterDeclaredHost", func() {
+		It("deletes the declared host and its image", func() {
+			declaredHost := declareHost(&models.DeclaredHostCreateParams{})
+			mockS3Client.EXPECT().DeleteObject(gomock.Any(), getDeclaredHostImageName(*cluster.ID, declaredHost.ID)).Return(true, nil).Times(1)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(1)
+
+			reply := bm.DeregisterDeclaredHost(ctx, installer.DeregisterDeclaredHostParams{ClusterID: *cluster.ID, DeclaredHostID: declaredHost.ID})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewDeregisterDeclaredHostNoContent()))
+			_, err := getDeclaredHost(db, *cluster.ID, declaredHost.ID)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("RegisterHost", func() {
+		var (
+			declaredHost *models.DeclaredHost
+			bindingToken string
+			hostID       strfmt.UUID
+		)
+
+		BeforeEach(func() {
+			hostID = strfmt.UUID(uuid.New().String())
+			declaredHost = declareHost(&models.DeclaredHostCreateParams{Hostname: "worker-0", Role: models.HostRoleUpdateParamsWorker})
+			var err error
+			bindingToken, err = hostbinding.NewToken()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(db.Model(&common.DeclaredHost{}).Where("id = ?", declaredHost.ID.String()).
+				Update("binding_token", bindingToken).Error).ShouldNot(HaveOccurred())
+		})
+
+		registerHost := func(bindingToken string) middleware.Responder {
+			return bm.RegisterHost(hostbinding.ToContext(ctx, bindingToken), installer.RegisterHostParams{
+				ClusterID: *cluster.ID,
+				NewHostParams: &models.HostCreateParams{
+					DiscoveryAgentVersion: "v1",
+					HostID:                &hostID,
+				},
+			})
+		}
+
+		It("binds the host to the declared host", func() {
+			mockClusterApi.EXPECT().AcceptRegistration(gomock.Any()).Return(nil).Times(1)
+			mockHostApi.EXPECT().RegisterHost(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, h *models.Host, db *gorm.DB) error {
+					Expect(h.Role).Should(Equal(models.HostRoleWorker))
+					Expect(h.RequestedHostname).Should(Equal("worker-0"))
+					return nil
+				}).Times(1)
+			mockCRDUtils.EXPECT().CreateAgentCR(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, &hostID, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(1)
+
+			Expect(registerHost(bindingToken)).Should(BeAssignableToTypeOf(installer.NewRegisterHostCreated()))
+			Expect(getDeclaredHostFromDB(declaredHost.ID).HostID).To(Equal(hostID))
+		})
+
+		It("binds the declared host again to the host it is bound to", func() {
+			Expect(db.Model(&common.DeclaredHost{}).Where("id = ?", declaredHost.ID.String()).
+				Update("host_id", hostID).Error).ShouldNot(HaveOccurred())
+			mockClusterApi.EXPECT().AcceptRegistration(gomock.Any()).Return(nil).Times(1)
+			mockHostApi.EXPECT().RegisterHost(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockCRDUtils.EXPECT().CreateAgentCR(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, &hostID, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(1)
+
+			Expect(registerHost(bindingToken)).Should(BeAssignableToTypeOf(installer.NewRegisterHostCreated()))
+			Expect(getDeclaredHostFromDB(declaredHost.ID).HostID).To(Equal(hostID))
+		})
+
+		It("fails when the declared host is bound to another host", func() {
+			Expect(db.Model(&common.DeclaredHost{}).Where("id = ?", declaredHost.ID.String()).
+				Update("host_id", strfmt.UUID(uuid.New().String())).Error).ShouldNot(HaveOccurred())
+			mockClusterApi.EXPECT().AcceptRegistration(gomock.Any()).Return(nil).Times(1)
+
+			verifyApiError(registerHost(bindingToken), http.StatusConflict)
+		})
+
+		It("fails with the binding token of another image", func() {
+			otherToken, err := hostbinding.NewToken()
+			Expect(err).NotTo(HaveOccurred())
+			mockClusterApi.EXPECT().AcceptRegistration(gomock.Any()).Return(nil).Times(1)
+
+			verifyApiError(registerHost(otherToken), http.StatusNotFound)
+			Expect(getDeclaredHostFromDB(declaredHost.ID).HostID).To(BeEmpty())
+		})
+	})
+})
+
 var _ = Describe("GenerateClusterISO", func() {
 	var (
 		bm             *bareMetalInventory
@@ -214,11 +467,38 @@ var _ = Describe("GenerateClusterISO", func() {
 		return registerClusterWithHTTPProxy(pullSecretSet, "")
 	}
 
+	mockImageCacheLookupWithKernelArguments := func(srcIso string, imageType models.ImageType, ramDisk, kernelArguments string, hit bool) string {
+		objectPrefix := fmt.Sprintf(s3wrapper.DiscoveryImageCacheTemplate, computeImageContentHash(srcIso, imageType, discovery_ignition_3_1, ramDisk, kernelArguments))
+		mockS3Client.EXPECT().UpdateObjectTimestamp(gomock.Any(), fmt.Sprintf("%s.%s", objectPrefix, getImageExtension(imageType))).Return(hit, nil).Times(1)
+		mockMetric.EXPECT().DiscoveryImageCacheLookup(imageType, hit).Times(1)
+		return objectPrefix
+	}
+
+	mockImageCacheLookup := func(srcIso string, imageType models.ImageType, ramDisk string, hit bool) string {
+		return mockImageCacheLookupWithKernelArguments(srcIso, imageType, ramDisk, "", hit)
+	}
+
+	mockUploadImageChecksum := func(imageName string) {
+		mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), s3wrapper.ChecksumObjectName(imageName)).Return(nil).Times(1)
+	}
+
+	mockImageChecksum := func(imageName string) {
+		mockS3Client.EXPECT().Download(gomock.Any(), s3wrapper.ChecksumObjectName(imageName)).
+			Return(ioutil.NopCloser(strings.NewReader(imageChecksum)), int64(len(imageChecksum)), nil).Times(1)
+	}
+
 	mockUploadIso := func(cluster *common.Cluster, returnValue error) {
 		srcIso := "rhcos"
 		mockS3Client.EXPECT().GetBaseIsoObject(cluster.OpenshiftVersion).Return(srcIso, nil).Times(1)
-		mockS3Client.EXPECT().UploadISO(gomock.Any(), gomock.Any(), srcIso,
-			fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, cluster.ID.String())).Return(returnValue).Times(1)
+		objectPrefix := mockImageCacheLookup(srcIso, models.ImageTypeFullIso, "", false)
+		mockS3Client.EXPECT().UploadISO(gomock.Any(), gomock.Any(), srcIso, objectPrefix).Return(returnValue).Times(1)
+		if returnValue == nil {
+			mockImageChecksum(fmt.Sprintf("%s.iso", objectPrefix))
+		}
+	}
+
+	mockMinimalIsoCacheMiss := func() string {
+		return mockImageCacheLookup("rhcos-minimal.iso", models.ImageTypeMinimalIso, "\n\n\n", false)
 	}
 
 	rollbackClusterImageCreationDate := func(clusterID *strfmt.UUID) {
@@ -405,6 +685,32 @@ var _ = Describe("GenerateClusterISO", func() {
 		Expect(getReply.Payload.ID).To(Equal(clusterId))
 	})
 
+	It("re-uses a cached image with the same content", func() {
+		cluster := registerCluster(true)
+		clusterId := cluster.ID
+		mockS3Client.EXPECT().IsAwsS3().Return(false)
+		mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("%s/discovery.ign", clusterId))
+		mockS3Client.EXPECT().GetBaseIsoObject(cluster.OpenshiftVersion).Return("rhcos", nil).Times(1)
+		objectPrefix := mockImageCacheLookup("rhcos", models.ImageTypeFullIso, "", true)
+		mockS3Client.EXPECT().UploadISO(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockImageChecksum(fmt.Sprintf("%s.iso", objectPrefix))
+		mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
+		mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), fmt.Sprintf("%s.iso", objectPrefix)).Return(int64(100), nil).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), *clusterId, nil, models.EventSeverityInfo, "Generated image (Image type is \"full-iso\", SSH public key is not set)", gomock.Any())
+		mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, false, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
+		mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, true, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
+		generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+			ClusterID:         *clusterId,
+			ImageCreateParams: &models.ImageCreateParams{},
+		})
+		Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOCreated()))
+
+		var updated common.Cluster
+		Expect(db.First(&updated, "id = ?", clusterId).Error).ShouldNot(HaveOccurred())
+		Expect(fmt.Sprintf(s3wrapper.DiscoveryImageCacheTemplate, updated.ImageContentHash)).To(Equal(objectPrefix))
+		Expect(updated.ImageInfo.Checksum).To(Equal(imageChecksum))
+	})
+
 	It("cluster_not_exists", func() {
 		generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
 			ClusterID:         strfmt.UUID(uuid.New().String()),
@@ -465,6 +771,106 @@ var _ = Describe("GenerateClusterISO", func() {
 		verifyApiError(generateReply, http.StatusInternalServerError)
 	})
 
+	Context("static IP pool", func() {
+		pool := &models.StaticIPPool{
+			Cidr:    "192.168.126.0/24",
+			Gateway: "192.168.126.1",
+			Hosts:   []*models.StaticIPPoolHost{{MacAddresses: []string{"02:00:00:2c:23:a5"}}, {MacAddresses: []string{"02:00:00:2c:23:a6"}}},
+		}
+		poolConfig := []*models.HostStaticNetworkConfig{{NetworkYaml: "interfaces: []"}}
+
+		mockPoolImageGenerated := func(cluster *common.Cluster) {
+			mockS3Client.EXPECT().IsAwsS3().Return(false)
+			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
+			mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID))
+			mockUploadIso(cluster, nil)
+			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(poolConfig).Return("pool config").Times(1)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any())
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, false, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, true, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
+		}
+
+		getAllocations := func(clusterID strfmt.UUID) map[string]string {
+			allocations, err := common.GetStaticIPAllocations(db, clusterID)
+			Expect(err).ShouldNot(HaveOccurred())
+			return allocations
+		}
+
+		It("saves the allocations with the image", func() {
+			cluster := registerCluster(true)
+			allocations := map[string]string{"02:00:00:2c:23:a5": "192.168.126.2", "02:00:00:2c:23:a6": "192.168.126.3"}
+			mockStaticNetworkConfig.EXPECT().ValidateStaticIPPool(pool).Return(nil).Times(1)
+			mockStaticNetworkConfig.EXPECT().GenerateStaticIPPoolConfig(pool, map[string]string{}).Return(poolConfig, allocations, nil).Times(1)
+			mockPoolImageGenerated(cluster)
+			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+				ClusterID:         *cluster.ID,
+				ImageCreateParams: &models.ImageCreateParams{StaticIPPool: pool},
+			})
+			Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOCreated()))
+			Expect(getAllocations(*cluster.ID)).To(Equal(allocations))
+		})
+
+		It("keeps the previous allocations", func() {
+			cluster := registerCluster(true)
+			previous := map[string]string{"02:00:00:2c:23:a5": "192.168.126.10"}
+			Expect(common.SetStaticIPAllocations(db, *cluster.ID, previous)).To(Succeed())
+			allocations := map[string]string{"02:00:00:2c:23:a5": "192.168.126.10", "02:00:00:2c:23:a6": "192.168.126.2"}
+			mockStaticNetworkConfig.EXPECT().ValidateStaticIPPool(pool).Return(nil).Times(1)
+			mockStaticNetworkConfig.EXPECT().GenerateStaticIPPoolConfig(pool, previous).Return(poolConfig, allocations, nil).Times(1)
+			mockPoolImageGenerated(cluster)
+			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+				ClusterID:         *cluster.ID,
+				ImageCreateParams: &models.ImageCreateParams{StaticIPPool: pool},
+			})
+			Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOCreated()))
+			Expect(getAllocations(*cluster.ID)).To(Equal(allocations))
+		})
+
+		It("fails on an address conflict without changing the allocations", func() {
+			cluster := registerCluster(true)
+			previous := map[string]string{"02:00:00:2c:23:a5": "192.168.126.10"}
+			Expect(common.SetStaticIPAllocations(db, *cluster.ID, previous)).To(Succeed())
+			mockStaticNetworkConfig.EXPECT().ValidateStaticIPPool(pool).Return(nil).Times(1)
+			mockStaticNetworkConfig.EXPECT().GenerateStaticIPPoolConfig(pool, previous).
+				Return(nil, nil, errors.New("address 192.168.126.10 is allocated to more than one host")).Times(1)
+			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+				ClusterID:         *cluster.ID,
+				ImageCreateParams: &models.ImageCreateParams{StaticIPPool: pool},
+			})
+			verifyApiErrorString(generateReply, http.StatusBadRequest, "allocated to more than one host")
+			Expect(getAllocations(*cluster.ID)).To(Equal(previous))
+		})
+
+		It("rolls back the allocations when the image is not generated", func() {
+			cluster := registerCluster(true)
+			Expect(db.Model(cluster).Update("user_name", "user1").Error).ShouldNot(HaveOccurred())
+			cluster.UserName = "user1"
+			Expect(bm.quotaApi.Record(ctx, cluster, "other", quota.KindLogs, "other", 10)).ShouldNot(HaveOccurred())
+			_, err := bm.quotaApi.UpdateLimits(ctx, models.QuotaScopeUser, "user1", &models.QuotaLimits{MaxStorageBytes: swag.Int64(10)})
+			Expect(err).ShouldNot(HaveOccurred())
+			mockStaticNetworkConfig.EXPECT().ValidateStaticIPPool(pool).Return(nil).Times(1)
+			mockStaticNetworkConfig.EXPECT().GenerateStaticIPPoolConfig(pool, map[string]string{}).
+				Return(poolConfig, map[string]string{"02:00:00:2c:23:a5": "192.168.126.2"}, nil).Times(1)
+			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(poolConfig).Return("pool config").Times(1)
+			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+				ClusterID:         *cluster.ID,
+				ImageCreateParams: &models.ImageCreateParams{StaticIPPool: pool},
+			})
+			verifyApiError(generateReply, http.StatusForbidden)
+			Expect(getAllocations(*cluster.ID)).To(BeEmpty())
+		})
+
+		It("fails together with a static network config", func() {
+			cluster := registerCluster(true)
+			mockStaticNetworkConfig.EXPECT().ValidateStaticConfigParams(poolConfig).Return(nil).Times(1)
+			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+				ClusterID:         *cluster.ID,
+				ImageCreateParams: &models.ImageCreateParams{StaticIPPool: pool, StaticNetworkConfig: poolConfig},
+			})
+			verifyApiErrorString(generateReply, http.StatusBadRequest, "Static IP pool cannot be set together with static network config")
+		})
+	})
+
 	It("failed corrupted ssh public key", func() {
 		clusterID := registerCluster(true).ID
 		reply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
@@ -657,11 +1063,13 @@ var _ = Describe("GenerateClusterISO", func() {
 			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion).Return("rhcos-minimal.iso", nil)
 			mockS3Client.EXPECT().DownloadPublic(gomock.Any(), "rhcos-minimal.iso").Return(ioutil.NopCloser(strings.NewReader("totallyaniso")), int64(12), nil)
 			editor := isoeditor.NewMockEditor(ctrl)
-			editor.EXPECT().CreateClusterMinimalISO(gomock.Any(), "", gomock.Any()).Return(isoFilePath, nil)
+			editor.EXPECT().CreateClusterMinimalISO(gomock.Any(), "", gomock.Any(), nil).Return(isoFilePath, nil)
 
 			stubWithEditor(mockIsoEditorFactory, editor)
 
-			mockS3Client.EXPECT().UploadFile(gomock.Any(), isoFilePath, fmt.Sprintf("discovery-image-%s.iso", cluster.ID))
+			minimalIsoName := fmt.Sprintf("%s.iso", mockMinimalIsoCacheMiss())
+			mockS3Client.EXPECT().UploadFile(gomock.Any(), isoFilePath, minimalIsoName)
+			mockUploadImageChecksum(minimalIsoName)
 			mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID))
 			mockS3Client.EXPECT().IsAwsS3().Return(false)
 			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
@@ -696,9 +1104,11 @@ var _ = Describe("GenerateClusterISO", func() {
 			// Generate minimal-iso
 			editor := isoeditor.NewMockEditor(ctrl)
 			stubWithEditor(mockIsoEditorFactory, editor)
-			editor.EXPECT().CreateClusterMinimalISO(gomock.Any(), "", gomock.Any()).Return(isoFilePath, nil)
+			editor.EXPECT().CreateClusterMinimalISO(gomock.Any(), "", gomock.Any(), nil).Return(isoFilePath, nil)
 			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
-			mockS3Client.EXPECT().UploadFile(gomock.Any(), isoFilePath, fmt.Sprintf("discovery-image-%s.iso", cluster.ID))
+			minimalIsoName := fmt.Sprintf("%s.iso", mockMinimalIsoCacheMiss())
+			mockS3Client.EXPECT().UploadFile(gomock.Any(), isoFilePath, minimalIsoName)
+			mockUploadImageChecksum(minimalIsoName)
 			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion).Return("rhcos-minimal.iso", nil)
 			mockS3Client.EXPECT().DownloadPublic(gomock.Any(), "rhcos-minimal.iso").Return(ioutil.NopCloser(strings.NewReader("totallyaniso")), int64(12), nil)
 			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo, "Generated image (Image type is \"minimal-iso\", SSH public key is not set)", gomock.Any())
@@ -732,6 +1142,7 @@ var _ = Describe("GenerateClusterISO", func() {
 			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
 			mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID))
 			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion).Return("rhcos-minimal.iso", nil)
+			mockMinimalIsoCacheMiss()
 			mockS3Client.EXPECT().DownloadPublic(gomock.Any(), "rhcos-minimal.iso").Return(nil, int64(0), errors.New(expectedErrMsg))
 			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityError, "Failed to generate minimal ISO", gomock.Any())
 			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, false, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
@@ -748,6 +1159,7 @@ var _ = Describe("GenerateClusterISO", func() {
 			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
 			mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID))
 			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion).Return("rhcos-minimal.iso", nil)
+			mockMinimalIsoCacheMiss()
 			mockS3Client.EXPECT().DownloadPublic(gomock.Any(), "rhcos-minimal.iso").Return(ioutil.NopCloser(strings.NewReader("totallyaniso")), int64(12), nil)
 			mockIsoEditorFactory.EXPECT().WithEditor(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New(expectedErrMsg))
 			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityError, "Failed to generate minimal ISO", gomock.Any())
@@ -765,10 +1177,11 @@ var _ = Describe("GenerateClusterISO", func() {
 			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
 			mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID))
 			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion).Return("rhcos-minimal.iso", nil)
+			mockMinimalIsoCacheMiss()
 			mockS3Client.EXPECT().DownloadPublic(gomock.Any(), "rhcos-minimal.iso").Return(ioutil.NopCloser(strings.NewReader("totallyaniso")), int64(12), nil)
 			editor := isoeditor.NewMockEditor(ctrl)
 			stubWithEditor(mockIsoEditorFactory, editor)
-			editor.EXPECT().CreateClusterMinimalISO(gomock.Any(), "", gomock.Any()).Return("", errors.New(expectedErrMsg))
+			editor.EXPECT().CreateClusterMinimalISO(gomock.Any(), "", gomock.Any(), nil).Return("", errors.New(expectedErrMsg))
 			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityError, "Failed to generate minimal ISO", gomock.Any())
 			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, false, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
 			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, true, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(0)
@@ -787,15 +1200,499 @@ var _ = Describe("GenerateClusterISO", func() {
 			mockS3Client.EXPECT().DownloadPublic(gomock.Any(), "rhcos-minimal.iso").Return(ioutil.NopCloser(strings.NewReader("totallyaniso")), int64(12), nil)
 			editor := isoeditor.NewMockEditor(ctrl)
 			stubWithEditor(mockIsoEditorFactory, editor)
-			editor.EXPECT().CreateClusterMinimalISO(gomock.Any(), "", gomock.Any()).Return(isoFilePath, nil)
-			mockS3Client.EXPECT().UploadFile(gomock.Any(), isoFilePath, fmt.Sprintf("discovery-image-%s.iso", cluster.ID)).Return(errors.New(expectedErrMsg))
+			editor.EXPECT().CreateClusterMinimalISO(gomock.Any(), "", gomock.Any(), nil).Return(isoFilePath, nil)
+			mockS3Client.EXPECT().UploadFile(gomock.Any(), isoFilePath, fmt.Sprintf("%s.iso", mockMinimalIsoCacheMiss())).Return(errors.New(expectedErrMsg))
 			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityError, "Failed to generate minimal ISO", gomock.Any())
 			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, false, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
-			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, true, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(0)
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, true, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(0)
+
+			generateReply := generateClusterISO(models.ImageTypeMinimalIso)
+			Expect(generateReply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+			Expect(generateReply.(*common.ApiErrorResponse).Error()).Should(Equal(expectedErrMsg))
+		})
+	})
+
+	Context("disk images", func() {
+		var (
+			cluster       *common.Cluster
+			imageFilePath string
+		)
+		BeforeEach(func() {
+			cluster = registerCluster(true)
+
+			isoCacheDir, err := ioutil.TempDir("", "diskimagetest")
+			Expect(err).NotTo(HaveOccurred())
+			bm.ISOCacheDir = isoCacheDir
+
+			f, err := ioutil.TempFile("", "diskimagetest")
+			Expect(err).NotTo(HaveOccurred())
+			imageFilePath = f.Name()
+		})
+		AfterEach(func() {
+			s3wrapper.ClearFileCache()
+			os.Remove(imageFilePath)
+			os.RemoveAll(bm.ISOCacheDir)
+		})
+
+		mockDiskImageGeneration := func(imageType models.ImageType, format isoeditor.DiskImageFormat, returnValue error) {
+			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
+			mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID))
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, false, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, true, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).MaxTimes(1)
+			mockS3Client.EXPECT().GetBaseIsoObject(cluster.OpenshiftVersion).Return("rhcos", nil)
+			objectPrefix := mockImageCacheLookup("rhcos", imageType, "", false)
+			mockS3Client.EXPECT().DownloadPublic(gomock.Any(), "rhcos").Return(ioutil.NopCloser(strings.NewReader("totallyaniso")), int64(12), nil)
+			editor := isoeditor.NewMockEditor(ctrl)
+			mockIsoEditorFactory.EXPECT().WithEditor(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, isoPath string, log logrus.FieldLogger, proc isoeditor.EditFunc) error {
+					return proc(editor)
+				})
+			editor.EXPECT().CreateClusterDiskImage(gomock.Any(), nil, format).Return(imageFilePath, returnValue)
+			if returnValue == nil {
+				mockS3Client.EXPECT().UploadFile(gomock.Any(), imageFilePath, fmt.Sprintf("%s.%s", objectPrefix, getImageExtension(imageType)))
+				mockUploadImageChecksum(fmt.Sprintf("%s.%s", objectPrefix, getImageExtension(imageType)))
+				mockS3Client.EXPECT().IsAwsS3().Return(false)
+				mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
+				mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo,
+					fmt.Sprintf("Generated image (Image type is \"%s\", SSH public key is not set)", imageType), gomock.Any())
+			} else {
+				mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityError, "Failed to generate disk image", gomock.Any())
+			}
+		}
+
+		generateDiskImage := func(imageType models.ImageType) middleware.Responder {
+			return bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+				ClusterID: *cluster.ID,
+				ImageCreateParams: &models.ImageCreateParams{
+					ImageType: imageType,
+				},
+			})
+		}
+
+		It("creates a raw disk image", func() {
+			mockDiskImageGeneration(models.ImageTypeRawDisk, isoeditor.DiskImageFormatRaw, nil)
+			Expect(generateDiskImage(models.ImageTypeRawDisk)).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOCreated()))
+			_, err := os.Stat(imageFilePath)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("creates a qcow2 image", func() {
+			mockDiskImageGeneration(models.ImageTypeQcow2, isoeditor.DiskImageFormatQcow2, nil)
+			Expect(generateDiskImage(models.ImageTypeQcow2)).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOCreated()))
+			_, err := os.Stat(imageFilePath)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("fails when the disk image cannot be created", func() {
+			mockDiskImageGeneration(models.ImageTypeRawDisk, isoeditor.DiskImageFormatRaw, errors.New("failed"))
+			verifyApiError(generateDiskImage(models.ImageTypeRawDisk), http.StatusInternalServerError)
+		})
+
+		It("downloads the image with its extension and content type", func() {
+			imageType := models.ImageTypeQcow2
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Updates(map[string]interface{}{
+				"image_type": imageType, "image_generated": true}).Error).ShouldNot(HaveOccurred())
+			mockS3Client.EXPECT().DoesObjectExist(ctx, getImageFileName(*cluster.ID, imageType)).Return(true, nil)
+			mockS3Client.EXPECT().Download(ctx, getImageFileName(*cluster.ID, imageType)).Return(ioutil.NopCloser(strings.NewReader("qcow2")), int64(5), nil)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo, `Started image download (image type is "qcow2")`, gomock.Any())
+
+			response := bm.DownloadClusterISO(ctx, installer.DownloadClusterISOParams{ClusterID: *cluster.ID})
+			recorder := httptest.NewRecorder()
+			response.WriteResponse(recorder, runtime.ByteStreamProducer())
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/x-qemu-disk"))
+			Expect(recorder.Header().Get("Content-Disposition")).To(Equal(fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("cluster-%s-discovery.qcow2", cluster.ID))))
+			Expect(recorder.Header().Get("Content-Length")).To(Equal("5"))
+		})
+	})
+
+	Context("image signature", func() {
+		var (
+			cluster      *common.Cluster
+			publicKeyPEM string
+		)
+
+		BeforeEach(func() {
+			cluster = registerCluster(true)
+			var err error
+			publicKeyPEM, bm.Config.ImageSigningKeyPEM, err = gencrypto.ECDSAKeyPairPEM()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		verifySignature := func(response middleware.Responder, checksum string) {
+			recorder := httptest.NewRecorder()
+			response.WriteResponse(recorder, runtime.ByteStreamProducer())
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Disposition")).To(Equal(fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("cluster-%s-discovery.iso.sig", cluster.ID))))
+
+			publicKey, err := jwt.ParseECPublicKeyFromPEM([]byte(publicKeyPEM))
+			Expect(err).NotTo(HaveOccurred())
+			digest, err := hex.DecodeString(checksum)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ecdsa.VerifyASN1(publicKey, digest, recorder.Body.Bytes())).To(BeTrue())
+		}
+
+		It("returns not found when the image was not generated", func() {
+			verifyApiError(bm.DownloadClusterISOSignature(ctx, installer.DownloadClusterISOSignatureParams{ClusterID: *cluster.ID}), http.StatusNotFound)
+		})
+
+		It("returns not found when the checksum of the image was not computed", func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Update("image_generated", true).Error).ShouldNot(HaveOccurred())
+			verifyApiError(bm.DownloadClusterISOSignature(ctx, installer.DownloadClusterISOSignatureParams{ClusterID: *cluster.ID}), http.StatusNotFound)
+		})
+
+		It("returns not found with a non-existant cluster", func() {
+			verifyApiError(bm.DownloadClusterISOSignature(ctx, installer.DownloadClusterISOSignatureParams{ClusterID: strfmt.UUID(uuid.New().String())}), http.StatusNotFound)
+		})
+
+		It("returns not found without a signing key", func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Updates(map[string]interface{}{
+				"image_generated": true, "image_checksum": imageChecksum}).Error).ShouldNot(HaveOccurred())
+			bm.Config.ImageSigningKeyPEM = ""
+			verifyApiError(bm.DownloadClusterISOSignature(ctx, installer.DownloadClusterISOSignatureParams{ClusterID: *cluster.ID}), http.StatusNotFound)
+		})
+
+		It("signs the checksum of the image", func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Updates(map[string]interface{}{
+				"image_generated": true, "image_checksum": imageChecksum}).Error).ShouldNot(HaveOccurred())
+			verifySignature(bm.DownloadClusterISOSignature(ctx, installer.DownloadClusterISOSignatureParams{ClusterID: *cluster.ID}), imageChecksum)
+		})
+
+		It("computes the checksum of a streamed image once, when its signature is first downloaded", func() {
+			bm.Config.StreamDiscoveryISOs = true
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Updates(map[string]interface{}{
+				"image_type": models.ImageTypeFullIso, "image_generated": true, "image_created_at": strfmt.DateTime(time.Now())}).Error).ShouldNot(HaveOccurred())
+			mockS3Client.EXPECT().Download(gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID)).
+				Return(ioutil.NopCloser(strings.NewReader(discovery_ignition_3_1)), int64(len(discovery_ignition_3_1)), nil).Times(1)
+			mockS3Client.EXPECT().GetBaseIsoObject(cluster.OpenshiftVersion).Return("rhcos.iso", nil).Times(1)
+			mockS3Client.EXPECT().StreamISO(gomock.Any(), discovery_ignition_3_1, nil, nil, "rhcos.iso").
+				Return(&seekableNopCloser{strings.NewReader("streamed iso")}, int64(12), nil).Times(1)
+			sum := sha256.Sum256([]byte("streamed iso"))
+			streamedChecksum := hex.EncodeToString(sum[:])
+
+			verifySignature(bm.DownloadClusterISOSignature(ctx, installer.DownloadClusterISOSignatureParams{ClusterID: *cluster.ID}), streamedChecksum)
+			updated, err := common.GetClusterFromDB(db, *cluster.ID, common.SkipEagerLoading)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.ImageInfo.Checksum).To(Equal(streamedChecksum))
+
+			By("reusing the stored checksum")
+			verifySignature(bm.DownloadClusterISOSignature(ctx, installer.DownloadClusterISOSignatureParams{ClusterID: *cluster.ID}), streamedChecksum)
+		})
+
+		It("returns the public signing key", func() {
+			response := bm.DownloadImageSigningKey(ctx, installer.DownloadImageSigningKeyParams{})
+			recorder := httptest.NewRecorder()
+			response.WriteResponse(recorder, runtime.ByteStreamProducer())
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Disposition")).To(Equal(fmt.Sprintf("attachment; filename=%q", "image-signing-key.pem")))
+			_, err := jwt.ParseECPublicKeyFromPEM(recorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns not found for the public signing key when signing is not configured", func() {
+			bm.Config.ImageSigningKeyPEM = ""
+			verifyApiError(bm.DownloadImageSigningKey(ctx, installer.DownloadImageSigningKeyParams{}), http.StatusNotFound)
+		})
+	})
+
+	Context("kernel arguments", func() {
+		var (
+			cluster         *common.Cluster
+			isoFilePath     string
+			kernelArguments = models.KernelArguments{
+				{Operation: models.KernelArgumentOperationAppend, Value: "console=ttyS1,115200"},
+				{Operation: models.KernelArgumentOperationReplace, Value: "random.trust_cpu=off"},
+			}
+		)
+		BeforeEach(func() {
+			cluster = registerCluster(true)
+
+			isoCacheDir, err := ioutil.TempDir("", "kargstest")
+			Expect(err).NotTo(HaveOccurred())
+			bm.ISOCacheDir = isoCacheDir
+
+			f, err := ioutil.TempFile("", "kargstest")
+			Expect(err).NotTo(HaveOccurred())
+			isoFilePath = f.Name()
+		})
+		AfterEach(func() {
+			s3wrapper.ClearFileCache()
+			os.Remove(isoFilePath)
+			os.RemoveAll(bm.ISOCacheDir)
+		})
+
+		generateClusterISO := func(imageType models.ImageType, kernelArguments models.KernelArguments) middleware.Responder {
+			return bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+				ClusterID: *cluster.ID,
+				ImageCreateParams: &models.ImageCreateParams{
+					ImageType:       imageType,
+					KernelArguments: kernelArguments,
+				},
+			})
+		}
+
+		mockImageGeneration := func(imageType models.ImageType) {
+			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
+			mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID))
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, false, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, true, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
+			mockS3Client.EXPECT().IsAwsS3().Return(false).MinTimes(1)
+			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo,
+				fmt.Sprintf("Generated image (Image type is \"%s\", SSH public key is not set)", imageType), gomock.Any())
+		}
+
+		It("rejects kernel arguments the discovery image relies on", func() {
+			reply := generateClusterISO(models.ImageTypeFullIso, models.KernelArguments{
+				{Operation: models.KernelArgumentOperationDelete, Value: "coreos.live.rootfs_url"},
+			})
+			verifyApiError(reply, http.StatusBadRequest)
+		})
+
+		It("embeds the kernel arguments in the full ISO with the ISO editor", func() {
+			mockImageGeneration(models.ImageTypeFullIso)
+			mockS3Client.EXPECT().GetBaseIsoObject(cluster.OpenshiftVersion).Return("rhcos", nil)
+			formattedKernelArguments, err := formatKernelArgumentsForDB(kernelArguments)
+			Expect(err).ToNot(HaveOccurred())
+			objectPrefix := mockImageCacheLookupWithKernelArguments("rhcos", models.ImageTypeFullIso, "", formattedKernelArguments, false)
+			mockS3Client.EXPECT().DownloadPublic(gomock.Any(), "rhcos").Return(ioutil.NopCloser(strings.NewReader("totallyaniso")), int64(12), nil)
+			editor := isoeditor.NewMockEditor(ctrl)
+			mockIsoEditorFactory.EXPECT().WithEditor(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, isoPath string, log logrus.FieldLogger, proc isoeditor.EditFunc) error {
+					return proc(editor)
+				})
+			editor.EXPECT().CreateClusterFullISO(gomock.Any(), kernelArguments).Return(isoFilePath, nil)
+			mockS3Client.EXPECT().UploadFile(gomock.Any(), isoFilePath, fmt.Sprintf("%s.iso", objectPrefix))
+			mockUploadImageChecksum(fmt.Sprintf("%s.iso", objectPrefix))
+
+			Expect(generateClusterISO(models.ImageTypeFullIso, kernelArguments)).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOCreated()))
+			_, err = os.Stat(isoFilePath)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+
+			var updated common.Cluster
+			Expect(db.First(&updated, "id = ?", cluster.ID.String()).Error).ShouldNot(HaveOccurred())
+			Expect(updated.ImageInfo.KernelArguments).To(Equal(formattedKernelArguments))
+		})
+
+		It("passes the kernel arguments to the minimal ISO editor", func() {
+			mockImageGeneration(models.ImageTypeMinimalIso)
+			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion).Return("rhcos-minimal.iso", nil)
+			formattedKernelArguments, err := formatKernelArgumentsForDB(kernelArguments)
+			Expect(err).ToNot(HaveOccurred())
+			objectPrefix := mockImageCacheLookupWithKernelArguments("rhcos-minimal.iso", models.ImageTypeMinimalIso, "\n\n\n", formattedKernelArguments, false)
+			mockS3Client.EXPECT().DownloadPublic(gomock.Any(), "rhcos-minimal.iso").Return(ioutil.NopCloser(strings.NewReader("totallyaniso")), int64(12), nil)
+			editor := isoeditor.NewMockEditor(ctrl)
+			mockIsoEditorFactory.EXPECT().WithEditor(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, isoPath string, log logrus.FieldLogger, proc isoeditor.EditFunc) error {
+					return proc(editor)
+				})
+			editor.EXPECT().CreateClusterMinimalISO(gomock.Any(), "", gomock.Any(), kernelArguments).Return(isoFilePath, nil)
+			mockS3Client.EXPECT().UploadFile(gomock.Any(), isoFilePath, fmt.Sprintf("%s.iso", objectPrefix))
+			mockUploadImageChecksum(fmt.Sprintf("%s.iso", objectPrefix))
+
+			Expect(generateClusterISO(models.ImageTypeMinimalIso, kernelArguments)).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOCreated()))
+		})
+	})
+
+	Context("streamed ISO", func() {
+		var cluster *common.Cluster
+
+		BeforeEach(func() {
+			bm.Config.StreamDiscoveryISOs = true
+			cluster = registerCluster(true)
+		})
+
+		It("generates the image without uploading it and streams it on download", func() {
+			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
+			mockS3Client.EXPECT().Upload(gomock.Any(), []byte(discovery_ignition_3_1), fmt.Sprintf("%s/discovery.ign", cluster.ID))
+			mockS3Client.EXPECT().UploadISO(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			mockS3Client.EXPECT().GetBaseIsoObject(cluster.OpenshiftVersion).Return("rhcos.iso", nil).Times(1)
+			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), "rhcos.iso").Return(int64(100), nil).Times(1)
+			mockS3Client.EXPECT().IsAwsS3().Return(false)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo, "Generated image (Image type is \"full-iso\", SSH public key is not set)", gomock.Any())
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, false, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, true, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
+			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+				ClusterID:         *cluster.ID,
+				ImageCreateParams: &models.ImageCreateParams{ImageType: models.ImageTypeFullIso},
+			})
+			Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOCreated()))
+			Expect(*generateReply.(*installer.GenerateClusterISOCreated).Payload.ImageInfo.SizeBytes).To(Equal(int64(100)))
+			Expect(generateReply.(*installer.GenerateClusterISOCreated).Payload.ImageInfo.Checksum).To(BeEmpty())
+
+			By("downloading a range of the image")
+			mockS3Client.EXPECT().Download(gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID)).
+				Return(ioutil.NopCloser(strings.NewReader(discovery_ignition_3_1)), int64(len(discovery_ignition_3_1)), nil)
+			mockS3Client.EXPECT().GetBaseIsoObject(cluster.OpenshiftVersion).Return("rhcos.iso", nil).Times(1)
+			mockS3Client.EXPECT().StreamISO(gomock.Any(), discovery_ignition_3_1, nil, nil, "rhcos.iso").
+				Return(&seekableNopCloser{strings.NewReader("streamed iso")}, int64(12), nil)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo, "Started image download (image type is \"full-iso\")", gomock.Any())
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			request.Header.Set("Range", "bytes=9-")
+			downloadReply := bm.DownloadClusterISO(ctx, installer.DownloadClusterISOParams{ClusterID: *cluster.ID, HTTPRequest: request})
+			recorder := httptest.NewRecorder()
+			downloadReply.WriteResponse(recorder, runtime.ByteStreamProducer())
+			Expect(recorder.Code).To(Equal(http.StatusPartialContent))
+			Expect(recorder.Body.String()).To(Equal("iso"))
+		})
+
+		It("streams a minimal ISO with the RAM disk uploaded when its image was generated", func() {
+			var ramDisk []byte
+			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
+			mockS3Client.EXPECT().Upload(gomock.Any(), []byte(discovery_ignition_3_1), fmt.Sprintf("%s/discovery.ign", cluster.ID))
+			mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("%s/discovery-ramdisk.img", cluster.ID)).
+				DoAndReturn(func(_ context.Context, data []byte, _ string) error {
+					ramDisk = data
+					return nil
+				})
+			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion).Return("rhcos-minimal.iso", nil).Times(1)
+			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), "rhcos-minimal.iso").Return(int64(100), nil).Times(1)
+			mockS3Client.EXPECT().IsAwsS3().Return(false)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any())
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, false, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, true, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
+			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+				ClusterID:         *cluster.ID,
+				ImageCreateParams: &models.ImageCreateParams{ImageType: models.ImageTypeMinimalIso},
+			})
+			Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOCreated()))
+			Expect(ramDisk).ToNot(BeEmpty())
+
+			By("streaming the generated RAM disk after the proxy settings changed")
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).
+				Update("http_proxy", "http://proxy.example.com:3128").Error).ShouldNot(HaveOccurred())
+			mockS3Client.EXPECT().Download(gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID)).
+				Return(ioutil.NopCloser(strings.NewReader(discovery_ignition_3_1)), int64(len(discovery_ignition_3_1)), nil)
+			mockS3Client.EXPECT().Download(gomock.Any(), fmt.Sprintf("%s/discovery-ramdisk.img", cluster.ID)).
+				Return(ioutil.NopCloser(bytes.NewReader(ramDisk)), int64(len(ramDisk)), nil)
+			mockS3Client.EXPECT().GetMinimalIsoObjectName(cluster.OpenshiftVersion).Return("rhcos-minimal.iso", nil).Times(1)
+			mockS3Client.EXPECT().StreamISO(gomock.Any(), discovery_ignition_3_1, ramDisk, nil, "rhcos-minimal.iso").
+				Return(&seekableNopCloser{strings.NewReader("streamed iso")}, int64(12), nil)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo, "Started image download (image type is \"minimal-iso\")", gomock.Any())
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			downloadReply := bm.DownloadClusterISO(ctx, installer.DownloadClusterISOParams{ClusterID: *cluster.ID, HTTPRequest: request})
+			recorder := httptest.NewRecorder()
+			downloadReply.WriteResponse(recorder, runtime.ByteStreamProducer())
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(Equal("streamed iso"))
+		})
+
+		It("streams a raw disk image with its extension and content type", func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Updates(map[string]interface{}{
+				"image_type": models.ImageTypeRawDisk, "image_generated": true}).Error).ShouldNot(HaveOccurred())
+			mockS3Client.EXPECT().Download(gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID)).
+				Return(ioutil.NopCloser(strings.NewReader(discovery_ignition_3_1)), int64(len(discovery_ignition_3_1)), nil)
+			mockS3Client.EXPECT().GetBaseIsoObject(cluster.OpenshiftVersion).Return("rhcos.iso", nil).Times(1)
+			mockS3Client.EXPECT().StreamISO(gomock.Any(), discovery_ignition_3_1, nil, nil, "rhcos.iso").
+				Return(&seekableNopCloser{strings.NewReader("streamed img")}, int64(12), nil)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo, "Started image download (image type is \"raw-disk\")", gomock.Any())
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			downloadReply := bm.DownloadClusterISO(ctx, installer.DownloadClusterISOParams{ClusterID: *cluster.ID, HTTPRequest: request})
+			recorder := httptest.NewRecorder()
+			downloadReply.WriteResponse(recorder, runtime.ByteStreamProducer())
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/octet-stream"))
+			Expect(recorder.Header().Get("Content-Disposition")).To(Equal(fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("cluster-%s-discovery.img", cluster.ID))))
+		})
+	})
+
+	Context("pxe", func() {
+		var cluster *common.Cluster
+
+		BeforeEach(func() {
+			cluster = registerCluster(true)
+
+			isoCacheDir, err := ioutil.TempDir("", "pxetest")
+			Expect(err).NotTo(HaveOccurred())
+			bm.ISOCacheDir = isoCacheDir
+		})
+		AfterEach(func() {
+			s3wrapper.ClearFileCache()
+			os.RemoveAll(bm.ISOCacheDir)
+		})
+
+		artifactURL := func(fileName string) string {
+			return fmt.Sprintf("%s/api/assisted-install/v1/clusters/%s/downloads/pxe-artifacts?file_name=%s", FakeServiceBaseURL, cluster.ID, fileName)
+		}
+
+		It("Creates the network boot artifacts successfully", func() {
+			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
+			mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID))
+			mockS3Client.EXPECT().GetBaseIsoObject(cluster.OpenshiftVersion).Return("rhcos.iso", nil)
+			mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(true, nil).Times(3)
+			mockS3Client.EXPECT().Download(gomock.Any(), "rhcos-pxe-initrd.img").Return(ioutil.NopCloser(strings.NewReader("baseinitrd")), int64(10), nil)
+			mockS3Client.EXPECT().UploadStream(gomock.Any(), gomock.Any(), fmt.Sprintf("discovery-image-%s-pxe-initrd.img", cluster.ID)).
+				DoAndReturn(func(ctx context.Context, reader io.Reader, objectName string) error {
+					initrd, err := ioutil.ReadAll(reader)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(initrd)).To(HavePrefix("baseinitrd"))
+					return nil
+				})
+			mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("discovery-image-%s-pxe-ipxe-script", cluster.ID)).
+				DoAndReturn(func(ctx context.Context, data []byte, objectName string) error {
+					script := string(data)
+					Expect(script).To(HavePrefix("#!ipxe\n"))
+					Expect(script).To(ContainSubstring(fmt.Sprintf("initrd --name initrd %s", artifactURL("initrd.img"))))
+					Expect(script).To(ContainSubstring(fmt.Sprintf("kernel %s initrd=initrd coreos.live.rootfs_url=%s", artifactURL("vmlinuz"), artifactURL("rootfs.img"))))
+					return nil
+				})
+			mockS3Client.EXPECT().IsAwsS3().Return(false).Times(4)
+			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), fmt.Sprintf("discovery-image-%s-pxe-initrd.img", cluster.ID)).Return(int64(100), nil).Times(1)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo, "Generated image (Image type is \"pxe\", SSH public key is not set)", gomock.Any())
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, false, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, true, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
+
+			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+				ClusterID:         *cluster.ID,
+				ImageCreateParams: &models.ImageCreateParams{ImageType: models.ImageTypePxe},
+			})
+			Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOCreated()))
+			Expect(generateReply.(*installer.GenerateClusterISOCreated).Payload.ImageInfo.DownloadURL).To(Equal(artifactURL("ipxe-script")))
+
+			By("downloading the kernel of the base ISO")
+			mockS3Client.EXPECT().GetBaseIsoObject(cluster.OpenshiftVersion).Return("rhcos.iso", nil)
+			mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), "rhcos-pxe-vmlinuz").Return(true, nil)
+			kernel := ioutil.NopCloser(strings.NewReader("kernel"))
+			mockS3Client.EXPECT().Download(gomock.Any(), "rhcos-pxe-vmlinuz").Return(kernel, int64(6), nil)
+			downloadReply := bm.DownloadClusterPxeArtifact(ctx, installer.DownloadClusterPxeArtifactParams{ClusterID: *cluster.ID, FileName: "vmlinuz"})
+			Expect(downloadReply).Should(Equal(filemiddleware.NewResponder(installer.NewDownloadClusterPxeArtifactOK().WithPayload(kernel),
+				fmt.Sprintf("cluster-%s-vmlinuz", cluster.ID), 6)))
+		})
+
+		It("Includes the kernel arguments in the iPXE script", func() {
+			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("").Times(1)
+			mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("%s/discovery.ign", cluster.ID))
+			mockS3Client.EXPECT().GetBaseIsoObject(cluster.OpenshiftVersion).Return("rhcos.iso", nil)
+			mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(true, nil).Times(3)
+			mockS3Client.EXPECT().Download(gomock.Any(), "rhcos-pxe-initrd.img").Return(ioutil.NopCloser(strings.NewReader("baseinitrd")), int64(10), nil)
+			mockS3Client.EXPECT().UploadStream(gomock.Any(), gomock.Any(), fmt.Sprintf("discovery-image-%s-pxe-initrd.img", cluster.ID)).Return(nil)
+			mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("discovery-image-%s-pxe-ipxe-script", cluster.ID)).
+				DoAndReturn(func(ctx context.Context, data []byte, objectName string) error {
+					Expect(string(data)).To(ContainSubstring(fmt.Sprintf("kernel %s initrd=initrd coreos.live.rootfs_url=%s ignition.firstboot ignition.platform.id=metal console=ttyS1,115200\n",
+						artifactURL("vmlinuz"), artifactURL("rootfs.img"))))
+					return nil
+				})
+			mockS3Client.EXPECT().IsAwsS3().Return(false).Times(4)
+			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), fmt.Sprintf("discovery-image-%s-pxe-initrd.img", cluster.ID)).Return(int64(100), nil).Times(1)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), *cluster.ID, nil, models.EventSeverityInfo, "Generated image (Image type is \"pxe\", SSH public key is not set)", gomock.Any())
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, false, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), bm.IgnitionConfig, true, bm.authHandler.AuthType()).Return(discovery_ignition_3_1, nil).Times(1)
 
-			generateReply := generateClusterISO(models.ImageTypeMinimalIso)
-			Expect(generateReply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
-			Expect(generateReply.(*common.ApiErrorResponse).Error()).Should(Equal(expectedErrMsg))
+			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+				ClusterID: *cluster.ID,
+				ImageCreateParams: &models.ImageCreateParams{
+					ImageType: models.ImageTypePxe,
+					KernelArguments: models.KernelArguments{
+						{Operation: models.KernelArgumentOperationDelete, Value: "random.trust_cpu"},
+						{Operation: models.KernelArgumentOperationAppend, Value: "console=ttyS1,115200"},
+					},
+				},
+			})
+			Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOCreated()))
+		})
+
+		It("Fails to download artifacts of an ISO image", func() {
+			downloadReply := bm.DownloadClusterPxeArtifact(ctx, installer.DownloadClusterPxeArtifactParams{ClusterID: *cluster.ID, FileName: "initrd.img"})
+			verifyApiError(downloadReply, http.StatusNotFound)
 		})
 	})
 })
@@ -1159,6 +2056,7 @@ var _ = Describe("PostStepReply", func() {
 		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
 		db, dbName = common.PrepareTestDB()
 		bm = createInventory(db, cfg)
+		mockHostApi.EXPECT().RecordStepSuccess(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	})
 
 	AfterEach(func() {
@@ -1651,6 +2549,65 @@ var _ = Describe("PostStepReply", func() {
 			Expect(reply).Should(BeAssignableToTypeOf(installer.NewPostStepReplyNoContent()))
 		})
 	})
+
+	Context("Upgrade agent", func() {
+		var (
+			clusterId *strfmt.UUID
+			hostId    *strfmt.UUID
+		)
+
+		var makeStepReply = func(clusterID, hostID strfmt.UUID, response *models.UpgradeAgentResponse, exitCode int64) installer.PostStepReplyParams {
+			b, err := json.Marshal(response)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			return installer.PostStepReplyParams{
+				ClusterID: clusterID,
+				HostID:    hostID,
+				Reply: &models.StepReply{
+					ExitCode: exitCode,
+					Output:   string(b),
+					StepType: models.StepTypeUpgradeAgent,
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			clusterId = strToUUID(uuid.New().String())
+			hostId = strToUUID(uuid.New().String())
+
+			host := models.Host{
+				ID:        hostId,
+				ClusterID: *clusterId,
+				Status:    swag.String("discovering"),
+			}
+			cluster := common.Cluster{Cluster: models.Cluster{ID: clusterId}}
+
+			Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		})
+
+		It("Upgrade agent success", func() {
+			response := &models.UpgradeAgentResponse{
+				AgentImage: "quay.io/ocpmetal/assisted-installer-agent:v1.0.20.2",
+				Result:     models.UpgradeAgentResultSuccess,
+			}
+			mockHostApi.EXPECT().UpdateAgentUpgradeStatus(gomock.Any(), gomock.Any(), response, gomock.Any()).Return(nil).Times(1)
+			params := makeStepReply(*clusterId, *hostId, response, 0)
+			reply := bm.PostStepReply(ctx, params)
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewPostStepReplyNoContent()))
+		})
+
+		It("Upgrade agent failure", func() {
+			expected := &models.UpgradeAgentResponse{
+				AgentImage: bm.AgentDockerImg,
+				Result:     models.UpgradeAgentResultFailure,
+			}
+			mockHostApi.EXPECT().UpdateAgentUpgradeStatus(gomock.Any(), gomock.Any(), expected, gomock.Any()).Return(nil).Times(1)
+			params := makeStepReply(*clusterId, *hostId, &models.UpgradeAgentResponse{}, -1)
+			reply := bm.PostStepReply(ctx, params)
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewPostStepReplyNoContent()))
+		})
+	})
 })
 
 var _ = Describe("GetFreeAddresses", func() {
@@ -2167,6 +3124,37 @@ var _ = Describe("cluster", func() {
 			})
 		})
 	})
+	Context("Create cluster with an additional trust bundle", func() {
+		BeforeEach(func() {
+			mockDurationsSuccess()
+		})
+		It("sets the bundle", func() {
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
+				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil)
+			mockClusterRegisterSuccess(bm, true)
+			reply := bm.RegisterCluster(ctx, installer.RegisterClusterParams{
+				NewClusterParams: &models.ClusterCreateParams{
+					Name:                  swag.String("some-cluster-name"),
+					OpenshiftVersion:      swag.String(common.TestDefaultConfig.OpenShiftVersion),
+					PullSecret:            swag.String("{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"),
+					AdditionalTrustBundle: swag.String(common.TestAdditionalTrustBundle),
+				},
+			})
+			Expect(reflect.TypeOf(reply)).Should(Equal(reflect.TypeOf(installer.NewRegisterClusterCreated())))
+			Expect(reply.(*installer.RegisterClusterCreated).Payload.AdditionalTrustBundle).To(Equal(common.TestAdditionalTrustBundle))
+		})
+		It("rejects an invalid bundle", func() {
+			reply := bm.RegisterCluster(ctx, installer.RegisterClusterParams{
+				NewClusterParams: &models.ClusterCreateParams{
+					Name:                  swag.String("some-cluster-name"),
+					OpenshiftVersion:      swag.String(common.TestDefaultConfig.OpenShiftVersion),
+					PullSecret:            swag.String("{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"),
+					AdditionalTrustBundle: swag.String("not a certificate"),
+				},
+			})
+			verifyApiError(reply, http.StatusBadRequest)
+		})
+	})
 	Context("Create non HA cluster", func() {
 		BeforeEach(func() {
 			mockDurationsSuccess()
@@ -2993,6 +3981,31 @@ var _ = Describe("cluster", func() {
 			Expect(actual.Payload.UserManagedNetworking).To(Equal(swag.Bool(true)))
 		})
 
+		It("Update routed machine networks keeps their order", func() {
+			mockSetConnectivityMajorityGroupsForCluster(mockClusterApi)
+			clusterID = strfmt.UUID(uuid.New().String())
+			err := db.Create(&common.Cluster{Cluster: models.Cluster{
+				ID:                    &clusterID,
+				UserManagedNetworking: swag.Bool(true),
+			}}).Error
+			Expect(err).ShouldNot(HaveOccurred())
+			mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+			mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+			reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+				ClusterID: clusterID,
+				ClusterUpdateParams: &models.ClusterUpdateParams{
+					MachineNetworks: []*models.MachineNetwork{{Cidr: "10.0.3.0/24"}, {Cidr: "10.0.1.0/24"}, {Cidr: "10.0.2.0/24"}},
+				},
+			})
+			Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+			actual := reply.(*installer.UpdateClusterCreated)
+			Expect(actual.Payload.MachineNetworks).To(HaveLen(3))
+			for i, cidr := range []string{"10.0.3.0/24", "10.0.1.0/24", "10.0.2.0/24"} {
+				Expect(actual.Payload.MachineNetworks[i].Cidr).To(Equal(cidr))
+				Expect(actual.Payload.MachineNetworks[i].Index).To(Equal(int64(i)))
+			}
+		})
+
 		It("Update UserManagedNetworking Unset non relevant parameters", func() {
 
 			mockSetConnectivityMajorityGroupsForCluster(mockClusterApi)
@@ -3161,6 +4174,49 @@ var _ = Describe("cluster", func() {
 			})
 		})
 
+		Context("Update additional trust bundle", func() {
+			BeforeEach(func() {
+				clusterID = strfmt.UUID(uuid.New().String())
+				err := db.Create(&common.Cluster{Cluster: models.Cluster{
+					ID: &clusterID,
+				}}).Error
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			updateCluster := func(trustBundle string) middleware.Responder {
+				return bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+					ClusterID:           clusterID,
+					ClusterUpdateParams: &models.ClusterUpdateParams{AdditionalTrustBundle: &trustBundle},
+				})
+			}
+
+			It("rejects an invalid bundle", func() {
+				verifyApiError(updateCluster("not a certificate"), http.StatusBadRequest)
+			})
+
+			It("sets the bundle and deletes the image", func() {
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+				mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				mockSetConnectivityMajorityGroupsForCluster(mockClusterApi)
+				mockS3Client.EXPECT().DeleteObject(gomock.Any(), getImageFileName(clusterID, "")).Return(true, nil).Times(1)
+				mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityInfo,
+					"Deleted image from backend because the additional trust bundle was updated. The image may be regenerated at any time.", gomock.Any())
+				reply := updateCluster(common.TestAdditionalTrustBundle)
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+				Expect(reply.(*installer.UpdateClusterCreated).Payload.AdditionalTrustBundle).To(Equal(common.TestAdditionalTrustBundle))
+			})
+
+			It("keeps the image when the bundle is unchanged", func() {
+				Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).
+					Update("additional_trust_bundle", common.TestAdditionalTrustBundle).Error).ShouldNot(HaveOccurred())
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+				mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				mockSetConnectivityMajorityGroupsForCluster(mockClusterApi)
+				mockS3Client.EXPECT().DeleteObject(gomock.Any(), gomock.Any()).Times(0)
+				Expect(updateCluster(common.TestAdditionalTrustBundle)).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+			})
+		})
+
 		Context("Hostname", func() {
 			BeforeEach(func() {
 				clusterID = strfmt.UUID(uuid.New().String())
@@ -4321,7 +5377,7 @@ var _ = Describe("KubeConfig download", func() {
 	})
 
 	It("kubeconfig presigned backend not aws", func() {
-		mockS3Client.EXPECT().IsAwsS3().Return(false)
+		mockS3Client.EXPECT().SupportsPresignedDownloadURLs().Return(false)
 		generateReply := bm.GetPresignedForClusterFiles(ctx, installer.GetPresignedForClusterFilesParams{
 			ClusterID: clusterID,
 			FileName:  constants.Kubeconfig,
@@ -4330,7 +5386,7 @@ var _ = Describe("KubeConfig download", func() {
 		Expect(generateReply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
 	})
 	It("kubeconfig presigned cluster is not in installed state", func() {
-		mockS3Client.EXPECT().IsAwsS3().Return(true)
+		mockS3Client.EXPECT().SupportsPresignedDownloadURLs().Return(true)
 		generateReply := bm.GetPresignedForClusterFiles(ctx, installer.GetPresignedForClusterFilesParams{
 			ClusterID: clusterID,
 			FileName:  constants.Kubeconfig,
@@ -4343,7 +5399,7 @@ var _ = Describe("KubeConfig download", func() {
 		c.Status = &status
 		db.Save(&c)
 		fileName := fmt.Sprintf("%s/%s", clusterID, constants.Kubeconfig)
-		mockS3Client.EXPECT().IsAwsS3().Return(true)
+		mockS3Client.EXPECT().SupportsPresignedDownloadURLs().Return(true)
 		mockS3Client.EXPECT().GeneratePresignedDownloadURL(ctx, fileName, constants.Kubeconfig, gomock.Any()).Return("url", nil)
 		generateReply := bm.GetPresignedForClusterFiles(ctx, installer.GetPresignedForClusterFilesParams{
 			ClusterID: clusterID,
@@ -4394,6 +5450,111 @@ var _ = Describe("KubeConfig download", func() {
 	})
 })
 
+var testIngressCa = models.IngressCertParams("-----BEGIN CERTIFICATE-----\nMIIDozCCAougAwIBAgIULCOqWTF" +
+	"aEA8gNEmV+rb7h1v0r3EwDQYJKoZIhvcNAQELBQAwYTELMAkGA1UEBhMCaXMxCzAJBgNVBAgMAmRk" +
+	"MQswCQYDVQQHDAJkZDELMAkGA1UECgwCZGQxCzAJBgNVBAsMAmRkMQswCQYDVQQDDAJkZDERMA8GCSqGSIb3DQEJARYCZGQwHhcNMjAwNTI1MTYwNTAwWhcNMzA" +
+	"wNTIzMTYwNTAwWjBhMQswCQYDVQQGEwJpczELMAkGA1UECAwCZGQxCzAJBgNVBAcMAmRkMQswCQYDVQQKDAJkZDELMAkGA1UECwwCZGQxCzAJBgNVBAMMAmRkMREwDwYJKoZIh" +
+	"vcNAQkBFgJkZDCCASIwDQYJKoZIhvcNAQEBBQADggEPADCCAQoCggEBAML63CXkBb+lvrJKfdfYBHLDYfuaC6exCSqASUAosJWWrfyDiDMUbmfs06PLKyv7N8efDhza74ov0EQJ" +
+	"NRhMNaCE+A0ceq6ZXmmMswUYFdLAy8K2VMz5mroBFX8sj5PWVr6rDJ2ckBaFKWBB8NFmiK7MTWSIF9n8M107/9a0QURCvThUYu+sguzbsLODFtXUxG5rtTVKBVcPZvEfRky2Tkt4AySFS" +
+	"mkO6Kf4sBd7MC4mKWZm7K8k7HrZYz2usSpbrEtYGtr6MmN9hci+/ITDPE291DFkzIcDCF493v/3T+7XsnmQajh6kuI+bjIaACfo8N+twEoJf/N1PmphAQdEiC0CAwEAAaNTMFEwHQYDVR0O" +
+	"BBYEFNvmSprQQ2HUUtPxs6UOuxq9lKKpMB8GA1UdIwQYMBaAFNvmSprQQ2HUUtPxs6UOuxq9lKKpMA8GA1UdEwEB/wQFMAMBAf8wDQYJKoZIhvcNAQELBQADggEBAJEWxnxtQV5IqPVRr2SM" +
+	"WNNxcJ7A/wyet39l5VhHjbrQGynk5WS80psn/riLUfIvtzYMWC0IR0pIMQuMDF5sNcKp4D8Xnrd+Bl/4/Iy/iTOoHlw+sPkKv+NL2XR3iO8bSDwjtjvd6L5NkUuzsRoSkQCG2fHASqqgFoyV9Ld" +
+	"RsQa1w9ZGebtEWLuGsrJtR7gaFECqJnDbb0aPUMixmpMHID8kt154TrLhVFmMEqGGC1GvZVlQ9Of3GP9y7X4vDpHshdlWotOnYKHaeu2d5cRVFHhEbrslkISgh/TRuyl7VIpnjOYUwMBpCiVH6M" +
+	"2lyDI6UR3Fbz4pVVAxGXnVhBExjBE=\n-----END CERTIFICATE-----")
+
+var _ = Describe("encrypted cluster files", func() {
+	var (
+		bm              *bareMetalInventory
+		cfg             Config
+		db              *gorm.DB
+		dbName          string
+		ctx             = context.Background()
+		clusterID       strfmt.UUID
+		hostID          strfmt.UUID
+		baseDir         string
+		encryptedClient *s3wrapper.EncryptedClient
+	)
+
+	BeforeEach(func() {
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		mockMetric.EXPECT().FileSystemUsage(gomock.Any()).AnyTimes()
+		var err error
+		baseDir, err = ioutil.TempDir("", "encrypted-files")
+		Expect(err).ShouldNot(HaveOccurred())
+		kms, err := s3wrapper.NewStaticKMS(map[string]string{"key1": base64.StdEncoding.EncodeToString(make([]byte, 32))}, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		encryptedClient = s3wrapper.NewEncryptedClient(s3wrapper.NewFSClient(baseDir, common.GetTestLog(), nil, nil, mockMetric, 80, ""),
+			db, kms, time.Hour, common.GetTestLog())
+		bm.objectHandler = encryptedClient
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+			Status:           swag.String(models.ClusterStatusInstalled),
+		}}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&common.Host{Host: models.Host{
+			ID:        &hostID,
+			ClusterID: clusterID,
+			Role:      models.HostRoleMaster,
+			Status:    swag.String(models.HostStatusInstalled),
+		}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+		Expect(os.RemoveAll(baseDir)).To(Succeed())
+	})
+
+	stored := func(name string) []byte {
+		content, err := ioutil.ReadFile(filepath.Join(baseDir, clusterID.String(), name))
+		Expect(err).ShouldNot(HaveOccurred())
+		return content
+	}
+
+	downloaded := func(reply middleware.Responder) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		reply.WriteResponse(recorder, runtime.ByteStreamProducer())
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		return recorder
+	}
+
+	It("uploads the kubeconfig with the ingress CA encrypted and downloads it decrypted", func() {
+		kubeconfig, err := ioutil.ReadFile("../../subsystem/test_kubeconfig")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(encryptedClient.Upload(ctx, kubeconfig, fmt.Sprintf("%s/%s-noingress", clusterID, constants.Kubeconfig))).To(Succeed())
+		ingressCa := testIngressCa
+		merged, err := mergeIngressCaIntoKubeconfig(kubeconfig, []byte(ingressCa), common.GetTestLog())
+		Expect(err).ShouldNot(HaveOccurred())
+
+		reply := bm.UploadClusterIngressCert(ctx, installer.UploadClusterIngressCertParams{
+			ClusterID:         clusterID,
+			IngressCertParams: ingressCa,
+		})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewUploadClusterIngressCertCreated()))
+		Expect(string(stored(constants.Kubeconfig))).ToNot(ContainSubstring("certificate-authority-data"))
+
+		recorder := downloaded(bm.DownloadClusterKubeconfig(ctx, installer.DownloadClusterKubeconfigParams{ClusterID: clusterID}))
+		Expect(recorder.Body.Bytes()).To(Equal(merged))
+		Expect(recorder.Header().Get("Content-Length")).To(Equal(strconv.Itoa(len(merged))))
+	})
+
+	It("downloads host ignitions decrypted", func() {
+		ignitionName := fmt.Sprintf("%s-%s.ign", models.HostRoleMaster, hostID)
+		ignition := []byte(`{"ignition": {"version": "3.1.0"}}`)
+		Expect(encryptedClient.Upload(ctx, ignition, fmt.Sprintf("%s/%s", clusterID, ignitionName))).To(Succeed())
+		Expect(string(stored(ignitionName))).ToNot(ContainSubstring("ignition"))
+
+		recorder := downloaded(bm.DownloadHostIgnition(ctx, installer.DownloadHostIgnitionParams{ClusterID: clusterID, HostID: hostID}))
+		Expect(recorder.Body.Bytes()).To(Equal(ignition))
+		Expect(recorder.Header().Get("Content-Length")).To(Equal(strconv.Itoa(len(ignition))))
+	})
+})
+
 var _ = Describe("UploadClusterIngressCert test", func() {
 	var (
 		bm                  *bareMetalInventory
@@ -4412,17 +5573,7 @@ var _ = Describe("UploadClusterIngressCert test", func() {
 	BeforeEach(func() {
 		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
 		db, dbName = common.PrepareTestDB()
-		ingressCa = "-----BEGIN CERTIFICATE-----\nMIIDozCCAougAwIBAgIULCOqWTF" +
-			"aEA8gNEmV+rb7h1v0r3EwDQYJKoZIhvcNAQELBQAwYTELMAkGA1UEBhMCaXMxCzAJBgNVBAgMAmRk" +
-			"MQswCQYDVQQHDAJkZDELMAkGA1UECgwCZGQxCzAJBgNVBAsMAmRkMQswCQYDVQQDDAJkZDERMA8GCSqGSIb3DQEJARYCZGQwHhcNMjAwNTI1MTYwNTAwWhcNMzA" +
-			"wNTIzMTYwNTAwWjBhMQswCQYDVQQGEwJpczELMAkGA1UECAwCZGQxCzAJBgNVBAcMAmRkMQswCQYDVQQKDAJkZDELMAkGA1UECwwCZGQxCzAJBgNVBAMMAmRkMREwDwYJKoZIh" +
-			"vcNAQkBFgJkZDCCASIwDQYJKoZIhvcNAQEBBQADggEPADCCAQoCggEBAML63CXkBb+lvrJKfdfYBHLDYfuaC6exCSqASUAosJWWrfyDiDMUbmfs06PLKyv7N8efDhza74ov0EQJ" +
-			"NRhMNaCE+A0ceq6ZXmmMswUYFdLAy8K2VMz5mroBFX8sj5PWVr6rDJ2ckBaFKWBB8NFmiK7MTWSIF9n8M107/9a0QURCvThUYu+sguzbsLODFtXUxG5rtTVKBVcPZvEfRky2Tkt4AySFS" +
-			"mkO6Kf4sBd7MC4mKWZm7K8k7HrZYz2usSpbrEtYGtr6MmN9hci+/ITDPE291DFkzIcDCF493v/3T+7XsnmQajh6kuI+bjIaACfo8N+twEoJf/N1PmphAQdEiC0CAwEAAaNTMFEwHQYDVR0O" +
-			"BBYEFNvmSprQQ2HUUtPxs6UOuxq9lKKpMB8GA1UdIwQYMBaAFNvmSprQQ2HUUtPxs6UOuxq9lKKpMA8GA1UdEwEB/wQFMAMBAf8wDQYJKoZIhvcNAQELBQADggEBAJEWxnxtQV5IqPVRr2SM" +
-			"WNNxcJ7A/wyet39l5VhHjbrQGynk5WS80psn/riLUfIvtzYMWC0IR0pIMQuMDF5sNcKp4D8Xnrd+Bl/4/Iy/iTOoHlw+sPkKv+NL2XR3iO8bSDwjtjvd6L5NkUuzsRoSkQCG2fHASqqgFoyV9Ld" +
-			"RsQa1w9ZGebtEWLuGsrJtR7gaFECqJnDbb0aPUMixmpMHID8kt154TrLhVFmMEqGGC1GvZVlQ9Of3GP9y7X4vDpHshdlWotOnYKHaeu2d5cRVFHhEbrslkISgh/TRuyl7VIpnjOYUwMBpCiVH6M" +
-			"2lyDI6UR3Fbz4pVVAxGXnVhBExjBE=\n-----END CERTIFICATE-----"
+		ingressCa = testIngressCa
 		clusterID = strfmt.UUID(uuid.New().String())
 		bm = createInventory(db, cfg)
 		mockOperators := operators.NewMockAPI(ctrl)
@@ -4778,6 +5929,27 @@ var _ = Describe("Upload and Download logs test", func() {
 		verifyApiError(bm.UploadHostLogs(ctx, params), http.StatusNotFound)
 	})
 
+	It("Download support bundle", func() {
+		Expect(db.Model(&host1).Update("logs_collected_at", strfmt.DateTime(time.Now())).Error).ShouldNot(HaveOccurred())
+		logsName := bm.getLogsFullName(clusterID.String(), hostID.String())
+		mockEvents.EXPECT().GetEvents(clusterID, nil).Return([]*common.Event{}, nil).Times(1)
+		mockInstallConfigBuilder.EXPECT().GetRedactedInstallConfig(gomock.Any()).Return([]byte("pullSecret: '*****'"), nil).Times(1)
+		mockS3Client.EXPECT().ListObjectsByPrefix(gomock.Any(), fmt.Sprintf("%s/manifests", clusterID)).
+			Return([]string{fmt.Sprintf("%s/manifests/openshift/99-custom.yaml", clusterID)}, nil).Times(1)
+		mockS3Client.EXPECT().Download(gomock.Any(), logsName).Return(ioutil.NopCloser(strings.NewReader("logs")), int64(4), nil).Times(1)
+
+		reply := bm.DownloadClusterSupportBundle(ctx, installer.DownloadClusterSupportBundleParams{ClusterID: clusterID})
+		Expect(reply).Should(BeAssignableToTypeOf(filemiddleware.NewResponder(nil, "", 0)))
+		recorder := httptest.NewRecorder()
+		reply.WriteResponse(recorder, runtime.ByteStreamProducer())
+		bundle, err := supportbundle.Read(ctx, recorder.Body, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*bundle.Cluster.ID).Should(Equal(clusterID))
+		Expect(bundle.Hosts).Should(HaveLen(1))
+		Expect(bundle.Manifests).Should(Equal(models.ListManifests{{Folder: "openshift", FileName: "99-custom.yaml"}}))
+		Expect(bundle.Metadata.Logs).Should(Equal([]string{logsName}))
+	})
+
 	It("Upload S3 upload fails", func() {
 		newHostID := strfmt.UUID(uuid.New().String())
 		host := addHost(newHostID, models.HostRoleMaster, "known", models.HostKindHost, clusterID, "{}", db)
@@ -4788,9 +5960,24 @@ var _ = Describe("Upload and Download logs test", func() {
 			HTTPRequest: request,
 		}
 		fileName := bm.getLogsFullName(clusterID.String(), host.ID.String())
+		mockLogAnalyzer.EXPECT().Analyze(gomock.Any()).Return(nil, nil).Times(1)
 		mockS3Client.EXPECT().UploadStream(gomock.Any(), gomock.Any(), fileName).Return(errors.Errorf("Dummy")).Times(1)
 		verifyApiError(bm.UploadHostLogs(ctx, params), http.StatusInternalServerError)
 	})
+	It("Upload fails when the owner of the cluster exceeds its storage quota", func() {
+		Expect(db.Model(&c).Update("user_name", "user1").Error).ShouldNot(HaveOccurred())
+		c.UserName = "user1"
+		Expect(bm.quotaApi.Record(ctx, &c, "other", quota.KindLogs, "other", 10)).ShouldNot(HaveOccurred())
+		_, err := bm.quotaApi.UpdateLimits(ctx, models.QuotaScopeUser, "user1", &models.QuotaLimits{MaxStorageBytes: swag.Int64(10)})
+		Expect(err).ShouldNot(HaveOccurred())
+		params := installer.UploadHostLogsParams{
+			ClusterID:   clusterID,
+			HostID:      hostID,
+			Upfile:      kubeconfigFile,
+			HTTPRequest: request,
+		}
+		verifyApiError(bm.UploadHostLogs(ctx, params), http.StatusForbidden)
+	})
 	It("Upload Hosts logs Happy flow", func() {
 
 		newHostID := strfmt.UUID(uuid.New().String())
@@ -4802,7 +5989,9 @@ var _ = Describe("Upload and Download logs test", func() {
 			HTTPRequest: request,
 		}
 		fileName := bm.getLogsFullName(clusterID.String(), host.ID.String())
+		mockLogAnalyzer.EXPECT().Analyze(gomock.Any()).Return(nil, nil).Times(1)
 		mockS3Client.EXPECT().UploadStream(gomock.Any(), gomock.Any(), fileName).Return(nil).Times(1)
+		mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), fileName).Return(int64(10), nil).Times(1)
 		mockHostApi.EXPECT().SetUploadLogsAt(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockHostApi.EXPECT().UpdateLogsProgress(gomock.Any(), gomock.Any(), string(models.LogsStateCollecting)).Return(nil).Times(1)
 		reply := bm.UploadHostLogs(ctx, params)
@@ -4847,12 +6036,434 @@ var _ = Describe("Upload and Download logs test", func() {
 			LogsType:    string(models.LogsTypeController),
 		}
 		fileName := bm.getLogsFullName(clusterID.String(), string(models.LogsTypeController))
+		mockLogAnalyzer.EXPECT().Analyze(gomock.Any()).Return(nil, nil).Times(1)
 		mockS3Client.EXPECT().UploadStream(gomock.Any(), gomock.Any(), fileName).Return(nil).Times(1)
+		mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), fileName).Return(int64(10), nil).Times(1)
 		mockClusterApi.EXPECT().SetUploadControllerLogsAt(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockClusterApi.EXPECT().UpdateLogsProgress(gomock.Any(), gomock.Any(), string(models.LogsStateCollecting)).Return(nil).Times(1)
 		reply := bm.UploadLogs(ctx, params)
 		Expect(reply).Should(BeAssignableToTypeOf(installer.NewUploadLogsNoContent()))
 	})
+
+	Context("resumable logs upload", func() {
+		var (
+			uploadID strfmt.UUID
+			upload   *models.LogsUpload
+			checksum = strings.Repeat("a", 64)
+		)
+
+		BeforeEach(func() {
+			uploadID = strfmt.UUID(uuid.New().String())
+			upload = &models.LogsUpload{
+				ID:        uploadID,
+				ClusterID: clusterID,
+				HostID:    hostID,
+				LogsType:  models.LogsTypeHost,
+				TotalSize: 10,
+				ChunkSize: 4,
+			}
+		})
+
+		newUploadParams := func(logsType string, hostID strfmt.UUID) *models.NewLogsUploadParams {
+			return &models.NewLogsUploadParams{
+				LogsType:  swag.String(logsType),
+				HostID:    hostID,
+				TotalSize: swag.Int64(10),
+				ChunkSize: swag.Int64(4),
+			}
+		}
+
+		It("starts an upload of host logs", func() {
+			uploadParams := newUploadParams(hostLogsType, hostID)
+			mockLogsUpload.EXPECT().Create(gomock.Any(), clusterID, uploadParams).Return(upload, nil).Times(1)
+			reply := bm.CreateLogsUpload(ctx, installer.CreateLogsUploadParams{ClusterID: clusterID, NewLogsUploadParams: uploadParams})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewCreateLogsUploadCreated()))
+			Expect(reply.(*installer.CreateLogsUploadCreated).Payload).To(Equal(upload))
+		})
+
+		It("fails to start an upload for a missing cluster", func() {
+			params := installer.CreateLogsUploadParams{
+				ClusterID:           strfmt.UUID(uuid.New().String()),
+				NewLogsUploadParams: newUploadParams(string(models.LogsTypeController), ""),
+			}
+			verifyApiError(bm.CreateLogsUpload(ctx, params), http.StatusNotFound)
+		})
+
+		It("fails to start an upload for a missing host", func() {
+			params := installer.CreateLogsUploadParams{
+				ClusterID:           clusterID,
+				NewLogsUploadParams: newUploadParams(hostLogsType, strfmt.UUID(uuid.New().String())),
+			}
+			verifyApiError(bm.CreateLogsUpload(ctx, params), http.StatusNotFound)
+		})
+
+		It("returns an upload", func() {
+			mockLogsUpload.EXPECT().Get(gomock.Any(), clusterID, uploadID).Return(upload, nil).Times(1)
+			reply := bm.GetLogsUpload(ctx, installer.GetLogsUploadParams{ClusterID: clusterID, UploadID: uploadID})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewGetLogsUploadOK()))
+			Expect(reply.(*installer.GetLogsUploadOK).Payload).To(Equal(upload))
+		})
+
+		It("fails to return a missing upload", func() {
+			mockLogsUpload.EXPECT().Get(gomock.Any(), clusterID, uploadID).
+				Return(nil, common.NewApiError(http.StatusNotFound, errors.New("not found"))).Times(1)
+			verifyApiError(bm.GetLogsUpload(ctx, installer.GetLogsUploadParams{ClusterID: clusterID, UploadID: uploadID}), http.StatusNotFound)
+		})
+
+		It("uploads a chunk", func() {
+			params := installer.UploadLogsChunkParams{
+				ClusterID:   clusterID,
+				UploadID:    uploadID,
+				ChunkNumber: 1,
+				Sha256:      checksum,
+				Upfile:      kubeconfigFile,
+				HTTPRequest: request,
+			}
+			mockLogsUpload.EXPECT().Get(gomock.Any(), clusterID, uploadID).Return(upload, nil).Times(1)
+			mockLogsUpload.EXPECT().UploadChunk(gomock.Any(), upload, int64(1), checksum, kubeconfigFile).Return(nil).Times(1)
+			reply := bm.UploadLogsChunk(ctx, params)
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewUploadLogsChunkNoContent()))
+		})
+
+		It("fails to upload a chunk that does not match its checksum", func() {
+			params := installer.UploadLogsChunkParams{
+				ClusterID:   clusterID,
+				UploadID:    uploadID,
+				ChunkNumber: 1,
+				Sha256:      checksum,
+				Upfile:      kubeconfigFile,
+				HTTPRequest: request,
+			}
+			mockLogsUpload.EXPECT().Get(gomock.Any(), clusterID, uploadID).Return(upload, nil).Times(1)
+			mockLogsUpload.EXPECT().UploadChunk(gomock.Any(), upload, int64(1), checksum, kubeconfigFile).
+				Return(common.NewApiError(http.StatusBadRequest, errors.New("checksum mismatch"))).Times(1)
+			verifyApiError(bm.UploadLogsChunk(ctx, params), http.StatusBadRequest)
+		})
+
+		It("completes an upload of host logs", func() {
+			archive := &testLogsArchive{Reader: strings.NewReader("0123456789")}
+			mockLogsUpload.EXPECT().Get(gomock.Any(), clusterID, uploadID).Return(upload, nil).Times(1)
+			mockLogsUpload.EXPECT().Open(gomock.Any(), upload).Return(archive, nil).Times(1)
+			mockLogAnalyzer.EXPECT().Analyze(gomock.Any()).Return(nil, nil).Times(1)
+			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), bm.getLogsFullName(clusterID.String(), hostID.String())).Return(int64(10), nil).Times(1)
+			mockHostApi.EXPECT().SetUploadLogsAt(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockHostApi.EXPECT().UpdateLogsProgress(gomock.Any(), gomock.Any(), string(models.LogsStateCollecting)).Return(nil).Times(1)
+			mockLogsUpload.EXPECT().Delete(gomock.Any(), upload).Return(nil).Times(1)
+			reply := bm.CompleteLogsUpload(ctx, installer.CompleteLogsUploadParams{ClusterID: clusterID, UploadID: uploadID})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewCompleteLogsUploadNoContent()))
+			Expect(archive.published).To(Equal(bm.getLogsFullName(clusterID.String(), hostID.String())))
+		})
+
+		It("completes an upload of controller logs", func() {
+			upload.LogsType = models.LogsTypeController
+			upload.HostID = ""
+			archive := &testLogsArchive{Reader: strings.NewReader("0123456789")}
+			mockLogsUpload.EXPECT().Get(gomock.Any(), clusterID, uploadID).Return(upload, nil).Times(1)
+			mockLogsUpload.EXPECT().Open(gomock.Any(), upload).Return(archive, nil).Times(1)
+			mockLogAnalyzer.EXPECT().Analyze(gomock.Any()).Return(nil, nil).Times(1)
+			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), bm.getLogsFullName(clusterID.String(), string(models.LogsTypeController))).Return(int64(10), nil).Times(1)
+			mockClusterApi.EXPECT().SetUploadControllerLogsAt(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockClusterApi.EXPECT().UpdateLogsProgress(gomock.Any(), gomock.Any(), string(models.LogsStateCollecting)).Return(nil).Times(1)
+			mockLogsUpload.EXPECT().Delete(gomock.Any(), upload).Return(nil).Times(1)
+			reply := bm.CompleteLogsUpload(ctx, installer.CompleteLogsUploadParams{ClusterID: clusterID, UploadID: uploadID})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewCompleteLogsUploadNoContent()))
+			Expect(archive.published).To(Equal(bm.getLogsFullName(clusterID.String(), string(models.LogsTypeController))))
+		})
+
+		It("fails to complete an upload whose chunks were not all received", func() {
+			mockLogsUpload.EXPECT().Get(gomock.Any(), clusterID, uploadID).Return(upload, nil).Times(1)
+			mockLogsUpload.EXPECT().Open(gomock.Any(), upload).
+				Return(nil, common.NewApiError(http.StatusConflict, errors.New("missing chunks"))).Times(1)
+			verifyApiError(bm.CompleteLogsUpload(ctx, installer.CompleteLogsUploadParams{ClusterID: clusterID, UploadID: uploadID}), http.StatusConflict)
+		})
+
+		It("fails to complete an upload that does not match its checksum", func() {
+			archive := &testLogsArchive{Reader: strings.NewReader("0123456789"), verificationErr: errors.New("checksum mismatch")}
+			mockLogsUpload.EXPECT().Get(gomock.Any(), clusterID, uploadID).Return(upload, nil).Times(1)
+			mockLogsUpload.EXPECT().Open(gomock.Any(), upload).Return(archive, nil).Times(1)
+			mockLogAnalyzer.EXPECT().Analyze(gomock.Any()).Return(nil, nil).Times(1)
+			verifyApiError(bm.CompleteLogsUpload(ctx, installer.CompleteLogsUploadParams{ClusterID: clusterID, UploadID: uploadID}), http.StatusBadRequest)
+			Expect(archive.published).To(BeEmpty())
+		})
+
+		It("aborts an upload", func() {
+			mockLogsUpload.EXPECT().Get(gomock.Any(), clusterID, uploadID).Return(upload, nil).Times(1)
+			mockLogsUpload.EXPECT().Delete(gomock.Any(), upload).Return(nil).Times(1)
+			reply := bm.AbortLogsUpload(ctx, installer.AbortLogsUploadParams{ClusterID: clusterID, UploadID: uploadID})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewAbortLogsUploadNoContent()))
+		})
+	})
+
+	Context("logs archive browsing", func() {
+		var archive []byte
+
+		BeforeEach(func() {
+			var buf bytes.Buffer
+			gw := gzip.NewWriter(&buf)
+			tw := tar.NewWriter(gw)
+			content := []byte("line 1\nline 2\nerror 3\nline 4\n")
+			Expect(tw.WriteHeader(&tar.Header{Name: "journal.logs", Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg})).To(Succeed())
+			_, err := tw.Write(content)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tw.Close()).To(Succeed())
+			Expect(gw.Close()).To(Succeed())
+			archive = buf.Bytes()
+
+			host1.LogsCollectedAt = strfmt.DateTime(time.Now())
+			Expect(db.Save(&host1).Error).ToNot(HaveOccurred())
+		})
+
+		mockDownloadArchive := func() {
+			mockS3Client.EXPECT().Download(gomock.Any(), bm.getLogsFullName(clusterID.String(), hostID.String())).
+				Return(ioutil.NopCloser(bytes.NewReader(archive)), int64(len(archive)), nil).Times(1)
+		}
+
+		It("lists the files of a host logs archive", func() {
+			mockDownloadArchive()
+			reply := bm.ListLogsArchiveEntries(ctx, installer.ListLogsArchiveEntriesParams{
+				ClusterID: clusterID,
+				HostID:    &hostID,
+				LogsType:  hostLogsType,
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewListLogsArchiveEntriesOK()))
+			entries := reply.(*installer.ListLogsArchiveEntriesOK).Payload
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Name).To(Equal("journal.logs"))
+			Expect(entries[0].Size).To(Equal(int64(28)))
+			Expect(reply.(*installer.ListLogsArchiveEntriesOK).XLogsArchiveTruncated).To(BeFalse())
+		})
+
+		It("reports a listing truncated by the size limit", func() {
+			bm.Config.LogsArchiveMaxReadBytes = 512
+			mockDownloadArchive()
+			reply := bm.ListLogsArchiveEntries(ctx, installer.ListLogsArchiveEntriesParams{
+				ClusterID: clusterID,
+				HostID:    &hostID,
+				LogsType:  hostLogsType,
+			})
+			recorder := httptest.NewRecorder()
+			reply.WriteResponse(recorder, runtime.JSONProducer())
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("X-Logs-Archive-Truncated")).To(Equal("true"))
+		})
+
+		It("fails to list when the logs were not uploaded", func() {
+			verifyApiError(bm.ListLogsArchiveEntries(ctx, installer.ListLogsArchiveEntriesParams{
+				ClusterID: clusterID,
+				LogsType:  string(models.LogsTypeController),
+			}), http.StatusConflict)
+		})
+
+		It("downloads a line range of a file", func() {
+			mockDownloadArchive()
+			reply := bm.DownloadLogsArchiveEntry(ctx, installer.DownloadLogsArchiveEntryParams{
+				ClusterID: clusterID,
+				HostID:    &hostID,
+				LogsType:  hostLogsType,
+				Name:      "journal.logs",
+				StartLine: swag.Int64(2),
+				EndLine:   swag.Int64(3),
+			})
+			recorder := httptest.NewRecorder()
+			reply.WriteResponse(recorder, runtime.ByteStreamProducer())
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(Equal("line 2\nerror 3\n"))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("text/plain"))
+		})
+
+		It("fails to download a missing file", func() {
+			mockDownloadArchive()
+			verifyApiError(bm.DownloadLogsArchiveEntry(ctx, installer.DownloadLogsArchiveEntryParams{
+				ClusterID: clusterID,
+				HostID:    &hostID,
+				LogsType:  hostLogsType,
+				Name:      "missing.log",
+			}), http.StatusNotFound)
+		})
+
+		It("rejects an inverted line range", func() {
+			verifyApiError(bm.DownloadLogsArchiveEntry(ctx, installer.DownloadLogsArchiveEntryParams{
+				ClusterID: clusterID,
+				HostID:    &hostID,
+				LogsType:  hostLogsType,
+				Name:      "journal.logs",
+				StartLine: swag.Int64(3),
+				EndLine:   swag.Int64(2),
+			}), http.StatusBadRequest)
+		})
+
+		It("searches the files of a host logs archive", func() {
+			mockDownloadArchive()
+			reply := bm.SearchLogsArchive(ctx, installer.SearchLogsArchiveParams{
+				ClusterID:  clusterID,
+				HostID:     &hostID,
+				LogsType:   hostLogsType,
+				Pattern:    "line [12]",
+				MaxResults: swag.Int64(1),
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewSearchLogsArchiveOK()))
+			result := reply.(*installer.SearchLogsArchiveOK).Payload
+			Expect(result.Truncated).To(BeTrue())
+			Expect(result.ArchiveTruncated).To(BeFalse())
+			Expect(result.Matches).To(Equal([]*models.LogsSearchMatch{{Name: "journal.logs", LineNumber: 1, Line: "line 1"}}))
+		})
+
+		It("reports a search truncated by the size limit", func() {
+			bm.Config.LogsArchiveMaxReadBytes = 512
+			mockDownloadArchive()
+			reply := bm.SearchLogsArchive(ctx, installer.SearchLogsArchiveParams{
+				ClusterID:  clusterID,
+				HostID:     &hostID,
+				LogsType:   hostLogsType,
+				Pattern:    "error",
+				MaxResults: swag.Int64(100),
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewSearchLogsArchiveOK()))
+			result := reply.(*installer.SearchLogsArchiveOK).Payload
+			Expect(result.ArchiveTruncated).To(BeTrue())
+			Expect(result.Matches).To(BeEmpty())
+		})
+
+		It("rejects an invalid search pattern", func() {
+			verifyApiError(bm.SearchLogsArchive(ctx, installer.SearchLogsArchiveParams{
+				ClusterID:  clusterID,
+				HostID:     &hostID,
+				LogsType:   hostLogsType,
+				Pattern:    "(",
+				MaxResults: swag.Int64(100),
+			}), http.StatusBadRequest)
+		})
+	})
+
+	Context("logs analysis", func() {
+		var etcdFinding, pullFinding *models.LogFinding
+
+		BeforeEach(func() {
+			etcdFinding = &models.LogFinding{
+				SignatureID: "etcd-quorum-loss",
+				Description: "etcd lost its quorum",
+				Severity:    models.EventSeverityCritical,
+				MatchCount:  3,
+				File:        "journal.log",
+				Excerpt:     "etcdserver: no leader",
+			}
+			pullFinding = &models.LogFinding{
+				SignatureID: "image-pull-failure",
+				Description: "A container image could not be pulled",
+				Severity:    models.EventSeverityError,
+				MatchCount:  1,
+				File:        "pods.log",
+				Excerpt:     "Back-off pulling image: ErrImagePull",
+			}
+		})
+
+		// readStream consumes the uploaded stream the way the object storage does
+		readStream := func(_ context.Context, reader io.Reader, _ string) error {
+			_, err := io.Copy(ioutil.Discard, reader)
+			return err
+		}
+
+		uploadHostLogs := func(hostID strfmt.UUID, findings ...*models.LogFinding) {
+			mockLogAnalyzer.EXPECT().Analyze(gomock.Any()).Return(findings, nil).Times(1)
+			mockS3Client.EXPECT().UploadStream(gomock.Any(), gomock.Any(), bm.getLogsFullName(clusterID.String(), hostID.String())).
+				DoAndReturn(readStream).Times(1)
+			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), bm.getLogsFullName(clusterID.String(), hostID.String())).Return(int64(10), nil).Times(1)
+			mockHostApi.EXPECT().SetUploadLogsAt(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockHostApi.EXPECT().UpdateLogsProgress(gomock.Any(), gomock.Any(), string(models.LogsStateCollecting)).Return(nil).Times(1)
+			reply := bm.UploadHostLogs(ctx, installer.UploadHostLogsParams{
+				ClusterID:   clusterID,
+				HostID:      hostID,
+				Upfile:      kubeconfigFile,
+				HTTPRequest: request,
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewUploadHostLogsNoContent()))
+		}
+
+		getAnalysis := func(params installer.GetClusterLogsAnalysisParams) models.LogFindingList {
+			params.ClusterID = clusterID
+			reply := bm.GetClusterLogsAnalysis(ctx, params)
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewGetClusterLogsAnalysisOK()))
+			return reply.(*installer.GetClusterLogsAnalysisOK).Payload
+		}
+
+		It("stores host findings and adds events", func() {
+			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, &hostID, models.EventSeverityCritical,
+				gomock.Any(), gomock.Any()).Times(1)
+			uploadHostLogs(hostID, etcdFinding)
+
+			findings := getAnalysis(installer.GetClusterLogsAnalysisParams{})
+			Expect(findings).To(HaveLen(1))
+			Expect(findings[0].SignatureID).To(Equal("etcd-quorum-loss"))
+			Expect(findings[0].HostID).To(Equal(hostID))
+			Expect(findings[0].LogsType).To(Equal(models.LogsTypeHost))
+			Expect(findings[0].MatchCount).To(Equal(int64(3)))
+			Expect(findings[0].Excerpt).To(Equal("etcdserver: no leader"))
+		})
+
+		It("replaces the findings of a previous upload", func() {
+			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, &hostID, gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
+			uploadHostLogs(hostID, etcdFinding, pullFinding)
+			Expect(getAnalysis(installer.GetClusterLogsAnalysisParams{})).To(HaveLen(2))
+
+			uploadHostLogs(hostID)
+			Expect(getAnalysis(installer.GetClusterLogsAnalysisParams{})).To(BeEmpty())
+		})
+
+		It("does not fail the upload when the analysis fails", func() {
+			mockLogAnalyzer.EXPECT().Analyze(gomock.Any()).Return(nil, errors.New("not a gzip archive")).Times(1)
+			mockS3Client.EXPECT().UploadStream(gomock.Any(), gomock.Any(), bm.getLogsFullName(clusterID.String(), hostID.String())).
+				DoAndReturn(readStream).Times(1)
+			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), bm.getLogsFullName(clusterID.String(), hostID.String())).Return(int64(10), nil).Times(1)
+			mockHostApi.EXPECT().SetUploadLogsAt(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockHostApi.EXPECT().UpdateLogsProgress(gomock.Any(), gomock.Any(), string(models.LogsStateCollecting)).Return(nil).Times(1)
+			reply := bm.UploadHostLogs(ctx, installer.UploadHostLogsParams{
+				ClusterID:   clusterID,
+				HostID:      hostID,
+				Upfile:      kubeconfigFile,
+				HTTPRequest: request,
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewUploadHostLogsNoContent()))
+			Expect(getAnalysis(installer.GetClusterLogsAnalysisParams{})).To(BeEmpty())
+		})
+
+		It("filters findings by logs type and host", func() {
+			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, &hostID, models.EventSeverityCritical,
+				gomock.Any(), gomock.Any()).Times(1)
+			uploadHostLogs(hostID, etcdFinding)
+
+			mockLogAnalyzer.EXPECT().Analyze(gomock.Any()).Return([]*models.LogFinding{pullFinding}, nil).Times(1)
+			mockS3Client.EXPECT().UploadStream(gomock.Any(), gomock.Any(), bm.getLogsFullName(clusterID.String(), string(models.LogsTypeController))).
+				DoAndReturn(readStream).Times(1)
+			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), bm.getLogsFullName(clusterID.String(), string(models.LogsTypeController))).Return(int64(10), nil).Times(1)
+			mockClusterApi.EXPECT().SetUploadControllerLogsAt(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockClusterApi.EXPECT().UpdateLogsProgress(gomock.Any(), gomock.Any(), string(models.LogsStateCollecting)).Return(nil).Times(1)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityError, gomock.Any(), gomock.Any()).Times(1)
+			reply := bm.UploadLogs(ctx, installer.UploadLogsParams{
+				ClusterID:   clusterID,
+				Upfile:      kubeconfigFile,
+				HTTPRequest: request,
+				LogsType:    string(models.LogsTypeController),
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewUploadLogsNoContent()))
+
+			Expect(getAnalysis(installer.GetClusterLogsAnalysisParams{})).To(HaveLen(2))
+			Expect(getAnalysis(installer.GetClusterLogsAnalysisParams{LogsType: swag.String(string(models.LogsTypeAll))})).To(HaveLen(2))
+
+			controllerFindings := getAnalysis(installer.GetClusterLogsAnalysisParams{LogsType: swag.String(string(models.LogsTypeController))})
+			Expect(controllerFindings).To(HaveLen(1))
+			Expect(controllerFindings[0].SignatureID).To(Equal("image-pull-failure"))
+			Expect(controllerFindings[0].HostID).To(BeEmpty())
+
+			hostFindings := getAnalysis(installer.GetClusterLogsAnalysisParams{HostID: &hostID})
+			Expect(hostFindings).To(HaveLen(1))
+			Expect(hostFindings[0].SignatureID).To(Equal("etcd-quorum-loss"))
+		})
+
+		It("fails for a missing cluster", func() {
+			verifyApiError(bm.GetClusterLogsAnalysis(ctx, installer.GetClusterLogsAnalysisParams{ClusterID: strfmt.UUID(uuid.New().String())}),
+				http.StatusNotFound)
+		})
+	})
 	It("Download controller log where not uploaded yet", func() {
 		logsType := string(models.LogsTypeController)
 		params := installer.DownloadClusterLogsParams{
@@ -4926,7 +6537,7 @@ var _ = Describe("Upload and Download logs test", func() {
 	})
 	It("Logs presigned host not found", func() {
 		hostID := strfmt.UUID(uuid.New().String())
-		mockS3Client.EXPECT().IsAwsS3().Return(true)
+		mockS3Client.EXPECT().SupportsPresignedDownloadURLs().Return(true)
 		generateReply := bm.GetPresignedForClusterFiles(ctx, installer.GetPresignedForClusterFilesParams{
 			ClusterID: clusterID,
 			FileName:  "logs",
@@ -4938,7 +6549,7 @@ var _ = Describe("Upload and Download logs test", func() {
 	It("Logs presigned no logs found", func() {
 		hostID := strfmt.UUID(uuid.New().String())
 		_ = addHost(hostID, models.HostRoleMaster, "known", models.HostKindHost, clusterID, "{}", db)
-		mockS3Client.EXPECT().IsAwsS3().Return(true)
+		mockS3Client.EXPECT().SupportsPresignedDownloadURLs().Return(true)
 		generateReply := bm.GetPresignedForClusterFiles(ctx, installer.GetPresignedForClusterFilesParams{
 			ClusterID: clusterID,
 			FileName:  "logs",
@@ -4950,7 +6561,7 @@ var _ = Describe("Upload and Download logs test", func() {
 	It("Logs presigned s3 error", func() {
 		hostID := strfmt.UUID(uuid.New().String())
 		host1 = addHost(hostID, models.HostRoleMaster, "known", models.HostKindHost, clusterID, "{}", db)
-		mockS3Client.EXPECT().IsAwsS3().Return(true)
+		mockS3Client.EXPECT().SupportsPresignedDownloadURLs().Return(true)
 		fileName := bm.getLogsFullName(clusterID.String(), hostID.String())
 		host1.LogsCollectedAt = strfmt.DateTime(time.Now())
 		db.Save(&host1)
@@ -4967,7 +6578,7 @@ var _ = Describe("Upload and Download logs test", func() {
 	It("host logs presigned happy flow", func() {
 		hostID := strfmt.UUID(uuid.New().String())
 		host1 = addHost(hostID, models.HostRoleMaster, "known", models.HostKindHost, clusterID, "{}", db)
-		mockS3Client.EXPECT().IsAwsS3().Return(true)
+		mockS3Client.EXPECT().SupportsPresignedDownloadURLs().Return(true)
 		fileName := bm.getLogsFullName(clusterID.String(), hostID.String())
 		host1.LogsCollectedAt = strfmt.DateTime(time.Now())
 		db.Save(&host1)
@@ -4985,7 +6596,7 @@ var _ = Describe("Upload and Download logs test", func() {
 	It("host logs presigned happy flow without log type", func() {
 		hostID := strfmt.UUID(uuid.New().String())
 		host1 = addHost(hostID, models.HostRoleMaster, "known", models.HostKindHost, clusterID, "{}", db)
-		mockS3Client.EXPECT().IsAwsS3().Return(true)
+		mockS3Client.EXPECT().SupportsPresignedDownloadURLs().Return(true)
 		fileName := bm.getLogsFullName(clusterID.String(), hostID.String())
 		host1.LogsCollectedAt = strfmt.DateTime(time.Now())
 		db.Save(&host1)
@@ -5039,7 +6650,7 @@ var _ = Describe("Upload and Download logs test", func() {
 	})
 
 	It("Logs presigned cluster logs failed", func() {
-		mockS3Client.EXPECT().IsAwsS3().Return(true)
+		mockS3Client.EXPECT().SupportsPresignedDownloadURLs().Return(true)
 		mockClusterApi.EXPECT().CreateTarredClusterLogs(ctx, gomock.Any(), gomock.Any()).Return("", errors.Errorf("dummy"))
 		generateReply := bm.GetPresignedForClusterFiles(ctx, installer.GetPresignedForClusterFilesParams{
 			ClusterID: clusterID,
@@ -5049,7 +6660,7 @@ var _ = Describe("Upload and Download logs test", func() {
 	})
 
 	It("Logs presigned cluster logs happy flow", func() {
-		mockS3Client.EXPECT().IsAwsS3().Return(true)
+		mockS3Client.EXPECT().SupportsPresignedDownloadURLs().Return(true)
 		mockClusterApi.EXPECT().CreateTarredClusterLogs(ctx, gomock.Any(), gomock.Any()).Return("tarred", nil)
 		mockS3Client.EXPECT().GeneratePresignedDownloadURL(ctx, "tarred", fmt.Sprintf("mycluster_%s.tar", clusterID.String()), gomock.Any()).Return("url", nil)
 		generateReply := bm.GetPresignedForClusterFiles(ctx, installer.GetPresignedForClusterFilesParams{
@@ -5356,15 +6967,45 @@ var _ = Describe("UpdateDiscoveryIgnition", func() {
 		Expect(response).To(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, errors.Errorf("error"))))
 	})
 
-	It("returns bad request when provided an old version", func() {
-		// Wrong version
+	It("translates an old version", func() {
 		override := `{"ignition": {"version": "3.0.0"}, "storage": {"files": [{"path": "/tmp/example", "contents": {"source": "data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj"}}]}}`
 		params := installer.UpdateDiscoveryIgnitionParams{
 			ClusterID:               clusterID,
 			DiscoveryIgnitionParams: &models.DiscoveryIgnitionParams{Config: override},
 		}
+		mockS3Client.EXPECT().DeleteObject(gomock.Any(),
+			fmt.Sprintf("%s.iso", fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, clusterID.String()))).Return(false, nil)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityInfo, "Custom discovery ignition config was applied to the cluster", gomock.Any())
 		response := bm.UpdateDiscoveryIgnition(ctx, params)
-		Expect(response).To(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, errors.Errorf("error"))))
+		Expect(response).To(BeAssignableToTypeOf(&installer.UpdateDiscoveryIgnitionCreated{}))
+
+		var updated common.Cluster
+		err := db.First(&updated, "id = ?", clusterID).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		config, err := ignition.ParseToLatest([]byte(updated.IgnitionConfigOverrides))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(config.Ignition.Version).To(Equal("3.1.0"))
+		Expect(config.Storage.Files).To(HaveLen(1))
+	})
+
+	It("returns bad request when provided an unsupported version", func() {
+		override := `{"ignition": {"version": "3.2.0"}, "storage": {"files": [{"path": "/tmp/example", "contents": {"source": "data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj"}}]}}`
+		params := installer.UpdateDiscoveryIgnitionParams{
+			ClusterID:               clusterID,
+			DiscoveryIgnitionParams: &models.DiscoveryIgnitionParams{Config: override},
+		}
+		response := bm.UpdateDiscoveryIgnition(ctx, params)
+		verifyApiFieldErrors(response, http.StatusBadRequest, "ignition.version")
+	})
+
+	It("returns bad request when overriding generated files", func() {
+		override := `{"ignition": {"version": "3.1.0"}, "storage": {"files": [{"path": "/etc/hosts", "contents": {"source": "data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj"}}]}}`
+		params := installer.UpdateDiscoveryIgnitionParams{
+			ClusterID:               clusterID,
+			DiscoveryIgnitionParams: &models.DiscoveryIgnitionParams{Config: override},
+		}
+		response := bm.UpdateDiscoveryIgnition(ctx, params)
+		verifyApiFieldErrors(response, http.StatusBadRequest, "storage.files.0.path")
 	})
 
 	It("returns an error if we fail to delete the iso", func() {
@@ -5393,6 +7034,68 @@ var _ = Describe("UpdateDiscoveryIgnition", func() {
 		response := bm.UpdateDiscoveryIgnition(ctx, params)
 		Expect(response).To(BeAssignableToTypeOf(&installer.UpdateDiscoveryIgnitionCreated{}))
 	})
+	Context("with a cached image", func() {
+		const contentHash = "8a6f2e5bd6bd0a2f4d3ac5e7a5c1f0e6e3b1a1ad0f64cbbab6e2a3c2d1f0e9b8"
+		var cachedImage = fmt.Sprintf("%s.iso", fmt.Sprintf(s3wrapper.DiscoveryImageCacheTemplate, contentHash))
+		var params installer.UpdateDiscoveryIgnitionParams
+
+		referenceImage := func(clusterID strfmt.UUID) {
+			updates := map[string]interface{}{"image_content_hash": contentHash, "image_expires_at": strfmt.DateTime(time.Now().Add(time.Hour))}
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Updates(updates).Error).ShouldNot(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			referenceImage(clusterID)
+			override := `{"ignition": {"version": "3.1.0"}, "storage": {"files": [{"path": "/tmp/example", "contents": {"source": "data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj"}}]}}`
+			params = installer.UpdateDiscoveryIgnitionParams{
+				ClusterID:               clusterID,
+				DiscoveryIgnitionParams: &models.DiscoveryIgnitionParams{Config: override},
+			}
+			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityInfo, "Custom discovery ignition config was applied to the cluster", gomock.Any())
+		})
+
+		It("deletes the image when no other cluster references it", func() {
+			mockS3Client.EXPECT().DeleteObject(gomock.Any(), s3wrapper.ChecksumObjectName(cachedImage)).Return(true, nil)
+			mockS3Client.EXPECT().DeleteObject(gomock.Any(), cachedImage).Return(true, nil)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityInfo, "Deleted image from backend because its ignition was updated. The image may be regenerated at any time.", gomock.Any())
+			response := bm.UpdateDiscoveryIgnition(ctx, params)
+			Expect(response).To(BeAssignableToTypeOf(&installer.UpdateDiscoveryIgnitionCreated{}))
+
+			var updated common.Cluster
+			Expect(db.First(&updated, "id = ?", clusterID).Error).ShouldNot(HaveOccurred())
+			Expect(updated.ImageContentHash).To(BeEmpty())
+		})
+
+		It("keeps the image while another cluster references it", func() {
+			otherClusterID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &otherClusterID}}).Error).ShouldNot(HaveOccurred())
+			referenceImage(otherClusterID)
+			mockS3Client.EXPECT().DeleteObject(gomock.Any(), gomock.Any()).Times(0)
+			response := bm.UpdateDiscoveryIgnition(ctx, params)
+			Expect(response).To(BeAssignableToTypeOf(&installer.UpdateDiscoveryIgnitionCreated{}))
+		})
+
+		It("deletes the image once the image of the other cluster expired", func() {
+			otherClusterID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &otherClusterID}}).Error).ShouldNot(HaveOccurred())
+			referenceImage(otherClusterID)
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", otherClusterID.String()).
+				Update("image_expires_at", strfmt.DateTime(time.Now().Add(-time.Minute))).Error).ShouldNot(HaveOccurred())
+			references, err := common.CountImageReferences(db, contentHash)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(references).To(Equal(int64(2)))
+
+			released, err := common.ReleaseExpiredImageReferences(db)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(released).To(Equal(int64(1)))
+
+			mockS3Client.EXPECT().DeleteObject(gomock.Any(), s3wrapper.ChecksumObjectName(cachedImage)).Return(true, nil)
+			mockS3Client.EXPECT().DeleteObject(gomock.Any(), cachedImage).Return(true, nil)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityInfo, "Deleted image from backend because its ignition was updated. The image may be regenerated at any time.", gomock.Any())
+			response := bm.UpdateDiscoveryIgnition(ctx, params)
+			Expect(response).To(BeAssignableToTypeOf(&installer.UpdateDiscoveryIgnitionCreated{}))
+		})
+	})
 })
 
 func verifyApiError(responder middleware.Responder, expectedHttpStatus int32) {
@@ -5401,6 +7104,19 @@ func verifyApiError(responder middleware.Responder, expectedHttpStatus int32) {
 	ExpectWithOffset(1, concreteError.StatusCode()).To(Equal(expectedHttpStatus))
 }
 
+func verifyApiFieldErrors(responder middleware.Responder, expectedHttpStatus int32, expectedFields ...string) {
+	verifyApiError(responder, expectedHttpStatus)
+	recorder := httptest.NewRecorder()
+	responder.WriteResponse(recorder, runtime.JSONProducer())
+	var apiErr models.Error
+	ExpectWithOffset(1, json.Unmarshal(recorder.Body.Bytes(), &apiErr)).ShouldNot(HaveOccurred())
+	fields := make([]string, 0, len(apiErr.FieldErrors))
+	for _, fieldErr := range apiErr.FieldErrors {
+		fields = append(fields, fieldErr.Field)
+	}
+	ExpectWithOffset(1, fields).To(ConsistOf(expectedFields))
+}
+
 func verifyApiErrorString(responder middleware.Responder, expectedHttpStatus int32, expectedSubstring string) {
 	ExpectWithOffset(1, responder).To(BeAssignableToTypeOf(common.NewApiError(expectedHttpStatus, nil)))
 	concreteError := responder.(*common.ApiErrorResponse)
@@ -5901,6 +7617,21 @@ var _ = Describe("TestRegisterCluster", func() {
 		Expect(reply).Should(BeAssignableToTypeOf(installer.NewRegisterClusterCreated()))
 	})
 
+	It("Fail when the user reached the quota of active clusters", func() {
+		bm.quotaApi = quota.NewManager(quota.Config{DefaultMaxClusters: 1}, common.GetTestLog(), db)
+		clusterID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, UserName: ocm.AdminUsername}}).Error).ShouldNot(HaveOccurred())
+
+		reply := bm.RegisterCluster(ctx, installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("some-cluster-name"),
+				OpenshiftVersion: swag.String(common.TestDefaultConfig.OpenShiftVersion),
+				PullSecret:       swag.String(`{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"`),
+			},
+		})
+		verifyApiError(reply, http.StatusForbidden)
+	})
+
 	It("UserManagedNetworking default value", func() {
 		mockClusterRegisterSuccess(bm, true)
 
@@ -6057,6 +7788,82 @@ var _ = Describe("TestRegisterCluster", func() {
 		Expect(reply).Should(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, errors.Errorf("error"))))
 	})
 
+	It("dual-stack networks", func() {
+		mockClusterRegisterSuccess(bm, true)
+
+		reply := bm.RegisterCluster(ctx, installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("some-cluster-name"),
+				OpenshiftVersion: swag.String(common.TestDefaultConfig.OpenShiftVersion),
+				PullSecret:       swag.String(`{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"`),
+				ClusterNetworks: []*models.ClusterNetwork{
+					{Cidr: "10.128.0.0/14", HostPrefix: 23},
+					{Cidr: "fd01::/48", HostPrefix: 64},
+				},
+				ServiceNetworks: []*models.ServiceNetwork{{Cidr: "172.30.0.0/16"}, {Cidr: "fd02::/112"}},
+			},
+		})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewRegisterClusterCreated()))
+		actual := reply.(*installer.RegisterClusterCreated)
+		Expect(actual.Payload.ClusterNetworkCidr).To(Equal("10.128.0.0/14"))
+		Expect(actual.Payload.ServiceNetworkCidr).To(Equal("172.30.0.0/16"))
+		Expect(actual.Payload.ClusterNetworks).To(HaveLen(2))
+		Expect(actual.Payload.ServiceNetworks).To(HaveLen(2))
+	})
+
+	It("UserManagedNetworking with routed machine networks", func() {
+		mockClusterRegisterSuccess(bm, true)
+
+		reply := bm.RegisterCluster(ctx, installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:                  swag.String("some-cluster-name"),
+				OpenshiftVersion:      swag.String(common.TestDefaultConfig.OpenShiftVersion),
+				PullSecret:            swag.String(`{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"`),
+				UserManagedNetworking: swag.Bool(true),
+				VipDhcpAllocation:     swag.Bool(false),
+				MachineNetworks:       []*models.MachineNetwork{{Cidr: "10.0.3.0/24"}, {Cidr: "10.0.1.0/24"}, {Cidr: "10.0.2.0/24"}},
+			},
+		})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewRegisterClusterCreated()))
+		actual := reply.(*installer.RegisterClusterCreated)
+		Expect(actual.Payload.MachineNetworkCidr).To(Equal("10.0.3.0/24"))
+		Expect(actual.Payload.MachineNetworks).To(HaveLen(3))
+		for i, cidr := range []string{"10.0.3.0/24", "10.0.1.0/24", "10.0.2.0/24"} {
+			Expect(actual.Payload.MachineNetworks[i].Cidr).To(Equal(cidr))
+			Expect(actual.Payload.MachineNetworks[i].Index).To(Equal(int64(i)))
+		}
+	})
+
+	It("Fail UserManagedNetworking with overlapping machine networks", func() {
+		reply := bm.RegisterCluster(ctx, installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:                  swag.String("some-cluster-name"),
+				OpenshiftVersion:      swag.String(common.TestDefaultConfig.OpenShiftVersion),
+				PullSecret:            swag.String(`{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"`),
+				UserManagedNetworking: swag.Bool(true),
+				VipDhcpAllocation:     swag.Bool(false),
+				MachineNetworks:       []*models.MachineNetwork{{Cidr: "10.0.0.0/16"}, {Cidr: "10.0.2.0/24"}},
+			},
+		})
+		verifyApiErrorString(reply, http.StatusBadRequest, "Machine networks: CIDRS 10.0.2.0/24 and 10.0.0.0/16 overlap")
+	})
+
+	It("Fail dual-stack cluster networks without dual-stack service networks", func() {
+		reply := bm.RegisterCluster(ctx, installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("some-cluster-name"),
+				OpenshiftVersion: swag.String(common.TestDefaultConfig.OpenShiftVersion),
+				PullSecret:       swag.String(`{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"`),
+				ClusterNetworks: []*models.ClusterNetwork{
+					{Cidr: "10.128.0.0/14", HostPrefix: 23},
+					{Cidr: "fd01::/48", HostPrefix: 64},
+				},
+			},
+		})
+		verifyApiErrorString(reply, http.StatusBadRequest,
+			"Dual-stack clusters require an IPv4 and an IPv6 network for both the cluster and the service networks")
+	})
+
 	It("openshift release image and version successfully defined", func() {
 		mockClusterRegisterSuccess(bm, true)
 		reply := bm.RegisterCluster(ctx, installer.RegisterClusterParams{
@@ -6577,6 +8384,69 @@ var _ = Describe("GetHostIgnition and DownloadHostIgnition", func() {
 	})
 })
 
+var _ = Describe("GetHostIgnitionPreview", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		dbName    string
+		clusterID strfmt.UUID
+		hostID    strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		status := models.ClusterStatusReady
+		c := common.Cluster{Cluster: models.Cluster{ID: &clusterID, Status: &status}}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+
+		hostID = strfmt.UUID(uuid.New().String())
+		addHost(hostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, clusterID, "{}", db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("returns not found for a host in a different cluster", func() {
+		resp := bm.GetHostIgnitionPreview(ctx, installer.GetHostIgnitionPreviewParams{
+			ClusterID: clusterID,
+			HostID:    strfmt.UUID(uuid.New().String()),
+		})
+		verifyApiError(resp, http.StatusNotFound)
+	})
+
+	It("returns conflict once the installation started", func() {
+		db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Update("status", models.ClusterStatusInstalling)
+		resp := bm.GetHostIgnitionPreview(ctx, installer.GetHostIgnitionPreviewParams{ClusterID: clusterID, HostID: hostID})
+		verifyApiError(resp, http.StatusConflict)
+	})
+
+	It("returns server error when the preview fails", func() {
+		mockIgnitionBuilder.EXPECT().PreviewHostIgnition(gomock.Any(), gomock.Any(), bm.IgnitionConfig, bm.authHandler.AuthType()).
+			Return(nil, errors.New("invalid overrides")).Times(1)
+		resp := bm.GetHostIgnitionPreview(ctx, installer.GetHostIgnitionPreviewParams{ClusterID: clusterID, HostID: hostID})
+		verifyApiError(resp, http.StatusInternalServerError)
+	})
+
+	It("returns the preview of the host", func() {
+		preview := &models.IgnitionPreview{Role: models.HostRoleMaster, NodeIgnition: "{}"}
+		mockIgnitionBuilder.EXPECT().PreviewHostIgnition(gomock.Any(), gomock.Any(), bm.IgnitionConfig, bm.authHandler.AuthType()).
+			DoAndReturn(func(cluster *common.Cluster, host *models.Host, _ ignition.IgnitionConfig, _ auth.AuthType) (*models.IgnitionPreview, error) {
+				Expect(*cluster.ID).To(Equal(clusterID))
+				Expect(*host.ID).To(Equal(hostID))
+				return preview, nil
+			}).Times(1)
+		resp := bm.GetHostIgnitionPreview(ctx, installer.GetHostIgnitionPreviewParams{ClusterID: clusterID, HostID: hostID})
+		Expect(resp).Should(Equal(installer.NewGetHostIgnitionPreviewOK().WithPayload(preview)))
+	})
+})
+
 var _ = Describe("UpdateHostIgnition", func() {
 	var (
 		bm        *bareMetalInventory
@@ -6670,16 +8540,35 @@ var _ = Describe("UpdateHostIgnition", func() {
 		verifyApiError(response, http.StatusBadRequest)
 	})
 
-	It("returns bad request when provided an old version", func() {
-		// Wrong version
-		override := `{"ignition": {"version": "3.0.0"}, "storage": {"files": [{"path": "/tmp/example", "contents": {"source": "data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj"}}]}}`
+	It("translates an old version", func() {
+		override := `{"ignition": {"version": "2.2.0"}, "storage": {"files": [{"filesystem": "root", "path": "/tmp/example", "contents": {"source": "data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj"}}]}}`
+		params := installer.UpdateHostIgnitionParams{
+			ClusterID:          clusterID,
+			HostID:             hostID,
+			HostIgnitionParams: &models.HostIgnitionParams{Config: override},
+		}
+		mockEvents.EXPECT().AddEvent(gomock.Any(), params.ClusterID, &params.HostID, models.EventSeverityInfo, fmt.Sprintf("Host %s: custom discovery ignition config was applied", params.HostID.String()), gomock.Any())
+		response := bm.UpdateHostIgnition(ctx, params)
+		Expect(response).To(BeAssignableToTypeOf(&installer.UpdateHostIgnitionCreated{}))
+
+		var updated models.Host
+		err := db.First(&updated, "id = ?", hostID).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		config, err := ignition.ParseToLatest([]byte(updated.IgnitionConfigOverrides))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(config.Ignition.Version).To(Equal("3.1.0"))
+		Expect(config.Storage.Files).To(HaveLen(1))
+	})
+
+	It("returns bad request when overriding generated files", func() {
+		override := `{"ignition": {"version": "3.1.0"}, "storage": {"files": [{"path": "/etc/hostname", "contents": {"source": "data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj"}}]}}`
 		params := installer.UpdateHostIgnitionParams{
 			ClusterID:          clusterID,
 			HostID:             hostID,
 			HostIgnitionParams: &models.HostIgnitionParams{Config: override},
 		}
 		response := bm.UpdateHostIgnition(ctx, params)
-		verifyApiError(response, http.StatusBadRequest)
+		verifyApiFieldErrors(response, http.StatusBadRequest, "storage.files.0.path")
 	})
 })
 
@@ -6929,11 +8818,16 @@ func createInventory(db *gorm.DB, cfg Config) *bareMetalInventory {
 	mockInstallConfigBuilder = installcfg.NewMockInstallConfigBuilder(ctrl)
 	mockHwValidator = hardware.NewMockValidator(ctrl)
 	mockStaticNetworkConfig = staticnetworkconfig.NewMockStaticNetworkConfig(ctrl)
+	mockLogAnalyzer = loganalysis.NewMockAnalyzer(ctrl)
+	mockLogsUpload = logsupload.NewMockAPI(ctrl)
+	mockClusterArchive = clusterarchive.NewMockAPI(ctrl)
 	dnsApi := dns.NewDNSHandler(cfg.BaseDNSDomains, common.GetTestLog())
+	quotaApi := quota.NewManager(quota.Config{}, common.GetTestLog(), db)
 	return NewBareMetalInventory(db, common.GetTestLog(), mockHostApi, mockClusterApi, cfg,
 		mockGenerator, mockEvents, mockS3Client, mockMetric, mockUsage, mockOperatorManager,
 		getTestAuthHandler(), mockK8sClient, ocmClient, nil, mockSecretValidator, mockVersions,
-		mockIsoEditorFactory, mockCRDUtils, mockIgnitionBuilder, mockHwValidator, dnsApi, mockInstallConfigBuilder, mockStaticNetworkConfig)
+		mockIsoEditorFactory, mockCRDUtils, mockIgnitionBuilder, mockHwValidator, dnsApi, mockInstallConfigBuilder, mockStaticNetworkConfig,
+		mockLogAnalyzer, mockLogsUpload, quotaApi, mockClusterArchive)
 }
 
 var _ = Describe("IPv6 support disabled", func() {
@@ -7109,3 +9003,168 @@ var _ = Describe("AddOpenshiftVersion", func() {
 		Expect(err).Should(HaveOccurred())
 	})
 })
+
+var _ = Describe("Quotas", func() {
+	var (
+		bm     *bareMetalInventory
+		cfg    Config
+		db     *gorm.DB
+		dbName string
+		ctx    = context.Background()
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		clusterID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, OrgID: "org1", UserName: "user1"}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	userCtx := func() context.Context {
+		payload := &ocm.AuthPayload{Role: ocm.UserRole, Username: "user1", Organization: "org1"}
+		return context.WithValue(ctx, restapi.AuthKey, payload)
+	}
+
+	It("lists the quotas of the owners of clusters", func() {
+		reply := bm.ListQuotas(ctx, installer.ListQuotasParams{Scope: swag.String(models.QuotaScopeOrg)})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewListQuotasOK()))
+		quotas := reply.(*installer.ListQuotasOK).Payload
+		Expect(quotas).To(HaveLen(1))
+		Expect(*quotas[0].Owner).To(Equal("org1"))
+		Expect(quotas[0].Usage.Clusters).To(Equal(int64(1)))
+	})
+
+	It("updates the limits of an organization", func() {
+		reply := bm.UpdateQuotaLimits(ctx, installer.UpdateQuotaLimitsParams{
+			Scope:  models.QuotaScopeOrg,
+			Owner:  "org1",
+			Limits: &models.QuotaLimits{MaxClusters: swag.Int64(5)},
+		})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewUpdateQuotaLimitsOK()))
+
+		reply = bm.GetQuota(ctx, installer.GetQuotaParams{Scope: models.QuotaScopeOrg, Owner: "org1"})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewGetQuotaOK()))
+		Expect(*reply.(*installer.GetQuotaOK).Payload.Limits.MaxClusters).To(Equal(int64(5)))
+	})
+
+	It("rejects users that are not admins", func() {
+		forbidden := common.NewInfraError(http.StatusForbidden, errors.Errorf(""))
+		Expect(bm.ListQuotas(userCtx(), installer.ListQuotasParams{})).Should(BeAssignableToTypeOf(forbidden))
+		Expect(bm.GetQuota(userCtx(), installer.GetQuotaParams{Scope: models.QuotaScopeUser, Owner: "user1"})).Should(BeAssignableToTypeOf(forbidden))
+		Expect(bm.UpdateQuotaLimits(userCtx(), installer.UpdateQuotaLimitsParams{
+			Scope:  models.QuotaScopeUser,
+			Owner:  "user1",
+			Limits: &models.QuotaLimits{},
+		})).Should(BeAssignableToTypeOf(forbidden))
+	})
+})
+
+var _ = Describe("Archived clusters", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		dbName    string
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		archived  *models.ArchivedCluster
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		clusterID = strfmt.UUID(uuid.New().String())
+		archivedAt := strfmt.DateTime(time.Now())
+		archived = &models.ArchivedCluster{
+			ArchivedAt: &archivedAt,
+			Cluster:    &models.Cluster{ID: &clusterID, UserName: "user1"},
+		}
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	userCtx := func(userName string) context.Context {
+		payload := &ocm.AuthPayload{Role: ocm.UserRole, Username: userName, Organization: "org1"}
+		return context.WithValue(ctx, restapi.AuthKey, payload)
+	}
+
+	It("gets an archived cluster of the user", func() {
+		mockClusterArchive.EXPECT().Get(gomock.Any(), clusterID).Return(archived, nil).Times(1)
+		reply := bm.GetArchivedCluster(userCtx("user1"), installer.GetArchivedClusterParams{ClusterID: clusterID})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewGetArchivedClusterOK()))
+		Expect(reply.(*installer.GetArchivedClusterOK).Payload).To(Equal(archived))
+	})
+
+	It("hides the archived clusters of other users", func() {
+		mockClusterArchive.EXPECT().Get(gomock.Any(), clusterID).Return(archived, nil).Times(1)
+		reply := bm.GetArchivedCluster(userCtx("user2"), installer.GetArchivedClusterParams{ClusterID: clusterID})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+
+	It("fails to get a cluster that is not archived", func() {
+		mockClusterArchive.EXPECT().Get(gomock.Any(), clusterID).
+			Return(nil, common.NewApiError(http.StatusNotFound, errors.New("not found"))).Times(1)
+		reply := bm.GetArchivedCluster(ctx, installer.GetArchivedClusterParams{ClusterID: clusterID})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+
+	It("restores an archived cluster", func() {
+		restored := &common.Cluster{Cluster: *archived.Cluster}
+		mockClusterArchive.EXPECT().Restore(gomock.Any(), clusterID).Return(restored, nil).Times(1)
+		reply := bm.RestoreArchivedCluster(ctx, installer.RestoreArchivedClusterParams{ClusterID: clusterID})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewRestoreArchivedClusterCreated()))
+		Expect(*reply.(*installer.RestoreArchivedClusterCreated).Payload.ID).To(Equal(clusterID))
+	})
+
+	It("fails to restore a cluster that already exists", func() {
+		mockClusterArchive.EXPECT().Restore(gomock.Any(), clusterID).
+			Return(nil, common.NewApiError(http.StatusConflict, errors.New("exists"))).Times(1)
+		reply := bm.RestoreArchivedCluster(ctx, installer.RestoreArchivedClusterParams{ClusterID: clusterID})
+		verifyApiError(reply, http.StatusConflict)
+	})
+
+	It("rejects restores by users that are not admins", func() {
+		reply := bm.RestoreArchivedCluster(userCtx("user1"), installer.RestoreArchivedClusterParams{ClusterID: clusterID})
+		Expect(reply).Should(BeAssignableToTypeOf(common.NewInfraError(http.StatusForbidden, errors.Errorf(""))))
+	})
+})
+
+// seekableNopCloser adds a no-op Close method to a reader that supports random access
+type seekableNopCloser struct {
+	io.ReadSeeker
+}
+
+func (seekableNopCloser) Close() error {
+	return nil
+}
+
+// testLogsArchive is the archive of a logs upload with the given content
+type testLogsArchive struct {
+	io.Reader
+	verificationErr error
+	published       string
+}
+
+func (a *testLogsArchive) Close() error {
+	return nil
+}
+
+func (a *testLogsArchive) VerificationError() error {
+	return a.verificationErr
+}
+
+func (a *testLogsArchive) Publish(objectName string) error {
+	if a.verificationErr != nil {
+		return a.verificationErr
+	}
+	a.published = objectName
+	return nil
+}
@@ -215,22 +215,28 @@ func (m *Manager) DeregisterCluster(ctx context.Context, c *common.Cluster) erro
 		return metricsErr
 	}
 
-	// Delete discovery image for deregistered cluster
-	discoveryImage := fmt.Sprintf("%s.iso", fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, c.ID.String()))
-	exists, err := m.objectHandler.DoesObjectExist(ctx, discoveryImage)
-	if err != nil {
-		m.log.WithError(err).Errorf("Failed to find cluster discovery image %s", discoveryImage)
-		return err
-	}
-	if exists {
-		_, err = m.objectHandler.DeleteObject(ctx, discoveryImage)
+	// Delete discovery image and network boot artifacts for deregistered cluster
+	imagePrefix := fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, c.ID.String())
+	for _, discoveryImage := range []string{
+		fmt.Sprintf("%s.iso", imagePrefix),
+		s3wrapper.PXEArtifactObjectName(imagePrefix, s3wrapper.PXEInitrd),
+		s3wrapper.PXEArtifactObjectName(imagePrefix, s3wrapper.PXEIPXEScript),
+	} {
+		exists, err := m.objectHandler.DoesObjectExist(ctx, discoveryImage)
 		if err != nil {
-			m.log.WithError(err).Errorf("Failed to delete cluster discovery image %s", discoveryImage)
+			m.log.WithError(err).Errorf("Failed to find cluster discovery image %s", discoveryImage)
 			return err
 		}
+		if exists {
+			_, err = m.objectHandler.DeleteObject(ctx, discoveryImage)
+			if err != nil {
+				m.log.WithError(err).Errorf("Failed to delete cluster discovery image %s", discoveryImage)
+				return err
+			}
+		}
 	}
 
-	err = m.registrationAPI.DeregisterCluster(ctx, c)
+	err := m.registrationAPI.DeregisterCluster(ctx, c)
 	if err != nil {
 		m.eventsHandler.AddEvent(ctx, *c.ID, nil, models.EventSeverityError,
 			fmt.Sprintf("Failed to deregister cluster. Error: %s", err.Error()), time.Now())
@@ -402,17 +408,27 @@ func (m *Manager) GetMasterNodesIds(ctx context.Context, c *common.Cluster, db *
 
 func (m *Manager) tryAssignMachineCidrDHCPMode(cluster *common.Cluster) error {
 	networks := network.GetClusterNetworks(cluster.Hosts, m.log)
+	if network.IsDualStack(cluster) {
+		// VIPs are allocated in the IPv4 machine network of dual-stack clusters
+		networks = funk.FilterString(networks, network.IsIPV4CIDR)
+	}
 	if len(networks) == 1 {
 		/*
 		 * Auto assign machine network CIDR is relevant if there is only single host network.  Otherwise the user
 		 * has to select the machine network CIDR
 		 */
-		return m.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Update(&common.Cluster{
+		if networks[0] == cluster.MachineNetworkCidr {
+			return m.setMachineNetworks(cluster, networks[0])
+		}
+		if err := m.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Update(&common.Cluster{
 			Cluster: models.Cluster{
 				MachineNetworkCidr: networks[0],
 			},
 			MachineNetworkCidrUpdatedAt: time.Now(),
-		}).Error
+		}).Error; err != nil {
+			return err
+		}
+		return m.setMachineNetworks(cluster, networks[0])
 	}
 	return nil
 }
@@ -424,13 +440,54 @@ func (m *Manager) tryAssignMachineCidrNonDHCPMode(cluster *common.Cluster) error
 	if err != nil {
 		return err
 	} else if machineCidr == cluster.MachineNetworkCidr {
+		if machineCidr != "" && network.IsDualStack(cluster) {
+			return m.setMachineNetworks(cluster, machineCidr)
+		}
 		return nil
 	}
 
-	return m.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Update(
+	if err = m.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Update(
 		"machine_network_cidr", machineCidr,
 		"machine_network_cidr_updated_at", time.Now(),
-	).Error
+	).Error; err != nil {
+		return err
+	}
+	return m.setMachineNetworks(cluster, machineCidr)
+}
+
+// setMachineNetworks stores the machine networks of the cluster according to the primary machine network.  Dual-stack
+// clusters get a secondary machine network of the other IP address family, calculated from the host inventories.
+// The networks are written only when they differ from the stored ones.
+func (m *Manager) setMachineNetworks(cluster *common.Cluster, primaryCidr string) error {
+	var machineNetworks []*models.MachineNetwork
+	if primaryCidr != "" {
+		machineNetworks = append(machineNetworks, &models.MachineNetwork{Cidr: primaryCidr})
+		if network.IsDualStack(cluster) {
+			secondaryCidr, err := network.CalculateSecondaryMachineNetworkCIDR(primaryCidr, cluster.Hosts)
+			if err != nil {
+				m.log.WithError(err).Debugf("Secondary machine network of cluster %s is not available yet", cluster.ID.String())
+			} else {
+				machineNetworks = append(machineNetworks, &models.MachineNetwork{Cidr: secondaryCidr})
+			}
+		}
+	}
+	if machineNetworksEqual(cluster.MachineNetworks, machineNetworks) {
+		return nil
+	}
+	cluster.MachineNetworks = machineNetworks
+	return common.SetNetworks(m.db, &cluster.Cluster, common.MachineNetworksTable)
+}
+
+func machineNetworksEqual(a, b []*models.MachineNetwork) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Cidr != b[i].Cidr {
+			return false
+		}
+	}
+	return true
 }
 
 func (m *Manager) autoAssignMachineNetworkCidr(c *common.Cluster) error {
@@ -486,8 +543,11 @@ func (m *Manager) initMonitorQueryGenerator() {
 			models.ClusterStatusInstalled,
 		}
 
-		dbWithCondition := m.db.Preload("Hosts", "status <> ?", models.HostStatusDisabled).Preload(common.MonitoredOperatorsTable).
-			Where("status NOT IN (?)", noNeedToMonitorInStates)
+		dbWithCondition := m.db.Preload("Hosts", "status <> ?", models.HostStatusDisabled).Preload(common.MonitoredOperatorsTable)
+		for _, tableName := range common.NetworkTables {
+			dbWithCondition = common.LoadTableFromDB(dbWithCondition, tableName)
+		}
+		dbWithCondition = dbWithCondition.Where("status NOT IN (?)", noNeedToMonitorInStates)
 		m.monitorQueryGenerator = common.NewMonitorQueryGenerator(m.db, dbWithCondition, m.MonitorBatchSize)
 	}
 }
@@ -875,6 +935,22 @@ func (m *Manager) setConnectivityMajorityGroupsForClusterInternal(cluster *commo
 		}
 		majorityGroups[cidr] = majorityGroup
 	}
+	if swag.BoolValue(cluster.UserManagedNetworking) && len(cluster.MachineNetworks) > 0 {
+		// The hosts may sit on different routed networks, so their connectivity is checked per IP address family over all the machine networks
+		machineCidrs := network.GetMachineNetworkCidrs(cluster)
+		for _, isIPv4 := range []bool{true, false} {
+			cidrs := network.GetCidrsOfFamily(machineCidrs, isIPv4)
+			if len(cidrs) == 0 {
+				continue
+			}
+			majorityGroup, err := network.CreateL3MajorityGroup(cidrs, hosts)
+			if err != nil {
+				m.log.WithError(err).Warnf("Create L3 majority group for %s", strings.Join(cidrs, ","))
+				continue
+			}
+			majorityGroups[network.L3MajorityGroupKey(isIPv4)] = majorityGroup
+		}
+	}
 	b, err := json.Marshal(&majorityGroups)
 	if err != nil {
 		return common.NewApiError(http.StatusInternalServerError, err)
@@ -900,7 +976,7 @@ func (m *Manager) SetConnectivityMajorityGroupsForCluster(clusterID strfmt.UUID,
 	}
 	// We want to calculate majority groups only when in pre-install states since it is needed for pre-install validations
 	var cluster common.Cluster
-	if err := db.Preload("Hosts", "status <> ?", models.HostStatusDisabled).Take(&cluster, "id = ?", clusterID.String()).Error; err != nil {
+	if err := common.LoadTableFromDB(db.Preload("Hosts", "status <> ?", models.HostStatusDisabled), common.MachineNetworksTable).Take(&cluster, "id = ?", clusterID.String()).Error; err != nil {
 		var statusCode int32 = http.StatusInternalServerError
 		if gorm.IsRecordNotFoundError(err) {
 			statusCode = http.StatusNotFound
@@ -933,6 +1009,11 @@ func (m *Manager) deleteClusterFiles(ctx context.Context, c *common.Cluster, obj
 		if err != nil {
 			m.log.WithError(err).Errorf("failed deleting s3 file: %s", file)
 			failedToDelete = append(failedToDelete, file)
+			continue
+		}
+		// The deleted file is no longer charged to the owners of the cluster
+		if err = m.db.Delete(&common.QuotaObject{}, "object_name = ?", file).Error; err != nil {
+			log.WithError(err).Warnf("Failed to release the quota of s3 file: %s", file)
 		}
 	}
 
@@ -1022,6 +1103,13 @@ func (m Manager) PermanentClustersDeletion(ctx context.Context, olderThan strfmt
 		if err := common.DeleteRecordsByClusterID(db, *c.ID, models.MonitoredOperator{}); err != nil {
 			m.log.WithError(err).Warnf("Failed deleting operators from db for cluster %s", c.ID.String())
 		}
+
+		for _, table := range []interface{}{models.ClusterNetwork{}, models.ServiceNetwork{}, models.MachineNetwork{}, models.StaticIPAllocation{}, common.DeclaredHost{}, models.LogFinding{},
+			models.LogsUpload{}, common.LogsUploadChunk{}, common.QuotaObject{}} {
+			if err := common.DeleteRecordsByClusterID(db, *c.ID, table); err != nil {
+				m.log.WithError(err).Warnf("Failed deleting %s from db for cluster %s", db.NewScope(table).TableName(), c.ID.String())
+			}
+		}
 	}
 	return nil
 }
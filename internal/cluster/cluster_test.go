@@ -1106,6 +1106,44 @@ var _ = Describe("Auto assign machine CIDR", func() {
 			ctrl.Finish()
 		})
 	}
+	It("does not rewrite an unchanged machine network", func() {
+		c = common.Cluster{Cluster: models.Cluster{
+			ID:                       &id,
+			Status:                   swag.String(models.ClusterStatusPendingForInput),
+			BaseDNSDomain:            "test.com",
+			PullSecretSet:            true,
+			ClusterNetworkCidr:       "1.2.4.0/24",
+			ServiceNetworkCidr:       "1.2.5.0/24",
+			ClusterNetworkHostPrefix: 24,
+			VipDhcpAllocation:        swag.Bool(true),
+			UserManagedNetworking:    swag.Bool(false),
+		}}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+		hostId := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&models.Host{
+			ID:        &hostId,
+			ClusterID: id,
+			Status:    swag.String(models.HostStatusInsufficient),
+			Inventory: common.GenerateTestInventoryWithNetwork(defaultIPv4Address),
+		}).Error).ShouldNot(HaveOccurred())
+		mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockHostAPI.EXPECT().IsValidMasterCandidate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockHostAPI.EXPECT().IsRequireUserActionReset(gomock.Any()).AnyTimes()
+
+		clusterApi.ClusterMonitoring()
+		cluster, err := common.GetClusterFromDB(db, id, common.UseEagerLoading)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.MachineNetworkCidr).To(Equal("1.2.3.0/24"))
+		Expect(cluster.MachineNetworks).To(HaveLen(1))
+		updatedAt := cluster.MachineNetworkCidrUpdatedAt
+
+		clusterApi.ClusterMonitoring()
+		cluster, err = common.GetClusterFromDB(db, id, common.UseEagerLoading)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.MachineNetworkCidrUpdatedAt).To(Equal(updatedAt))
+		Expect(cluster.MachineNetworks).To(HaveLen(1))
+		Expect(cluster.MachineNetworks[0].Cidr).To(Equal("1.2.3.0/24"))
+	})
 	AfterEach(func() {
 		common.DeleteTestDB(db, dbName)
 	})
@@ -2396,7 +2434,7 @@ var _ = Describe("Deregister inactive clusters", func() {
 	})
 
 	It("Deregister inactive cluster", func() {
-		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(3)
 		Expect(state.DeregisterInactiveCluster(ctx, 10, strfmt.DateTime(time.Now()))).ShouldNot(HaveOccurred())
 		Expect(wasDeregisterd(db, *c.ID)).To(BeTrue())
 	})
@@ -2409,7 +2447,7 @@ var _ = Describe("Deregister inactive clusters", func() {
 	})
 
 	It("Deregister inactive cluster with new clusters", func() {
-		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(4)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(12)
 		inactiveCluster1 := registerCluster()
 		inactiveCluster2 := registerCluster()
 		inactiveCluster3 := registerCluster()
@@ -2432,7 +2470,7 @@ var _ = Describe("Deregister inactive clusters", func() {
 	})
 
 	It("Deregister inactive cluster limited", func() {
-		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(3)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(9)
 		inactiveCluster1 := registerCluster()
 		inactiveCluster2 := registerCluster()
 		inactiveCluster3 := registerCluster()
@@ -2731,7 +2769,7 @@ var _ = Describe("Validation metrics and events", func() {
 	})
 
 	It("Test DeregisterCluster before discovery image was generated", func() {
-		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(3)
 		mockS3Client.EXPECT().DeleteObject(gomock.Any(), gomock.Any()).Times(0)
 		mockHost.EXPECT().ReportValidationFailedMetrics(ctx, gomock.Any(), openshiftVersion, emailDomain)
 		mockMetric.EXPECT().ClusterValidationFailed(openshiftVersion, emailDomain, models.ClusterValidationIDSufficientMastersCount)
@@ -2742,7 +2780,8 @@ var _ = Describe("Validation metrics and events", func() {
 	})
 
 	It("Test DeregisterCluster after discovery image was generated", func() {
-		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(true, nil).Times(1)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), fmt.Sprintf("%s.iso", fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, c.ID.String()))).Return(true, nil).Times(1)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(2)
 		mockS3Client.EXPECT().DeleteObject(gomock.Any(), gomock.Any()).Return(true, nil).Times(1)
 		mockHost.EXPECT().ReportValidationFailedMetrics(ctx, gomock.Any(), openshiftVersion, emailDomain)
 		mockMetric.EXPECT().ClusterValidationFailed(openshiftVersion, emailDomain, models.ClusterValidationIDSufficientMastersCount)
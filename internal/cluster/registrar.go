@@ -69,6 +69,7 @@ func (r *registrar) registerCluster(ctx context.Context, cluster *common.Cluster
 		tx = common.LoadTableFromDB(tx, tableName)
 	}
 
+	common.IndexNetworks(&cluster.Cluster)
 	if err := tx.Create(cluster).Error; err != nil {
 		r.log.Errorf("Error registering cluster %s", cluster.Name)
 		return err
@@ -85,6 +86,7 @@ func (r *registrar) registerCluster(ctx context.Context, cluster *common.Cluster
 func (r *registrar) RegisterAddHostsOCPCluster(c *common.Cluster, db *gorm.DB) error {
 	c.Status = swag.String(models.ClusterStatusAddingHosts)
 	c.StatusInfo = swag.String(StatusInfoReady)
+	common.IndexNetworks(&c.Cluster)
 	err := db.Create(c).Error
 	if err != nil {
 		r.log.WithError(err).Errorf("Failed to create OCP cluster in DB")
@@ -2,8 +2,10 @@ package validations
 
 import (
 	"bytes"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"net"
 	"net/http"
@@ -15,6 +17,7 @@ import (
 	"github.com/containers/image/v5/docker/reference"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
 	"github.com/openshift/assisted-service/pkg/ocm"
 	"github.com/pkg/errors"
@@ -35,8 +38,22 @@ const (
 	dockerHubLegacyAuth = "https://index.docker.io/v1/"
 	stageRegistry       = "registry.stage.redhat.io"
 	ignoreListSeparator = ","
+	kernelArgumentRegex = `^[^\s'"\\;#$\x60{}]+$`
 )
 
+// Kernel arguments that the discovery image relies on to boot, which cannot be changed through kernel argument operations
+var kernelArgumentsDenylist = []string{
+	"coreos.live.rootfs_url",
+	"coreos.liveiso",
+	"ignition.config.url",
+	"ignition.firstboot",
+	"ignition.platform.id",
+	"init",
+	"initrd",
+	"rd.break",
+	"root",
+}
+
 var regexpSshPublicKey *regexp.Regexp
 
 func init() {
@@ -299,6 +316,25 @@ func ValidateNoProxyFormat(noProxy string, ocpVersion string) error {
 	return nil
 }
 
+// ValidateAdditionalTrustBundle validates that the additional trust bundle is made of PEM-encoded X.509 certificates only
+func ValidateAdditionalTrustBundle(trustBundle string) error {
+	rest := []byte(trustBundle)
+	for len(bytes.TrimSpace(rest)) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return errors.Errorf("Additional trust bundle is not valid: it must contain PEM-encoded certificates only")
+		}
+		if block.Type != "CERTIFICATE" {
+			return errors.Errorf("Additional trust bundle is not valid: unexpected PEM block of type %s", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return errors.Wrapf(err, "Additional trust bundle is not valid: failed to parse certificate")
+		}
+	}
+	return nil
+}
+
 func ValidateSSHPublicKey(sshPublicKeys string) error {
 	if regexpSshPublicKey == nil {
 		return fmt.Errorf("Can't parse SSH keys.")
@@ -320,6 +356,33 @@ func ValidateSSHPublicKey(sshPublicKeys string) error {
 	return nil
 }
 
+// ValidateKernelArguments verifies that every kernel argument operation has a valid operation and a single kernel
+// argument as its value, which is not one the discovery image relies on
+func ValidateKernelArguments(kernelArguments models.KernelArguments) error {
+	re := regexp.MustCompile(kernelArgumentRegex)
+	for _, kernelArgument := range kernelArguments {
+		if kernelArgument == nil {
+			return errors.New("Kernel argument operation must not be empty")
+		}
+		switch kernelArgument.Operation {
+		case models.KernelArgumentOperationAppend, models.KernelArgumentOperationReplace, models.KernelArgumentOperationDelete:
+		default:
+			return errors.Errorf("Kernel argument operation %q is not valid, it must be one of append, replace or delete", kernelArgument.Operation)
+		}
+		if !re.MatchString(kernelArgument.Value) {
+			return errors.Errorf("Kernel argument %q is not valid, it must be a single argument without whitespace, quotes or boot loader special characters",
+				kernelArgument.Value)
+		}
+		key := strings.SplitN(kernelArgument.Value, "=", 2)[0]
+		for _, denied := range kernelArgumentsDenylist {
+			if key == denied {
+				return errors.Errorf("Kernel argument %s is required by the discovery image and cannot be changed", key)
+			}
+		}
+	}
+	return nil
+}
+
 // ParseRegistry extracts the registry from a full image name, or returns
 // the default if the name does not start with a registry.
 func ParseRegistry(image string) (string, error) {
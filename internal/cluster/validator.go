@@ -200,7 +200,7 @@ func (v *clusterValidator) isApiVipValid(c *clusterPreprocessContext) Validation
 	if c.cluster.APIVip == "" {
 		return ValidationPending
 	}
-	err := network.VerifyVip(c.cluster.Hosts, c.cluster.MachineNetworkCidr, c.cluster.APIVip, ApiVipName,
+	err := network.VerifyVip(c.cluster.Hosts, network.GetClusterVipMachineNetworkCidr(c.cluster, c.cluster.APIVip), c.cluster.APIVip, ApiVipName,
 		true, v.log)
 	return boolValue(err == nil)
 }
@@ -261,7 +261,7 @@ func (v *clusterValidator) isIngressVipValid(c *clusterPreprocessContext) Valida
 	if c.cluster.IngressVip == "" {
 		return ValidationPending
 	}
-	err := network.VerifyVip(c.cluster.Hosts, c.cluster.MachineNetworkCidr, c.cluster.IngressVip, IngressVipName,
+	err := network.VerifyVip(c.cluster.Hosts, network.GetClusterVipMachineNetworkCidr(c.cluster, c.cluster.IngressVip), c.cluster.IngressVip, IngressVipName,
 		true, v.log)
 	return boolValue(err == nil)
 }
@@ -419,6 +419,13 @@ func (v *clusterValidator) noCidrsOverlapping(c *clusterPreprocessContext) Valid
 			return ValidationPending
 		}
 	}
+	if network.IsDualStack(c.cluster) {
+		if !swag.BoolValue(c.cluster.UserManagedNetworking) && len(c.cluster.MachineNetworks) < 2 {
+			return ValidationPending
+		}
+		return boolValue(network.VerifyDualStackCIDRsNotOverlap(network.GetMachineNetworkCidrs(c.cluster), network.GetClusterNetworkCidrs(c.cluster),
+			network.GetServiceNetworkCidrs(c.cluster), swag.BoolValue(c.cluster.UserManagedNetworking)) == nil)
+	}
 	return boolValue(network.VerifyClusterCIDRsNotOverlap(c.cluster.MachineNetworkCidr, c.cluster.ClusterNetworkCidr, c.cluster.ServiceNetworkCidr, swag.BoolValue(c.cluster.UserManagedNetworking)) == nil)
 }
 
@@ -427,7 +434,8 @@ func (v *clusterValidator) printNoCidrsOverlapping(c *clusterPreprocessContext,
 	case ValidationSuccess:
 		return "No CIDRS are overlapping."
 	case ValidationFailure:
-		if err := network.VerifyClusterCIDRsNotOverlap(c.cluster.MachineNetworkCidr, c.cluster.ClusterNetworkCidr, c.cluster.ServiceNetworkCidr, swag.BoolValue(c.cluster.UserManagedNetworking)); err != nil {
+		if err := network.VerifyDualStackCIDRsNotOverlap(network.GetMachineNetworkCidrs(c.cluster), network.GetClusterNetworkCidrs(c.cluster),
+			network.GetServiceNetworkCidrs(c.cluster), swag.BoolValue(c.cluster.UserManagedNetworking)); err != nil {
 			return fmt.Sprintf("CIDRS Overlapping: %s.", err.Error())
 		}
 		return ""
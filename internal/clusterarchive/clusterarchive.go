@@ -0,0 +1,416 @@
+package clusterarchive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/pkg/transaction"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// formatVersion is the version of the layout of archives, which is bumped whenever archives cannot be restored by
+// older versions of the service. Archives of version 1 hold the pull secret of the cluster, and have no secrets object.
+const formatVersion = 2
+
+// secretsFileName names the object in the directory of an archived cluster that holds its secrets, which the storage
+// encrypts with the data keys of the cluster like its other sensitive files
+const secretsFileName = "archive-secrets.json"
+
+// ObjectPrefix is the prefix of the names of the archives in the storage
+const ObjectPrefix = "archived-clusters/"
+
+// ObjectName returns the name of the archive of a cluster in the storage
+func ObjectName(clusterID strfmt.UUID) string {
+	return fmt.Sprintf("%s%s.json.gz", ObjectPrefix, clusterID)
+}
+
+func secretsObjectName(clusterID strfmt.UUID) string {
+	return fmt.Sprintf("%s/%s", clusterID, secretsFileName)
+}
+
+//go:generate mockgen -source=clusterarchive.go -package=clusterarchive -destination=mock_clusterarchive.go
+type API interface {
+	// ArchiveInstalledClusters archives up to max clusters whose installation completed, and that were last updated,
+	// before the given time
+	ArchiveInstalledClusters(ctx context.Context, max int, installedBefore strfmt.DateTime) error
+	// Archive stores a cluster with all its records in the storage, and then deletes them from the database. The other
+	// objects of the cluster are kept in the storage.
+	Archive(ctx context.Context, clusterID strfmt.UUID) error
+	// Get returns an archived cluster with its hosts, monitored operators and events
+	Get(ctx context.Context, clusterID strfmt.UUID) (*models.ArchivedCluster, error)
+	// Restore inserts an archived cluster with all its records back into the database, and then deletes the archive
+	Restore(ctx context.Context, clusterID strfmt.UUID) (*common.Cluster, error)
+}
+
+// archive is the content of an archived cluster. Unlike the API model, it keeps the columns that are not exposed by the
+// API, such that the cluster is restored as it was. The secrets of the cluster are left out, and are stored apart.
+type archive struct {
+	Version             int                          `json:"version"`
+	ArchivedAt          strfmt.DateTime              `json:"archived_at"`
+	Cluster             *common.Cluster              `json:"cluster"`
+	Hosts               []*common.Host               `json:"hosts"`
+	Events              []*common.Event              `json:"events"`
+	StaticIPAllocations []*models.StaticIPAllocation `json:"static_ip_allocations,omitempty"`
+	DeclaredHosts       []*common.DeclaredHost       `json:"declared_hosts,omitempty"`
+	LogFindings         []*models.LogFinding         `json:"log_findings,omitempty"`
+	LogsUploads         []*models.LogsUpload         `json:"logs_uploads,omitempty"`
+	LogsUploadChunks    []*common.LogsUploadChunk    `json:"logs_upload_chunks,omitempty"`
+	QuotaObjects        []*common.QuotaObject        `json:"quota_objects,omitempty"`
+	DataKeysLocks       []*common.DataKeysLock       `json:"data_keys_locks,omitempty"`
+}
+
+// secrets are the secrets of an archived cluster, which are kept out of the archive since admins can read archives
+type secrets struct {
+	PullSecret string `json:"pull_secret"`
+	// The binding tokens of the declared hosts by their IDs
+	BindingTokens map[strfmt.UUID]string `json:"binding_tokens,omitempty"`
+}
+
+type Manager struct {
+	log           logrus.FieldLogger
+	db            *gorm.DB
+	objectHandler s3wrapper.API
+}
+
+func NewManager(log logrus.FieldLogger, db *gorm.DB, objectHandler s3wrapper.API) *Manager {
+	return &Manager{
+		log:           log,
+		db:            db,
+		objectHandler: objectHandler,
+	}
+}
+
+func (m *Manager) ArchiveInstalledClusters(ctx context.Context, max int, installedBefore strfmt.DateTime) error {
+	log := logutil.FromContext(ctx, m.log)
+	var clusters []*common.Cluster
+	err := m.db.Select("id").Limit(max).
+		Where("status = ? and install_completed_at < ? and updated_at < ?",
+			models.ClusterStatusInstalled, installedBefore, installedBefore).
+		Find(&clusters).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to list installed clusters")
+	}
+	for _, c := range clusters {
+		if err = m.Archive(ctx, *c.ID); err != nil {
+			log.WithError(err).Errorf("failed to archive cluster %s", c.ID)
+			continue
+		}
+		log.Infof("Archived cluster %s that was installed before %s", c.ID, installedBefore)
+	}
+	return nil
+}
+
+// Archive keeps the objects of the cluster in the storage: its directory with its ignitions, credentials, install
+// config, manifests, logs and logs upload chunks, its discovery images and its data keys. They are used again by the
+// restored cluster, and the sensitive objects in the directory of the cluster, including its secrets, stay encrypted
+// with its data keys when the storage is encrypted. Stored objects are no longer charged to the owners of the cluster while it is archived,
+// and discovery images keep expiring as usual.
+// A cluster that changes while it is being archived is not deleted, since the archive would miss the change.
+func (m *Manager) Archive(ctx context.Context, clusterID strfmt.UUID) error {
+	log := logutil.FromContext(ctx, m.log)
+	cluster, err := common.GetClusterFromDB(m.db, clusterID, common.UseEagerLoading)
+	if err != nil {
+		return err
+	}
+	// The hosts are loaded on their own to keep the columns that the API model does not have
+	cluster.Hosts = nil
+	a := &archive{
+		Version:    formatVersion,
+		ArchivedAt: strfmt.DateTime(time.Now()),
+		Cluster:    cluster,
+	}
+	db := m.db.Unscoped()
+	if err = db.Find(&a.Hosts, "cluster_id = ?", clusterID.String()).Error; err != nil {
+		return errors.Wrapf(err, "failed to get hosts of cluster %s", clusterID)
+	}
+	if err = db.Order("event_time").Find(&a.Events, "cluster_id = ?", clusterID.String()).Error; err != nil {
+		return errors.Wrapf(err, "failed to get events of cluster %s", clusterID)
+	}
+	for _, records := range []interface{}{&a.StaticIPAllocations, &a.DeclaredHosts, &a.LogFindings, &a.LogsUploads,
+		&a.LogsUploadChunks, &a.QuotaObjects, &a.DataKeysLocks} {
+		if err = db.Find(records, "cluster_id = ?", clusterID.String()).Error; err != nil {
+			return errors.Wrapf(err, "failed to get records of cluster %s", clusterID)
+		}
+	}
+
+	s := secrets{PullSecret: cluster.PullSecret, BindingTokens: make(map[strfmt.UUID]string)}
+	for _, h := range a.DeclaredHosts {
+		s.BindingTokens[h.ID] = h.BindingToken
+	}
+	// The archived cluster is a copy, such that the secrets are left out of the archive only
+	archivedCluster := *cluster
+	archivedCluster.PullSecret = ""
+	a.Cluster = &archivedCluster
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return errors.Wrapf(err, "failed to serialize the secrets of cluster %s", clusterID)
+	}
+	if err = m.objectHandler.Upload(ctx, data, secretsObjectName(clusterID)); err != nil {
+		return errors.Wrapf(err, "failed to upload the secrets of cluster %s", clusterID)
+	}
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if err = json.NewEncoder(gz).Encode(a); err != nil {
+		return errors.Wrapf(err, "failed to serialize cluster %s", clusterID)
+	}
+	if err = gz.Close(); err != nil {
+		return errors.Wrapf(err, "failed to compress cluster %s", clusterID)
+	}
+	if err = m.objectHandler.Upload(ctx, buf.Bytes(), ObjectName(clusterID)); err != nil {
+		return errors.Wrapf(err, "failed to upload the archive of cluster %s", clusterID)
+	}
+
+	err = m.transaction(func(tx *gorm.DB) error {
+		tx = tx.Unscoped()
+		var current common.Cluster
+		if err := transaction.AddForUpdateQueryOption(tx).Select("status, updated_at").
+			Take(&current, "id = ?", clusterID.String()).Error; err != nil {
+			return errors.Wrapf(err, "failed to lock cluster %s", clusterID)
+		}
+		if swag.StringValue(current.Status) != swag.StringValue(cluster.Status) ||
+			!time.Time(current.UpdatedAt).Equal(time.Time(cluster.UpdatedAt)) {
+			return common.NewApiError(http.StatusConflict, errors.Errorf("Cluster %s changed while it was being archived", clusterID))
+		}
+		for _, table := range []interface{}{&models.Host{}, &common.Event{}, &models.MonitoredOperator{},
+			&models.ClusterNetwork{}, &models.ServiceNetwork{}, &models.MachineNetwork{}, &models.StaticIPAllocation{},
+			&common.DeclaredHost{}, &models.LogFinding{}, &models.LogsUpload{}, &common.LogsUploadChunk{},
+			&common.QuotaObject{}, &common.DataKeysLock{}} {
+			if err := common.DeleteRecordsByClusterID(tx, clusterID, table); err != nil {
+				return errors.Wrapf(err, "failed to delete archived records of cluster %s", clusterID)
+			}
+		}
+		return errors.Wrapf(tx.Delete(&common.Cluster{}, "id = ?", clusterID.String()).Error,
+			"failed to delete archived cluster %s", clusterID)
+	})
+	if err != nil {
+		// The cluster stays in the database, so its archive must not be found
+		for _, objectName := range []string{ObjectName(clusterID), secretsObjectName(clusterID)} {
+			if _, deleteErr := m.objectHandler.DeleteObject(ctx, objectName); deleteErr != nil {
+				log.WithError(deleteErr).Warnf("Failed to delete %s of cluster %s that was not archived", objectName, clusterID)
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func (m *Manager) transaction(fn func(tx *gorm.DB) error) error {
+	tx := m.db.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit().Error
+}
+
+func (m *Manager) read(ctx context.Context, clusterID strfmt.UUID) (*archive, error) {
+	reader, _, err := m.objectHandler.Download(ctx, ObjectName(clusterID))
+	if err != nil {
+		if _, ok := err.(common.NotFound); ok {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("Archived cluster %s was not found", clusterID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError,
+			errors.Wrapf(err, "failed to download the archive of cluster %s", clusterID))
+	}
+	defer reader.Close()
+	gz, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError,
+			errors.Wrapf(err, "failed to decompress the archive of cluster %s", clusterID))
+	}
+	data, err := ioutil.ReadAll(gz)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError,
+			errors.Wrapf(err, "failed to read the archive of cluster %s", clusterID))
+	}
+	var a archive
+	if err = json.Unmarshal(data, &a); err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError,
+			errors.Wrapf(err, "failed to parse the archive of cluster %s", clusterID))
+	}
+	if a.Version == 0 || a.Version > formatVersion || a.Cluster == nil {
+		return nil, common.NewApiError(http.StatusInternalServerError,
+			errors.Errorf("archive of cluster %s has unsupported version %d", clusterID, a.Version))
+	}
+	return &a, nil
+}
+
+func (m *Manager) readSecrets(ctx context.Context, clusterID strfmt.UUID) (*secrets, error) {
+	reader, _, err := m.objectHandler.Download(ctx, secretsObjectName(clusterID))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download the secrets of cluster %s", clusterID)
+	}
+	defer reader.Close()
+	var s secrets
+	if err = json.NewDecoder(reader).Decode(&s); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the secrets of cluster %s", clusterID)
+	}
+	return &s, nil
+}
+
+func (m *Manager) Get(ctx context.Context, clusterID strfmt.UUID) (*models.ArchivedCluster, error) {
+	a, err := m.read(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	cluster := a.Cluster.Cluster
+	cluster.Hosts = make([]*models.Host, 0, len(a.Hosts))
+	for _, h := range a.Hosts {
+		cluster.Hosts = append(cluster.Hosts, &h.Host)
+	}
+	events := make([]*models.Event, 0, len(a.Events))
+	for _, event := range a.Events {
+		events = append(events, &event.Event)
+	}
+	return &models.ArchivedCluster{ArchivedAt: &a.ArchivedAt, Cluster: &cluster, Events: events}, nil
+}
+
+func (m *Manager) Restore(ctx context.Context, clusterID strfmt.UUID) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, m.log)
+	a, err := m.read(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	var count int
+	if err = m.db.Unscoped().Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Count(&count).Error; err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to look up cluster %s", clusterID))
+	}
+	if count > 0 {
+		return nil, common.NewApiError(http.StatusConflict, errors.Errorf("Cluster %s already exists", clusterID))
+	}
+
+	// Archives of version 1 hold the pull secret of the cluster
+	s := &secrets{PullSecret: a.Cluster.PullSecret}
+	if a.Version > 1 {
+		if s, err = m.readSecrets(ctx, clusterID); err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
+	quotaObjects, err := m.storedQuotaObjects(ctx, a.QuotaObjects)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError,
+			errors.Wrapf(err, "failed to look up the stored objects of cluster %s", clusterID))
+	}
+
+	err = m.transaction(func(tx *gorm.DB) error {
+		cluster := *a.Cluster
+		cluster.PullSecret = s.PullSecret
+		// A restored cluster is kept in the database for as long as an installed cluster before it is archived again
+		cluster.UpdatedAt = strfmt.DateTime(time.Now())
+		// The records of the cluster are created one by one rather than as associations of the cluster
+		cluster.Hosts = nil
+		cluster.MonitoredOperators = nil
+		cluster.ClusterNetworks = nil
+		cluster.ServiceNetworks = nil
+		cluster.MachineNetworks = nil
+		records := []interface{}{&cluster}
+		for _, h := range a.Hosts {
+			records = append(records, h)
+		}
+		for _, operator := range a.Cluster.MonitoredOperators {
+			records = append(records, operator)
+		}
+		for _, network := range a.Cluster.ClusterNetworks {
+			records = append(records, network)
+		}
+		for _, network := range a.Cluster.ServiceNetworks {
+			records = append(records, network)
+		}
+		for _, network := range a.Cluster.MachineNetworks {
+			records = append(records, network)
+		}
+		for _, event := range a.Events {
+			records = append(records, event)
+		}
+		for _, allocation := range a.StaticIPAllocations {
+			records = append(records, allocation)
+		}
+		for _, h := range a.DeclaredHosts {
+			h.BindingToken = s.BindingTokens[h.ID]
+			records = append(records, h)
+		}
+		for _, finding := range a.LogFindings {
+			records = append(records, finding)
+		}
+		for _, upload := range a.LogsUploads {
+			records = append(records, upload)
+		}
+		for _, chunk := range a.LogsUploadChunks {
+			records = append(records, chunk)
+		}
+		for _, object := range quotaObjects {
+			records = append(records, object)
+		}
+		for _, record := range records {
+			if err := tx.Create(record).Error; err != nil {
+				return errors.Wrapf(err, "failed to restore cluster %s", clusterID)
+			}
+		}
+		// The storage creates the lock of the data keys again whenever it changes them while the cluster is archived
+		for _, lock := range a.DataKeysLocks {
+			if err := tx.Exec("INSERT INTO data_keys_locks (cluster_id) VALUES (?) ON CONFLICT DO NOTHING", lock.ClusterID).Error; err != nil {
+				return errors.Wrapf(err, "failed to restore cluster %s", clusterID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	// An archive that is left behind is overwritten when the restored cluster is archived again
+	for _, objectName := range []string{ObjectName(clusterID), secretsObjectName(clusterID)} {
+		if _, err = m.objectHandler.DeleteObject(ctx, objectName); err != nil {
+			log.WithError(err).Warnf("Failed to delete %s of restored cluster %s", objectName, clusterID)
+		}
+	}
+	log.Infof("Restored archived cluster %s", clusterID)
+
+	cluster, err := common.GetClusterFromDB(m.db, clusterID, common.UseEagerLoading)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return cluster, nil
+}
+
+// storedQuotaObjects returns the archived quota objects whose objects are still stored, such that discovery images that
+// expired while the cluster was archived, and objects that were being stored when it was archived, are not charged
+func (m *Manager) storedQuotaObjects(ctx context.Context, objects []*common.QuotaObject) ([]*common.QuotaObject, error) {
+	var stored []*common.QuotaObject
+	for _, object := range objects {
+		if object.ObjectName == "" {
+			continue
+		}
+		exists, err := m.objectHandler.DoesObjectExist(ctx, object.ObjectName)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			stored = append(stored, object)
+		}
+	}
+	return stored, nil
+}
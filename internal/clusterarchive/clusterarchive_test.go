@@ -0,0 +1,297 @@
+package clusterarchive
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestClusterArchive(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "Cluster archive Suite")
+}
+
+var _ = Describe("Cluster archive", func() {
+	var (
+		ctx       = context.Background()
+		db        *gorm.DB
+		dbName    string
+		baseDir   string
+		storage   s3wrapper.API
+		manager   *Manager
+		clusterID strfmt.UUID
+		hostID    strfmt.UUID
+		logsName  string
+	)
+
+	const pullSecret = `{"auths":{"cloud.openshift.com":{"auth":"dG9rZW46dGVzdAo="}}}`
+
+	createCluster := func(status string, installCompletedAt time.Time) strfmt.UUID {
+		id := strfmt.UUID(uuid.New().String())
+		hID := strfmt.UUID(uuid.New().String())
+		cluster := &common.Cluster{
+			Cluster: models.Cluster{
+				ID:                 &id,
+				Name:               "mycluster",
+				Status:             swag.String(status),
+				UserName:           "user1",
+				InstallCompletedAt: strfmt.DateTime(installCompletedAt),
+				UpdatedAt:          strfmt.DateTime(installCompletedAt),
+				Hosts: []*models.Host{{
+					ID:        &hID,
+					ClusterID: id,
+					Inventory: `{"hostname":"master-0"}`,
+				}},
+				MonitoredOperators: []*models.MonitoredOperator{{ClusterID: id, Name: "console", Status: models.OperatorStatusAvailable}},
+				ClusterNetworks:    []*models.ClusterNetwork{{ClusterID: id, Cidr: "10.128.0.0/14", HostPrefix: 23}},
+				MachineNetworks:    []*models.MachineNetwork{{ClusterID: id, Cidr: "192.168.127.0/24"}},
+			},
+			PullSecret: pullSecret,
+		}
+		Expect(db.Create(cluster).Error).ToNot(HaveOccurred())
+		eventTime := strfmt.DateTime(time.Now())
+		event := &common.Event{Event: models.Event{ClusterID: &id, Message: swag.String("Registered cluster"), EventTime: &eventTime}}
+		Expect(db.Create(event).Error).ToNot(HaveOccurred())
+
+		uploadID := strfmt.UUID(uuid.New().String())
+		logsName = id.String() + "/logs/" + hID.String() + "/logs.tar.gz"
+		Expect(storage.Upload(ctx, []byte("host logs"), logsName)).To(Succeed())
+		for _, record := range []interface{}{
+			&models.StaticIPAllocation{ClusterID: id, MacAddress: "52:54:00:00:00:01", IP: "192.168.127.10"},
+			&common.DeclaredHost{DeclaredHost: models.DeclaredHost{ID: strfmt.UUID(uuid.New().String()), ClusterID: id,
+				Hostname: "worker-0"}, BindingToken: "binding-token"},
+			&models.LogFinding{ID: strfmt.UUID(uuid.New().String()), ClusterID: id, HostID: hID, SignatureID: "oom"},
+			&models.LogsUpload{ID: uploadID, ClusterID: id, HostID: hID, TotalSize: 10, ChunkSize: 10},
+			&common.LogsUploadChunk{UploadID: uploadID, Number: 0, ClusterID: id, Size: 10},
+			&common.QuotaObject{ClusterID: id, Name: logsName, Kind: "logs", ObjectName: logsName, SizeBytes: 9},
+			&common.QuotaObject{ClusterID: id, Name: "discovery-image", Kind: "image",
+				ObjectName: "discovery-image-" + id.String() + ".iso", SizeBytes: 100},
+			&common.DataKeysLock{ClusterID: id},
+		} {
+			Expect(db.Create(record).Error).ToNot(HaveOccurred())
+		}
+		hostID = hID
+		return id
+	}
+
+	archivedRecords := []interface{}{&models.Host{}, &common.Event{}, &models.MonitoredOperator{}, &models.ClusterNetwork{},
+		&models.MachineNetwork{}, &models.StaticIPAllocation{}, &common.DeclaredHost{}, &models.LogFinding{},
+		&models.LogsUpload{}, &common.LogsUploadChunk{}, &common.QuotaObject{}, &common.DataKeysLock{}}
+
+	readStored := func(objectName string) string {
+		content, err := ioutil.ReadFile(filepath.Join(baseDir, objectName))
+		Expect(err).ToNot(HaveOccurred())
+		return string(content)
+	}
+
+	countRows := func(model interface{}, id strfmt.UUID) int {
+		var count int
+		Expect(db.Unscoped().Model(model).Where("cluster_id = ?", id.String()).Count(&count).Error).ToNot(HaveOccurred())
+		return count
+	}
+
+	clusterExists := func(id strfmt.UUID) bool {
+		var count int
+		Expect(db.Unscoped().Model(&common.Cluster{}).Where("id = ?", id.String()).Count(&count).Error).ToNot(HaveOccurred())
+		return count > 0
+	}
+
+	BeforeEach(func() {
+		var err error
+		db, dbName = common.PrepareTestDB()
+		baseDir, err = ioutil.TempDir("", "cluster-archive")
+		Expect(err).ToNot(HaveOccurred())
+		storage = s3wrapper.NewFSClient(baseDir, common.GetTestLog(), nil, nil,
+			metrics.NewMetricsManager(prometheus.NewRegistry(), nil), 100, "")
+		manager = NewManager(common.GetTestLog(), db, storage)
+		clusterID = createCluster(models.ClusterStatusInstalled, time.Now().Add(-time.Hour))
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		Expect(os.RemoveAll(baseDir)).To(Succeed())
+	})
+
+	It("moves a cluster from the database to the storage", func() {
+		Expect(manager.Archive(ctx, clusterID)).To(Succeed())
+
+		Expect(clusterExists(clusterID)).To(BeFalse())
+		for _, model := range archivedRecords {
+			Expect(countRows(model, clusterID)).To(BeZero())
+		}
+		for _, objectName := range []string{ObjectName(clusterID), secretsObjectName(clusterID), logsName} {
+			exists, err := storage.DoesObjectExist(ctx, objectName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+		}
+	})
+
+	It("does not archive a cluster that changes while it is being archived", func() {
+		updating := &updatingStorage{API: storage, update: func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).
+				Update("status", models.ClusterStatusError).Error).ToNot(HaveOccurred())
+		}}
+		manager = NewManager(common.GetTestLog(), db, updating)
+
+		err := manager.Archive(ctx, clusterID)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusConflict)))
+
+		Expect(clusterExists(clusterID)).To(BeTrue())
+		for _, model := range archivedRecords {
+			Expect(countRows(model, clusterID)).ToNot(BeZero())
+		}
+		for _, objectName := range []string{ObjectName(clusterID), secretsObjectName(clusterID)} {
+			exists, err := storage.DoesObjectExist(ctx, objectName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		}
+	})
+
+	It("keeps the secrets of the cluster out of the archive", func() {
+		Expect(manager.Archive(ctx, clusterID)).To(Succeed())
+
+		reader, _, err := storage.Download(ctx, ObjectName(clusterID))
+		Expect(err).ToNot(HaveOccurred())
+		defer reader.Close()
+		gz, err := gzip.NewReader(reader)
+		Expect(err).ToNot(HaveOccurred())
+		content, err := ioutil.ReadAll(gz)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring("worker-0"))
+		Expect(string(content)).ToNot(ContainSubstring("dG9rZW46dGVzdAo="))
+		Expect(string(content)).ToNot(ContainSubstring("binding-token"))
+		Expect(readStored(secretsObjectName(clusterID))).To(ContainSubstring("binding-token"))
+	})
+
+	It("encrypts the secrets of the cluster with its data keys", func() {
+		key := make([]byte, 32)
+		_, err := rand.Read(key)
+		Expect(err).ToNot(HaveOccurred())
+		kms, err := s3wrapper.NewStaticKMS(map[string]string{"key1": base64.StdEncoding.EncodeToString(key)}, "")
+		Expect(err).ToNot(HaveOccurred())
+		manager = NewManager(common.GetTestLog(), db, s3wrapper.NewEncryptedClient(storage, db, kms, time.Hour, common.GetTestLog()))
+		Expect(manager.Archive(ctx, clusterID)).To(Succeed())
+		Expect(readStored(secretsObjectName(clusterID))).ToNot(ContainSubstring("binding-token"))
+
+		cluster, err := manager.Restore(ctx, clusterID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.PullSecret).To(Equal(pullSecret))
+	})
+
+	It("gets an archived cluster", func() {
+		Expect(manager.Archive(ctx, clusterID)).To(Succeed())
+
+		archived, err := manager.Get(ctx, clusterID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(archived.ArchivedAt).ToNot(BeNil())
+		Expect(*archived.Cluster.ID).To(Equal(clusterID))
+		Expect(archived.Cluster.UserName).To(Equal("user1"))
+		Expect(archived.Cluster.Hosts).To(HaveLen(1))
+		Expect(*archived.Cluster.Hosts[0].ID).To(Equal(hostID))
+		Expect(archived.Cluster.MonitoredOperators).To(HaveLen(1))
+		Expect(archived.Events).To(HaveLen(1))
+	})
+
+	It("restores an archived cluster", func() {
+		Expect(manager.Archive(ctx, clusterID)).To(Succeed())
+
+		cluster, err := manager.Restore(ctx, clusterID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.Name).To(Equal("mycluster"))
+		Expect(cluster.PullSecret).To(Equal(pullSecret))
+		Expect(cluster.Hosts).To(HaveLen(1))
+		Expect(cluster.MonitoredOperators).To(HaveLen(1))
+		Expect(cluster.ClusterNetworks).To(HaveLen(1))
+		Expect(cluster.MachineNetworks).To(HaveLen(1))
+		for _, model := range archivedRecords {
+			if _, ok := model.(*common.QuotaObject); ok {
+				continue
+			}
+			Expect(countRows(model, clusterID)).To(Equal(1))
+		}
+		var declaredHost common.DeclaredHost
+		Expect(db.Take(&declaredHost, "cluster_id = ?", clusterID.String()).Error).ToNot(HaveOccurred())
+		Expect(declaredHost.BindingToken).To(Equal("binding-token"))
+		// The discovery image is not stored, so it is no longer charged
+		var quotaObjects []*common.QuotaObject
+		Expect(db.Find(&quotaObjects, "cluster_id = ?", clusterID.String()).Error).ToNot(HaveOccurred())
+		Expect(quotaObjects).To(HaveLen(1))
+		Expect(quotaObjects[0].ObjectName).To(Equal(logsName))
+
+		Expect(manager.ArchiveInstalledClusters(ctx, 10, strfmt.DateTime(time.Now().Add(-time.Minute)))).To(Succeed())
+		Expect(clusterExists(clusterID)).To(BeTrue())
+
+		for _, objectName := range []string{ObjectName(clusterID), secretsObjectName(clusterID)} {
+			exists, err := storage.DoesObjectExist(ctx, objectName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		}
+
+		Expect(manager.Archive(ctx, clusterID)).To(Succeed())
+		Expect(clusterExists(clusterID)).To(BeFalse())
+	})
+
+	It("does not restore a cluster that exists", func() {
+		Expect(manager.Archive(ctx, clusterID)).To(Succeed())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ToNot(HaveOccurred())
+
+		_, err := manager.Restore(ctx, clusterID)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusConflict)))
+	})
+
+	It("fails to get a cluster that is not archived", func() {
+		_, err := manager.Get(ctx, clusterID)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+
+		_, err = manager.Restore(ctx, clusterID)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+	})
+
+	It("archives only clusters that were installed before the given time", func() {
+		recentID := createCluster(models.ClusterStatusInstalled, time.Now())
+		errorID := createCluster(models.ClusterStatusError, time.Now().Add(-time.Hour))
+
+		Expect(manager.ArchiveInstalledClusters(ctx, 10, strfmt.DateTime(time.Now().Add(-time.Minute)))).To(Succeed())
+
+		Expect(clusterExists(clusterID)).To(BeFalse())
+		Expect(clusterExists(recentID)).To(BeTrue())
+		Expect(clusterExists(errorID)).To(BeTrue())
+	})
+})
+
+// updatingStorage updates the database whenever an object is uploaded, like a request that changes a cluster while it is
+// being archived
+type updatingStorage struct {
+	s3wrapper.API
+	update func()
+}
+
+func (s *updatingStorage) Upload(ctx context.Context, data []byte, objectName string) error {
+	s.update()
+	return s.API.Upload(ctx, data, objectName)
+}
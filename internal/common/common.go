@@ -17,6 +17,8 @@ const (
 	IllegalWorkerHostsCount                = 1
 
 	HostCACertPath = "/etc/assisted-service/service-ca-cert.crt"
+	// The additional trust bundle of a cluster is added to the system trust store of discovered hosts
+	AdditionalTrustBundlePath = "/etc/pki/ca-trust/source/anchors/assisted-additional-trust-bundle.crt"
 
 	consoleUrlPrefix = "https://console-openshift-console.apps"
 
@@ -9,6 +9,7 @@ import (
 	"github.com/jinzhu/gorm"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
 )
 
 const (
@@ -51,6 +52,10 @@ type Cluster struct {
 	// and the generation failed, the value of ImageGenerated will be set to 'false'. In that case, providing the
 	// same request with the same custom parameters will re-attempt to generate the image.
 	ImageGenerated bool `json:"image_generated"`
+
+	// The hash of the content of the discovery image, which names the cached image object the cluster references.
+	// Images generated before images were cached, and network boot artifacts, are stored per cluster and have no hash.
+	ImageContentHash string `json:"image_content_hash"`
 }
 
 type Event struct {
@@ -58,8 +63,61 @@ type Event struct {
 	models.Event
 }
 
+// DeclaredHost is a host declared before it boots its own discovery image
+type DeclaredHost struct {
+	models.DeclaredHost
+	// The secret carried by the current discovery image of the declared host, by which the host booting the image is
+	// bound to the declared host
+	BindingToken string `json:"-" gorm:"index"`
+}
+
+// LogsUploadChunk is a chunk of a logs upload that was received and stored
+type LogsUploadChunk struct {
+	UploadID strfmt.UUID `gorm:"primary_key"`
+	Number   int64       `gorm:"primary_key;auto_increment:false"`
+	// The cluster of the upload, by which the chunks are deleted together with the cluster
+	ClusterID strfmt.UUID `gorm:"index"`
+	Size      int64
+	SHA256    string
+	CreatedAt time.Time
+}
+
+// QuotaObject is a discovery image or logs archive that is stored for a cluster, and is charged to the organization
+// and the user that own the cluster
+type QuotaObject struct {
+	ClusterID strfmt.UUID `gorm:"primary_key"`
+	// The name under which the cluster stores the object, such that storing another object under the same name replaces it
+	Name       string `gorm:"primary_key"`
+	Kind       string
+	ObjectName string `gorm:"index"`
+	SizeBytes  int64
+	UpdatedAt  time.Time
+}
+
+// QuotaLimits are the limits of an organization or a user that replace the default limits
+type QuotaLimits struct {
+	Scope string `gorm:"primary_key"`
+	Owner string `gorm:"primary_key"`
+	models.QuotaLimits
+	UpdatedAt time.Time
+}
+
+// QuotaLock is the row of an organization or a user that is locked to check their quota and charge it atomically
+type QuotaLock struct {
+	Scope string `gorm:"primary_key"`
+	Owner string `gorm:"primary_key"`
+}
+
+// DataKeysLock is the row of a cluster that is locked to change the data keys of the cluster and the objects that
+// they encrypt
+type DataKeysLock struct {
+	ClusterID strfmt.UUID `gorm:"primary_key"`
+}
+
 func AutoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(&models.MonitoredOperator{}, &Host{}, &Cluster{}, &Event{}).Error
+	return db.AutoMigrate(&models.MonitoredOperator{}, &Host{}, &Cluster{}, &Event{},
+		&models.ClusterNetwork{}, &models.ServiceNetwork{}, &models.MachineNetwork{}, &models.StaticIPAllocation{}, &DeclaredHost{}, &models.LogFinding{},
+		&models.LogsUpload{}, &LogsUploadChunk{}, &QuotaObject{}, &QuotaLimits{}, &QuotaLock{}, &DataKeysLock{}).Error
 }
 
 type Host struct {
@@ -68,6 +126,9 @@ type Host struct {
 
 	// Namespace of the KubeAPI resource
 	KubeKeyNamespace string `json:"kube_key_namespace"`
+
+	// When the steps of each type were last sent to the host and last succeeded, as JSON
+	StepRecords string `json:"step_records,omitempty" gorm:"type:text"`
 }
 
 type EagerLoadingState bool
@@ -87,11 +148,22 @@ const (
 const (
 	HostsTable              = "Hosts"
 	MonitoredOperatorsTable = "MonitoredOperators"
+	ClusterNetworksTable    = "ClusterNetworks"
+	ServiceNetworksTable    = "ServiceNetworks"
+	MachineNetworksTable    = "MachineNetworks"
 )
 
-var ClusterSubTables = [...]string{HostsTable, MonitoredOperatorsTable}
+var ClusterSubTables = [...]string{HostsTable, MonitoredOperatorsTable, ClusterNetworksTable, ServiceNetworksTable, MachineNetworksTable}
+
+// NetworkTables are the tables of the network lists of a cluster, which are loaded in the order of their index
+var NetworkTables = [...]string{ClusterNetworksTable, ServiceNetworksTable, MachineNetworksTable}
 
 func LoadTableFromDB(db *gorm.DB, tableName string, conditions ...interface{}) *gorm.DB {
+	if funk.ContainsString(NetworkTables[:], tableName) {
+		conditions = append(conditions, func(db *gorm.DB) *gorm.DB {
+			return db.Order("index")
+		})
+	}
 	return db.Preload(tableName, conditions...)
 }
 
@@ -107,6 +179,9 @@ func GetClusterFromDB(db *gorm.DB, clusterId strfmt.UUID, eagerLoading EagerLoad
 func GetClusterFromDBWithoutDisabledHosts(db *gorm.DB, clusterId strfmt.UUID) (*Cluster, error) {
 	db = LoadTableFromDB(db, HostsTable, "status <> ?", models.HostStatusDisabled)
 	db = LoadTableFromDB(db, MonitoredOperatorsTable)
+	db = LoadTableFromDB(db, ClusterNetworksTable)
+	db = LoadTableFromDB(db, ServiceNetworksTable)
+	db = LoadTableFromDB(db, MachineNetworksTable)
 	return GetClusterFromDB(db, clusterId, SkipEagerLoading)
 }
 
@@ -175,6 +250,105 @@ func DeleteRecordsByClusterID(db *gorm.DB, clusterID strfmt.UUID, value interfac
 	return db.Where("cluster_id = ?", clusterID).Delete(value, where...).Error
 }
 
+// IndexNetworks numbers the networks of each of the network lists of the cluster by their position in the list, such
+// that they are loaded in the same order. The network at index 0 is the primary one.
+func IndexNetworks(cluster *models.Cluster) {
+	for i, n := range cluster.ClusterNetworks {
+		n.ClusterID, n.Index = *cluster.ID, int64(i)
+	}
+	for i, n := range cluster.ServiceNetworks {
+		n.ClusterID, n.Index = *cluster.ID, int64(i)
+	}
+	for i, n := range cluster.MachineNetworks {
+		n.ClusterID, n.Index = *cluster.ID, int64(i)
+	}
+}
+
+// SetNetworks replaces the records of the given network tables of the cluster with its network lists
+func SetNetworks(db *gorm.DB, cluster *models.Cluster, tableNames ...string) error {
+	IndexNetworks(cluster)
+	for _, tableName := range tableNames {
+		var model interface{}
+		var records []interface{}
+		switch tableName {
+		case ClusterNetworksTable:
+			model = &models.ClusterNetwork{}
+			for _, n := range cluster.ClusterNetworks {
+				records = append(records, n)
+			}
+		case ServiceNetworksTable:
+			model = &models.ServiceNetwork{}
+			for _, n := range cluster.ServiceNetworks {
+				records = append(records, n)
+			}
+		case MachineNetworksTable:
+			model = &models.MachineNetwork{}
+			for _, n := range cluster.MachineNetworks {
+				records = append(records, n)
+			}
+		default:
+			return errors.Errorf("%s is not a network table", tableName)
+		}
+		if err := DeleteRecordsByClusterID(db, *cluster.ID, model); err != nil {
+			return errors.Wrapf(err, "failed to delete %s of cluster %s", tableName, cluster.ID)
+		}
+		for _, record := range records {
+			if err := db.Create(record).Error; err != nil {
+				return errors.Wrapf(err, "failed to create %s of cluster %s", tableName, cluster.ID)
+			}
+		}
+	}
+	return nil
+}
+
+// GetStaticIPAllocations returns the addresses allocated from the static IP pool of the cluster, keyed by MAC address
+func GetStaticIPAllocations(db *gorm.DB, clusterID strfmt.UUID) (map[string]string, error) {
+	var allocations []*models.StaticIPAllocation
+	if err := db.Where("cluster_id = ?", clusterID.String()).Find(&allocations).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to get static IP allocations of cluster %s", clusterID)
+	}
+	ret := make(map[string]string)
+	for _, a := range allocations {
+		ret[a.MacAddress] = a.IP
+	}
+	return ret, nil
+}
+
+// CountImageReferences returns the number of clusters whose discovery image is the cached image with the given content hash.
+// Clusters reference their cached image until it is released, either explicitly or once the image expires.
+func CountImageReferences(db *gorm.DB, contentHash string) (int64, error) {
+	var count int64
+	if err := db.Model(&Cluster{}).Where("image_content_hash = ?", contentHash).Count(&count).Error; err != nil {
+		return 0, errors.Wrapf(err, "failed to count the references to image %s", contentHash)
+	}
+	return count, nil
+}
+
+// ReleaseExpiredImageReferences stops the clusters whose discovery image expired from referencing their cached image, such
+// that the image may be deleted. The images are generated again when the clusters ask for them.
+func ReleaseExpiredImageReferences(db *gorm.DB) (int64, error) {
+	reply := db.Model(&Cluster{}).Where("image_content_hash != '' and image_expires_at < ?", strfmt.DateTime(time.Now())).
+		Updates(map[string]interface{}{"image_content_hash": "", "image_generated": false})
+	if reply.Error != nil {
+		return 0, errors.Wrap(reply.Error, "failed to release the references to expired images")
+	}
+	return reply.RowsAffected, nil
+}
+
+// SetStaticIPAllocations replaces the static IP allocations of the cluster with the given ones
+func SetStaticIPAllocations(db *gorm.DB, clusterID strfmt.UUID, allocations map[string]string) error {
+	if err := DeleteRecordsByClusterID(db, clusterID, &models.StaticIPAllocation{}); err != nil {
+		return errors.Wrapf(err, "failed to delete static IP allocations of cluster %s", clusterID)
+	}
+	for mac, ip := range allocations {
+		allocation := &models.StaticIPAllocation{ClusterID: clusterID, MacAddress: mac, IP: ip}
+		if err := db.Create(allocation).Error; err != nil {
+			return errors.Wrapf(err, "failed to create static IP allocation %s of cluster %s", mac, clusterID)
+		}
+	}
+	return nil
+}
+
 func (c *Cluster) AfterFind(db *gorm.DB) error {
 	for _, h := range c.Hosts {
 		if *h.Status == models.HostStatusKnown {
@@ -19,14 +19,24 @@ func (f NotFound) Error() string {
 	return fmt.Sprintf("object %s was not found", string(f))
 }
 
+// FieldErrorsReporter is implemented by errors that pinpoint the fields of the request they were found in
+type FieldErrorsReporter interface {
+	FieldErrors() []*models.FieldError
+}
+
 func GenerateError(id int32, err error) *models.Error {
-	return &models.Error{
+	apiErr := &models.Error{
 		Code:   swag.String(strconv.Itoa(int(id))),
 		Href:   swag.String(""),
 		ID:     swag.Int32(id),
 		Kind:   swag.String("Error"),
 		Reason: swag.String(err.Error()),
 	}
+	var reporter FieldErrorsReporter
+	if errors.As(err, &reporter) {
+		apiErr.FieldErrors = reporter.FieldErrors()
+	}
+	return apiErr
 }
 
 func GenerateInternalFromError(err error) *models.Error {
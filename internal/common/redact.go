@@ -0,0 +1,13 @@
+package common
+
+import "regexp"
+
+// RedactedValue replaces the secrets that are redacted from the documents returned to users
+const RedactedValue = "*****"
+
+var urlCredentialsRegex = regexp.MustCompile(`://[^/@\s]+@`)
+
+// RedactURLCredentials replaces the credentials of the URLs in a value, such as those of a proxy URL
+func RedactURLCredentials(value string) string {
+	return urlCredentialsRegex.ReplaceAllString(value, "://"+RedactedValue+"@")
+}
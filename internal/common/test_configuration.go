@@ -33,6 +33,20 @@ type TestConfiguration struct {
 const TestDiskId = "/dev/disk/by-id/test-disk-id"
 const TestDiskPath = "/dev/test-disk"
 
+// A self-signed certificate to be used as an additional trust bundle
+const TestAdditionalTrustBundle = `-----BEGIN CERTIFICATE-----
+MIIBlTCCATugAwIBAgIUDBG5e2ppb0HAGoSMiOkQmPh22OgwCgYIKoZIzj0EAwIw
+HzEdMBsGA1UEAwwUcHJveHktY2EuZXhhbXBsZS5jb20wIBcNMjYxMDE5MDg0NzE2
+WhgPMjEyNjA5MjUwODQ3MTZaMB8xHTAbBgNVBAMMFHByb3h5LWNhLmV4YW1wbGUu
+Y29tMFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE03FjTsRH3HrljQwD3ebfryV2
+qM3loGjt5OYU9aGh8Xz0rrAkmmYFW9cSYp3OORu4SxCnVGBRS9kgK46CL+Ec9aNT
+MFEwHQYDVR0OBBYEFLoLUi0LsI+ln5oURT2IP1atJ6jvMB8GA1UdIwQYMBaAFLoL
+Ui0LsI+ln5oURT2IP1atJ6jvMA8GA1UdEwEB/wQFMAMBAf8wCgYIKoZIzj0EAwID
+SAAwRQIgPuNlj7M/k27ue5buTZeds0zhVyWd7Gq3jGHFMqc2U40CIQDfk0wm+9Hx
+dV41I4rg/pYNIvld4FpJ29hM1T13TXccww==
+-----END CERTIFICATE-----
+`
+
 var (
 	OpenShiftVersion string = "4.6"
 	ReleaseVersion          = "4.6.0"
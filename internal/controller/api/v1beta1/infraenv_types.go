@@ -87,6 +87,29 @@ type InfraEnvSpec struct {
 	// Json formatted string containing the user overrides for the initial ignition config
 	// +optional
 	IgnitionConfigOverride string `json:"ignitionConfigOverride,omitempty"`
+
+	// KernelArguments is a list of operations on the kernel arguments of the discovery image, which
+	// are applied in order.
+	// +optional
+	KernelArguments []KernelArgument `json:"kernelArguments,omitempty"`
+
+	// AdditionalTrustBundle is a PEM-encoded X.509 certificate bundle that is added to the system
+	// trust store of the agents and of the installed cluster. When the AgentClusterInstall of the
+	// cluster sets an additional trust bundle as well, both must be the same.
+	// +optional
+	AdditionalTrustBundle string `json:"additionalTrustBundle,omitempty"`
+}
+
+// KernelArgument is an operation on the kernel arguments of the discovery image.
+type KernelArgument struct {
+	// Operation is the operation to apply on the kernel arguments of the discovery image. A replace
+	// operation replaces the arguments with the same key. A delete operation removes the arguments
+	// with the same key when only a key is given, and the identical arguments otherwise.
+	// +kubebuilder:validation:Enum=append;replace;delete
+	Operation string `json:"operation"`
+
+	// Value is a single kernel argument, such as console=ttyS1,115200.
+	Value string `json:"value"`
 }
 
 // Proxy defines the proxy settings for agents and clusters that use the InfraEnv.
@@ -578,6 +578,11 @@ func (in *InfraEnvSpec) DeepCopyInto(out *InfraEnvSpec) {
 		*out = new(ClusterReference)
 		**out = **in
 	}
+	if in.KernelArguments != nil {
+		in, out := &in.KernelArguments, &out.KernelArguments
+		*out = make([]KernelArgument, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfraEnvSpec.
@@ -627,6 +632,21 @@ func (in *Interface) DeepCopy() *Interface {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KernelArgument) DeepCopyInto(out *KernelArgument) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelArgument.
+func (in *KernelArgument) DeepCopy() *KernelArgument {
+	if in == nil {
+		return nil
+	}
+	out := new(KernelArgument)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NMStateConfig) DeepCopyInto(out *NMStateConfig) {
 	*out = *in
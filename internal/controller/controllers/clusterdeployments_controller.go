@@ -630,6 +630,13 @@ func (r *ClusterDeploymentsReconciler) updateIfNeeded(ctx context.Context,
 		notifyInfraEnv = true
 	}
 
+	// The additional trust bundle is part of the discovery image, which must be generated again when it changes
+	if clusterInstall.Spec.AdditionalTrustBundle != "" && clusterInstall.Spec.AdditionalTrustBundle != cluster.AdditionalTrustBundle {
+		params.AdditionalTrustBundle = swag.String(clusterInstall.Spec.AdditionalTrustBundle)
+		update = true
+		notifyInfraEnv = true
+	}
+
 	// update hyperthreading settings
 	hyperthreading := getHyperthreading(clusterInstall)
 	if cluster.Hyperthreading != *hyperthreading {
@@ -823,6 +830,10 @@ func (r *ClusterDeploymentsReconciler) createNewCluster(
 		clusterParams.Hyperthreading = getHyperthreading(clusterInstall)
 	}
 
+	if clusterInstall.Spec.AdditionalTrustBundle != "" {
+		clusterParams.AdditionalTrustBundle = swag.String(clusterInstall.Spec.AdditionalTrustBundle)
+	}
+
 	c, err := r.Installer.RegisterClusterInternal(ctx, &key, installer.RegisterClusterParams{
 		NewClusterParams: clusterParams,
 	})
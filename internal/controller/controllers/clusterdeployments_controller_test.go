@@ -1472,6 +1472,46 @@ var _ = Describe("cluster reconcile", func() {
 			Expect(FindStatusCondition(aci.Status.Conditions, ClusterRequirementsMetCondition).Status).To(Equal(corev1.ConditionFalse))
 		})
 
+		It("update additional trust bundle", func() {
+			backEndCluster := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:                       &sId,
+					Name:                     clusterName,
+					OpenshiftVersion:         "4.8",
+					ClusterNetworkCidr:       defaultAgentClusterInstallSpec.Networking.ClusterNetwork[0].CIDR,
+					ClusterNetworkHostPrefix: int64(defaultAgentClusterInstallSpec.Networking.ClusterNetwork[0].HostPrefix),
+					Status:                   swag.String(models.ClusterStatusInsufficient),
+					ServiceNetworkCidr:       defaultAgentClusterInstallSpec.Networking.ServiceNetwork[0],
+					IngressVip:               defaultAgentClusterInstallSpec.IngressVIP,
+					APIVip:                   defaultAgentClusterInstallSpec.APIVIP,
+					BaseDNSDomain:            defaultClusterSpec.BaseDomain,
+					SSHPublicKey:             defaultAgentClusterInstallSpec.SSHPublicKey,
+					Hyperthreading:           models.ClusterHyperthreadingAll,
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+			updateReply := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:                    &sId,
+					Status:                swag.String(models.ClusterStatusInsufficient),
+					AdditionalTrustBundle: common.TestAdditionalTrustBundle,
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().UpdateClusterInternal(gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, param installer.UpdateClusterParams) {
+					Expect(swag.StringValue(param.ClusterUpdateParams.AdditionalTrustBundle)).To(Equal(common.TestAdditionalTrustBundle))
+				}).Return(updateReply, nil)
+			aci.Spec.AdditionalTrustBundle = common.TestAdditionalTrustBundle
+			Expect(c.Update(ctx, aci)).Should(BeNil())
+
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+		})
+
 		It("only state changed", func() {
 			backEndCluster := &common.Cluster{
 				Cluster: models.Cluster{
@@ -116,6 +116,11 @@ func (r *InfraEnvReconciler) updateClusterIfNeeded(ctx context.Context, log logr
 		log.Debugf("AdditionalNTPSources changed from %s to %s", cluster.AdditionalNtpSource, infraEnv.Spec.AdditionalNTPSources[0])
 		update = true
 	}
+	if infraEnv.Spec.AdditionalTrustBundle != "" && infraEnv.Spec.AdditionalTrustBundle != cluster.AdditionalTrustBundle {
+		params.AdditionalTrustBundle = swag.String(infraEnv.Spec.AdditionalTrustBundle)
+		log.Debugf("AdditionalTrustBundle changed")
+		update = true
+	}
 
 	if update {
 		updateString, err := json.Marshal(params)
@@ -277,6 +282,10 @@ func (r *InfraEnvReconciler) ensureISO(ctx context.Context, log logrus.FieldLogg
 			SSHPublicKey: infraEnv.Spec.SSHAuthorizedKey,
 		},
 	}
+	for _, kernelArgument := range infraEnv.Spec.KernelArguments {
+		isoParams.ImageCreateParams.KernelArguments = append(isoParams.ImageCreateParams.KernelArguments,
+			&models.KernelArgument{Operation: kernelArgument.Operation, Value: kernelArgument.Value})
+	}
 
 	staticNetworkConfig, err := r.processNMStateConfig(ctx, log, infraEnv)
 	if err != nil {
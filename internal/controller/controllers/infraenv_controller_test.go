@@ -176,6 +176,36 @@ var _ = Describe("infraEnv reconcile", func() {
 		Expect(conditionsv1.FindStatusCondition(infraEnvImage.Status.Conditions, aiv1beta1.ImageCreatedCondition).Status).To(Equal(corev1.ConditionTrue))
 	})
 
+	It("create new infraEnv image with kernel arguments - success", func() {
+		imageInfo := models.ImageInfo{
+			DownloadURL: "downloadurl",
+		}
+		clusterDeployment := newClusterDeployment("clusterDeployment", testNamespace, getDefaultClusterDeploymentSpec("clusterDeployment-test", "test-cluster-aci", "pull-secret"))
+		Expect(c.Create(ctx, clusterDeployment)).To(BeNil())
+		mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+		mockInstallerInternal.EXPECT().GenerateClusterISOInternal(gomock.Any(), gomock.Any()).
+			Do(func(ctx context.Context, params installer.GenerateClusterISOParams) {
+				Expect(params.ClusterID).To(Equal(*backEndCluster.ID))
+				Expect(params.ImageCreateParams.KernelArguments).To(Equal(models.KernelArguments{
+					{Operation: models.KernelArgumentOperationAppend, Value: "console=ttyS1,115200"},
+					{Operation: models.KernelArgumentOperationDelete, Value: "rd.luks.options"},
+				}))
+			}).Return(&common.Cluster{Cluster: models.Cluster{ImageInfo: &imageInfo}}, nil).Times(1)
+		mockInstallerInternal.EXPECT().AddOpenshiftVersion(gomock.Any(), gomock.Any(), gomock.Any()).Return(openshiftVersion, nil)
+		infraEnvImage := newInfraEnvImage("infraEnvImage", testNamespace, aiv1beta1.InfraEnvSpec{
+			ClusterRef: &aiv1beta1.ClusterReference{Name: "clusterDeployment", Namespace: testNamespace},
+			KernelArguments: []aiv1beta1.KernelArgument{
+				{Operation: models.KernelArgumentOperationAppend, Value: "console=ttyS1,115200"},
+				{Operation: models.KernelArgumentOperationDelete, Value: "rd.luks.options"},
+			},
+		})
+		Expect(c.Create(ctx, infraEnvImage)).To(BeNil())
+
+		res, err := ir.Reconcile(ctx, newInfraEnvRequest(infraEnvImage))
+		Expect(err).To(BeNil())
+		Expect(res).To(Equal(ctrl.Result{}))
+	})
+
 	It("create new infraEnv image - backend failure", func() {
 		clusterDeployment := newClusterDeployment("clusterDeployment", testNamespace, getDefaultClusterDeploymentSpec("clusterDeployment-test", "test-cluster-aci", "pull-secret"))
 		Expect(c.Create(ctx, clusterDeployment)).To(BeNil())
@@ -373,6 +403,31 @@ var _ = Describe("infraEnv reconcile", func() {
 		Expect(res).To(Equal(ctrl.Result{Requeue: false}))
 	})
 
+	It("create image with an additional trust bundle", func() {
+		imageInfo := models.ImageInfo{DownloadURL: "downloadurl"}
+		clusterDeployment := newClusterDeployment("clusterDeployment", testNamespace, getDefaultClusterDeploymentSpec("clusterDeployment-test", "test-cluster-aci", "pull-secret"))
+		Expect(c.Create(ctx, clusterDeployment)).To(BeNil())
+		infraEnvImage := newInfraEnvImage("infraEnvImage", testNamespace,
+			aiv1beta1.InfraEnvSpec{
+				AdditionalTrustBundle: common.TestAdditionalTrustBundle,
+				ClusterRef:            &aiv1beta1.ClusterReference{Name: "clusterDeployment", Namespace: testNamespace},
+			})
+		Expect(c.Create(ctx, infraEnvImage)).To(BeNil())
+
+		mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+		mockInstallerInternal.EXPECT().UpdateClusterInternal(gomock.Any(), gomock.Any()).
+			Do(func(ctx context.Context, param installer.UpdateClusterParams) {
+				Expect(swag.StringValue(param.ClusterUpdateParams.AdditionalTrustBundle)).To(Equal(common.TestAdditionalTrustBundle))
+			}).Return(nil, nil).Times(1)
+		mockInstallerInternal.EXPECT().GenerateClusterISOInternal(gomock.Any(), gomock.Any()).
+			Return(&common.Cluster{Cluster: models.Cluster{ImageInfo: &imageInfo}}, nil).Times(1)
+		mockInstallerInternal.EXPECT().AddOpenshiftVersion(gomock.Any(), gomock.Any(), gomock.Any()).Return(openshiftVersion, nil)
+
+		res, err := ir.Reconcile(ctx, newInfraEnvRequest(infraEnvImage))
+		Expect(err).To(BeNil())
+		Expect(res).To(Equal(ctrl.Result{Requeue: false}))
+	})
+
 	It("create image with ignition config override", func() {
 		imageInfo := models.ImageInfo{DownloadURL: "downloadurl"}
 		ignitionConfigOverride := `{"ignition": {"version": "3.1.0"}, "storage": {"files": [{"path": "/tmp/example", "contents": {"source": "data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj"}}]}}`
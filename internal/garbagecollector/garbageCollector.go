@@ -7,7 +7,9 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/jinzhu/gorm"
 	clusterPkg "github.com/openshift/assisted-service/internal/cluster"
+	"github.com/openshift/assisted-service/internal/clusterarchive"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/logsupload"
 	"github.com/openshift/assisted-service/pkg/leader"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
@@ -18,6 +20,11 @@ type Config struct {
 	DeletedUnregisteredAfter time.Duration `envconfig:"DELETED_UNREGISTERED_AFTER" default:"72h"` // 3d
 	DeregisterInactiveAfter  time.Duration `envconfig:"DELETED_INACTIVE_AFTER" default:"480h"`    // 20d
 	MaxGCClustersPerInterval int           `envconfig:"MAX_GC_CLUSTERS_PER_INTERVAL" default:"100"`
+	// Logs uploads that did not receive a chunk for this long are deleted together with their chunks
+	DeleteAbandonedLogsUploadsAfter time.Duration `envconfig:"DELETE_ABANDONED_LOGS_UPLOADS_AFTER" default:"24h"`
+	// Installed clusters are moved to the storage this long after their installation completed, 0 disables archival.
+	// It must be shorter than DELETED_INACTIVE_AFTER, otherwise the clusters are deregistered before they are archived.
+	ArchiveInstalledAfter time.Duration `envconfig:"ARCHIVE_INSTALLED_AFTER" default:"0"`
 }
 
 type GarbageCollectors interface {
@@ -33,27 +40,32 @@ func NewGarbageCollectors(
 	clusterApi clusterPkg.API,
 	objectHandler s3wrapper.API,
 	leaderElector leader.Leader,
-
+	logsUploadApi logsupload.API,
+	clusterArchiveApi clusterarchive.API,
 ) *garbageCollector {
 	return &garbageCollector{
-		Config:        Config,
-		db:            db,
-		log:           log,
-		hostApi:       hostApi,
-		clusterApi:    clusterApi,
-		objectHandler: objectHandler,
-		leaderElector: leaderElector,
+		Config:            Config,
+		db:                db,
+		log:               log,
+		hostApi:           hostApi,
+		clusterApi:        clusterApi,
+		objectHandler:     objectHandler,
+		leaderElector:     leaderElector,
+		logsUploadApi:     logsUploadApi,
+		clusterArchiveApi: clusterArchiveApi,
 	}
 }
 
 type garbageCollector struct {
 	Config
-	db            *gorm.DB
-	log           logrus.FieldLogger
-	hostApi       host.API
-	clusterApi    clusterPkg.API
-	objectHandler s3wrapper.API
-	leaderElector leader.Leader
+	db                *gorm.DB
+	log               logrus.FieldLogger
+	hostApi           host.API
+	clusterApi        clusterPkg.API
+	objectHandler     s3wrapper.API
+	leaderElector     leader.Leader
+	logsUploadApi     logsupload.API
+	clusterArchiveApi clusterarchive.API
 }
 
 func (g garbageCollector) DeregisterInactiveClusters() {
@@ -87,3 +99,27 @@ func (g garbageCollector) PermanentlyDeleteUnregisteredClustersAndHosts() {
 		return
 	}
 }
+
+func (g garbageCollector) DeleteAbandonedLogsUploads() {
+	if !g.leaderElector.IsLeader() {
+		return
+	}
+
+	notUpdatedSince := time.Now().Add(-g.Config.DeleteAbandonedLogsUploadsAfter)
+	if err := g.logsUploadApi.DeleteAbandoned(context.Background(), notUpdatedSince); err != nil {
+		g.log.WithError(err).Errorf("Failed deleting abandoned logs uploads")
+		return
+	}
+}
+
+func (g garbageCollector) ArchiveInstalledClusters() {
+	if !g.leaderElector.IsLeader() {
+		return
+	}
+
+	installedBefore := strfmt.DateTime(time.Now().Add(-g.Config.ArchiveInstalledAfter))
+	if err := g.clusterArchiveApi.ArchiveInstalledClusters(context.Background(), g.MaxGCClustersPerInterval, installedBefore); err != nil {
+		g.log.WithError(err).Errorf("Failed archiving installed clusters")
+		return
+	}
+}
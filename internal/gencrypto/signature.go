@@ -0,0 +1,42 @@
+package gencrypto
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+)
+
+// SignDigest signs a digest with the given EC private key. The signature is ASN.1 encoded, as expected by
+// "openssl dgst -verify".
+func SignDigest(digest []byte, privateKeyPEM string) ([]byte, error) {
+	if privateKeyPEM == "" {
+		return nil, errors.Errorf("signing key is not configured")
+	}
+	priv, err := jwt.ParseECPrivateKeyFromPEM([]byte(privateKeyPEM))
+	if err != nil {
+		return nil, err
+	}
+	return ecdsa.SignASN1(rand.Reader, priv, digest)
+}
+
+// PublicKeyPEM returns the PEM encoded public half of an EC private key, to verify the signatures of SignDigest
+func PublicKeyPEM(privateKeyPEM string) (string, error) {
+	priv, err := jwt.ParseECPrivateKeyFromPEM([]byte(privateKeyPEM))
+	if err != nil {
+		return "", err
+	}
+	pubBytes, err := x509.MarshalPKIXPublicKey(priv.Public())
+	if err != nil {
+		return "", err
+	}
+	var pubKeyPEM bytes.Buffer
+	if err := pem.Encode(&pubKeyPEM, &pem.Block{Type: "PUBLIC KEY", Bytes: pubBytes}); err != nil {
+		return "", err
+	}
+	return pubKeyPEM.String(), nil
+}
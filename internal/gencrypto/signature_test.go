@@ -0,0 +1,65 @@
+package gencrypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+
+	"github.com/dgrijalva/jwt-go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Digest signing", func() {
+	var (
+		publicKey     *ecdsa.PublicKey
+		privateKeyPEM string
+		digest        [sha256.Size]byte
+	)
+
+	BeforeEach(func() {
+		publicKeyPEM, priv, err := ECDSAKeyPairPEM()
+		Expect(err).NotTo(HaveOccurred())
+		privateKeyPEM = priv
+		publicKey, err = jwt.ParseECPublicKeyFromPEM([]byte(publicKeyPEM))
+		Expect(err).NotTo(HaveOccurred())
+		digest = sha256.Sum256([]byte("discovery image"))
+	})
+
+	It("SignDigest fails without a key", func() {
+		_, err := SignDigest(digest[:], "")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("SignDigest creates a valid signature", func() {
+		signature, err := SignDigest(digest[:], privateKeyPEM)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ecdsa.VerifyASN1(publicKey, digest[:], signature)).To(BeTrue())
+	})
+
+	It("SignDigest creates a signature that does not verify another digest", func() {
+		signature, err := SignDigest(digest[:], privateKeyPEM)
+		Expect(err).NotTo(HaveOccurred())
+		other := sha256.Sum256([]byte("another image"))
+		Expect(ecdsa.VerifyASN1(publicKey, other[:], signature)).To(BeFalse())
+	})
+
+	It("SignDigest fails with an invalid key", func() {
+		_, err := SignDigest(digest[:], "not a key")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("PublicKeyPEM returns the key verifying the signatures", func() {
+		publicKeyPEM, err := PublicKeyPEM(privateKeyPEM)
+		Expect(err).NotTo(HaveOccurred())
+		key, err := jwt.ParseECPublicKeyFromPEM([]byte(publicKeyPEM))
+		Expect(err).NotTo(HaveOccurred())
+		signature, err := SignDigest(digest[:], privateKeyPEM)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ecdsa.VerifyASN1(key, digest[:], signature)).To(BeTrue())
+	})
+
+	It("PublicKeyPEM fails with an invalid key", func() {
+		_, err := PublicKeyPEM("not a key")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,139 @@
+package gencrypto
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+)
+
+// objectURLKeyContext separates the key that signs object URLs from other uses of the EC private key
+const objectURLKeyContext = "assisted-service object URL"
+
+// ObjectURLKey is a key that signs object URLs, derived from an EC private key of the service
+type ObjectURLKey struct {
+	// ID identifies the key in the URLs it signs
+	ID string
+	// ValidUntil is the time after which the signatures of the key are no longer accepted, zero for the current key
+	ValidUntil time.Time
+	secret     []byte
+}
+
+// NewObjectURLKey derives the key that signs object URLs from an EC private key
+func NewObjectURLKey(private_key_pem string) (*ObjectURLKey, error) {
+	priv, err := jwt.ParseECPrivateKeyFromPEM([]byte(private_key_pem))
+	if err != nil {
+		return nil, err
+	}
+	pub, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	id := sha256.Sum256(pub)
+
+	keyHash := hmac.New(sha256.New, []byte(objectURLKeyContext))
+	keyHash.Write(priv.D.Bytes())
+	return &ObjectURLKey{ID: hex.EncodeToString(id[:8]), secret: keyHash.Sum(nil)}, nil
+}
+
+// ObjectURLSigningEnabled returns whether the service has a private key to sign object URLs with
+func ObjectURLSigningEnabled() bool {
+	key, ok := os.LookupEnv("EC_PRIVATE_KEY_PEM")
+	return ok && key != ""
+}
+
+// ObjectURLKeys returns the key of EC_PRIVATE_KEY_PEM, which signs object URLs, followed by the key of
+// EC_PREVIOUS_PRIVATE_KEY_PEM if it is set. After a rotation of the key pair of the service, the previous key keeps
+// verifying the URLs it signed until EC_PREVIOUS_PRIVATE_KEY_VALID_UNTIL, which should be set to the time of the
+// rotation plus the longest expiration time of the URLs.
+func ObjectURLKeys() ([]*ObjectURLKey, error) {
+	key, ok := os.LookupEnv("EC_PRIVATE_KEY_PEM")
+	if !ok || key == "" {
+		return nil, errors.Errorf("EC_PRIVATE_KEY_PEM not found")
+	}
+	current, err := NewObjectURLKey(key)
+	if err != nil {
+		return nil, err
+	}
+	keys := []*ObjectURLKey{current}
+
+	previousKey := os.Getenv("EC_PREVIOUS_PRIVATE_KEY_PEM")
+	if previousKey == "" {
+		return keys, nil
+	}
+	previous, err := NewObjectURLKey(previousKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid EC_PREVIOUS_PRIVATE_KEY_PEM")
+	}
+	if previous.ValidUntil, err = time.Parse(time.RFC3339, os.Getenv("EC_PREVIOUS_PRIVATE_KEY_VALID_UNTIL")); err != nil {
+		return nil, errors.Wrap(err, "EC_PREVIOUS_PRIVATE_KEY_PEM requires a valid EC_PREVIOUS_PRIVATE_KEY_VALID_UNTIL")
+	}
+	return append(keys, previous), nil
+}
+
+// ObjectURLSignature signs the download of an object under the given file name until the expiration time with the
+// current object URL key, and returns the ID of the key along with the signature
+func ObjectURLSignature(objectName, fileName string, expires time.Time) (string, string, error) {
+	keys, err := ObjectURLKeys()
+	if err != nil {
+		return "", "", err
+	}
+	return keys[0].ID, keys[0].Sign(objectName, fileName, expires), nil
+}
+
+// VerifyObjectURLSignature checks that a signature was created by ObjectURLSignature with the key of the given ID
+// and has not expired
+func VerifyObjectURLSignature(objectName, fileName string, expires time.Time, keyID, signature string) error {
+	keys, err := ObjectURLKeys()
+	if err != nil {
+		return err
+	}
+	return VerifyObjectURLSignatureForKeys(objectName, fileName, expires, keyID, signature, keys)
+}
+
+// VerifyObjectURLSignatureForKeys checks a signature with the key of the given ID among the keys. Signatures without a
+// key ID, which were created before the URLs carried one, are checked with the first key only.
+func VerifyObjectURLSignatureForKeys(objectName, fileName string, expires time.Time, keyID, signature string, keys []*ObjectURLKey) error {
+	now := time.Now()
+	if now.After(expires) {
+		return errors.Errorf("signature expired at %s", expires.UTC().Format(time.RFC3339))
+	}
+	decoded, err := hex.DecodeString(signature)
+	if err != nil {
+		return errors.Wrap(err, "malformed signature")
+	}
+	for i, key := range keys {
+		if keyID != key.ID && (keyID != "" || i > 0) {
+			continue
+		}
+		if !key.ValidUntil.IsZero() && (now.After(key.ValidUntil) || expires.After(key.ValidUntil)) {
+			return errors.Errorf("key %s is no longer valid", key.ID)
+		}
+		if !hmac.Equal(decoded, key.mac(objectName, fileName, expires)) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	}
+	return errors.Errorf("unknown key %s", keyID)
+}
+
+// Sign signs the download of an object under the given file name until the expiration time
+func (k *ObjectURLKey) Sign(objectName, fileName string, expires time.Time) string {
+	return hex.EncodeToString(k.mac(objectName, fileName, expires))
+}
+
+func (k *ObjectURLKey) mac(objectName, fileName string, expires time.Time) []byte {
+	mac := hmac.New(sha256.New, k.secret)
+	mac.Write([]byte(objectName))
+	mac.Write([]byte{0})
+	mac.Write([]byte(fileName))
+	mac.Write([]byte{0})
+	mac.Write([]byte(strconv.FormatInt(expires.Unix(), 10)))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,111 @@
+package gencrypto
+
+import (
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Object URL signing", func() {
+	var (
+		privateKeyPEM string
+		key           *ObjectURLKey
+		expires       time.Time
+	)
+
+	BeforeEach(func() {
+		var err error
+		_, privateKeyPEM, err = ECDSAKeyPairPEM()
+		Expect(err).NotTo(HaveOccurred())
+		key, err = NewObjectURLKey(privateKeyPEM)
+		Expect(err).NotTo(HaveOccurred())
+		expires = time.Now().Add(time.Hour).Truncate(time.Second)
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("EC_PRIVATE_KEY_PEM")
+		os.Unsetenv("EC_PREVIOUS_PRIVATE_KEY_PEM")
+		os.Unsetenv("EC_PREVIOUS_PRIVATE_KEY_VALID_UNTIL")
+	})
+
+	rotatedKey := func() *ObjectURLKey {
+		_, rotatedKeyPEM, err := ECDSAKeyPairPEM()
+		Expect(err).NotTo(HaveOccurred())
+		rotated, err := NewObjectURLKey(rotatedKeyPEM)
+		Expect(err).NotTo(HaveOccurred())
+		return rotated
+	}
+
+	It("ObjectURLSignature fails when EC_PRIVATE_KEY_PEM is unset", func() {
+		Expect(ObjectURLSigningEnabled()).To(BeFalse())
+		_, _, err := ObjectURLSignature("cluster/logs.tar.gz", "logs.tar.gz", expires)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("verifies a signature created with EC_PRIVATE_KEY_PEM", func() {
+		os.Setenv("EC_PRIVATE_KEY_PEM", privateKeyPEM)
+		Expect(ObjectURLSigningEnabled()).To(BeTrue())
+		keyID, signature, err := ObjectURLSignature("cluster/logs.tar.gz", "logs.tar.gz", expires)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keyID).To(Equal(key.ID))
+		Expect(VerifyObjectURLSignature("cluster/logs.tar.gz", "logs.tar.gz", expires, keyID, signature)).To(Succeed())
+	})
+
+	It("rejects a signature of another object, file name or expiration time", func() {
+		keys := []*ObjectURLKey{key}
+		signature := key.Sign("cluster/logs.tar.gz", "logs.tar.gz", expires)
+		Expect(VerifyObjectURLSignatureForKeys("cluster/other.tar.gz", "logs.tar.gz", expires, key.ID, signature, keys)).ToNot(Succeed())
+		Expect(VerifyObjectURLSignatureForKeys("cluster/logs.tar.gz", "other.tar.gz", expires, key.ID, signature, keys)).ToNot(Succeed())
+		Expect(VerifyObjectURLSignatureForKeys("cluster/logs.tar.gz", "logs.tar.gz", expires.Add(time.Hour), key.ID, signature, keys)).ToNot(Succeed())
+		Expect(VerifyObjectURLSignatureForKeys("cluster/logs.tar.gz", "logs.tar.gz", expires, key.ID, "not hex", keys)).ToNot(Succeed())
+	})
+
+	It("rejects a signature of an unknown key", func() {
+		signature := key.Sign("cluster/logs.tar.gz", "logs.tar.gz", expires)
+		Expect(VerifyObjectURLSignatureForKeys("cluster/logs.tar.gz", "logs.tar.gz", expires, key.ID, signature, []*ObjectURLKey{rotatedKey()})).ToNot(Succeed())
+	})
+
+	It("verifies a signature without a key ID with the current key only", func() {
+		signature := key.Sign("cluster/logs.tar.gz", "logs.tar.gz", expires)
+		Expect(VerifyObjectURLSignatureForKeys("cluster/logs.tar.gz", "logs.tar.gz", expires, "", signature, []*ObjectURLKey{key})).To(Succeed())
+		key.ValidUntil = expires
+		Expect(VerifyObjectURLSignatureForKeys("cluster/logs.tar.gz", "logs.tar.gz", expires, "", signature, []*ObjectURLKey{rotatedKey(), key})).ToNot(Succeed())
+	})
+
+	It("verifies a signature of the previous key until it is no longer valid", func() {
+		os.Setenv("EC_PRIVATE_KEY_PEM", privateKeyPEM)
+		_, previousKeyPEM, err := ECDSAKeyPairPEM()
+		Expect(err).NotTo(HaveOccurred())
+		previous, err := NewObjectURLKey(previousKeyPEM)
+		Expect(err).NotTo(HaveOccurred())
+		os.Setenv("EC_PREVIOUS_PRIVATE_KEY_PEM", previousKeyPEM)
+		signature := previous.Sign("cluster/logs.tar.gz", "logs.tar.gz", expires)
+
+		By("requiring the validity of the previous key")
+		Expect(VerifyObjectURLSignature("cluster/logs.tar.gz", "logs.tar.gz", expires, previous.ID, signature)).ToNot(Succeed())
+
+		By("accepting URLs that expire while the previous key is valid")
+		os.Setenv("EC_PREVIOUS_PRIVATE_KEY_VALID_UNTIL", expires.Format(time.RFC3339))
+		Expect(VerifyObjectURLSignature("cluster/logs.tar.gz", "logs.tar.gz", expires, previous.ID, signature)).To(Succeed())
+		keyID, _, err := ObjectURLSignature("cluster/logs.tar.gz", "logs.tar.gz", expires)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keyID).To(Equal(key.ID))
+
+		By("rejecting URLs that expire after the previous key")
+		later := expires.Add(time.Hour)
+		laterSignature := previous.Sign("cluster/logs.tar.gz", "logs.tar.gz", later)
+		Expect(VerifyObjectURLSignature("cluster/logs.tar.gz", "logs.tar.gz", later, previous.ID, laterSignature)).ToNot(Succeed())
+
+		By("rejecting all URLs once the previous key expired")
+		os.Setenv("EC_PREVIOUS_PRIVATE_KEY_VALID_UNTIL", time.Now().Add(-time.Minute).Format(time.RFC3339))
+		Expect(VerifyObjectURLSignature("cluster/logs.tar.gz", "logs.tar.gz", expires, previous.ID, signature)).ToNot(Succeed())
+	})
+
+	It("rejects an expired signature", func() {
+		expired := time.Now().Add(-time.Minute)
+		signature := key.Sign("cluster/logs.tar.gz", "logs.tar.gz", expired)
+		Expect(VerifyObjectURLSignatureForKeys("cluster/logs.tar.gz", "logs.tar.gz", expired, key.ID, signature, []*ObjectURLKey{key})).ToNot(Succeed())
+	})
+})
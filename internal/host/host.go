@@ -98,6 +98,7 @@ type Config struct {
 	ResetTimeout            time.Duration           `envconfig:"RESET_CLUSTER_TIMEOUT" default:"3m"`
 	MonitorBatchSize        int                     `envconfig:"HOST_MONITOR_BATCH_SIZE" default:"100"`
 	DisabledHostvalidations DisabledHostValidations `envconfig:"DISABLED_HOST_VALIDATIONS" default:"sufficient-network-latency-requirement-for-role,sufficient-packet-loss-requirement-for-role"` // Which host validations to disable (should not run in preprocess)
+	AgentDockerImage        string                  // The agent image that the hosts are expected to run, copied from the instructions configuration
 }
 
 //go:generate mockgen -package=host -aux_files=github.com/openshift/assisted-service/internal/host/hostcommands=instruction_manager.go -destination=mock_host_api.go . API
@@ -143,6 +144,7 @@ type API interface {
 	UpdateKubeKeyNS(ctx context.Context, hostID, namespace string) error
 	GetHostValidDisks(role *models.Host) ([]*models.Disk, error)
 	UpdateImageStatus(ctx context.Context, h *models.Host, imageStatus *models.ContainerImageAvailability, db *gorm.DB) error
+	UpdateAgentUpgradeStatus(ctx context.Context, h *models.Host, upgradeStatus *models.UpgradeAgentResponse, db *gorm.DB) error
 	SetDiskSpeed(ctx context.Context, h *models.Host, path string, speedMs int64, exitCode int64, db *gorm.DB) error
 	ResetHostValidation(ctx context.Context, hostID, clusterID strfmt.UUID, validationID string, db *gorm.DB) error
 }
@@ -176,7 +178,7 @@ func NewManager(log logrus.FieldLogger, db *gorm.DB, eventsHandler events.Handle
 		hwValidator:    hwValidator,
 		eventsHandler:  eventsHandler,
 		sm:             NewHostStateMachine(th),
-		rp:             newRefreshPreprocessor(log, hwValidatorCfg, hwValidator, operatorsApi, config.DisabledHostvalidations),
+		rp:             newRefreshPreprocessor(log, hwValidatorCfg, hwValidator, operatorsApi, config.DisabledHostvalidations, config.AgentDockerImage),
 		metricApi:      metricApi,
 		Config:         *config,
 		leaderElector:  leaderElector,
@@ -492,6 +494,10 @@ func (m *Manager) GetNextSteps(ctx context.Context, host *models.Host) (models.S
 	return m.instructionApi.GetNextSteps(ctx, host)
 }
 
+func (m *Manager) RecordStepSuccess(ctx context.Context, host *models.Host, stepType models.StepType, stepID string) {
+	m.instructionApi.RecordStepSuccess(ctx, host, stepType, stepID)
+}
+
 func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, progress *models.HostProgress) error {
 	previousProgress := h.Progress
 
@@ -730,6 +736,24 @@ func (m *Manager) UpdateImageStatus(ctx context.Context, h *models.Host, newImag
 	return db.Model(h).Update("images_status", marshalledStatuses).Error
 }
 
+func (m *Manager) UpdateAgentUpgradeStatus(ctx context.Context, h *models.Host, upgradeStatus *models.UpgradeAgentResponse, db *gorm.DB) error {
+	marshalledStatus, err := hostutil.MarshalAgentUpgradeStatus(upgradeStatus)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to marshal agent upgrade status for host %s", h.ID.String())
+	}
+
+	m.log.Infof("Agent upgrade of host %s to %s finished with result %s", h.ID.String(), upgradeStatus.AgentImage, upgradeStatus.Result)
+	if upgradeStatus.Result == models.UpgradeAgentResultSuccess {
+		m.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityInfo,
+			fmt.Sprintf("Host %s: agent was upgraded to %s", hostutil.GetHostnameForMsg(h), upgradeStatus.AgentImage), time.Now())
+	} else {
+		m.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityError,
+			fmt.Sprintf("Host %s: failed to upgrade agent from %s to %s", hostutil.GetHostnameForMsg(h), h.DiscoveryAgentVersion, upgradeStatus.AgentImage), time.Now())
+	}
+
+	return db.Model(h).Update("agent_upgrade_status", marshalledStatus).Error
+}
+
 func (m *Manager) UpdateHostname(ctx context.Context, h *models.Host, hostname string, db *gorm.DB) error {
 	hostStatus := swag.StringValue(h.Status)
 	if !funk.ContainsString(hostStatusesBeforeInstallation[:], hostStatus) {
@@ -1995,6 +1995,75 @@ var _ = Describe("UpdateImageStatus", func() {
 	})
 })
 
+var _ = Describe("UpdateAgentUpgradeStatus", func() {
+	var (
+		ctx               = context.Background()
+		hapi              API
+		db                *gorm.DB
+		ctrl              *gomock.Controller
+		mockEvents        *events.MockHandler
+		hostId, clusterId strfmt.UUID
+		host              models.Host
+		dbName            string
+	)
+
+	const agentImage = "quay.io/ocpmetal/assisted-installer-agent:v1.0.20.2"
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = events.NewMockHandler(ctrl)
+		dummy := &leader.DummyElector{}
+		hapi = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy, nil)
+		hostId = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+
+		host = hostutil.GenerateTestHost(hostId, clusterId, models.HostStatusInsufficient)
+		host.DiscoveryAgentVersion = "quay.io/ocpmetal/assisted-installer-agent:v0.9"
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	tests := []struct {
+		name     string
+		result   models.UpgradeAgentResult
+		severity string
+		message  string
+	}{
+		{
+			name:     "success",
+			result:   models.UpgradeAgentResultSuccess,
+			severity: models.EventSeverityInfo,
+			message:  fmt.Sprintf("agent was upgraded to %s", agentImage),
+		},
+		{
+			name:     "failure",
+			result:   models.UpgradeAgentResultFailure,
+			severity: models.EventSeverityError,
+			message:  fmt.Sprintf("failed to upgrade agent from quay.io/ocpmetal/assisted-installer-agent:v0.9 to %s", agentImage),
+		},
+	}
+
+	for i := range tests {
+		t := tests[i]
+		It(t.name, func() {
+			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterId, &hostId, t.severity,
+				fmt.Sprintf("Host %s: %s", hostutil.GetHostnameForMsg(&host), t.message), gomock.Any()).Times(1)
+			Expect(hapi.UpdateAgentUpgradeStatus(ctx, &host, &models.UpgradeAgentResponse{AgentImage: agentImage, Result: t.result}, db)).ShouldNot(HaveOccurred())
+
+			h := hostutil.GetHostFromDB(*host.ID, host.ClusterID, db)
+			status, err := hostutil.UnmarshalAgentUpgradeStatus(h.AgentUpgradeStatus)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.AgentImage).Should(Equal(agentImage))
+			Expect(status.Result).Should(Equal(t.result))
+		})
+	}
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+})
+
 var _ = Describe("UpdateKubeKeyNS", func() {
 	var (
 		ctx               = context.Background()
@@ -28,6 +28,13 @@ func NewAPIVIPConnectivityCheckCmd(log logrus.FieldLogger, db *gorm.DB, connecti
 	}
 }
 
+func (c *apivipConnectivityCheckCmd) Schedule() StepSchedule {
+	return StepSchedule{
+		StepType:  models.StepTypeAPIVipConnectivityCheck,
+		DependsOn: []models.StepType{models.StepTypeInventory},
+	}
+}
+
 func (c *apivipConnectivityCheckCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
 	var cluster common.Cluster
 	if err := c.db.First(&cluster, "id = ?", host.ClusterID).Error; err != nil {
@@ -2,6 +2,7 @@ package hostcommands
 
 import (
 	"context"
+	"time"
 
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
@@ -9,9 +10,31 @@ import (
 
 type CommandGetter interface {
 	GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error)
+	// Schedule describes when the steps of the command are due. A zero schedule means that
+	// the steps are sent on every GetNextSteps call.
+	Schedule() StepSchedule
+}
+
+// StepSchedule is the scheduling metadata a command declares for the steps it generates
+type StepSchedule struct {
+	// StepType is the type of the steps generated by the command. Only commands that set it are tracked.
+	StepType models.StepType
+	// MinInterval is the minimal time between two consecutive sends of the step to the same host
+	MinInterval time.Duration
+	// DependsOn lists step types whose result must be available before the step is sent
+	DependsOn []models.StepType
+	// Fingerprint summarizes the input of the step. When not set, the step command and arguments are used.
+	Fingerprint func(host *models.Host, step *models.Step) string
+	// IsFresh reports whether the last successful result is still valid at the given time for a step with the given
+	// fingerprint. Fresh steps are not sent.
+	IsFresh func(fingerprint string, last StepResult, now time.Time) bool
 }
 
 type baseCmd struct {
 	CommandGetter
 	log logrus.FieldLogger
 }
+
+func (b *baseCmd) Schedule() StepSchedule {
+	return StepSchedule{}
+}
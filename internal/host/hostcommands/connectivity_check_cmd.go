@@ -2,6 +2,9 @@ package hostcommands
 
 import (
 	"context"
+	"encoding/json"
+	"sort"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	"github.com/openshift/assisted-service/internal/connectivity"
@@ -9,6 +12,9 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// The connectivity check is skipped as long as the peers and interfaces are unchanged, but not for longer than this
+const connectivityCheckMaxAge = 10 * time.Minute
+
 type connectivityCheckCmd struct {
 	baseCmd
 	db                     *gorm.DB
@@ -58,3 +64,40 @@ func (c *connectivityCheckCmd) GetSteps(ctx context.Context, host *models.Host)
 	}
 	return []*models.Step{step}, nil
 }
+
+func (c *connectivityCheckCmd) Schedule() StepSchedule {
+	return StepSchedule{
+		StepType:    models.StepTypeConnectivityCheck,
+		DependsOn:   []models.StepType{models.StepTypeInventory},
+		Fingerprint: connectivityCheckFingerprint,
+		IsFresh: func(fingerprint string, last StepResult, now time.Time) bool {
+			return last.Fingerprint == fingerprint && now.Sub(last.SucceededAt) < connectivityCheckMaxAge
+		},
+	}
+}
+
+// connectivityCheckFingerprint summarizes the peers the host is asked to check and the interfaces of the host itself
+func connectivityCheckFingerprint(host *models.Host, step *models.Step) string {
+	var peers models.ConnectivityCheckParams
+	if len(step.Args) > 0 {
+		if err := json.Unmarshal([]byte(step.Args[len(step.Args)-1]), &peers); err != nil {
+			return ""
+		}
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].HostID.String() < peers[j].HostID.String()
+	})
+	peersData, err := json.Marshal(peers)
+	if err != nil {
+		return ""
+	}
+	var inventory models.Inventory
+	if err = json.Unmarshal([]byte(host.Inventory), &inventory); err != nil {
+		return ""
+	}
+	interfacesData, err := json.Marshal(inventory.Interfaces)
+	if err != nil {
+		return ""
+	}
+	return hashStrings(string(peersData), string(interfacesData))
+}
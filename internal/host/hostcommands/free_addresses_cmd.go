@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/alessio/shellescape"
 	"github.com/openshift/assisted-service/models"
@@ -12,6 +13,9 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// Scanning the host networks for free addresses is expensive, so it is not repeated on every step request
+const freeAddressesMinInterval = 3 * time.Minute
+
 type freeAddressesCmd struct {
 	baseCmd
 	freeAddressesImage string
@@ -60,6 +64,14 @@ func (f *freeAddressesCmd) prepareParam(host *models.Host) (string, error) {
 	return string(b), nil
 }
 
+func (f *freeAddressesCmd) Schedule() StepSchedule {
+	return StepSchedule{
+		StepType:    models.StepTypeFreeNetworkAddresses,
+		MinInterval: freeAddressesMinInterval,
+		DependsOn:   []models.StepType{models.StepTypeInventory},
+	}
+}
+
 func (f *freeAddressesCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
 	param, err := f.prepareParam(host)
 	if err != nil {
@@ -17,31 +17,69 @@ import (
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/transaction"
 	"github.com/sirupsen/logrus"
 )
 
 //go:generate mockgen -package=hostcommands -destination=mock_instruction_api.go . InstructionApi
 type InstructionApi interface {
 	GetNextSteps(ctx context.Context, host *models.Host) (models.Steps, error)
+	// RecordStepSuccess marks a step as successfully handled, so its schedule can take the result into account
+	RecordStepSuccess(ctx context.Context, host *models.Host, stepType models.StepType, stepID string)
 }
 
 const (
 	defaultNextInstructionInSec      = int64(60)
 	defaultBackedOffInstructionInSec = int64(120)
+	// minNextInstructionInSec bounds how soon a host is asked to come back for steps that become due
+	minNextInstructionInSec = int64(10)
 )
 
-type StepsStruct struct {
-	Commands      []CommandGetter
-	NextStepInSec int64
+// backedOffStates are the host states in which the host is polled less often, since it has nothing to report
+var backedOffStates = map[string]bool{
+	models.HostStatusDisconnected: true,
+	models.HostStatusInstalling:   true,
+	models.HostStatusDisabled:     true,
+	models.HostStatusResetting:    true,
+	models.HostStatusError:        true,
+	models.HostStatusCancelled:    true,
 }
 
-type stateToStepsMap map[string]StepsStruct
+// stateNextInstructionInSec is the longest a host in the given state waits before it asks for steps again
+func stateNextInstructionInSec(state string) int64 {
+	if backedOffStates[state] {
+		return defaultBackedOffInstructionInSec
+	}
+	return defaultNextInstructionInSec
+}
+
+// registeredCommand tells in which host states a command generates steps, for hosts of clusters being installed and
+// for hosts added to installed clusters
+type registeredCommand struct {
+	cmd        CommandGetter
+	states     []string
+	day2States []string
+}
+
+func (r registeredCommand) runsIn(state string, day2 bool) bool {
+	states := r.states
+	if day2 {
+		states = r.day2States
+	}
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
 
 type InstructionManager struct {
-	log                           logrus.FieldLogger
-	db                            *gorm.DB
-	installingClusterStateToSteps stateToStepsMap
-	addHostsClusterToSteps        stateToStepsMap
+	log       logrus.FieldLogger
+	db        *gorm.DB
+	scheduler *stepScheduler
+	// commands are listed by priority, the steps of the first commands are sent first
+	commands []registeredCommand
 }
 
 type InstructionConfig struct {
@@ -73,36 +111,29 @@ func NewInstructionManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hard
 	ntpSynchronizerCmd := NewNtpSyncCmd(log, instructionConfig.AgentImage, db)
 	diskPerfCheckCmd := NewDiskPerfCheckCmd(log, instructionConfig.AgentImage, hwValidator, instructionConfig.DiskCheckTimeout.Seconds())
 	imageAvailabilityCmd := NewImageAvailabilityCmd(log, db, ocRelease, versionHandler, instructionConfig)
+	upgradeAgentCmd := NewUpgradeAgentCmd(log, instructionConfig.AgentImage)
 
+	discoveryStates := []string{models.HostStatusKnown, models.HostStatusInsufficient, models.HostStatusPendingForInput}
 	return &InstructionManager{
-		log: log,
-		db:  db,
-		installingClusterStateToSteps: stateToStepsMap{
-			models.HostStatusKnown:                    {[]CommandGetter{connectivityCmd, freeAddressesCmd, dhcpAllocateCmd, inventoryCmd, ntpSynchronizerCmd}, defaultNextInstructionInSec},
-			models.HostStatusInsufficient:             {[]CommandGetter{inventoryCmd, connectivityCmd, freeAddressesCmd, dhcpAllocateCmd, ntpSynchronizerCmd}, defaultNextInstructionInSec},
-			models.HostStatusDisconnected:             {[]CommandGetter{inventoryCmd}, defaultBackedOffInstructionInSec},
-			models.HostStatusDiscovering:              {[]CommandGetter{inventoryCmd}, defaultNextInstructionInSec},
-			models.HostStatusPendingForInput:          {[]CommandGetter{inventoryCmd, connectivityCmd, freeAddressesCmd, dhcpAllocateCmd, ntpSynchronizerCmd}, defaultNextInstructionInSec},
-			models.HostStatusInstalling:               {[]CommandGetter{installCmd, dhcpAllocateCmd}, defaultBackedOffInstructionInSec},
-			models.HostStatusInstallingInProgress:     {[]CommandGetter{inventoryCmd, dhcpAllocateCmd}, defaultNextInstructionInSec}, //TODO inventory step here is a temporary solution until format command is moved to a different state
-			models.HostStatusPreparingForInstallation: {[]CommandGetter{dhcpAllocateCmd, diskPerfCheckCmd, imageAvailabilityCmd}, defaultNextInstructionInSec},
-			models.HostStatusDisabled:                 {[]CommandGetter{}, defaultBackedOffInstructionInSec},
-			models.HostStatusResetting:                {[]CommandGetter{resetCmd}, defaultBackedOffInstructionInSec},
-			models.HostStatusError:                    {[]CommandGetter{logsCmd, stopCmd}, defaultBackedOffInstructionInSec},
-			models.HostStatusCancelled:                {[]CommandGetter{logsCmd, stopCmd}, defaultBackedOffInstructionInSec},
-		},
-		addHostsClusterToSteps: stateToStepsMap{
-			models.HostStatusKnown:                {[]CommandGetter{connectivityCmd, apivipConnectivityCmd, inventoryCmd, ntpSynchronizerCmd}, defaultNextInstructionInSec},
-			models.HostStatusInsufficient:         {[]CommandGetter{inventoryCmd, connectivityCmd, apivipConnectivityCmd, ntpSynchronizerCmd}, defaultNextInstructionInSec},
-			models.HostStatusDisconnected:         {[]CommandGetter{inventoryCmd}, defaultBackedOffInstructionInSec},
-			models.HostStatusDiscovering:          {[]CommandGetter{inventoryCmd, ntpSynchronizerCmd}, defaultNextInstructionInSec},
-			models.HostStatusPendingForInput:      {[]CommandGetter{inventoryCmd, connectivityCmd, apivipConnectivityCmd}, defaultNextInstructionInSec},
-			models.HostStatusInstalling:           {[]CommandGetter{installCmd}, defaultBackedOffInstructionInSec},
-			models.HostStatusInstallingInProgress: {[]CommandGetter{}, defaultNextInstructionInSec},
-			models.HostStatusDisabled:             {[]CommandGetter{}, defaultBackedOffInstructionInSec},
-			models.HostStatusResetting:            {[]CommandGetter{resetCmd}, defaultBackedOffInstructionInSec},
-			models.HostStatusError:                {[]CommandGetter{stopCmd}, defaultBackedOffInstructionInSec},
-			models.HostStatusCancelled:            {[]CommandGetter{stopCmd}, defaultBackedOffInstructionInSec},
+		log:       log,
+		db:        db,
+		scheduler: newStepScheduler(),
+		commands: []registeredCommand{
+			{upgradeAgentCmd, append([]string{models.HostStatusDiscovering}, discoveryStates...), append([]string{models.HostStatusDiscovering}, discoveryStates...)},
+			{installCmd, []string{models.HostStatusInstalling}, []string{models.HostStatusInstalling}},
+			{resetCmd, []string{models.HostStatusResetting}, []string{models.HostStatusResetting}},
+			{logsCmd, []string{models.HostStatusError, models.HostStatusCancelled}, nil},
+			{stopCmd, []string{models.HostStatusError, models.HostStatusCancelled}, []string{models.HostStatusError, models.HostStatusCancelled}},
+			//TODO inventory step while installing is a temporary solution until format command is moved to a different state
+			{inventoryCmd, append([]string{models.HostStatusDiscovering, models.HostStatusDisconnected, models.HostStatusInstallingInProgress}, discoveryStates...),
+				append([]string{models.HostStatusDiscovering, models.HostStatusDisconnected}, discoveryStates...)},
+			{connectivityCmd, discoveryStates, discoveryStates},
+			{apivipConnectivityCmd, nil, discoveryStates},
+			{freeAddressesCmd, discoveryStates, nil},
+			{dhcpAllocateCmd, append([]string{models.HostStatusPreparingForInstallation, models.HostStatusInstalling, models.HostStatusInstallingInProgress}, discoveryStates...), nil},
+			{diskPerfCheckCmd, []string{models.HostStatusPreparingForInstallation}, nil},
+			{imageAvailabilityCmd, []string{models.HostStatusPreparingForInstallation}, nil},
+			{ntpSynchronizerCmd, discoveryStates, []string{models.HostStatusDiscovering, models.HostStatusKnown, models.HostStatusInsufficient}},
 		},
 	}
 }
@@ -116,40 +147,115 @@ func (i *InstructionManager) GetNextSteps(ctx context.Context, host *models.Host
 	log.Infof("GetNextSteps cluster: ,<%s> host: <%s>, host status: <%s>", ClusterID, hostID, hostStatus)
 
 	returnSteps := models.Steps{}
-	stateToSteps := i.installingClusterStateToSteps
-	if hostutil.IsDay2Host(host) {
-		stateToSteps = i.addHostsClusterToSteps
+	returnSteps.PostStepAction = swag.String(models.StepsPostStepActionContinue)
+	nextInstruction := time.Duration(stateNextInstructionInSec(hostStatus)) * time.Second
+	day2 := hostutil.IsDay2Host(host)
+
+	// The steps are generated without locking the host, and their sending is recorded in a short transaction afterwards
+	read, err := loadStepRecords(i.db, host)
+	if err != nil {
+		// Without the records all the steps are due, which only costs the agent some work
+		log.WithError(err).Warnf("Failed to load the step records of host <%s>", hostID)
 	}
+	records := read.clone()
 
-	returnSteps.PostStepAction = swag.String(models.StepsPostStepActionContinue)
-	if cmdsMap, ok := stateToSteps[hostStatus]; ok {
-		//need to add the step id
-		returnSteps.NextInstructionSeconds = cmdsMap.NextStepInSec
-		for _, cmd := range cmdsMap.Commands {
-			steps, err := cmd.GetSteps(ctx, host)
-			if err != nil {
-				// Allow to return additional steps if the current one failed
-				log.WithError(err).Warnf("Failed to generate steps for command %T", cmd)
-				continue
-			}
-			if steps == nil {
-				continue
-			}
-			for _, step := range steps {
-				if step.StepID == "" {
-					step.StepID = createStepID(step.StepType)
-				}
+	for _, registered := range i.commands {
+		if !registered.runsIn(hostStatus, day2) {
+			continue
+		}
+		cmd := registered.cmd
+		schedule := cmd.Schedule()
+		if dueIn, held := i.scheduler.nextDueIn(records, schedule); held && dueIn > 0 && dueIn < nextInstruction {
+			nextInstruction = dueIn
+		}
+		if !i.scheduler.isDue(host, records, schedule) {
+			log.Debugf("Step %s is not due for host <%s>", schedule.StepType, hostID)
+			continue
+		}
+		steps, err := cmd.GetSteps(ctx, host)
+		if err != nil {
+			// Allow to return additional steps if the current one failed
+			log.WithError(err).Warnf("Failed to generate steps for command %T", cmd)
+			continue
+		}
+		if steps == nil {
+			continue
+		}
+		for _, step := range steps {
+			if step.StepID == "" {
+				step.StepID = createStepID(step.StepType)
 			}
+		}
+		steps = i.scheduler.filterFresh(host, records, schedule, steps)
 
-			returnSteps.Instructions = append(returnSteps.Instructions, steps...)
+		// An incompatible agent may mishandle the other steps, so it is only asked to upgrade itself
+		if schedule.StepType == models.StepTypeUpgradeAgent && len(steps) > 0 {
+			returnSteps.Instructions = steps
+			break
 		}
-	} else {
-		returnSteps.NextInstructionSeconds = defaultNextInstructionInSec
+
+		returnSteps.Instructions = append(returnSteps.Instructions, steps...)
+	}
+
+	// A step that was held back is due before the next poll, so the host is asked to come back when it is due
+	returnSteps.NextInstructionSeconds = int64(nextInstruction.Seconds())
+	if returnSteps.NextInstructionSeconds < minNextInstructionInSec {
+		returnSteps.NextInstructionSeconds = minNextInstructionInSec
+	}
+
+	if read != nil {
+		returnSteps.Instructions = i.recordSentSteps(ctx, host, read, records, returnSteps.Instructions)
 	}
 	logSteps(returnSteps, ClusterID, hostID, log)
 	return returnSteps, nil
 }
 
+// recordSentSteps saves the sending of the steps in the records of the host while its row is locked, and returns the
+// steps that were not sent by another instance in the meantime
+func (i *InstructionManager) recordSentSteps(ctx context.Context, host *models.Host, read, generated stepRecords, steps []*models.Step) []*models.Step {
+	log := logutil.FromContext(ctx, i.log)
+	tx := i.db.Begin()
+	locked, err := loadStepRecords(transaction.AddForUpdateQueryOption(tx), host)
+	if err != nil {
+		log.WithError(err).Warnf("Failed to load the step records of host <%s>", host.ID)
+		tx.Rollback()
+		return steps
+	}
+	steps = i.scheduler.recordSent(locked, read, generated, steps)
+	if err = saveStepRecords(tx, host, locked); err != nil {
+		log.WithError(err).Warnf("Failed to save the step records of host <%s>", host.ID)
+		tx.Rollback()
+		return steps
+	}
+	if err = tx.Commit().Error; err != nil {
+		log.WithError(err).Warnf("Failed to commit the step records of host <%s>", host.ID)
+	}
+	return steps
+}
+
+func (i *InstructionManager) RecordStepSuccess(ctx context.Context, host *models.Host, stepType models.StepType, stepID string) {
+	log := logutil.FromContext(ctx, i.log)
+	tx := i.db.Begin()
+	records, err := loadStepRecords(transaction.AddForUpdateQueryOption(tx), host)
+	if err != nil {
+		log.WithError(err).Warnf("Failed to load the step records of host <%s>", host.ID)
+		tx.Rollback()
+		return
+	}
+	if !i.scheduler.recordSuccess(records, stepType, stepID) {
+		tx.Rollback()
+		return
+	}
+	if err = saveStepRecords(tx, host, records); err != nil {
+		log.WithError(err).Warnf("Failed to save the step records of host <%s>", host.ID)
+		tx.Rollback()
+		return
+	}
+	if err = tx.Commit().Error; err != nil {
+		log.WithError(err).Warnf("Failed to commit the step records of host <%s>", host.ID)
+	}
+}
+
 func createStepID(stepType models.StepType) string {
 	return fmt.Sprintf("%s-%s", stepType, uuid.New().String()[:8])
 }
@@ -83,8 +83,8 @@ var _ = Describe("instruction_manager", func() {
 			})
 			It("known", func() {
 				checkStep(models.HostStatusKnown, []models.StepType{
-					models.StepTypeConnectivityCheck, models.StepTypeFreeNetworkAddresses,
-					models.StepTypeInventory, models.StepTypeNtpSynchronizer,
+					models.StepTypeInventory, models.StepTypeConnectivityCheck,
+					models.StepTypeFreeNetworkAddresses, models.StepTypeNtpSynchronizer,
 				})
 			})
 			It("disconnected", func() {
@@ -131,6 +131,46 @@ var _ = Describe("instruction_manager", func() {
 					models.StepTypeResetInstallation,
 				})
 			})
+			It("known - skip steps that are not due", func() {
+				mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID, host.ID, gomock.Any(), gomock.Any(), gomock.Any())
+				_, err := hostutil.UpdateHostStatus(ctx, common.GetTestLog(), db, mockEvents, host.ClusterID, *host.ID, *host.Status, models.HostStatusKnown, "")
+				Expect(err).ShouldNot(HaveOccurred())
+				h := hostutil.GetHostFromDB(*host.ID, host.ClusterID, db)
+				cnValidator.EXPECT().GetHostValidInterfaces(gomock.Any()).Return([]*models.Interface{
+					{
+						Name: "eth0",
+						IPV4Addresses: []string{
+							"1.2.3.10/24",
+						},
+						MacAddress: "52:54:00:09:de:93",
+					},
+				}, nil).Times(4)
+				stepsReply, stepsErr = instMng.GetNextSteps(ctx, &h.Host)
+				Expect(stepsErr).ShouldNot(HaveOccurred())
+				Expect(stepsReply.Instructions).To(HaveLen(4))
+				for _, step := range stepsReply.Instructions {
+					instMng.RecordStepSuccess(ctx, &h.Host, step.StepType, step.StepID)
+				}
+
+				By("connectivity check is fresh, inventory was just collected and free addresses were just scanned")
+				stepsReply, stepsErr = instMng.GetNextSteps(ctx, &h.Host)
+				Expect(stepsErr).ShouldNot(HaveOccurred())
+				Expect(stepsReply.Instructions).To(HaveLen(1))
+				Expect(stepsReply.Instructions[0].StepType).To(Equal(models.StepTypeNtpSynchronizer))
+
+				By("the schedule is shared by instances of the service")
+				other := NewInstructionManager(common.GetTestLog(), db, hwValidator, mockRelease, instructionConfig, cnValidator, mockEvents, mockVersions)
+				stepsReply, stepsErr = other.GetNextSteps(ctx, &h.Host)
+				Expect(stepsErr).ShouldNot(HaveOccurred())
+				Expect(stepsReply.Instructions).To(HaveLen(1))
+
+				By("the host comes back when a held back step is due")
+				other.scheduler.now = func() time.Time { return time.Now().Add(inventoryMinInterval - 30*time.Second) }
+				stepsReply, stepsErr = other.GetNextSteps(ctx, &h.Host)
+				Expect(stepsErr).ShouldNot(HaveOccurred())
+				Expect(stepsReply.NextInstructionSeconds).To(BeNumerically("<=", 30))
+				Expect(stepsReply.NextInstructionSeconds).To(BeNumerically(">=", minNextInstructionInSec))
+			})
 		})
 	})
 
@@ -152,8 +192,8 @@ var _ = Describe("instruction_manager", func() {
 			})
 			It("known", func() {
 				checkStep(models.HostStatusKnown, []models.StepType{
-					models.StepTypeConnectivityCheck, models.StepTypeFreeNetworkAddresses,
-					models.StepTypeDhcpLeaseAllocate, models.StepTypeInventory,
+					models.StepTypeInventory, models.StepTypeConnectivityCheck,
+					models.StepTypeFreeNetworkAddresses, models.StepTypeDhcpLeaseAllocate,
 					models.StepTypeNtpSynchronizer,
 				})
 			})
@@ -242,11 +282,7 @@ func checkStepsByState(state string, host *models.Host, db *gorm.DB, mockEvents
 
 	stepsReply, stepsErr := instMng.GetNextSteps(ctx, &h.Host)
 	ExpectWithOffset(1, stepsReply.Instructions).To(HaveLen(len(expectedStepTypes)))
-	if stateValues, ok := instMng.installingClusterStateToSteps[state]; ok {
-		Expect(stepsReply.NextInstructionSeconds).Should(Equal(stateValues.NextStepInSec))
-	} else {
-		Expect(stepsReply.NextInstructionSeconds).Should(Equal(defaultNextInstructionInSec))
-	}
+	Expect(stepsReply.NextInstructionSeconds).Should(Equal(stateNextInstructionInSec(state)))
 
 	ExpectWithOffset(1, *stepsReply.PostStepAction).Should(Equal(models.StepsPostStepActionContinue))
 
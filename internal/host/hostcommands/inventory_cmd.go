@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
 )
 
+// The hardware of a host rarely changes, so collecting its inventory is not repeated on every step request
+const inventoryMinInterval = 3 * time.Minute
+
 type inventoryCmd struct {
 	baseCmd
 	inventoryImage string
@@ -21,6 +25,13 @@ func NewInventoryCmd(log logrus.FieldLogger, inventoryImage string) *inventoryCm
 	}
 }
 
+func (h *inventoryCmd) Schedule() StepSchedule {
+	return StepSchedule{
+		StepType:    models.StepTypeInventory,
+		MinInterval: inventoryMinInterval,
+	}
+}
+
 func (h *inventoryCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
 	podmanRunCmd := strings.Join([]string{
 		"podman", "run", "--privileged", "--net=host", "--rm", "--quiet",
@@ -48,3 +48,15 @@ func (mr *MockInstructionApiMockRecorder) GetNextSteps(arg0, arg1 interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextSteps", reflect.TypeOf((*MockInstructionApi)(nil).GetNextSteps), arg0, arg1)
 }
+
+// RecordStepSuccess mocks base method
+func (m *MockInstructionApi) RecordStepSuccess(arg0 context.Context, arg1 *models.Host, arg2 models.StepType, arg3 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordStepSuccess", arg0, arg1, arg2, arg3)
+}
+
+// RecordStepSuccess indicates an expected call of RecordStepSuccess
+func (mr *MockInstructionApiMockRecorder) RecordStepSuccess(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordStepSuccess", reflect.TypeOf((*MockInstructionApi)(nil).RecordStepSuccess), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,228 @@
+package hostcommands
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// stepRetryInterval is the time after which a step that was not answered successfully is sent again, unless the
+// minimal interval of its schedule is shorter
+const stepRetryInterval = time.Minute
+
+// StepResult is the last successful reply of a step type received from a host
+type StepResult struct {
+	Fingerprint string    `json:"fingerprint,omitempty"`
+	SucceededAt time.Time `json:"succeeded_at"`
+}
+
+type stepRecord struct {
+	LastSentAt      time.Time   `json:"last_sent_at"`
+	LastSentStepID  string      `json:"last_sent_step_id,omitempty"`
+	LastFingerprint string      `json:"last_fingerprint,omitempty"`
+	LastSuccess     *StepResult `json:"last_success,omitempty"`
+}
+
+// answered tells whether the last sent step succeeded
+func (r *stepRecord) answered() bool {
+	return r.LastSuccess != nil && !r.LastSuccess.SucceededAt.Before(r.LastSentAt)
+}
+
+// stepRecords tracks, per step type, when a step was last sent to a host and last succeeded. The records are stored
+// in the host row, such that all the service instances share them and they survive restarts.
+type stepRecords map[models.StepType]*stepRecord
+
+func (r stepRecords) clone() stepRecords {
+	ret := make(stepRecords, len(r))
+	for stepType, record := range r {
+		copied := *record
+		ret[stepType] = &copied
+	}
+	return ret
+}
+
+// stepResultOnHost tells whether the result of a step type is already stored on the host. It is used to
+// resolve dependencies of hosts that were discovered before their steps were recorded.
+var stepResultOnHost = map[models.StepType]func(host *models.Host) bool{
+	models.StepTypeInventory:               func(host *models.Host) bool { return host.Inventory != "" },
+	models.StepTypeConnectivityCheck:       func(host *models.Host) bool { return host.Connectivity != "" },
+	models.StepTypeFreeNetworkAddresses:    func(host *models.Host) bool { return host.FreeAddresses != "" },
+	models.StepTypeNtpSynchronizer:         func(host *models.Host) bool { return host.NtpSources != "" },
+	models.StepTypeAPIVipConnectivityCheck: func(host *models.Host) bool { return host.APIVipConnectivity != "" },
+}
+
+// loadStepRecords reads the step records of a host. The row of the host is locked when db is a query for update.
+func loadStepRecords(db *gorm.DB, host *models.Host) (stepRecords, error) {
+	var h common.Host
+	err := db.Select("step_records").
+		Take(&h, "id = ? and cluster_id = ?", host.ID.String(), host.ClusterID.String()).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the step records of host %s", host.ID)
+	}
+	records := make(stepRecords)
+	if h.StepRecords == "" {
+		return records, nil
+	}
+	if err = json.Unmarshal([]byte(h.StepRecords), &records); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the step records of host %s", host.ID)
+	}
+	return records, nil
+}
+
+func saveStepRecords(tx *gorm.DB, host *models.Host, records stepRecords) error {
+	data, err := json.Marshal(records)
+	if err != nil {
+		return errors.Wrapf(err, "failed to serialize the step records of host %s", host.ID)
+	}
+	// The records are not a change of the host, so they do not touch its update time
+	return errors.Wrapf(tx.Model(&common.Host{}).Where("id = ? and cluster_id = ?", host.ID.String(), host.ClusterID.String()).
+		UpdateColumn("step_records", string(data)).Error, "failed to save the step records of host %s", host.ID)
+}
+
+// stepScheduler decides which steps are due according to the schedules of their commands and the step records
+type stepScheduler struct {
+	now func() time.Time
+}
+
+func newStepScheduler() *stepScheduler {
+	return &stepScheduler{now: time.Now}
+}
+
+// isDue checks the interval and the dependencies of a schedule, before the steps are generated
+func (s *stepScheduler) isDue(host *models.Host, records stepRecords, schedule StepSchedule) bool {
+	if schedule.StepType == "" {
+		return true
+	}
+	if !s.dependenciesMet(host, records, schedule) {
+		return false
+	}
+	record, ok := records[schedule.StepType]
+	if !ok || schedule.MinInterval == 0 {
+		return true
+	}
+	return s.now().Sub(record.LastSentAt) >= interval(record, schedule)
+}
+
+// interval returns the time between two sends of a step, which is shorter when the last sent step failed or was not
+// answered, such that a missing result is not delayed for the whole minimal interval
+func interval(record *stepRecord, schedule StepSchedule) time.Duration {
+	if !record.answered() && stepRetryInterval < schedule.MinInterval {
+		return stepRetryInterval
+	}
+	return schedule.MinInterval
+}
+
+func (s *stepScheduler) dependenciesMet(host *models.Host, records stepRecords, schedule StepSchedule) bool {
+	for _, dependency := range schedule.DependsOn {
+		if record, ok := records[dependency]; ok && record.LastSuccess != nil {
+			continue
+		}
+		if onHost, ok := stepResultOnHost[dependency]; ok && onHost(host) {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// nextDueIn returns how long until a step that is held back by the minimal interval of its schedule becomes due.
+// Steps that wait for the result of another step are not taken into account, since that result may never come.
+func (s *stepScheduler) nextDueIn(records stepRecords, schedule StepSchedule) (time.Duration, bool) {
+	if schedule.StepType == "" || schedule.MinInterval == 0 {
+		return 0, false
+	}
+	record, ok := records[schedule.StepType]
+	if !ok {
+		return 0, false
+	}
+	return record.LastSentAt.Add(interval(record, schedule)).Sub(s.now()), true
+}
+
+// filterFresh drops the steps whose last successful result is still fresh and records the sending of the others
+func (s *stepScheduler) filterFresh(host *models.Host, records stepRecords, schedule StepSchedule, steps []*models.Step) []*models.Step {
+	if schedule.StepType == "" {
+		return steps
+	}
+	ret := make([]*models.Step, 0, len(steps))
+	for _, step := range steps {
+		fingerprint := stepFingerprint(host, step, schedule)
+		record, ok := records[step.StepType]
+		if !ok {
+			record = &stepRecord{}
+			records[step.StepType] = record
+		}
+		if schedule.IsFresh != nil && record.LastSuccess != nil && schedule.IsFresh(fingerprint, *record.LastSuccess, s.now()) {
+			continue
+		}
+		record.LastSentAt = s.now()
+		record.LastSentStepID = step.StepID
+		record.LastFingerprint = fingerprint
+		ret = append(ret, step)
+	}
+	return ret
+}
+
+// recordSent records the sending of the steps, which were generated with the records that were read, in the records
+// that were locked afterwards. A step that another instance sent since the records were read is dropped, such that
+// a host is not sent the same step twice.
+func (s *stepScheduler) recordSent(locked, read, generated stepRecords, steps []*models.Step) []*models.Step {
+	ret := make([]*models.Step, 0, len(steps))
+	for _, step := range steps {
+		sent, ok := generated[step.StepType]
+		if !ok || sent.LastSentStepID != step.StepID {
+			ret = append(ret, step)
+			continue
+		}
+		var readStepID string
+		if record, ok := read[step.StepType]; ok {
+			readStepID = record.LastSentStepID
+		}
+		record, ok := locked[step.StepType]
+		if !ok {
+			record = &stepRecord{}
+			locked[step.StepType] = record
+		}
+		if record.LastSentStepID != readStepID {
+			continue
+		}
+		record.LastSentAt = sent.LastSentAt
+		record.LastSentStepID = sent.LastSentStepID
+		record.LastFingerprint = sent.LastFingerprint
+		ret = append(ret, step)
+	}
+	return ret
+}
+
+// recordSuccess marks the last sent step of the given type as successfully handled. A reply to an older
+// step only updates the success time, since its input is unknown.
+func (s *stepScheduler) recordSuccess(records stepRecords, stepType models.StepType, stepID string) bool {
+	record, ok := records[stepType]
+	if !ok {
+		return false
+	}
+	result := &StepResult{SucceededAt: s.now()}
+	if record.LastSentStepID == stepID {
+		result.Fingerprint = record.LastFingerprint
+	}
+	record.LastSuccess = result
+	return true
+}
+
+func stepFingerprint(host *models.Host, step *models.Step, schedule StepSchedule) string {
+	if schedule.Fingerprint != nil {
+		return schedule.Fingerprint(host, step)
+	}
+	return hashStrings(append([]string{step.Command}, step.Args...)...)
+}
+
+func hashStrings(values ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(values, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,188 @@
+package hostcommands
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("step scheduler", func() {
+	var (
+		scheduler *stepScheduler
+		records   stepRecords
+		host      *models.Host
+		now       time.Time
+		schedule  StepSchedule
+	)
+
+	newStep := func(args ...string) *models.Step {
+		return &models.Step{
+			StepType: models.StepTypeFreeNetworkAddresses,
+			StepID:   createStepID(models.StepTypeFreeNetworkAddresses),
+			Command:  "podman",
+			Args:     args,
+		}
+	}
+
+	BeforeEach(func() {
+		now = time.Now()
+		scheduler = newStepScheduler()
+		scheduler.now = func() time.Time { return now }
+		records = make(stepRecords)
+		hostID := strfmt.UUID(uuid.New().String())
+		host = &models.Host{ID: &hostID, Inventory: "{}"}
+		schedule = StepSchedule{
+			StepType:    models.StepTypeFreeNetworkAddresses,
+			MinInterval: time.Minute,
+			IsFresh: func(fingerprint string, last StepResult, _ time.Time) bool {
+				return fingerprint == last.Fingerprint
+			},
+		}
+	})
+
+	It("zero schedule is always due", func() {
+		Expect(scheduler.isDue(host, records, StepSchedule{})).To(BeTrue())
+		steps := []*models.Step{newStep("a")}
+		Expect(scheduler.filterFresh(host, records, StepSchedule{}, steps)).To(Equal(steps))
+		Expect(records).To(BeEmpty())
+	})
+
+	It("min interval", func() {
+		Expect(scheduler.isDue(host, records, schedule)).To(BeTrue())
+		Expect(scheduler.filterFresh(host, records, schedule, []*models.Step{newStep("a")})).To(HaveLen(1))
+		now = now.Add(20 * time.Second)
+		Expect(scheduler.isDue(host, records, schedule)).To(BeFalse())
+		dueIn, held := scheduler.nextDueIn(records, schedule)
+		Expect(held).To(BeTrue())
+		Expect(dueIn).To(Equal(40 * time.Second))
+		now = now.Add(40 * time.Second)
+		Expect(scheduler.isDue(host, records, schedule)).To(BeTrue())
+	})
+
+	It("retries a step that was not answered before its min interval", func() {
+		schedule.MinInterval = 3 * time.Minute
+		step := newStep("a")
+		Expect(scheduler.filterFresh(host, records, schedule, []*models.Step{step})).To(HaveLen(1))
+		now = now.Add(stepRetryInterval)
+		Expect(scheduler.isDue(host, records, schedule)).To(BeTrue())
+
+		By("an answered step waits for its min interval")
+		step = newStep("a")
+		Expect(scheduler.filterFresh(host, records, schedule, []*models.Step{step})).To(HaveLen(1))
+		Expect(scheduler.recordSuccess(records, step.StepType, step.StepID)).To(BeTrue())
+		now = now.Add(stepRetryInterval)
+		Expect(scheduler.isDue(host, records, schedule)).To(BeFalse())
+		dueIn, held := scheduler.nextDueIn(records, schedule)
+		Expect(held).To(BeTrue())
+		Expect(dueIn).To(Equal(schedule.MinInterval - stepRetryInterval))
+	})
+
+	It("records the sending of steps that no other instance sent meanwhile", func() {
+		read := make(stepRecords)
+		generated := read.clone()
+		step := newStep("a")
+		Expect(scheduler.filterFresh(host, generated, schedule, []*models.Step{step})).To(HaveLen(1))
+		untracked := &models.Step{StepType: models.StepTypeExecute, StepID: createStepID(models.StepTypeExecute)}
+
+		locked := make(stepRecords)
+		Expect(scheduler.recordSent(locked, read, generated, []*models.Step{step, untracked})).To(Equal([]*models.Step{step, untracked}))
+		Expect(locked[step.StepType].LastSentStepID).To(Equal(step.StepID))
+
+		By("another instance sent the step since the records were read")
+		other := newStep("a")
+		generated = read.clone()
+		scheduler.filterFresh(host, generated, schedule, []*models.Step{other})
+		Expect(scheduler.recordSent(locked, read, generated, []*models.Step{other})).To(BeEmpty())
+		Expect(locked[step.StepType].LastSentStepID).To(Equal(step.StepID))
+	})
+
+	It("dependencies", func() {
+		schedule.DependsOn = []models.StepType{models.StepTypeConnectivityCheck}
+		Expect(scheduler.isDue(host, records, schedule)).To(BeFalse())
+		_, held := scheduler.nextDueIn(records, schedule)
+		Expect(held).To(BeFalse())
+
+		By("result stored on the host")
+		host.Connectivity = "{}"
+		Expect(scheduler.isDue(host, records, schedule)).To(BeTrue())
+
+		By("result recorded")
+		host.Connectivity = ""
+		step := &models.Step{StepType: models.StepTypeConnectivityCheck, StepID: createStepID(models.StepTypeConnectivityCheck)}
+		scheduler.filterFresh(host, records, StepSchedule{StepType: models.StepTypeConnectivityCheck}, []*models.Step{step})
+		Expect(scheduler.recordSuccess(records, step.StepType, step.StepID)).To(BeTrue())
+		Expect(scheduler.isDue(host, records, schedule)).To(BeTrue())
+	})
+
+	It("fresh result", func() {
+		step := newStep("a")
+		Expect(scheduler.filterFresh(host, records, schedule, []*models.Step{step})).To(HaveLen(1))
+
+		By("not fresh before a success is recorded")
+		Expect(scheduler.filterFresh(host, records, schedule, []*models.Step{newStep("a")})).To(HaveLen(1))
+
+		By("fresh once the same input succeeded")
+		step = newStep("a")
+		Expect(scheduler.filterFresh(host, records, schedule, []*models.Step{step})).To(HaveLen(1))
+		scheduler.recordSuccess(records, step.StepType, step.StepID)
+		Expect(scheduler.filterFresh(host, records, schedule, []*models.Step{newStep("a")})).To(BeEmpty())
+
+		By("not fresh when the input changed")
+		Expect(scheduler.filterFresh(host, records, schedule, []*models.Step{newStep("b")})).To(HaveLen(1))
+	})
+
+	It("success of an older step does not make the result fresh", func() {
+		older := newStep("a")
+		scheduler.filterFresh(host, records, schedule, []*models.Step{older})
+		scheduler.filterFresh(host, records, schedule, []*models.Step{newStep("a")})
+		scheduler.recordSuccess(records, older.StepType, older.StepID)
+		Expect(scheduler.filterFresh(host, records, schedule, []*models.Step{newStep("a")})).To(HaveLen(1))
+	})
+
+	It("success of a step that was never sent is ignored", func() {
+		Expect(scheduler.recordSuccess(records, models.StepTypeInventory, "inventory-1234")).To(BeFalse())
+	})
+
+	Context("stored records", func() {
+		var (
+			db     *gorm.DB
+			dbName string
+		)
+
+		BeforeEach(func() {
+			db, dbName = common.PrepareTestDB()
+			h := hostutil.GenerateTestHost(*host.ID, strfmt.UUID(uuid.New().String()), models.HostStatusKnown)
+			Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+			host = &h
+		})
+
+		AfterEach(func() {
+			common.DeleteTestDB(db, dbName)
+		})
+
+		It("are saved with the host", func() {
+			loaded, err := loadStepRecords(db, host)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(loaded).To(BeEmpty())
+
+			step := newStep("a")
+			scheduler.filterFresh(host, loaded, schedule, []*models.Step{step})
+			scheduler.recordSuccess(loaded, step.StepType, step.StepID)
+			Expect(saveStepRecords(db, host, loaded)).To(Succeed())
+
+			loaded, err = loadStepRecords(db, host)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(loaded).To(HaveKey(models.StepTypeFreeNetworkAddresses))
+			Expect(loaded[models.StepTypeFreeNetworkAddresses].LastSentStepID).To(Equal(step.StepID))
+			Expect(loaded[models.StepTypeFreeNetworkAddresses].LastSuccess).ToNot(BeNil())
+			Expect(scheduler.isDue(host, loaded, schedule)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,88 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+// Pulling the image and restarting the agent takes a while, so the upgrade is not requested again meanwhile
+const upgradeAgentMinInterval = 10 * time.Minute
+
+type upgradeAgentCmd struct {
+	baseCmd
+	agentImage string
+}
+
+func NewUpgradeAgentCmd(log logrus.FieldLogger, agentImage string) *upgradeAgentCmd {
+	return &upgradeAgentCmd{
+		baseCmd:    baseCmd{log: log},
+		agentImage: agentImage,
+	}
+}
+
+func (c *upgradeAgentCmd) Schedule() StepSchedule {
+	return StepSchedule{
+		StepType:    models.StepTypeUpgradeAgent,
+		MinInterval: upgradeAgentMinInterval,
+	}
+}
+
+func (c *upgradeAgentCmd) prepareParam() (string, error) {
+	request := models.UpgradeAgentRequest{
+		AgentImage: &c.agentImage,
+	}
+	b, err := json.Marshal(&request)
+	if err != nil {
+		c.log.WithError(err).Warn("Json marshal")
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (c *upgradeAgentCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
+	if hostutil.IsAgentCompatible(c.agentImage, host.DiscoveryAgentVersion) {
+		return nil, nil
+	}
+	// Only older agents are upgraded, replacing a newer agent with the service agent would downgrade it
+	if hostutil.IsAgentNewer(c.agentImage, host.DiscoveryAgentVersion) {
+		return nil, nil
+	}
+
+	status, err := hostutil.UnmarshalAgentUpgradeStatus(host.AgentUpgradeStatus)
+	if err != nil {
+		c.log.WithError(err).Warnf("Failed to parse agent upgrade status of host %s", host.ID.String())
+		return nil, err
+	}
+	// Don't retry an upgrade to the same image that already failed, the host requires a new discovery image
+	if status != nil && status.AgentImage == c.agentImage && status.Result == models.UpgradeAgentResultFailure {
+		return nil, nil
+	}
+
+	param, err := c.prepareParam()
+	if err != nil {
+		return nil, err
+	}
+
+	c.log.Infof("Host %s in cluster %s has incompatible agent %s, upgrading to %s",
+		host.ID.String(), host.ClusterID.String(), host.DiscoveryAgentVersion, c.agentImage)
+	step := &models.Step{
+		StepType: models.StepTypeUpgradeAgent,
+		Command:  "podman",
+		Args: []string{
+			"run", "--privileged", "--net=host", "--pid=host", "--rm", "--quiet",
+			"-v", "/var/log:/var/log",
+			"-v", "/run/systemd/journal/socket:/run/systemd/journal/socket",
+			"-v", "/usr/local/bin:/hostbin",
+			"-v", "/etc/systemd/system:/etc/systemd/system",
+			c.agentImage,
+			"upgrade_agent",
+			param,
+		},
+	}
+	return []*models.Step{step}, nil
+}
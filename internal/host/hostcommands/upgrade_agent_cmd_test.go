@@ -0,0 +1,82 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("upgrade agent", func() {
+	const agentImage = "quay.io/ocpmetal/assisted-installer-agent:v1.0.20.2"
+	ctx := context.Background()
+	var host models.Host
+	var upgradeCmd *upgradeAgentCmd
+
+	BeforeEach(func() {
+		upgradeCmd = NewUpgradeAgentCmd(common.GetTestLog(), agentImage)
+		host = hostutil.GenerateTestHost(strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String()), models.HostStatusDiscovering)
+	})
+
+	It("compatible agent", func() {
+		host.DiscoveryAgentVersion = "quay.io/ocpmetal/assisted-installer-agent:v1.0.20.1"
+		stepReply, stepErr := upgradeCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	It("incompatible agent", func() {
+		host.DiscoveryAgentVersion = "quay.io/ocpmetal/assisted-installer-agent:v0.9"
+		stepReply, stepErr := upgradeCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(HaveLen(1))
+		Expect(stepReply[0].StepType).To(Equal(models.StepTypeUpgradeAgent))
+		Expect(stepReply[0].Args).To(ContainElement(agentImage))
+		var request models.UpgradeAgentRequest
+		Expect(json.Unmarshal([]byte(stepReply[0].Args[len(stepReply[0].Args)-1]), &request)).ToNot(HaveOccurred())
+		Expect(*request.AgentImage).To(Equal(agentImage))
+	})
+
+	It("newer patch version is compatible", func() {
+		host.DiscoveryAgentVersion = "quay.io/ocpmetal/assisted-installer-agent:v1.0.21"
+		stepReply, stepErr := upgradeCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	It("newer agent is not downgraded", func() {
+		host.DiscoveryAgentVersion = "quay.io/ocpmetal/assisted-installer-agent:v1.1.0"
+		stepReply, stepErr := upgradeCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	It("previous upgrade to the same image failed", func() {
+		host.DiscoveryAgentVersion = "quay.io/ocpmetal/assisted-installer-agent:v0.9"
+		status, err := hostutil.MarshalAgentUpgradeStatus(&models.UpgradeAgentResponse{AgentImage: agentImage, Result: models.UpgradeAgentResultFailure})
+		Expect(err).ToNot(HaveOccurred())
+		host.AgentUpgradeStatus = status
+		stepReply, stepErr := upgradeCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	It("previous upgrade to another image failed", func() {
+		host.DiscoveryAgentVersion = "quay.io/ocpmetal/assisted-installer-agent:v0.9"
+		status, err := hostutil.MarshalAgentUpgradeStatus(&models.UpgradeAgentResponse{
+			AgentImage: "quay.io/ocpmetal/assisted-installer-agent:v1.0.20.1",
+			Result:     models.UpgradeAgentResultFailure,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		host.AgentUpgradeStatus = status
+		stepReply, stepErr := upgradeCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(HaveLen(1))
+	})
+})
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"strings"
 
 	"github.com/go-openapi/swag"
+	"github.com/hashicorp/go-version"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
@@ -206,3 +208,76 @@ func UnmarshalConnectivityReport(reportStr string) (*models.ConnectivityReport,
 	}
 	return &report, nil
 }
+
+// imageTag returns the tag (or digest) of an image reference. A bare version, as reported by old agents, is returned as is.
+func imageTag(image string) string {
+	name := image[strings.LastIndex(image, "/")+1:]
+	if i := strings.LastIndexAny(name, ":@"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// IsAgentCompatible checks whether an agent reporting agentVersion can be used with a service configured with agentImage.
+// When both image tags are versions, the supported range is any version with the same major and minor as the service
+// agent image, including newer patch versions. Otherwise the tags must be identical.
+// An unknown agent version or agent image is considered compatible.
+func IsAgentCompatible(agentImage, agentVersion string) bool {
+	if agentImage == "" || agentVersion == "" {
+		return true
+	}
+	expectedTag := imageTag(agentImage)
+	actualTag := imageTag(agentVersion)
+	if expectedTag == actualTag {
+		return true
+	}
+	expected, err := version.NewVersion(expectedTag)
+	if err != nil {
+		return false
+	}
+	actual, err := version.NewVersion(actualTag)
+	if err != nil {
+		return false
+	}
+	expectedSegments := expected.Segments()
+	actualSegments := actual.Segments()
+	return expectedSegments[0] == actualSegments[0] && expectedSegments[1] == actualSegments[1]
+}
+
+// IsAgentNewer checks whether an agent reporting agentVersion is newer than the agent of a service configured with
+// agentImage. Such an agent is not replaced by the agent image, which would be a downgrade. Agents whose versions can't
+// be compared are not newer.
+func IsAgentNewer(agentImage, agentVersion string) bool {
+	if agentImage == "" || agentVersion == "" {
+		return false
+	}
+	expected, err := version.NewVersion(imageTag(agentImage))
+	if err != nil {
+		return false
+	}
+	actual, err := version.NewVersion(imageTag(agentVersion))
+	if err != nil {
+		return false
+	}
+	return actual.GreaterThan(expected)
+}
+
+func MarshalAgentUpgradeStatus(status *models.UpgradeAgentResponse) (string, error) {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// UnmarshalAgentUpgradeStatus returns the last agent upgrade result of the host, or nil if no upgrade was attempted
+func UnmarshalAgentUpgradeStatus(statusStr string) (*models.UpgradeAgentResponse, error) {
+	if statusStr == "" {
+		return nil, nil
+	}
+	var status models.UpgradeAgentResponse
+	if err := json.Unmarshal([]byte(statusStr), &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
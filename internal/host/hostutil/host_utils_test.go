@@ -88,6 +88,65 @@ var _ = Describe("Installation Disk selection", func() {
 	}
 })
 
+var _ = Describe("Agent compatibility", func() {
+	const agentImage = "quay.io/ocpmetal/assisted-installer-agent:v1.0.20.2"
+
+	for _, test := range []struct {
+		testName     string
+		agentImage   string
+		agentVersion string
+		compatible   bool
+	}{
+		{testName: "Unknown agent version", agentImage: agentImage, agentVersion: "", compatible: true},
+		{testName: "No agent image configured", agentImage: "", agentVersion: "v1.0.1", compatible: true},
+		{testName: "Same image", agentImage: agentImage, agentVersion: agentImage, compatible: true},
+		{testName: "Same tag from another registry", agentImage: agentImage, agentVersion: "registry:5000/agent:v1.0.20.2", compatible: true},
+		{testName: "Older patch version", agentImage: agentImage, agentVersion: "quay.io/ocpmetal/assisted-installer-agent:v1.0.19", compatible: true},
+		{testName: "Bare older patch version", agentImage: agentImage, agentVersion: "v1.0.20.1", compatible: true},
+		{testName: "Newer patch version", agentImage: agentImage, agentVersion: "v1.0.21", compatible: true},
+		{testName: "Newer minor version", agentImage: agentImage, agentVersion: "v1.1.0", compatible: false},
+		{testName: "Different major version", agentImage: agentImage, agentVersion: "v2.0.20.2", compatible: false},
+		{testName: "Same non version tag", agentImage: "quay.io/ocpmetal/assisted-installer-agent:latest", agentVersion: "quay.io/ocpmetal/assisted-installer-agent:latest", compatible: true},
+		{testName: "Different non version tag", agentImage: "quay.io/ocpmetal/assisted-installer-agent:latest", agentVersion: "quay.io/ocpmetal/assisted-installer-agent:ocm-2.3", compatible: false},
+		{testName: "Different digest", agentImage: "quay.io/ocpmetal/assisted-installer-agent@sha256:1234", agentVersion: "quay.io/ocpmetal/assisted-installer-agent@sha256:5678", compatible: false},
+	} {
+		test := test
+		It(test.testName, func() {
+			Expect(IsAgentCompatible(test.agentImage, test.agentVersion)).To(Equal(test.compatible))
+		})
+	}
+
+	for _, test := range []struct {
+		testName     string
+		agentVersion string
+		newer        bool
+	}{
+		{testName: "Newer patch version is newer", agentVersion: "v1.0.21", newer: true},
+		{testName: "Newer minor version is newer", agentVersion: "quay.io/ocpmetal/assisted-installer-agent:v1.1.0", newer: true},
+		{testName: "Older major version is not newer", agentVersion: "v0.9", newer: false},
+		{testName: "Same version is not newer", agentVersion: agentImage, newer: false},
+		{testName: "Non version tag is not newer", agentVersion: "quay.io/ocpmetal/assisted-installer-agent:latest", newer: false},
+		{testName: "Unknown agent version is not newer", agentVersion: "", newer: false},
+	} {
+		test := test
+		It(test.testName, func() {
+			Expect(IsAgentNewer(agentImage, test.agentVersion)).To(Equal(test.newer))
+		})
+	}
+
+	It("Agent upgrade status", func() {
+		status, err := UnmarshalAgentUpgradeStatus("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status).To(BeNil())
+		statusStr, err := MarshalAgentUpgradeStatus(&models.UpgradeAgentResponse{AgentImage: agentImage, Result: models.UpgradeAgentResultFailure})
+		Expect(err).NotTo(HaveOccurred())
+		status, err = UnmarshalAgentUpgradeStatus(statusStr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.AgentImage).To(Equal(agentImage))
+		Expect(status.Result).To(Equal(models.UpgradeAgentResultFailure))
+	})
+})
+
 func TestHostUtil(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "HostUtil Tests")
@@ -235,6 +235,18 @@ func (mr *MockAPIMockRecorder) PermanentHostsDeletion(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentHostsDeletion", reflect.TypeOf((*MockAPI)(nil).PermanentHostsDeletion), arg0)
 }
 
+// RecordStepSuccess mocks base method
+func (m *MockAPI) RecordStepSuccess(arg0 context.Context, arg1 *models.Host, arg2 models.StepType, arg3 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordStepSuccess", arg0, arg1, arg2, arg3)
+}
+
+// RecordStepSuccess indicates an expected call of RecordStepSuccess
+func (mr *MockAPIMockRecorder) RecordStepSuccess(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordStepSuccess", reflect.TypeOf((*MockAPI)(nil).RecordStepSuccess), arg0, arg1, arg2, arg3)
+}
+
 // RefreshInventory mocks base method
 func (m *MockAPI) RefreshInventory(arg0 context.Context, arg1 *common.Cluster, arg2 *models.Host, arg3 *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -389,6 +401,20 @@ func (mr *MockAPIMockRecorder) SetUploadLogsAt(arg0, arg1, arg2 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUploadLogsAt", reflect.TypeOf((*MockAPI)(nil).SetUploadLogsAt), arg0, arg1, arg2)
 }
 
+// UpdateAgentUpgradeStatus mocks base method
+func (m *MockAPI) UpdateAgentUpgradeStatus(arg0 context.Context, arg1 *models.Host, arg2 *models.UpgradeAgentResponse, arg3 *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAgentUpgradeStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAgentUpgradeStatus indicates an expected call of UpdateAgentUpgradeStatus
+func (mr *MockAPIMockRecorder) UpdateAgentUpgradeStatus(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAgentUpgradeStatus", reflect.TypeOf((*MockAPI)(nil).UpdateAgentUpgradeStatus), arg0, arg1, arg2, arg3)
+}
+
 // UpdateApiVipConnectivityReport mocks base method
 func (m *MockAPI) UpdateApiVipConnectivityReport(arg0 context.Context, arg1 *models.Host, arg2 string) error {
 	m.ctrl.T.Helper()
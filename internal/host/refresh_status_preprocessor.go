@@ -37,12 +37,14 @@ type refreshPreprocessor struct {
 	disabledHostValidations DisabledHostValidations
 }
 
-func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCfg, hwValidator hardware.Validator, operatorsApi operators.API, disabledHostValidations DisabledHostValidations) *refreshPreprocessor {
+func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCfg, hwValidator hardware.Validator, operatorsApi operators.API,
+	disabledHostValidations DisabledHostValidations, agentDockerImage string) *refreshPreprocessor {
 	v := &validator{
-		log:            log,
-		hwValidatorCfg: hwValidatorCfg,
-		hwValidator:    hwValidator,
-		operatorsAPI:   operatorsApi,
+		log:              log,
+		hwValidatorCfg:   hwValidatorCfg,
+		hwValidator:      hwValidator,
+		operatorsAPI:     operatorsApi,
+		agentDockerImage: agentDockerImage,
 	}
 	return &refreshPreprocessor{
 		log:                     log,
@@ -224,6 +226,11 @@ func newValidations(v *validator) []validation {
 			condition: v.hasSufficientPacketLossRequirementForRole,
 			formatter: v.printSufficientPacketLossRequirementForRole,
 		},
+		{
+			id:        IsAgentCompatible,
+			condition: v.isAgentCompatible,
+			formatter: v.printAgentCompatible,
+		},
 	}
 }
 
@@ -3951,6 +3951,183 @@ var _ = Describe("Refresh Host", func() {
 			})
 		}
 	})
+	Context("agent compatibility", func() {
+		const agentImage = "quay.io/ocpmetal/assisted-installer-agent:v1.0.20.2"
+
+		BeforeEach(func() {
+			mockDefaultClusterHostRequirements(mockHwValidator)
+			defaultNTPSourcesInBytes, err := json.Marshal(defaultNTPSources)
+			Expect(err).NotTo(HaveOccurred())
+			cluster = hostutil.GenerateTestCluster(clusterId, "1.2.3.0/24")
+			Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+			host = hostutil.GenerateTestHost(hostId, clusterId, models.HostStatusDiscovering)
+			host.Inventory = hostutil.GenerateInventoryWithResourcesWithBytes(4, conversions.GibToBytes(16), "master")
+			host.Role = models.HostRoleMaster
+			host.NtpSources = string(defaultNTPSourcesInBytes)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID,
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				AnyTimes()
+		})
+
+		failedUpgrade := func(image string) string {
+			status, err := hostutil.MarshalAgentUpgradeStatus(&models.UpgradeAgentResponse{AgentImage: image, Result: models.UpgradeAgentResultFailure})
+			Expect(err).NotTo(HaveOccurred())
+			return status
+		}
+
+		tests := []struct {
+			name               string
+			agentVersion       string
+			agentUpgradeStatus string
+			dstState           string
+			validationStatus   ValidationStatus
+			validationMessage  string
+		}{
+			{
+				name:              "compatible agent",
+				agentVersion:      "quay.io/ocpmetal/assisted-installer-agent:v1.0.20.1",
+				dstState:          models.HostStatusKnown,
+				validationStatus:  ValidationSuccess,
+				validationMessage: "Host agent is compatible with the service",
+			},
+			{
+				name:              "incompatible agent - waiting for upgrade",
+				agentVersion:      "quay.io/ocpmetal/assisted-installer-agent:v0.9",
+				dstState:          models.HostStatusInsufficient,
+				validationStatus:  ValidationFailure,
+				validationMessage: "Host agent quay.io/ocpmetal/assisted-installer-agent:v0.9 is not compatible with the service, waiting for it to be upgraded to " + agentImage,
+			},
+			{
+				name:               "incompatible agent - upgrade to another image failed",
+				agentVersion:       "quay.io/ocpmetal/assisted-installer-agent:v0.9",
+				agentUpgradeStatus: failedUpgrade("quay.io/ocpmetal/assisted-installer-agent:v1.0.20.1"),
+				dstState:           models.HostStatusInsufficient,
+				validationStatus:   ValidationFailure,
+				validationMessage:  "Host agent quay.io/ocpmetal/assisted-installer-agent:v0.9 is not compatible with the service, waiting for it to be upgraded to " + agentImage,
+			},
+			{
+				name:               "incompatible agent - upgrade failed",
+				agentVersion:       "quay.io/ocpmetal/assisted-installer-agent:v0.9",
+				agentUpgradeStatus: failedUpgrade(agentImage),
+				dstState:           models.HostStatusInsufficient,
+				validationStatus:   ValidationFailure,
+				validationMessage: "Host agent quay.io/ocpmetal/assisted-installer-agent:v0.9 is not compatible with the service and could not be upgraded to " +
+					agentImage + ", boot the host with a newly generated discovery ISO",
+			},
+		}
+
+		for i := range tests {
+			t := tests[i]
+			It(t.name, func() {
+				host.DiscoveryAgentVersion = t.agentVersion
+				host.AgentUpgradeStatus = t.agentUpgradeStatus
+				Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+				cfg := *defaultConfig
+				cfg.AgentDockerImage = agentImage
+				cfg.DisabledHostvalidations = DisabledHostValidations{string(models.HostValidationIDBelongsToMajorityGroup): struct{}{}}
+				hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, nil, &cfg, nil, operatorsManager)
+
+				Expect(hapi.RefreshStatus(ctx, &host, db)).ToNot(HaveOccurred())
+
+				var resultHost models.Host
+				Expect(db.Take(&resultHost, "id = ? and cluster_id = ?", hostId, clusterId.String()).Error).ToNot(HaveOccurred())
+				Expect(swag.StringValue(resultHost.Status)).To(Equal(t.dstState))
+				validationRes := ValidationsStatus{}
+				Expect(json.Unmarshal([]byte(resultHost.ValidationsInfo), &validationRes)).ToNot(HaveOccurred())
+				found := false
+				for _, val := range validationRes["network"] {
+					if val.ID == IsAgentCompatible {
+						found = true
+						Expect(val.Status).To(Equal(t.validationStatus))
+						Expect(val.Message).To(Equal(t.validationMessage))
+					}
+				}
+				Expect(found).To(BeTrue())
+				if t.dstState == models.HostStatusInsufficient {
+					Expect(swag.StringValue(resultHost.StatusInfo)).To(ContainSubstring(t.validationMessage))
+				}
+			})
+		}
+	})
+
+	Context("user managed networking with machine networks", func() {
+
+		BeforeEach(func() {
+			mockDefaultClusterHostRequirements(mockHwValidator)
+			hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, nil, defaultConfig, nil, operatorsManager)
+			host = hostutil.GenerateTestHost(hostId, clusterId, models.HostStatusDiscovering)
+			host.Inventory = hostutil.GenerateInventoryWithResourcesWithBytes(4, conversions.GibToBytes(16), "master")
+			host.Role = models.HostRoleMaster
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID,
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				AnyTimes()
+		})
+
+		tests := []struct {
+			name                 string
+			machineNetworks      []string
+			majorityGroups       string
+			machineCidrStatus    ValidationStatus
+			machineCidrMessage   string
+			majorityGroupStatus  ValidationStatus
+			majorityGroupMessage string
+		}{
+			{
+				name:                 "host in one of the machine networks",
+				machineNetworks:      []string{"10.0.1.0/24", "1.2.3.0/24"},
+				majorityGroups:       fmt.Sprintf(`{"IPv4":["%s"]}`, hostId.String()),
+				machineCidrStatus:    ValidationSuccess,
+				machineCidrMessage:   "Host belongs to machine network CIDR 1.2.3.0/24",
+				majorityGroupStatus:  ValidationSuccess,
+				majorityGroupMessage: "Host has L3 connectivity to the majority of hosts in the cluster",
+			},
+			{
+				name:                 "host in none of the machine networks",
+				machineNetworks:      []string{"10.0.1.0/24", "10.0.2.0/24"},
+				majorityGroups:       `{"IPv4":[]}`,
+				machineCidrStatus:    ValidationFailure,
+				machineCidrMessage:   "Host does not belong to any of the machine network CIDRs 10.0.1.0/24, 10.0.2.0/24",
+				majorityGroupStatus:  ValidationPending,
+				majorityGroupMessage: "Not enough enabled hosts in cluster to calculate connectivity groups",
+			},
+		}
+
+		for i := range tests {
+			t := tests[i]
+			It(t.name, func() {
+				cluster = hostutil.GenerateTestCluster(clusterId, t.machineNetworks[0])
+				cluster.UserManagedNetworking = swag.Bool(true)
+				cluster.ConnectivityMajorityGroups = t.majorityGroups
+				for _, cidr := range t.machineNetworks {
+					cluster.MachineNetworks = append(cluster.MachineNetworks, &models.MachineNetwork{ClusterID: clusterId, Cidr: cidr})
+				}
+				Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+
+				Expect(hapi.RefreshStatus(ctx, &host, db)).ToNot(HaveOccurred())
+
+				var resultHost models.Host
+				Expect(db.Take(&resultHost, "id = ? and cluster_id = ?", hostId, clusterId.String()).Error).ToNot(HaveOccurred())
+				validationRes := ValidationsStatus{}
+				Expect(json.Unmarshal([]byte(resultHost.ValidationsInfo), &validationRes)).ToNot(HaveOccurred())
+				found := 0
+				for _, val := range validationRes["network"] {
+					switch val.ID {
+					case BelongsToMachineCidr:
+						found++
+						Expect(val.Status).To(Equal(t.machineCidrStatus))
+						Expect(val.Message).To(Equal(t.machineCidrMessage))
+					case BelongsToMajorityGroup:
+						found++
+						Expect(val.Status).To(Equal(t.majorityGroupStatus))
+						Expect(val.Message).To(Equal(t.majorityGroupMessage))
+					}
+				}
+				Expect(found).To(Equal(2))
+			})
+		}
+	})
+
 	Context("L3 network latency and packet loss validation", func() {
 
 		defaultNTPSourcesInBytes, err := json.Marshal(defaultNTPSources)
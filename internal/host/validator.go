@@ -178,10 +178,11 @@ func boolValue(b bool) ValidationStatus {
 }
 
 type validator struct {
-	log            logrus.FieldLogger
-	hwValidatorCfg *hardware.ValidatorCfg
-	hwValidator    hardware.Validator
-	operatorsAPI   operators.API
+	log              logrus.FieldLogger
+	hwValidatorCfg   *hardware.ValidatorCfg
+	hwValidator      hardware.Validator
+	operatorsAPI     operators.API
+	agentDockerImage string
 }
 
 func (v *validator) isConnected(c *validationContext) ValidationStatus {
@@ -365,8 +366,23 @@ func (v *validator) printHasMemoryForRole(c *validationContext, status Validatio
 	}
 }
 
+// hasUserManagedMachineNetworks returns true if the hosts of a cluster with user managed networking are validated against
+// machine networks set by the user
+func hasUserManagedMachineNetworks(cluster *common.Cluster) bool {
+	return swag.BoolValue(cluster.UserManagedNetworking) && !common.IsSingleNodeCluster(cluster) && len(cluster.MachineNetworks) > 0
+}
+
 func (v *validator) belongsToMachineCidr(c *validationContext) ValidationStatus {
-	if swag.StringValue(c.cluster.Kind) == models.ClusterKindAddHostsCluster || (swag.BoolValue(c.cluster.UserManagedNetworking) && !common.IsSingleNodeCluster(c.cluster)) {
+	if swag.StringValue(c.cluster.Kind) == models.ClusterKindAddHostsCluster {
+		return ValidationSuccess
+	}
+	if hasUserManagedMachineNetworks(c.cluster) {
+		if c.inventory == nil {
+			return ValidationPending
+		}
+		return boolValue(len(network.GetHostMachineNetworks(v.log, c.cluster, c.host)) > 0)
+	}
+	if swag.BoolValue(c.cluster.UserManagedNetworking) && !common.IsSingleNodeCluster(c.cluster) {
 		return ValidationSuccess
 	}
 	if c.inventory == nil || c.cluster.MachineNetworkCidr == "" {
@@ -378,11 +394,19 @@ func (v *validator) belongsToMachineCidr(c *validationContext) ValidationStatus
 func (v *validator) printBelongsToMachineCidr(c *validationContext, status ValidationStatus) string {
 	switch status {
 	case ValidationSuccess:
+		if hasUserManagedMachineNetworks(c.cluster) {
+			return fmt.Sprintf("Host belongs to machine network CIDR %s",
+				strings.Join(network.GetHostMachineNetworks(v.log, c.cluster, c.host), ", "))
+		}
 		if swag.BoolValue(c.cluster.UserManagedNetworking) {
 			return "No machine network CIDR validation needed: User Managed Networking"
 		}
 		return fmt.Sprintf("Host belongs to machine network CIDR %s", c.cluster.MachineNetworkCidr)
 	case ValidationFailure:
+		if hasUserManagedMachineNetworks(c.cluster) {
+			return fmt.Sprintf("Host does not belong to any of the machine network CIDRs %s",
+				strings.Join(network.GetMachineNetworkCidrs(c.cluster), ", "))
+		}
 		return fmt.Sprintf("Host does not belong to machine network CIDR %s", c.cluster.MachineNetworkCidr)
 	case ValidationPending:
 		return "Missing inventory or machine network CIDR"
@@ -492,8 +516,23 @@ func getNumEnabledHosts(hosts []*models.Host) int {
 	return ret
 }
 
+// majorityGroupKeys returns the keys of the connectivity majority groups that the host must belong to
+func majorityGroupKeys(cluster *common.Cluster) []string {
+	if !hasUserManagedMachineNetworks(cluster) {
+		return []string{cluster.MachineNetworkCidr}
+	}
+	ret := make([]string, 0)
+	machineCidrs := network.GetMachineNetworkCidrs(cluster)
+	for _, isIPv4 := range []bool{true, false} {
+		if network.GetCidrOfFamily(machineCidrs, isIPv4) != "" {
+			ret = append(ret, network.L3MajorityGroupKey(isIPv4))
+		}
+	}
+	return ret
+}
+
 func (v *validator) belongsToMajorityGroup(c *validationContext) ValidationStatus {
-	if hostutil.IsDay2Host(c.host) || swag.BoolValue(c.cluster.UserManagedNetworking) {
+	if hostutil.IsDay2Host(c.host) || swag.BoolValue(c.cluster.UserManagedNetworking) && !hasUserManagedMachineNetworks(c.cluster) {
 		return ValidationSuccess
 	}
 	if c.cluster.MachineNetworkCidr == "" || c.cluster.ConnectivityMajorityGroups == "" {
@@ -505,7 +544,11 @@ func (v *validator) belongsToMajorityGroup(c *validationContext) ValidationStatu
 		v.log.WithError(err).Warn("Parse majority group")
 		return ValidationError
 	}
-	if funk.Contains(majorityGroups[c.cluster.MachineNetworkCidr], *c.host.ID) {
+	inMajorityGroups := true
+	for _, key := range majorityGroupKeys(c.cluster) {
+		inMajorityGroups = inMajorityGroups && funk.Contains(majorityGroups[key], *c.host.ID)
+	}
+	if inMajorityGroups {
 		return ValidationSuccess
 	} else if getNumEnabledHosts(c.cluster.Hosts) < 3 {
 		// The minimum non disabled hosts for connectivity check is 3
@@ -520,11 +563,17 @@ func (v *validator) printBelongsToMajorityGroup(c *validationContext, status Val
 		if hostutil.IsDay2Host(c.host) {
 			return "Day2 host is not required to be connected to other hosts in the cluster"
 		}
+		if hasUserManagedMachineNetworks(c.cluster) {
+			return "Host has L3 connectivity to the majority of hosts in the cluster"
+		}
 		if swag.BoolValue(c.cluster.UserManagedNetworking) {
 			return "L2 connectivy validation skipped: User Managed Networking"
 		}
 		return "Host has connectivity to the majority of hosts in the cluster"
 	case ValidationFailure:
+		if hasUserManagedMachineNetworks(c.cluster) {
+			return "No L3 connectivity to the majority of hosts in the cluster"
+		}
 		return "No connectivity to the majority of hosts in the cluster"
 	case ValidationError:
 		return "Parse error for connectivity majority group"
@@ -795,3 +844,30 @@ func (v *validator) printSufficientPacketLossRequirementForRole(c *validationCon
 		return fmt.Sprintf("Unexpected status %s", status)
 	}
 }
+
+func (v *validator) isAgentCompatible(c *validationContext) ValidationStatus {
+	return boolValue(hostutil.IsAgentCompatible(v.agentDockerImage, c.host.DiscoveryAgentVersion))
+}
+
+func (v *validator) printAgentCompatible(c *validationContext, status ValidationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return "Host agent is compatible with the service"
+	case ValidationFailure:
+		if hostutil.IsAgentNewer(v.agentDockerImage, c.host.DiscoveryAgentVersion) {
+			return fmt.Sprintf("Host agent %s is newer than the service agent %s, boot the host with a newly generated discovery ISO",
+				c.host.DiscoveryAgentVersion, v.agentDockerImage)
+		}
+		upgradeStatus, err := hostutil.UnmarshalAgentUpgradeStatus(c.host.AgentUpgradeStatus)
+		if err != nil {
+			return fmt.Sprintf("Host agent %s is not compatible with the service agent %s", c.host.DiscoveryAgentVersion, v.agentDockerImage)
+		}
+		if upgradeStatus != nil && upgradeStatus.AgentImage == v.agentDockerImage && upgradeStatus.Result == models.UpgradeAgentResultFailure {
+			return fmt.Sprintf("Host agent %s is not compatible with the service and could not be upgraded to %s, boot the host with a newly generated discovery ISO",
+				c.host.DiscoveryAgentVersion, v.agentDockerImage)
+		}
+		return fmt.Sprintf("Host agent %s is not compatible with the service, waiting for it to be upgraded to %s", c.host.DiscoveryAgentVersion, v.agentDockerImage)
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
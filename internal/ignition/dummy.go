@@ -72,10 +72,6 @@ func (g *dummyGenerator) UploadToS3(ctx context.Context) error {
 	return uploadToS3(ctx, g.workDir, g.cluster, g.s3Client, g.log)
 }
 
-func (g *dummyGenerator) UpdateEtcHosts(serviceIPs string) error {
-	return nil
-}
-
 const kubeconfig string = `
 clusters:
 - cluster:
@@ -22,7 +22,6 @@ import (
 	"github.com/coreos/ignition/v2/config/merge"
 	config_31 "github.com/coreos/ignition/v2/config/v3_1"
 	config_latest "github.com/coreos/ignition/v2/config/v3_2"
-	config_latest_trans "github.com/coreos/ignition/v2/config/v3_2/translate"
 	config_latest_types "github.com/coreos/ignition/v2/config/v3_2/types"
 	"github.com/coreos/vcontext/report"
 	"github.com/go-openapi/swag"
@@ -37,6 +36,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/hostbinding"
 	"github.com/openshift/assisted-service/pkg/mirrorregistries"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
@@ -223,6 +223,24 @@ const discoveryIgnitionConfigFormat = `{
           "name": "root"
       },
       "contents": { "source": "data:,{{.RH_ROOT_CA}}" }
+    }{{end}}{{if .AdditionalTrustBundle}},
+    {
+      "overwrite": true,
+      "path": "{{.AdditionalTrustBundlePath}}",
+      "mode": 420,
+      "user": {
+          "name": "root"
+      },
+      "contents": { "source": "data:text/plain;base64,{{.AdditionalTrustBundle}}" }
+    }{{end}}{{if .Hostname}},
+    {
+      "overwrite": true,
+      "path": "/etc/hostname",
+      "mode": 420,
+      "user": {
+          "name": "root"
+      },
+      "contents": { "source": "data:,{{.Hostname}}" }
     }{{end}}{{if .HostCACertPath}},
     {
       "path": "{{.HostCACertPath}}",
@@ -316,14 +334,15 @@ var fileNames = [...]string{
 type Generator interface {
 	Generate(ctx context.Context, installConfig []byte) error
 	UploadToS3(ctx context.Context) error
-	UpdateEtcHosts(string) error
 }
 
 // IgnitionBuilder defines the ignition formatting methods for the various images
 //go:generate mockgen -source=ignition.go -package=ignition -destination=mock_ignition.go
 type IgnitionBuilder interface {
 	FormatDiscoveryIgnitionFile(cluster *common.Cluster, cfg IgnitionConfig, safeForLogs bool, authType auth.AuthType) (string, error)
+	FormatDeclaredHostDiscoveryIgnitionFile(cluster *common.Cluster, declaredHost *common.DeclaredHost, cfg IgnitionConfig, safeForLogs bool, authType auth.AuthType) (string, error)
 	FormatSecondDayWorkerIgnitionFile(address string, machineConfigPoolName string) ([]byte, error)
+	PreviewHostIgnition(cluster *common.Cluster, host *models.Host, cfg IgnitionConfig, authType auth.AuthType) (*models.IgnitionPreview, error)
 }
 
 type installerGenerator struct {
@@ -334,6 +353,7 @@ type installerGenerator struct {
 	releaseImageMirror       string
 	installerDir             string
 	serviceCACert            string
+	serviceIPs               string
 	encodedDhcpFileContents  string
 	s3Client                 s3wrapper.API
 	enableMetal3Provisioning bool
@@ -369,7 +389,7 @@ func NewBuilder(log logrus.FieldLogger, staticNetworkConfig staticnetworkconfig.
 
 // NewGenerator returns a generator that can generate ignition files
 func NewGenerator(workDir string, installerDir string, cluster *common.Cluster, releaseImage string, releaseImageMirror string,
-	serviceCACert, installInvoker string, s3Client s3wrapper.API, log logrus.FieldLogger, operatorsApi operators.API) Generator {
+	serviceCACert, serviceIPs, installInvoker string, s3Client s3wrapper.API, log logrus.FieldLogger, operatorsApi operators.API) Generator {
 	return &installerGenerator{
 		cluster:                  cluster,
 		log:                      log,
@@ -378,6 +398,7 @@ func NewGenerator(workDir string, installerDir string, cluster *common.Cluster,
 		workDir:                  workDir,
 		installerDir:             installerDir,
 		serviceCACert:            serviceCACert,
+		serviceIPs:               serviceIPs,
 		s3Client:                 s3Client,
 		enableMetal3Provisioning: true,
 		operatorsApi:             operatorsApi,
@@ -801,129 +822,148 @@ func (g *installerGenerator) modifyBMHFile(file *config_latest_types.File, bmh *
 	return nil
 }
 
-func (g *installerGenerator) updateDhcpFiles() error {
-	path := filepath.Join(g.workDir, masterIgn)
-	config, err := parseIgnitionFile(path)
-	if err != nil {
-		return err
-	}
-	setFileInIgnition(config, "/etc/keepalived/unsupported-monitor.conf", g.encodedDhcpFileContents, false, 0o644)
-	encodedApiVip := network.GetEncodedApiVipLease(g.cluster)
+func setDhcpFilesInIgnition(config *config_latest_types.Config, cluster *common.Cluster, encodedDhcpFileContents string) {
+	setFileInIgnition(config, "/etc/keepalived/unsupported-monitor.conf", encodedDhcpFileContents, false, 0o644)
+	encodedApiVip := network.GetEncodedApiVipLease(cluster)
 	if encodedApiVip != "" {
 		setFileInIgnition(config, "/etc/keepalived/lease-api", encodedApiVip, false, 0o644)
 	}
-	encodedIngressVip := network.GetEncodedIngressVipLease(g.cluster)
+	encodedIngressVip := network.GetEncodedIngressVipLease(cluster)
 	if encodedIngressVip != "" {
 		setFileInIgnition(config, "/etc/keepalived/lease-ingress", encodedIngressVip, false, 0o644)
 	}
-	err = writeIgnitionFile(path, config)
-	if err != nil {
-		return err
-	}
-	return nil
 }
 
 func encodeIpv6Contents() string {
 	return fmt.Sprintf("data:,%s", url.PathEscape(common.Ipv6DuidRuntimeConf))
 }
 
-func (g *installerGenerator) addIpv6FileInIgnition(ignition string) error {
-	path := filepath.Join(g.workDir, ignition)
-	config, err := parseIgnitionFile(path)
-	if err != nil {
-		return err
-	}
+func setIpv6FileInIgnition(config *config_latest_types.Config) {
 	setFileInIgnition(config, "/etc/NetworkManager/conf.d/01-ipv6.conf", encodeIpv6Contents(), false, 0o644)
-	err = writeIgnitionFile(path, config)
-	if err != nil {
-		return err
-	}
-	return nil
 }
 
-func (g *installerGenerator) addStaticNetworkConfigToIgnition(ignition string) error {
-	path := filepath.Join(g.workDir, ignition)
-	config, err := parseIgnitionFile(path)
-	if err != nil {
-		return err
-	}
-	is47Version, err := common.VersionGreaterOrEqual(g.cluster.OpenshiftVersion, "4.7")
+func setStaticNetworkConfigInIgnition(config *config_latest_types.Config, cluster *common.Cluster) error {
+	is47Version, err := common.VersionGreaterOrEqual(cluster.OpenshiftVersion, "4.7")
 	if err != nil {
 		return err
 	}
-	if (swag.BoolValue(g.cluster.UserManagedNetworking) && is47Version) || !is47Version {
+	if (swag.BoolValue(cluster.UserManagedNetworking) && is47Version) || !is47Version {
 		// add overlay configuration for NM in case of 4.7 and None platform.
 		// TODO - remove once this configuration is integrated in MCO for None platform (Bugzilla 1928473)
 		setFileInIgnition(config, "/etc/tmpfiles.d/kni.conf", fmt.Sprintf("data:,%s", url.PathEscape(kniTempFile)), false, 420)
 		setUnitInIgnition(config, SystemConnectionsMerged, "etc-NetworkManager-system\\x2dconnections\\x2dmerged.mount", true)
 	}
-	err = writeIgnitionFile(path, config)
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
-func (g *installerGenerator) updateIgnitions() error {
-	masterPath := filepath.Join(g.workDir, masterIgn)
-	caCertFile := g.serviceCACert
+// ignitionLayer is a named change the service applies to an ignition config. The generator and the ignition preview
+// apply the same layers, so that the preview shows what the generator writes.
+type ignitionLayer struct {
+	name  string
+	apply func(config *config_latest_types.Config) (*config_latest_types.Config, error)
+}
 
-	if caCertFile != "" {
-		err := setCACertInIgnition(models.HostRoleMaster, masterPath, g.workDir, caCertFile)
-		if err != nil {
-			return errors.Wrapf(err, "error adding CA cert to ignition %s", masterPath)
+func applyIgnitionLayers(config *config_latest_types.Config, layers []ignitionLayer) (*config_latest_types.Config, error) {
+	var err error
+	for _, layer := range layers {
+		if config, err = layer.apply(config); err != nil {
+			return nil, errors.Wrapf(err, "failed to apply ignition layer %s", layer.name)
 		}
 	}
+	return config, nil
+}
 
-	if g.encodedDhcpFileContents != "" {
-		if err := g.updateDhcpFiles(); err != nil {
-			return errors.Wrapf(err, "error adding DHCP file to ignition %s", masterPath)
-		}
+// roleIgnitionLayers returns the changes applied to the ignition that openshift-install generates for a role
+func roleIgnitionLayers(cluster *common.Cluster, role models.HostRole, serviceCACert, encodedDhcpFileContents, serviceIPs string) ([]ignitionLayer, error) {
+	var layers []ignitionLayer
+	if serviceCACert != "" {
+		layers = append(layers, ignitionLayer{models.IgnitionLayerNameServiceCa, func(config *config_latest_types.Config) (*config_latest_types.Config, error) {
+			return config, setCACertFileInIgnition(config, serviceCACert)
+		}})
+	}
+	if cluster.AdditionalTrustBundle != "" {
+		layers = append(layers, ignitionLayer{models.IgnitionLayerNameTrustBundle, func(config *config_latest_types.Config) (*config_latest_types.Config, error) {
+			setFileInIgnition(config, common.AdditionalTrustBundlePath,
+				"data:text/plain;base64,"+base64.StdEncoding.EncodeToString([]byte(cluster.AdditionalTrustBundle)), false, 420)
+			return config, nil
+		}})
+	}
+	if role == models.HostRoleMaster && encodedDhcpFileContents != "" {
+		layers = append(layers, ignitionLayer{models.IgnitionLayerNameVipDhcp, func(config *config_latest_types.Config) (*config_latest_types.Config, error) {
+			setDhcpFilesInIgnition(config, cluster, encodedDhcpFileContents)
+			return config, nil
+		}})
+	}
+
+	_, ipv6, err := network.GetClusterAddressStack(cluster.Hosts)
+	if err != nil {
+		return nil, err
 	}
-
-	workerPath := filepath.Join(g.workDir, workerIgn)
-	if caCertFile != "" {
-		err := setCACertInIgnition(models.HostRoleWorker, workerPath, g.workDir, caCertFile)
-		if err != nil {
-			return errors.Wrapf(err, "error adding CA cert to ignition %s", workerPath)
+	if ipv6 {
+		layers = append(layers, ignitionLayer{models.IgnitionLayerNameIPV6, func(config *config_latest_types.Config) (*config_latest_types.Config, error) {
+			setIpv6FileInIgnition(config)
+			return config, nil
+		}})
+		if cluster.ImageInfo != nil && cluster.ImageInfo.StaticNetworkConfig != "" {
+			layers = append(layers, ignitionLayer{models.IgnitionLayerNameStaticNetwork, func(config *config_latest_types.Config) (*config_latest_types.Config, error) {
+				return config, setStaticNetworkConfigInIgnition(config, cluster)
+			}})
 		}
 	}
 
-	_, ipv6, err := network.GetClusterAddressStack(g.cluster.Hosts)
-	if err != nil {
-		return err
+	if serviceIPs != "" {
+		layers = append(layers, ignitionLayer{models.IgnitionLayerNameEtcHosts, func(config *config_latest_types.Config) (*config_latest_types.Config, error) {
+			setFileInIgnition(config, "/etc/hosts", dataurl.EncodeBytes([]byte(GetServiceIPHostnames(serviceIPs))), true, 420)
+			return config, nil
+		}})
 	}
-	if ipv6 {
-		for _, ignition := range []string{masterIgn, workerIgn} {
-			if err = g.addIpv6FileInIgnition(ignition); err != nil {
-				return err
-			}
+	return layers, nil
+}
 
-			if g.cluster.ImageInfo.StaticNetworkConfig != "" {
-				if err := g.addStaticNetworkConfigToIgnition(ignition); err != nil {
-					return err
-				}
-			}
-		}
+// hostIgnitionLayers returns the changes applied to the ignition of the role of a host to get the ignition of the host
+func hostIgnitionLayers(host *models.Host) []ignitionLayer {
+	layers := []ignitionLayer{{models.IgnitionLayerNameHostname, func(config *config_latest_types.Config) (*config_latest_types.Config, error) {
+		return config, setHostnameInIgnition(config, host)
+	}}}
+	if host.IgnitionConfigOverrides != "" {
+		layers = append(layers, ignitionLayer{models.IgnitionLayerNameHostOverrides, mergeOverridesLayer(host.IgnitionConfigOverrides)})
 	}
-	return nil
+	return layers
 }
 
-func (g *installerGenerator) UpdateEtcHosts(serviceIPs string) error {
-	masterPath := filepath.Join(g.workDir, masterIgn)
-
-	if serviceIPs != "" {
-		err := setEtcHostsInIgnition(models.HostRoleMaster, masterPath, g.workDir, GetServiceIPHostnames(serviceIPs))
+func mergeOverridesLayer(overrides string) func(config *config_latest_types.Config) (*config_latest_types.Config, error) {
+	return func(config *config_latest_types.Config) (*config_latest_types.Config, error) {
+		configBytes, err := json.Marshal(config)
 		if err != nil {
-			return errors.Wrapf(err, "error adding Etc Hosts to ignition %s", masterPath)
+			return nil, err
 		}
+		merged, err := MergeIgnitionConfig(configBytes, []byte(overrides))
+		if err != nil {
+			return nil, err
+		}
+		return ParseToLatest([]byte(merged))
 	}
+}
 
-	workerPath := filepath.Join(g.workDir, workerIgn)
-	if serviceIPs != "" {
-		err := setEtcHostsInIgnition(models.HostRoleWorker, workerPath, g.workDir, GetServiceIPHostnames(serviceIPs))
+func (g *installerGenerator) updateIgnitions() error {
+	for _, role := range []models.HostRole{models.HostRoleMaster, models.HostRoleWorker} {
+		layers, err := roleIgnitionLayers(g.cluster, role, g.serviceCACert, g.encodedDhcpFileContents, g.serviceIPs)
+		if err != nil {
+			return err
+		}
+		if len(layers) == 0 {
+			continue
+		}
+		path := filepath.Join(g.workDir, fmt.Sprintf("%s.ign", role))
+		config, err := parseIgnitionFile(path)
 		if err != nil {
-			return errors.Wrapf(err, "error adding Etc Hosts to ignition %s", workerPath)
+			return err
+		}
+		if config, err = applyIgnitionLayers(config, layers); err != nil {
+			return errors.Wrapf(err, "error updating ignition %s", path)
+		}
+		if err = writeIgnitionFile(path, config); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -977,18 +1017,15 @@ func uploadToS3(ctx context.Context, workDir string, cluster *common.Cluster, s3
 	return nil
 }
 
+// ParseToLatest parses an ignition config of any supported spec version to the latest types.
+// Configs older than spec 3.2 keep version 3.1.0.
 func ParseToLatest(content []byte) (*config_latest_types.Config, error) {
-	config, _, err := config_latest.Parse(content)
+	config, _, err := parseAnyVersion(content)
 	if err != nil {
-		configv31, _, err := config_31.Parse(content)
-		if err != nil {
-			return nil, errors.Errorf("error parsing ignition: %v", err)
-		}
-		config = config_latest_trans.Translate(configv31)
-		config.Ignition.Version = "3.1.0"
+		return nil, errors.Wrap(err, "error parsing ignition")
 	}
 
-	return &config, nil
+	return config, nil
 }
 
 func parseIgnitionFile(path string) (*config_latest_types.Config, error) {
@@ -1060,26 +1097,13 @@ func setUnitInIgnition(config *config_latest_types.Config, contents, name string
 	config.Systemd.Units = append(config.Systemd.Units, newUnit)
 }
 
-func setCACertInIgnition(role models.HostRole, path string, workDir string, caCertFile string) error {
-	config, err := parseIgnitionFile(path)
-	if err != nil {
-		return err
-	}
-
-	var caCertData []byte
-	caCertData, err = ioutil.ReadFile(caCertFile)
+func setCACertFileInIgnition(config *config_latest_types.Config, caCertFile string) error {
+	caCertData, err := ioutil.ReadFile(caCertFile)
 	if err != nil {
 		return err
 	}
 
 	setFileInIgnition(config, common.HostCACertPath, fmt.Sprintf("data:,%s", url.PathEscape(string(caCertData))), false, 420)
-
-	fileName := fmt.Sprintf("%s.ign", role)
-	err = writeIgnitionFile(filepath.Join(workDir, fileName), config)
-	if err != nil {
-		return err
-	}
-
 	return nil
 }
 
@@ -1093,28 +1117,11 @@ func writeHostFiles(hosts []*models.Host, baseFile string, workDir string) error
 				return err
 			}
 
-			hostname, err := hostutil.GetCurrentHostName(host)
-			if err != nil {
-				return errors.Wrapf(err, "failed to get hostname for host %s", host.ID)
-			}
-
-			setFileInIgnition(config, "/etc/hostname", fmt.Sprintf("data:,%s", hostname), false, 420)
-
-			configBytes, err := json.Marshal(config)
-			if err != nil {
-				return err
-			}
-
-			if host.IgnitionConfigOverrides != "" {
-				merged, mergeErr := MergeIgnitionConfig(configBytes, []byte(host.IgnitionConfigOverrides))
-				if mergeErr != nil {
-					return errors.Wrapf(mergeErr, "failed to apply ignition config overrides for host %s", host.ID)
-				}
-				configBytes = []byte(merged)
+			if config, err = applyIgnitionLayers(config, hostIgnitionLayers(host)); err != nil {
+				return errors.Wrapf(err, "failed to build ignition for host %s", host.ID)
 			}
 
-			err = ioutil.WriteFile(filepath.Join(workDir, hostutil.IgnitionFileName(host)), configBytes, 0600)
-			if err != nil {
+			if err = writeIgnitionFile(filepath.Join(workDir, hostutil.IgnitionFileName(host)), config); err != nil {
 				return errors.Wrapf(err, "failed to write ignition for host %s", host.ID)
 			}
 
@@ -1152,6 +1159,10 @@ func MergeIgnitionConfig(base []byte, overrides []byte) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	// Overrides of an older version than the base are also valid in the base version
+	if overrideConfig.Ignition.Version < baseConfig.Ignition.Version {
+		overrideConfig.Ignition.Version = baseConfig.Ignition.Version
+	}
 
 	mergeResult, _ := merge.MergeStructTranscribe(*baseConfig, *overrideConfig)
 	res, err := json.Marshal(mergeResult)
@@ -1176,22 +1187,6 @@ func MergeIgnitionConfig(base []byte, overrides []byte) (string, error) {
 	return string(res), nil
 }
 
-func setEtcHostsInIgnition(role models.HostRole, path string, workDir string, content string) error {
-	config, err := parseIgnitionFile(path)
-	if err != nil {
-		return err
-	}
-
-	setFileInIgnition(config, "/etc/hosts", dataurl.EncodeBytes([]byte(content)), true, 420)
-
-	fileName := fmt.Sprintf("%s.ign", role)
-	err = writeIgnitionFile(filepath.Join(workDir, fileName), config)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
 func GetServiceIPHostnames(serviceIPs string) string {
 	ips := strings.Split(strings.TrimSpace(serviceIPs), ",")
 	content := ""
@@ -1238,19 +1233,26 @@ func (g *installerGenerator) downloadManifest(ctx context.Context, manifest stri
 	return nil
 }
 
+func setHostnameInIgnition(config *config_latest_types.Config, host *models.Host) error {
+	hostname, err := hostutil.GetCurrentHostName(host)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get hostname for host %s", host.ID)
+	}
+
+	setFileInIgnition(config, "/etc/hostname", fmt.Sprintf("data:,%s", hostname), false, 420)
+	return nil
+}
+
 func SetHostnameForNodeIgnition(ignition []byte, host *models.Host) ([]byte, error) {
 	config, err := ParseToLatest(ignition)
 	if err != nil {
 		return nil, errors.Errorf("error parsing ignition: %v", err)
 	}
 
-	hostname, err := hostutil.GetCurrentHostName(host)
-	if err != nil {
-		return nil, errors.Errorf("failed to get hostname for host %s", host.ID)
+	if err = setHostnameInIgnition(config, host); err != nil {
+		return nil, err
 	}
 
-	setFileInIgnition(config, "/etc/hostname", fmt.Sprintf("data:,%s", hostname), false, 420)
-
 	configBytes, err := json.Marshal(config)
 	if err != nil {
 		return nil, err
@@ -1259,6 +1261,43 @@ func SetHostnameForNodeIgnition(ignition []byte, host *models.Host) ([]byte, err
 }
 
 func (ib *ignitionBuilder) FormatDiscoveryIgnitionFile(cluster *common.Cluster, cfg IgnitionConfig, safeForLogs bool, authType auth.AuthType) (string, error) {
+	return ib.formatDiscoveryIgnitionFile(cluster, nil, cfg, safeForLogs, authType)
+}
+
+// FormatDeclaredHostDiscoveryIgnitionFile renders the discovery ignition of the full ISO of a declared host, which
+// carries the static network config and the hostname of the declared host instead of the ones of the cluster image
+func (ib *ignitionBuilder) FormatDeclaredHostDiscoveryIgnitionFile(cluster *common.Cluster, declaredHost *common.DeclaredHost, cfg IgnitionConfig, safeForLogs bool, authType auth.AuthType) (string, error) {
+	hostCluster := *cluster
+	imageInfo := models.ImageInfo{}
+	if cluster.ImageInfo != nil {
+		imageInfo = *cluster.ImageInfo
+	}
+	imageInfo.Type = models.ImageTypeFullIso
+	imageInfo.StaticNetworkConfig = declaredHost.StaticNetworkConfig
+	hostCluster.ImageInfo = &imageInfo
+	return ib.formatDiscoveryIgnitionFile(&hostCluster, declaredHost, cfg, safeForLogs, authType)
+}
+
+func (ib *ignitionBuilder) formatDiscoveryIgnitionFile(cluster *common.Cluster, declaredHost *common.DeclaredHost, cfg IgnitionConfig, safeForLogs bool, authType auth.AuthType) (string, error) {
+	res, err := ib.formatDiscoveryIgnitionTemplate(cluster, declaredHost, cfg, safeForLogs, authType)
+	if err != nil {
+		return "", err
+	}
+
+	if cluster.IgnitionConfigOverrides != "" {
+		res, err = MergeIgnitionConfig([]byte(res), []byte(cluster.IgnitionConfigOverrides))
+		if err != nil {
+			return "", err
+		}
+		ib.log.Infof("Applying ignition overrides %s for cluster %s, resulting ignition: %s", cluster.IgnitionConfigOverrides, cluster.ID, res)
+	}
+
+	return res, nil
+}
+
+// formatDiscoveryIgnitionTemplate renders the discovery ignition of the cluster, or of one of its declared hosts when
+// one is given, without its ignition config overrides
+func (ib *ignitionBuilder) formatDiscoveryIgnitionTemplate(cluster *common.Cluster, declaredHost *common.DeclaredHost, cfg IgnitionConfig, safeForLogs bool, authType auth.AuthType) (string, error) {
 	pullSecretToken, err := clusterPkg.AgentToken(cluster, authType)
 	if err != nil {
 		return "", err
@@ -1271,10 +1310,15 @@ func (ib *ignitionBuilder) FormatDiscoveryIgnitionFile(cluster *common.Cluster,
 	if cfg.InstallRHCa {
 		rhCa = url.PathEscape(RedhatRootCA)
 	}
+	serviceBaseURL := strings.TrimSpace(cfg.ServiceBaseURL)
+	if declaredHost != nil {
+		// The agent reaches the service through the URL of the declared host, which binds the host to it on registration
+		serviceBaseURL = hostbinding.URL(serviceBaseURL, declaredHost.BindingToken)
+	}
 	var ignitionParams = map[string]interface{}{
 		"userSshKey":           getUserSSHKey(cluster.ImageInfo.SSHPublicKey),
 		"AgentDockerImg":       cfg.AgentDockerImg,
-		"ServiceBaseURL":       strings.TrimSpace(cfg.ServiceBaseURL),
+		"ServiceBaseURL":       serviceBaseURL,
 		"clusterId":            cluster.ID.String(),
 		"PullSecretToken":      pullSecretToken,
 		"AGENT_MOTD":           url.PathEscape(agentMessageOfTheDay),
@@ -1307,8 +1351,17 @@ func (ib *ignitionBuilder) FormatDiscoveryIgnitionFile(cluster *common.Cluster,
 	if cfg.ServiceIPs != "" {
 		ignitionParams["ServiceIPs"] = dataurl.EncodeBytes([]byte(GetServiceIPHostnames(cfg.ServiceIPs)))
 	}
+	if declaredHost != nil && declaredHost.Hostname != "" {
+		ignitionParams["Hostname"] = url.PathEscape(declaredHost.Hostname)
+	}
+	if cluster.AdditionalTrustBundle != "" {
+		ignitionParams["AdditionalTrustBundle"] = base64.StdEncoding.EncodeToString([]byte(cluster.AdditionalTrustBundle))
+		ignitionParams["AdditionalTrustBundlePath"] = common.AdditionalTrustBundlePath
+	}
 
-	if cluster.ImageInfo.StaticNetworkConfig != "" && cluster.ImageInfo.Type == models.ImageTypeFullIso {
+	// Images without a custom RAM disk configure the static network with the ignition
+	if cluster.ImageInfo.StaticNetworkConfig != "" && (cluster.ImageInfo.Type == models.ImageTypeFullIso ||
+		cluster.ImageInfo.Type == models.ImageTypeRawDisk || cluster.ImageInfo.Type == models.ImageTypeQcow2) {
 		filesList, newErr := ib.prepareStaticNetworkConfigForIgnition(cluster)
 		if newErr != nil {
 			ib.log.WithError(newErr).Errorf("Failed to add static network config to ignition for cluster %s", cluster.ID)
@@ -1343,16 +1396,7 @@ func (ib *ignitionBuilder) FormatDiscoveryIgnitionFile(cluster *common.Cluster,
 		return "", err
 	}
 
-	res := buf.String()
-	if cluster.IgnitionConfigOverrides != "" {
-		res, err = MergeIgnitionConfig(buf.Bytes(), []byte(cluster.IgnitionConfigOverrides))
-		if err != nil {
-			return "", err
-		}
-		ib.log.Infof("Applying ignition overrides %s for cluster %s, resulting ignition: %s", cluster.IgnitionConfigOverrides, cluster.ID, res)
-	}
-
-	return res, nil
+	return buf.String(), nil
 }
 
 func (ib *ignitionBuilder) prepareStaticNetworkConfigForIgnition(cluster *common.Cluster) ([]staticnetworkconfig.StaticNetworkConfigData, error) {
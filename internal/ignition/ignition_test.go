@@ -2,6 +2,7 @@ package ignition
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"strings"
 
 	config_31 "github.com/coreos/ignition/v2/config/v3_1"
+	config_31_types "github.com/coreos/ignition/v2/config/v3_1/types"
 	config_32 "github.com/coreos/ignition/v2/config/v3_2"
 	config_32_types "github.com/coreos/ignition/v2/config/v3_2/types"
 	"github.com/go-openapi/strfmt"
@@ -117,7 +119,7 @@ var _ = Describe("Bootstrap Ignition Update", func() {
 				Role:              models.HostRoleMaster,
 			},
 		}
-		g := NewGenerator(workDir, installerCacheDir, cluster, "", "", "", "", mockS3Client, log, mockOperatorManager).(*installerGenerator)
+		g := NewGenerator(workDir, installerCacheDir, cluster, "", "", "", "", "", mockS3Client, log, mockOperatorManager).(*installerGenerator)
 		err = g.updateBootstrap(examplePath)
 
 		bootstrapBytes, _ := ioutil.ReadFile(examplePath)
@@ -211,7 +213,7 @@ SV4bRR9i0uf+xQ/oYRvugQ25Q7EahO5hJIWRf4aULbk36Zpw3++v2KFnF26zqwB6
 
 	Describe("update ignitions", func() {
 		It("with ca cert file", func() {
-			g := NewGenerator(workDir, installerCacheDir, cluster, "", "", caCertPath, "", nil, log, mockOperatorManager).(*installerGenerator)
+			g := NewGenerator(workDir, installerCacheDir, cluster, "", "", caCertPath, "", "", nil, log, mockOperatorManager).(*installerGenerator)
 			err := g.updateIgnitions()
 			Expect(err).NotTo(HaveOccurred())
 
@@ -232,7 +234,7 @@ SV4bRR9i0uf+xQ/oYRvugQ25Q7EahO5hJIWRf4aULbk36Zpw3++v2KFnF26zqwB6
 			Expect(file.Path).To(Equal(common.HostCACertPath))
 		})
 		It("with no ca cert file", func() {
-			g := NewGenerator(workDir, installerCacheDir, cluster, "", "", "", "", nil, log, mockOperatorManager).(*installerGenerator)
+			g := NewGenerator(workDir, installerCacheDir, cluster, "", "", "", "", "", nil, log, mockOperatorManager).(*installerGenerator)
 			err := g.updateIgnitions()
 			Expect(err).NotTo(HaveOccurred())
 
@@ -249,8 +251,8 @@ SV4bRR9i0uf+xQ/oYRvugQ25Q7EahO5hJIWRf4aULbk36Zpw3++v2KFnF26zqwB6
 			Expect(workerConfig.Storage.Files).To(HaveLen(0))
 		})
 		It("with service ips", func() {
-			g := NewGenerator(workDir, installerCacheDir, cluster, "", "", "", "", nil, log, mockOperatorManager).(*installerGenerator)
-			err := g.UpdateEtcHosts("10.10.10.1,10.10.10.2")
+			g := NewGenerator(workDir, installerCacheDir, cluster, "", "", "", "10.10.10.1,10.10.10.2", "", nil, log, mockOperatorManager).(*installerGenerator)
+			err := g.updateIgnitions()
 			Expect(err).NotTo(HaveOccurred())
 
 			masterBytes, err := ioutil.ReadFile(masterPath)
@@ -270,8 +272,8 @@ SV4bRR9i0uf+xQ/oYRvugQ25Q7EahO5hJIWRf4aULbk36Zpw3++v2KFnF26zqwB6
 			Expect(file.Path).To(Equal("/etc/hosts"))
 		})
 		It("with no service ips", func() {
-			g := NewGenerator(workDir, installerCacheDir, cluster, "", "", "", "", nil, log, mockOperatorManager).(*installerGenerator)
-			err := g.UpdateEtcHosts("")
+			g := NewGenerator(workDir, installerCacheDir, cluster, "", "", "", "", "", nil, log, mockOperatorManager).(*installerGenerator)
+			err := g.updateIgnitions()
 			Expect(err).NotTo(HaveOccurred())
 
 			masterBytes, err := ioutil.ReadFile(masterPath)
@@ -286,6 +288,21 @@ SV4bRR9i0uf+xQ/oYRvugQ25Q7EahO5hJIWRf4aULbk36Zpw3++v2KFnF26zqwB6
 			Expect(err).NotTo(HaveOccurred())
 			Expect(workerConfig.Storage.Files).To(HaveLen(0))
 		})
+		It("with additional trust bundle", func() {
+			cluster.AdditionalTrustBundle = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----"
+			g := NewGenerator(workDir, installerCacheDir, cluster, "", "", "", "", "", nil, log, mockOperatorManager).(*installerGenerator)
+			err := g.updateIgnitions()
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, path := range []string{masterPath, workerPath} {
+				ignBytes, err := ioutil.ReadFile(path)
+				Expect(err).NotTo(HaveOccurred())
+				config, _, err := config_32.Parse(ignBytes)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.Storage.Files).To(HaveLen(1))
+				Expect(config.Storage.Files[0].Path).To(Equal(common.AdditionalTrustBundlePath))
+			}
+		})
 		It("get service ip hostnames", func() {
 			content := GetServiceIPHostnames("")
 			Expect(content).To(Equal(""))
@@ -298,7 +315,7 @@ SV4bRR9i0uf+xQ/oYRvugQ25Q7EahO5hJIWRf4aULbk36Zpw3++v2KFnF26zqwB6
 		})
 		Context("DHCP generation", func() {
 			It("Definitions only", func() {
-				g := NewGenerator(workDir, installerCacheDir, cluster, "", "", "", "", nil, log, mockOperatorManager).(*installerGenerator)
+				g := NewGenerator(workDir, installerCacheDir, cluster, "", "", "", "", "", nil, log, mockOperatorManager).(*installerGenerator)
 				g.encodedDhcpFileContents = "data:,abc"
 				err := g.updateIgnitions()
 				Expect(err).NotTo(HaveOccurred())
@@ -315,7 +332,7 @@ SV4bRR9i0uf+xQ/oYRvugQ25Q7EahO5hJIWRf4aULbk36Zpw3++v2KFnF26zqwB6
 			})
 		})
 		It("Definitions+leases", func() {
-			g := NewGenerator(workDir, installerCacheDir, cluster, "", "", "", "", nil, log, mockOperatorManager).(*installerGenerator)
+			g := NewGenerator(workDir, installerCacheDir, cluster, "", "", "", "", "", nil, log, mockOperatorManager).(*installerGenerator)
 			g.encodedDhcpFileContents = "data:,abc"
 			cluster.ApiVipLease = "api"
 			cluster.IngressVipLease = "ingress"
@@ -436,7 +453,7 @@ var _ = Describe("createHostIgnitions", func() {
 				host.ID = &id
 			}
 
-			g := NewGenerator(workDir, installerCacheDir, cluster, "", "", "", "", nil, log, mockOperatorManager).(*installerGenerator)
+			g := NewGenerator(workDir, installerCacheDir, cluster, "", "", "", "", "", nil, log, mockOperatorManager).(*installerGenerator)
 			err := g.createHostIgnitions()
 			Expect(err).NotTo(HaveOccurred())
 
@@ -479,7 +496,7 @@ var _ = Describe("createHostIgnitions", func() {
 			IgnitionConfigOverrides: `{"ignition": {"version": "3.2.0"}, "storage": {"files": [{"path": "/tmp/example", "contents": {"source": "data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj"}}]}}`,
 		}}
 
-		g := NewGenerator(workDir, installerCacheDir, cluster, "", "", "", "", nil, log, mockOperatorManager).(*installerGenerator)
+		g := NewGenerator(workDir, installerCacheDir, cluster, "", "", "", "", "", nil, log, mockOperatorManager).(*installerGenerator)
 		err := g.createHostIgnitions()
 		Expect(err).NotTo(HaveOccurred())
 
@@ -772,6 +789,160 @@ var _ = Describe("ParseToLatest", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(v31Config.Ignition.Version).To(Equal("3.1.0"))
 	})
+
+	It("translates a v30 config to v31", func() {
+		config, err := ParseToLatest([]byte(`{"ignition": {"version": "3.0.0"}, "storage": {"files": [{"path": "/etc/example", "contents": {"source": "data:,hello"}}]}}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.Ignition.Version).To(Equal("3.1.0"))
+		Expect(config.Storage.Files).To(HaveLen(1))
+		Expect(config.Storage.Files[0].Path).To(Equal("/etc/example"))
+	})
+
+	It("translates a v22 config to v31", func() {
+		config, err := ParseToLatest([]byte(`{"ignition": {"version": "2.2.0"},
+			"storage": {"files": [{"filesystem": "root", "path": "/etc/example", "mode": 420, "contents": {"source": "data:,hello"}}]},
+			"systemd": {"units": [{"name": "example.service", "enable": true, "contents": "[Service]"}]},
+			"passwd": {"users": [{"name": "core", "sshAuthorizedKeys": ["ssh-rsa AAAA"]}]}}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.Ignition.Version).To(Equal("3.1.0"))
+		Expect(config.Storage.Files).To(HaveLen(1))
+		Expect(config.Storage.Files[0].Path).To(Equal("/etc/example"))
+		Expect(swag.BoolValue(config.Storage.Files[0].Overwrite)).To(BeTrue())
+		Expect(swag.StringValue(config.Storage.Files[0].Contents.Source)).To(Equal("data:,hello"))
+		Expect(swag.IntValue(config.Storage.Files[0].Mode)).To(Equal(420))
+		Expect(config.Systemd.Units).To(HaveLen(1))
+		Expect(swag.BoolValue(config.Systemd.Units[0].Enabled)).To(BeTrue())
+		Expect(config.Passwd.Users).To(HaveLen(1))
+		Expect(config.Passwd.Users[0].SSHAuthorizedKeys).To(ConsistOf(config_32_types.SSHAuthorizedKey("ssh-rsa AAAA")))
+
+		bytes, err := json.Marshal(config)
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = config_31.Parse(bytes)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("reports the fields of a v2 config that cannot be translated", func() {
+		for content, field := range map[string]string{
+			`{"ignition": {"version": "2.3.0"}, "networkd": {"units": [{"name": "00-eth0.network", "contents": "[Match]"}]}}`: "networkd",
+			`{"ignition": {"version": "2.3.0"},
+				"storage": {"filesystems": [{"name": "data", "mount": {"device": "/dev/sdb1", "format": "xfs"}}],
+					"files": [{"filesystem": "data", "path": "/var/example", "contents": {"source": "data:,hello"}}]}}`: "storage.filesystems",
+			`{"ignition": {"version": "2.3.0"}, "systemd": {"units": [{"name": "example.service"}, {"name": "example.service"}]}}`: "systemd.units",
+		} {
+			_, err := ParseToLatest([]byte(content))
+			Expect(err).To(HaveOccurred())
+			var validationErr *IgnitionValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.FieldErrors()).To(HaveLen(1))
+			Expect(validationErr.FieldErrors()[0].Field).To(Equal(field))
+		}
+	})
+
+	It("reports an invalid v2 config", func() {
+		_, err := ParseToLatest([]byte(`{"ignition": {"version": "2.2.0"}, "storage": {"files": [{"filesystem": "root", "path": "relative/path"}]}}`))
+		Expect(err).To(HaveOccurred())
+		var validationErr *IgnitionValidationError
+		Expect(errors.As(err, &validationErr)).To(BeTrue())
+		Expect(validationErr.FieldErrors()).ToNot(BeEmpty())
+	})
+
+	It("reports the field of an invalid config", func() {
+		_, err := ParseToLatest([]byte(`{"ignition": {"version": "3.1.0"}, "storage": {"files": [{"path": "relative/path"}]}}`))
+		Expect(err).To(HaveOccurred())
+		var validationErr *IgnitionValidationError
+		Expect(errors.As(err, &validationErr)).To(BeTrue())
+		Expect(validationErr.FieldErrors()).To(HaveLen(1))
+		Expect(validationErr.FieldErrors()[0].Field).To(Equal("storage.files.0.path"))
+	})
+
+	for _, version := range []string{"1.0.0", "2.5.0-experimental", "3.3.0", "invalid"} {
+		version := version
+		It(fmt.Sprintf("fails on version %s", version), func() {
+			_, err := ParseToLatest([]byte(fmt.Sprintf(`{"ignition": {"version": "%s"}}`, version)))
+			Expect(err).To(HaveOccurred())
+			var validationErr *IgnitionValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.FieldErrors()[0].Field).To(Equal("ignition.version"))
+		})
+	}
+
+	It("fails on invalid JSON", func() {
+		_, err := ParseToLatest([]byte(`{"ignition": `))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Ignition override validation", func() {
+	It("keeps a v31 discovery override as is", func() {
+		override := `{"ignition": {"version": "3.1.0"}, "storage": {"files": [{"path": "/etc/containers/registries.conf", "contents": {"source": "data:,hello"}}]}}`
+		res, err := ValidateDiscoveryIgnitionOverride(override)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal(override))
+	})
+
+	It("translates a v22 discovery override to v31", func() {
+		res, err := ValidateDiscoveryIgnitionOverride(`{"ignition": {"version": "2.2.0"}, "storage": {"files": [{"filesystem": "root", "path": "/etc/example", "contents": {"source": "data:,hello"}}]}}`)
+		Expect(err).ToNot(HaveOccurred())
+		config, report, err := config_31.Parse([]byte(res))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.IsFatal()).To(BeFalse())
+		Expect(config.Storage.Files).To(HaveLen(1))
+		Expect(config.Storage.Files[0].Path).To(Equal("/etc/example"))
+	})
+
+	It("rejects a v32 discovery override", func() {
+		_, err := ValidateDiscoveryIgnitionOverride(`{"ignition": {"version": "3.2.0"}}`)
+		Expect(err).To(HaveOccurred())
+		var validationErr *IgnitionValidationError
+		Expect(errors.As(err, &validationErr)).To(BeTrue())
+		Expect(validationErr.FieldErrors()[0].Field).To(Equal("ignition.version"))
+	})
+
+	It("rejects discovery overrides of generated files and units", func() {
+		_, err := ValidateDiscoveryIgnitionOverride(`{"ignition": {"version": "3.1.0"},
+			"storage": {"files": [{"path": "/etc/example", "contents": {"source": "data:,hello"}},
+				{"path": "/etc/hosts", "contents": {"source": "data:,hello"}},
+				{"path": "/etc/NetworkManager/system-connections/eth0.nmconnection", "contents": {"source": "data:,hello"}}]},
+			"systemd": {"units": [{"name": "agent.service", "enabled": false}]}}`)
+		Expect(err).To(HaveOccurred())
+		var validationErr *IgnitionValidationError
+		Expect(errors.As(err, &validationErr)).To(BeTrue())
+		fields := make([]string, 0)
+		for _, fieldErr := range validationErr.FieldErrors() {
+			fields = append(fields, fieldErr.Field)
+		}
+		Expect(fields).To(ConsistOf("storage.files.1.path", "storage.files.2.path", "systemd.units.0"))
+	})
+
+	It("accepts dropins of generated units", func() {
+		_, err := ValidateDiscoveryIgnitionOverride(`{"ignition": {"version": "3.1.0"},
+			"systemd": {"units": [{"name": "agent.service", "dropins": [{"name": "10-proxy.conf", "contents": "[Service]"}]}]}}`)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("keeps a v32 host override as is", func() {
+		override := `{"ignition": {"version": "3.2.0"}, "storage": {"files": [{"path": "/etc/example", "contents": {"source": "data:,hello"}}]}}`
+		res, err := ValidateHostIgnitionOverride(override)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal(override))
+	})
+
+	It("rejects host overrides of generated files", func() {
+		_, err := ValidateHostIgnitionOverride(`{"ignition": {"version": "3.1.0"}, "storage": {"files": [{"path": "/etc/hostname", "contents": {"source": "data:,hello"}}]}}`)
+		Expect(err).To(HaveOccurred())
+		var validationErr *IgnitionValidationError
+		Expect(errors.As(err, &validationErr)).To(BeTrue())
+		Expect(validationErr.FieldErrors()).To(HaveLen(1))
+		Expect(validationErr.FieldErrors()[0].Field).To(Equal("storage.files.0.path"))
+	})
+
+	It("merges an override of an older version than the base", func() {
+		res, err := MergeIgnitionConfig([]byte(`{"ignition": {"version": "3.2.0"}}`), []byte(`{"ignition": {"version": "3.0.0"}, "storage": {"files": [{"path": "/etc/example", "contents": {"source": "data:,hello"}}]}}`))
+		Expect(err).ToNot(HaveOccurred())
+		config, _, err := config_32.Parse([]byte(res))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.Storage.Files).To(HaveLen(1))
+	})
 })
 
 var _ = AfterEach(func() {
@@ -951,6 +1122,32 @@ var _ = Describe("IgnitionBuilder", func() {
 		Expect(text).Should(ContainSubstring(`"proxy": { "httpProxy": "http://10.10.1.1:3128", "noProxy": ["*"] }`))
 	})
 
+	It("ignition_file_contains_additional_trust_bundle", func() {
+		cluster.AdditionalTrustBundle = common.TestAdditionalTrustBundle
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(1)
+		text, err := builder.FormatDiscoveryIgnitionFile(&cluster, IgnitionConfig{}, false, auth.TypeRHSSO)
+		Expect(err).NotTo(HaveOccurred())
+
+		config, report, err := config_31.Parse([]byte(text))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.IsFatal()).To(BeFalse())
+		var trustBundleFile *config_31_types.File
+		for i := range config.Storage.Files {
+			if config.Storage.Files[i].Path == common.AdditionalTrustBundlePath {
+				trustBundleFile = &config.Storage.Files[i]
+			}
+		}
+		Expect(trustBundleFile).NotTo(BeNil())
+		Expect(*trustBundleFile.Contents.Source).To(Equal("data:text/plain;base64," + base64.StdEncoding.EncodeToString([]byte(cluster.AdditionalTrustBundle))))
+	})
+
+	It("ignition_file_does_not_contain_an_empty_additional_trust_bundle", func() {
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(1)
+		text, err := builder.FormatDiscoveryIgnitionFile(&cluster, IgnitionConfig{}, false, auth.TypeRHSSO)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(text).NotTo(ContainSubstring(common.AdditionalTrustBundlePath))
+	})
+
 	It("produces a valid ignition v3.1 spec by default", func() {
 		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(1)
 		text, err := builder.FormatDiscoveryIgnitionFile(&cluster, IgnitionConfig{}, false, auth.TypeRHSSO)
@@ -984,7 +1181,7 @@ var _ = Describe("IgnitionBuilder", func() {
 	})
 
 	It("fails when given overrides with an incompatible version", func() {
-		cluster.IgnitionConfigOverrides = `{"ignition": {"version": "2.2.0"}, "storage": {"files": [{"path": "/tmp/example", "contents": {"source": "data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj"}}]}}`
+		cluster.IgnitionConfigOverrides = `{"ignition": {"version": "3.3.0"}, "storage": {"files": [{"path": "/tmp/example", "contents": {"source": "data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj"}}]}}`
 		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(1)
 		_, err := builder.FormatDiscoveryIgnitionFile(&cluster, IgnitionConfig{}, false, auth.TypeRHSSO)
 
@@ -1046,6 +1243,52 @@ var _ = Describe("IgnitionBuilder", func() {
 		})
 	})
 
+	Context("declared host", func() {
+		const bindingToken = "4f1c2c7c0e5d8a9b6f3e2d1c0b9a8f7e6d5c4b3a29180f7e6d5c4b3a2918f0e1"
+		declaredHost := &common.DeclaredHost{
+			DeclaredHost: models.DeclaredHost{
+				ID:                  strfmt.UUID("0d2f7a6e-1c1e-4f36-a2a4-97e0b6c5f3f1"),
+				Hostname:            "worker-0.example.com",
+				StaticNetworkConfig: "declared host static network config",
+			},
+			BindingToken: bindingToken,
+		}
+
+		It("carries the static network config and the hostname of the declared host", func() {
+			mockStaticNetworkConfig.EXPECT().GenerateStaticNetworkConfigData(declaredHost.StaticNetworkConfig).Return(
+				[]staticnetworkconfig.StaticNetworkConfigData{{FilePath: "eth0.nmconnection", FileContents: "eth0 nmconnection content"}}, nil).Times(1)
+			cluster.ImageInfo.StaticNetworkConfig = "cluster static network config"
+			cluster.ImageInfo.Type = models.ImageTypeMinimalIso
+			mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(1)
+			text, err := builder.FormatDeclaredHostDiscoveryIgnitionFile(&cluster, declaredHost, IgnitionConfig{ServiceBaseURL: "https://assisted.example.com"}, false, auth.TypeRHSSO)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(text).To(ContainSubstring("--url https://assisted.example.com/declared-hosts/" + bindingToken + " "))
+
+			config, report, err := config_31.Parse([]byte(text))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.IsFatal()).To(BeFalse())
+			files := map[string]string{}
+			for _, f := range config.Storage.Files {
+				files[f.Path] = swag.StringValue(f.Contents.Source)
+			}
+			Expect(files).To(HaveKeyWithValue("/etc/hostname", "data:,worker-0.example.com"))
+			Expect(files).To(HaveKey(filepath.Join(tempNMConnectionsDir, "eth0.nmconnection")))
+
+			By("leaving the image info of the cluster as is")
+			Expect(cluster.ImageInfo.StaticNetworkConfig).To(Equal("cluster static network config"))
+			Expect(cluster.ImageInfo.Type).To(Equal(models.ImageTypeMinimalIso))
+		})
+
+		It("does not name the host when the declared host has no hostname", func() {
+			mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(1)
+			text, err := builder.FormatDeclaredHostDiscoveryIgnitionFile(&cluster, &common.DeclaredHost{DeclaredHost: models.DeclaredHost{ID: declaredHost.ID}, BindingToken: bindingToken},
+				IgnitionConfig{}, false, auth.TypeRHSSO)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(text).NotTo(ContainSubstring("/etc/hostname"))
+			Expect(text).To(ContainSubstring("/declared-hosts/" + bindingToken))
+		})
+	})
+
 	Context("mirror registries config", func() {
 
 		It("produce ignition with mirror registries config", func() {
@@ -1066,4 +1309,112 @@ var _ = Describe("IgnitionBuilder", func() {
 			Expect(count).Should(Equal(2))
 		})
 	})
+
+	Context("preview host ignition", func() {
+		var host models.Host
+
+		BeforeEach(func() {
+			cluster.Name = "test-cluster"
+			cluster.BaseDNSDomain = "example.com"
+			hostID := strfmt.UUID(uuid.New().String())
+			host = models.Host{ID: &hostID, ClusterID: clusterID, RequestedHostname: "master-0", Role: models.HostRoleMaster}
+			mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(1)
+		})
+
+		findLayer := func(layers []*models.IgnitionLayer, name string) *models.IgnitionLayer {
+			for _, layer := range layers {
+				if layer.Name == name {
+					return layer
+				}
+			}
+			return nil
+		}
+
+		It("renders the discovery and node ignitions with secrets redacted", func() {
+			preview, err := builder.PreviewHostIgnition(&cluster, &host, IgnitionConfig{}, auth.TypeRHSSO)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(preview.Role).To(Equal(models.HostRoleMaster))
+			Expect(preview.DiscoveryLayers).To(HaveLen(1))
+			Expect(preview.DiscoveryLayers[0].Changes).To(ContainElement(&models.IgnitionChange{
+				Kind: models.IgnitionChangeKindUnit, Name: "agent.service", Action: models.IgnitionChangeActionAdded}))
+			Expect(preview.DiscoveryIgnition).To(ContainSubstring("PULL_SECRET_TOKEN=*****"))
+			Expect(preview.DiscoveryIgnition).NotTo(ContainSubstring("cloud.openshift.com"))
+			Expect(preview.DiscoveryIgnition).NotTo(ContainSubstring("dG9rZW46dGVzdAo="))
+
+			Expect(preview.NodeIgnition).To(ContainSubstring("https://api-int.test-cluster.example.com:22623/config/master"))
+			Expect(preview.NodeLayers).To(HaveLen(2))
+			Expect(preview.NodeLayers[0].Name).To(Equal(models.IgnitionLayerNamePointer))
+			Expect(preview.NodeLayers[1]).To(Equal(&models.IgnitionLayer{
+				Name: models.IgnitionLayerNameHostname,
+				Changes: []*models.IgnitionChange{
+					{Kind: models.IgnitionChangeKindFile, Name: "/etc/hostname", Action: models.IgnitionChangeActionAdded},
+				},
+			}))
+			config, err := ParseToLatest([]byte(preview.NodeIgnition))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Storage.Files).To(HaveLen(1))
+			Expect(*config.Storage.Files[0].Contents.Source).To(Equal("data:,master-0"))
+		})
+
+		It("reports the changes of the override layers", func() {
+			cluster.IgnitionConfigOverrides = `{"ignition": {"version": "3.1.0"}, "storage": {"files": [{"path": "/tmp/example", "contents": {"source": "data:,hello"}}]}}`
+			host.IgnitionConfigOverrides = `{"ignition": {"version": "3.1.0"}, "passwd": {"users": [{"name": "core", "passwordHash": "$6$secret"}]}, "storage": {"files": [{"path": "/etc/hostname", "overwrite": true, "contents": {"source": "data:,other"}}]}}`
+			preview, err := builder.PreviewHostIgnition(&cluster, &host, IgnitionConfig{}, auth.TypeRHSSO)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(findLayer(preview.DiscoveryLayers, models.IgnitionLayerNameClusterOverrides).Changes).To(Equal([]*models.IgnitionChange{
+				{Kind: models.IgnitionChangeKindFile, Name: "/tmp/example", Action: models.IgnitionChangeActionAdded},
+			}))
+			Expect(findLayer(preview.NodeLayers, models.IgnitionLayerNameHostOverrides).Changes).To(ConsistOf(
+				&models.IgnitionChange{Kind: models.IgnitionChangeKindFile, Name: "/etc/hostname", Action: models.IgnitionChangeActionModified},
+				&models.IgnitionChange{Kind: models.IgnitionChangeKindUser, Name: "core", Action: models.IgnitionChangeActionAdded},
+			))
+			Expect(preview.NodeIgnition).To(ContainSubstring("data:,other"))
+			Expect(preview.NodeIgnition).NotTo(ContainSubstring("$6$secret"))
+		})
+
+		It("adds the DHCP files to master ignitions", func() {
+			cluster.VipDhcpAllocation = swag.Bool(true)
+			cluster.MachineNetworkCidr = "192.168.126.0/24"
+			cluster.APIVip = "192.168.126.100"
+			cluster.IngressVip = "192.168.126.101"
+			preview, err := builder.PreviewHostIgnition(&cluster, &host, IgnitionConfig{}, auth.TypeRHSSO)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(findLayer(preview.NodeLayers, models.IgnitionLayerNameVipDhcp).Changes).To(ContainElement(&models.IgnitionChange{
+				Kind: models.IgnitionChangeKindFile, Name: "/etc/keepalived/unsupported-monitor.conf", Action: models.IgnitionChangeActionAdded}))
+
+			host.Role = models.HostRoleWorker
+			mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(1)
+			preview, err = builder.PreviewHostIgnition(&cluster, &host, IgnitionConfig{}, auth.TypeRHSSO)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(findLayer(preview.NodeLayers, models.IgnitionLayerNameVipDhcp)).To(BeNil())
+		})
+
+		It("adds the service hosts and the additional trust bundle like the generator", func() {
+			cluster.AdditionalTrustBundle = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----"
+			preview, err := builder.PreviewHostIgnition(&cluster, &host, IgnitionConfig{ServiceIPs: "10.10.10.1"}, auth.TypeRHSSO)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(findLayer(preview.NodeLayers, models.IgnitionLayerNameTrustBundle).Changes).To(Equal([]*models.IgnitionChange{
+				{Kind: models.IgnitionChangeKindFile, Name: common.AdditionalTrustBundlePath, Action: models.IgnitionChangeActionAdded},
+			}))
+			Expect(findLayer(preview.NodeLayers, models.IgnitionLayerNameEtcHosts).Changes).To(Equal([]*models.IgnitionChange{
+				{Kind: models.IgnitionChangeKindFile, Name: "/etc/hosts", Action: models.IgnitionChangeActionAdded},
+			}))
+		})
+
+		It("skips the node ignition of auto-assigned hosts", func() {
+			host.Role = models.HostRoleAutoAssign
+			preview, err := builder.PreviewHostIgnition(&cluster, &host, IgnitionConfig{}, auth.TypeRHSSO)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(preview.DiscoveryIgnition).NotTo(BeEmpty())
+			Expect(preview.NodeIgnition).To(BeEmpty())
+			Expect(preview.NodeLayers).To(BeEmpty())
+		})
+
+		It("fails on invalid host overrides", func() {
+			host.IgnitionConfigOverrides = `{"ignition": {"version": "3.3.0"}}`
+			_, err := builder.PreviewHostIgnition(&cluster, &host, IgnitionConfig{}, auth.TypeRHSSO)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
@@ -8,6 +8,7 @@ import (
 	context "context"
 	gomock "github.com/golang/mock/gomock"
 	common "github.com/openshift/assisted-service/internal/common"
+	models "github.com/openshift/assisted-service/models"
 	auth "github.com/openshift/assisted-service/pkg/auth"
 	reflect "reflect"
 )
@@ -63,20 +64,6 @@ func (mr *MockGeneratorMockRecorder) UploadToS3(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadToS3", reflect.TypeOf((*MockGenerator)(nil).UploadToS3), ctx)
 }
 
-// UpdateEtcHosts mocks base method
-func (m *MockGenerator) UpdateEtcHosts(arg0 string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEtcHosts", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// UpdateEtcHosts indicates an expected call of UpdateEtcHosts
-func (mr *MockGeneratorMockRecorder) UpdateEtcHosts(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEtcHosts", reflect.TypeOf((*MockGenerator)(nil).UpdateEtcHosts), arg0)
-}
-
 // MockIgnitionBuilder is a mock of IgnitionBuilder interface
 type MockIgnitionBuilder struct {
 	ctrl     *gomock.Controller
@@ -100,6 +87,21 @@ func (m *MockIgnitionBuilder) EXPECT() *MockIgnitionBuilderMockRecorder {
 	return m.recorder
 }
 
+// FormatDeclaredHostDiscoveryIgnitionFile mocks base method
+func (m *MockIgnitionBuilder) FormatDeclaredHostDiscoveryIgnitionFile(cluster *common.Cluster, declaredHost *common.DeclaredHost, cfg IgnitionConfig, safeForLogs bool, authType auth.AuthType) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FormatDeclaredHostDiscoveryIgnitionFile", cluster, declaredHost, cfg, safeForLogs, authType)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FormatDeclaredHostDiscoveryIgnitionFile indicates an expected call of FormatDeclaredHostDiscoveryIgnitionFile
+func (mr *MockIgnitionBuilderMockRecorder) FormatDeclaredHostDiscoveryIgnitionFile(cluster, declaredHost, cfg, safeForLogs, authType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FormatDeclaredHostDiscoveryIgnitionFile", reflect.TypeOf((*MockIgnitionBuilder)(nil).FormatDeclaredHostDiscoveryIgnitionFile), cluster, declaredHost, cfg, safeForLogs, authType)
+}
+
 // FormatDiscoveryIgnitionFile mocks base method
 func (m *MockIgnitionBuilder) FormatDiscoveryIgnitionFile(cluster *common.Cluster, cfg IgnitionConfig, safeForLogs bool, authType auth.AuthType) (string, error) {
 	m.ctrl.T.Helper()
@@ -129,3 +131,18 @@ func (mr *MockIgnitionBuilderMockRecorder) FormatSecondDayWorkerIgnitionFile(add
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FormatSecondDayWorkerIgnitionFile", reflect.TypeOf((*MockIgnitionBuilder)(nil).FormatSecondDayWorkerIgnitionFile), address, machineConfigPoolName)
 }
+
+// PreviewHostIgnition mocks base method
+func (m *MockIgnitionBuilder) PreviewHostIgnition(cluster *common.Cluster, host *models.Host, cfg IgnitionConfig, authType auth.AuthType) (*models.IgnitionPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewHostIgnition", cluster, host, cfg, authType)
+	ret0, _ := ret[0].(*models.IgnitionPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewHostIgnition indicates an expected call of PreviewHostIgnition
+func (mr *MockIgnitionBuilderMockRecorder) PreviewHostIgnition(cluster, host, cfg, authType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewHostIgnition", reflect.TypeOf((*MockIgnitionBuilder)(nil).PreviewHostIgnition), cluster, host, cfg, authType)
+}
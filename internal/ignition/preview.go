@@ -0,0 +1,267 @@
+package ignition
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	config_latest_types "github.com/coreos/ignition/v2/config/v3_2/types"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/pkg/errors"
+)
+
+// pointerIgnitionFormat projects the pointer ignition generated by openshift-install for each role.
+// The machine config server CA is only known once the installation starts, so it is always redacted.
+const pointerIgnitionFormat = `{"ignition":{"version":"3.1.0","config":{"merge":[{"source":"https://api-int.%s.%s:22623/config/%s"}]},"security":{"tls":{"certificateAuthorities":[{"source":"data:,%s"}]}}}}`
+
+var (
+	sensitiveIgnitionFiles = map[string]bool{
+		"/root/.docker/config.json":    true,
+		"/var/lib/kubelet/config.json": true,
+	}
+	pullSecretTokenRegex = regexp.MustCompile(`PULL_SECRET_TOKEN=[^\s\\]*`)
+)
+
+// layeredIgnition applies layers to an ignition config, recording the entries each of them changed
+type layeredIgnition struct {
+	config *config_latest_types.Config
+	layers []*models.IgnitionLayer
+}
+
+func (l *layeredIgnition) apply(name string, layer func(config *config_latest_types.Config) (*config_latest_types.Config, error)) error {
+	before := ignitionEntryValues(l.config)
+	config, err := layer(l.config)
+	if err != nil {
+		return errors.Wrapf(err, "failed to apply ignition layer %s", name)
+	}
+	l.config = config
+	l.layers = append(l.layers, &models.IgnitionLayer{Name: name, Changes: ignitionChanges(before, config)})
+	return nil
+}
+
+// PreviewHostIgnition renders the discovery ignition of the cluster and projects the ignition the host boots
+// with once it is written to disk, layer by layer. Secrets are redacted from the returned configs.
+func (ib *ignitionBuilder) PreviewHostIgnition(cluster *common.Cluster, host *models.Host, cfg IgnitionConfig, authType auth.AuthType) (*models.IgnitionPreview, error) {
+	discovery := &layeredIgnition{config: &config_latest_types.Config{}}
+	err := discovery.apply(models.IgnitionLayerNameTemplate, func(*config_latest_types.Config) (*config_latest_types.Config, error) {
+		res, templateErr := ib.formatDiscoveryIgnitionTemplate(cluster, nil, cfg, false, authType)
+		if templateErr != nil {
+			return nil, templateErr
+		}
+		return ParseToLatest([]byte(res))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cluster.IgnitionConfigOverrides != "" {
+		if err = discovery.apply(models.IgnitionLayerNameClusterOverrides, mergeOverridesLayer(cluster.IgnitionConfigOverrides)); err != nil {
+			return nil, err
+		}
+	}
+
+	preview := &models.IgnitionPreview{Role: host.Role, DiscoveryLayers: discovery.layers}
+	if preview.DiscoveryIgnition, err = redactIgnition(discovery.config); err != nil {
+		return nil, err
+	}
+
+	// The role of an auto-assigned host is only decided once the installation starts
+	if host.Role != models.HostRoleMaster && host.Role != models.HostRoleWorker {
+		return preview, nil
+	}
+	node, err := projectNodeIgnition(cluster, host, cfg)
+	if err != nil {
+		return nil, err
+	}
+	preview.NodeLayers = node.layers
+	if preview.NodeIgnition, err = redactIgnition(node.config); err != nil {
+		return nil, err
+	}
+	return preview, nil
+}
+
+// projectNodeIgnition applies the layers of the generator to the pointer ignition of the host role
+func projectNodeIgnition(cluster *common.Cluster, host *models.Host, cfg IgnitionConfig) (*layeredIgnition, error) {
+	node := &layeredIgnition{config: &config_latest_types.Config{}}
+	err := node.apply(models.IgnitionLayerNamePointer, func(*config_latest_types.Config) (*config_latest_types.Config, error) {
+		return ParseToLatest([]byte(fmt.Sprintf(pointerIgnitionFormat, cluster.Name, cluster.BaseDNSDomain, host.Role, common.RedactedValue)))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	encodedDhcpFileContents, err := network.GetEncodedDhcpParamFileContents(cluster)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not create DHCP encoded file")
+	}
+	layers, err := roleIgnitionLayers(cluster, host.Role, cfg.ServiceCACertPath, encodedDhcpFileContents, cfg.ServiceIPs)
+	if err != nil {
+		return nil, err
+	}
+	for _, layer := range append(layers, hostIgnitionLayers(host)...) {
+		if err = node.apply(layer.name, layer.apply); err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+type ignitionEntry struct {
+	kind  string
+	name  string
+	value string
+}
+
+// ignitionEntries lists the entries of an ignition config that a layer may add or modify
+func ignitionEntries(config *config_latest_types.Config) []ignitionEntry {
+	marshal := func(v interface{}) string {
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+	entries := []ignitionEntry{{kind: models.IgnitionChangeKindConfig, name: "ignition", value: marshal(config.Ignition)}}
+	entries = append(entries,
+		ignitionEntry{kind: models.IgnitionChangeKindConfig, name: "storage.disks", value: marshal(config.Storage.Disks)},
+		ignitionEntry{kind: models.IgnitionChangeKindConfig, name: "storage.raid", value: marshal(config.Storage.Raid)},
+		ignitionEntry{kind: models.IgnitionChangeKindConfig, name: "storage.filesystems", value: marshal(config.Storage.Filesystems)},
+		ignitionEntry{kind: models.IgnitionChangeKindConfig, name: "storage.luks", value: marshal(config.Storage.Luks)},
+	)
+	for _, file := range config.Storage.Files {
+		entries = append(entries, ignitionEntry{kind: models.IgnitionChangeKindFile, name: file.Path, value: marshal(file)})
+	}
+	for _, directory := range config.Storage.Directories {
+		entries = append(entries, ignitionEntry{kind: models.IgnitionChangeKindDirectory, name: directory.Path, value: marshal(directory)})
+	}
+	for _, link := range config.Storage.Links {
+		entries = append(entries, ignitionEntry{kind: models.IgnitionChangeKindLink, name: link.Path, value: marshal(link)})
+	}
+	for _, unit := range config.Systemd.Units {
+		dropins := unit.Dropins
+		unit.Dropins = nil
+		entries = append(entries, ignitionEntry{kind: models.IgnitionChangeKindUnit, name: unit.Name, value: marshal(unit)})
+		for _, dropin := range dropins {
+			entries = append(entries, ignitionEntry{kind: models.IgnitionChangeKindDropin, name: unit.Name + "/" + dropin.Name, value: marshal(dropin)})
+		}
+	}
+	for _, user := range config.Passwd.Users {
+		entries = append(entries, ignitionEntry{kind: models.IgnitionChangeKindUser, name: user.Name, value: marshal(user)})
+	}
+	for _, group := range config.Passwd.Groups {
+		entries = append(entries, ignitionEntry{kind: models.IgnitionChangeKindGroup, name: group.Name, value: marshal(group)})
+	}
+	return entries
+}
+
+func ignitionEntryKey(entry ignitionEntry) string {
+	return entry.kind + ":" + entry.name
+}
+
+// ignitionEntryValues maps each entry to its value. Entries listed more than once, such as appended files,
+// are concatenated.
+func ignitionEntryValues(config *config_latest_types.Config) map[string]string {
+	values := make(map[string]string)
+	for _, entry := range ignitionEntries(config) {
+		values[ignitionEntryKey(entry)] += entry.value
+	}
+	return values
+}
+
+func ignitionChanges(before map[string]string, config *config_latest_types.Config) []*models.IgnitionChange {
+	after := ignitionEntryValues(config)
+	changes := make([]*models.IgnitionChange, 0)
+	reported := make(map[string]bool)
+	for _, entry := range ignitionEntries(config) {
+		key := ignitionEntryKey(entry)
+		if reported[key] {
+			continue
+		}
+		reported[key] = true
+		// Config entries are listed for every config, so they can only be modified
+		previous, existed := before[key]
+		switch {
+		case !existed:
+			changes = append(changes, &models.IgnitionChange{Kind: entry.kind, Name: entry.name, Action: models.IgnitionChangeActionAdded})
+		case previous != after[key]:
+			changes = append(changes, &models.IgnitionChange{Kind: entry.kind, Name: entry.name, Action: models.IgnitionChangeActionModified})
+		}
+	}
+	return changes
+}
+
+// redactIgnition returns the JSON representation of an ignition config, without its secrets
+func redactIgnition(config *config_latest_types.Config) (string, error) {
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	var redacted config_latest_types.Config
+	if err = json.Unmarshal(configBytes, &redacted); err != nil {
+		return "", err
+	}
+
+	redactURL := func(value *string) {
+		if value != nil {
+			*value = common.RedactURLCredentials(*value)
+		}
+	}
+	redactResource := func(resource *config_latest_types.Resource) {
+		redactURL(resource.Source)
+		for i := range resource.HTTPHeaders {
+			if resource.HTTPHeaders[i].Value != nil {
+				resource.HTTPHeaders[i].Value = swag.String(common.RedactedValue)
+			}
+		}
+	}
+	redactUnit := func(contents *string) {
+		if contents != nil {
+			*contents = pullSecretTokenRegex.ReplaceAllString(*contents, "PULL_SECRET_TOKEN="+common.RedactedValue)
+			redactURL(contents)
+		}
+	}
+
+	redactURL(redacted.Ignition.Proxy.HTTPProxy)
+	redactURL(redacted.Ignition.Proxy.HTTPSProxy)
+	for i := range redacted.Ignition.Config.Merge {
+		redactResource(&redacted.Ignition.Config.Merge[i])
+	}
+	redactResource(&redacted.Ignition.Config.Replace)
+	for i := range redacted.Ignition.Security.TLS.CertificateAuthorities {
+		redactResource(&redacted.Ignition.Security.TLS.CertificateAuthorities[i])
+	}
+	for i := range redacted.Storage.Files {
+		file := &redacted.Storage.Files[i]
+		if sensitiveIgnitionFiles[file.Path] {
+			if file.Contents.Source != nil {
+				file.Contents.Source = swag.String("data:," + common.RedactedValue)
+			}
+			for j := range file.Append {
+				file.Append[j].Source = swag.String("data:," + common.RedactedValue)
+			}
+		}
+		redactResource(&file.Contents)
+		for j := range file.Append {
+			redactResource(&file.Append[j])
+		}
+	}
+	for i := range redacted.Systemd.Units {
+		unit := &redacted.Systemd.Units[i]
+		redactUnit(unit.Contents)
+		for j := range unit.Dropins {
+			redactUnit(unit.Dropins[j].Contents)
+		}
+	}
+	for i := range redacted.Passwd.Users {
+		user := &redacted.Passwd.Users[i]
+		if user.PasswordHash != nil && *user.PasswordHash != "" && *user.PasswordHash != "!" {
+			user.PasswordHash = swag.String(common.RedactedValue)
+		}
+	}
+
+	res, err := json.Marshal(redacted)
+	if err != nil {
+		return "", err
+	}
+	return string(res), nil
+}
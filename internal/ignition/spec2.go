@@ -0,0 +1,58 @@
+package ignition
+
+import (
+	"github.com/coreos/ign-converter/translate/v24tov31"
+	"github.com/coreos/ign-converter/util"
+	config_24 "github.com/coreos/ignition/config/v2_4"
+	v2_report "github.com/coreos/ignition/config/validate/report"
+	config_latest_trans "github.com/coreos/ignition/v2/config/v3_2/translate"
+	config_latest_types "github.com/coreos/ignition/v2/config/v3_2/types"
+	"github.com/openshift/assisted-service/models"
+)
+
+// spec2ReportFieldErrors converts the fatal entries of a spec 2 validation report to field errors. Spec 2 reports
+// locate entries by line and column rather than by field.
+func spec2ReportFieldErrors(rpt v2_report.Report, err error) *IgnitionValidationError {
+	var fieldErrors []*models.FieldError
+	for _, entry := range rpt.Entries {
+		if entry.Kind == v2_report.EntryError {
+			fieldErrors = append(fieldErrors, &models.FieldError{Message: entry.String()})
+		}
+	}
+	if len(fieldErrors) == 0 {
+		fieldErrors = append(fieldErrors, &models.FieldError{Message: err.Error()})
+	}
+	return newIgnitionValidationError(fieldErrors...)
+}
+
+// spec2TranslationFieldError reports why ign-converter refused to translate a config
+func spec2TranslationFieldError(err error) *models.FieldError {
+	fieldErr := &models.FieldError{Message: err.Error()}
+	switch err.(type) {
+	case util.NoFilesystemError:
+		fieldErr.Field = "storage.filesystems"
+	case util.DuplicateInodeError, *util.DuplicateInodeError, util.UsesOwnLinkError, *util.UsesOwnLinkError:
+		fieldErr.Field = "storage"
+	case util.DuplicateUnitError, util.DuplicateDropinError:
+		fieldErr.Field = "systemd.units"
+	default:
+		if err == util.UsesNetworkdError {
+			fieldErr.Field = "networkd"
+		}
+	}
+	return fieldErr
+}
+
+// parseSpec2 parses a spec 2.x config and translates it with ign-converter, which refuses the configs that
+// cannot be translated to spec 3 without changing their meaning. Nodes may only be written to the root filesystem.
+func parseSpec2(content []byte) (config_latest_types.Config, *IgnitionValidationError) {
+	old, rpt, err := config_24.Parse(content)
+	if err != nil {
+		return config_latest_types.Config{}, spec2ReportFieldErrors(rpt, err)
+	}
+	translated, err := v24tov31.Translate(old, nil)
+	if err != nil {
+		return config_latest_types.Config{}, newIgnitionValidationError(spec2TranslationFieldError(err))
+	}
+	return config_latest_trans.Translate(translated), nil
+}
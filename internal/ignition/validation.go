@@ -0,0 +1,276 @@
+package ignition
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ign_errors "github.com/coreos/ignition/v2/config/shared/errors"
+	config_30 "github.com/coreos/ignition/v2/config/v3_0"
+	config_30_types "github.com/coreos/ignition/v2/config/v3_0/types"
+	config_31 "github.com/coreos/ignition/v2/config/v3_1"
+	config_31_trans "github.com/coreos/ignition/v2/config/v3_1/translate"
+	config_31_types "github.com/coreos/ignition/v2/config/v3_1/types"
+	config_latest "github.com/coreos/ignition/v2/config/v3_2"
+	config_latest_trans "github.com/coreos/ignition/v2/config/v3_2/translate"
+	config_latest_types "github.com/coreos/ignition/v2/config/v3_2/types"
+	"github.com/coreos/vcontext/report"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/thoas/go-funk"
+)
+
+// IgnitionValidationError reports the problems found in an ignition config, field by field
+type IgnitionValidationError struct {
+	fieldErrors []*models.FieldError
+}
+
+func newIgnitionValidationError(fieldErrors ...*models.FieldError) *IgnitionValidationError {
+	return &IgnitionValidationError{fieldErrors: fieldErrors}
+}
+
+func (e *IgnitionValidationError) Error() string {
+	messages := make([]string, 0, len(e.fieldErrors))
+	for _, fieldErr := range e.fieldErrors {
+		if fieldErr.Field == "" {
+			messages = append(messages, fieldErr.Message)
+		} else {
+			messages = append(messages, fmt.Sprintf("%s: %s", fieldErr.Field, fieldErr.Message))
+		}
+	}
+	return fmt.Sprintf("invalid ignition config: %s", strings.Join(messages, "; "))
+}
+
+// FieldErrors returns the problems found in the config, so that they are reported in the API error
+func (e *IgnitionValidationError) FieldErrors() []*models.FieldError {
+	return e.fieldErrors
+}
+
+// reportFieldErrors converts the fatal entries of a spec 3 validation report to field errors
+func reportFieldErrors(rpt report.Report, err error) *IgnitionValidationError {
+	var fieldErrors []*models.FieldError
+	for _, entry := range rpt.Entries {
+		if !entry.Kind.IsFatal() {
+			continue
+		}
+		message := entry.Message
+		if entry.Marker.StartP != nil {
+			message = fmt.Sprintf("%s (%s)", message, entry.Marker.String())
+		}
+		fieldErrors = append(fieldErrors, &models.FieldError{
+			Field:   strings.TrimPrefix(strings.TrimPrefix(entry.Context.String(), "$"), "."),
+			Message: message,
+		})
+	}
+	if len(fieldErrors) == 0 {
+		message := ign_errors.ErrInvalid.Error()
+		if err != nil {
+			message = err.Error()
+		}
+		fieldErrors = append(fieldErrors, &models.FieldError{Message: message})
+	}
+	return newIgnitionValidationError(fieldErrors...)
+}
+
+// spec2Versions are the spec 2 versions that ign-converter translates, through spec 2.4
+var spec2Versions = []string{"2.0.0", "2.1.0", "2.2.0", "2.3.0", "2.4.0"}
+
+// parseAnyVersion parses a config of any supported spec version. Configs older than spec 3.1 are translated
+// to spec 3.1, which every supported OpenShift version boots with, and reported as translated.
+func parseAnyVersion(content []byte) (*config_latest_types.Config, bool, *IgnitionValidationError) {
+	if len(content) == 0 {
+		return nil, false, newIgnitionValidationError(&models.FieldError{Message: ign_errors.ErrEmpty.Error()})
+	}
+	stub := struct {
+		Ignition struct {
+			Version string `json:"version"`
+		} `json:"ignition"`
+	}{}
+	if err := json.Unmarshal(content, &stub); err != nil {
+		return nil, false, newIgnitionValidationError(&models.FieldError{Message: fmt.Sprintf("%s: %s", ign_errors.ErrInvalid, err)})
+	}
+	version := stub.Ignition.Version
+
+	var config config_latest_types.Config
+	switch {
+	case version == config_latest_types.MaxVersion.String():
+		configv32, rpt, err := config_latest.Parse(content)
+		if err != nil {
+			return nil, false, reportFieldErrors(rpt, err)
+		}
+		return &configv32, false, nil
+	case version == config_31_types.MaxVersion.String():
+		configv31, rpt, parseErr := config_31.Parse(content)
+		if parseErr != nil {
+			return nil, false, reportFieldErrors(rpt, parseErr)
+		}
+		config = config_latest_trans.Translate(configv31)
+		config.Ignition.Version = config_31_types.MaxVersion.String()
+		return &config, false, nil
+	case version == config_30_types.MaxVersion.String():
+		configv30, rpt, parseErr := config_30.Parse(content)
+		if parseErr != nil {
+			return nil, false, reportFieldErrors(rpt, parseErr)
+		}
+		config = config_latest_trans.Translate(config_31_trans.Translate(configv30))
+	case funk.ContainsString(spec2Versions, version):
+		var validationErr *IgnitionValidationError
+		if config, validationErr = parseSpec2(content); validationErr != nil {
+			return nil, false, validationErr
+		}
+	default:
+		return nil, false, newIgnitionValidationError(&models.FieldError{
+			Field:   "ignition.version",
+			Message: fmt.Sprintf("%s %q, supported versions are 2.0.0 to 2.4.0, 3.0.0, 3.1.0 and 3.2.0", ign_errors.ErrUnknownVersion, version),
+		})
+	}
+	config.Ignition.Version = config_31_types.MaxVersion.String()
+	return &config, true, nil
+}
+
+// generatedIgnitionEntries lists the files and units the service generates in an ignition config
+type generatedIgnitionEntries struct {
+	paths        []string
+	pathPrefixes []string
+	units        []string
+}
+
+var discoveryIgnitionGeneratedEntries = generatedIgnitionEntries{
+	paths: []string{
+		"/etc/hosts",
+		"/etc/motd",
+		"/etc/NetworkManager/conf.d/01-ipv6.conf",
+		"/etc/NetworkManager/conf.d/02-hostname-mode.conf",
+		"/root/.docker/config.json",
+		"/root/assisted.te",
+		"/usr/local/bin/agent-fix-bz1964591",
+		"/usr/local/bin/pre-network-manager-config.sh",
+		common.HostCACertPath,
+	},
+	pathPrefixes: []string{
+		tempNMConnectionsDir + "/",
+		"/etc/NetworkManager/system-connections/",
+	},
+	units: []string{
+		"agent.service",
+		"pre-network-manager-config.service",
+		"selinux.service",
+	},
+}
+
+var nodeIgnitionGeneratedEntries = generatedIgnitionEntries{
+	paths: []string{
+		"/etc/hostname",
+		"/etc/hosts",
+		"/etc/keepalived/lease-api",
+		"/etc/keepalived/lease-ingress",
+		"/etc/keepalived/unsupported-monitor.conf",
+		"/etc/NetworkManager/conf.d/01-ipv6.conf",
+		"/etc/tmpfiles.d/kni.conf",
+		common.HostCACertPath,
+	},
+	pathPrefixes: []string{
+		"/etc/NetworkManager/system-connections/",
+	},
+	units: []string{
+		"etc-NetworkManager-system\\x2dconnections\\x2dmerged.mount",
+	},
+}
+
+func (g generatedIgnitionEntries) isGeneratedPath(path string) bool {
+	for _, generated := range g.paths {
+		if path == generated {
+			return true
+		}
+	}
+	for _, prefix := range g.pathPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g generatedIgnitionEntries) isGeneratedUnit(name string) bool {
+	for _, generated := range g.units {
+		if name == generated {
+			return true
+		}
+	}
+	return false
+}
+
+// conflicts reports the entries of an override that would replace generated files or units once merged.
+// Units may still be customized with dropins.
+func (g generatedIgnitionEntries) conflicts(config *config_latest_types.Config) []*models.FieldError {
+	var fieldErrors []*models.FieldError
+	checkPath := func(field, path string) {
+		if g.isGeneratedPath(path) {
+			fieldErrors = append(fieldErrors, &models.FieldError{
+				Field:   field,
+				Message: fmt.Sprintf("%s is generated by the service and cannot be overridden", path),
+			})
+		}
+	}
+	for i, file := range config.Storage.Files {
+		checkPath(fmt.Sprintf("storage.files.%d.path", i), file.Path)
+	}
+	for i, directory := range config.Storage.Directories {
+		checkPath(fmt.Sprintf("storage.directories.%d.path", i), directory.Path)
+	}
+	for i, link := range config.Storage.Links {
+		checkPath(fmt.Sprintf("storage.links.%d.path", i), link.Path)
+	}
+	for i, unit := range config.Systemd.Units {
+		if g.isGeneratedUnit(unit.Name) && (unit.Contents != nil || unit.Enabled != nil || unit.Mask != nil) {
+			fieldErrors = append(fieldErrors, &models.FieldError{
+				Field:   fmt.Sprintf("systemd.units.%d", i),
+				Message: fmt.Sprintf("%s is generated by the service and cannot be overridden, use a dropin to customize it", unit.Name),
+			})
+		}
+	}
+	return fieldErrors
+}
+
+// validateIgnitionOverride parses an override and checks it against the generated entries it is merged with.
+// Overrides that needed no translation are returned as they were submitted.
+func validateIgnitionOverride(override string, generated generatedIgnitionEntries) (string, *config_latest_types.Config, error) {
+	config, translated, validationErr := parseAnyVersion([]byte(override))
+	if validationErr != nil {
+		return "", nil, validationErr
+	}
+	if fieldErrors := generated.conflicts(config); len(fieldErrors) > 0 {
+		return "", nil, newIgnitionValidationError(fieldErrors...)
+	}
+	if !translated {
+		return override, config, nil
+	}
+	res, err := json.Marshal(config)
+	if err != nil {
+		return "", nil, err
+	}
+	return string(res), config, nil
+}
+
+// ValidateDiscoveryIgnitionOverride validates an override of the discovery ignition of any supported spec version,
+// and returns it translated to spec 3.1, which the discovery ignition uses
+func ValidateDiscoveryIgnitionOverride(override string) (string, error) {
+	res, config, err := validateIgnitionOverride(override, discoveryIgnitionGeneratedEntries)
+	if err != nil {
+		return "", err
+	}
+	if config.Ignition.Version != config_31_types.MaxVersion.String() {
+		return "", newIgnitionValidationError(&models.FieldError{
+			Field:   "ignition.version",
+			Message: fmt.Sprintf("the discovery ignition uses version %s, overrides of version %s cannot be merged into it", config_31_types.MaxVersion, config.Ignition.Version),
+		})
+	}
+	return res, nil
+}
+
+// ValidateHostIgnitionOverride validates an override of the pointer ignition of a host of any supported spec version,
+// and returns it translated to spec 3.1 unless it uses spec 3.2
+func ValidateHostIgnitionOverride(override string) (string, error) {
+	res, _, err := validateIgnitionOverride(override, nodeIgnitionGeneratedEntries)
+	return res, err
+}
@@ -2,39 +2,52 @@ package imgexpirer
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"time"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/quota"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/leader"
+	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/pkg/requestid"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/sirupsen/logrus"
 )
 
 const imagePrefix = "discovery-image-"
-const imageRegex = imagePrefix + `(?P<uuid>[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{12}).iso`
+const imageRegex = imagePrefix + `(?P<uuid>[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{12})(.iso|-pxe-(?P<artifact>\S+)|-declared-(?P<declared>\S+)\.iso)`
 const AssistedServiceLiveISOPrefix = "assisted-service-iso-"
+const imageCachePrefix = "discovery-cache-"
 
 var (
-	//Image name format is "discovery-image-<clusterID>.iso"
+	//Image name format is "discovery-image-<clusterID>.iso", "discovery-image-<clusterID>-pxe-<artifact>" for network boot artifacts,
+	//or "discovery-image-<clusterID>-declared-<declaredHostID>.iso" for images of declared hosts
 	uuidRegex = regexp.MustCompile(imageRegex)
+	//Cached image name format is "discovery-cache-<content hash>.<iso, img or qcow2>"
+	contentHashRegex = regexp.MustCompile(imageCachePrefix + `([a-f0-9]{64})\.(iso|img|qcow2)`)
 )
 
 type Manager struct {
+	db            *gorm.DB
 	objectHandler s3wrapper.API
 	eventsHandler events.Handler
+	quotaApi      quota.API
 	deleteTime    time.Duration
 	leaderElector leader.Leader
 	enableKubeAPI bool
 }
 
-func NewManager(objectHandler s3wrapper.API, eventsHandler events.Handler, deleteTime time.Duration, leaderElector leader.ElectorInterface, enableKubeAPI bool) *Manager {
+func NewManager(db *gorm.DB, objectHandler s3wrapper.API, eventsHandler events.Handler, quotaApi quota.API, deleteTime time.Duration, leaderElector leader.ElectorInterface, enableKubeAPI bool) *Manager {
 	return &Manager{
+		db:            db,
 		objectHandler: objectHandler,
 		eventsHandler: eventsHandler,
+		quotaApi:      quotaApi,
 		deleteTime:    deleteTime,
 		leaderElector: leaderElector,
 		enableKubeAPI: enableKubeAPI,
@@ -48,20 +61,68 @@ func (m *Manager) ExpirationTask() {
 	ctx := requestid.ToContext(context.Background(), requestid.NewID())
 	if !m.enableKubeAPI {
 		m.objectHandler.ExpireObjects(ctx, imagePrefix, m.deleteTime, m.DeletedImageCallback)
+		m.releaseExpiredImages(ctx)
+		m.objectHandler.ExpireObjectsUnlessReferenced(ctx, imageCachePrefix, m.deleteTime, m.IsImageReferenced, m.DeletedCachedImageCallback)
 	}
 	m.objectHandler.ExpireObjects(ctx, AssistedServiceLiveISOPrefix, m.deleteTime, m.DeletedImageNoCallback)
 }
 
 func (m *Manager) DeletedImageCallback(ctx context.Context, log logrus.FieldLogger, objectName string) {
+	if err := m.quotaApi.Release(ctx, objectName); err != nil {
+		log.WithError(err).Warnf("Failed to release the quota of image %s", objectName)
+	}
 	matches := uuidRegex.FindStringSubmatch(objectName)
-	if len(matches) != 2 {
+	if len(matches) != 5 {
 		log.Errorf("Cannot find cluster ID in object name: %s", objectName)
 		return
 	}
+	// Report a single event for the several network boot artifacts of an image
+	if artifact := matches[3]; artifact != "" && artifact != s3wrapper.PXEInitrd {
+		return
+	}
 	clusterID := strfmt.UUID(matches[1])
+	if declaredHostID := matches[4]; declaredHostID != "" {
+		m.eventsHandler.AddEvent(ctx, clusterID, nil, models.EventSeverityInfo,
+			fmt.Sprintf("Deleted image of declared host %s from backend because it expired. It may be generated again at any time.", declaredHostID), time.Now())
+		return
+	}
 	m.eventsHandler.AddEvent(ctx, clusterID, nil, models.EventSeverityInfo,
 		"Deleted image from backend because it expired. It may be generated again at any time.", time.Now())
 }
 
+// DeletedCachedImageCallback stops charging a cached image, which no cluster references once it is deleted
+func (m *Manager) DeletedCachedImageCallback(ctx context.Context, log logrus.FieldLogger, objectName string) {
+	if err := m.quotaApi.Release(ctx, objectName); err != nil {
+		log.WithError(err).Warnf("Failed to release the quota of cached image %s", objectName)
+	}
+}
+
+func (m *Manager) releaseExpiredImages(ctx context.Context) {
+	log := logutil.FromContext(ctx, logrus.StandardLogger())
+	released, err := common.ReleaseExpiredImageReferences(m.db)
+	if err != nil {
+		log.WithError(err).Error("Failed to release the cached images of clusters whose image expired")
+		return
+	}
+	if released > 0 {
+		log.Infof("Released the cached images of %d clusters whose image expired", released)
+	}
+}
+
+// IsImageReferenced reports whether a cluster still uses a cached image. Images are kept when it cannot be determined.
+func (m *Manager) IsImageReferenced(ctx context.Context, log logrus.FieldLogger, objectName string) bool {
+	matches := contentHashRegex.FindStringSubmatch(objectName)
+	if len(matches) != 3 {
+		log.Errorf("Cannot find content hash in object name: %s", objectName)
+		return true
+	}
+	references, err := common.CountImageReferences(m.db, matches[1])
+	if err != nil {
+		log.WithError(err).Errorf("Failed to count the clusters referencing image %s", objectName)
+		return true
+	}
+	return references > 0
+}
+
 func (m *Manager) DeletedImageNoCallback(ctx context.Context, log logrus.FieldLogger, objectName string) {
 }
@@ -12,6 +12,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/quota"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/leader"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
@@ -29,6 +30,7 @@ var _ = Describe("imgexpirer", func() {
 		ctx        = context.Background()
 		ctrl       *gomock.Controller
 		mockEvents *events.MockHandler
+		mockQuota  *quota.MockAPI
 		leaderMock *leader.MockElectorInterface
 		log        = logrus.New()
 	)
@@ -37,19 +39,47 @@ var _ = Describe("imgexpirer", func() {
 		log.SetOutput(ioutil.Discard)
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
+		mockQuota = quota.NewMockAPI(ctrl)
 		deleteTime, _ := time.ParseDuration("60m")
 		leaderMock = leader.NewMockElectorInterface(ctrl)
-		imgExp = NewManager(nil, mockEvents, deleteTime, leaderMock, false)
+		imgExp = NewManager(nil, nil, mockEvents, mockQuota, deleteTime, leaderMock, false)
 	})
 	It("callback_valid_objname", func() {
 		clusterId := "53116787-3eb0-4211-93ac-611d5cedaa30"
+		objectName := fmt.Sprintf("%s.iso", fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, clusterId))
+		mockQuota.EXPECT().Release(gomock.Any(), objectName).Return(nil).Times(1)
 		mockEvents.EXPECT().AddEvent(gomock.Any(), strfmt.UUID(clusterId), nil, models.EventSeverityInfo, gomock.Any(), gomock.Any())
-		imgExp.DeletedImageCallback(ctx, log, fmt.Sprintf("%s.iso", fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, clusterId)))
+		imgExp.DeletedImageCallback(ctx, log, objectName)
+	})
+	It("callback_pxe_objname", func() {
+		clusterId := "53116787-3eb0-4211-93ac-611d5cedaa30"
+		mockEvents.EXPECT().AddEvent(gomock.Any(), strfmt.UUID(clusterId), nil, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(1)
+		imagePrefix := fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, clusterId)
+		mockQuota.EXPECT().Release(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		imgExp.DeletedImageCallback(ctx, log, s3wrapper.PXEArtifactObjectName(imagePrefix, s3wrapper.PXEInitrd))
+		imgExp.DeletedImageCallback(ctx, log, s3wrapper.PXEArtifactObjectName(imagePrefix, s3wrapper.PXEIPXEScript))
+	})
+	It("callback_declared_host_objname", func() {
+		clusterId := "53116787-3eb0-4211-93ac-611d5cedaa30"
+		declaredHostId := "0d2f7a6e-1c1e-4f36-a2a4-97e0b6c5f3f1"
+		mockEvents.EXPECT().AddEvent(gomock.Any(), strfmt.UUID(clusterId), nil, models.EventSeverityInfo,
+			fmt.Sprintf("Deleted image of declared host %s from backend because it expired. It may be generated again at any time.", declaredHostId), gomock.Any())
+		mockQuota.EXPECT().Release(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		imgExp.DeletedImageCallback(ctx, log, fmt.Sprintf("%s-declared-%s.iso", fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, clusterId), declaredHostId))
 	})
 	It("callback_invalid_objname", func() {
 		clusterId := "53116787-3eb0-4211-93ac-611d5cedaa30"
+		mockQuota.EXPECT().Release(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		imgExp.DeletedImageCallback(ctx, log, fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, clusterId))
 	})
+	It("cached_image_callback", func() {
+		objectName := fmt.Sprintf(s3wrapper.DiscoveryImageCacheTemplate, "0123456789abcdef") + ".iso"
+		mockQuota.EXPECT().Release(gomock.Any(), objectName).Return(nil).Times(1)
+		imgExp.DeletedCachedImageCallback(ctx, log, objectName)
+	})
+	It("referenced_invalid_objname", func() {
+		Expect(imgExp.IsImageReferenced(ctx, log, fmt.Sprintf(s3wrapper.DiscoveryImageCacheTemplate, "not-a-hash")+".iso")).To(BeTrue())
+	})
 
 	AfterEach(func() {
 		ctrl.Finish()
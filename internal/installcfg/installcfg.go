@@ -53,6 +53,15 @@ type proxy struct {
 	NoProxy    string `yaml:"noProxy,omitempty"`
 }
 
+type clusterNetwork struct {
+	Cidr       string `yaml:"cidr"`
+	HostPrefix int    `yaml:"hostPrefix"`
+}
+
+type machineNetwork struct {
+	Cidr string `yaml:"cidr"`
+}
+
 type imageContentSource struct {
 	Mirrors []string `yaml:"mirrors"`
 	Source  string   `yaml:"source"`
@@ -63,15 +72,10 @@ type InstallerConfigBaremetal struct {
 	BaseDomain string `yaml:"baseDomain"`
 	Proxy      *proxy `yaml:"proxy,omitempty"`
 	Networking struct {
-		NetworkType    string `yaml:"networkType"`
-		ClusterNetwork []struct {
-			Cidr       string `yaml:"cidr"`
-			HostPrefix int    `yaml:"hostPrefix"`
-		} `yaml:"clusterNetwork"`
-		MachineNetwork []struct {
-			Cidr string `yaml:"cidr"`
-		} `yaml:"machineNetwork,omitempty"`
-		ServiceNetwork []string `yaml:"serviceNetwork"`
+		NetworkType    string           `yaml:"networkType"`
+		ClusterNetwork []clusterNetwork `yaml:"clusterNetwork"`
+		MachineNetwork []machineNetwork `yaml:"machineNetwork,omitempty"`
+		ServiceNetwork []string         `yaml:"serviceNetwork"`
 	} `yaml:"networking"`
 	Metadata struct {
 		Name string `yaml:"name"`
@@ -121,6 +125,9 @@ func (c *InstallerConfigBaremetal) Validate() error {
 //go:generate mockgen -source=installcfg.go -package=installcfg -destination=mock_installcfg.go
 type InstallConfigBuilder interface {
 	GetInstallConfig(cluster *common.Cluster, addRhCa bool, ca string) ([]byte, error)
+	// GetRedactedInstallConfig returns the install config of the cluster without its pull secret, SSH key and
+	// proxy credentials
+	GetRedactedInstallConfig(cluster *common.Cluster) ([]byte, error)
 	ValidateInstallConfigPatch(cluster *common.Cluster, patch string) error
 }
 
@@ -157,12 +164,50 @@ func (i *installConfigBuilder) getBMHName(host *models.Host, masterIdx, workerId
 
 func (i *installConfigBuilder) getNetworkType(cluster *common.Cluster) string {
 	networkType := "OpenShiftSDN"
-	if network.IsIPv6CIDR(cluster.ClusterNetworkCidr) || network.IsIPv6CIDR(cluster.MachineNetworkCidr) || network.IsIPv6CIDR(cluster.ServiceNetworkCidr) {
+	if network.IsIPv6CIDR(cluster.ClusterNetworkCidr) || network.IsIPv6CIDR(cluster.MachineNetworkCidr) || network.IsIPv6CIDR(cluster.ServiceNetworkCidr) ||
+		network.IsDualStack(cluster) {
 		networkType = "OVNKubernetes"
 	}
 	return networkType
 }
 
+// getClusterNetworks returns the cluster networks, one of each IP address family for dual-stack clusters
+func (i *installConfigBuilder) getClusterNetworks(cluster *common.Cluster) []clusterNetwork {
+	if len(cluster.ClusterNetworks) == 0 {
+		return []clusterNetwork{{Cidr: cluster.ClusterNetworkCidr, HostPrefix: int(cluster.ClusterNetworkHostPrefix)}}
+	}
+	ret := make([]clusterNetwork, 0, len(cluster.ClusterNetworks))
+	for _, n := range cluster.ClusterNetworks {
+		ret = append(ret, clusterNetwork{Cidr: n.Cidr, HostPrefix: int(n.HostPrefix)})
+	}
+	return ret
+}
+
+func (i *installConfigBuilder) getServiceNetworks(cluster *common.Cluster) []string {
+	if len(cluster.ServiceNetworks) == 0 {
+		return []string{cluster.ServiceNetworkCidr}
+	}
+	return network.GetServiceNetworkCidrs(cluster)
+}
+
+func (i *installConfigBuilder) getClusterMachineNetworks(cluster *common.Cluster) []machineNetwork {
+	if len(cluster.MachineNetworks) == 0 {
+		return []machineNetwork{{Cidr: cluster.MachineNetworkCidr}}
+	}
+	return i.getMachineNetworks(network.GetMachineNetworkCidrs(cluster))
+}
+
+func (i *installConfigBuilder) getMachineNetworks(cidrs []string) []machineNetwork {
+	if len(cidrs) == 0 {
+		return nil
+	}
+	ret := make([]machineNetwork, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		ret = append(ret, machineNetwork{Cidr: cidr})
+	}
+	return ret
+}
+
 func (i *installConfigBuilder) generateNoProxy(cluster *common.Cluster) string {
 	noProxy := strings.TrimSpace(cluster.NoProxy)
 	if noProxy == "*" {
@@ -170,12 +215,15 @@ func (i *installConfigBuilder) generateNoProxy(cluster *common.Cluster) string {
 	}
 
 	splitNoProxy := funk.FilterString(strings.Split(noProxy, ","), func(s string) bool { return s != "" })
-	if cluster.MachineNetworkCidr != "" {
-		splitNoProxy = append(splitNoProxy, cluster.MachineNetworkCidr)
-	}
+	splitNoProxy = append(splitNoProxy, network.GetMachineNetworkCidrs(cluster)...)
 	// Add internal OCP DNS domain
 	internalDnsDomain := "." + cluster.Name + "." + cluster.BaseDNSDomain
-	return strings.Join(append(splitNoProxy, internalDnsDomain, cluster.ClusterNetworkCidr, cluster.ServiceNetworkCidr), ",")
+	splitNoProxy = append(splitNoProxy, internalDnsDomain)
+	if network.IsDualStack(cluster) {
+		splitNoProxy = append(splitNoProxy, network.GetClusterNetworkCidrs(cluster)...)
+		return strings.Join(append(splitNoProxy, network.GetServiceNetworkCidrs(cluster)...), ",")
+	}
+	return strings.Join(append(splitNoProxy, cluster.ClusterNetworkCidr, cluster.ServiceNetworkCidr), ",")
 }
 
 func (i *installConfigBuilder) getBasicInstallConfig(cluster *common.Cluster) (*InstallerConfigBaremetal, error) {
@@ -185,29 +233,15 @@ func (i *installConfigBuilder) getBasicInstallConfig(cluster *common.Cluster) (*
 		APIVersion: "v1",
 		BaseDomain: cluster.BaseDNSDomain,
 		Networking: struct {
-			NetworkType    string `yaml:"networkType"`
-			ClusterNetwork []struct {
-				Cidr       string `yaml:"cidr"`
-				HostPrefix int    `yaml:"hostPrefix"`
-			} `yaml:"clusterNetwork"`
-			MachineNetwork []struct {
-				Cidr string `yaml:"cidr"`
-			} `yaml:"machineNetwork,omitempty"`
-			ServiceNetwork []string `yaml:"serviceNetwork"`
+			NetworkType    string           `yaml:"networkType"`
+			ClusterNetwork []clusterNetwork `yaml:"clusterNetwork"`
+			MachineNetwork []machineNetwork `yaml:"machineNetwork,omitempty"`
+			ServiceNetwork []string         `yaml:"serviceNetwork"`
 		}{
-			NetworkType: networkType,
-			ClusterNetwork: []struct {
-				Cidr       string `yaml:"cidr"`
-				HostPrefix int    `yaml:"hostPrefix"`
-			}{
-				{Cidr: cluster.ClusterNetworkCidr, HostPrefix: int(cluster.ClusterNetworkHostPrefix)},
-			},
-			MachineNetwork: []struct {
-				Cidr string `yaml:"cidr"`
-			}{
-				{Cidr: cluster.MachineNetworkCidr},
-			},
-			ServiceNetwork: []string{cluster.ServiceNetworkCidr},
+			NetworkType:    networkType,
+			ClusterNetwork: i.getClusterNetworks(cluster),
+			MachineNetwork: i.getClusterMachineNetworks(cluster),
+			ServiceNetwork: i.getServiceNetworks(cluster),
 		},
 		Metadata: struct {
 			Name string `yaml:"name"`
@@ -355,15 +389,11 @@ func (i *installConfigBuilder) getInstallConfig(cluster *common.Cluster, addRhCa
 			None:      &platformNone{},
 		}
 
-		bootstrapCidr := network.GetMachineCidrForUserManagedNetwork(cluster, i.log)
-		if bootstrapCidr != "" {
-			i.log.Infof("None-Platform: Selected bootstrap machine network CIDR %s for cluster %s", bootstrapCidr, cluster.ID.String())
-			cfg.Networking.MachineNetwork = []struct {
-				Cidr string `yaml:"cidr"`
-			}{
-				{Cidr: bootstrapCidr},
-			}
-			cluster.MachineNetworkCidr = bootstrapCidr
+		bootstrapCidrs := network.GetMachineNetworksForUserManagedNetwork(cluster, i.log)
+		if len(bootstrapCidrs) > 0 {
+			i.log.Infof("None-Platform: Selected bootstrap machine network CIDRs %s for cluster %s", strings.Join(bootstrapCidrs, ", "), cluster.ID.String())
+			cfg.Networking.MachineNetwork = i.getMachineNetworks(bootstrapCidrs)
+			cluster.MachineNetworkCidr = bootstrapCidrs[0]
 			cfg.Networking.NetworkType = i.getNetworkType(cluster)
 
 		} else {
@@ -405,6 +435,25 @@ func (i *installConfigBuilder) GetInstallConfig(cluster *common.Cluster, addRhCa
 	return yaml.Marshal(*cfg)
 }
 
+func (i *installConfigBuilder) GetRedactedInstallConfig(cluster *common.Cluster) ([]byte, error) {
+	cfg, err := i.getInstallConfig(cluster, false, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.PullSecret != "" {
+		cfg.PullSecret = common.RedactedValue
+	}
+	if cfg.SSHKey != "" {
+		cfg.SSHKey = common.RedactedValue
+	}
+	if cfg.Proxy != nil {
+		cfg.Proxy.HTTPProxy = common.RedactURLCredentials(cfg.Proxy.HTTPProxy)
+		cfg.Proxy.HTTPSProxy = common.RedactURLCredentials(cfg.Proxy.HTTPSProxy)
+	}
+	return yaml.Marshal(*cfg)
+}
+
 func (i *installConfigBuilder) ValidateInstallConfigPatch(cluster *common.Cluster, patch string) error {
 	config, err := i.getInstallConfig(cluster, false, "")
 	if err != nil {
@@ -436,6 +485,18 @@ func (i *installConfigBuilder) getHypethreadingConfiguration(cluster *common.Clu
 }
 
 func (i *installConfigBuilder) getCAContents(cluster *common.Cluster, rhRootCA string, installRHRootCAFlag bool) string {
+	caContents := i.getServiceCAContents(rhRootCA, installRHRootCAFlag)
+	// The additional trust bundle of the cluster is trusted in addition to the CAs configured for the service
+	if cluster.AdditionalTrustBundle != "" {
+		if caContents != "" {
+			caContents += "\n"
+		}
+		caContents += cluster.AdditionalTrustBundle
+	}
+	return caContents
+}
+
+func (i *installConfigBuilder) getServiceCAContents(rhRootCA string, installRHRootCAFlag bool) string {
 	// CA for mirror registries and RH CA are mutually exclusive
 	if i.mirrorRegistriesBuilder.IsMirrorRegistriesConfigured() {
 		caContents, err := i.mirrorRegistriesBuilder.GetMirrorCA()
@@ -48,6 +48,21 @@ func (mr *MockInstallConfigBuilderMockRecorder) GetInstallConfig(cluster, addRhC
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstallConfig", reflect.TypeOf((*MockInstallConfigBuilder)(nil).GetInstallConfig), cluster, addRhCa, ca)
 }
 
+// GetRedactedInstallConfig mocks base method
+func (m *MockInstallConfigBuilder) GetRedactedInstallConfig(cluster *common.Cluster) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRedactedInstallConfig", cluster)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRedactedInstallConfig indicates an expected call of GetRedactedInstallConfig
+func (mr *MockInstallConfigBuilderMockRecorder) GetRedactedInstallConfig(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedactedInstallConfig", reflect.TypeOf((*MockInstallConfigBuilder)(nil).GetRedactedInstallConfig), cluster)
+}
+
 // ValidateInstallConfigPatch mocks base method
 func (m *MockInstallConfigBuilder) ValidateInstallConfigPatch(cluster *common.Cluster, patch string) error {
 	m.ctrl.T.Helper()
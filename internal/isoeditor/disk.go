@@ -0,0 +1,47 @@
+package isoeditor
+
+import (
+	"os"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// DiskImageFormat is the format of a discovery disk image, which is written to a disk rather than booted from a CD
+type DiskImageFormat string
+
+const (
+	// DiskImageFormatRaw is a hybrid raw disk image, which may be written to a USB stick or attached as a raw disk
+	DiskImageFormatRaw DiskImageFormat = "raw"
+	// DiskImageFormatQcow2 is a qcow2 image with the content of the raw disk image
+	DiskImageFormatQcow2 DiskImageFormat = "qcow2"
+)
+
+// CreateClusterDiskImage creates a disk image of the given format from the base ISO, which is a hybrid ISO that boots
+// when written to a disk, with the ignition config and kernel arguments embedded as in the full ISO.
+// Returns the path to the created image file
+func (e *rhcosEditor) CreateClusterDiskImage(ignition string, kernelArguments models.KernelArguments, format DiskImageFormat) (string, error) {
+	rawPath, err := e.CreateClusterFullISO(ignition, kernelArguments)
+	if err != nil {
+		return "", err
+	}
+
+	switch format {
+	case DiskImageFormatRaw:
+		return rawPath, nil
+	case DiskImageFormatQcow2:
+		defer os.Remove(rawPath)
+		qcow2Path, err := tempFileName(e.workDir)
+		if err != nil {
+			return "", err
+		}
+		if err = ConvertRawToQcow2(rawPath, qcow2Path); err != nil {
+			os.Remove(qcow2Path)
+			return "", errors.Wrap(err, "failed to convert disk image to qcow2")
+		}
+		return qcow2Path, nil
+	default:
+		os.Remove(rawPath)
+		return "", errors.Errorf("unsupported disk image format %s", format)
+	}
+}
@@ -0,0 +1,153 @@
+package isoeditor
+
+import (
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/isoutil"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// The boot configs of the minimal ISO template end with a comment line of this length, which is shortened or
+// extended when kernel arguments are added or removed, such that the boot configs keep their size in the ISO
+const kernelArgumentsPaddingLength = 1024
+
+var (
+	grubKernelArgumentsRegex     = regexp.MustCompile(`(?m)^([ \t]+linux[ \t]+\S+)(.*)$`)
+	isolinuxKernelArgumentsRegex = regexp.MustCompile(`(?m)^([ \t]+append)(.*)$`)
+	kernelArgumentsPaddingRegex  = regexp.MustCompile(`(?m)^#+$`)
+)
+
+var kernelArgumentsConfigs = []struct {
+	path  string
+	regex *regexp.Regexp
+}{
+	{path: "/EFI/redhat/grub.cfg", regex: grubKernelArgumentsRegex},
+	{path: "/isolinux/isolinux.cfg", regex: isolinuxKernelArgumentsRegex},
+}
+
+// ApplyKernelArguments returns the kernel arguments after applying the kernel argument operations in order.
+// A replace operation appends the argument when there is no argument with the same key.
+func ApplyKernelArguments(args []string, kernelArguments models.KernelArguments) []string {
+	result := append([]string{}, args...)
+	for _, kernelArgument := range kernelArguments {
+		switch kernelArgument.Operation {
+		case models.KernelArgumentOperationAppend:
+			result = append(result, kernelArgument.Value)
+		case models.KernelArgumentOperationReplace:
+			key := kernelArgumentKey(kernelArgument.Value)
+			replaced := false
+			updated := make([]string, 0, len(result))
+			for _, arg := range result {
+				if kernelArgumentKey(arg) != key {
+					updated = append(updated, arg)
+				} else if !replaced {
+					updated = append(updated, kernelArgument.Value)
+					replaced = true
+				}
+			}
+			if !replaced {
+				updated = append(updated, kernelArgument.Value)
+			}
+			result = updated
+		case models.KernelArgumentOperationDelete:
+			updated := make([]string, 0, len(result))
+			for _, arg := range result {
+				if !kernelArgumentMatches(arg, kernelArgument.Value) {
+					updated = append(updated, arg)
+				}
+			}
+			result = updated
+		}
+	}
+	return result
+}
+
+// kernelArgumentKey returns the key of a kernel argument, which may be quoted in boot configs
+func kernelArgumentKey(arg string) string {
+	return strings.SplitN(strings.Trim(arg, `'"`), "=", 2)[0]
+}
+
+func kernelArgumentMatches(arg, value string) bool {
+	if strings.Contains(value, "=") {
+		return strings.Trim(arg, `'"`) == value
+	}
+	return kernelArgumentKey(arg) == value
+}
+
+// embedKernelArguments applies the kernel argument operations to the boot configs of the ISO
+func embedKernelArguments(isoPath string, kernelArguments models.KernelArguments) error {
+	overlays, err := kernelArgumentsOverlays(isoPath, kernelArguments)
+	if err != nil {
+		return err
+	}
+	for _, overlay := range overlays {
+		if err = writeAt(overlay.data, overlay.offset, isoPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// kernelArgumentsOverlays returns the boot configs of the ISO with the kernel argument operations applied
+func kernelArgumentsOverlays(isoPath string, kernelArguments models.KernelArguments) ([]isoOverlay, error) {
+	iso, err := os.Open(isoPath)
+	if err != nil {
+		return nil, err
+	}
+	defer iso.Close()
+
+	overlays := make([]isoOverlay, 0, len(kernelArgumentsConfigs))
+	for _, config := range kernelArgumentsConfigs {
+		offset, err := isoutil.GetFileLocation(config.path, isoPath)
+		if err != nil {
+			return nil, err
+		}
+		size, err := isoutil.GetFileSize(config.path, isoPath)
+		if err != nil {
+			return nil, err
+		}
+		content := make([]byte, size)
+		if _, err = iso.ReadAt(content, int64(offset)); err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", config.path)
+		}
+		edited, err := editKernelArgumentsConfig(content, config.regex, kernelArguments)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to edit kernel arguments in %s", config.path)
+		}
+		overlays = append(overlays, isoOverlay{offset: int64(offset), data: edited})
+	}
+	return overlays, nil
+}
+
+// editKernelArgumentsConfig applies the kernel argument operations to the kernel command lines of a boot config,
+// resizing its padding line such that the config keeps its size
+func editKernelArgumentsConfig(config []byte, re *regexp.Regexp, kernelArguments models.KernelArguments) ([]byte, error) {
+	edited := re.ReplaceAllStringFunc(string(config), func(line string) string {
+		matches := re.FindStringSubmatch(line)
+		args := ApplyKernelArguments(strings.Fields(matches[2]), kernelArguments)
+		return strings.Join(append([]string{matches[1]}, args...), " ")
+	})
+
+	sizeChange := len(edited) - len(config)
+	if sizeChange == 0 {
+		return []byte(edited), nil
+	}
+	// The padding is the longest comment line made of # characters only
+	var padding []int
+	for _, match := range kernelArgumentsPaddingRegex.FindAllStringIndex(edited, -1) {
+		if padding == nil || match[1]-match[0] > padding[1]-padding[0] {
+			padding = match
+		}
+	}
+	if padding == nil {
+		return nil, errors.New("no space is reserved for kernel arguments")
+	}
+	paddingLength := padding[1] - padding[0] - sizeChange
+	if paddingLength < 0 {
+		return nil, errors.Errorf("kernel arguments are larger than the reserved space by %d bytes", -paddingLength)
+	}
+	return []byte(edited[:padding[0]] + strings.Repeat("#", paddingLength) + edited[padding[1]:]), nil
+}
@@ -0,0 +1,88 @@
+package isoeditor
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("ApplyKernelArguments", func() {
+	args := []string{"random.trust_cpu=on", "ignition.firstboot", "'coreos.live.rootfs_url=http://example.com/rootfs.img'"}
+
+	It("appends kernel arguments", func() {
+		Expect(ApplyKernelArguments(args, models.KernelArguments{
+			{Operation: models.KernelArgumentOperationAppend, Value: "nomodeset"},
+			{Operation: models.KernelArgumentOperationAppend, Value: "console=ttyS1,115200"},
+		})).To(Equal(append(append([]string{}, args...), "nomodeset", "console=ttyS1,115200")))
+	})
+
+	It("replaces kernel arguments with the same key", func() {
+		Expect(ApplyKernelArguments([]string{"console=tty0", "nomodeset", "console=ttyS0"}, models.KernelArguments{
+			{Operation: models.KernelArgumentOperationReplace, Value: "console=ttyS1,115200"},
+		})).To(Equal([]string{"console=ttyS1,115200", "nomodeset"}))
+	})
+
+	It("appends a replaced kernel argument when there is none with the same key", func() {
+		Expect(ApplyKernelArguments(args, models.KernelArguments{
+			{Operation: models.KernelArgumentOperationReplace, Value: "rd.net.timeout.carrier=30"},
+		})).To(Equal(append(append([]string{}, args...), "rd.net.timeout.carrier=30")))
+	})
+
+	It("deletes kernel arguments by key", func() {
+		Expect(ApplyKernelArguments(args, models.KernelArguments{
+			{Operation: models.KernelArgumentOperationDelete, Value: "random.trust_cpu"},
+			{Operation: models.KernelArgumentOperationDelete, Value: "coreos.live.rootfs_url"},
+		})).To(Equal([]string{"ignition.firstboot"}))
+	})
+
+	It("deletes kernel arguments by value", func() {
+		Expect(ApplyKernelArguments([]string{"console=tty0", "console=ttyS0"}, models.KernelArguments{
+			{Operation: models.KernelArgumentOperationDelete, Value: "console=ttyS0"},
+		})).To(Equal([]string{"console=tty0"}))
+	})
+})
+
+var _ = Describe("editKernelArgumentsConfig", func() {
+	config := "label linux\n" +
+		"  kernel /images/pxeboot/vmlinuz\n" +
+		"  append initrd=/images/pxeboot/initrd.img random.trust_cpu=on\n" +
+		"#\n" +
+		strings.Repeat("#", 32) + "\n"
+
+	It("keeps the size of the config", func() {
+		edited, err := editKernelArgumentsConfig([]byte(config), isolinuxKernelArgumentsRegex, models.KernelArguments{
+			{Operation: models.KernelArgumentOperationAppend, Value: "nomodeset"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(edited)).To(Equal("label linux\n" +
+			"  kernel /images/pxeboot/vmlinuz\n" +
+			"  append initrd=/images/pxeboot/initrd.img random.trust_cpu=on nomodeset\n" +
+			"#\n" +
+			strings.Repeat("#", 22) + "\n"))
+	})
+
+	It("extends the padding when kernel arguments are deleted", func() {
+		edited, err := editKernelArgumentsConfig([]byte(config), isolinuxKernelArgumentsRegex, models.KernelArguments{
+			{Operation: models.KernelArgumentOperationDelete, Value: "random.trust_cpu"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(edited).To(HaveLen(len(config)))
+		Expect(string(edited)).To(HaveSuffix(strings.Repeat("#", 52) + "\n"))
+	})
+
+	It("fails when the kernel arguments do not fit the padding", func() {
+		_, err := editKernelArgumentsConfig([]byte(config), isolinuxKernelArgumentsRegex, models.KernelArguments{
+			{Operation: models.KernelArgumentOperationAppend, Value: strings.Repeat("a", 40)},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails when the config has no padding", func() {
+		_, err := editKernelArgumentsConfig([]byte("  append initrd=/images/pxeboot/initrd.img\n"), isolinuxKernelArgumentsRegex, models.KernelArguments{
+			{Operation: models.KernelArgumentOperationAppend, Value: "nomodeset"},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
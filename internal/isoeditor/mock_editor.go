@@ -6,6 +6,7 @@ package isoeditor
 
 import (
 	gomock "github.com/golang/mock/gomock"
+	models "github.com/openshift/assisted-service/models"
 	reflect "reflect"
 )
 
@@ -32,19 +33,49 @@ func (m *MockEditor) EXPECT() *MockEditorMockRecorder {
 	return m.recorder
 }
 
+// CreateClusterDiskImage mocks base method
+func (m *MockEditor) CreateClusterDiskImage(arg0 string, arg1 models.KernelArguments, arg2 DiskImageFormat) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterDiskImage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClusterDiskImage indicates an expected call of CreateClusterDiskImage
+func (mr *MockEditorMockRecorder) CreateClusterDiskImage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterDiskImage", reflect.TypeOf((*MockEditor)(nil).CreateClusterDiskImage), arg0, arg1, arg2)
+}
+
+// CreateClusterFullISO mocks base method
+func (m *MockEditor) CreateClusterFullISO(arg0 string, arg1 models.KernelArguments) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterFullISO", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClusterFullISO indicates an expected call of CreateClusterFullISO
+func (mr *MockEditorMockRecorder) CreateClusterFullISO(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterFullISO", reflect.TypeOf((*MockEditor)(nil).CreateClusterFullISO), arg0, arg1)
+}
+
 // CreateClusterMinimalISO mocks base method
-func (m *MockEditor) CreateClusterMinimalISO(arg0, arg1 string, arg2 *ClusterProxyInfo) (string, error) {
+func (m *MockEditor) CreateClusterMinimalISO(arg0, arg1 string, arg2 *ClusterProxyInfo, arg3 models.KernelArguments) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateClusterMinimalISO", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "CreateClusterMinimalISO", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateClusterMinimalISO indicates an expected call of CreateClusterMinimalISO
-func (mr *MockEditorMockRecorder) CreateClusterMinimalISO(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockEditorMockRecorder) CreateClusterMinimalISO(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterMinimalISO", reflect.TypeOf((*MockEditor)(nil).CreateClusterMinimalISO), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterMinimalISO", reflect.TypeOf((*MockEditor)(nil).CreateClusterMinimalISO), arg0, arg1, arg2, arg3)
 }
 
 // CreateMinimalISOTemplate mocks base method
@@ -61,3 +92,18 @@ func (mr *MockEditorMockRecorder) CreateMinimalISOTemplate(arg0 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMinimalISOTemplate", reflect.TypeOf((*MockEditor)(nil).CreateMinimalISOTemplate), arg0)
 }
+
+// ExtractPXEArtifacts mocks base method
+func (m *MockEditor) ExtractPXEArtifacts() (*PXEArtifacts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractPXEArtifacts")
+	ret0, _ := ret[0].(*PXEArtifacts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractPXEArtifacts indicates an expected call of ExtractPXEArtifacts
+func (mr *MockEditorMockRecorder) ExtractPXEArtifacts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractPXEArtifacts", reflect.TypeOf((*MockEditor)(nil).ExtractPXEArtifacts))
+}
@@ -0,0 +1,76 @@
+package isoeditor
+
+import (
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	pxeKernelPath = "/images/pxeboot/vmlinuz"
+	pxeInitrdPath = "/images/pxeboot/initrd.img"
+	pxeRootFSPath = "/images/pxeboot/rootfs.img"
+)
+
+// PXEArtifacts holds the paths of the network boot artifacts extracted from an ISO
+type PXEArtifacts struct {
+	KernelPath string
+	InitrdPath string
+	RootFSPath string
+}
+
+// Remove deletes the extracted artifacts
+func (a *PXEArtifacts) Remove() {
+	for _, path := range []string{a.KernelPath, a.InitrdPath, a.RootFSPath} {
+		if path != "" {
+			os.Remove(path)
+		}
+	}
+}
+
+// Extracts the kernel, initrd and rootfs from the ISO into the work directory
+// Returns the paths to the extracted files, which should be removed by the caller
+func (e *rhcosEditor) ExtractPXEArtifacts() (*PXEArtifacts, error) {
+	artifacts := &PXEArtifacts{}
+	for _, f := range []struct {
+		isoPath string
+		path    *string
+	}{
+		{isoPath: pxeKernelPath, path: &artifacts.KernelPath},
+		{isoPath: pxeInitrdPath, path: &artifacts.InitrdPath},
+		{isoPath: pxeRootFSPath, path: &artifacts.RootFSPath},
+	} {
+		path, err := e.extractFile(f.isoPath)
+		if err != nil {
+			artifacts.Remove()
+			return nil, errors.Wrapf(err, "failed to extract %s", f.isoPath)
+		}
+		*f.path = path
+	}
+	return artifacts, nil
+}
+
+func (e *rhcosEditor) extractFile(isoPath string) (string, error) {
+	reader, err := e.isoHandler.ReadFile(isoPath)
+	if err != nil {
+		return "", err
+	}
+
+	path, err := tempFileName(e.workDir)
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err = io.Copy(f, reader); err != nil {
+		os.Remove(path)
+		return "", err
+	}
+	return path, nil
+}
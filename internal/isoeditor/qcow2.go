@@ -0,0 +1,179 @@
+package isoeditor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	qcow2Magic         = uint32(0x514649fb) // "QFI\xfb"
+	qcow2Version       = uint32(2)
+	qcow2ClusterBits   = uint32(16)
+	qcow2ClusterSize   = int64(1) << qcow2ClusterBits
+	qcow2OflagCopied   = uint64(1) << 63
+	qcow2RefcountBytes = 2 // version 2 images have 16 bit refcounts
+)
+
+type qcow2Header struct {
+	Magic                 uint32
+	Version               uint32
+	BackingFileOffset     uint64
+	BackingFileSize       uint32
+	ClusterBits           uint32
+	Size                  uint64
+	CryptMethod           uint32
+	L1Size                uint32
+	L1TableOffset         uint64
+	RefcountTableOffset   uint64
+	RefcountTableClusters uint32
+	NbSnapshots           uint32
+	SnapshotsOffset       uint64
+}
+
+func clustersFor(size int64) int64 {
+	return (size + qcow2ClusterSize - 1) / qcow2ClusterSize
+}
+
+// ConvertRawToQcow2 writes a qcow2 image with the content of the raw image. Clusters that are all zeros are left
+// unallocated, such that the qcow2 image is only as large as the data of the raw image.
+func ConvertRawToQcow2(rawPath, qcow2Path string) error {
+	raw, err := os.Open(rawPath)
+	if err != nil {
+		return err
+	}
+	defer raw.Close()
+	info, err := raw.Stat()
+	if err != nil {
+		return err
+	}
+	size := info.Size()
+
+	// Find the clusters with data
+	guestClusters := clustersFor(size)
+	allocated := make([]bool, guestClusters)
+	dataClusters := int64(0)
+	buf := make([]byte, qcow2ClusterSize)
+	zeros := make([]byte, qcow2ClusterSize)
+	for i := int64(0); i < guestClusters; i++ {
+		n, err := raw.ReadAt(buf, i*qcow2ClusterSize)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if !bytes.Equal(buf[:n], zeros[:n]) {
+			allocated[i] = true
+			dataClusters++
+		}
+	}
+
+	// Lay out the header, L1 table, refcount table and blocks, L2 tables and data clusters in that order
+	l2Entries := qcow2ClusterSize / 8
+	l1Size := (guestClusters + l2Entries - 1) / l2Entries
+	l1Clusters := clustersFor(l1Size * 8)
+	metadataClusters := 1 + l1Clusters + l1Size + dataClusters
+	var refcountTableClusters, refcountBlocks, totalClusters int64
+	for {
+		totalClusters = metadataClusters + refcountTableClusters + refcountBlocks
+		neededBlocks := (totalClusters*qcow2RefcountBytes + qcow2ClusterSize - 1) / qcow2ClusterSize
+		neededTableClusters := clustersFor(neededBlocks * 8)
+		if neededBlocks == refcountBlocks && neededTableClusters == refcountTableClusters {
+			break
+		}
+		refcountBlocks, refcountTableClusters = neededBlocks, neededTableClusters
+	}
+	l1TableOffset := qcow2ClusterSize
+	refcountTableOffset := l1TableOffset + l1Clusters*qcow2ClusterSize
+	refcountBlocksOffset := refcountTableOffset + refcountTableClusters*qcow2ClusterSize
+	l2TablesOffset := refcountBlocksOffset + refcountBlocks*qcow2ClusterSize
+	dataOffset := l2TablesOffset + l1Size*qcow2ClusterSize
+
+	out, err := os.Create(qcow2Path)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	header := qcow2Header{
+		Magic:                 qcow2Magic,
+		Version:               qcow2Version,
+		ClusterBits:           qcow2ClusterBits,
+		Size:                  uint64(size),
+		L1Size:                uint32(l1Size),
+		L1TableOffset:         uint64(l1TableOffset),
+		RefcountTableOffset:   uint64(refcountTableOffset),
+		RefcountTableClusters: uint32(refcountTableClusters),
+	}
+	if err = writeBigEndianAt(out, header, 0); err != nil {
+		return err
+	}
+
+	l1Table := make([]uint64, l1Size)
+	for i := range l1Table {
+		l1Table[i] = uint64(l2TablesOffset+int64(i)*qcow2ClusterSize) | qcow2OflagCopied
+	}
+	if err = writeBigEndianAt(out, l1Table, l1TableOffset); err != nil {
+		return err
+	}
+
+	refcountTable := make([]uint64, refcountBlocks)
+	for i := range refcountTable {
+		refcountTable[i] = uint64(refcountBlocksOffset + int64(i)*qcow2ClusterSize)
+	}
+	if err = writeBigEndianAt(out, refcountTable, refcountTableOffset); err != nil {
+		return err
+	}
+	refcounts := make([]uint16, totalClusters)
+	for i := range refcounts {
+		refcounts[i] = 1
+	}
+	if err = writeBigEndianAt(out, refcounts, refcountBlocksOffset); err != nil {
+		return err
+	}
+
+	l2Table := make([]uint64, l1Size*l2Entries)
+	nextDataOffset := dataOffset
+	for i := int64(0); i < guestClusters; i++ {
+		if allocated[i] {
+			l2Table[i] = uint64(nextDataOffset) | qcow2OflagCopied
+			nextDataOffset += qcow2ClusterSize
+		}
+	}
+	if err = writeBigEndianAt(out, l2Table, l2TablesOffset); err != nil {
+		return err
+	}
+
+	nextDataOffset = dataOffset
+	for i := int64(0); i < guestClusters; i++ {
+		if !allocated[i] {
+			continue
+		}
+		n, err := raw.ReadAt(buf, i*qcow2ClusterSize)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		// The last cluster is padded with zeros
+		copy(buf[n:], zeros)
+		if _, err = out.WriteAt(buf, nextDataOffset); err != nil {
+			return err
+		}
+		nextDataOffset += qcow2ClusterSize
+	}
+
+	// Make sure the image ends at a cluster boundary even when its last clusters hold no data
+	if err = out.Truncate(nextDataOffset); err != nil {
+		return errors.Wrap(err, "failed to resize qcow2 image")
+	}
+	return nil
+}
+
+func writeBigEndianAt(w io.WriterAt, data interface{}, offset int64) error {
+	buf := new(bytes.Buffer)
+	if err := binary.Write(buf, binary.BigEndian, data); err != nil {
+		return err
+	}
+	_, err := w.WriteAt(buf.Bytes(), offset)
+	return err
+}
@@ -0,0 +1,76 @@
+package isoeditor
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// readQcow2 returns the guest content of a qcow2 image written by ConvertRawToQcow2
+func readQcow2(path string) []byte {
+	image, err := ioutil.ReadFile(path)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(binary.BigEndian.Uint32(image[0:4])).To(Equal(qcow2Magic))
+	Expect(binary.BigEndian.Uint32(image[4:8])).To(Equal(qcow2Version))
+	clusterSize := uint64(1) << binary.BigEndian.Uint32(image[20:24])
+	size := binary.BigEndian.Uint64(image[24:32])
+	l1Size := uint64(binary.BigEndian.Uint32(image[36:40]))
+	l1TableOffset := binary.BigEndian.Uint64(image[40:48])
+	Expect(uint64(len(image)) % clusterSize).To(BeZero())
+
+	content := make([]byte, size)
+	l2Entries := clusterSize / 8
+	offsetMask := ^qcow2OflagCopied
+	for l1Index := uint64(0); l1Index < l1Size; l1Index++ {
+		l2TableOffset := binary.BigEndian.Uint64(image[l1TableOffset+l1Index*8:]) & offsetMask
+		if l2TableOffset == 0 {
+			continue
+		}
+		for l2Index := uint64(0); l2Index < l2Entries; l2Index++ {
+			dataOffset := binary.BigEndian.Uint64(image[l2TableOffset+l2Index*8:]) & offsetMask
+			guestOffset := (l1Index*l2Entries + l2Index) * clusterSize
+			if dataOffset == 0 || guestOffset >= size {
+				continue
+			}
+			copy(content[guestOffset:], image[dataOffset:dataOffset+clusterSize])
+		}
+	}
+	return content
+}
+
+var _ = Describe("ConvertRawToQcow2", func() {
+	var workDir string
+
+	BeforeEach(func() {
+		var err error
+		workDir, err = ioutil.TempDir("", "qcow2test")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(workDir)
+	})
+
+	It("converts a sparse raw image", func() {
+		// Data in the first clusters, in a middle cluster and in the last partial cluster, with zeros in between
+		raw := make([]byte, 3*1024*1024+2048)
+		rand.Read(raw[:100000])
+		rand.Read(raw[2*1024*1024 : 2*1024*1024+10])
+		rand.Read(raw[len(raw)-100:])
+		rawPath := filepath.Join(workDir, "disk.raw")
+		Expect(ioutil.WriteFile(rawPath, raw, 0600)).To(Succeed())
+
+		qcow2Path := filepath.Join(workDir, "disk.qcow2")
+		Expect(ConvertRawToQcow2(rawPath, qcow2Path)).To(Succeed())
+
+		info, err := os.Stat(qcow2Path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Size()).To(BeNumerically("<", 1024*1024))
+		Expect(readQcow2(qcow2Path)).To(Equal(raw))
+	})
+})
@@ -10,11 +10,13 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"text/template"
 
 	"github.com/cavaliercoder/go-cpio"
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/isoutil"
+	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -55,7 +57,10 @@ type OffsetInfo struct {
 //go:generate mockgen -package=isoeditor -destination=mock_editor.go -self_package=github.com/openshift/assisted-service/internal/isoeditor . Editor
 type Editor interface {
 	CreateMinimalISOTemplate(rootFSURL string) (string, error)
-	CreateClusterMinimalISO(ignition string, staticNetworkConfig string, clusterProxyInfo *ClusterProxyInfo) (string, error)
+	CreateClusterMinimalISO(ignition string, staticNetworkConfig string, clusterProxyInfo *ClusterProxyInfo, kernelArguments models.KernelArguments) (string, error)
+	CreateClusterFullISO(ignition string, kernelArguments models.KernelArguments) (string, error)
+	ExtractPXEArtifacts() (*PXEArtifacts, error)
+	CreateClusterDiskImage(ignition string, kernelArguments models.KernelArguments, format DiskImageFormat) (string, error)
 }
 
 type rhcosEditor struct {
@@ -101,7 +106,7 @@ func (e *rhcosEditor) CreateMinimalISOTemplate(rootFSURL string) (string, error)
 	return isoPath, nil
 }
 
-func (e *rhcosEditor) CreateClusterMinimalISO(ignition string, staticNetworkConfig string, clusterProxyInfo *ClusterProxyInfo) (string, error) {
+func (e *rhcosEditor) CreateClusterMinimalISO(ignition string, staticNetworkConfig string, clusterProxyInfo *ClusterProxyInfo, kernelArguments models.KernelArguments) (string, error) {
 	clusterISOPath, err := tempFileName(e.workDir)
 	if err != nil {
 		return "", err
@@ -126,6 +131,12 @@ func (e *rhcosEditor) CreateClusterMinimalISO(ignition string, staticNetworkConf
 		}
 	}
 
+	if len(kernelArguments) > 0 {
+		if err := embedKernelArguments(clusterISOPath, kernelArguments); err != nil {
+			return "", errors.Wrap(err, "failed to embed kernel arguments")
+		}
+	}
+
 	if err := e.isoHandler.CleanWorkDir(); err != nil {
 		e.log.WithError(err).Warnf("Failed to clean isoHandler work dir")
 	}
@@ -133,6 +144,29 @@ func (e *rhcosEditor) CreateClusterMinimalISO(ignition string, staticNetworkConf
 	return clusterISOPath, nil
 }
 
+// CreateClusterFullISO creates a copy of the full ISO with the ignition config and kernel arguments embedded
+// Returns the path to the created iso file
+func (e *rhcosEditor) CreateClusterFullISO(ignition string, kernelArguments models.KernelArguments) (string, error) {
+	clusterISOPath, err := tempFileName(e.workDir)
+	if err != nil {
+		return "", err
+	}
+	if err = e.isoHandler.Copy(clusterISOPath); err != nil {
+		return "", errors.Wrap(err, "failed to copy iso")
+	}
+	if err = embedIgnitionArea(clusterISOPath, ignition); err != nil {
+		os.Remove(clusterISOPath)
+		return "", err
+	}
+	if len(kernelArguments) > 0 {
+		if err = embedKernelArguments(clusterISOPath, kernelArguments); err != nil {
+			os.Remove(clusterISOPath)
+			return "", errors.Wrap(err, "failed to embed kernel arguments")
+		}
+	}
+	return clusterISOPath, nil
+}
+
 func (e *rhcosEditor) embedInitrdPlaceholders() error {
 	// Create ramdisk image placeholder
 	if err := e.createImagePlaceholder(ramDiskImagePath, RamDiskPaddingLength); err != nil {
@@ -201,56 +235,62 @@ func (e *rhcosEditor) addIgnitionArchive(clusterISOPath, ignition string, igniti
 }
 
 func (e *rhcosEditor) addCustomRAMDisk(clusterISOPath, staticNetworkConfig string, clusterProxyInfo *ClusterProxyInfo, ramdiskOffsetInfo *OffsetInfo) error {
+	compressedArchive, err := RAMDiskImageArchive(e.staticNetworkConfig, staticNetworkConfig, clusterProxyInfo)
+	if err != nil {
+		return err
+	}
+
+	// Ensures RAM placeholder is large enough to accommodate the compressed archive
+	if uint64(len(compressedArchive)) > ramdiskOffsetInfo.Length {
+		return errors.Errorf("Custom RAM disk is larger than the placeholder in ISO (%d bytes > %d bytes)",
+			len(compressedArchive), RamDiskPaddingLength)
+	}
+
+	return writeAt(compressedArchive, int64(ramdiskOffsetInfo.Offset), clusterISOPath)
+}
+
+// RAMDiskImageArchive returns a gzipped CPIO archive (in bytes) with the static network configuration
+// and the rootfs proxy configuration, to be appended to the discovery initrd
+func RAMDiskImageArchive(generator staticnetworkconfig.StaticNetworkConfig, staticNetworkConfig string, clusterProxyInfo *ClusterProxyInfo) ([]byte, error) {
 	buffer := new(bytes.Buffer)
 	w := cpio.NewWriter(buffer)
 	if staticNetworkConfig != "" {
-		filesList, newErr := e.staticNetworkConfig.GenerateStaticNetworkConfigData(staticNetworkConfig)
+		filesList, newErr := generator.GenerateStaticNetworkConfigData(staticNetworkConfig)
 		if newErr != nil {
-			return newErr
+			return nil, newErr
 		}
 		for _, file := range filesList {
 			err := addFileToArchive(w, filepath.Join("/etc/assisted/network", file.FilePath), file.FileContents, 0o600)
 			if err != nil {
-				return err
+				return nil, err
 			}
 		}
 		scriptPath := "/usr/lib/dracut/hooks/initqueue/settled/90-assisted-pre-static-network-config.sh"
 		scriptContent := constants.PreNetworkConfigScript
 
 		if err := addFileToArchive(w, scriptPath, scriptContent, 0o755); err != nil {
-			return err
+			return nil, err
 		}
 	}
 	if clusterProxyInfo.HTTPProxy != "" || clusterProxyInfo.HTTPSProxy != "" {
 		rootfsServiceConfigPath := "/etc/systemd/system/coreos-livepxe-rootfs.service.d/10-proxy.conf"
-		rootfsServiceConfig, err := e.formatRootfsServiceConfigFile(clusterProxyInfo)
+		rootfsServiceConfig, err := formatRootfsServiceConfigFile(clusterProxyInfo)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if err := addFileToArchive(w, rootfsServiceConfigPath, rootfsServiceConfig, 0o664); err != nil {
-			return err
+			return nil, err
 		}
 	}
 	if err := w.Close(); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Compress custom RAM disk
-	compressedArchive, err := getCompressedArchive(buffer)
-	if err != nil {
-		return err
-	}
-
-	// Ensures RAM placeholder is large enough to accommodate the compressed archive
-	if uint64(len(compressedArchive)) > ramdiskOffsetInfo.Length {
-		return errors.Errorf("Custom RAM disk is larger than the placeholder in ISO (%d bytes > %d bytes)",
-			len(compressedArchive), RamDiskPaddingLength)
-	}
-
-	return writeAt(compressedArchive, int64(ramdiskOffsetInfo.Offset), clusterISOPath)
+	return getCompressedArchive(buffer)
 }
 
-func (e *rhcosEditor) formatRootfsServiceConfigFile(clusterProxyInfo *ClusterProxyInfo) (string, error) {
+func formatRootfsServiceConfigFile(clusterProxyInfo *ClusterProxyInfo) (string, error) {
 	var rootfsServicConfigParams = map[string]string{
 		"HTTP_PROXY":  clusterProxyInfo.HTTPProxy,
 		"HTTPS_PROXY": clusterProxyInfo.HTTPSProxy,
@@ -344,6 +384,15 @@ func (e *rhcosEditor) fixTemplateConfigs(rootFSURL string) error {
 		return err
 	}
 
+	// Reserve space for the cluster kernel arguments
+	padding := strings.Repeat("#", kernelArgumentsPaddingLength) + "\n"
+	if err := appendFile(e.isoHandler.ExtractedPath("EFI/redhat/grub.cfg"), padding); err != nil {
+		return err
+	}
+	if err := appendFile(e.isoHandler.ExtractedPath("isolinux/isolinux.cfg"), padding); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -363,6 +412,17 @@ func editFile(fileName string, reString string, replacement string) error {
 	return nil
 }
 
+func appendFile(fileName string, content string) error {
+	f, err := os.OpenFile(fileName, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.WriteString(content)
+	return err
+}
+
 func tempFileName(baseDir string) (string, error) {
 	f, err := ioutil.TempFile(baseDir, "isoeditor")
 	if err != nil {
@@ -550,46 +610,63 @@ func ParseOffsetInfo(headerBytes []byte) (*OffsetInfo, error) {
 }
 
 func EmbedIgnition(inputISOPath, outputISOPath, ignitionConfig string) error {
-	coreosIgnitionHeader := make([]byte, ignitionHeaderSize)
-
 	inputISO, err := os.Open(inputISOPath)
 	if err != nil {
 		return err
 	}
 	defer inputISO.Close()
 
-	// Reading the last 24 bytes at the end of the system area)
-	if _, err = inputISO.ReadAt(coreosIgnitionHeader, isoSystemAreaSize-ignitionHeaderSize); err != nil {
+	resultISO, err := os.Create(outputISOPath)
+	if err != nil {
 		return err
 	}
-	ignitionOffsetInfo, err := GetIgnitionArea(coreosIgnitionHeader)
-	if err != nil {
+	if _, err = io.Copy(resultISO, inputISO); err != nil {
+		resultISO.Close()
+		return err
+	}
+	if err = resultISO.Close(); err != nil {
 		return err
 	}
 
-	cpio, err := IgnitionImageArchive(ignitionConfig)
-	if err != nil {
+	if err = embedIgnitionArea(outputISOPath, ignitionConfig); err != nil {
+		os.Remove(outputISOPath)
 		return err
 	}
+	return nil
+}
 
-	if uint64(len(cpio)) > ignitionOffsetInfo.Length {
-		return errors.Errorf("Compressed Ignition config is too large: %v > %v", len(cpio), int(ignitionOffsetInfo.Length))
+// embedIgnitionArea writes the ignition config to the ignition area of an RHCOS live ISO
+func embedIgnitionArea(isoPath, ignitionConfig string) error {
+	coreosIgnitionHeader := make([]byte, ignitionHeaderSize)
+
+	iso, err := os.OpenFile(isoPath, os.O_RDWR, 0o664)
+	if err != nil {
+		return err
 	}
+	defer iso.Close()
 
-	resultISO, err := os.Create(outputISOPath)
+	// Reading the last 24 bytes at the end of the system area)
+	if _, err = iso.ReadAt(coreosIgnitionHeader, isoSystemAreaSize-ignitionHeaderSize); err != nil {
+		return err
+	}
+	ignitionOffsetInfo, err := GetIgnitionArea(coreosIgnitionHeader)
 	if err != nil {
 		return err
 	}
-	defer resultISO.Close()
 
-	if _, err = io.Copy(resultISO, inputISO); err != nil {
+	cpio, err := IgnitionImageArchive(ignitionConfig)
+	if err != nil {
 		return err
 	}
 
+	if uint64(len(cpio)) > ignitionOffsetInfo.Length {
+		return errors.Errorf("Compressed Ignition config is too large: %v > %v", len(cpio), int(ignitionOffsetInfo.Length))
+	}
+
 	// clear out the embed area
 	embedArea := make([]byte, ignitionOffsetInfo.Length)
 	copy(embedArea, cpio)
-	if _, err = resultISO.WriteAt(embedArea, int64(ignitionOffsetInfo.Offset)); err != nil {
+	if _, err = iso.WriteAt(embedArea, int64(ignitionOffsetInfo.Offset)); err != nil {
 		return err
 	}
 
@@ -17,6 +17,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/isoutil"
+	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
 	"github.com/sirupsen/logrus"
 )
@@ -95,7 +96,7 @@ var _ = Context("with test files", func() {
 		It("cluster ISO created successfully", func() {
 			editor := editorForFile(isoFile, workDir, mockStaticNetworkConfig)
 			proxyInfo := &ClusterProxyInfo{}
-			file, err := editor.CreateClusterMinimalISO("ignition", "", proxyInfo)
+			file, err := editor.CreateClusterMinimalISO("ignition", "", proxyInfo, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			_, err = os.Stat(workDir)
@@ -105,6 +106,153 @@ var _ = Context("with test files", func() {
 		})
 	})
 
+	Describe("NewClusterISOReader", func() {
+		It("reads the same content as the created cluster ISO", func() {
+			templatePath, err := editorForFile(isoFile, workDir, mockStaticNetworkConfig).CreateMinimalISOTemplate(testRootFSURL)
+			Expect(err).ToNot(HaveOccurred())
+			defer os.Remove(templatePath)
+
+			proxyInfo := &ClusterProxyInfo{HTTPProxy: "http://10.10.1.1:3128"}
+			clusterISOPath, err := editorForFile(templatePath, workDir, mockStaticNetworkConfig).CreateClusterMinimalISO("ignition", "", proxyInfo, nil)
+			Expect(err).ToNot(HaveOccurred())
+			defer os.Remove(clusterISOPath)
+			expected, err := ioutil.ReadFile(clusterISOPath)
+			Expect(err).ToNot(HaveOccurred())
+
+			ramDisk, err := RAMDiskImageArchive(mockStaticNetworkConfig, "", proxyInfo)
+			Expect(err).ToNot(HaveOccurred())
+			reader, err := NewClusterISOReader(templatePath, "ignition", ramDisk, nil)
+			Expect(err).ToNot(HaveOccurred())
+			defer reader.Close()
+			Expect(reader.Size()).To(Equal(int64(len(expected))))
+			content, err := ioutil.ReadAll(reader)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(content).To(Equal(expected))
+
+			By("reading a range across the ignition area")
+			ignitionOffsetInfo, _, err := readHeader(templatePath)
+			Expect(err).ToNot(HaveOccurred())
+			start := int64(ignitionOffsetInfo.Offset) - 10
+			_, err = reader.Seek(start, io.SeekStart)
+			Expect(err).ToNot(HaveOccurred())
+			part := make([]byte, 100)
+			_, err = io.ReadFull(reader, part)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(part).To(Equal(expected[start : start+100]))
+		})
+
+		It("reads the same content as the created cluster ISO with kernel arguments", func() {
+			templatePath, err := editorForFile(isoFile, workDir, mockStaticNetworkConfig).CreateMinimalISOTemplate(testRootFSURL)
+			Expect(err).ToNot(HaveOccurred())
+			defer os.Remove(templatePath)
+
+			kernelArguments := models.KernelArguments{
+				{Operation: models.KernelArgumentOperationAppend, Value: "console=ttyS1,115200"},
+				{Operation: models.KernelArgumentOperationDelete, Value: "rd.luks.options"},
+			}
+			proxyInfo := &ClusterProxyInfo{}
+			clusterISOPath, err := editorForFile(templatePath, workDir, mockStaticNetworkConfig).CreateClusterMinimalISO("ignition", "", proxyInfo, kernelArguments)
+			Expect(err).ToNot(HaveOccurred())
+			defer os.Remove(clusterISOPath)
+			expected, err := ioutil.ReadFile(clusterISOPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(expected)).To(ContainSubstring("ignition.platform.id=metal coreos.live.rootfs_url=%s console=ttyS1,115200", testRootFSURL))
+			Expect(string(expected)).ToNot(ContainSubstring("rd.luks.options"))
+
+			ramDisk, err := RAMDiskImageArchive(mockStaticNetworkConfig, "", proxyInfo)
+			Expect(err).ToNot(HaveOccurred())
+			reader, err := NewClusterISOReader(templatePath, "ignition", ramDisk, kernelArguments)
+			Expect(err).ToNot(HaveOccurred())
+			defer reader.Close()
+			Expect(reader.Size()).To(Equal(int64(len(expected))))
+			content, err := ioutil.ReadAll(reader)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(content).To(Equal(expected))
+		})
+
+		It("fails when the RAM disk does not fit its area", func() {
+			templatePath, err := editorForFile(isoFile, workDir, mockStaticNetworkConfig).CreateMinimalISOTemplate(testRootFSURL)
+			Expect(err).ToNot(HaveOccurred())
+			defer os.Remove(templatePath)
+
+			_, err = NewClusterISOReader(templatePath, "ignition", make([]byte, RamDiskPaddingLength+1), nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("CreateClusterDiskImage", func() {
+		var templatePath string
+
+		BeforeEach(func() {
+			var err error
+			templatePath, err = editorForFile(isoFile, workDir, mockStaticNetworkConfig).CreateMinimalISOTemplate(testRootFSURL)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.Remove(templatePath)
+		})
+
+		readClusterISO := func() []byte {
+			reader, err := NewClusterISOReader(templatePath, "ignition", nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			defer reader.Close()
+			content, err := ioutil.ReadAll(reader)
+			Expect(err).ToNot(HaveOccurred())
+			return content
+		}
+
+		It("creates a raw disk image with the ignition embedded", func() {
+			imagePath, err := editorForFile(templatePath, workDir, mockStaticNetworkConfig).CreateClusterDiskImage("ignition", nil, DiskImageFormatRaw)
+			Expect(err).ToNot(HaveOccurred())
+			defer os.Remove(imagePath)
+
+			content, err := ioutil.ReadFile(imagePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(content).To(Equal(readClusterISO()))
+		})
+
+		It("creates a qcow2 image with the content of the raw disk image", func() {
+			imagePath, err := editorForFile(templatePath, workDir, mockStaticNetworkConfig).CreateClusterDiskImage("ignition", nil, DiskImageFormatQcow2)
+			Expect(err).ToNot(HaveOccurred())
+			defer os.Remove(imagePath)
+
+			Expect(readQcow2(imagePath)).To(Equal(readClusterISO()))
+		})
+
+		It("fails with an unsupported format", func() {
+			_, err := editorForFile(templatePath, workDir, mockStaticNetworkConfig).CreateClusterDiskImage("ignition", nil, "vmdk")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("ExtractPXEArtifacts", func() {
+		It("extracts the network boot artifacts", func() {
+			editor := &rhcosEditor{
+				isoHandler: isoutil.NewHandler(isoFile, workDir),
+				log:        getTestLog(),
+				workDir:    workDir,
+			}
+			artifacts, err := editor.ExtractPXEArtifacts()
+			Expect(err).ToNot(HaveOccurred())
+
+			for path, content := range map[string]string{
+				artifacts.KernelPath: "this is kernel",
+				artifacts.InitrdPath: "this is initrd",
+				artifacts.RootFSPath: "this is rootfs",
+			} {
+				Expect(filepath.Dir(path)).To(Equal(workDir))
+				fileContent, err := ioutil.ReadFile(path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(fileContent)).To(Equal(content))
+			}
+
+			artifacts.Remove()
+			_, err = os.Stat(artifacts.KernelPath)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
 	Describe("fixTemplateConfigs", func() {
 		It("alters the kernel parameters correctly", func() {
 			editor := editorForFile(isoFile, workDir, mockStaticNetworkConfig)
@@ -300,6 +448,10 @@ label linux
 	Expect(err).ToNot(HaveOccurred())
 	err = ioutil.WriteFile(filepath.Join(filesDir, "files/images/pxeboot/rootfs.img"), []byte("this is rootfs"), 0600)
 	Expect(err).ToNot(HaveOccurred())
+	err = ioutil.WriteFile(filepath.Join(filesDir, "files/images/pxeboot/vmlinuz"), []byte("this is kernel"), 0600)
+	Expect(err).ToNot(HaveOccurred())
+	err = ioutil.WriteFile(filepath.Join(filesDir, "files/images/pxeboot/initrd.img"), []byte("this is initrd"), 0600)
+	Expect(err).ToNot(HaveOccurred())
 	err = os.MkdirAll(filepath.Join(filesDir, "files/EFI/redhat"), 0755)
 	Expect(err).ToNot(HaveOccurred())
 	err = ioutil.WriteFile(filepath.Join(filesDir, "files/EFI/redhat/grub.cfg"), []byte(grubConfig), 0600)
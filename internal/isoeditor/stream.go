@@ -0,0 +1,165 @@
+package isoeditor
+
+import (
+	"io"
+	"os"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// isoOverlay replaces an area of the base ISO with the given data, padded with zeros to the length of the area
+type isoOverlay struct {
+	offset int64
+	data   []byte
+}
+
+// ClusterISOReader reads a cluster discovery ISO while generating it from a base ISO, such that the
+// cluster's ignition and RAM disk areas are overlaid at read time and the cluster ISO is never written.
+type ClusterISOReader struct {
+	iso      *os.File
+	size     int64
+	position int64
+	overlays []isoOverlay
+}
+
+// NewClusterISOReader opens the base ISO for reading with the given ignition config, and RAM disk archive if the base
+// ISO is a minimal ISO template. The RAM disk area is left as is when no RAM disk archive is given, and so are the
+// boot configs when no kernel argument operations are given.
+func NewClusterISOReader(isoPath, ignitionConfig string, ramDisk []byte, kernelArguments models.KernelArguments) (*ClusterISOReader, error) {
+	var configOverlays []isoOverlay
+	if len(kernelArguments) > 0 {
+		var err error
+		if configOverlays, err = kernelArgumentsOverlays(isoPath, kernelArguments); err != nil {
+			return nil, err
+		}
+	}
+	iso, err := os.Open(isoPath)
+	if err != nil {
+		return nil, err
+	}
+	r, err := newClusterISOReader(iso, ignitionConfig, ramDisk)
+	if err != nil {
+		iso.Close()
+		return nil, err
+	}
+	r.overlays = append(r.overlays, configOverlays...)
+	return r, nil
+}
+
+func newClusterISOReader(iso *os.File, ignitionConfig string, ramDisk []byte) (*ClusterISOReader, error) {
+	info, err := iso.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	ignitionMetadata := make([]byte, ignitionHeaderSize)
+	if _, err = iso.ReadAt(ignitionMetadata, isoSystemAreaSize-ignitionHeaderSize); err != nil {
+		return nil, err
+	}
+	ignitionOffsetInfo, err := GetIgnitionArea(ignitionMetadata)
+	if err != nil {
+		return nil, err
+	}
+	ignitionArchive, err := IgnitionImageArchive(ignitionConfig)
+	if err != nil {
+		return nil, err
+	}
+	ignitionOverlay, err := newISOOverlay(ignitionArchive, ignitionOffsetInfo, "Compressed Ignition config")
+	if err != nil {
+		return nil, err
+	}
+	overlays := []isoOverlay{ignitionOverlay}
+
+	if len(ramDisk) > 0 {
+		ramDiskMetadata := make([]byte, ignitionHeaderSize)
+		if _, err = iso.ReadAt(ramDiskMetadata, isoSystemAreaSize-2*ignitionHeaderSize); err != nil {
+			return nil, err
+		}
+		ramDiskOffsetInfo, err := GetRamDiskArea(ramDiskMetadata)
+		if err != nil {
+			return nil, err
+		}
+		ramDiskOverlay, err := newISOOverlay(ramDisk, ramDiskOffsetInfo, "Custom RAM disk")
+		if err != nil {
+			return nil, err
+		}
+		overlays = append(overlays, ramDiskOverlay)
+	}
+
+	return &ClusterISOReader{iso: iso, size: info.Size(), overlays: overlays}, nil
+}
+
+func newISOOverlay(data []byte, offsetInfo *OffsetInfo, description string) (isoOverlay, error) {
+	if uint64(len(data)) > offsetInfo.Length {
+		return isoOverlay{}, errors.Errorf("%s is larger than its area in the ISO (%d bytes > %d bytes)",
+			description, len(data), offsetInfo.Length)
+	}
+	area := make([]byte, offsetInfo.Length)
+	copy(area, data)
+	return isoOverlay{offset: int64(offsetInfo.Offset), data: area}, nil
+}
+
+// Size returns the size of the cluster ISO, which is the size of the base ISO
+func (r *ClusterISOReader) Size() int64 {
+	return r.size
+}
+
+func (r *ClusterISOReader) Read(p []byte) (int, error) {
+	if r.position >= r.size {
+		return 0, io.EOF
+	}
+	if remaining := r.size - r.position; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+	n, err := r.iso.ReadAt(p, r.position)
+	if err != nil && err != io.EOF {
+		return n, err
+	}
+	for _, overlay := range r.overlays {
+		start := max(overlay.offset, r.position)
+		end := min(overlay.offset+int64(len(overlay.data)), r.position+int64(n))
+		if start < end {
+			copy(p[start-r.position:end-r.position], overlay.data[start-overlay.offset:end-overlay.offset])
+		}
+	}
+	r.position += int64(n)
+	return n, err
+}
+
+func (r *ClusterISOReader) Seek(offset int64, whence int) (int64, error) {
+	var position int64
+	switch whence {
+	case io.SeekStart:
+		position = offset
+	case io.SeekCurrent:
+		position = r.position + offset
+	case io.SeekEnd:
+		position = r.size + offset
+	default:
+		return 0, errors.Errorf("invalid whence %d", whence)
+	}
+	if position < 0 {
+		return 0, errors.New("negative position")
+	}
+	r.position = position
+	return position, nil
+}
+
+func (r *ClusterISOReader) Close() error {
+	return r.iso.Close()
+}
+
+func min(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,120 @@
+package loganalysis
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/logarchive"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// maxExcerptLength limits the length of the matching line that is stored with a finding
+	maxExcerptLength = 512
+	// maxLineLength is the longest line that is scanned, longer lines end the scan of their file
+	maxLineLength = 1024 * 1024
+)
+
+type Config struct {
+	Signatures SignaturesDecoder `envconfig:"LOG_ANALYSIS_SIGNATURES" default:""`
+	// MaxAnalyzedBytes limits the amount of uncompressed data that is scanned in a single archive
+	MaxAnalyzedBytes int64 `envconfig:"LOG_ANALYSIS_MAX_ANALYZED_BYTES" default:"2147483648"`
+}
+
+//go:generate mockgen -source=analyzer.go -package=loganalysis -destination=mock_analyzer.go
+type Analyzer interface {
+	// Analyze scans a gzipped tar archive of logs and returns a finding for every signature that matched.
+	// The returned findings are not associated with a cluster or a host.
+	Analyze(reader io.Reader) ([]*models.LogFinding, error)
+}
+
+type analyzer struct {
+	log        logrus.FieldLogger
+	signatures []Signature
+	maxBytes   int64
+}
+
+func NewAnalyzer(log logrus.FieldLogger, cfg Config) (Analyzer, error) {
+	signatures := []Signature(cfg.Signatures)
+	if len(signatures) == 0 {
+		signatures = make([]Signature, len(DefaultSignatures))
+		copy(signatures, DefaultSignatures)
+		if err := compileSignatures(signatures); err != nil {
+			return nil, err
+		}
+	}
+	return &analyzer{
+		log:        log,
+		signatures: signatures,
+		maxBytes:   cfg.MaxAnalyzedBytes,
+	}, nil
+}
+
+func (a *analyzer) Analyze(reader io.Reader) ([]*models.LogFinding, error) {
+	findings := make(map[string]*models.LogFinding)
+	err := logarchive.Walk(a.log, reader, a.maxBytes, func(entry logarchive.Entry, r io.Reader) error {
+		a.analyzeFile(r, entry.Name, findings)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the order of the catalogue so results are stable
+	ret := make([]*models.LogFinding, 0, len(findings))
+	for i := range a.signatures {
+		if finding, ok := findings[a.signatures[i].ID]; ok {
+			ret = append(ret, finding)
+		}
+	}
+	return ret, nil
+}
+
+func (a *analyzer) analyzeFile(reader io.Reader, name string, findings map[string]*models.LogFinding) {
+	var signatures []*Signature
+	for i := range a.signatures {
+		if a.signatures[i].appliesTo(name) {
+			signatures = append(signatures, &a.signatures[i])
+		}
+	}
+	if len(signatures) == 0 {
+		return
+	}
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), maxLineLength)
+	for scanner.Scan() {
+		line := scanner.Text()
+		for _, s := range signatures {
+			if !s.regex.MatchString(line) {
+				continue
+			}
+			finding, ok := findings[s.ID]
+			if !ok {
+				finding = &models.LogFinding{
+					SignatureID: s.ID,
+					Description: s.Description,
+					Severity:    s.Severity,
+					File:        name,
+					Excerpt:     excerpt(line),
+				}
+				findings[s.ID] = finding
+			}
+			finding.MatchCount++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		a.log.WithError(err).Debugf("Stopped scanning log file %s", name)
+	}
+}
+
+// excerpt returns a shortened line that is safe to store in the database
+func excerpt(line string) string {
+	line = strings.TrimSpace(line)
+	if len(line) > maxExcerptLength {
+		line = line[:maxExcerptLength]
+	}
+	return strings.ReplaceAll(strings.ToValidUTF8(line, ""), "\x00", "")
+}
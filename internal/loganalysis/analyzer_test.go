@@ -0,0 +1,189 @@
+package loganalysis
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+func TestLogAnalysis(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Log analysis Suite")
+}
+
+type logFile struct {
+	name    string
+	content []byte
+}
+
+func createTar(files ...logFile) []byte {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, f := range files {
+		Expect(tw.WriteHeader(&tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.content)), Typeflag: tar.TypeReg})).To(Succeed())
+		_, err := tw.Write(f.content)
+		Expect(err).ToNot(HaveOccurred())
+	}
+	Expect(tw.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+func gzipped(content []byte) []byte {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	_, err := gw.Write(content)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(gw.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+func createArchive(files ...logFile) []byte {
+	return gzipped(createTar(files...))
+}
+
+func text(lines ...string) []byte {
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
+
+func findingIDs(findings []*models.LogFinding) []string {
+	ids := make([]string, 0, len(findings))
+	for _, f := range findings {
+		ids = append(ids, f.SignatureID)
+	}
+	return ids
+}
+
+var _ = Describe("Analyze", func() {
+	var a Analyzer
+
+	BeforeEach(func() {
+		var err error
+		a, err = NewAnalyzer(common.GetTestLog(), Config{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("finds every default signature", func() {
+		archive := createArchive(
+			logFile{name: "journal.logs", content: text(
+				"Jun 10 10:00:00 host ignition[812]: failed to fetch config: Get \"https://10.0.0.1:22623/config/master\": dial tcp: connection refused",
+				"Jun 10 10:00:01 host kernel: blk_update_request: I/O error, dev sda, sector 2048",
+				"Jun 10 10:00:02 host kubelet[2211]: Error: ErrImagePull",
+				"Jun 10 10:00:03 host etcd[3122]: etcdserver: no leader",
+				"Jun 10 10:00:04 host installer: csr-8fl2x 5m system:node:master-0 Pending",
+			)},
+		)
+		findings, err := a.Analyze(bytes.NewReader(archive))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(findingIDs(findings)).To(Equal([]string{
+			"ignition-fetch-failure", "image-pull-failure", "etcd-quorum-loss", "disk-write-error", "csr-approval-stall",
+		}))
+		Expect(findings[2].Severity).To(Equal(models.EventSeverityCritical))
+		Expect(findings[2].File).To(Equal("journal.logs"))
+		Expect(findings[2].Excerpt).To(Equal("Jun 10 10:00:03 host etcd[3122]: etcdserver: no leader"))
+	})
+
+	It("returns no findings for clean logs", func() {
+		findings, err := a.Analyze(bytes.NewReader(createArchive(logFile{name: "journal.logs", content: text("all good")})))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(findings).To(BeEmpty())
+	})
+
+	It("counts matches and keeps the first one", func() {
+		archive := createArchive(
+			logFile{name: "a.log", content: text("etcdserver: request timed out", "nothing")},
+			logFile{name: "b.log", content: text("raft: lost leader", "etcdserver: no leader")},
+		)
+		findings, err := a.Analyze(bytes.NewReader(archive))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(findings).To(HaveLen(1))
+		Expect(findings[0].MatchCount).To(Equal(int64(3)))
+		Expect(findings[0].File).To(Equal("a.log"))
+		Expect(findings[0].Excerpt).To(Equal("etcdserver: request timed out"))
+	})
+
+	It("unpacks nested archives and compressed files", func() {
+		nested := createArchive(
+			logFile{name: "must-gather/etcd.log", content: text("etcdserver: no leader")},
+			logFile{name: "kubelet.log.gz", content: gzipped(text("Back-off pulling image: ImagePullBackOff"))},
+		)
+		archive := createArchive(logFile{name: "controller_logs.tar.gz", content: nested})
+		findings, err := a.Analyze(bytes.NewReader(archive))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(findingIDs(findings)).To(Equal([]string{"image-pull-failure", "etcd-quorum-loss"}))
+		Expect(findings[0].File).To(Equal("controller_logs.tar.gz/kubelet.log"))
+		Expect(findings[1].File).To(Equal("controller_logs.tar.gz/must-gather/etcd.log"))
+	})
+
+	It("analyzes a truncated archive as far as it can", func() {
+		archive := createTar(
+			logFile{name: "journal.logs", content: text("etcdserver: no leader")},
+			logFile{name: "other.logs", content: bytes.Repeat([]byte("x"), 4096)},
+		)
+		findings, err := a.Analyze(bytes.NewReader(gzipped(archive[:len(archive)-3072])))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(findingIDs(findings)).To(Equal([]string{"etcd-quorum-loss"}))
+	})
+
+	It("fails for data that is not a gzip archive", func() {
+		_, err := a.Analyze(strings.NewReader("not an archive"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("sanitizes excerpts", func() {
+		line := "etcdserver: no leader \x00\xff" + strings.Repeat("a", 2*maxExcerptLength)
+		findings, err := a.Analyze(bytes.NewReader(createArchive(logFile{name: "etcd.log", content: text(line)})))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(findings).To(HaveLen(1))
+		Expect(len(findings[0].Excerpt)).To(BeNumerically("<=", maxExcerptLength))
+		Expect(findings[0].Excerpt).ToNot(ContainSubstring("\x00"))
+		Expect(findings[0].Excerpt).To(HavePrefix("etcdserver: no leader a"))
+	})
+})
+
+var _ = Describe("Signatures configuration", func() {
+	It("uses a configured catalogue instead of the default one", func() {
+		var signatures SignaturesDecoder
+		Expect(signatures.Decode(`[{"id": "oom", "description": "Out of memory", "severity": "warning", "pattern": "Out of memory: Killed process", "files": ["*.journal"]}]`)).To(Succeed())
+
+		a, err := NewAnalyzer(common.GetTestLog(), Config{Signatures: signatures})
+		Expect(err).ToNot(HaveOccurred())
+		archive := createArchive(
+			logFile{name: "host.journal", content: text("kernel: Out of memory: Killed process 1234 (java)", "etcdserver: no leader")},
+			logFile{name: "other.log", content: text("kernel: Out of memory: Killed process 4321 (java)")},
+		)
+		findings, err := a.Analyze(bytes.NewReader(archive))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(findings).To(HaveLen(1))
+		Expect(findings[0].SignatureID).To(Equal("oom"))
+		Expect(findings[0].Severity).To(Equal(models.EventSeverityWarning))
+		Expect(findings[0].MatchCount).To(Equal(int64(1)))
+	})
+
+	It("accepts an empty configuration", func() {
+		var signatures SignaturesDecoder
+		Expect(signatures.Decode("")).To(Succeed())
+		Expect(signatures).To(BeEmpty())
+	})
+
+	table.DescribeTable("rejects invalid signatures",
+		func(value string) {
+			var signatures SignaturesDecoder
+			Expect(signatures.Decode(value)).ToNot(Succeed())
+		},
+		table.Entry("invalid JSON", `[{`),
+		table.Entry("missing id", `[{"severity": "error", "pattern": "x"}]`),
+		table.Entry("duplicate id", `[{"id": "a", "severity": "error", "pattern": "x"}, {"id": "a", "severity": "error", "pattern": "y"}]`),
+		table.Entry("invalid severity", `[{"id": "a", "severity": "fatal", "pattern": "x"}]`),
+		table.Entry("missing pattern", `[{"id": "a", "severity": "error"}]`),
+		table.Entry("invalid pattern", `[{"id": "a", "severity": "error", "pattern": "("}]`),
+		table.Entry("invalid file pattern", `[{"id": "a", "severity": "error", "pattern": "x", "files": ["["]}]`),
+	)
+})
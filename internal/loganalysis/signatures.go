@@ -0,0 +1,124 @@
+package loganalysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// Signature describes a known failure that can be recognized by matching a single line of a log file
+type Signature struct {
+	// ID is a stable identifier of the signature, stored with every finding
+	ID string `json:"id"`
+	// Description is a human readable explanation of the failure
+	Description string `json:"description"`
+	// Severity is one of the event severities (info, warning, error or critical)
+	Severity string `json:"severity"`
+	// Pattern is a regular expression that is matched against every log line
+	Pattern string `json:"pattern"`
+	// Files is an optional list of glob patterns of file base names the signature applies to. All files are scanned if empty
+	Files []string `json:"files,omitempty"`
+
+	regex *regexp.Regexp
+}
+
+// DefaultSignatures is the catalogue used when no catalogue is configured
+var DefaultSignatures = []Signature{
+	{
+		ID:          "ignition-fetch-failure",
+		Description: "Ignition failed to fetch its configuration",
+		Severity:    models.EventSeverityError,
+		Pattern:     `(?i)(failed to fetch config|ignition.*GET error|ignition.*GET .* failed)`,
+	},
+	{
+		ID:          "image-pull-failure",
+		Description: "A container image could not be pulled",
+		Severity:    models.EventSeverityError,
+		Pattern:     `(?i)(ErrImagePull|ImagePullBackOff|failed to pull image|error pulling image|Error: (initializing source|reading manifest))`,
+	},
+	{
+		ID:          "etcd-quorum-loss",
+		Description: "etcd lost its quorum",
+		Severity:    models.EventSeverityCritical,
+		Pattern:     `(?i)(etcdserver: (no leader|request timed out)|lost leader|quorum (lost|is lost|not met))`,
+	},
+	{
+		ID:          "disk-write-error",
+		Description: "Writing to a disk failed",
+		Severity:    models.EventSeverityError,
+		Pattern:     `(?i)(Buffer I/O error|blk_update_request: (I/O|critical medium) error|I/O error, dev|No space left on device|EXT4-fs error|XFS \(.*\): (metadata I/O error|Corruption))`,
+	},
+	{
+		ID:          "csr-approval-stall",
+		Description: "Certificate signing requests are waiting for approval",
+		Severity:    models.EventSeverityWarning,
+		Pattern:     `(?i)(csr-\S+\s.*Pending|certificate signing requests? .*(pending|not (yet )?approved)|waiting for csrs? )`,
+	},
+}
+
+// SignaturesDecoder decodes a JSON list of signatures from the configuration
+type SignaturesDecoder []Signature
+
+func (d *SignaturesDecoder) Decode(value string) error {
+	var signatures []Signature
+	if strings.TrimSpace(value) != "" {
+		if err := json.Unmarshal([]byte(value), &signatures); err != nil {
+			return err
+		}
+	}
+	if err := compileSignatures(signatures); err != nil {
+		return err
+	}
+	*d = signatures
+	return nil
+}
+
+func compileSignatures(signatures []Signature) error {
+	ids := make(map[string]bool)
+	for i := range signatures {
+		s := &signatures[i]
+		if s.ID == "" {
+			return fmt.Errorf("log analysis signature %d has no id", i)
+		}
+		if ids[s.ID] {
+			return fmt.Errorf("log analysis signature %s is defined more than once", s.ID)
+		}
+		ids[s.ID] = true
+		switch s.Severity {
+		case models.EventSeverityInfo, models.EventSeverityWarning, models.EventSeverityError, models.EventSeverityCritical:
+		default:
+			return fmt.Errorf("log analysis signature %s has invalid severity %q", s.ID, s.Severity)
+		}
+		for _, pattern := range s.Files {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("log analysis signature %s has invalid file pattern %q: %w", s.ID, pattern, err)
+			}
+		}
+		if s.Pattern == "" {
+			return fmt.Errorf("log analysis signature %s has no pattern", s.ID)
+		}
+		regex, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return fmt.Errorf("log analysis signature %s has invalid pattern: %w", s.ID, err)
+		}
+		s.regex = regex
+	}
+	return nil
+}
+
+func (s *Signature) appliesTo(fileName string) bool {
+	if len(s.Files) == 0 {
+		return true
+	}
+	base := path.Base(fileName)
+	for _, pattern := range s.Files {
+		if matched, _ := path.Match(pattern, base); matched {
+			return true
+		}
+	}
+	return false
+}
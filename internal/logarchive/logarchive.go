@@ -0,0 +1,280 @@
+package logarchive
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// maxArchiveDepth limits the nesting of archives inside a logs archive
+	maxArchiveDepth = 3
+	// maxLineLength is the longest line that is searched, longer lines end the search of their file
+	maxLineLength = 1024 * 1024
+)
+
+// SkipAll is returned by a WalkFunc to stop the walk without failing it
+var SkipAll = errors.New("skip all remaining entries")
+
+// Entry is a log file inside a logs archive. Files inside nested archives are named by the path of the nested
+// archive followed by their path inside it, and compressed files are named without their .gz suffix.
+type Entry struct {
+	Name    string
+	Size    int64 // The uncompressed size, or -1 when the file is compressed and its size is unknown
+	ModTime time.Time
+}
+
+// WalkFunc is called for every file of a logs archive with a reader of its uncompressed content
+type WalkFunc func(entry Entry, reader io.Reader) error
+
+// Walk unpacks a gzipped tar archive of logs, including nested archives and compressed files, and calls fn for
+// every file in it. When maxBytes is positive, no more than maxBytes of uncompressed data are read.
+// A truncated archive is walked as far as it can be read.
+func Walk(log logrus.FieldLogger, reader io.Reader, maxBytes int64, fn WalkFunc) error {
+	_, err := walk(log, reader, maxBytes, fn)
+	return err
+}
+
+// walk is Walk, which also returns whether the archive had more than maxBytes of uncompressed data
+func walk(log logrus.FieldLogger, reader io.Reader, maxBytes int64, fn WalkFunc) (bool, error) {
+	gz, err := gzip.NewReader(reader)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to open logs archive")
+	}
+	defer gz.Close()
+
+	var src io.Reader = gz
+	var limited *limitedReader
+	if maxBytes > 0 {
+		limited = &limitedReader{reader: gz, remaining: maxBytes}
+		src = limited
+	}
+	err = walkArchive(log, tar.NewReader(src), "", 0, fn)
+	if err == SkipAll {
+		err = nil
+	}
+	return limited != nil && limited.truncated, err
+}
+
+// limitedReader reads up to a limit, and records whether there was more to read
+type limitedReader struct {
+	reader    io.Reader
+	remaining int64
+	truncated bool
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.remaining <= 0 {
+		if !l.truncated {
+			n, _ := l.reader.Read(make([]byte, 1))
+			l.truncated = n > 0
+		}
+		return 0, io.EOF
+	}
+	if int64(len(p)) > l.remaining {
+		p = p[:l.remaining]
+	}
+	n, err := l.reader.Read(p)
+	l.remaining -= int64(n)
+	return n, err
+}
+
+func walkArchive(log logrus.FieldLogger, tr *tar.Reader, prefix string, depth int, fn WalkFunc) error {
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			if depth == 0 && err != io.ErrUnexpectedEOF {
+				return errors.Wrap(err, "failed to read logs archive")
+			}
+			// A truncated archive, either because of the size limit or an incomplete upload,
+			// is walked as far as it could be read
+			log.WithError(err).Debugf("Stopped reading logs archive %s", prefix)
+			return nil
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := path.Join(prefix, hdr.Name)
+		switch {
+		case strings.HasSuffix(name, ".tar.gz") || strings.HasSuffix(name, ".tgz"):
+			if depth >= maxArchiveDepth {
+				continue
+			}
+			gz, err := gzip.NewReader(tr)
+			if err != nil {
+				log.WithError(err).Debugf("Skipping nested logs archive %s", name)
+				continue
+			}
+			if err = walkArchive(log, tar.NewReader(gz), name, depth+1, fn); err != nil {
+				return err
+			}
+		case strings.HasSuffix(name, ".tar"):
+			if depth >= maxArchiveDepth {
+				continue
+			}
+			if err = walkArchive(log, tar.NewReader(tr), name, depth+1, fn); err != nil {
+				return err
+			}
+		case strings.HasSuffix(name, ".gz"):
+			gz, err := gzip.NewReader(tr)
+			if err != nil {
+				log.WithError(err).Debugf("Skipping compressed log file %s", name)
+				continue
+			}
+			if err = fn(Entry{Name: strings.TrimSuffix(name, ".gz"), Size: -1, ModTime: hdr.ModTime}, gz); err != nil {
+				return err
+			}
+		default:
+			if err = fn(Entry{Name: name, Size: hdr.Size, ModTime: hdr.ModTime}, tr); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// List returns the files of a logs archive, up to maxBytes of uncompressed data when it is positive. The size of
+// compressed files is found by reading their content. The returned flag is set when the archive was only listed up
+// to maxBytes.
+func List(log logrus.FieldLogger, reader io.Reader, maxBytes int64) ([]Entry, bool, error) {
+	entries := make([]Entry, 0)
+	truncated, err := walk(log, reader, maxBytes, func(entry Entry, r io.Reader) error {
+		if entry.Size < 0 {
+			size, err := io.Copy(ioutil.Discard, r)
+			if err != nil {
+				log.WithError(err).Debugf("Failed to read compressed log file %s", entry.Name)
+				return nil
+			}
+			entry.Size = size
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return entries, truncated, nil
+}
+
+// CopyLines copies the lines from startLine to endLine of a log file, both 1-based and inclusive.
+// A non-positive endLine copies until the end of the file.
+func CopyLines(w io.Writer, r io.Reader, startLine, endLine int64) error {
+	br := bufio.NewReader(r)
+	for lineNumber := int64(1); endLine <= 0 || lineNumber <= endLine; lineNumber++ {
+		line, err := br.ReadSlice('\n')
+		for err == bufio.ErrBufferFull {
+			// Long lines are copied in chunks
+			if lineNumber >= startLine {
+				if _, werr := w.Write(line); werr != nil {
+					return werr
+				}
+			}
+			line, err = br.ReadSlice('\n')
+		}
+		if lineNumber >= startLine && len(line) > 0 {
+			if _, werr := w.Write(line); werr != nil {
+				return werr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ErrEntryNotFound is returned when a logs archive has no file with the requested name
+var ErrEntryNotFound = errors.New("log file not found in logs archive")
+
+// Extract returns the lines from startLine to endLine of a file of a logs archive, see CopyLines.
+// The content is extracted while it is read, and the archive is closed once the returned reader is done.
+func Extract(log logrus.FieldLogger, archive io.ReadCloser, name string, startLine, endLine int64) (io.ReadCloser, error) {
+	pr, pw := io.Pipe()
+	found := make(chan error, 1)
+	go func() {
+		defer archive.Close()
+		extracted := false
+		err := Walk(log, archive, 0, func(entry Entry, r io.Reader) error {
+			if entry.Name != name {
+				return nil
+			}
+			extracted = true
+			found <- nil
+			if err := CopyLines(pw, r, startLine, endLine); err != nil {
+				return err
+			}
+			return SkipAll
+		})
+		if !extracted {
+			if err == nil {
+				err = ErrEntryNotFound
+			}
+			found <- err
+		}
+		pw.CloseWithError(err)
+	}()
+
+	if err := <-found; err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// Match is a line of a log file that matched a search
+type Match struct {
+	Name       string
+	LineNumber int64
+	Line       string
+}
+
+// SearchResult holds the lines of a logs archive that matched a search
+type SearchResult struct {
+	Matches []Match
+	// Set when there were more matches than the requested maximum
+	Truncated bool
+	// Set when the archive was only searched up to the size limit
+	ArchiveTruncated bool
+}
+
+// Search returns the lines of the files of a logs archive that match regex, up to maxResults matches, searching up
+// to maxBytes of uncompressed data when it is positive
+func Search(log logrus.FieldLogger, reader io.Reader, regex *regexp.Regexp, maxResults int, maxBytes int64) (*SearchResult, error) {
+	matches := make([]Match, 0)
+	truncated := false
+	archiveTruncated, err := walk(log, reader, maxBytes, func(entry Entry, r io.Reader) error {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 64*1024), maxLineLength)
+		for lineNumber := int64(1); scanner.Scan(); lineNumber++ {
+			if !regex.Match(scanner.Bytes()) {
+				continue
+			}
+			if len(matches) == maxResults {
+				truncated = true
+				return SkipAll
+			}
+			matches = append(matches, Match{Name: entry.Name, LineNumber: lineNumber, Line: scanner.Text()})
+		}
+		if err := scanner.Err(); err != nil {
+			log.WithError(err).Debugf("Stopped searching log file %s", entry.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &SearchResult{Matches: matches, Truncated: truncated, ArchiveTruncated: archiveTruncated}, nil
+}
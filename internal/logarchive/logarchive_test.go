@@ -0,0 +1,166 @@
+package logarchive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+)
+
+func TestLogArchive(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Log archive Suite")
+}
+
+var modTime = time.Date(2021, 6, 10, 10, 0, 0, 0, time.UTC)
+
+type logFile struct {
+	name    string
+	content []byte
+}
+
+func createTar(files ...logFile) []byte {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, f := range files {
+		Expect(tw.WriteHeader(&tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.content)), ModTime: modTime, Typeflag: tar.TypeReg})).To(Succeed())
+		_, err := tw.Write(f.content)
+		Expect(err).ToNot(HaveOccurred())
+	}
+	Expect(tw.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+func gzipped(content []byte) []byte {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	_, err := gw.Write(content)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(gw.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+func createArchive(files ...logFile) []byte {
+	return gzipped(createTar(files...))
+}
+
+var _ = Describe("Logs archive", func() {
+	var archive []byte
+
+	BeforeEach(func() {
+		nested := createArchive(
+			logFile{name: "must-gather/etcd.log", content: []byte("etcd 1\netcd 2\n")},
+		)
+		archive = createArchive(
+			logFile{name: "journal.logs", content: []byte("line 1\nline 2\nline 3\nline 4\n")},
+			logFile{name: "kubelet.log.gz", content: gzipped([]byte("kubelet 1\nkubelet 2\nerror 3\n"))},
+			logFile{name: "controller_logs.tar.gz", content: nested},
+		)
+	})
+
+	It("lists the files", func() {
+		entries, truncated, err := List(common.GetTestLog(), bytes.NewReader(archive), 1024*1024)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(truncated).To(BeFalse())
+		Expect(entries).To(HaveLen(3))
+		for i, expected := range []Entry{
+			{Name: "journal.logs", Size: 28},
+			{Name: "kubelet.log", Size: 28},
+			{Name: "controller_logs.tar.gz/must-gather/etcd.log", Size: 14},
+		} {
+			Expect(entries[i].Name).To(Equal(expected.Name))
+			Expect(entries[i].Size).To(Equal(expected.Size))
+			Expect(entries[i].ModTime.Equal(modTime)).To(BeTrue())
+		}
+	})
+
+	It("lists the files up to the size limit", func() {
+		// The header and the padded content of the first file
+		entries, truncated, err := List(common.GetTestLog(), bytes.NewReader(archive), 1024)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(truncated).To(BeTrue())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Name).To(Equal("journal.logs"))
+	})
+
+	It("fails to list data that is not a gzip archive", func() {
+		_, _, err := List(common.GetTestLog(), strings.NewReader("not an archive"), 0)
+		Expect(err).To(HaveOccurred())
+	})
+
+	table.DescribeTable("extracts lines of a file",
+		func(name string, startLine, endLine int64, expected string) {
+			r, err := Extract(common.GetTestLog(), ioutil.NopCloser(bytes.NewReader(archive)), name, startLine, endLine)
+			Expect(err).ToNot(HaveOccurred())
+			content, err := ioutil.ReadAll(r)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(r.Close()).To(Succeed())
+			Expect(string(content)).To(Equal(expected))
+		},
+		table.Entry("whole file", "journal.logs", int64(0), int64(0), "line 1\nline 2\nline 3\nline 4\n"),
+		table.Entry("line range", "journal.logs", int64(2), int64(3), "line 2\nline 3\n"),
+		table.Entry("from a line to the end", "journal.logs", int64(3), int64(0), "line 3\nline 4\n"),
+		table.Entry("range past the end", "journal.logs", int64(4), int64(10), "line 4\n"),
+		table.Entry("compressed file", "kubelet.log", int64(2), int64(2), "kubelet 2\n"),
+		table.Entry("file in a nested archive", "controller_logs.tar.gz/must-gather/etcd.log", int64(0), int64(0), "etcd 1\netcd 2\n"),
+	)
+
+	It("fails to extract a missing file", func() {
+		_, err := Extract(common.GetTestLog(), ioutil.NopCloser(bytes.NewReader(archive)), "missing.log", 0, 0)
+		Expect(err).To(Equal(ErrEntryNotFound))
+	})
+
+	It("copies long lines", func() {
+		long := strings.Repeat("a", 10000)
+		var buf bytes.Buffer
+		Expect(CopyLines(&buf, strings.NewReader("short\n"+long+"\nlast"), 2, 0)).To(Succeed())
+		Expect(buf.String()).To(Equal(long + "\nlast"))
+	})
+
+	It("searches all files", func() {
+		result, err := Search(common.GetTestLog(), bytes.NewReader(archive), regexp.MustCompile(`(2|error)`), 10, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Truncated).To(BeFalse())
+		Expect(result.ArchiveTruncated).To(BeFalse())
+		Expect(result.Matches).To(Equal([]Match{
+			{Name: "journal.logs", LineNumber: 2, Line: "line 2"},
+			{Name: "kubelet.log", LineNumber: 2, Line: "kubelet 2"},
+			{Name: "kubelet.log", LineNumber: 3, Line: "error 3"},
+			{Name: "controller_logs.tar.gz/must-gather/etcd.log", LineNumber: 2, Line: "etcd 2"},
+		}))
+	})
+
+	It("caps the search results", func() {
+		result, err := Search(common.GetTestLog(), bytes.NewReader(archive), regexp.MustCompile(`1`), 2, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Truncated).To(BeTrue())
+		Expect(result.Matches).To(HaveLen(2))
+	})
+
+	It("searches up to the size limit", func() {
+		result, err := Search(common.GetTestLog(), bytes.NewReader(archive), regexp.MustCompile(`2`), 10, 1024)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Truncated).To(BeFalse())
+		Expect(result.ArchiveTruncated).To(BeTrue())
+		Expect(result.Matches).To(Equal([]Match{{Name: "journal.logs", LineNumber: 2, Line: "line 2"}}))
+	})
+
+	It("stops walking when asked to", func() {
+		var names []string
+		Expect(Walk(common.GetTestLog(), bytes.NewReader(archive), 0, func(entry Entry, _ io.Reader) error {
+			names = append(names, entry.Name)
+			return SkipAll
+		})).To(Succeed())
+		Expect(names).To(Equal([]string{"journal.logs"}))
+	})
+})
@@ -0,0 +1,370 @@
+package logsupload
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type Config struct {
+	// MaxSize limits the size of the logs archives that are uploaded in chunks
+	MaxSize int64 `envconfig:"LOGS_UPLOAD_MAX_SIZE" default:"10737418240"` // 10GiB
+}
+
+//go:generate mockgen -source=logsupload.go -package=logsupload -destination=mock_logsupload.go
+type API interface {
+	// Create starts a resumable upload of a logs archive of a cluster or of one of its hosts
+	Create(ctx context.Context, clusterID strfmt.UUID, params *models.NewLogsUploadParams) (*models.LogsUpload, error)
+	// Get returns an upload of the cluster with the byte ranges that were received
+	Get(ctx context.Context, clusterID, uploadID strfmt.UUID) (*models.LogsUpload, error)
+	// UploadChunk stores a chunk of an upload once its size and checksum were verified
+	UploadChunk(ctx context.Context, upload *models.LogsUpload, number int64, checksum string, reader io.Reader) error
+	// Open returns the logs archive of an upload whose chunks were all received
+	Open(ctx context.Context, upload *models.LogsUpload) (Archive, error)
+	// Delete deletes an upload together with its chunks
+	Delete(ctx context.Context, upload *models.LogsUpload) error
+	// DeleteAbandoned deletes the uploads that did not receive a chunk since the given time
+	DeleteAbandoned(ctx context.Context, notUpdatedSince time.Time) error
+}
+
+// Archive reads the logs archive of an upload from its chunks. Once the archive was read to its end, and its size or
+// checksum don't match those of the upload, reading fails and VerificationError returns the reason.
+type Archive interface {
+	io.ReadCloser
+	VerificationError() error
+	// Publish stores the archive as the given object by composing its chunks, once it was read to its end and verified
+	Publish(objectName string) error
+}
+
+type Manager struct {
+	Config
+	log           logrus.FieldLogger
+	db            *gorm.DB
+	objectHandler s3wrapper.API
+}
+
+func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, objectHandler s3wrapper.API) *Manager {
+	return &Manager{
+		Config:        cfg,
+		log:           log,
+		db:            db,
+		objectHandler: objectHandler,
+	}
+}
+
+// The chunks of an upload are stored apart from the logs of the cluster, which are collected from the logs directory
+func uploadObjectPrefix(upload *models.LogsUpload) string {
+	return fmt.Sprintf("%s/logs-uploads/%s/", upload.ClusterID, upload.ID)
+}
+
+func chunkObjectName(upload *models.LogsUpload, number int64) string {
+	return fmt.Sprintf("%s%d", uploadObjectPrefix(upload), number)
+}
+
+// tempChunkObjectName returns a unique name of the object a chunk is received in before it is verified
+func tempChunkObjectName(upload *models.LogsUpload, number int64) string {
+	return fmt.Sprintf("%stmp/%d-%s", uploadObjectPrefix(upload), number, uuid.New().String())
+}
+
+func chunksCount(upload *models.LogsUpload) int64 {
+	return (upload.TotalSize + upload.ChunkSize - 1) / upload.ChunkSize
+}
+
+func chunkSize(upload *models.LogsUpload, number int64) int64 {
+	if number == chunksCount(upload)-1 {
+		return upload.TotalSize - number*upload.ChunkSize
+	}
+	return upload.ChunkSize
+}
+
+func (m *Manager) Create(ctx context.Context, clusterID strfmt.UUID, params *models.NewLogsUploadParams) (*models.LogsUpload, error) {
+	log := logutil.FromContext(ctx, m.log)
+	if *params.TotalSize > m.MaxSize {
+		return nil, common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Logs archive of %d bytes exceeds the maximal size of %d bytes", *params.TotalSize, m.MaxSize))
+	}
+	// The chunks of an archive are composed into a single object, which the storage limits to a number of objects
+	if count := (*params.TotalSize + *params.ChunkSize - 1) / *params.ChunkSize; count > s3wrapper.MaxComposedObjects {
+		return nil, common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Logs archive of %d bytes has %d chunks of %d bytes, at most %d chunks are supported",
+				*params.TotalSize, count, *params.ChunkSize, s3wrapper.MaxComposedObjects))
+	}
+	upload := &models.LogsUpload{
+		ID:             strfmt.UUID(uuid.New().String()),
+		ClusterID:      clusterID,
+		LogsType:       models.LogsType(*params.LogsType),
+		TotalSize:      *params.TotalSize,
+		ChunkSize:      *params.ChunkSize,
+		Sha256:         strings.ToLower(params.Sha256),
+		CreatedAt:      strfmt.DateTime(time.Now()),
+		UpdatedAt:      strfmt.DateTime(time.Now()),
+		ReceivedRanges: []*models.LogsUploadRange{},
+	}
+	if upload.LogsType == models.LogsTypeHost {
+		if params.HostID == "" {
+			return nil, common.NewApiError(http.StatusBadRequest, errors.New("A host is required to upload host logs"))
+		}
+		upload.HostID = params.HostID
+	}
+	if err := m.db.Create(upload).Error; err != nil {
+		log.WithError(err).Errorf("Failed to create logs upload for cluster %s", clusterID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Started logs upload %s of %d bytes in %d chunks for cluster %s", upload.ID, upload.TotalSize, chunksCount(upload), clusterID)
+	return upload, nil
+}
+
+func (m *Manager) Get(ctx context.Context, clusterID, uploadID strfmt.UUID) (*models.LogsUpload, error) {
+	var upload models.LogsUpload
+	if err := m.db.Take(&upload, "id = ? and cluster_id = ?", uploadID, clusterID).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("Logs upload %s was not found", uploadID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	chunks, err := m.chunks(&upload)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	upload.ReceivedRanges = receivedRanges(&upload, chunks)
+	return &upload, nil
+}
+
+func (m *Manager) chunks(upload *models.LogsUpload) ([]*common.LogsUploadChunk, error) {
+	var chunks []*common.LogsUploadChunk
+	if err := m.db.Where("upload_id = ?", upload.ID).Order("number").Find(&chunks).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to get the chunks of logs upload %s", upload.ID)
+	}
+	return chunks, nil
+}
+
+// receivedRanges merges the byte ranges of consecutive chunks, which are sorted by their numbers
+func receivedRanges(upload *models.LogsUpload, chunks []*common.LogsUploadChunk) []*models.LogsUploadRange {
+	ranges := []*models.LogsUploadRange{}
+	var last *models.LogsUploadRange
+	for _, chunk := range chunks {
+		start := chunk.Number * upload.ChunkSize
+		end := start + chunk.Size - 1
+		if last != nil && last.End+1 == start {
+			last.End = end
+			continue
+		}
+		last = &models.LogsUploadRange{Start: start, End: end}
+		ranges = append(ranges, last)
+	}
+	return ranges
+}
+
+func (m *Manager) UploadChunk(ctx context.Context, upload *models.LogsUpload, number int64, checksum string, reader io.Reader) error {
+	log := logutil.FromContext(ctx, m.log)
+	if number >= chunksCount(upload) {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Logs upload %s has %d chunks, chunk %d is out of range", upload.ID, chunksCount(upload), number))
+	}
+	// The chunk is uploaded to a temporary object first, so that a chunk that fails its verification does not replace
+	// one that was received before
+	expectedSize := chunkSize(upload, number)
+	tempObjectName := tempChunkObjectName(upload, number)
+	defer func() {
+		if _, err := m.objectHandler.DeleteObject(ctx, tempObjectName); err != nil {
+			log.WithError(err).Warnf("Failed to delete temporary object %s of logs upload %s", tempObjectName, upload.ID)
+		}
+	}()
+	verifier := newVerifier(io.LimitReader(reader, expectedSize+1))
+	if err := m.objectHandler.UploadStream(ctx, verifier, tempObjectName); err != nil {
+		log.WithError(err).Errorf("Failed to upload chunk %d of logs upload %s", number, upload.ID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err := verifier.verify(fmt.Sprintf("chunk %d", number), expectedSize, checksum); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err := m.objectHandler.ComposeObjects(ctx, []string{tempObjectName}, chunkObjectName(upload, number)); err != nil {
+		log.WithError(err).Errorf("Failed to store chunk %d of logs upload %s", number, upload.ID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	chunk := &common.LogsUploadChunk{
+		UploadID:  upload.ID,
+		Number:    number,
+		ClusterID: upload.ClusterID,
+		Size:      verifier.size,
+		SHA256:    verifier.checksum(),
+		CreatedAt: time.Now(),
+	}
+	if err := m.db.Save(chunk).Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to save chunk %d of logs upload %s", number, upload.ID))
+	}
+	if err := m.db.Model(upload).Update("updated_at", strfmt.DateTime(time.Now())).Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to update logs upload %s", upload.ID))
+	}
+	log.Debugf("Received chunk %d of logs upload %s", number, upload.ID)
+	return nil
+}
+
+func (m *Manager) Open(ctx context.Context, upload *models.LogsUpload) (Archive, error) {
+	chunks, err := m.chunks(upload)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if int64(len(chunks)) != chunksCount(upload) {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("Logs upload %s received %d of its %d chunks", upload.ID, len(chunks), chunksCount(upload)))
+	}
+	return &archive{
+		ctx:      ctx,
+		upload:   upload,
+		api:      m.objectHandler,
+		verifier: newVerifier(nil),
+	}, nil
+}
+
+func (m *Manager) Delete(ctx context.Context, upload *models.LogsUpload) error {
+	log := logutil.FromContext(ctx, m.log)
+	objects, err := m.objectHandler.ListObjectsByPrefix(ctx, uploadObjectPrefix(upload))
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to list the chunks of logs upload %s", upload.ID))
+	}
+	for _, objectName := range objects {
+		if _, err = m.objectHandler.DeleteObject(ctx, objectName); err != nil {
+			return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to delete chunk %s", objectName))
+		}
+	}
+	if err = m.db.Delete(&common.LogsUploadChunk{}, "upload_id = ?", upload.ID).Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to delete the chunks of logs upload %s", upload.ID))
+	}
+	if err = m.db.Delete(&models.LogsUpload{}, "id = ?", upload.ID).Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to delete logs upload %s", upload.ID))
+	}
+	log.Infof("Deleted logs upload %s of cluster %s", upload.ID, upload.ClusterID)
+	return nil
+}
+
+func (m *Manager) DeleteAbandoned(ctx context.Context, notUpdatedSince time.Time) error {
+	var uploads []*models.LogsUpload
+	if err := m.db.Where("updated_at < ?", notUpdatedSince).Find(&uploads).Error; err != nil {
+		return errors.Wrap(err, "failed to list abandoned logs uploads")
+	}
+	for _, upload := range uploads {
+		if err := m.Delete(ctx, upload); err != nil {
+			m.log.WithError(err).Warnf("Failed to delete abandoned logs upload %s", upload.ID)
+		}
+	}
+	return nil
+}
+
+// verifier computes the size and the checksum of the content that is read through it
+type verifier struct {
+	reader io.Reader
+	hash   hash.Hash
+	size   int64
+}
+
+func newVerifier(reader io.Reader) *verifier {
+	return &verifier{reader: reader, hash: sha256.New()}
+}
+
+func (v *verifier) Read(p []byte) (int, error) {
+	n, err := v.reader.Read(p)
+	v.hash.Write(p[:n])
+	v.size += int64(n)
+	return n, err
+}
+
+func (v *verifier) checksum() string {
+	return hex.EncodeToString(v.hash.Sum(nil))
+}
+
+func (v *verifier) verify(name string, expectedSize int64, expectedChecksum string) error {
+	if v.size != expectedSize {
+		return errors.Errorf("Size of %s is %d bytes, while %d bytes were expected", name, v.size, expectedSize)
+	}
+	// The checksum of a whole archive is optional, only its size is verified without one
+	if expectedChecksum == "" {
+		return nil
+	}
+	return s3wrapper.VerifyChecksum(name, expectedChecksum, v.checksum())
+}
+
+// archive reads the chunks of an upload one after the other
+type archive struct {
+	ctx       context.Context
+	upload    *models.LogsUpload
+	api       s3wrapper.API
+	verifier  *verifier
+	next      int64
+	current   io.ReadCloser
+	verifyErr error
+	verified  bool
+}
+
+func (a *archive) Read(p []byte) (int, error) {
+	for {
+		if a.verifyErr != nil {
+			return 0, a.verifyErr
+		}
+		if a.current == nil {
+			if a.next == chunksCount(a.upload) {
+				a.verifyErr = a.verifier.verify(fmt.Sprintf("logs upload %s", a.upload.ID), a.upload.TotalSize, a.upload.Sha256)
+				if a.verifyErr != nil {
+					return 0, a.verifyErr
+				}
+				a.verified = true
+				return 0, io.EOF
+			}
+			reader, _, err := a.api.Download(a.ctx, chunkObjectName(a.upload, a.next))
+			if err != nil {
+				return 0, errors.Wrapf(err, "failed to download chunk %d of logs upload %s", a.next, a.upload.ID)
+			}
+			a.current = reader
+			a.verifier.reader = reader
+			a.next++
+		}
+		n, err := a.verifier.Read(p)
+		if err == io.EOF {
+			a.current.Close()
+			a.current = nil
+			err = nil
+		}
+		if n > 0 || err != nil {
+			return n, err
+		}
+	}
+}
+
+func (a *archive) Close() error {
+	if a.current != nil {
+		return a.current.Close()
+	}
+	return nil
+}
+
+func (a *archive) VerificationError() error {
+	return a.verifyErr
+}
+
+func (a *archive) Publish(objectName string) error {
+	if !a.verified {
+		return errors.Errorf("logs upload %s was not verified", a.upload.ID)
+	}
+	chunks := make([]string, chunksCount(a.upload))
+	for number := range chunks {
+		chunks[number] = chunkObjectName(a.upload, int64(number))
+	}
+	return a.api.ComposeObjects(a.ctx, chunks, objectName)
+}
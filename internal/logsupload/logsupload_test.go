@@ -0,0 +1,311 @@
+package logsupload
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestLogsUpload(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "Logs upload Suite")
+}
+
+func sha256Of(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func expectApiError(err error, statusCode int32) {
+	Expect(err).To(HaveOccurred())
+	apiErr, ok := err.(*common.ApiErrorResponse)
+	Expect(ok).To(BeTrue())
+	Expect(apiErr.StatusCode()).To(Equal(statusCode))
+}
+
+var _ = Describe("Manager", func() {
+	var (
+		ctx       = context.Background()
+		db        *gorm.DB
+		dbName    string
+		baseDir   string
+		storage   s3wrapper.API
+		manager   *Manager
+		clusterID strfmt.UUID
+		hostID    strfmt.UUID
+		content   = []byte("0123456789abcd")
+	)
+
+	BeforeEach(func() {
+		var err error
+		db, dbName = common.PrepareTestDB()
+		baseDir, err = ioutil.TempDir("", "logs-upload")
+		Expect(err).ToNot(HaveOccurred())
+		storage = s3wrapper.NewFSClient(baseDir, common.GetTestLog(), nil, nil,
+			metrics.NewMetricsManager(prometheus.NewRegistry(), nil), 100, "")
+		manager = NewManager(Config{MaxSize: 1024}, common.GetTestLog(), db, storage)
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		Expect(os.RemoveAll(baseDir)).To(Succeed())
+	})
+
+	create := func(checksum string) *models.LogsUpload {
+		upload, err := manager.Create(ctx, clusterID, &models.NewLogsUploadParams{
+			LogsType:  swag.String(string(models.LogsTypeHost)),
+			HostID:    hostID,
+			TotalSize: swag.Int64(int64(len(content))),
+			ChunkSize: swag.Int64(4),
+			Sha256:    checksum,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return upload
+	}
+
+	chunk := func(number int64) []byte {
+		end := (number + 1) * 4
+		if end > int64(len(content)) {
+			end = int64(len(content))
+		}
+		return content[number*4 : end]
+	}
+
+	uploadChunk := func(upload *models.LogsUpload, number int64) {
+		data := chunk(number)
+		Expect(manager.UploadChunk(ctx, upload, number, sha256Of(data), bytes.NewReader(data))).To(Succeed())
+	}
+
+	get := func(upload *models.LogsUpload) *models.LogsUpload {
+		upload, err := manager.Get(ctx, clusterID, upload.ID)
+		Expect(err).ToNot(HaveOccurred())
+		return upload
+	}
+
+	Context("Create", func() {
+		It("starts an upload", func() {
+			upload := create(sha256Of(content))
+			Expect(upload.ClusterID).To(Equal(clusterID))
+			Expect(upload.HostID).To(Equal(hostID))
+			Expect(upload.LogsType).To(Equal(models.LogsTypeHost))
+			Expect(upload.Sha256).To(Equal(sha256Of(content)))
+			Expect(get(upload).ReceivedRanges).To(BeEmpty())
+		})
+
+		It("requires a host for host logs", func() {
+			_, err := manager.Create(ctx, clusterID, &models.NewLogsUploadParams{
+				LogsType:  swag.String(string(models.LogsTypeHost)),
+				TotalSize: swag.Int64(10),
+				ChunkSize: swag.Int64(4),
+			})
+			expectApiError(err, http.StatusBadRequest)
+		})
+
+		It("ignores the host of controller logs", func() {
+			upload, err := manager.Create(ctx, clusterID, &models.NewLogsUploadParams{
+				LogsType:  swag.String(string(models.LogsTypeController)),
+				HostID:    hostID,
+				TotalSize: swag.Int64(10),
+				ChunkSize: swag.Int64(4),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(upload.HostID).To(BeEmpty())
+		})
+
+		It("fails for an archive of more chunks than the storage composes", func() {
+			manager = NewManager(Config{MaxSize: 2 * s3wrapper.MaxComposedObjects}, common.GetTestLog(), db, storage)
+			_, err := manager.Create(ctx, clusterID, &models.NewLogsUploadParams{
+				LogsType:  swag.String(string(models.LogsTypeController)),
+				TotalSize: swag.Int64(s3wrapper.MaxComposedObjects + 1),
+				ChunkSize: swag.Int64(1),
+			})
+			expectApiError(err, http.StatusBadRequest)
+		})
+
+		It("fails for an archive that exceeds the maximal size", func() {
+			_, err := manager.Create(ctx, clusterID, &models.NewLogsUploadParams{
+				LogsType:  swag.String(string(models.LogsTypeController)),
+				TotalSize: swag.Int64(1025),
+				ChunkSize: swag.Int64(4),
+			})
+			expectApiError(err, http.StatusBadRequest)
+		})
+	})
+
+	Context("Get", func() {
+		It("fails for an upload of another cluster", func() {
+			upload := create("")
+			_, err := manager.Get(ctx, strfmt.UUID(uuid.New().String()), upload.ID)
+			expectApiError(err, http.StatusNotFound)
+		})
+
+		It("returns the received ranges", func() {
+			upload := create("")
+			uploadChunk(upload, 3)
+			uploadChunk(upload, 0)
+			uploadChunk(upload, 1)
+			Expect(get(upload).ReceivedRanges).To(Equal([]*models.LogsUploadRange{{Start: 0, End: 7}, {Start: 12, End: 13}}))
+			uploadChunk(upload, 2)
+			Expect(get(upload).ReceivedRanges).To(Equal([]*models.LogsUploadRange{{Start: 0, End: 13}}))
+		})
+	})
+
+	Context("UploadChunk", func() {
+		It("replaces a chunk that was already received", func() {
+			upload := create("")
+			uploadChunk(upload, 1)
+			uploadChunk(upload, 1)
+			Expect(get(upload).ReceivedRanges).To(Equal([]*models.LogsUploadRange{{Start: 4, End: 7}}))
+		})
+
+		It("fails for a chunk that is out of range", func() {
+			upload := create("")
+			err := manager.UploadChunk(ctx, upload, 4, sha256Of(nil), bytes.NewReader(nil))
+			expectApiError(err, http.StatusBadRequest)
+		})
+
+		It("fails for a chunk of a wrong size", func() {
+			upload := create("")
+			data := []byte("01234")
+			err := manager.UploadChunk(ctx, upload, 0, sha256Of(data), bytes.NewReader(data))
+			expectApiError(err, http.StatusBadRequest)
+			Expect(get(upload).ReceivedRanges).To(BeEmpty())
+		})
+
+		It("fails for a chunk that does not match its checksum, and keeps the chunk it would have replaced", func() {
+			upload := create("")
+			uploadChunk(upload, 0)
+			err := manager.UploadChunk(ctx, upload, 0, sha256Of([]byte("abcd")), bytes.NewReader([]byte("0000")))
+			expectApiError(err, http.StatusBadRequest)
+			Expect(get(upload).ReceivedRanges).To(Equal([]*models.LogsUploadRange{{Start: 0, End: 3}}))
+			reader, _, err := storage.Download(ctx, chunkObjectName(upload, 0))
+			Expect(err).ToNot(HaveOccurred())
+			defer reader.Close()
+			data, err := ioutil.ReadAll(reader)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal(chunk(0)))
+		})
+
+		It("deletes the temporary objects of the chunks", func() {
+			upload := create("")
+			uploadChunk(upload, 0)
+			err := manager.UploadChunk(ctx, upload, 1, sha256Of([]byte("abcd")), bytes.NewReader([]byte("0000")))
+			expectApiError(err, http.StatusBadRequest)
+			objects, err := storage.ListObjectsByPrefix(ctx, uploadObjectPrefix(upload))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(objects).To(ConsistOf(chunkObjectName(upload, 0)))
+		})
+	})
+
+	Context("Open", func() {
+		It("assembles the chunks", func() {
+			upload := create(sha256Of(content))
+			for number := int64(3); number >= 0; number-- {
+				uploadChunk(upload, number)
+			}
+			archive, err := manager.Open(ctx, upload)
+			Expect(err).ToNot(HaveOccurred())
+			defer archive.Close()
+			data, err := ioutil.ReadAll(archive)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal(content))
+			Expect(archive.VerificationError()).ToNot(HaveOccurred())
+
+			Expect(archive.Publish("logs.tar.gz")).To(Succeed())
+			reader, _, err := storage.Download(ctx, "logs.tar.gz")
+			Expect(err).ToNot(HaveOccurred())
+			defer reader.Close()
+			published, err := ioutil.ReadAll(reader)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(published).To(Equal(content))
+		})
+
+		It("does not publish an archive that was not read to its end", func() {
+			upload := create(sha256Of(content))
+			for number := int64(0); number < 4; number++ {
+				uploadChunk(upload, number)
+			}
+			archive, err := manager.Open(ctx, upload)
+			Expect(err).ToNot(HaveOccurred())
+			defer archive.Close()
+			Expect(archive.Publish("logs.tar.gz")).ToNot(Succeed())
+			exists, err := storage.DoesObjectExist(ctx, "logs.tar.gz")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		It("fails while chunks are missing", func() {
+			upload := create("")
+			uploadChunk(upload, 0)
+			uploadChunk(upload, 1)
+			_, err := manager.Open(ctx, upload)
+			expectApiError(err, http.StatusConflict)
+		})
+
+		It("fails to read an archive that does not match its checksum", func() {
+			upload := create(sha256Of([]byte("another archive")))
+			for number := int64(0); number < 4; number++ {
+				uploadChunk(upload, number)
+			}
+			archive, err := manager.Open(ctx, upload)
+			Expect(err).ToNot(HaveOccurred())
+			defer archive.Close()
+			_, err = ioutil.ReadAll(archive)
+			Expect(err).To(HaveOccurred())
+			Expect(archive.VerificationError()).To(Equal(err))
+			Expect(archive.Publish("logs.tar.gz")).ToNot(Succeed())
+		})
+	})
+
+	Context("Delete", func() {
+		It("deletes an upload with its chunks", func() {
+			upload := create("")
+			uploadChunk(upload, 0)
+			uploadChunk(upload, 2)
+			Expect(manager.Delete(ctx, upload)).To(Succeed())
+			_, err := manager.Get(ctx, clusterID, upload.ID)
+			expectApiError(err, http.StatusNotFound)
+			objects, err := storage.ListObjectsByPrefix(ctx, uploadObjectPrefix(upload))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(objects).To(BeEmpty())
+			var count int
+			Expect(db.Model(&common.LogsUploadChunk{}).Where("upload_id = ?", upload.ID).Count(&count).Error).ToNot(HaveOccurred())
+			Expect(count).To(BeZero())
+		})
+
+		It("deletes abandoned uploads", func() {
+			abandoned := create("")
+			uploadChunk(abandoned, 0)
+			Expect(db.Model(abandoned).Update("updated_at", strfmt.DateTime(time.Now().Add(-2*time.Hour))).Error).ToNot(HaveOccurred())
+			active := create("")
+			uploadChunk(active, 0)
+
+			Expect(manager.DeleteAbandoned(ctx, time.Now().Add(-time.Hour))).To(Succeed())
+			_, err := manager.Get(ctx, clusterID, abandoned.ID)
+			expectApiError(err, http.StatusNotFound)
+			Expect(get(active).ReceivedRanges).To(HaveLen(1))
+		})
+	})
+})
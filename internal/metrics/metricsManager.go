@@ -41,6 +41,7 @@ const (
 	counterFilesystemUsagePercentage              = "assisted_installer_filesystem_usage_percentage"
 	counterMonitoredHosts                         = "assisted_installer_monitored_hosts"
 	counterMonitoredClusters                      = "assisted_installer_monitored_clusters"
+	counterDiscoveryImageCacheLookups             = "assisted_installer_discovery_image_cache_lookups"
 )
 
 const (
@@ -65,6 +66,7 @@ const (
 	counterDescriptionFilesystemUsagePercentage              = "The percentage of the filesystem usage by the service"
 	counterDescriptionMonitoredHosts                         = "Number of hosts monitored by host monitor"
 	counterDescriptionMonitoredClusters                      = "Number of clusters monitored by cluster monitor"
+	counterDescriptionDiscoveryImageCacheLookups             = "Number of lookups of generated discovery images in the image cache, by image type and result (hit or miss)"
 )
 
 const (
@@ -88,6 +90,7 @@ const (
 	hostValidationTypeLabel    = "hostValidationType"
 	clusterValidationTypeLabel = "clusterValidationType"
 	imageLabel                 = "imageName"
+	imageTypeLabel             = "imageType"
 	hosts                      = "hosts"
 	clusters                   = "clusters"
 )
@@ -108,6 +111,7 @@ type API interface {
 	FileSystemUsage(usageInPercentage float64)
 	MonitoredHostsCount(monitoredHosts int64)
 	MonitoredClusterCount(monitoredClusters int64)
+	DiscoveryImageCacheLookup(imageType models.ImageType, hit bool)
 }
 
 type MetricsManager struct {
@@ -135,6 +139,7 @@ type MetricsManager struct {
 	serviceLogicFilesystemUsagePercentage              *prometheus.GaugeVec
 	serviceLogicMonitoredHosts                         *prometheus.GaugeVec
 	serviceLogicMonitoredClusters                      *prometheus.GaugeVec
+	serviceLogicDiscoveryImageCacheLookups             *prometheus.CounterVec
 }
 
 var _ API = &MetricsManager{}
@@ -310,6 +315,14 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler events.Hand
 			Name:      counterMonitoredClusters,
 			Help:      counterDescriptionMonitoredClusters,
 		}, []string{hosts}),
+
+		serviceLogicDiscoveryImageCacheLookups: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      counterDiscoveryImageCacheLookups,
+				Help:      counterDescriptionDiscoveryImageCacheLookups,
+			}, []string{imageTypeLabel, resultLabel}),
 	}
 
 	registry.MustRegister(
@@ -332,6 +345,7 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler events.Hand
 		m.serviceLogicFilesystemUsagePercentage,
 		m.serviceLogicMonitoredHosts,
 		m.serviceLogicMonitoredClusters,
+		m.serviceLogicDiscoveryImageCacheLookups,
 	)
 	return m
 }
@@ -513,6 +527,14 @@ func (m *MetricsManager) MonitoredClusterCount(monitoredClusters int64) {
 	m.serviceLogicMonitoredClusters.WithLabelValues(clusters).Set(float64(monitoredClusters))
 }
 
+func (m *MetricsManager) DiscoveryImageCacheLookup(imageType models.ImageType, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.serviceLogicDiscoveryImageCacheLookups.WithLabelValues(string(imageType), result).Inc()
+}
+
 func bytesToGib(bytes int64) int64 {
 	return bytes / int64(units.GiB)
 }
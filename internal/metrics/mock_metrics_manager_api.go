@@ -215,3 +215,15 @@ func (mr *MockAPIMockRecorder) MonitoredClusterCount(monitoredClusters interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonitoredClusterCount", reflect.TypeOf((*MockAPI)(nil).MonitoredClusterCount), monitoredClusters)
 }
+
+// DiscoveryImageCacheLookup mocks base method
+func (m *MockAPI) DiscoveryImageCacheLookup(imageType models.ImageType, hit bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DiscoveryImageCacheLookup", imageType, hit)
+}
+
+// DiscoveryImageCacheLookup indicates an expected call of DiscoveryImageCacheLookup
+func (mr *MockAPIMockRecorder) DiscoveryImageCacheLookup(imageType, hit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoveryImageCacheLookup", reflect.TypeOf((*MockAPI)(nil).DiscoveryImageCacheLookup), imageType, hit)
+}
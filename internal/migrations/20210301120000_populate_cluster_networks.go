@@ -0,0 +1,45 @@
+package migrations
+
+import (
+	"github.com/jinzhu/gorm"
+	gormigrate "gopkg.in/gormigrate.v1"
+)
+
+// populateClusterNetworks creates the cluster, service and machine network records of the clusters that were created
+// before the network lists were introduced, from their single network CIDR columns
+func populateClusterNetworks() *gormigrate.Migration {
+	migrate := func(tx *gorm.DB) error {
+		queries := []string{
+			`INSERT INTO cluster_networks (cluster_id, cidr, host_prefix)
+				SELECT id, cluster_network_cidr, cluster_network_host_prefix FROM clusters WHERE cluster_network_cidr <> ''
+				ON CONFLICT DO NOTHING`,
+			`INSERT INTO service_networks (cluster_id, cidr)
+				SELECT id, service_network_cidr FROM clusters WHERE service_network_cidr <> ''
+				ON CONFLICT DO NOTHING`,
+			`INSERT INTO machine_networks (cluster_id, cidr)
+				SELECT id, machine_network_cidr FROM clusters WHERE machine_network_cidr <> ''
+				ON CONFLICT DO NOTHING`,
+		}
+		for _, query := range queries {
+			if err := tx.Exec(query).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	rollback := func(tx *gorm.DB) error {
+		for _, table := range []string{"cluster_networks", "service_networks", "machine_networks"} {
+			if err := tx.Exec("DELETE FROM " + table).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return &gormigrate.Migration{
+		ID:       "20210301120000",
+		Migrate:  migrate,
+		Rollback: rollback,
+	}
+}
@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"gopkg.in/gormigrate.v1"
+)
+
+var _ = Describe("PopulateClusterNetworks", func() {
+	var (
+		db        *gorm.DB
+		dbName    string
+		gm        *gormigrate.Gormigrate
+		clusterID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		cluster := common.Cluster{Cluster: models.Cluster{
+			ID:                       &clusterID,
+			ClusterNetworkCidr:       "10.128.0.0/14",
+			ClusterNetworkHostPrefix: 23,
+			ServiceNetworkCidr:       "172.30.0.0/16",
+			MachineNetworkCidr:       "192.168.126.0/24",
+		}}
+		Expect(db.Create(&cluster).Error).NotTo(HaveOccurred())
+
+		gm = gormigrate.New(db, gormigrate.DefaultOptions, all())
+		Expect(gm.MigrateTo("20210301120000")).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	expectNetworks := func(clusterNetworks, serviceNetworks, machineNetworks int) {
+		c, err := common.GetClusterFromDB(db, clusterID, common.UseEagerLoading)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.ClusterNetworks).To(HaveLen(clusterNetworks))
+		Expect(c.ServiceNetworks).To(HaveLen(serviceNetworks))
+		Expect(c.MachineNetworks).To(HaveLen(machineNetworks))
+	}
+
+	It("Migrates down and up", func() {
+		c, err := common.GetClusterFromDB(db, clusterID, common.UseEagerLoading)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.ClusterNetworks).To(HaveLen(1))
+		Expect(c.ClusterNetworks[0].Cidr).To(Equal("10.128.0.0/14"))
+		Expect(c.ClusterNetworks[0].HostPrefix).To(Equal(int64(23)))
+		Expect(c.ServiceNetworks).To(HaveLen(1))
+		Expect(c.ServiceNetworks[0].Cidr).To(Equal("172.30.0.0/16"))
+		Expect(c.MachineNetworks).To(HaveLen(1))
+		Expect(c.MachineNetworks[0].Cidr).To(Equal("192.168.126.0/24"))
+
+		Expect(gm.RollbackMigration(populateClusterNetworks())).ToNot(HaveOccurred())
+		expectNetworks(0, 0, 0)
+
+		Expect(gm.MigrateTo("20210301120000")).ToNot(HaveOccurred())
+		expectNetworks(1, 1, 1)
+	})
+})
@@ -3,6 +3,7 @@ package network
 import (
 	"net"
 
+	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 )
 
@@ -125,3 +126,102 @@ func VerifyNetworkHostPrefix(prefix int64) error {
 	}
 	return nil
 }
+
+// verifyNetworkFamilies verifies that a list of networks has a single network, or an IPv4 network followed by an IPv6 network
+func verifyNetworkFamilies(cidrs []string, networkName string) error {
+	switch len(cidrs) {
+	case 0:
+		return errors.Errorf("At least one %s network is required", networkName)
+	case 1:
+		return nil
+	case 2:
+		if IsIPV4CIDR(cidrs[0]) && IsIPv6CIDR(cidrs[1]) {
+			return nil
+		}
+		return errors.Errorf("Dual-stack %s networks must be an IPv4 network followed by an IPv6 network", networkName)
+	default:
+		return errors.Errorf("At most two %s networks, one per IP address family, are supported", networkName)
+	}
+}
+
+func VerifyClusterNetworks(networks []*models.ClusterNetwork, numberOfHosts int) error {
+	cidrs := make([]string, 0, len(networks))
+	for _, n := range networks {
+		if err := VerifyClusterOrServiceCIDR(n.Cidr); err != nil {
+			return errors.Wrapf(err, "Cluster network CIDR %s", n.Cidr)
+		}
+		if err := VerifyNetworkHostPrefix(n.HostPrefix); err != nil {
+			return err
+		}
+		if err := VerifyClusterCidrSize(int(n.HostPrefix), n.Cidr, numberOfHosts); err != nil {
+			return err
+		}
+		cidrs = append(cidrs, n.Cidr)
+	}
+	return verifyNetworkFamilies(cidrs, "cluster")
+}
+
+func VerifyServiceNetworks(networks []*models.ServiceNetwork) error {
+	cidrs := make([]string, 0, len(networks))
+	for _, n := range networks {
+		if err := VerifyClusterOrServiceCIDR(n.Cidr); err != nil {
+			return errors.Wrapf(err, "Service network CIDR %s", n.Cidr)
+		}
+		cidrs = append(cidrs, n.Cidr)
+	}
+	return verifyNetworkFamilies(cidrs, "service")
+}
+
+func VerifyMachineNetworks(networks []*models.MachineNetwork) error {
+	cidrs := make([]string, 0, len(networks))
+	for _, n := range networks {
+		if err := VerifyMachineCIDR(n.Cidr); err != nil {
+			return errors.Wrapf(err, "Machine network CIDR %s", n.Cidr)
+		}
+		cidrs = append(cidrs, n.Cidr)
+	}
+	return verifyNetworkFamilies(cidrs, "machine")
+}
+
+// VerifyUserManagedMachineNetworks verifies the machine networks of a cluster with user managed networking. The hosts
+// may be spread over several routed networks, so any number of networks is allowed as long as they do not overlap.
+func VerifyUserManagedMachineNetworks(networks []*models.MachineNetwork) error {
+	if len(networks) == 0 {
+		return errors.New("At least one machine network is required")
+	}
+	for i, n := range networks {
+		if err := VerifyMachineCIDR(n.Cidr); err != nil {
+			return errors.Wrapf(err, "Machine network CIDR %s", n.Cidr)
+		}
+		for _, other := range networks[:i] {
+			if err := VerifyCIDRsNotOverlap(n.Cidr, other.Cidr); err != nil {
+				return errors.Wrap(err, "Machine networks")
+			}
+		}
+	}
+	return nil
+}
+
+// VerifyDualStackNetworks verifies that when one of the network lists is dual-stack, the cluster and service networks
+// are both dual-stack. Machine networks may still be single-stack, as the IPv6 one may be calculated later.
+func VerifyDualStackNetworks(machineNetworkCidrs, clusterNetworkCidrs, serviceNetworkCidrs []string) error {
+	if !HasBothFamilies(machineNetworkCidrs) && !HasBothFamilies(clusterNetworkCidrs) && !HasBothFamilies(serviceNetworkCidrs) {
+		return nil
+	}
+	if len(clusterNetworkCidrs) != 2 || len(serviceNetworkCidrs) != 2 {
+		return errors.New("Dual-stack clusters require an IPv4 and an IPv6 network for both the cluster and the service networks")
+	}
+	return nil
+}
+
+// VerifyDualStackCIDRsNotOverlap verifies, for each IP address family, that the machine, cluster and service networks do not overlap
+func VerifyDualStackCIDRsNotOverlap(machineNetworkCidrs, clusterNetworkCidrs, serviceNetworkCidrs []string, userManagedNetworking bool) error {
+	for _, isIPv4 := range []bool{true, false} {
+		err := VerifyClusterCIDRsNotOverlap(GetCidrOfFamily(machineNetworkCidrs, isIPv4), GetCidrOfFamily(clusterNetworkCidrs, isIPv4),
+			GetCidrOfFamily(serviceNetworkCidrs, isIPv4), userManagedNetworking)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package network
 import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
 )
 
 var _ = Describe("CIDR validations", func() {
@@ -53,4 +54,78 @@ var _ = Describe("CIDR validations", func() {
 			Expect(VerifyClusterOrServiceCIDR("1.2.3.0/25")).ToNot(HaveOccurred())
 		})
 	})
+	Context("Verify dual-stack networks", func() {
+		It("single cluster network", func() {
+			Expect(VerifyClusterNetworks([]*models.ClusterNetwork{{Cidr: "10.128.0.0/14", HostPrefix: 23}}, 3)).ToNot(HaveOccurred())
+		})
+		It("dual-stack cluster networks", func() {
+			Expect(VerifyClusterNetworks([]*models.ClusterNetwork{
+				{Cidr: "10.128.0.0/14", HostPrefix: 23},
+				{Cidr: "fd01::/48", HostPrefix: 64},
+			}, 3)).ToNot(HaveOccurred())
+		})
+		It("IPv6 cluster network first", func() {
+			Expect(VerifyClusterNetworks([]*models.ClusterNetwork{
+				{Cidr: "fd01::/48", HostPrefix: 64},
+				{Cidr: "10.128.0.0/14", HostPrefix: 23},
+			}, 3)).To(HaveOccurred())
+		})
+		It("two cluster networks of the same family", func() {
+			Expect(VerifyClusterNetworks([]*models.ClusterNetwork{
+				{Cidr: "10.128.0.0/14", HostPrefix: 23},
+				{Cidr: "10.192.0.0/14", HostPrefix: 23},
+			}, 3)).To(HaveOccurred())
+		})
+		It("no service networks", func() {
+			Expect(VerifyServiceNetworks(nil)).To(HaveOccurred())
+		})
+		It("three machine networks", func() {
+			Expect(VerifyMachineNetworks([]*models.MachineNetwork{
+				{Cidr: "192.168.1.0/24"},
+				{Cidr: "fd2e:6f44:5dd8::/64"},
+				{Cidr: "192.168.2.0/24"},
+			})).To(HaveOccurred())
+		})
+		It("dual-stack machine networks with single-stack cluster networks", func() {
+			Expect(VerifyDualStackNetworks([]string{"192.168.1.0/24", "fd2e:6f44:5dd8::/64"},
+				[]string{"10.128.0.0/14"}, []string{"172.30.0.0/16", "fd02::/112"})).To(HaveOccurred())
+		})
+		It("dual-stack cluster and service networks", func() {
+			Expect(VerifyDualStackNetworks([]string{"192.168.1.0/24"},
+				[]string{"10.128.0.0/14", "fd01::/48"}, []string{"172.30.0.0/16", "fd02::/112"})).ToNot(HaveOccurred())
+		})
+		It("no overlap", func() {
+			Expect(VerifyDualStackCIDRsNotOverlap([]string{"192.168.1.0/24", "fd2e:6f44:5dd8::/64"},
+				[]string{"10.128.0.0/14", "fd01::/48"}, []string{"172.30.0.0/16", "fd02::/112"}, false)).ToNot(HaveOccurred())
+		})
+		It("IPv6 networks overlap", func() {
+			Expect(VerifyDualStackCIDRsNotOverlap([]string{"192.168.1.0/24", "fd01::/64"},
+				[]string{"10.128.0.0/14", "fd01::/48"}, []string{"172.30.0.0/16", "fd02::/112"}, false)).To(HaveOccurred())
+		})
+	})
+	Context("Verify user managed machine networks", func() {
+		It("several routed networks", func() {
+			Expect(VerifyUserManagedMachineNetworks([]*models.MachineNetwork{
+				{Cidr: "10.0.1.0/24"},
+				{Cidr: "10.0.2.0/24"},
+				{Cidr: "10.0.3.0/24"},
+			})).ToNot(HaveOccurred())
+		})
+		It("overlapping networks", func() {
+			Expect(VerifyUserManagedMachineNetworks([]*models.MachineNetwork{
+				{Cidr: "10.0.0.0/16"},
+				{Cidr: "10.0.2.0/24"},
+			})).To(HaveOccurred())
+		})
+		It("invalid network", func() {
+			Expect(VerifyUserManagedMachineNetworks([]*models.MachineNetwork{{Cidr: "10.0.1.0/30"}})).To(HaveOccurred())
+		})
+		It("no networks", func() {
+			Expect(VerifyUserManagedMachineNetworks(nil)).To(HaveOccurred())
+		})
+		It("several IPv4 networks are not dual-stack", func() {
+			Expect(VerifyDualStackNetworks([]string{"10.0.1.0/24", "10.0.2.0/24"},
+				[]string{"10.128.0.0/14"}, []string{"172.30.0.0/16"})).ToNot(HaveOccurred())
+		})
+	})
 })
@@ -270,20 +270,52 @@ func createMachineCidrConnectivityMap(cidr string, hosts []*models.Host, idToInd
 }
 
 /*
- * Crate majority for a cidr.  A majority group is a the largest group of hosts in a cluster that all of them have full mesh
- * to the other group members.
- * It is done by taking a sorted connectivity group list according to the group size, and from this group take the
- * largest one
+ * Create L3 connectivity map from host list.  Unlike the L2 map, the hosts are not required to share a network.  Two hosts
+ * are connected if there is a successful L3 connectivity check to an address in any of the given networks.
  */
-func CreateMajorityGroup(cidr string, hosts []*models.Host) ([]strfmt.UUID, error) {
-	idToIndex := make(map[strfmt.UUID]int)
-	for i, h := range hosts {
-		idToIndex[*h.ID] = i
+func createL3ConnectivityMap(cidrs []string, hosts []*models.Host, idToIndex map[strfmt.UUID]int) (connectivityMap, error) {
+	ret := make(connectivityMap)
+	parsedCidrs := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, parsedCidr, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		parsedCidrs = append(parsedCidrs, parsedCidr)
 	}
-	cMap, err := createMachineCidrConnectivityMap(cidr, hosts, idToIndex)
-	if err != nil {
-		return nil, err
+	inNetworks := func(ip net.IP) bool {
+		for _, parsedCidr := range parsedCidrs {
+			if parsedCidr.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+	for fromIndex, h := range hosts {
+		if h.Connectivity == "" {
+			continue
+		}
+		var connectivityReport models.ConnectivityReport
+		if err := json.Unmarshal([]byte(h.Connectivity), &connectivityReport); err != nil {
+			return nil, err
+		}
+		for _, r := range connectivityReport.RemoteHosts {
+			for _, l3 := range r.L3Connectivity {
+				ip := net.ParseIP(l3.RemoteIPAddress)
+				if ip != nil && inNetworks(ip) && l3.Successful {
+					toIndex, ok := idToIndex[r.HostID]
+					if ok {
+						ret.add(fromIndex, toIndex, true)
+					}
+					break
+				}
+			}
+		}
 	}
+	return ret, nil
+}
+
+func createMajorityGroup(cMap connectivityMap, hosts []*models.Host) []strfmt.UUID {
 	candidates := make([]groupCandidate, 0)
 	for hostIndex := range hosts {
 		candidate := createHostGroupCandidate(hostIndex, len(hosts), cMap)
@@ -293,7 +325,49 @@ func CreateMajorityGroup(cidr string, hosts []*models.Host) ([]strfmt.UUID, erro
 	}
 	groups := createConnectivityGroups(candidates)
 	if len(groups) > 0 {
-		return groups[0].toList(hosts), nil
+		return groups[0].toList(hosts)
+	}
+	return make([]strfmt.UUID, 0)
+}
+
+func hostIndexes(hosts []*models.Host) map[strfmt.UUID]int {
+	idToIndex := make(map[strfmt.UUID]int)
+	for i, h := range hosts {
+		idToIndex[*h.ID] = i
+	}
+	return idToIndex
+}
+
+/*
+ * Crate majority for a cidr.  A majority group is a the largest group of hosts in a cluster that all of them have full mesh
+ * to the other group members.
+ * It is done by taking a sorted connectivity group list according to the group size, and from this group take the
+ * largest one
+ */
+func CreateMajorityGroup(cidr string, hosts []*models.Host) ([]strfmt.UUID, error) {
+	cMap, err := createMachineCidrConnectivityMap(cidr, hosts, hostIndexes(hosts))
+	if err != nil {
+		return nil, err
+	}
+	return createMajorityGroup(cMap, hosts), nil
+}
+
+/*
+ * Create L3 majority group for a set of routed networks of the same IP address family.  It is the largest group of hosts
+ * that all of them have full mesh L3 connectivity to the other group members, over any of the networks.
+ */
+func CreateL3MajorityGroup(cidrs []string, hosts []*models.Host) ([]strfmt.UUID, error) {
+	cMap, err := createL3ConnectivityMap(cidrs, hosts, hostIndexes(hosts))
+	if err != nil {
+		return nil, err
+	}
+	return createMajorityGroup(cMap, hosts), nil
+}
+
+// L3MajorityGroupKey is the key of the L3 majority group of an IP address family in the cluster connectivity majority groups
+func L3MajorityGroupKey(isIPv4 bool) string {
+	if isIPv4 {
+		return "IPv4"
 	}
-	return make([]strfmt.UUID, 0), nil
+	return "IPv6"
 }
@@ -457,3 +457,66 @@ func GenerateConnectivityGroupTests(ipV4 bool, net1CIDR, net2CIDR string) {
 		})
 	})
 }
+
+var _ = Describe("L3 connectivity groups", func() {
+	var (
+		nodes     []*node
+		addresses []string
+	)
+
+	BeforeEach(func() {
+		// The first two hosts are on one network, the others are on a routed network
+		nodes = generateIPv4Nodes(4, "10.0.1.0/24", "10.0.2.0/24")
+		addresses = []string{nodes[0].addressNet1, nodes[1].addressNet1, nodes[2].addressNet2, nodes[3].addressNet2}
+	})
+
+	createHosts := func(isConnected func(from, to int) bool) []*models.Host {
+		hosts := make([]*models.Host, 0, len(nodes))
+		for from, n := range nodes {
+			report := models.ConnectivityReport{}
+			for to, remote := range nodes {
+				if from == to {
+					continue
+				}
+				report.RemoteHosts = append(report.RemoteHosts, &models.ConnectivityRemoteHost{
+					HostID: *remote.id,
+					L3Connectivity: []*models.L3Connectivity{
+						{RemoteIPAddress: addresses[to], Successful: isConnected(from, to)},
+					},
+				})
+			}
+			b, err := json.Marshal(&report)
+			Expect(err).ToNot(HaveOccurred())
+			hosts = append(hosts, &models.Host{ID: n.id, Connectivity: string(b)})
+		}
+		return hosts
+	}
+
+	It("full mesh across networks", func() {
+		hosts := createHosts(func(from, to int) bool { return true })
+		ret, err := CreateL3MajorityGroup([]string{"10.0.1.0/24", "10.0.2.0/24"}, hosts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ret).To(ConsistOf(*nodes[0].id, *nodes[1].id, *nodes[2].id, *nodes[3].id))
+	})
+
+	It("one direction missing", func() {
+		hosts := createHosts(func(from, to int) bool { return from != 0 || to != 3 })
+		ret, err := CreateL3MajorityGroup([]string{"10.0.1.0/24", "10.0.2.0/24"}, hosts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ret).To(HaveLen(3))
+		Expect(ret).To(ContainElement(*nodes[1].id))
+		Expect(ret).To(ContainElement(*nodes[2].id))
+	})
+
+	It("addresses out of the machine networks are ignored", func() {
+		hosts := createHosts(func(from, to int) bool { return true })
+		ret, err := CreateL3MajorityGroup([]string{"10.0.1.0/24"}, hosts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ret).To(BeEmpty())
+	})
+
+	It("invalid network", func() {
+		_, err := CreateL3MajorityGroup([]string{"10.0.1.0"}, createHosts(func(from, to int) bool { return true }))
+		Expect(err).To(HaveOccurred())
+	})
+})
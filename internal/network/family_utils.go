@@ -3,6 +3,8 @@ package network
 import (
 	"net"
 	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
 )
 
 func IsIPv4Addr(ip string) bool {
@@ -18,3 +20,97 @@ func IsIPv6CIDR(cidr string) bool {
 	_, _, e := net.ParseCIDR(cidr)
 	return strings.Contains(cidr, ":") && e == nil
 }
+
+// GetCidrOfFamily returns the first CIDR of the requested IP address family, or an empty string if there is none
+func GetCidrOfFamily(cidrs []string, isIPv4 bool) string {
+	for _, cidr := range cidrs {
+		if isIPv4 && IsIPV4CIDR(cidr) || !isIPv4 && IsIPv6CIDR(cidr) {
+			return cidr
+		}
+	}
+	return ""
+}
+
+// HasBothFamilies returns true if the list contains CIDRs of both IP address families
+func HasBothFamilies(cidrs []string) bool {
+	return GetCidrOfFamily(cidrs, true) != "" && GetCidrOfFamily(cidrs, false) != ""
+}
+
+// GetCidrsOfFamily returns all the CIDRs of the requested IP address family
+func GetCidrsOfFamily(cidrs []string, isIPv4 bool) []string {
+	ret := make([]string, 0)
+	for _, cidr := range cidrs {
+		if isIPv4 && IsIPV4CIDR(cidr) || !isIPv4 && IsIPv6CIDR(cidr) {
+			ret = append(ret, cidr)
+		}
+	}
+	return ret
+}
+
+// IsDualStack returns true if the cluster has networks of both IP address families
+func IsDualStack(cluster *common.Cluster) bool {
+	return HasBothFamilies(GetClusterNetworkCidrs(cluster)) || HasBothFamilies(GetServiceNetworkCidrs(cluster)) ||
+		HasBothFamilies(GetMachineNetworkCidrs(cluster))
+}
+
+// GetClusterNetworkCidrs returns the CIDRs of the cluster networks. Clusters that were created before the network
+// lists were introduced only have the single cluster network CIDR.
+func GetClusterNetworkCidrs(cluster *common.Cluster) []string {
+	if len(cluster.ClusterNetworks) == 0 {
+		return nonEmpty(cluster.ClusterNetworkCidr)
+	}
+	ret := make([]string, 0, len(cluster.ClusterNetworks))
+	for _, n := range cluster.ClusterNetworks {
+		ret = append(ret, n.Cidr)
+	}
+	return ret
+}
+
+// GetServiceNetworkCidrs returns the CIDRs of the service networks, falling back to the single service network CIDR
+func GetServiceNetworkCidrs(cluster *common.Cluster) []string {
+	if len(cluster.ServiceNetworks) == 0 {
+		return nonEmpty(cluster.ServiceNetworkCidr)
+	}
+	ret := make([]string, 0, len(cluster.ServiceNetworks))
+	for _, n := range cluster.ServiceNetworks {
+		ret = append(ret, n.Cidr)
+	}
+	return ret
+}
+
+// GetMachineNetworkCidrs returns the CIDRs of the machine networks, falling back to the single machine network CIDR
+func GetMachineNetworkCidrs(cluster *common.Cluster) []string {
+	if len(cluster.MachineNetworks) == 0 {
+		return nonEmpty(cluster.MachineNetworkCidr)
+	}
+	ret := make([]string, 0, len(cluster.MachineNetworks))
+	for _, n := range cluster.MachineNetworks {
+		ret = append(ret, n.Cidr)
+	}
+	return ret
+}
+
+// GetVipMachineNetworkCidr returns the machine network a VIP is verified against: the first one of the IP address
+// family of the VIP, falling back to the first machine network so that the verification reports the mismatch
+func GetVipMachineNetworkCidr(machineNetworkCidrs []string, vip string) string {
+	if cidr := GetCidrOfFamily(machineNetworkCidrs, IsIPv4Addr(vip)); cidr != "" {
+		return cidr
+	}
+	if len(machineNetworkCidrs) > 0 {
+		return machineNetworkCidrs[0]
+	}
+	return ""
+}
+
+// GetClusterVipMachineNetworkCidr returns the machine network of the cluster a VIP is verified against.  The primary
+// machine network comes first, so single-stack clusters keep verifying their VIPs against it.
+func GetClusterVipMachineNetworkCidr(cluster *common.Cluster, vip string) string {
+	return GetVipMachineNetworkCidr(append(nonEmpty(cluster.MachineNetworkCidr), GetMachineNetworkCidrs(cluster)...), vip)
+}
+
+func nonEmpty(cidr string) []string {
+	if cidr == "" {
+		return nil
+	}
+	return []string{cidr}
+}
@@ -73,6 +73,59 @@ func CalculateMachineNetworkCIDR(apiVip string, ingressVip string, hosts []*mode
 	return "", errors.Errorf("No suitable matching CIDR found for VIP %s", ip)
 }
 
+/*
+ * Calculate the machine network CIDR of the other IP address family for dual-stack clusters.  The candidates are the
+ * networks of the other family that are configured on the interfaces that have an address in the primary machine
+ * network.  The network that is found on the largest number of hosts is returned.
+ */
+func CalculateSecondaryMachineNetworkCIDR(primaryCidr string, hosts []*models.Host) (string, error) {
+	_, primaryNet, err := net.ParseCIDR(primaryCidr)
+	if err != nil {
+		return "", err
+	}
+	isPrimaryIPv4 := IsIPV4CIDR(primaryCidr)
+	counts := make(map[string]int)
+	for _, h := range hosts {
+		if swag.StringValue(h.Status) == models.HostStatusDisabled {
+			continue
+		}
+		var inventory models.Inventory
+		if err = json.Unmarshal([]byte(h.Inventory), &inventory); err != nil {
+			continue
+		}
+		hostCidrs := make(map[string]bool)
+		for _, intf := range inventory.Interfaces {
+			if found, _ := findMatchingIP(primaryNet, intf, isPrimaryIPv4); !found {
+				continue
+			}
+			addresses := intf.IPV6Addresses
+			if !isPrimaryIPv4 {
+				addresses = intf.IPV4Addresses
+			}
+			for _, addr := range addresses {
+				ip, ipnet, err := net.ParseCIDR(addr)
+				if err != nil || ip.IsLinkLocalUnicast() {
+					continue
+				}
+				hostCidrs[ipnet.String()] = true
+			}
+		}
+		for cidr := range hostCidrs {
+			counts[cidr]++
+		}
+	}
+	ret := ""
+	for cidr, count := range counts {
+		if count > counts[ret] || count == counts[ret] && cidr < ret {
+			ret = cidr
+		}
+	}
+	if ret == "" {
+		return "", errors.Errorf("No secondary machine network found for machine network %s", primaryCidr)
+	}
+	return ret, nil
+}
+
 func ipInCidr(ipStr, cidrStr string) bool {
 	ip := net.ParseIP(ipStr)
 	if ip == nil {
@@ -105,10 +158,11 @@ func VerifyDifferentVipAddresses(apiVip string, ingressVip string) error {
 	return nil
 }
 
-func VerifyVips(hosts []*models.Host, machineNetworkCidr string, apiVip string, ingressVip string, mustExist bool, log logrus.FieldLogger) error {
-	err := VerifyVip(hosts, machineNetworkCidr, apiVip, "api-vip", mustExist, log)
+// VerifyVips verifies that each VIP belongs to the machine network of its own IP address family and is not in use
+func VerifyVips(hosts []*models.Host, machineNetworkCidrs []string, apiVip string, ingressVip string, mustExist bool, log logrus.FieldLogger) error {
+	err := VerifyVip(hosts, GetVipMachineNetworkCidr(machineNetworkCidrs, apiVip), apiVip, "api-vip", mustExist, log)
 	if err == nil {
-		err = VerifyVip(hosts, machineNetworkCidr, ingressVip, "ingress-vip", mustExist, log)
+		err = VerifyVip(hosts, GetVipMachineNetworkCidr(machineNetworkCidrs, ingressVip), ingressVip, "ingress-vip", mustExist, log)
 	}
 	if err == nil {
 		err = VerifyDifferentVipAddresses(apiVip, ingressVip)
@@ -200,23 +254,54 @@ func belongsToNetwork(log logrus.FieldLogger, h *models.Host, machineIpnet *net.
 	return false
 }
 
+// getHostValidationMachineNetworks returns the machine networks the hosts are validated against. With user managed
+// networking the hosts may sit on any of the machine networks, otherwise they must belong to the primary one.
+func getHostValidationMachineNetworks(cluster *common.Cluster) []string {
+	if swag.BoolValue(cluster.UserManagedNetworking) && len(cluster.MachineNetworks) > 0 {
+		return GetMachineNetworkCidrs(cluster)
+	}
+	return nonEmpty(cluster.MachineNetworkCidr)
+}
+
 func GetMachineCIDRHosts(log logrus.FieldLogger, cluster *common.Cluster) ([]*models.Host, error) {
 	if cluster.MachineNetworkCidr == "" {
 		return nil, errors.New("Machine network CIDR was not set in cluster")
 	}
-	_, machineIpnet, err := net.ParseCIDR(cluster.MachineNetworkCidr)
-	if err != nil {
-		return nil, err
+	machineIpnets := make([]*net.IPNet, 0)
+	for _, cidr := range getHostValidationMachineNetworks(cluster) {
+		_, machineIpnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		machineIpnets = append(machineIpnets, machineIpnet)
 	}
 	ret := make([]*models.Host, 0)
 	for _, h := range cluster.Hosts {
-		if belongsToNetwork(log, h, machineIpnet) {
-			ret = append(ret, h)
+		for _, machineIpnet := range machineIpnets {
+			if belongsToNetwork(log, h, machineIpnet) {
+				ret = append(ret, h)
+				break
+			}
 		}
 	}
 	return ret, nil
 }
 
+// GetHostMachineNetworks returns the machine networks of the cluster that the host has an address in
+func GetHostMachineNetworks(log logrus.FieldLogger, cluster *common.Cluster, host *models.Host) []string {
+	ret := make([]string, 0)
+	for _, cidr := range getHostValidationMachineNetworks(cluster) {
+		_, machineIpnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		if belongsToNetwork(log, host, machineIpnet) {
+			ret = append(ret, cidr)
+		}
+	}
+	return ret
+}
+
 // GetMachineCidrForUserManagedNetwork used to get machine cidr in case of none platform and sno
 func GetMachineCidrForUserManagedNetwork(cluster *common.Cluster, log logrus.FieldLogger) string {
 	if cluster.MachineNetworkCidr != "" {
@@ -242,6 +327,31 @@ func GetMachineCidrForUserManagedNetwork(cluster *common.Cluster, log logrus.Fie
 	return ""
 }
 
+// GetMachineNetworksForUserManagedNetwork is the multi-network variant of GetMachineCidrForUserManagedNetwork. The
+// machine networks set by the user are all returned, and dual-stack clusters get a machine network of each IP address family.
+func GetMachineNetworksForUserManagedNetwork(cluster *common.Cluster, log logrus.FieldLogger) []string {
+	if len(cluster.MachineNetworks) > 1 {
+		return GetMachineNetworkCidrs(cluster)
+	}
+	primary := GetMachineCidrForUserManagedNetwork(cluster, log)
+	if primary == "" {
+		return nil
+	}
+	if !IsDualStack(cluster) {
+		return []string{primary}
+	}
+	bootstrap := common.GetBootstrapHost(cluster)
+	if bootstrap == nil {
+		return []string{primary}
+	}
+	secondary, err := CalculateSecondaryMachineNetworkCIDR(primary, []*models.Host{bootstrap})
+	if err != nil {
+		log.WithError(err).Warnf("Failed to find secondary machine network for cluster %s", cluster.ID)
+		return []string{primary}
+	}
+	return []string{primary, secondary}
+}
+
 func GetIpForSingleNodeInstallation(cluster *common.Cluster, log logrus.FieldLogger) (string, error) {
 	bootstrap := common.GetBootstrapHost(cluster)
 	if bootstrap == nil {
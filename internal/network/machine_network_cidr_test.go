@@ -171,9 +171,9 @@ var _ = Describe("inventory", func() {
 				},
 			}
 			cluster.IngressVip = cluster.APIVip
-			err := VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, false, log)
+			err := VerifyVips(cluster.Hosts, []string{cluster.MachineNetworkCidr}, cluster.APIVip, cluster.IngressVip, false, log)
 			Expect(err).To(HaveOccurred())
-			err = VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, true, log)
+			err = VerifyVips(cluster.Hosts, []string{cluster.MachineNetworkCidr}, cluster.APIVip, cluster.IngressVip, true, log)
 			Expect(err).To(HaveOccurred())
 		})
 		It("Different vips", func() {
@@ -185,9 +185,9 @@ var _ = Describe("inventory", func() {
 					FreeAddresses: "[{\"network\":\"1.2.4.0/23\",\"free_addresses\":[\"1.2.5.6\",\"1.2.5.8\"]}]",
 				},
 			}
-			err := VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, false, log)
+			err := VerifyVips(cluster.Hosts, []string{cluster.MachineNetworkCidr}, cluster.APIVip, cluster.IngressVip, false, log)
 			Expect(err).ToNot(HaveOccurred())
-			err = VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, true, log)
+			err = VerifyVips(cluster.Hosts, []string{cluster.MachineNetworkCidr}, cluster.APIVip, cluster.IngressVip, true, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 		It("Not free", func() {
@@ -199,9 +199,9 @@ var _ = Describe("inventory", func() {
 					FreeAddresses: "[{\"network\":\"1.2.4.0/23\",\"free_addresses\":[\"1.2.5.9\"]}]",
 				},
 			}
-			err := VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, false, log)
+			err := VerifyVips(cluster.Hosts, []string{cluster.MachineNetworkCidr}, cluster.APIVip, cluster.IngressVip, false, log)
 			Expect(err).To(HaveOccurred())
-			err = VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, true, log)
+			err = VerifyVips(cluster.Hosts, []string{cluster.MachineNetworkCidr}, cluster.APIVip, cluster.IngressVip, true, log)
 			Expect(err).To(HaveOccurred())
 		})
 		It("Disabled", func() {
@@ -214,9 +214,9 @@ var _ = Describe("inventory", func() {
 					Status:        swag.String(models.HostStatusDisabled),
 				},
 			}
-			err := VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, false, log)
+			err := VerifyVips(cluster.Hosts, []string{cluster.MachineNetworkCidr}, cluster.APIVip, cluster.IngressVip, false, log)
 			Expect(err).ToNot(HaveOccurred())
-			err = VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, true, log)
+			err = VerifyVips(cluster.Hosts, []string{cluster.MachineNetworkCidr}, cluster.APIVip, cluster.IngressVip, true, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 		It("Empty", func() {
@@ -228,9 +228,9 @@ var _ = Describe("inventory", func() {
 					FreeAddresses: "",
 				},
 			}
-			err := VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, false, log)
+			err := VerifyVips(cluster.Hosts, []string{cluster.MachineNetworkCidr}, cluster.APIVip, cluster.IngressVip, false, log)
 			Expect(err).ToNot(HaveOccurred())
-			err = VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, true, log)
+			err = VerifyVips(cluster.Hosts, []string{cluster.MachineNetworkCidr}, cluster.APIVip, cluster.IngressVip, true, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 		It("Free", func() {
@@ -242,11 +242,27 @@ var _ = Describe("inventory", func() {
 					FreeAddresses: "[{\"network\":\"1.2.4.0/23\",\"free_addresses\":[\"1.2.5.6\",\"1.2.5.8\",\"1.2.5.9\"]}]",
 				},
 			}
-			err := VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, false, log)
+			err := VerifyVips(cluster.Hosts, []string{cluster.MachineNetworkCidr}, cluster.APIVip, cluster.IngressVip, false, log)
 			Expect(err).ToNot(HaveOccurred())
-			err = VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, true, log)
+			err = VerifyVips(cluster.Hosts, []string{cluster.MachineNetworkCidr}, cluster.APIVip, cluster.IngressVip, true, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
+		It("Dual-stack", func() {
+			hosts := []*models.Host{
+				{
+					FreeAddresses: "[{\"network\":\"1.2.4.0/23\",\"free_addresses\":[\"1.2.5.6\",\"1.2.5.8\"]}]",
+				},
+			}
+			machineNetworkCidrs := []string{"1001:db8::/120", "1.2.4.0/23"}
+			err := VerifyVips(hosts, machineNetworkCidrs, "1.2.5.6", "1.2.5.8", true, log)
+			Expect(err).ToNot(HaveOccurred())
+			err = VerifyVips(hosts, machineNetworkCidrs, "1001:db8::64", "1.2.5.8", true, log)
+			Expect(err).ToNot(HaveOccurred())
+			err = VerifyVips(hosts, machineNetworkCidrs, "1.2.5.6", "1.2.7.8", true, log)
+			Expect(err).To(HaveOccurred())
+			err = VerifyVips(hosts, []string{"1.2.4.0/23"}, "1001:db8::64", "1.2.5.8", true, log)
+			Expect(err).To(HaveOccurred())
+		})
 	})
 
 	Context("GetClusterNetworks", func() {
@@ -327,6 +343,72 @@ var _ = Describe("inventory", func() {
 		})
 	})
 
+	Context("CalculateSecondaryMachineNetworkCIDR", func() {
+		It("Found on all hosts", func() {
+			cidr, err := CalculateSecondaryMachineNetworkCIDR("1.2.3.0/24", createHosts(
+				createInventory(addIPv6Addresses(createInterface("1.2.3.4/24"), "2001:db8::a1/64", "fe80::1/64")),
+				createInventory(addIPv6Addresses(createInterface("1.2.3.5/24"), "2001:db8::a2/64"))))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cidr).To(Equal("2001:db8::/64"))
+		})
+		It("Most common network", func() {
+			cidr, err := CalculateSecondaryMachineNetworkCIDR("1.2.3.0/24", createHosts(
+				createInventory(addIPv6Addresses(createInterface("1.2.3.4/24"), "2002:db8::a1/64")),
+				createInventory(addIPv6Addresses(createInterface("1.2.3.5/24"), "2001:db8::a2/64")),
+				createInventory(addIPv6Addresses(createInterface("1.2.3.6/24"), "2001:db8::a3/64"))))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cidr).To(Equal("2001:db8::/64"))
+		})
+		It("Ignores interfaces outside of the primary network", func() {
+			cidr, err := CalculateSecondaryMachineNetworkCIDR("1.2.3.0/24", createHosts(
+				createInventory(createInterface("1.2.3.4/24"), addIPv6Addresses(createInterface("10.0.0.4/24"), "2001:db8::a1/64"))))
+			Expect(err).To(HaveOccurred())
+			Expect(cidr).To(BeEmpty())
+		})
+		It("IPv6 primary network", func() {
+			cidr, err := CalculateSecondaryMachineNetworkCIDR("2001:db8::/64", createHosts(
+				createInventory(addIPv6Addresses(createInterface("1.2.3.4/24"), "2001:db8::a1/64"))))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cidr).To(Equal("1.2.3.0/24"))
+		})
+	})
+
+	Context("GetHostMachineNetworks", func() {
+		var (
+			cluster *common.Cluster
+			log     logrus.FieldLogger
+		)
+
+		BeforeEach(func() {
+			log = logrus.New()
+			cluster = createCluster("", "10.0.1.0/24",
+				createInventory(createInterface("10.0.1.10/24")),
+				createInventory(createInterface("10.0.2.10/24")),
+				createInventory(createInterface("10.0.3.10/24")))
+			cluster.UserManagedNetworking = swag.Bool(true)
+			cluster.MachineNetworks = []*models.MachineNetwork{{Cidr: "10.0.1.0/24"}, {Cidr: "10.0.2.0/24"}}
+		})
+
+		It("User managed networking", func() {
+			Expect(GetHostMachineNetworks(log, cluster, cluster.Hosts[0])).To(Equal([]string{"10.0.1.0/24"}))
+			Expect(GetHostMachineNetworks(log, cluster, cluster.Hosts[1])).To(Equal([]string{"10.0.2.0/24"}))
+			Expect(GetHostMachineNetworks(log, cluster, cluster.Hosts[2])).To(BeEmpty())
+			hosts, err := GetMachineCIDRHosts(log, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hosts).To(Equal(cluster.Hosts[:2]))
+		})
+
+		It("Only the primary machine network without user managed networking", func() {
+			cluster.UserManagedNetworking = swag.Bool(false)
+			Expect(GetHostMachineNetworks(log, cluster, cluster.Hosts[0])).To(Equal([]string{"10.0.1.0/24"}))
+			Expect(GetHostMachineNetworks(log, cluster, cluster.Hosts[1])).To(BeEmpty())
+		})
+
+		It("All machine networks are emitted", func() {
+			Expect(GetMachineNetworksForUserManagedNetwork(cluster, log)).To(Equal([]string{"10.0.1.0/24", "10.0.2.0/24"}))
+		})
+	})
+
 	Context("GetMachineCidrForUserManagedNetwork", func() {
 
 		var log logrus.FieldLogger
@@ -0,0 +1,375 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/pkg/transaction"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+// Kind is the kind of a stored object that is charged to the owners of its cluster
+type Kind string
+
+const (
+	KindImage Kind = "image"
+	KindLogs  Kind = "logs"
+)
+
+// DiscoveryImage is the name under which the discovery image of a cluster is charged, such that generating the image
+// again replaces the image that the cluster references
+const DiscoveryImage = "discovery-image"
+
+// Config holds the default limits of the organizations and the users, where 0 is unlimited
+type Config struct {
+	DefaultMaxClusters     int64 `envconfig:"QUOTA_DEFAULT_MAX_CLUSTERS" default:"0"`
+	DefaultMaxImages       int64 `envconfig:"QUOTA_DEFAULT_MAX_IMAGES" default:"0"`
+	DefaultMaxStorageBytes int64 `envconfig:"QUOTA_DEFAULT_MAX_STORAGE_BYTES" default:"0"`
+}
+
+//go:generate mockgen -source=quota.go -package=quota -destination=mock_quota.go
+type API interface {
+	// CheckCluster fails when the organization or the user of the request reached their quota of active clusters, and
+	// otherwise registers the cluster while the quotas of the owners are locked, such that concurrent registrations
+	// cannot exceed the quota together
+	CheckCluster(ctx context.Context, register func() error) error
+	// CheckObject fails when storing an object under a name of the cluster would exceed a quota of the organization or
+	// the user that own the cluster. The object that is charged under the same name is replaced, and a size of 0
+	// stands for an object whose size is not known before it is stored. Checking does not charge the object, which
+	// is only reserved when it is stored.
+	CheckObject(ctx context.Context, cluster *common.Cluster, name string, kind Kind, sizeBytes int64) error
+	// ReserveObject checks an object like CheckObject and charges its size under the name until the stored object is
+	// recorded, such that concurrently stored objects cannot exceed the quota together. An object that fails to be
+	// stored is released with ReleaseClusterObject.
+	ReserveObject(ctx context.Context, cluster *common.Cluster, name string, kind Kind, sizeBytes int64) error
+	// Record charges an object that was stored under a name of the cluster to the owners of the cluster
+	Record(ctx context.Context, cluster *common.Cluster, name string, kind Kind, objectName string, sizeBytes int64) error
+	// Release stops charging an object that was deleted from the storage
+	Release(ctx context.Context, objectName string) error
+	// ReleaseClusterObject stops charging the object under a name of the cluster, which the cluster no longer stores
+	// or references
+	ReleaseClusterObject(ctx context.Context, cluster *common.Cluster, name string) error
+	// Get returns the usage and limits of an organization or a user
+	Get(ctx context.Context, scope, owner string) (*models.Quota, error)
+	// List returns the quotas of the organizations and users that own clusters or have limits of their own, and
+	// optionally only those of the given scope
+	List(ctx context.Context, scope string) ([]*models.Quota, error)
+	// UpdateLimits replaces the limits of an organization or a user. Limits that are not set are the default limits.
+	UpdateLimits(ctx context.Context, scope, owner string, limits *models.QuotaLimits) (*models.Quota, error)
+}
+
+type Manager struct {
+	Config
+	log logrus.FieldLogger
+	db  *gorm.DB
+}
+
+func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB) *Manager {
+	return &Manager{
+		Config: cfg,
+		log:    log,
+		db:     db,
+	}
+}
+
+type owner struct {
+	scope string
+	id    string
+}
+
+// ownersOf returns the organization and the user that are charged for a cluster. Clusters that are registered without
+// authentication have no organization.
+func ownersOf(orgID, userName string) []owner {
+	var owners []owner
+	if orgID != "" {
+		owners = append(owners, owner{scope: models.QuotaScopeOrg, id: orgID})
+	}
+	if userName != "" {
+		owners = append(owners, owner{scope: models.QuotaScopeUser, id: userName})
+	}
+	return owners
+}
+
+// column is the column of the clusters table that holds the owner
+func (o owner) column() string {
+	if o.scope == models.QuotaScopeOrg {
+		return "org_id"
+	}
+	return "user_name"
+}
+
+func (o owner) String() string {
+	if o.scope == models.QuotaScopeOrg {
+		return fmt.Sprintf("organization %s", o.id)
+	}
+	return fmt.Sprintf("user %s", o.id)
+}
+
+func exceeds(limit *int64, value int64) bool {
+	return swag.Int64Value(limit) > 0 && value > *limit
+}
+
+// withOwnersLock calls f with a transaction that holds the locks of the owners. The owners are always locked in the
+// order of ownersOf, such that concurrent callers do not deadlock.
+func (m *Manager) withOwnersLock(owners []owner, f func(tx *gorm.DB) error) error {
+	success := false
+	tx := m.db.Begin()
+	defer func() {
+		if !success {
+			tx.Rollback()
+		}
+	}()
+	for _, o := range owners {
+		// Concurrent inserts of the row of a new owner wait for each other, and all but one of them insert nothing
+		if err := tx.Exec("INSERT INTO quota_locks (scope, owner) VALUES (?, ?) ON CONFLICT DO NOTHING", o.scope, o.id).Error; err != nil {
+			return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to create the quota lock of %s", o))
+		}
+		var lock common.QuotaLock
+		if err := transaction.AddForUpdateQueryOption(tx).Take(&lock, "scope = ? and owner = ?", o.scope, o.id).Error; err != nil {
+			return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to lock the quota of %s", o))
+		}
+	}
+	if err := f(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, errors.Wrap(err, "failed to commit the quota check"))
+	}
+	success = true
+	return nil
+}
+
+func (m *Manager) CheckCluster(ctx context.Context, register func() error) error {
+	owners := ownersOf(ocm.OrgIDFromContext(ctx), ocm.UserNameFromContext(ctx))
+	return m.withOwnersLock(owners, func(tx *gorm.DB) error {
+		for _, o := range owners {
+			quota, err := m.get(tx, o)
+			if err != nil {
+				return common.NewApiError(http.StatusInternalServerError, err)
+			}
+			if exceeds(quota.Limits.MaxClusters, quota.Usage.Clusters+1) {
+				return m.exceeded(ctx, errors.Errorf("%s reached its quota of %d active clusters", o, *quota.Limits.MaxClusters))
+			}
+		}
+		// The cluster is registered by another connection, and is committed before the locks are released
+		return register()
+	})
+}
+
+func (m *Manager) CheckObject(ctx context.Context, cluster *common.Cluster, name string, kind Kind, sizeBytes int64) error {
+	return m.withOwnersLock(ownersOf(cluster.OrgID, cluster.UserName), func(tx *gorm.DB) error {
+		return m.checkObject(ctx, tx, cluster, name, kind, sizeBytes)
+	})
+}
+
+func (m *Manager) ReserveObject(ctx context.Context, cluster *common.Cluster, name string, kind Kind, sizeBytes int64) error {
+	return m.withOwnersLock(ownersOf(cluster.OrgID, cluster.UserName), func(tx *gorm.DB) error {
+		if err := m.checkObject(ctx, tx, cluster, name, kind, sizeBytes); err != nil {
+			return err
+		}
+		// The reservation has no object name until the stored object is recorded
+		reserved := &common.QuotaObject{
+			ClusterID: *cluster.ID,
+			Name:      name,
+			Kind:      string(kind),
+			SizeBytes: minimumSize(sizeBytes),
+		}
+		if err := tx.Save(reserved).Error; err != nil {
+			return common.NewApiError(http.StatusInternalServerError,
+				errors.Wrapf(err, "failed to reserve object %s of cluster %s", name, cluster.ID))
+		}
+		return nil
+	})
+}
+
+// minimumSize is the size that is charged for an object, where an object whose size is not known needs at least a
+// byte to be left
+func minimumSize(sizeBytes int64) int64 {
+	if sizeBytes == 0 {
+		return 1
+	}
+	return sizeBytes
+}
+
+func (m *Manager) checkObject(ctx context.Context, tx *gorm.DB, cluster *common.Cluster, name string, kind Kind, sizeBytes int64) error {
+	var replaced common.QuotaObject
+	err := tx.Take(&replaced, "cluster_id = ? and name = ?", cluster.ID.String(), name).Error
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		return common.NewApiError(http.StatusInternalServerError,
+			errors.Wrapf(err, "failed to get object %s of cluster %s", name, cluster.ID))
+	}
+	sizeBytes = minimumSize(sizeBytes)
+	for _, o := range ownersOf(cluster.OrgID, cluster.UserName) {
+		quota, err := m.get(tx, o)
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if kind == KindImage {
+			images := quota.Usage.Images + 1
+			if replaced.Kind == string(KindImage) {
+				images--
+			}
+			if exceeds(quota.Limits.MaxImages, images) {
+				return m.exceeded(ctx, errors.Errorf("%s reached its quota of %d discovery images", o, *quota.Limits.MaxImages))
+			}
+		}
+		if exceeds(quota.Limits.MaxStorageBytes, quota.Usage.StorageBytes-replaced.SizeBytes+sizeBytes) {
+			return m.exceeded(ctx, errors.Errorf("%s would exceed its quota of %d stored bytes", o, *quota.Limits.MaxStorageBytes))
+		}
+	}
+	return nil
+}
+
+func (m *Manager) exceeded(ctx context.Context, err error) error {
+	logutil.FromContext(ctx, m.log).Info(err.Error())
+	return common.NewApiError(http.StatusForbidden, err)
+}
+
+func (m *Manager) Record(ctx context.Context, cluster *common.Cluster, name string, kind Kind, objectName string, sizeBytes int64) error {
+	object := &common.QuotaObject{
+		ClusterID:  *cluster.ID,
+		Name:       name,
+		Kind:       string(kind),
+		ObjectName: objectName,
+		SizeBytes:  sizeBytes,
+	}
+	if err := m.db.Save(object).Error; err != nil {
+		return errors.Wrapf(err, "failed to record object %s of cluster %s", objectName, cluster.ID)
+	}
+	return nil
+}
+
+func (m *Manager) Release(ctx context.Context, objectName string) error {
+	if err := m.db.Where("object_name = ?", objectName).Delete(&common.QuotaObject{}).Error; err != nil {
+		return errors.Wrapf(err, "failed to release object %s", objectName)
+	}
+	return nil
+}
+
+func (m *Manager) ReleaseClusterObject(ctx context.Context, cluster *common.Cluster, name string) error {
+	if err := m.db.Delete(&common.QuotaObject{}, "cluster_id = ? and name = ?", cluster.ID.String(), name).Error; err != nil {
+		return errors.Wrapf(err, "failed to release object %s of cluster %s", name, cluster.ID)
+	}
+	return nil
+}
+
+func (m *Manager) Get(ctx context.Context, scope, id string) (*models.Quota, error) {
+	return m.get(m.db, owner{scope: scope, id: id})
+}
+
+func (m *Manager) get(db *gorm.DB, o owner) (*models.Quota, error) {
+	usage, err := m.usage(db, o)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := m.limits(db, o)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Quota{
+		Scope:  swag.String(o.scope),
+		Owner:  swag.String(o.id),
+		Usage:  usage,
+		Limits: limits,
+	}, nil
+}
+
+func (m *Manager) usage(db *gorm.DB, o owner) (*models.QuotaUsage, error) {
+	usage := &models.QuotaUsage{}
+	// Installed clusters are no longer active, they only keep charging their stored objects
+	if err := db.Model(&common.Cluster{}).Where(o.column()+" = ? and (status is null or status <> ?)", o.id, models.ClusterStatusInstalled).
+		Count(&usage.Clusters).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to count the clusters of %s", o)
+	}
+	// Objects are no longer charged once their cluster was deregistered
+	var stored struct {
+		Images       int64
+		StorageBytes int64
+	}
+	err := db.Table("quota_objects").
+		Select("count(case when quota_objects.kind = ? then 1 end) as images, coalesce(sum(quota_objects.size_bytes), 0) as storage_bytes", string(KindImage)).
+		Joins("join clusters on clusters.id = quota_objects.cluster_id").
+		Where("clusters.deleted_at is null and clusters."+o.column()+" = ?", o.id).
+		Scan(&stored).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to sum the objects of %s", o)
+	}
+	usage.Images = stored.Images
+	usage.StorageBytes = stored.StorageBytes
+	return usage, nil
+}
+
+func (m *Manager) limits(db *gorm.DB, o owner) (*models.QuotaLimits, error) {
+	limits := &models.QuotaLimits{
+		MaxClusters:     swag.Int64(m.DefaultMaxClusters),
+		MaxImages:       swag.Int64(m.DefaultMaxImages),
+		MaxStorageBytes: swag.Int64(m.DefaultMaxStorageBytes),
+	}
+	var custom common.QuotaLimits
+	if err := db.Take(&custom, "scope = ? and owner = ?", o.scope, o.id).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return limits, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get the limits of %s", o)
+	}
+	if custom.MaxClusters != nil {
+		limits.MaxClusters = custom.MaxClusters
+	}
+	if custom.MaxImages != nil {
+		limits.MaxImages = custom.MaxImages
+	}
+	if custom.MaxStorageBytes != nil {
+		limits.MaxStorageBytes = custom.MaxStorageBytes
+	}
+	return limits, nil
+}
+
+func (m *Manager) List(ctx context.Context, scope string) ([]*models.Quota, error) {
+	quotas := []*models.Quota{}
+	for _, s := range []string{models.QuotaScopeOrg, models.QuotaScopeUser} {
+		if scope != "" && scope != s {
+			continue
+		}
+		column := owner{scope: s}.column()
+		var ids, limited []string
+		if err := m.db.Model(&common.Cluster{}).Where(column+" <> ''").Pluck("distinct "+column, &ids).Error; err != nil {
+			return nil, errors.Wrapf(err, "failed to list the owners of clusters by %s", column)
+		}
+		if err := m.db.Model(&common.QuotaLimits{}).Where("scope = ?", s).Pluck("owner", &limited).Error; err != nil {
+			return nil, errors.Wrapf(err, "failed to list the owners of limits of scope %s", s)
+		}
+		for _, id := range limited {
+			if !funk.ContainsString(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			quota, err := m.Get(ctx, s, id)
+			if err != nil {
+				return nil, err
+			}
+			quotas = append(quotas, quota)
+		}
+	}
+	return quotas, nil
+}
+
+func (m *Manager) UpdateLimits(ctx context.Context, scope, id string, limits *models.QuotaLimits) (*models.Quota, error) {
+	record := &common.QuotaLimits{Scope: scope, Owner: id, QuotaLimits: *limits}
+	if err := m.db.Save(record).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to update the limits of %s", owner{scope: scope, id: id})
+	}
+	logutil.FromContext(ctx, m.log).Infof("Updated the limits of %s", owner{scope: scope, id: id})
+	return m.Get(ctx, scope, id)
+}
@@ -0,0 +1,259 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+)
+
+func TestQuota(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "Quota Suite")
+}
+
+func expectApiError(err error, statusCode int32) {
+	Expect(err).To(HaveOccurred())
+	apiErr, ok := err.(*common.ApiErrorResponse)
+	Expect(ok).To(BeTrue())
+	Expect(apiErr.StatusCode()).To(Equal(statusCode))
+}
+
+var _ = Describe("Manager", func() {
+	var (
+		ctx     context.Context
+		db      *gorm.DB
+		dbName  string
+		manager *Manager
+		cluster *common.Cluster
+	)
+
+	addCluster := func(orgID, userName string) *common.Cluster {
+		id := strfmt.UUID(uuid.New().String())
+		c := &common.Cluster{Cluster: models.Cluster{ID: &id, OrgID: orgID, UserName: userName}}
+		Expect(db.Create(c).Error).ToNot(HaveOccurred())
+		return c
+	}
+
+	get := func(scope, owner string) *models.Quota {
+		quota, err := manager.Get(ctx, scope, owner)
+		Expect(err).ToNot(HaveOccurred())
+		return quota
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		manager = NewManager(Config{DefaultMaxClusters: 2}, common.GetTestLog(), db)
+		ctx = context.WithValue(context.Background(), restapi.AuthKey,
+			&ocm.AuthPayload{Role: ocm.UserRole, Username: "user1", Organization: "org1"})
+		cluster = addCluster("org1", "user1")
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	Context("CheckCluster", func() {
+		register := func() error {
+			addCluster("org1", "user1")
+			return nil
+		}
+
+		It("registers clusters below the quota", func() {
+			Expect(manager.CheckCluster(ctx, register)).To(Succeed())
+			Expect(get(models.QuotaScopeOrg, "org1").Usage.Clusters).To(Equal(int64(2)))
+		})
+
+		It("fails when the organization reached its quota", func() {
+			addCluster("org1", "user2")
+			expectApiError(manager.CheckCluster(ctx, func() error {
+				Fail("registered a cluster over the quota")
+				return nil
+			}), http.StatusForbidden)
+		})
+
+		It("does not count installed clusters", func() {
+			installed := addCluster("org1", "user2")
+			Expect(db.Model(installed).Update("status", models.ClusterStatusInstalled).Error).ToNot(HaveOccurred())
+			Expect(manager.CheckCluster(ctx, register)).To(Succeed())
+			Expect(get(models.QuotaScopeOrg, "org1").Usage.Clusters).To(Equal(int64(2)))
+		})
+
+		It("does not exceed the quota with concurrent registrations", func() {
+			var wg sync.WaitGroup
+			errs := make(chan error, 3)
+			for i := 0; i < 3; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					errs <- manager.CheckCluster(ctx, register)
+				}()
+			}
+			wg.Wait()
+			close(errs)
+			succeeded := 0
+			for err := range errs {
+				if err == nil {
+					succeeded++
+				}
+			}
+			Expect(succeeded).To(Equal(1))
+			Expect(get(models.QuotaScopeOrg, "org1").Usage.Clusters).To(Equal(int64(2)))
+		})
+
+		It("returns the error of the registration", func() {
+			expectApiError(manager.CheckCluster(ctx, func() error {
+				return common.NewApiError(http.StatusInternalServerError, errors.New("failed"))
+			}), http.StatusInternalServerError)
+		})
+
+		It("fails when the user reached a quota of their own", func() {
+			_, err := manager.UpdateLimits(ctx, models.QuotaScopeUser, "user1", &models.QuotaLimits{MaxClusters: swag.Int64(1)})
+			Expect(err).ToNot(HaveOccurred())
+			expectApiError(manager.CheckCluster(ctx, register), http.StatusForbidden)
+		})
+
+		It("does not count deleted clusters", func() {
+			Expect(db.Delete(addCluster("org1", "user1")).Error).ToNot(HaveOccurred())
+			Expect(manager.CheckCluster(ctx, register)).To(Succeed())
+		})
+	})
+
+	Context("CheckObject", func() {
+		BeforeEach(func() {
+			_, err := manager.UpdateLimits(ctx, models.QuotaScopeOrg, "org1",
+				&models.QuotaLimits{MaxImages: swag.Int64(1), MaxStorageBytes: swag.Int64(100)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manager.Record(ctx, cluster, DiscoveryImage, KindImage, "image.iso", 60)).To(Succeed())
+		})
+
+		It("replaces the object that is charged under the same name", func() {
+			Expect(manager.CheckObject(ctx, cluster, DiscoveryImage, KindImage, 100)).To(Succeed())
+		})
+
+		It("fails when the organization reached its quota of images", func() {
+			other := addCluster("org1", "user2")
+			expectApiError(manager.CheckObject(ctx, other, DiscoveryImage, KindImage, 0), http.StatusForbidden)
+		})
+
+		It("fails when the object would exceed the quota of stored bytes", func() {
+			Expect(manager.CheckObject(ctx, cluster, "logs", KindLogs, 40)).To(Succeed())
+			expectApiError(manager.CheckObject(ctx, cluster, "logs", KindLogs, 41), http.StatusForbidden)
+		})
+
+		It("allows objects again once the stored objects were released", func() {
+			Expect(manager.Release(ctx, "image.iso")).To(Succeed())
+			Expect(manager.CheckObject(ctx, cluster, "logs", KindLogs, 100)).To(Succeed())
+		})
+
+		It("allows objects again once the cluster no longer references them", func() {
+			Expect(manager.ReleaseClusterObject(ctx, cluster, DiscoveryImage)).To(Succeed())
+			Expect(manager.CheckObject(ctx, cluster, "logs", KindLogs, 100)).To(Succeed())
+		})
+	})
+
+	Context("ReserveObject", func() {
+		BeforeEach(func() {
+			_, err := manager.UpdateLimits(ctx, models.QuotaScopeOrg, "org1", &models.QuotaLimits{MaxStorageBytes: swag.Int64(100)})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("charges the object until it is recorded", func() {
+			Expect(manager.ReserveObject(ctx, cluster, "logs", KindLogs, 60)).To(Succeed())
+			Expect(get(models.QuotaScopeOrg, "org1").Usage.StorageBytes).To(Equal(int64(60)))
+			expectApiError(manager.ReserveObject(ctx, cluster, "other", KindLogs, 41), http.StatusForbidden)
+
+			Expect(manager.Record(ctx, cluster, "logs", KindLogs, "logs", 50)).To(Succeed())
+			Expect(get(models.QuotaScopeOrg, "org1").Usage.StorageBytes).To(Equal(int64(50)))
+		})
+
+		It("stops charging an object that failed to be stored", func() {
+			Expect(manager.ReserveObject(ctx, cluster, "logs", KindLogs, 60)).To(Succeed())
+			Expect(manager.ReleaseClusterObject(ctx, cluster, "logs")).To(Succeed())
+			Expect(get(models.QuotaScopeOrg, "org1").Usage.StorageBytes).To(BeZero())
+		})
+
+		It("does not exceed the quota with concurrent objects", func() {
+			var wg sync.WaitGroup
+			errs := make(chan error, 3)
+			for i := 0; i < 3; i++ {
+				wg.Add(1)
+				go func(name string) {
+					defer GinkgoRecover()
+					defer wg.Done()
+					errs <- manager.ReserveObject(ctx, cluster, name, KindLogs, 60)
+				}(fmt.Sprintf("logs-%d", i))
+			}
+			wg.Wait()
+			close(errs)
+			succeeded := 0
+			for err := range errs {
+				if err == nil {
+					succeeded++
+				}
+			}
+			Expect(succeeded).To(Equal(1))
+			Expect(get(models.QuotaScopeOrg, "org1").Usage.StorageBytes).To(Equal(int64(60)))
+		})
+	})
+
+	Context("Get", func() {
+		It("returns the usage of the owner", func() {
+			Expect(manager.Record(ctx, cluster, DiscoveryImage, KindImage, "image.iso", 60)).To(Succeed())
+			Expect(manager.Record(ctx, cluster, "logs", KindLogs, "logs", 40)).To(Succeed())
+			Expect(manager.Record(ctx, cluster, DiscoveryImage, KindImage, "image.iso", 50)).To(Succeed())
+			Expect(get(models.QuotaScopeUser, "user1").Usage).To(Equal(&models.QuotaUsage{Clusters: 1, Images: 1, StorageBytes: 90}))
+		})
+
+		It("does not charge the objects of deleted clusters", func() {
+			Expect(manager.Record(ctx, cluster, DiscoveryImage, KindImage, "image.iso", 60)).To(Succeed())
+			Expect(db.Delete(cluster).Error).ToNot(HaveOccurred())
+			Expect(get(models.QuotaScopeOrg, "org1").Usage).To(Equal(&models.QuotaUsage{}))
+		})
+
+		It("falls back to the default limits", func() {
+			_, err := manager.UpdateLimits(ctx, models.QuotaScopeOrg, "org1", &models.QuotaLimits{MaxImages: swag.Int64(3)})
+			Expect(err).ToNot(HaveOccurred())
+			limits := get(models.QuotaScopeOrg, "org1").Limits
+			Expect(*limits.MaxClusters).To(Equal(int64(2)))
+			Expect(*limits.MaxImages).To(Equal(int64(3)))
+			Expect(*limits.MaxStorageBytes).To(BeZero())
+		})
+	})
+
+	Context("List", func() {
+		It("lists the owners of clusters and the owners with limits", func() {
+			addCluster("", "user0")
+			_, err := manager.UpdateLimits(ctx, models.QuotaScopeOrg, "org0", &models.QuotaLimits{})
+			Expect(err).ToNot(HaveOccurred())
+
+			quotas, err := manager.List(ctx, "")
+			Expect(err).ToNot(HaveOccurred())
+			var owners []string
+			for _, quota := range quotas {
+				owners = append(owners, *quota.Scope+"/"+*quota.Owner)
+			}
+			Expect(owners).To(Equal([]string{"org/org0", "org/org1", "user/user0", "user/user1"}))
+
+			quotas, err = manager.List(ctx, models.QuotaScopeUser)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(quotas).To(HaveLen(2))
+		})
+	})
+})
@@ -0,0 +1,265 @@
+package storagemigration
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/renameio"
+	"github.com/openshift/assisted-service/internal/clusterarchive"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Status is the outcome of the migration of an object
+type Status string
+
+const (
+	// StatusPending is reported for objects that a dry run would copy
+	StatusPending Status = "pending"
+	// StatusMigrated is reported for objects that were copied by a previous run
+	StatusMigrated Status = "already migrated"
+	StatusCopied   Status = "copied"
+	StatusFailed   Status = "failed"
+)
+
+// ObjectReport is the migration of an object referenced by a cluster
+type ObjectReport struct {
+	ClusterID  string
+	ObjectName string
+	SizeBytes  int64
+	Status     Status
+	Error      string
+}
+
+// Report is the result of a migration, or of a dry run
+type Report struct {
+	Objects    []ObjectReport
+	TotalBytes int64
+}
+
+// Count returns the number of objects with the given status
+func (r *Report) Count(status Status) int {
+	count := 0
+	for _, object := range r.Objects {
+		if object.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// migratedObject is the record of an object that was copied and verified
+type migratedObject struct {
+	SizeBytes int64  `json:"size_bytes"`
+	SHA256    string `json:"sha256"`
+}
+
+// Migrator copies the objects referenced by clusters from one storage to another. The objects that were copied and
+// verified are recorded in a state file, such that an interrupted migration resumes where it stopped, and that the
+// objects that were created or changed while the service kept running are copied by running the migration again.
+type Migrator struct {
+	log       logrus.FieldLogger
+	source    s3wrapper.API
+	target    s3wrapper.API
+	stateFile string
+	migrated  map[string]migratedObject
+}
+
+func NewMigrator(log logrus.FieldLogger, source, target s3wrapper.API, stateFile string) (*Migrator, error) {
+	m := &Migrator{
+		log:       log,
+		source:    source,
+		target:    target,
+		stateFile: stateFile,
+		migrated:  make(map[string]migratedObject),
+	}
+	content, err := ioutil.ReadFile(stateFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return m, nil
+		}
+		return nil, errors.Wrapf(err, "failed to read migration state %s", stateFile)
+	}
+	if err = json.Unmarshal(content, &m.migrated); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse migration state %s", stateFile)
+	}
+	return m, nil
+}
+
+// ClusterObjectPrefixes returns the prefixes of the objects of a cluster: its directory with its ignitions, credentials,
+// manifests and logs, its discovery images, network boot artifacts and images of declared hosts, the cached image
+// that it shares with other clusters, and its data keys. The checksums of the objects share their prefixes.
+func ClusterObjectPrefixes(cluster *common.Cluster) []string {
+	prefixes := []string{
+		cluster.ID.String() + "/",
+		fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, cluster.ID.String()),
+		s3wrapper.DataKeysObjectName(cluster.ID.String()),
+	}
+	if cluster.ImageContentHash != "" {
+		prefixes = append(prefixes, fmt.Sprintf(s3wrapper.DiscoveryImageCacheTemplate, cluster.ImageContentHash)+".")
+	}
+	return prefixes
+}
+
+// Migrate copies the objects of the clusters to the target storage, and verifies their checksums. A dry run only
+// reports the objects that would be copied.
+func (m *Migrator) Migrate(ctx context.Context, clusters []*common.Cluster, dryRun bool) (*Report, error) {
+	objects, err := m.listObjects(ctx, clusters)
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{}
+	names := make([]string, 0, len(objects))
+	for name := range objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		object := ObjectReport{ClusterID: objects[name], ObjectName: name}
+		object.SizeBytes, err = m.source.GetObjectSizeBytes(ctx, name)
+		if err != nil {
+			object.Status = StatusFailed
+			object.Error = err.Error()
+			report.Objects = append(report.Objects, object)
+			continue
+		}
+		report.TotalBytes += object.SizeBytes
+		switch {
+		case m.isMigrated(ctx, name, object.SizeBytes):
+			object.Status = StatusMigrated
+		case dryRun:
+			object.Status = StatusPending
+		default:
+			if err = m.copyObject(ctx, name); err != nil {
+				m.log.WithError(err).Errorf("Failed to migrate object %s", name)
+				object.Status = StatusFailed
+				object.Error = err.Error()
+			} else {
+				object.Status = StatusCopied
+			}
+		}
+		report.Objects = append(report.Objects, object)
+	}
+	return report, nil
+}
+
+// listObjects returns the objects of the clusters in the source storage by their names, with the IDs of their clusters.
+// Archived clusters are no longer in the database, so their archives and directories are found in the source storage.
+func (m *Migrator) listObjects(ctx context.Context, clusters []*common.Cluster) (map[string]string, error) {
+	objects := make(map[string]string)
+	archives, err := m.source.ListObjectsByPrefix(ctx, clusterarchive.ObjectPrefix)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list the archived clusters")
+	}
+	for _, name := range archives {
+		clusterID := strfmt.UUID(strings.TrimSuffix(strings.TrimPrefix(name, clusterarchive.ObjectPrefix), ".json.gz"))
+		objects[name] = clusterID.String()
+		clusters = append(clusters, &common.Cluster{Cluster: models.Cluster{ID: &clusterID}})
+	}
+	for _, cluster := range clusters {
+		for _, prefix := range ClusterObjectPrefixes(cluster) {
+			names, err := m.source.ListObjectsByPrefix(ctx, prefix)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to list the objects of cluster %s", cluster.ID.String())
+			}
+			for _, name := range names {
+				// A shared cached image is reported with the first cluster that references it
+				if _, ok := objects[name]; !ok {
+					objects[name] = cluster.ID.String()
+				}
+			}
+		}
+	}
+	return objects, nil
+}
+
+// isMigrated returns whether an object was copied by a previous run and was not changed since. Objects may be
+// rewritten with the same size, e.g. a regenerated ignition, so the checksum of the source object is compared with the
+// checksum that was recorded when it was copied.
+func (m *Migrator) isMigrated(ctx context.Context, name string, sizeBytes int64) bool {
+	record, ok := m.migrated[name]
+	if !ok || record.SizeBytes != sizeBytes {
+		return false
+	}
+	targetSize, err := m.target.GetObjectSizeBytes(ctx, name)
+	if err != nil || targetSize != sizeBytes {
+		return false
+	}
+	reader, _, err := m.source.Download(ctx, name)
+	if err != nil {
+		return false
+	}
+	defer reader.Close()
+	checksum, err := s3wrapper.SHA256Sum(reader)
+	return err == nil && checksum == record.SHA256
+}
+
+func (m *Migrator) copyObject(ctx context.Context, name string) error {
+	reader, size, err := m.source.Download(ctx, name)
+	if err != nil {
+		return errors.Wrap(err, "failed to download from the source storage")
+	}
+	defer reader.Close()
+	hash := sha256.New()
+	counter := &countingReader{reader: io.TeeReader(reader, hash)}
+	if err = m.target.UploadStream(ctx, counter, name); err != nil {
+		return errors.Wrap(err, "failed to upload to the target storage")
+	}
+	if counter.count != size {
+		return errors.Errorf("read %d bytes from the source storage while %d bytes were expected", counter.count, size)
+	}
+	checksum := hex.EncodeToString(hash.Sum(nil))
+
+	copied, copiedSize, err := m.target.Download(ctx, name)
+	if err != nil {
+		return errors.Wrap(err, "failed to download from the target storage for verification")
+	}
+	defer copied.Close()
+	copiedChecksum, err := s3wrapper.SHA256Sum(copied)
+	if err != nil {
+		return errors.Wrap(err, "failed to read from the target storage for verification")
+	}
+	if copiedSize != size {
+		return errors.Errorf("copied object has %d bytes rather than %d", copiedSize, size)
+	}
+	if err = s3wrapper.VerifyChecksum(name, checksum, copiedChecksum); err != nil {
+		return err
+	}
+
+	m.migrated[name] = migratedObject{SizeBytes: size, SHA256: checksum}
+	return m.saveState()
+}
+
+func (m *Migrator) saveState() error {
+	content, err := json.Marshal(m.migrated)
+	if err != nil {
+		return err
+	}
+	if err = renameio.WriteFile(m.stateFile, content, 0600); err != nil {
+		return errors.Wrapf(err, "failed to write migration state %s", m.stateFile)
+	}
+	return nil
+}
+
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.reader.Read(p)
+	c.count += int64(n)
+	return n, err
+}
@@ -0,0 +1,206 @@
+package storagemigration
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/clusterarchive"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestStorageMigration(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Storage migration Suite")
+}
+
+// corruptingStorage stores objects as is, and returns them with a flipped byte
+type corruptingStorage struct {
+	s3wrapper.API
+}
+
+func (c *corruptingStorage) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	reader, size, err := c.API.Download(ctx, objectName)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer reader.Close()
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, 0, err
+	}
+	content[0] ^= 0xff
+	return ioutil.NopCloser(bytes.NewReader(content)), size, nil
+}
+
+var _ = Describe("Migrator", func() {
+	var (
+		ctx       = context.Background()
+		dir       string
+		stateFile string
+		source    s3wrapper.API
+		target    s3wrapper.API
+		cluster   *common.Cluster
+		other     *common.Cluster
+		deleted   strfmt.UUID
+		archived  strfmt.UUID
+	)
+
+	newStorage := func(name string) s3wrapper.API {
+		return s3wrapper.NewFSClient(filepath.Join(dir, name), common.GetTestLog(), nil, nil,
+			metrics.NewMetricsManager(prometheus.NewRegistry(), nil), 100, "")
+	}
+
+	newCluster := func(contentHash string) *common.Cluster {
+		id := strfmt.UUID(uuid.New().String())
+		return &common.Cluster{Cluster: models.Cluster{ID: &id}, ImageContentHash: contentHash}
+	}
+
+	upload := func(objectName, content string) {
+		Expect(source.Upload(ctx, []byte(content), objectName)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "storage-migration")
+		Expect(err).ToNot(HaveOccurred())
+		stateFile = filepath.Join(dir, "state.json")
+		source = newStorage("source")
+		target = newStorage("target")
+
+		cluster = newCluster("abcd")
+		other = newCluster("")
+		deleted = strfmt.UUID(uuid.New().String())
+		upload(cluster.ID.String()+"/kubeconfig", "kubeconfig")
+		upload(cluster.ID.String()+"/logs/controller_logs.tar.gz", "logs")
+		upload(cluster.ID.String()+"/manifests/openshift/99-custom.yaml", "manifest")
+		upload(fmt.Sprintf("discovery-image-%s-pxe-initrd.img", cluster.ID), "initrd")
+		upload("discovery-cache-abcd.iso", "shared iso")
+		upload("discovery-cache-abcd.iso.sha256", "checksum")
+		upload(s3wrapper.DataKeysObjectName(cluster.ID.String()), "keys")
+		upload(fmt.Sprintf("discovery-image-%s.iso", other.ID), "iso")
+		upload(deleted.String()+"/kubeconfig", "deleted")
+		upload("discovery-cache-ef01.iso", "unreferenced iso")
+		archived = strfmt.UUID(uuid.New().String())
+		upload(clusterarchive.ObjectName(archived), "archive")
+		upload(archived.String()+"/logs/controller_logs.tar.gz", "archived logs")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	migrate := func(dryRun bool) *Report {
+		migrator, err := NewMigrator(common.GetTestLog(), source, target, stateFile)
+		Expect(err).ToNot(HaveOccurred())
+		report, err := migrator.Migrate(ctx, []*common.Cluster{cluster, other}, dryRun)
+		Expect(err).ToNot(HaveOccurred())
+		return report
+	}
+
+	objectNames := func(report *Report) []string {
+		var names []string
+		for _, object := range report.Objects {
+			names = append(names, object.ObjectName)
+		}
+		return names
+	}
+
+	expectedObjects := func() []string {
+		return []string{
+			cluster.ID.String() + "/kubeconfig",
+			cluster.ID.String() + "/logs/controller_logs.tar.gz",
+			cluster.ID.String() + "/manifests/openshift/99-custom.yaml",
+			s3wrapper.DataKeysObjectName(cluster.ID.String()),
+			"discovery-cache-abcd.iso",
+			"discovery-cache-abcd.iso.sha256",
+			fmt.Sprintf("discovery-image-%s-pxe-initrd.img", cluster.ID),
+			fmt.Sprintf("discovery-image-%s.iso", other.ID),
+			clusterarchive.ObjectName(archived),
+			archived.String() + "/logs/controller_logs.tar.gz",
+		}
+	}
+
+	It("reports the objects of live and archived clusters in a dry run", func() {
+		report := migrate(true)
+		Expect(objectNames(report)).To(ConsistOf(expectedObjects()))
+		Expect(report.Count(StatusPending)).To(Equal(len(expectedObjects())))
+		Expect(report.TotalBytes).To(Equal(int64(len("kubeconfiglogsmanifestinitrdshared isochecksumkeysisoarchivearchived logs"))))
+		Expect(filepath.Join(dir, "target")).ToNot(BeAnExistingFile())
+		Expect(stateFile).ToNot(BeAnExistingFile())
+	})
+
+	It("copies the objects of live and archived clusters", func() {
+		report := migrate(false)
+		Expect(report.Count(StatusCopied)).To(Equal(len(expectedObjects())))
+		objects, err := target.ListObjectsByPrefix(ctx, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(ConsistOf(expectedObjects()))
+
+		reader, _, err := target.Download(ctx, cluster.ID.String()+"/kubeconfig")
+		Expect(err).ToNot(HaveOccurred())
+		content, err := ioutil.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reader.Close()).To(Succeed())
+		Expect(string(content)).To(Equal("kubeconfig"))
+	})
+
+	It("resumes a migration", func() {
+		migrate(false)
+		upload(cluster.ID.String()+"/kubeadmin-password", "password")
+		upload(cluster.ID.String()+"/kubeconfig", "changed kubeconfig")
+
+		report := migrate(false)
+		Expect(report.Count(StatusMigrated)).To(Equal(len(expectedObjects()) - 1))
+		Expect(report.Count(StatusCopied)).To(Equal(2))
+		Expect(migrate(true).Count(StatusPending)).To(Equal(0))
+	})
+
+	It("copies an object again when it was changed without changing its size", func() {
+		migrate(false)
+		upload(cluster.ID.String()+"/kubeconfig", "KUBECONFIG")
+
+		report := migrate(false)
+		Expect(report.Count(StatusCopied)).To(Equal(1))
+		reader, _, err := target.Download(ctx, cluster.ID.String()+"/kubeconfig")
+		Expect(err).ToNot(HaveOccurred())
+		content, err := ioutil.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reader.Close()).To(Succeed())
+		Expect(string(content)).To(Equal("KUBECONFIG"))
+	})
+
+	It("copies an object again when it is missing in the target storage", func() {
+		migrate(false)
+		_, err := target.DeleteObject(ctx, cluster.ID.String()+"/kubeconfig")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(migrate(true).Count(StatusPending)).To(Equal(1))
+	})
+
+	It("fails objects whose copy does not match their checksum", func() {
+		target = &corruptingStorage{API: target}
+		report := migrate(false)
+		Expect(report.Count(StatusFailed)).To(Equal(len(expectedObjects())))
+		Expect(report.Objects[0].Error).To(ContainSubstring("Checksum"))
+		Expect(stateFile).ToNot(BeAnExistingFile())
+	})
+
+	It("fails with a corrupted state file", func() {
+		Expect(ioutil.WriteFile(stateFile, []byte("not json"), 0600)).To(Succeed())
+		_, err := NewMigrator(common.GetTestLog(), source, target, stateFile)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,464 @@
+package supportbundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// FormatVersion is the version of the layout of the bundle, which is bumped whenever the bundle cannot be loaded by
+// older versions of the loader
+const FormatVersion = 1
+
+const (
+	metadataFile      = "metadata.json"
+	clusterFile       = "cluster.json"
+	hostsFile         = "hosts.json"
+	eventsFile        = "events.json"
+	validationsFile   = "validations.json"
+	installConfigFile = "install-config.yaml"
+	manifestsFile     = "manifests.json"
+	operatorsFile     = "operators.json"
+	logsNoticeFile    = "LOGS.txt"
+	logsFolder        = "logs"
+)
+
+const (
+	validationFailure = "failure"
+	validationSuccess = "success"
+)
+
+var (
+	urlQueryRegex = regexp.MustCompile(`\?.*$`)
+	// The messages of the events that are added when the status of a validation changes
+	hostValidationChangeRegex    = regexp.MustCompile(`^Host .*: validation '([^']+)' (that used to succeed is now failing|is now fixed)$`)
+	clusterValidationChangeRegex = regexp.MustCompile(`^Cluster validation '([^']+)' (that used to succeed is now failing|is now fixed)$`)
+	// sensitiveKeys are the keys of secrets in the install config and ignition overrides
+	sensitiveKeys = map[string]bool{
+		"pullSecret":        true,
+		"sshKey":            true,
+		"sshAuthorizedKeys": true,
+		"password":          true,
+		"passwordHash":      true,
+	}
+)
+
+// Metadata describes the bundle
+type Metadata struct {
+	Version   int         `json:"version"`
+	ClusterID strfmt.UUID `json:"cluster_id"`
+	CreatedAt time.Time   `json:"created_at"`
+	// Logs are the object names of the log archives in the bundle. Unlike the records of the bundle, the log
+	// archives are not redacted and may hold secrets.
+	Logs []string `json:"logs,omitempty"`
+}
+
+// ValidationChange is a change of the status of a validation
+type ValidationChange struct {
+	Time         strfmt.DateTime `json:"time"`
+	ValidationID string          `json:"validation_id"`
+	Status       string          `json:"status"`
+}
+
+// Validations are the validations info of the cluster and its hosts when the bundle was created, along with the
+// history of their changes, which is rebuilt from the validation change events
+type Validations struct {
+	Cluster        json.RawMessage                     `json:"cluster,omitempty"`
+	Hosts          map[strfmt.UUID]json.RawMessage     `json:"hosts,omitempty"`
+	ClusterHistory []*ValidationChange                 `json:"cluster_history,omitempty"`
+	HostsHistory   map[strfmt.UUID][]*ValidationChange `json:"hosts_history,omitempty"`
+}
+
+// Bundle holds the records of a cluster that are collected for a support case, with their secrets redacted
+type Bundle struct {
+	Metadata      Metadata
+	Cluster       *common.Cluster
+	Hosts         []*models.Host
+	Events        []*common.Event
+	Validations   *Validations
+	InstallConfig []byte
+	Manifests     models.ListManifests
+	Operators     []*models.MonitoredOperator
+}
+
+// New collects the records of a cluster, loaded with its hosts and monitored operators, into a bundle and redacts their
+// secrets. The install config is expected to be redacted already. Logs are the object names of the log archives of the
+// cluster.
+func New(cluster *common.Cluster, events []*common.Event, installConfig []byte, manifests models.ListManifests, logs []string) (*Bundle, error) {
+	// Work on a copy, such that the cluster of the caller keeps its secrets
+	var c common.Cluster
+	if err := copyJSON(cluster, &c); err != nil {
+		return nil, errors.Wrapf(err, "failed to copy cluster %s", cluster.ID)
+	}
+	bundle := &Bundle{
+		Metadata: Metadata{
+			Version:   FormatVersion,
+			ClusterID: *cluster.ID,
+			CreatedAt: time.Now().UTC(),
+			Logs:      logs,
+		},
+		Cluster:       &c,
+		Hosts:         c.Hosts,
+		Events:        events,
+		Validations:   newValidations(&c, events),
+		InstallConfig: installConfig,
+		Manifests:     manifests,
+		Operators:     c.MonitoredOperators,
+	}
+	c.Hosts = nil
+	c.MonitoredOperators = nil
+
+	redactCluster(&c)
+	for _, host := range bundle.Hosts {
+		host.IgnitionConfigOverrides = redactJSON(host.IgnitionConfigOverrides)
+	}
+	return bundle, nil
+}
+
+// newValidations collects the validations info of the cluster and its hosts, and rebuilds the history of their changes
+// from the events, which are ordered by time
+func newValidations(c *common.Cluster, events []*common.Event) *Validations {
+	validations := &Validations{
+		Cluster:      rawJSON(c.ValidationsInfo),
+		Hosts:        map[strfmt.UUID]json.RawMessage{},
+		HostsHistory: map[strfmt.UUID][]*ValidationChange{},
+	}
+	for _, host := range c.Hosts {
+		if host.ID != nil && host.ValidationsInfo != "" {
+			validations.Hosts[*host.ID] = rawJSON(host.ValidationsInfo)
+		}
+	}
+	for _, event := range events {
+		if event.Message == nil || event.EventTime == nil {
+			continue
+		}
+		if event.HostID != "" {
+			if change := newValidationChange(hostValidationChangeRegex, event); change != nil {
+				validations.HostsHistory[event.HostID] = append(validations.HostsHistory[event.HostID], change)
+			}
+		} else if change := newValidationChange(clusterValidationChangeRegex, event); change != nil {
+			validations.ClusterHistory = append(validations.ClusterHistory, change)
+		}
+	}
+	return validations
+}
+
+func newValidationChange(regex *regexp.Regexp, event *common.Event) *ValidationChange {
+	match := regex.FindStringSubmatch(*event.Message)
+	if match == nil {
+		return nil
+	}
+	status := validationSuccess
+	if match[2] == "that used to succeed is now failing" {
+		status = validationFailure
+	}
+	return &ValidationChange{Time: *event.EventTime, ValidationID: match[1], Status: status}
+}
+
+func copyJSON(from, to interface{}) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, to)
+}
+
+func rawJSON(value string) json.RawMessage {
+	if value == "" || !json.Valid([]byte(value)) {
+		return nil
+	}
+	return json.RawMessage(value)
+}
+
+func redactString(value string) string {
+	if value == "" {
+		return value
+	}
+	return common.RedactedValue
+}
+
+func redactCluster(c *common.Cluster) {
+	c.PullSecret = redactString(c.PullSecret)
+	c.SSHPublicKey = redactString(c.SSHPublicKey)
+	c.HTTPProxy = common.RedactURLCredentials(c.HTTPProxy)
+	c.HTTPSProxy = common.RedactURLCredentials(c.HTTPSProxy)
+	c.InstallConfigOverrides = redactJSON(c.InstallConfigOverrides)
+	c.IgnitionConfigOverrides = redactJSON(c.IgnitionConfigOverrides)
+	if c.ImageInfo != nil {
+		c.ImageInfo.SSHPublicKey = redactString(c.ImageInfo.SSHPublicKey)
+		// The query of the download URL holds the token that authorizes the download
+		c.ImageInfo.DownloadURL = urlQueryRegex.ReplaceAllString(c.ImageInfo.DownloadURL, "?"+common.RedactedValue)
+	}
+}
+
+// redactJSON redacts the values of the sensitive keys and the credentials of the URLs in a JSON document. A value that
+// is not a JSON document is redacted as a whole.
+func redactJSON(value string) string {
+	if value == "" {
+		return value
+	}
+	var doc interface{}
+	if err := json.Unmarshal([]byte(value), &doc); err != nil {
+		return common.RedactedValue
+	}
+	redacted, err := json.Marshal(redactValue(doc))
+	if err != nil {
+		return common.RedactedValue
+	}
+	return string(redacted)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if sensitiveKeys[key] {
+				v[key] = common.RedactedValue
+			} else {
+				v[key] = redactValue(child)
+			}
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+	case string:
+		return common.RedactURLCredentials(v)
+	}
+	return value
+}
+
+// Write writes the bundle as a gzipped tar archive, along with the log archives that are downloaded from the storage and
+// a notice that they are not redacted. Log archives that are no longer stored are skipped.
+func Write(ctx context.Context, w io.Writer, bundle *Bundle, objectHandler s3wrapper.API, log logrus.FieldLogger) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	validations, err := json.Marshal(bundle.Validations)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal validations")
+	}
+	files := []struct {
+		name  string
+		value interface{}
+	}{
+		{metadataFile, bundle.Metadata},
+		{clusterFile, bundle.Cluster},
+		{hostsFile, bundle.Hosts},
+		{eventsFile, bundle.Events},
+		{validationsFile, json.RawMessage(validations)},
+		{manifestsFile, bundle.Manifests},
+		{operatorsFile, bundle.Operators},
+	}
+	for _, file := range files {
+		data, err := json.MarshalIndent(file.value, "", "  ")
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal %s", file.name)
+		}
+		if err = writeFile(tw, file.name, data, bundle.Metadata.CreatedAt); err != nil {
+			return err
+		}
+	}
+	if len(bundle.InstallConfig) > 0 {
+		if err = writeFile(tw, installConfigFile, bundle.InstallConfig, bundle.Metadata.CreatedAt); err != nil {
+			return err
+		}
+	}
+	if len(bundle.Metadata.Logs) > 0 {
+		if err = writeFile(tw, logsNoticeFile, logsNotice(bundle), bundle.Metadata.CreatedAt); err != nil {
+			return err
+		}
+	}
+	for _, objectName := range bundle.Metadata.Logs {
+		if err = writeObject(ctx, tw, objectName, objectHandler); err != nil {
+			if _, ok := err.(common.NotFound); ok {
+				log.WithError(err).Warnf("Skipping log archive %s of cluster %s", objectName, bundle.Metadata.ClusterID)
+				continue
+			}
+			return err
+		}
+	}
+
+	if err = tw.Close(); err != nil {
+		return errors.Wrap(err, "failed to close tar writer")
+	}
+	return errors.Wrap(gz.Close(), "failed to close gzip writer")
+}
+
+// logsNotice warns the readers of the bundle that the log archives are not redacted
+func logsNotice(bundle *Bundle) []byte {
+	var notice strings.Builder
+	notice.WriteString("The log archives under " + logsFolder + "/ are not redacted and may hold secrets, such as pull secrets,\n")
+	notice.WriteString("SSH keys and passwords. Review them before sharing this bundle.\n")
+	notice.WriteString("\nLog archives:\n")
+	for _, objectName := range bundle.Metadata.Logs {
+		notice.WriteString(path.Join(logsFolder, objectName) + "\n")
+	}
+	return []byte(notice.String())
+}
+
+func writeFile(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	header := &tar.Header{Name: name, Size: int64(len(data)), Mode: 0644, ModTime: modTime}
+	if err := tw.WriteHeader(header); err != nil {
+		return errors.Wrapf(err, "failed to write tar header of %s", name)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return errors.Wrapf(err, "failed to write %s", name)
+	}
+	return nil
+}
+
+func writeObject(ctx context.Context, tw *tar.Writer, objectName string, objectHandler s3wrapper.API) error {
+	reader, size, err := objectHandler.Download(ctx, objectName)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	name := path.Join(logsFolder, objectName)
+	if err = tw.WriteHeader(&tar.Header{Name: name, Size: size, Mode: 0644, ModTime: time.Now()}); err != nil {
+		return errors.Wrapf(err, "failed to write tar header of %s", name)
+	}
+	if _, err = io.Copy(tw, reader); err != nil {
+		return errors.Wrapf(err, "failed to write %s", name)
+	}
+	return nil
+}
+
+// Read reads a bundle that was written by Write. The log archives are uploaded to the storage under their original
+// object names, unless the storage is nil.
+func Read(ctx context.Context, r io.Reader, objectHandler s3wrapper.API) (*Bundle, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open gzip reader")
+	}
+	defer gz.Close()
+
+	bundle := &Bundle{Validations: &Validations{}}
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read bundle")
+		}
+
+		if strings.HasPrefix(header.Name, logsFolder+"/") {
+			if objectHandler == nil {
+				continue
+			}
+			objectName := strings.TrimPrefix(header.Name, logsFolder+"/")
+			if err = objectHandler.UploadStream(ctx, tr, objectName); err != nil {
+				return nil, errors.Wrapf(err, "failed to store %s", objectName)
+			}
+			continue
+		}
+
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", header.Name)
+		}
+		var target interface{}
+		switch header.Name {
+		case metadataFile:
+			target = &bundle.Metadata
+		case clusterFile:
+			target = &bundle.Cluster
+		case hostsFile:
+			target = &bundle.Hosts
+		case eventsFile:
+			target = &bundle.Events
+		case validationsFile:
+			target = bundle.Validations
+		case manifestsFile:
+			target = &bundle.Manifests
+		case operatorsFile:
+			target = &bundle.Operators
+		case installConfigFile:
+			bundle.InstallConfig = data
+			continue
+		default:
+			continue
+		}
+		if err = json.Unmarshal(data, target); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s", header.Name)
+		}
+	}
+
+	if bundle.Metadata.Version == 0 || bundle.Metadata.Version > FormatVersion {
+		return nil, errors.Errorf("unsupported bundle version %d", bundle.Metadata.Version)
+	}
+	if bundle.Cluster == nil || bundle.Cluster.ID == nil {
+		return nil, errors.Errorf("bundle has no cluster")
+	}
+	return bundle, nil
+}
+
+// Load inserts the records of the bundle into the database. The cluster must not exist in the database.
+func Load(db *gorm.DB, bundle *Bundle) error {
+	clusterID := bundle.Cluster.ID.String()
+	var count int
+	if err := db.Unscoped().Model(&common.Cluster{}).Where("id = ?", clusterID).Count(&count).Error; err != nil {
+		return errors.Wrapf(err, "failed to look up cluster %s", clusterID)
+	}
+	if count > 0 {
+		return errors.Errorf("cluster %s already exists", clusterID)
+	}
+
+	tx := db.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+	if err := load(tx, bundle); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return errors.Wrapf(tx.Commit().Error, "failed to commit cluster %s", clusterID)
+}
+
+func load(tx *gorm.DB, bundle *Bundle) error {
+	clusterID := bundle.Cluster.ID.String()
+	cluster := *bundle.Cluster
+	cluster.Hosts = nil
+	cluster.MonitoredOperators = nil
+	if err := tx.Create(&cluster).Error; err != nil {
+		return errors.Wrapf(err, "failed to create cluster %s", clusterID)
+	}
+	for _, host := range bundle.Hosts {
+		if err := tx.Create(host).Error; err != nil {
+			return errors.Wrapf(err, "failed to create host %s of cluster %s", host.ID, clusterID)
+		}
+	}
+	for _, operator := range bundle.Operators {
+		if err := tx.Create(operator).Error; err != nil {
+			return errors.Wrapf(err, "failed to create operator %s of cluster %s", operator.Name, clusterID)
+		}
+	}
+	for _, event := range bundle.Events {
+		// Events are numbered by the database they are loaded into
+		event.ID = 0
+		if err := tx.Create(event).Error; err != nil {
+			return errors.Wrapf(err, "failed to create event of cluster %s", clusterID)
+		}
+	}
+	return nil
+}
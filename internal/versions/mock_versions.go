@@ -81,6 +81,21 @@ func (mr *MockHandlerMockRecorder) GetRHCOSImage(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRHCOSImage", reflect.TypeOf((*MockHandler)(nil).GetRHCOSImage), arg0)
 }
 
+// GetRHCOSImageSHA256 mocks base method
+func (m *MockHandler) GetRHCOSImageSHA256(arg0 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRHCOSImageSHA256", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRHCOSImageSHA256 indicates an expected call of GetRHCOSImageSHA256
+func (mr *MockHandlerMockRecorder) GetRHCOSImageSHA256(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRHCOSImageSHA256", reflect.TypeOf((*MockHandler)(nil).GetRHCOSImageSHA256), arg0)
+}
+
 // GetRHCOSRootFS mocks base method
 func (m *MockHandler) GetRHCOSRootFS(arg0 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -27,6 +27,7 @@ type Handler interface {
 	restapi.VersionsAPI
 	GetReleaseImage(openshiftVersion string) (string, error)
 	GetRHCOSImage(openshiftVersion string) (string, error)
+	GetRHCOSImageSHA256(openshiftVersion string) (string, error)
 	GetRHCOSRootFS(openshiftVersion string) (string, error)
 	GetRHCOSVersion(openshiftVersion string) (string, error)
 	GetReleaseVersion(openshiftVersion string) (string, error)
@@ -107,6 +108,19 @@ func (h *handler) GetRHCOSImage(openshiftVersion string) (string, error) {
 	return *h.openshiftVersions[versionKey].RhcosImage, nil
 }
 
+// Returns the SHA-256 digest the RHCOS image is verified against, which is empty when it is not configured
+func (h *handler) GetRHCOSImageSHA256(openshiftVersion string) (string, error) {
+	versionKey, err := h.GetKey(openshiftVersion)
+	if err != nil {
+		return "", err
+	}
+	if !h.IsOpenshiftVersionSupported(versionKey) {
+		return "", errors.Errorf("No rhcos image for unsupported openshift version %s", versionKey)
+	}
+
+	return h.openshiftVersions[versionKey].RhcosImageSha256, nil
+}
+
 func (h *handler) GetRHCOSRootFS(openshiftVersion string) (string, error) {
 	versionKey, err := h.GetKey(openshiftVersion)
 	if err != nil {
@@ -227,12 +241,13 @@ func (h *handler) AddOpenshiftVersion(ocpReleaseImage, pullSecret string) (*mode
 
 	// Create OpenshiftVersion according to fetched data
 	openshiftVersion := &models.OpenshiftVersion{
-		DisplayName:    &ocpReleaseVersion,
-		ReleaseImage:   &ocpReleaseImage,
-		ReleaseVersion: &ocpReleaseVersion,
-		RhcosImage:     versionFromCache.RhcosImage,
-		RhcosVersion:   versionFromCache.RhcosVersion,
-		SupportLevel:   &supportLevel,
+		DisplayName:      &ocpReleaseVersion,
+		ReleaseImage:     &ocpReleaseImage,
+		ReleaseVersion:   &ocpReleaseVersion,
+		RhcosImage:       versionFromCache.RhcosImage,
+		RhcosImageSha256: versionFromCache.RhcosImageSha256,
+		RhcosVersion:     versionFromCache.RhcosVersion,
+		SupportLevel:     &supportLevel,
 	}
 
 	// Store in map
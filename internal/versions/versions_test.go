@@ -36,7 +36,8 @@ var defaultOpenShiftVersions = models.OpenshiftVersions{
 		DisplayName:  swag.String("4.6-candidate"),
 		ReleaseImage: swag.String("release_4.6"), ReleaseVersion: swag.String("4.6-candidate"),
 		RhcosImage: swag.String("rhcos_4.6"), RhcosVersion: swag.String("version-46.123-0"),
-		SupportLevel: swag.String("newbie"),
+		RhcosImageSha256: "0a2b4b3c5d58f4e6b9c3a6c6e57d3f35a1d2e8d6c2c2f1f2d2b1d5e8c0c6c7a1",
+		SupportLevel:     swag.String("newbie"),
 	},
 }
 
@@ -186,6 +187,32 @@ var _ = Describe("list versions", func() {
 		})
 	})
 
+	Context("GetRHCOSImageSHA256", func() {
+		BeforeEach(func() {
+			openshiftVersions = &defaultOpenShiftVersions
+			h = NewHandler(logger, mockRelease, versions, *openshiftVersions, "")
+		})
+
+		It("default", func() {
+			for key := range *openshiftVersions {
+				rhcosImageSHA256, err := h.GetRHCOSImageSHA256(key)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(rhcosImageSHA256).Should(Equal((*openshiftVersions)[key].RhcosImageSha256))
+			}
+		})
+
+		It("not configured", func() {
+			rhcosImageSHA256, err := h.GetRHCOSImageSHA256("4.5")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rhcosImageSHA256).Should(BeEmpty())
+		})
+
+		It("unsupported_key", func() {
+			_, err := h.GetRHCOSImageSHA256("unsupported")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
 	Context("GetRHCOSVersion", func() {
 		var (
 			rhcosVersion string
@@ -35,6 +35,20 @@ func (m *MockInstallerAPI) EXPECT() *MockInstallerAPIMockRecorder {
 	return m.recorder
 }
 
+// AbortLogsUpload mocks base method
+func (m *MockInstallerAPI) AbortLogsUpload(arg0 context.Context, arg1 installer.AbortLogsUploadParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortLogsUpload", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// AbortLogsUpload indicates an expected call of AbortLogsUpload
+func (mr *MockInstallerAPIMockRecorder) AbortLogsUpload(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortLogsUpload", reflect.TypeOf((*MockInstallerAPI)(nil).AbortLogsUpload), arg0, arg1)
+}
+
 // CancelInstallation mocks base method
 func (m *MockInstallerAPI) CancelInstallation(arg0 context.Context, arg1 installer.CancelInstallationParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -63,6 +77,34 @@ func (mr *MockInstallerAPIMockRecorder) CompleteInstallation(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteInstallation", reflect.TypeOf((*MockInstallerAPI)(nil).CompleteInstallation), arg0, arg1)
 }
 
+// CompleteLogsUpload mocks base method
+func (m *MockInstallerAPI) CompleteLogsUpload(arg0 context.Context, arg1 installer.CompleteLogsUploadParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteLogsUpload", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// CompleteLogsUpload indicates an expected call of CompleteLogsUpload
+func (mr *MockInstallerAPIMockRecorder) CompleteLogsUpload(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteLogsUpload", reflect.TypeOf((*MockInstallerAPI)(nil).CompleteLogsUpload), arg0, arg1)
+}
+
+// CreateLogsUpload mocks base method
+func (m *MockInstallerAPI) CreateLogsUpload(arg0 context.Context, arg1 installer.CreateLogsUploadParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLogsUpload", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// CreateLogsUpload indicates an expected call of CreateLogsUpload
+func (mr *MockInstallerAPIMockRecorder) CreateLogsUpload(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLogsUpload", reflect.TypeOf((*MockInstallerAPI)(nil).CreateLogsUpload), arg0, arg1)
+}
+
 // DeregisterCluster mocks base method
 func (m *MockInstallerAPI) DeregisterCluster(arg0 context.Context, arg1 installer.DeregisterClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -77,6 +119,20 @@ func (mr *MockInstallerAPIMockRecorder) DeregisterCluster(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterCluster", reflect.TypeOf((*MockInstallerAPI)(nil).DeregisterCluster), arg0, arg1)
 }
 
+// DeregisterDeclaredHost mocks base method
+func (m *MockInstallerAPI) DeregisterDeclaredHost(arg0 context.Context, arg1 installer.DeregisterDeclaredHostParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterDeclaredHost", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// DeregisterDeclaredHost indicates an expected call of DeregisterDeclaredHost
+func (mr *MockInstallerAPIMockRecorder) DeregisterDeclaredHost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterDeclaredHost", reflect.TypeOf((*MockInstallerAPI)(nil).DeregisterDeclaredHost), arg0, arg1)
+}
+
 // DeregisterHost mocks base method
 func (m *MockInstallerAPI) DeregisterHost(arg0 context.Context, arg1 installer.DeregisterHostParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -147,6 +203,20 @@ func (mr *MockInstallerAPIMockRecorder) DownloadClusterISOHeaders(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadClusterISOHeaders", reflect.TypeOf((*MockInstallerAPI)(nil).DownloadClusterISOHeaders), arg0, arg1)
 }
 
+// DownloadClusterISOSignature mocks base method
+func (m *MockInstallerAPI) DownloadClusterISOSignature(arg0 context.Context, arg1 installer.DownloadClusterISOSignatureParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadClusterISOSignature", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// DownloadClusterISOSignature indicates an expected call of DownloadClusterISOSignature
+func (mr *MockInstallerAPIMockRecorder) DownloadClusterISOSignature(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadClusterISOSignature", reflect.TypeOf((*MockInstallerAPI)(nil).DownloadClusterISOSignature), arg0, arg1)
+}
+
 // DownloadClusterKubeconfig mocks base method
 func (m *MockInstallerAPI) DownloadClusterKubeconfig(arg0 context.Context, arg1 installer.DownloadClusterKubeconfigParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -175,6 +245,48 @@ func (mr *MockInstallerAPIMockRecorder) DownloadClusterLogs(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadClusterLogs", reflect.TypeOf((*MockInstallerAPI)(nil).DownloadClusterLogs), arg0, arg1)
 }
 
+// DownloadClusterPxeArtifact mocks base method
+func (m *MockInstallerAPI) DownloadClusterPxeArtifact(arg0 context.Context, arg1 installer.DownloadClusterPxeArtifactParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadClusterPxeArtifact", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// DownloadClusterPxeArtifact indicates an expected call of DownloadClusterPxeArtifact
+func (mr *MockInstallerAPIMockRecorder) DownloadClusterPxeArtifact(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadClusterPxeArtifact", reflect.TypeOf((*MockInstallerAPI)(nil).DownloadClusterPxeArtifact), arg0, arg1)
+}
+
+// DownloadClusterSupportBundle mocks base method
+func (m *MockInstallerAPI) DownloadClusterSupportBundle(arg0 context.Context, arg1 installer.DownloadClusterSupportBundleParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadClusterSupportBundle", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// DownloadClusterSupportBundle indicates an expected call of DownloadClusterSupportBundle
+func (mr *MockInstallerAPIMockRecorder) DownloadClusterSupportBundle(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadClusterSupportBundle", reflect.TypeOf((*MockInstallerAPI)(nil).DownloadClusterSupportBundle), arg0, arg1)
+}
+
+// DownloadDeclaredHostISO mocks base method
+func (m *MockInstallerAPI) DownloadDeclaredHostISO(arg0 context.Context, arg1 installer.DownloadDeclaredHostISOParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadDeclaredHostISO", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// DownloadDeclaredHostISO indicates an expected call of DownloadDeclaredHostISO
+func (mr *MockInstallerAPIMockRecorder) DownloadDeclaredHostISO(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadDeclaredHostISO", reflect.TypeOf((*MockInstallerAPI)(nil).DownloadDeclaredHostISO), arg0, arg1)
+}
+
 // DownloadHostIgnition mocks base method
 func (m *MockInstallerAPI) DownloadHostIgnition(arg0 context.Context, arg1 installer.DownloadHostIgnitionParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -203,6 +315,34 @@ func (mr *MockInstallerAPIMockRecorder) DownloadHostLogs(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadHostLogs", reflect.TypeOf((*MockInstallerAPI)(nil).DownloadHostLogs), arg0, arg1)
 }
 
+// DownloadImageSigningKey mocks base method
+func (m *MockInstallerAPI) DownloadImageSigningKey(arg0 context.Context, arg1 installer.DownloadImageSigningKeyParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadImageSigningKey", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// DownloadImageSigningKey indicates an expected call of DownloadImageSigningKey
+func (mr *MockInstallerAPIMockRecorder) DownloadImageSigningKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadImageSigningKey", reflect.TypeOf((*MockInstallerAPI)(nil).DownloadImageSigningKey), arg0, arg1)
+}
+
+// DownloadLogsArchiveEntry mocks base method
+func (m *MockInstallerAPI) DownloadLogsArchiveEntry(arg0 context.Context, arg1 installer.DownloadLogsArchiveEntryParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadLogsArchiveEntry", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// DownloadLogsArchiveEntry indicates an expected call of DownloadLogsArchiveEntry
+func (mr *MockInstallerAPIMockRecorder) DownloadLogsArchiveEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadLogsArchiveEntry", reflect.TypeOf((*MockInstallerAPI)(nil).DownloadLogsArchiveEntry), arg0, arg1)
+}
+
 // EnableHost mocks base method
 func (m *MockInstallerAPI) EnableHost(arg0 context.Context, arg1 installer.EnableHostParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -231,6 +371,34 @@ func (mr *MockInstallerAPIMockRecorder) GenerateClusterISO(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateClusterISO", reflect.TypeOf((*MockInstallerAPI)(nil).GenerateClusterISO), arg0, arg1)
 }
 
+// GenerateDeclaredHostISO mocks base method
+func (m *MockInstallerAPI) GenerateDeclaredHostISO(arg0 context.Context, arg1 installer.GenerateDeclaredHostISOParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateDeclaredHostISO", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GenerateDeclaredHostISO indicates an expected call of GenerateDeclaredHostISO
+func (mr *MockInstallerAPIMockRecorder) GenerateDeclaredHostISO(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateDeclaredHostISO", reflect.TypeOf((*MockInstallerAPI)(nil).GenerateDeclaredHostISO), arg0, arg1)
+}
+
+// GetArchivedCluster mocks base method
+func (m *MockInstallerAPI) GetArchivedCluster(arg0 context.Context, arg1 installer.GetArchivedClusterParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivedCluster", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetArchivedCluster indicates an expected call of GetArchivedCluster
+func (mr *MockInstallerAPIMockRecorder) GetArchivedCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedCluster", reflect.TypeOf((*MockInstallerAPI)(nil).GetArchivedCluster), arg0, arg1)
+}
+
 // GetCluster mocks base method
 func (m *MockInstallerAPI) GetCluster(arg0 context.Context, arg1 installer.GetClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -287,6 +455,20 @@ func (mr *MockInstallerAPIMockRecorder) GetClusterInstallConfig(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterInstallConfig", reflect.TypeOf((*MockInstallerAPI)(nil).GetClusterInstallConfig), arg0, arg1)
 }
 
+// GetClusterLogsAnalysis mocks base method
+func (m *MockInstallerAPI) GetClusterLogsAnalysis(arg0 context.Context, arg1 installer.GetClusterLogsAnalysisParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterLogsAnalysis", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetClusterLogsAnalysis indicates an expected call of GetClusterLogsAnalysis
+func (mr *MockInstallerAPIMockRecorder) GetClusterLogsAnalysis(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterLogsAnalysis", reflect.TypeOf((*MockInstallerAPI)(nil).GetClusterLogsAnalysis), arg0, arg1)
+}
+
 // GetCredentials mocks base method
 func (m *MockInstallerAPI) GetCredentials(arg0 context.Context, arg1 installer.GetCredentialsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -357,6 +539,20 @@ func (mr *MockInstallerAPIMockRecorder) GetHostIgnition(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostIgnition", reflect.TypeOf((*MockInstallerAPI)(nil).GetHostIgnition), arg0, arg1)
 }
 
+// GetHostIgnitionPreview mocks base method
+func (m *MockInstallerAPI) GetHostIgnitionPreview(arg0 context.Context, arg1 installer.GetHostIgnitionPreviewParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostIgnitionPreview", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetHostIgnitionPreview indicates an expected call of GetHostIgnitionPreview
+func (mr *MockInstallerAPIMockRecorder) GetHostIgnitionPreview(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostIgnitionPreview", reflect.TypeOf((*MockInstallerAPI)(nil).GetHostIgnitionPreview), arg0, arg1)
+}
+
 // GetHostRequirements mocks base method
 func (m *MockInstallerAPI) GetHostRequirements(arg0 context.Context, arg1 installer.GetHostRequirementsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -371,6 +567,20 @@ func (mr *MockInstallerAPIMockRecorder) GetHostRequirements(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostRequirements", reflect.TypeOf((*MockInstallerAPI)(nil).GetHostRequirements), arg0, arg1)
 }
 
+// GetLogsUpload mocks base method
+func (m *MockInstallerAPI) GetLogsUpload(arg0 context.Context, arg1 installer.GetLogsUploadParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLogsUpload", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetLogsUpload indicates an expected call of GetLogsUpload
+func (mr *MockInstallerAPIMockRecorder) GetLogsUpload(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogsUpload", reflect.TypeOf((*MockInstallerAPI)(nil).GetLogsUpload), arg0, arg1)
+}
+
 // GetNextSteps mocks base method
 func (m *MockInstallerAPI) GetNextSteps(arg0 context.Context, arg1 installer.GetNextStepsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -413,6 +623,20 @@ func (mr *MockInstallerAPIMockRecorder) GetPresignedForClusterFiles(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresignedForClusterFiles", reflect.TypeOf((*MockInstallerAPI)(nil).GetPresignedForClusterFiles), arg0, arg1)
 }
 
+// GetQuota mocks base method
+func (m *MockInstallerAPI) GetQuota(arg0 context.Context, arg1 installer.GetQuotaParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuota", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetQuota indicates an expected call of GetQuota
+func (mr *MockInstallerAPIMockRecorder) GetQuota(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuota", reflect.TypeOf((*MockInstallerAPI)(nil).GetQuota), arg0, arg1)
+}
+
 // InstallCluster mocks base method
 func (m *MockInstallerAPI) InstallCluster(arg0 context.Context, arg1 installer.InstallClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -469,6 +693,20 @@ func (mr *MockInstallerAPIMockRecorder) ListClusters(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockInstallerAPI)(nil).ListClusters), arg0, arg1)
 }
 
+// ListDeclaredHosts mocks base method
+func (m *MockInstallerAPI) ListDeclaredHosts(arg0 context.Context, arg1 installer.ListDeclaredHostsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeclaredHosts", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ListDeclaredHosts indicates an expected call of ListDeclaredHosts
+func (mr *MockInstallerAPIMockRecorder) ListDeclaredHosts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeclaredHosts", reflect.TypeOf((*MockInstallerAPI)(nil).ListDeclaredHosts), arg0, arg1)
+}
+
 // ListHosts mocks base method
 func (m *MockInstallerAPI) ListHosts(arg0 context.Context, arg1 installer.ListHostsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -483,6 +721,34 @@ func (mr *MockInstallerAPIMockRecorder) ListHosts(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHosts", reflect.TypeOf((*MockInstallerAPI)(nil).ListHosts), arg0, arg1)
 }
 
+// ListLogsArchiveEntries mocks base method
+func (m *MockInstallerAPI) ListLogsArchiveEntries(arg0 context.Context, arg1 installer.ListLogsArchiveEntriesParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLogsArchiveEntries", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ListLogsArchiveEntries indicates an expected call of ListLogsArchiveEntries
+func (mr *MockInstallerAPIMockRecorder) ListLogsArchiveEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLogsArchiveEntries", reflect.TypeOf((*MockInstallerAPI)(nil).ListLogsArchiveEntries), arg0, arg1)
+}
+
+// ListQuotas mocks base method
+func (m *MockInstallerAPI) ListQuotas(arg0 context.Context, arg1 installer.ListQuotasParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQuotas", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ListQuotas indicates an expected call of ListQuotas
+func (mr *MockInstallerAPIMockRecorder) ListQuotas(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuotas", reflect.TypeOf((*MockInstallerAPI)(nil).ListQuotas), arg0, arg1)
+}
+
 // PostStepReply mocks base method
 func (m *MockInstallerAPI) PostStepReply(arg0 context.Context, arg1 installer.PostStepReplyParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -525,6 +791,20 @@ func (mr *MockInstallerAPIMockRecorder) RegisterCluster(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCluster", reflect.TypeOf((*MockInstallerAPI)(nil).RegisterCluster), arg0, arg1)
 }
 
+// RegisterDeclaredHost mocks base method
+func (m *MockInstallerAPI) RegisterDeclaredHost(arg0 context.Context, arg1 installer.RegisterDeclaredHostParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterDeclaredHost", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// RegisterDeclaredHost indicates an expected call of RegisterDeclaredHost
+func (mr *MockInstallerAPIMockRecorder) RegisterDeclaredHost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDeclaredHost", reflect.TypeOf((*MockInstallerAPI)(nil).RegisterDeclaredHost), arg0, arg1)
+}
+
 // RegisterHost mocks base method
 func (m *MockInstallerAPI) RegisterHost(arg0 context.Context, arg1 installer.RegisterHostParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -581,6 +861,34 @@ func (mr *MockInstallerAPIMockRecorder) ResetHostValidation(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetHostValidation", reflect.TypeOf((*MockInstallerAPI)(nil).ResetHostValidation), arg0, arg1)
 }
 
+// RestoreArchivedCluster mocks base method
+func (m *MockInstallerAPI) RestoreArchivedCluster(arg0 context.Context, arg1 installer.RestoreArchivedClusterParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreArchivedCluster", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// RestoreArchivedCluster indicates an expected call of RestoreArchivedCluster
+func (mr *MockInstallerAPIMockRecorder) RestoreArchivedCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreArchivedCluster", reflect.TypeOf((*MockInstallerAPI)(nil).RestoreArchivedCluster), arg0, arg1)
+}
+
+// SearchLogsArchive mocks base method
+func (m *MockInstallerAPI) SearchLogsArchive(arg0 context.Context, arg1 installer.SearchLogsArchiveParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchLogsArchive", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// SearchLogsArchive indicates an expected call of SearchLogsArchive
+func (mr *MockInstallerAPIMockRecorder) SearchLogsArchive(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchLogsArchive", reflect.TypeOf((*MockInstallerAPI)(nil).SearchLogsArchive), arg0, arg1)
+}
+
 // UpdateCluster mocks base method
 func (m *MockInstallerAPI) UpdateCluster(arg0 context.Context, arg1 installer.UpdateClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -693,6 +1001,20 @@ func (mr *MockInstallerAPIMockRecorder) UpdateHostLogsProgress(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHostLogsProgress", reflect.TypeOf((*MockInstallerAPI)(nil).UpdateHostLogsProgress), arg0, arg1)
 }
 
+// UpdateQuotaLimits mocks base method
+func (m *MockInstallerAPI) UpdateQuotaLimits(arg0 context.Context, arg1 installer.UpdateQuotaLimitsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQuotaLimits", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// UpdateQuotaLimits indicates an expected call of UpdateQuotaLimits
+func (mr *MockInstallerAPIMockRecorder) UpdateQuotaLimits(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuotaLimits", reflect.TypeOf((*MockInstallerAPI)(nil).UpdateQuotaLimits), arg0, arg1)
+}
+
 // UploadClusterIngressCert mocks base method
 func (m *MockInstallerAPI) UploadClusterIngressCert(arg0 context.Context, arg1 installer.UploadClusterIngressCertParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -734,3 +1056,17 @@ func (mr *MockInstallerAPIMockRecorder) UploadLogs(arg0, arg1 interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadLogs", reflect.TypeOf((*MockInstallerAPI)(nil).UploadLogs), arg0, arg1)
 }
+// UploadLogsChunk mocks base method
+func (m *MockInstallerAPI) UploadLogsChunk(arg0 context.Context, arg1 installer.UploadLogsChunkParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadLogsChunk", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// UploadLogsChunk indicates an expected call of UploadLogsChunk
+func (mr *MockInstallerAPIMockRecorder) UploadLogsChunk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadLogsChunk", reflect.TypeOf((*MockInstallerAPI)(nil).UploadLogsChunk), arg0, arg1)
+}
+
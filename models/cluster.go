@@ -23,6 +23,9 @@ type Cluster struct {
 	// A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.
 	AdditionalNtpSource string `json:"additional_ntp_source,omitempty"`
 
+	// PEM-encoded X.509 certificate bundle. Hosts discovered by the service and the installed cluster trust the certificates in the bundle.
+	AdditionalTrustBundle string `json:"additional_trust_bundle,omitempty" gorm:"type:text"`
+
 	// Unique identifier of the AMS subscription in OCM.
 	// Format: uuid
 	AmsSubscriptionID strfmt.UUID `json:"ams_subscription_id,omitempty"`
@@ -46,6 +49,9 @@ type Cluster struct {
 	// Minimum: 1
 	ClusterNetworkHostPrefix int64 `json:"cluster_network_host_prefix,omitempty"`
 
+	// Cluster networks that are associated with this cluster.
+	ClusterNetworks []*ClusterNetwork `json:"cluster_networks" gorm:"foreignkey:ClusterID;association_foreignkey:ID"`
+
 	// Json formatted string containing the majority groups for connectivity checks.
 	ConnectivityMajorityGroups string `json:"connectivity_majority_groups,omitempty" gorm:"type:text"`
 
@@ -145,6 +151,9 @@ type Cluster struct {
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	MachineNetworkCidr string `json:"machine_network_cidr,omitempty"`
 
+	// Machine networks that are associated with this cluster.
+	MachineNetworks []*MachineNetwork `json:"machine_networks" gorm:"foreignkey:ClusterID;association_foreignkey:ID"`
+
 	// Operators that are associated with this cluster.
 	MonitoredOperators []*MonitoredOperator `json:"monitored_operators" gorm:"foreignkey:ClusterID;association_foreignkey:ID"`
 
@@ -177,6 +186,9 @@ type Cluster struct {
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	ServiceNetworkCidr string `json:"service_network_cidr,omitempty"`
 
+	// Service networks that are associated with this cluster.
+	ServiceNetworks []*ServiceNetwork `json:"service_networks" gorm:"foreignkey:ClusterID;association_foreignkey:ID"`
+
 	// SSH public key for debugging OpenShift nodes.
 	SSHPublicKey string `json:"ssh_public_key,omitempty"`
 
@@ -233,6 +245,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateClusterNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateControllerLogsCollectedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -301,6 +317,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMachineNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMonitoredOperators(formats); err != nil {
 		res = append(res, err)
 	}
@@ -313,6 +333,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateServiceNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStatus(formats); err != nil {
 		res = append(res, err)
 	}
@@ -391,6 +415,31 @@ func (m *Cluster) validateClusterNetworkHostPrefix(formats strfmt.Registry) erro
 	return nil
 }
 
+func (m *Cluster) validateClusterNetworks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ClusterNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ClusterNetworks); i++ {
+		if swag.IsZero(m.ClusterNetworks[i]) { // not required
+			continue
+		}
+
+		if m.ClusterNetworks[i] != nil {
+			if err := m.ClusterNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("cluster_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateControllerLogsCollectedAt(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ControllerLogsCollectedAt) { // not required
@@ -736,6 +785,31 @@ func (m *Cluster) validateMachineNetworkCidr(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateMachineNetworks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MachineNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.MachineNetworks); i++ {
+		if swag.IsZero(m.MachineNetworks[i]) { // not required
+			continue
+		}
+
+		if m.MachineNetworks[i] != nil {
+			if err := m.MachineNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("machine_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateMonitoredOperators(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.MonitoredOperators) { // not required
@@ -787,6 +861,31 @@ func (m *Cluster) validateServiceNetworkCidr(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateServiceNetworks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ServiceNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ServiceNetworks); i++ {
+		if swag.IsZero(m.ServiceNetworks[i]) { // not required
+			continue
+		}
+
+		if m.ServiceNetworks[i] != nil {
+			if err := m.ServiceNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("service_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 var clusterTypeStatusPropEnum []interface{}
 
 func init() {
@@ -20,6 +20,9 @@ import (
 // swagger:model host
 type Host struct {
 
+	// Json formatted string containing the result of the last attempt to upgrade the discovery agent of the host.
+	AgentUpgradeStatus string `json:"agent_upgrade_status,omitempty" gorm:"type:text"`
+
 	// api vip connectivity
 	APIVipConnectivity string `json:"api_vip_connectivity,omitempty" gorm:"type:text"`
 
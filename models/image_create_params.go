@@ -21,9 +21,15 @@ type ImageCreateParams struct {
 	// Type of image that should be generated.
 	ImageType ImageType `json:"image_type,omitempty"`
 
+	// Kernel argument operations applied to the discovery image.
+	KernelArguments KernelArguments `json:"kernel_arguments,omitempty"`
+
 	// SSH public key for debugging the installation.
 	SSHPublicKey string `json:"ssh_public_key,omitempty"`
 
+	// Pool of static IP addresses from which the per-host static network configuration is generated. Cannot be set together with static_network_config.
+	StaticIPPool *StaticIPPool `json:"static_ip_pool,omitempty"`
+
 	// static network config
 	StaticNetworkConfig []*HostStaticNetworkConfig `json:"static_network_config"`
 }
@@ -36,6 +42,14 @@ func (m *ImageCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateKernelArguments(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStaticIPPool(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStaticNetworkConfig(formats); err != nil {
 		res = append(res, err)
 	}
@@ -62,6 +76,40 @@ func (m *ImageCreateParams) validateImageType(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ImageCreateParams) validateKernelArguments(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.KernelArguments) { // not required
+		return nil
+	}
+
+	if err := m.KernelArguments.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("kernel_arguments")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ImageCreateParams) validateStaticIPPool(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.StaticIPPool) { // not required
+		return nil
+	}
+
+	if m.StaticIPPool != nil {
+		if err := m.StaticIPPool.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("static_ip_pool")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ImageCreateParams) validateStaticNetworkConfig(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.StaticNetworkConfig) { // not required
@@ -17,6 +17,9 @@ import (
 // swagger:model image_info
 type ImageInfo struct {
 
+	// Hex encoded SHA-256 digest of the discovery image.
+	Checksum string `json:"checksum,omitempty"`
+
 	// created at
 	// Format: date-time
 	CreatedAt strfmt.DateTime `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
@@ -31,6 +34,9 @@ type ImageInfo struct {
 	// Image generator version.
 	GeneratorVersion string `json:"generator_version,omitempty"`
 
+	// JSON formatted list of the kernel argument operations applied to the discovery image
+	KernelArguments string `json:"kernel_arguments,omitempty"`
+
 	// size bytes
 	// Minimum: 0
 	SizeBytes *int64 `json:"size_bytes,omitempty"`
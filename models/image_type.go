@@ -25,6 +25,15 @@ const (
 
 	// ImageTypeMinimalIso captures enum value "minimal-iso"
 	ImageTypeMinimalIso ImageType = "minimal-iso"
+
+	// ImageTypePxe captures enum value "pxe"
+	ImageTypePxe ImageType = "pxe"
+
+	// ImageTypeRawDisk captures enum value "raw-disk"
+	ImageTypeRawDisk ImageType = "raw-disk"
+
+	// ImageTypeQcow2 captures enum value "qcow2"
+	ImageTypeQcow2 ImageType = "qcow2"
 )
 
 // for schema
@@ -32,7 +41,7 @@ var imageTypeEnum []interface{}
 
 func init() {
 	var res []ImageType
-	if err := json.Unmarshal([]byte(`["full-iso","minimal-iso"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["full-iso","minimal-iso","pxe","raw-disk","qcow2"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
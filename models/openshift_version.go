@@ -38,6 +38,10 @@ type OpenshiftVersion struct {
 	// Required: true
 	RhcosImage *string `json:"rhcos_image"`
 
+	// SHA-256 digest of the base RHCOS image, which is verified before the image is used.
+	// Pattern: ^[a-f0-9]{64}$
+	RhcosImageSha256 string `json:"rhcos_image_sha256,omitempty"`
+
 	// The RHCOS rootfs url.
 	// Required: true
 	RhcosRootfs *string `json:"rhcos_rootfs"`
@@ -72,6 +76,10 @@ func (m *OpenshiftVersion) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRhcosImageSha256(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRhcosRootfs(formats); err != nil {
 		res = append(res, err)
 	}
@@ -126,6 +134,19 @@ func (m *OpenshiftVersion) validateRhcosImage(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *OpenshiftVersion) validateRhcosImageSha256(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RhcosImageSha256) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("rhcos_image_sha256", "body", string(m.RhcosImageSha256), `^[a-f0-9]{64}$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *OpenshiftVersion) validateRhcosRootfs(formats strfmt.Registry) error {
 
 	if err := validate.Required("rhcos_rootfs", "body", m.RhcosRootfs); err != nil {
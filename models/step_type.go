@@ -55,6 +55,9 @@ const (
 
 	// StepTypeDomainResolution captures enum value "domain-resolution"
 	StepTypeDomainResolution StepType = "domain-resolution"
+
+	// StepTypeUpgradeAgent captures enum value "upgrade-agent"
+	StepTypeUpgradeAgent StepType = "upgrade-agent"
 )
 
 // for schema
@@ -62,7 +65,7 @@ var stepTypeEnum []interface{}
 
 func init() {
 	var res []StepType
-	if err := json.Unmarshal([]byte(`["connectivity-check","execute","inventory","install","free-network-addresses","reset-installation","dhcp-lease-allocate","api-vip-connectivity-check","ntp-synchronizer","installation-disk-speed-check","container-image-availability","domain-resolution"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["connectivity-check","execute","inventory","install","free-network-addresses","reset-installation","dhcp-lease-allocate","api-vip-connectivity-check","ntp-synchronizer","installation-disk-speed-check","container-image-availability","domain-resolution","upgrade-agent"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -40,6 +40,10 @@ func (f fakeInventory) DeregisterCluster(ctx context.Context, params installer.D
 	return installer.NewDeregisterClusterNoContent()
 }
 
+func (f fakeInventory) DeregisterDeclaredHost(ctx context.Context, params installer.DeregisterDeclaredHostParams) middleware.Responder {
+	return installer.NewDeregisterDeclaredHostNoContent()
+}
+
 func (f fakeInventory) DeregisterHost(ctx context.Context, params installer.DeregisterHostParams) middleware.Responder {
 	return installer.NewDeregisterHostNoContent()
 }
@@ -76,6 +80,42 @@ func (f fakeInventory) DownloadClusterISO(ctx context.Context, params installer.
 		0)
 }
 
+func (f fakeInventory) DownloadClusterISOSignature(ctx context.Context, params installer.DownloadClusterISOSignatureParams) middleware.Responder {
+	file, err := ioutil.TempFile("/tmp", "test.file")
+	if err != nil {
+		return installer.NewDownloadClusterISOSignatureInternalServerError().WithPayload(
+			common.GenerateError(http.StatusInternalServerError, err))
+	}
+	return filemiddleware.NewResponder(
+		installer.NewDownloadClusterISOSignatureOK().WithPayload(io.ReadCloser(file)),
+		"test",
+		0)
+}
+
+func (f fakeInventory) DownloadImageSigningKey(ctx context.Context, params installer.DownloadImageSigningKeyParams) middleware.Responder {
+	file, err := ioutil.TempFile("/tmp", "test.file")
+	if err != nil {
+		return installer.NewDownloadImageSigningKeyInternalServerError().WithPayload(
+			common.GenerateError(http.StatusInternalServerError, err))
+	}
+	return filemiddleware.NewResponder(
+		installer.NewDownloadImageSigningKeyOK().WithPayload(io.ReadCloser(file)),
+		"test",
+		0)
+}
+
+func (f fakeInventory) DownloadClusterPxeArtifact(ctx context.Context, params installer.DownloadClusterPxeArtifactParams) middleware.Responder {
+	file, err := ioutil.TempFile("/tmp", "test.file")
+	if err != nil {
+		return installer.NewDownloadClusterPxeArtifactInternalServerError().WithPayload(
+			common.GenerateError(http.StatusInternalServerError, err))
+	}
+	return filemiddleware.NewResponder(
+		installer.NewDownloadClusterPxeArtifactOK().WithPayload(io.ReadCloser(file)),
+		"test",
+		0)
+}
+
 func (f fakeInventory) DownloadClusterISOHeaders(ctx context.Context, params installer.DownloadClusterISOHeadersParams) middleware.Responder {
 	_, err := ioutil.TempFile("/tmp", "test.file")
 	if err != nil {
@@ -88,6 +128,18 @@ func (f fakeInventory) DownloadClusterISOHeaders(ctx context.Context, params ins
 		0)
 }
 
+func (f fakeInventory) DownloadDeclaredHostISO(ctx context.Context, params installer.DownloadDeclaredHostISOParams) middleware.Responder {
+	file, err := ioutil.TempFile("/tmp", "test.file")
+	if err != nil {
+		return installer.NewDownloadDeclaredHostISOInternalServerError().WithPayload(
+			common.GenerateError(http.StatusInternalServerError, err))
+	}
+	return filemiddleware.NewResponder(
+		installer.NewDownloadDeclaredHostISOOK().WithPayload(io.ReadCloser(file)),
+		"test",
+		0)
+}
+
 func (f fakeInventory) DownloadClusterKubeconfig(ctx context.Context, params installer.DownloadClusterKubeconfigParams) middleware.Responder {
 	file, err := ioutil.TempFile("/tmp", "test.file")
 	if err != nil {
@@ -104,6 +156,10 @@ func (f fakeInventory) EnableHost(ctx context.Context, params installer.EnableHo
 	return installer.NewEnableHostOK()
 }
 
+func (f fakeInventory) GenerateDeclaredHostISO(ctx context.Context, params installer.GenerateDeclaredHostISOParams) middleware.Responder {
+	return installer.NewGenerateDeclaredHostISOCreated()
+}
+
 func (f fakeInventory) GenerateClusterISO(ctx context.Context, params installer.GenerateClusterISOParams) middleware.Responder {
 	return installer.NewGenerateClusterISOCreated()
 }
@@ -144,6 +200,10 @@ func (f fakeInventory) ListClusters(ctx context.Context, params installer.ListCl
 	return installer.NewListClustersOK()
 }
 
+func (f fakeInventory) ListDeclaredHosts(ctx context.Context, params installer.ListDeclaredHostsParams) middleware.Responder {
+	return installer.NewListDeclaredHostsOK()
+}
+
 func (f fakeInventory) ListHosts(ctx context.Context, params installer.ListHostsParams) middleware.Responder {
 	return installer.NewListHostsOK()
 }
@@ -160,6 +220,10 @@ func (f fakeInventory) RegisterAddHostsCluster(ctx context.Context, params insta
 	return installer.NewRegisterAddHostsClusterCreated()
 }
 
+func (f fakeInventory) RegisterDeclaredHost(ctx context.Context, params installer.RegisterDeclaredHostParams) middleware.Responder {
+	return installer.NewRegisterDeclaredHostCreated()
+}
+
 func (f fakeInventory) RegisterHost(ctx context.Context, params installer.RegisterHostParams) middleware.Responder {
 	return installer.NewRegisterHostCreated()
 }
@@ -240,6 +304,18 @@ func (f fakeInventory) DownloadClusterLogs(ctx context.Context, params installer
 		0)
 }
 
+func (f fakeInventory) DownloadClusterSupportBundle(ctx context.Context, params installer.DownloadClusterSupportBundleParams) middleware.Responder {
+	file, err := ioutil.TempFile("/tmp", "test.file")
+	if err != nil {
+		return installer.NewDownloadClusterSupportBundleInternalServerError().WithPayload(
+			common.GenerateError(http.StatusInternalServerError, err))
+	}
+	return filemiddleware.NewResponder(
+		installer.NewDownloadClusterSupportBundleOK().WithPayload(io.ReadCloser(file)),
+		"test",
+		0)
+}
+
 func (f fakeInventory) GetDiscoveryIgnition(ctx context.Context, params installer.GetDiscoveryIgnitionParams) middleware.Responder {
 	return installer.NewGetDiscoveryIgnitionOK()
 }
@@ -256,6 +332,10 @@ func (f fakeInventory) GetHostIgnition(ctx context.Context, params installer.Get
 	return installer.NewGetHostIgnitionOK()
 }
 
+func (f fakeInventory) GetHostIgnitionPreview(ctx context.Context, params installer.GetHostIgnitionPreviewParams) middleware.Responder {
+	return installer.NewGetHostIgnitionPreviewOK()
+}
+
 func (f fakeInventory) DownloadHostIgnition(ctx context.Context, params installer.DownloadHostIgnitionParams) middleware.Responder {
 	file, err := ioutil.TempFile("/tmp", "test.file")
 	if err != nil {
@@ -276,6 +356,70 @@ func (f fakeInventory) GetClusterHostRequirements(ctx context.Context, params in
 	return installer.NewGetClusterHostRequirementsOK().WithPayload(models.ClusterHostRequirementsList{})
 }
 
+func (f fakeInventory) GetClusterLogsAnalysis(ctx context.Context, params installer.GetClusterLogsAnalysisParams) middleware.Responder {
+	return installer.NewGetClusterLogsAnalysisOK()
+}
+
+func (f fakeInventory) ListLogsArchiveEntries(ctx context.Context, params installer.ListLogsArchiveEntriesParams) middleware.Responder {
+	return installer.NewListLogsArchiveEntriesOK()
+}
+
+func (f fakeInventory) DownloadLogsArchiveEntry(ctx context.Context, params installer.DownloadLogsArchiveEntryParams) middleware.Responder {
+	file, err := ioutil.TempFile("/tmp", "test.file")
+	if err != nil {
+		return installer.NewDownloadLogsArchiveEntryInternalServerError().WithPayload(
+			common.GenerateError(http.StatusInternalServerError, err))
+	}
+	return filemiddleware.NewResponder(
+		installer.NewDownloadLogsArchiveEntryOK().WithPayload(io.ReadCloser(file)),
+		"test",
+		0)
+}
+
+func (f fakeInventory) SearchLogsArchive(ctx context.Context, params installer.SearchLogsArchiveParams) middleware.Responder {
+	return installer.NewSearchLogsArchiveOK()
+}
+
+func (f fakeInventory) CreateLogsUpload(ctx context.Context, params installer.CreateLogsUploadParams) middleware.Responder {
+	return installer.NewCreateLogsUploadCreated()
+}
+
+func (f fakeInventory) GetLogsUpload(ctx context.Context, params installer.GetLogsUploadParams) middleware.Responder {
+	return installer.NewGetLogsUploadOK()
+}
+
+func (f fakeInventory) AbortLogsUpload(ctx context.Context, params installer.AbortLogsUploadParams) middleware.Responder {
+	return installer.NewAbortLogsUploadNoContent()
+}
+
+func (f fakeInventory) UploadLogsChunk(ctx context.Context, params installer.UploadLogsChunkParams) middleware.Responder {
+	return installer.NewUploadLogsChunkNoContent()
+}
+
+func (f fakeInventory) CompleteLogsUpload(ctx context.Context, params installer.CompleteLogsUploadParams) middleware.Responder {
+	return installer.NewCompleteLogsUploadNoContent()
+}
+
+func (f fakeInventory) GetArchivedCluster(ctx context.Context, params installer.GetArchivedClusterParams) middleware.Responder {
+	return installer.NewGetArchivedClusterOK()
+}
+
+func (f fakeInventory) RestoreArchivedCluster(ctx context.Context, params installer.RestoreArchivedClusterParams) middleware.Responder {
+	return installer.NewRestoreArchivedClusterCreated()
+}
+
+func (f fakeInventory) ListQuotas(ctx context.Context, params installer.ListQuotasParams) middleware.Responder {
+	return installer.NewListQuotasOK()
+}
+
+func (f fakeInventory) GetQuota(ctx context.Context, params installer.GetQuotaParams) middleware.Responder {
+	return installer.NewGetQuotaOK()
+}
+
+func (f fakeInventory) UpdateQuotaLimits(ctx context.Context, params installer.UpdateQuotaLimitsParams) middleware.Responder {
+	return installer.NewUpdateQuotaLimitsOK()
+}
+
 func (f fakeInventory) ResetHostValidation(ctx context.Context, params installer.ResetHostValidationParams) middleware.Responder {
 	return installer.NewResetHostValidationOK()
 }
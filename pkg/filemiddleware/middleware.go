@@ -2,31 +2,76 @@ package filemiddleware
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 )
 
 func NewResponder(next middleware.Responder, fname string, length int64) middleware.Responder {
+	return NewResponderWithContentType(next, fname, "", length)
+}
+
+// NewResponderWithContentType responds with a file of the given media type
+func NewResponderWithContentType(next middleware.Responder, fname string, contentType string, length int64) middleware.Responder {
 	return &fileMiddlewareResponder{
-		next:     next,
-		fileName: fname,
-		length:   length,
+		next:        next,
+		fileName:    fname,
+		contentType: contentType,
+		length:      length,
 	}
 }
 
 type fileMiddlewareResponder struct {
-	next     middleware.Responder
-	fileName string
-	length   int64
+	next        middleware.Responder
+	fileName    string
+	contentType string
+	length      int64
 }
 
 func (f *fileMiddlewareResponder) WriteResponse(rw http.ResponseWriter, r runtime.Producer) {
 	rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", f.fileName))
+	if f.contentType != "" {
+		rw.Header().Set("Content-Type", f.contentType)
+	}
 	if f.length != 0 {
 		rw.Header().Set("Content-Length", strconv.FormatInt(f.length, 10))
 	}
 	f.next.WriteResponse(rw, r)
 }
+
+// NewRangeResponder serves content that can be read from any offset, such that the Range header of the request
+// is honored
+func NewRangeResponder(content io.ReadSeeker, fname string, contentType string, modTime time.Time, request *http.Request) middleware.Responder {
+	return &rangeResponder{
+		content:     content,
+		fileName:    fname,
+		contentType: contentType,
+		modTime:     modTime,
+		request:     request,
+	}
+}
+
+type rangeResponder struct {
+	content     io.ReadSeeker
+	fileName    string
+	contentType string
+	modTime     time.Time
+	request     *http.Request
+}
+
+func (f *rangeResponder) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	if closer, ok := f.content.(io.Closer); ok {
+		defer closer.Close()
+	}
+	request := f.request
+	if request == nil {
+		request = &http.Request{Method: http.MethodGet, Header: http.Header{}}
+	}
+	rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", f.fileName))
+	rw.Header().Set("Content-Type", f.contentType)
+	http.ServeContent(rw, request, f.fileName, f.modTime, f.content)
+}
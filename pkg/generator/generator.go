@@ -89,20 +89,13 @@ func (k *installGenerator) GenerateInstallConfig(ctx context.Context, cluster co
 	if k.Config.DummyIgnition {
 		generator = ignition.NewDummyGenerator(clusterWorkDir, &cluster, k.s3Client, log)
 	} else {
-		generator = ignition.NewGenerator(clusterWorkDir, installerCacheDir, &cluster, releaseImage, k.Config.ReleaseImageMirror, k.Config.ServiceCACertPath, k.Config.InstallInvoker, k.s3Client, log, k.operatorsApi)
+		generator = ignition.NewGenerator(clusterWorkDir, installerCacheDir, &cluster, releaseImage, k.Config.ReleaseImageMirror, k.Config.ServiceCACertPath, k.Config.ServiceIPs, k.Config.InstallInvoker, k.s3Client, log, k.operatorsApi)
 	}
 	err = generator.Generate(ctx, cfg)
 	if err != nil {
 		return err
 	}
 
-	if k.Config.ServiceIPs != "" {
-		err = generator.UpdateEtcHosts(k.Config.ServiceIPs)
-		if err != nil {
-			return err
-		}
-	}
-
 	// upload files to S3
 	err = generator.UploadToS3(ctx)
 	if err != nil {
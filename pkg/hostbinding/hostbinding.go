@@ -0,0 +1,83 @@
+package hostbinding
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+type bindingTokenKey string
+
+const (
+	// pathPrefix prefixes the paths of the requests of agents that booted the discovery image of a declared host,
+	// followed by the binding token of the image
+	pathPrefix                 = "/declared-hosts/"
+	ctxKey     bindingTokenKey = "declared-host-binding-token"
+	tokenBytes                 = 32
+)
+
+// NewToken returns a random binding token. The token is only carried by the discovery image of a declared host, so
+// only the hosts that booted the image can be bound to the declared host.
+func NewToken() (string, error) {
+	token := make([]byte, tokenBytes)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
+
+// URL returns the service URL that the agent of the discovery image with the binding token connects to
+func URL(serviceBaseURL, token string) string {
+	return strings.TrimSuffix(strings.TrimSpace(serviceBaseURL), "/") + pathPrefix + token
+}
+
+// Middleware wraps an http handler.
+// Requests under the path prefix of a binding token are passed to the inner handler without the prefix, and the
+// token is injected into the request context.
+// Other requests are passed as is.
+func Middleware(inner http.Handler) http.Handler {
+	return handler{inner: inner}
+}
+
+// FromContext returns the binding token stored in the context, or an empty token if the request was not made by the
+// agent of the discovery image of a declared host
+func FromContext(ctx context.Context) string {
+	token := ctx.Value(ctxKey)
+	if token == nil {
+		return ""
+	}
+	return token.(string)
+}
+
+func ToContext(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, ctxKey, token)
+}
+
+func isToken(s string) bool {
+	decoded, err := hex.DecodeString(s)
+	return err == nil && len(decoded) == tokenBytes
+}
+
+type handler struct {
+	inner http.Handler
+}
+
+func (h handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, pathPrefix) {
+		h.inner.ServeHTTP(w, r)
+		return
+	}
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, pathPrefix), "/", 2)
+	if len(parts) != 2 || !isToken(parts[0]) {
+		http.NotFound(w, r)
+		return
+	}
+	r = r.WithContext(ToContext(r.Context(), parts[0]))
+	u := *r.URL
+	u.Path = "/" + parts[1]
+	u.RawPath = ""
+	r.URL = &u
+	h.inner.ServeHTTP(w, r)
+}
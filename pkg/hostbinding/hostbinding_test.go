@@ -0,0 +1,69 @@
+package hostbinding
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestHostBinding(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Host binding Suite")
+}
+
+var _ = Describe("Middleware", func() {
+	var (
+		token   string
+		path    string
+		tokenIn string
+		called  bool
+		h       http.Handler
+	)
+
+	BeforeEach(func() {
+		var err error
+		token, err = NewToken()
+		Expect(err).NotTo(HaveOccurred())
+		called = false
+		h = Middleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			called = true
+			path = r.URL.Path
+			tokenIn = FromContext(r.Context())
+		}))
+	})
+
+	It("strips the prefix of a binding token", func() {
+		serviceURL := URL("http://example.org:8090/ ", token)
+		Expect(serviceURL).To(Equal("http://example.org:8090/declared-hosts/" + token))
+
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, serviceURL+"/api/assisted-install/v1/clusters", nil))
+		Expect(called).To(BeTrue())
+		Expect(path).To(Equal("/api/assisted-install/v1/clusters"))
+		Expect(tokenIn).To(Equal(token))
+	})
+
+	It("passes other requests as is", func() {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "http://example.org/api/assisted-install/v1/clusters", nil))
+		Expect(called).To(BeTrue())
+		Expect(path).To(Equal("/api/assisted-install/v1/clusters"))
+		Expect(tokenIn).To(BeEmpty())
+	})
+
+	It("rejects a malformed binding token", func() {
+		for _, malformed := range []string{"not-a-token", "4ad1bd8c-1f6c-4b2a-9c51-8a2c86f4b1ad", token[:62]} {
+			recorder := httptest.NewRecorder()
+			h.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://example.org/declared-hosts/"+malformed+"/api/assisted-install/v1/clusters", nil))
+			Expect(called).To(BeFalse())
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		}
+	})
+
+	It("creates distinct tokens", func() {
+		other, err := NewToken()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(other).NotTo(Equal(token))
+	})
+})
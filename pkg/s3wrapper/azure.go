@@ -0,0 +1,527 @@
+package s3wrapper
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/isoeditor"
+	"github.com/openshift/assisted-service/internal/versions"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	azureEndpointTemplate = "https://%s.blob.core.windows.net"
+	azureEndpointSuffix   = ".blob.core.windows.net"
+	azureUploadBufferSize = 8 * 1024 * 1024
+	azureUploadMaxBuffers = 4
+	// The storage service reads the base ISO when it is copied, using a short lived SAS
+	azureCopySourceSASDuration = time.Hour
+)
+
+type AzureConfig struct {
+	AccountName string `envconfig:"AZURE_STORAGE_ACCOUNT"`
+	AccountKey  string `envconfig:"AZURE_STORAGE_ACCOUNT_KEY"`
+	// EndpointURL overrides the blob service endpoint of the account, e.g. http://127.0.0.1:10000/devstoreaccount1 for Azurite
+	EndpointURL string `envconfig:"AZURE_STORAGE_ENDPOINT_URL"`
+	Container   string `envconfig:"AZURE_STORAGE_CONTAINER"`
+
+	// Warning - the files stored in this container should only be RHCOS image files that are readily available
+	// on the Internet, see the public S3 bucket
+	PublicContainer string `envconfig:"AZURE_STORAGE_CONTAINER_PUBLIC"`
+}
+
+var _ API = &AzureClient{}
+
+// AzureClient stores objects as block blobs in Azure Blob Storage
+type AzureClient struct {
+	log              logrus.FieldLogger
+	cfg              *AzureConfig
+	credential       *azblob.SharedKeyCredential
+	container        azblob.ContainerURL
+	publicContainer  azblob.ContainerURL
+	areaCache        embeddedAreaCache
+	versionsHandler  versions.Handler
+	isoEditorFactory isoeditor.Factory
+}
+
+// NewAzureClient creates a client of the containers of a storage account, authenticated with the account key
+func NewAzureClient(cfg *AzureConfig, logger logrus.FieldLogger, versionsHandler versions.Handler, isoEditorFactory isoeditor.Factory) *AzureClient {
+	credential, err := azblob.NewSharedKeyCredential(cfg.AccountName, cfg.AccountKey)
+	if err != nil {
+		logger.WithError(err).Error("failed to create Azure storage credential")
+		return nil
+	}
+
+	endpoint := cfg.EndpointURL
+	if endpoint == "" {
+		endpoint = fmt.Sprintf(azureEndpointTemplate, cfg.AccountName)
+	}
+	serviceURL, err := url.Parse(endpoint)
+	if err != nil {
+		logger.WithError(err).Errorf("invalid Azure storage endpoint %s", endpoint)
+		return nil
+	}
+
+	service := azblob.NewServiceURL(*serviceURL, azblob.NewPipeline(credential, azblob.PipelineOptions{}))
+	return &AzureClient{
+		log:              logger,
+		cfg:              cfg,
+		credential:       credential,
+		container:        service.NewContainerURL(cfg.Container),
+		publicContainer:  service.NewContainerURL(cfg.PublicContainer),
+		versionsHandler:  versionsHandler,
+		isoEditorFactory: isoEditorFactory,
+	}
+}
+
+// IsAwsS3 returns whether the storage is reachable by clients, which is only assumed for the Azure endpoints
+func (c *AzureClient) IsAwsS3() bool {
+	return c.cfg.EndpointURL == "" || strings.HasSuffix(strings.TrimSuffix(c.cfg.EndpointURL, "/"), azureEndpointSuffix)
+}
+
+func isAzureServiceCode(err error, code azblob.ServiceCodeType) bool {
+	if serr, ok := err.(azblob.StorageError); ok {
+		return serr.ServiceCode() == code
+	}
+	return false
+}
+
+func (c *AzureClient) createContainer(container azblob.ContainerURL, name string) error {
+	_, err := container.Create(context.Background(), azblob.Metadata{}, azblob.PublicAccessNone)
+	if err != nil && !isAzureServiceCode(err, azblob.ServiceCodeContainerAlreadyExists) {
+		return errors.Wrapf(err, "Failed to create Azure storage container %s", name)
+	}
+	return nil
+}
+
+func (c *AzureClient) CreateBucket() error {
+	return c.createContainer(c.container, c.cfg.Container)
+}
+
+func (c *AzureClient) CreatePublicBucket() error {
+	return c.createContainer(c.publicContainer, c.cfg.PublicContainer)
+}
+
+func (c *AzureClient) uploadStream(ctx context.Context, reader io.Reader, objectName string, container azblob.ContainerURL, containerName string) error {
+	log := logutil.FromContext(ctx, c.log)
+	_, err := azblob.UploadStreamToBlockBlob(ctx, reader, container.NewBlockBlobURL(objectName), azblob.UploadStreamToBlockBlobOptions{
+		BufferSize: azureUploadBufferSize,
+		MaxBuffers: azureUploadMaxBuffers,
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "Unable to upload %s to container %s", objectName, containerName)
+		log.Error(err)
+		return err
+	}
+	log.Infof("Successfully uploaded %s to container %s", objectName, containerName)
+	return nil
+}
+
+func (c *AzureClient) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	return c.uploadStream(ctx, reader, objectName, c.container, c.cfg.Container)
+}
+
+func (c *AzureClient) UploadStreamToPublicBucket(ctx context.Context, reader io.Reader, objectName string) error {
+	return c.uploadStream(ctx, reader, objectName, c.publicContainer, c.cfg.PublicContainer)
+}
+
+func (c *AzureClient) uploadFile(ctx context.Context, filePath, objectName string, container azblob.ContainerURL, containerName string) error {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Uploading file %s as object %s to container %s", filePath, objectName, containerName)
+	file, err := os.Open(filePath)
+	if err != nil {
+		err = errors.Wrapf(err, "Unable to open file %s for upload", filePath)
+		log.Error(err)
+		return err
+	}
+	defer file.Close()
+
+	return c.uploadStream(ctx, bufio.NewReader(file), objectName, container, containerName)
+}
+
+func (c *AzureClient) UploadFile(ctx context.Context, filePath, objectName string) error {
+	return c.uploadFile(ctx, filePath, objectName, c.container, c.cfg.Container)
+}
+
+func (c *AzureClient) UploadFileToPublicBucket(ctx context.Context, filePath, objectName string) error {
+	return c.uploadFile(ctx, filePath, objectName, c.publicContainer, c.cfg.PublicContainer)
+}
+
+func (c *AzureClient) Upload(ctx context.Context, data []byte, objectName string) error {
+	return c.UploadStream(ctx, bytes.NewReader(data), objectName)
+}
+
+// ComposeObjects commits the objects as the blocks of a block blob, which the storage service copies from them
+func (c *AzureClient) ComposeObjects(ctx context.Context, sourceObjects []string, objectName string) error {
+	log := logutil.FromContext(ctx, c.log)
+	dest := c.container.NewBlockBlobURL(objectName)
+	blockIDs := make([]string, len(sourceObjects))
+	g, gctx := errgroup.WithContext(ctx)
+	for i, sourceObject := range sourceObjects {
+		sourceObject, blockID := sourceObject, base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", i)))
+		blockIDs[i] = blockID
+		srcURL, err := c.sasURL(c.container.NewBlobURL(sourceObject), c.cfg.Container, sourceObject, azureCopySourceSASDuration, "")
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		g.Go(func() error {
+			_, err := dest.StageBlockFromURL(gctx, blockID, *srcURL, 0, azblob.CountToEnd, azblob.LeaseAccessConditions{},
+				azblob.ModifiedAccessConditions{}, azblob.ClientProvidedKeyOptions{})
+			return errors.Wrapf(err, "Failed to copy %s", sourceObject)
+		})
+	}
+	if err := g.Wait(); err != nil {
+		// Blocks that were not committed are discarded by the storage service
+		err = errors.Wrapf(err, "Failed to compose %s", objectName)
+		log.Error(err)
+		return err
+	}
+	_, err := dest.CommitBlockList(ctx, blockIDs, azblob.BlobHTTPHeaders{}, azblob.Metadata{}, azblob.BlobAccessConditions{},
+		azblob.AccessTierNone, nil, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to complete upload for %s", objectName)
+		log.Error(err)
+		return err
+	}
+	log.Infof("Successfully composed %s of %d objects", objectName, len(sourceObjects))
+	return nil
+}
+
+// UploadISO creates the ISO from blocks that the storage service copies from the base ISO, except for the
+// embedded area that is uploaded with the ignition config
+func (c *AzureClient) UploadISO(ctx context.Context, ignitionConfig, srcObject, destObjectPrefix string) error {
+	log := logutil.FromContext(ctx, c.log)
+	destObjectName := fmt.Sprintf("%s.iso", destObjectPrefix)
+	log.Debugf("Started upload of ISO %s", destObjectName)
+
+	src := c.publicContainer.NewBlobURL(srcObject)
+	props, err := src.GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to fetch metadata for base object %s", srcObject)
+		log.Error(err)
+		return err
+	}
+	info, area, err := c.areaCache.get(string(props.ETag()), props.ContentLength(), func(offset, length int64) ([]byte, error) {
+		return c.readRange(ctx, src, offset, length)
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to fetch base ISO information")
+		log.Error(err)
+		return err
+	}
+	if err = embedIgnition(area, info.areaLengthBytes, ignitionConfig); err != nil {
+		log.Error(err)
+		return err
+	}
+
+	srcURL, err := c.sasURL(src, c.cfg.PublicContainer, srcObject, azureCopySourceSASDuration, "")
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	dest := c.container.NewBlockBlobURL(destObjectName)
+	var blockIDs []string
+	newBlockID := func() string {
+		blockID := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", len(blockIDs))))
+		blockIDs = append(blockIDs, blockID)
+		return blockID
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	copyRange := func(start, end int64) {
+		for chunkStart := start; chunkStart < end; chunkStart += copyPartChunkSizeBytes {
+			blockID, offset, count := newBlockID(), chunkStart, minInt64(copyPartChunkSizeBytes, end-chunkStart)
+			g.Go(func() error {
+				_, err := dest.StageBlockFromURL(gctx, blockID, *srcURL, offset, count, azblob.LeaseAccessConditions{},
+					azblob.ModifiedAccessConditions{IfMatch: props.ETag()}, azblob.ClientProvidedKeyOptions{})
+				return errors.Wrapf(err, "Failed to copy range %d-%d of %s", offset, offset+count-1, srcObject)
+			})
+		}
+	}
+	copyRange(0, info.areaOffsetBytes)
+	areaBlockID := newBlockID()
+	g.Go(func() error {
+		_, err := dest.StageBlock(gctx, areaBlockID, bytes.NewReader(area), azblob.LeaseAccessConditions{}, nil, azblob.ClientProvidedKeyOptions{})
+		return errors.Wrapf(err, "Failed to upload ignition for file %s", destObjectName)
+	})
+	copyRange(info.areaOffsetBytes+minimumPartSizeBytes, info.baseObjectSize)
+
+	if err = g.Wait(); err != nil {
+		// Blocks that were not committed are discarded by the storage service
+		err = errors.Wrapf(err, "Failed to create ISO %s", destObjectName)
+		log.Error(err)
+		return err
+	}
+	_, err = dest.CommitBlockList(ctx, blockIDs, azblob.BlobHTTPHeaders{}, azblob.Metadata{}, azblob.BlobAccessConditions{},
+		azblob.AccessTierNone, nil, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to complete upload for %s", destObjectName)
+		log.Error(err)
+		return err
+	}
+	log.Debugf("Completed upload of ISO %s", destObjectName)
+	return nil
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func (c *AzureClient) readRange(ctx context.Context, blob azblob.BlobURL, offset, length int64) ([]byte, error) {
+	resp, err := blob.Download(ctx, offset, length, azblob.BlobAccessConditions{}, false, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		return nil, err
+	}
+	body := resp.Body(azblob.RetryReaderOptions{})
+	defer body.Close()
+	return ioutil.ReadAll(body)
+}
+
+func (c *AzureClient) StreamISO(ctx context.Context, ignitionConfig string, ramDisk []byte, kernelArguments models.KernelArguments, srcObject string) (ReadSeekCloser, int64, error) {
+	return nil, 0, errors.New("Streaming ISOs is only supported with filesystem storage")
+}
+
+func (c *AzureClient) download(ctx context.Context, objectName string, container azblob.ContainerURL, containerName string) (io.ReadCloser, int64, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Downloading %s from container %s", objectName, containerName)
+
+	resp, err := container.NewBlobURL(objectName).Download(ctx, 0, azblob.CountToEnd, azblob.BlobAccessConditions{}, false, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		if isAzureServiceCode(err, azblob.ServiceCodeBlobNotFound) {
+			return nil, 0, common.NotFound(objectName)
+		}
+		log.WithError(err).Errorf("Failed to get %s object from container %s", objectName, containerName)
+		return nil, 0, err
+	}
+	return resp.Body(azblob.RetryReaderOptions{MaxRetryRequests: 3}), resp.ContentLength(), nil
+}
+
+func (c *AzureClient) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	return c.download(ctx, objectName, c.container, c.cfg.Container)
+}
+
+func (c *AzureClient) DownloadPublic(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	return c.download(ctx, objectName, c.publicContainer, c.cfg.PublicContainer)
+}
+
+func (c *AzureClient) doesObjectExist(ctx context.Context, objectName string, container azblob.ContainerURL, containerName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Debugf("Verifying if %s exists in %s", objectName, containerName)
+	_, err := container.NewBlobURL(objectName).GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		if isAzureServiceCode(err, azblob.ServiceCodeBlobNotFound) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to get %s from container %s", objectName, containerName)
+	}
+	return true, nil
+}
+
+func (c *AzureClient) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
+	return c.doesObjectExist(ctx, objectName, c.container, c.cfg.Container)
+}
+
+func (c *AzureClient) DoesPublicObjectExist(ctx context.Context, objectName string) (bool, error) {
+	return c.doesObjectExist(ctx, objectName, c.publicContainer, c.cfg.PublicContainer)
+}
+
+func (c *AzureClient) DeleteObject(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Deleting object %s from %s", objectName, c.cfg.Container)
+
+	_, err := c.container.NewBlobURL(objectName).Delete(ctx, azblob.DeleteSnapshotsOptionInclude, azblob.BlobAccessConditions{})
+	if err != nil {
+		if isAzureServiceCode(err, azblob.ServiceCodeBlobNotFound) {
+			log.Infof("Object %s does not exist in container %s", objectName, c.cfg.Container)
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "Failed to delete object %s from container %s", objectName, c.cfg.Container)
+	}
+
+	log.Infof("Deleted object %s from container %s", objectName, c.cfg.Container)
+	return true, nil
+}
+
+// UpdateObjectTimestamp keeps the timestamp in the metadata of the blob
+func (c *AzureClient) UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Updating timestamp of object %s", objectName)
+	_, err := c.container.NewBlobURL(objectName).SetMetadata(ctx, azblob.Metadata{timestampTagKey: strconv.FormatInt(time.Now().Unix(), 10)},
+		azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		if isAzureServiceCode(err, azblob.ServiceCodeBlobNotFound) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "Failed to update metadata of object %s in container %s", objectName, c.cfg.Container)
+	}
+	return true, nil
+}
+
+func (c *AzureClient) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	log := logutil.FromContext(ctx, c.log)
+	props, err := c.container.NewBlobURL(objectName).GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to fetch metadata for object %s in container %s", objectName, c.cfg.Container)
+		log.Error(err)
+		return 0, err
+	}
+	return props.ContentLength(), nil
+}
+
+// sasURL returns the URL of a blob with a read only shared access signature
+func (c *AzureClient) sasURL(blob azblob.BlobURL, containerName, objectName string, duration time.Duration, downloadFilename string) (*url.URL, error) {
+	values := azblob.BlobSASSignatureValues{
+		ExpiryTime:    time.Now().UTC().Add(duration),
+		Permissions:   azblob.BlobSASPermissions{Read: true}.String(),
+		ContainerName: containerName,
+		BlobName:      objectName,
+	}
+	if downloadFilename != "" {
+		values.ContentDisposition = fmt.Sprintf("attachment;filename=%s", downloadFilename)
+	}
+	sas, err := values.NewSASQueryParameters(c.credential)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to sign URL of object %s in container %s", objectName, containerName)
+	}
+	parts := azblob.NewBlobURLParts(blob.URL())
+	parts.SAS = sas
+	u := parts.URL()
+	return &u, nil
+}
+
+func (c *AzureClient) SupportsPresignedDownloadURLs() bool {
+	return c.IsAwsS3()
+}
+
+func (c *AzureClient) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
+	log := logutil.FromContext(ctx, c.log)
+	u, err := c.sasURL(c.container.NewBlobURL(objectName), c.cfg.Container, objectName, duration, downloadFilename)
+	if err != nil {
+		log.Error(err)
+		return "", err
+	}
+	return u.String(), nil
+}
+
+func (c *AzureClient) ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	c.expireObjects(ctx, prefix, deleteTime, nil, callback)
+}
+
+// ExpireObjectsUnlessReferenced deletes the expired objects with the given prefix, keeping those that are still referenced,
+// and calls the callback with every deleted object
+func (c *AzureClient) ExpireObjectsUnlessReferenced(ctx context.Context, prefix string, deleteTime time.Duration,
+	isReferenced func(ctx context.Context, log logrus.FieldLogger, objectName string) bool,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	c.expireObjects(ctx, prefix, deleteTime, isReferenced, callback)
+}
+
+func (c *AzureClient) expireObjects(ctx context.Context, prefix string, deleteTime time.Duration,
+	isReferenced func(ctx context.Context, log logrus.FieldLogger, objectName string) bool,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	log := logutil.FromContext(ctx, c.log)
+	now := time.Now()
+
+	log.Info("Checking for expired objects...")
+	err := c.listBlobs(ctx, prefix, true, func(blob *azblob.BlobItemInternal) {
+		c.handleBlob(ctx, log, blob, now, deleteTime, isReferenced, callback)
+	})
+	if err != nil {
+		log.WithError(err).Error("Error listing objects")
+	}
+}
+
+func (c *AzureClient) handleBlob(ctx context.Context, log logrus.FieldLogger, blob *azblob.BlobItemInternal, now time.Time, deleteTime time.Duration,
+	isReferenced func(ctx context.Context, log logrus.FieldLogger, objectName string) bool,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	if isChecksumObject(blob.Name) {
+		return
+	}
+	// The metadata timestamp only exists if the same image was created more than once
+	creationTime := blob.Properties.LastModified
+	if value, ok := blob.Metadata[timestampTagKey]; ok {
+		objTime, _ := strconv.ParseInt(value, 10, 64)
+		creationTime = time.Unix(objTime, 0)
+	}
+
+	if now.After(creationTime.Add(deleteTime)) {
+		if isReferenced != nil && isReferenced(ctx, log, blob.Name) {
+			log.Infof("Keeping expired object %s since it is still referenced", blob.Name)
+			return
+		}
+		_, err := c.DeleteObject(ctx, blob.Name)
+		if err != nil {
+			log.WithError(err).Errorf("Error deleting expired object %s", blob.Name)
+			return
+		}
+		log.Infof("Deleted expired object %s", blob.Name)
+		deleteChecksum(ctx, log, c, blob.Name)
+		callback(ctx, log, blob.Name)
+	}
+}
+
+func (c *AzureClient) listBlobs(ctx context.Context, prefix string, withMetadata bool, fn func(blob *azblob.BlobItemInternal)) error {
+	for marker := (azblob.Marker{}); marker.NotDone(); {
+		resp, err := c.container.ListBlobsFlatSegment(ctx, marker, azblob.ListBlobsSegmentOptions{
+			Prefix:  prefix,
+			Details: azblob.BlobListingDetails{Metadata: withMetadata},
+		})
+		if err != nil {
+			return err
+		}
+		for i := range resp.Segment.BlobItems {
+			fn(&resp.Segment.BlobItems[i])
+		}
+		marker = resp.NextMarker
+	}
+	return nil
+}
+
+func (c *AzureClient) ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	log := logutil.FromContext(ctx, c.log)
+	var objects []string
+	log.Infof("Listing objects by with prefix %s", prefix)
+	err := c.listBlobs(ctx, prefix, false, func(blob *azblob.BlobItemInternal) {
+		objects = append(objects, blob.Name)
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "Error listing objects for prefix %s", prefix)
+		log.Error(err)
+		return nil, err
+	}
+	return objects, nil
+}
+
+func (c *AzureClient) UploadISOs(ctx context.Context, openshiftVersion string, haveLatestMinimalTemplate bool) error {
+	log := logutil.FromContext(ctx, c.log)
+	return uploadISOsForVersion(ctx, log, c, c.versionsHandler, c.isoEditorFactory, openshiftVersion, haveLatestMinimalTemplate)
+}
+
+func (c *AzureClient) GetBaseIsoObject(openshiftVersion string) (string, error) {
+	return rhcosObjectName(c.versionsHandler, rhcosObjectTemplate, openshiftVersion)
+}
+
+func (c *AzureClient) GetMinimalIsoObjectName(openshiftVersion string) (string, error) {
+	return rhcosObjectName(c.versionsHandler, rhcosMinimalObjectTemplate, openshiftVersion)
+}
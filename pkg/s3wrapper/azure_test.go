@@ -0,0 +1,96 @@
+package s3wrapper
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+// The well known account of the Azurite emulator
+const (
+	azuriteAccountName = "devstoreaccount1"
+	azuriteAccountKey  = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+)
+
+func discardLogger() logrus.FieldLogger {
+	log := logrus.New()
+	log.SetOutput(ioutil.Discard)
+	return log
+}
+
+var _ = Describe("AzureClient", func() {
+	var (
+		ctx    = context.Background()
+		client *AzureClient
+	)
+
+	BeforeEach(func() {
+		// Set AZURITE_ENDPOINT, e.g. to http://127.0.0.1:10000/devstoreaccount1, to run against the Azurite emulator
+		endpoint := os.Getenv("AZURITE_ENDPOINT")
+		if endpoint == "" {
+			Skip("AZURITE_ENDPOINT is not set")
+		}
+		id := uuid.New().String()
+		client = NewAzureClient(&AzureConfig{
+			AccountName:     azuriteAccountName,
+			AccountKey:      azuriteAccountKey,
+			EndpointURL:     endpoint,
+			Container:       fmt.Sprintf("private-%s", id),
+			PublicContainer: fmt.Sprintf("public-%s", id),
+		}, discardLogger(), nil, nil)
+		Expect(client).ToNot(BeNil())
+		Expect(client.CreateBucket()).To(Succeed())
+		Expect(client.CreatePublicBucket()).To(Succeed())
+		// Creating an existing container succeeds
+		Expect(client.CreateBucket()).To(Succeed())
+	})
+
+	storageBackendSpecs(func() API { return client })
+
+	It("generates presigned download URLs", func() {
+		Expect(client.Upload(ctx, []byte("content"), "object")).To(Succeed())
+		downloadURL, err := client.GeneratePresignedDownloadURL(ctx, "object", "file.txt", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		resp, err := http.Get(downloadURL)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		content, err := ioutil.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(content).To(Equal([]byte("content")))
+	})
+})
+
+var _ = Describe("AzureClient configuration", func() {
+	It("is reachable by clients at the Azure endpoint", func() {
+		client := NewAzureClient(&AzureConfig{AccountName: "account", AccountKey: azuriteAccountKey, Container: "images"}, discardLogger(), nil, nil)
+		Expect(client).ToNot(BeNil())
+		Expect(client.IsAwsS3()).To(BeTrue())
+		Expect(client.container.String()).To(Equal("https://account.blob.core.windows.net/images"))
+	})
+
+	It("is not reported as the Azure cloud at a custom endpoint", func() {
+		client := NewAzureClient(&AzureConfig{
+			AccountName: azuriteAccountName,
+			AccountKey:  azuriteAccountKey,
+			EndpointURL: "http://127.0.0.1:10000/devstoreaccount1",
+			Container:   "images",
+		}, discardLogger(), nil, nil)
+		Expect(client).ToNot(BeNil())
+		Expect(client.IsAwsS3()).To(BeFalse())
+		Expect(client.container.String()).To(Equal("http://127.0.0.1:10000/devstoreaccount1/images"))
+	})
+
+	It("fails with an invalid account key", func() {
+		Expect(NewAzureClient(&AzureConfig{AccountName: "account", AccountKey: "not base64!"}, discardLogger(), nil, nil)).To(BeNil())
+	})
+})
@@ -0,0 +1,131 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// The SHA-256 digest of an object is stored next to it, hex encoded, in an object with this suffix
+const checksumObjectSuffix = ".sha256"
+
+func ChecksumObjectName(objectName string) string {
+	return objectName + checksumObjectSuffix
+}
+
+// isChecksumObject returns whether the object holds the checksum of another object. Checksum objects do not expire on
+// their own, they are deleted with the object they belong to.
+func isChecksumObject(objectName string) bool {
+	return strings.HasSuffix(objectName, checksumObjectSuffix)
+}
+
+// deleteChecksum deletes the checksum stored next to an expired object
+func deleteChecksum(ctx context.Context, log logrus.FieldLogger, api API, objectName string) {
+	if _, err := api.DeleteObject(ctx, ChecksumObjectName(objectName)); err != nil {
+		log.WithError(err).Errorf("Error deleting the checksum of expired object %s", objectName)
+	}
+}
+
+// SHA256Sum returns the hex encoded SHA-256 digest of the content of the reader
+func SHA256Sum(reader io.Reader) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, reader); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// FileSHA256Sum returns the hex encoded SHA-256 digest of the file
+func FileSHA256Sum(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	checksum, err := SHA256Sum(f)
+	if err != nil {
+		return "", errors.Wrapf(err, "Failed to compute the checksum of %s", filePath)
+	}
+	return checksum, nil
+}
+
+// VerifyChecksum fails unless the checksum of an object is the expected one, which includes having no expected checksum
+func VerifyChecksum(objectName, expected, checksum string) error {
+	if expected == "" {
+		return errors.Errorf("No checksum to verify %s with", objectName)
+	}
+	if !strings.EqualFold(expected, checksum) {
+		return errors.Errorf("Checksum of %s is %s, while %s was expected", objectName, checksum, expected)
+	}
+	return nil
+}
+
+// UploadChecksum stores the checksum of an object next to it
+func UploadChecksum(ctx context.Context, api API, objectName, checksum string, public bool) error {
+	checksumObject := ChecksumObjectName(objectName)
+	var err error
+	if public {
+		err = api.UploadStreamToPublicBucket(ctx, bytes.NewReader([]byte(checksum)), checksumObject)
+	} else {
+		err = api.Upload(ctx, []byte(checksum), checksumObject)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "Failed to upload checksum of %s", objectName)
+	}
+	return nil
+}
+
+// GetChecksum returns the checksum stored next to an object. When there is none, the checksum is computed from the
+// content of the object and stored.
+func GetChecksum(ctx context.Context, log logrus.FieldLogger, api API, objectName string, public bool) (string, error) {
+	checksum, err := downloadChecksum(ctx, api, objectName, public)
+	if err == nil {
+		return checksum, nil
+	}
+	if _, ok := err.(common.NotFound); !ok {
+		return "", err
+	}
+
+	log.Infof("Computing the checksum of %s", objectName)
+	reader, _, err := download(ctx, api, objectName, public)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	if checksum, err = SHA256Sum(reader); err != nil {
+		return "", errors.Wrapf(err, "Failed to compute the checksum of %s", objectName)
+	}
+	if err = UploadChecksum(ctx, api, objectName, checksum, public); err != nil {
+		return "", err
+	}
+	return checksum, nil
+}
+
+func downloadChecksum(ctx context.Context, api API, objectName string, public bool) (string, error) {
+	reader, _, err := download(ctx, api, ChecksumObjectName(objectName), public)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	checksum, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return "", errors.Wrapf(err, "Failed to read checksum of %s", objectName)
+	}
+	return strings.TrimSpace(string(checksum)), nil
+}
+
+func download(ctx context.Context, api API, objectName string, public bool) (io.ReadCloser, int64, error) {
+	if public {
+		return api.DownloadPublic(ctx, objectName)
+	}
+	return api.Download(ctx, objectName)
+}
@@ -0,0 +1,73 @@
+package s3wrapper
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("Checksums", func() {
+	var (
+		ctx     = context.Background()
+		log     = logrus.New()
+		baseDir string
+		client  *FSClient
+		// sha256sum of "foo"
+		fooChecksum = "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+	)
+
+	BeforeEach(func() {
+		log.SetOutput(ioutil.Discard)
+		var err error
+		baseDir, err = ioutil.TempDir("", "checksumtest")
+		Expect(err).NotTo(HaveOccurred())
+		client = &FSClient{basedir: baseDir, log: log}
+		Expect(ioutil.WriteFile(filepath.Join(baseDir, "image.iso"), []byte("foo"), 0600)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(baseDir)
+	})
+
+	It("FileSHA256Sum computes the checksum of a file", func() {
+		Expect(FileSHA256Sum(filepath.Join(baseDir, "image.iso"))).To(Equal(fooChecksum))
+	})
+
+	It("GetChecksum computes and stores a missing checksum", func() {
+		Expect(GetChecksum(ctx, log, client, "image.iso", false)).To(Equal(fooChecksum))
+		stored, err := ioutil.ReadFile(filepath.Join(baseDir, ChecksumObjectName("image.iso")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(stored)).To(Equal(fooChecksum))
+	})
+
+	It("GetChecksum returns the stored checksum", func() {
+		Expect(UploadChecksum(ctx, client, "image.iso", "0123", false)).To(Succeed())
+		Expect(GetChecksum(ctx, log, client, "image.iso", false)).To(Equal("0123"))
+	})
+
+	It("GetChecksum fails when the object does not exist", func() {
+		_, err := GetChecksum(ctx, log, client, "missing.iso", false)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("UploadChecksum fails when the upload fails", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		defer ctrl.Finish()
+		mockAPI := NewMockAPI(ctrl)
+		mockAPI.EXPECT().UploadStreamToPublicBucket(ctx, gomock.Any(), ChecksumObjectName("image.iso")).Return(errors.New("failed"))
+		Expect(UploadChecksum(ctx, mockAPI, "image.iso", fooChecksum, true)).NotTo(Succeed())
+	})
+
+	It("VerifyChecksum verifies the expected checksum and fails without one", func() {
+		Expect(VerifyChecksum("image.iso", "", fooChecksum)).NotTo(Succeed())
+		Expect(VerifyChecksum("image.iso", fooChecksum, fooChecksum)).To(Succeed())
+		Expect(VerifyChecksum("image.iso", fooChecksum, "0123")).NotTo(Succeed())
+	})
+})
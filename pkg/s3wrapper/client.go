@@ -26,18 +26,35 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/isoeditor"
 	"github.com/openshift/assisted-service/internal/versions"
+	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 const (
-	awsEndpointSuffix          = ".amazonaws.com"
-	rhcosObjectTemplate        = "rhcos-%s.iso"
-	rhcosMinimalObjectTemplate = "rhcos-%s-minimal.iso"
-	DiscoveryImageTemplate     = "discovery-image-%s"
+	awsEndpointSuffix           = ".amazonaws.com"
+	rhcosObjectTemplate         = "rhcos-%s.iso"
+	rhcosMinimalObjectTemplate  = "rhcos-%s-minimal.iso"
+	DiscoveryImageTemplate      = "discovery-image-%s"
+	DiscoveryImageCacheTemplate = "discovery-cache-%s"
+	pxeArtifactTemplate         = "%s-pxe-%s"
 )
 
+// Network boot artifacts, named as the files served by the pxe-artifacts download endpoint
+const (
+	PXEKernel     = "vmlinuz"
+	PXEInitrd     = "initrd.img"
+	PXERootFS     = "rootfs.img"
+	PXEIPXEScript = "ipxe-script"
+)
+
+// ReadSeekCloser is the content of an object that can be read from any offset
+type ReadSeekCloser interface {
+	io.ReadSeeker
+	io.Closer
+}
+
 //go:generate mockgen -package=s3wrapper -destination=mock_s3wrapper.go . API
 //go:generate mockgen -package s3wrapper -destination mock_s3iface.go github.com/aws/aws-sdk-go/service/s3/s3iface S3API
 //go:generate mockgen -package s3wrapper -destination mock_s3manageriface.go github.com/aws/aws-sdk-go/service/s3/s3manager/s3manageriface UploaderAPI
@@ -47,14 +64,19 @@ type API interface {
 	Upload(ctx context.Context, data []byte, objectName string) error
 	UploadStream(ctx context.Context, reader io.Reader, objectName string) error
 	UploadFile(ctx context.Context, filePath, objectName string) error
+	ComposeObjects(ctx context.Context, sourceObjects []string, objectName string) error
 	UploadISO(ctx context.Context, ignitionConfig, srcObject, destObjectPrefix string) error
+	StreamISO(ctx context.Context, ignitionConfig string, ramDisk []byte, kernelArguments models.KernelArguments, srcObject string) (ReadSeekCloser, int64, error)
 	Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error)
 	DoesObjectExist(ctx context.Context, objectName string) (bool, error)
 	DeleteObject(ctx context.Context, objectName string) (bool, error)
 	GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error)
 	GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error)
+	SupportsPresignedDownloadURLs() bool
 	UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error)
 	ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration, callback func(ctx context.Context, log logrus.FieldLogger, objectName string))
+	ExpireObjectsUnlessReferenced(ctx context.Context, prefix string, deleteTime time.Duration, isReferenced func(ctx context.Context, log logrus.FieldLogger, objectName string) bool,
+		callback func(ctx context.Context, log logrus.FieldLogger, objectName string))
 	ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error)
 	UploadISOs(ctx context.Context, openshiftVersion string, haveLatestMinimalTemplate bool) error
 	GetBaseIsoObject(openshiftVersion string) (string, error)
@@ -236,11 +258,96 @@ func (c *S3Client) UploadFileToPublicBucket(ctx context.Context, filePath, objec
 	return c.uploadFile(ctx, filePath, objectName, c.cfg.PublicS3Bucket, c.publicUploader)
 }
 
+// MaxComposedObjects is the maximal number of objects ComposeObjects composes, which is the maximal number of parts of
+// an S3 multipart upload
+const MaxComposedObjects = 10000
+
+// ComposeObjects copies the objects as the parts of a multipart upload. Every part but the last has to be at least 5MB,
+// so smaller objects are streamed through the service instead.
+func (c *S3Client) ComposeObjects(ctx context.Context, sourceObjects []string, objectName string) error {
+	log := logutil.FromContext(ctx, c.log)
+	if len(sourceObjects) == 0 {
+		return errors.Errorf("No objects to compose %s of", objectName)
+	}
+	if len(sourceObjects) > MaxComposedObjects {
+		return errors.Errorf("Cannot compose %s of %d objects, at most %d objects can be composed", objectName, len(sourceObjects), MaxComposedObjects)
+	}
+	for _, sourceObject := range sourceObjects[:len(sourceObjects)-1] {
+		size, err := c.GetObjectSizeBytes(ctx, sourceObject)
+		if err != nil {
+			return err
+		}
+		if size < minimumPartSizeBytes {
+			log.Debugf("Streaming the objects of %s, since %s is smaller than a part", objectName, sourceObject)
+			reader := newObjectsReader(ctx, c, sourceObjects)
+			defer reader.Close()
+			return c.UploadStream(ctx, reader, objectName)
+		}
+	}
+
+	multiOut, err := c.client.CreateMultipartUploadWithContext(ctx,
+		&s3.CreateMultipartUploadInput{Bucket: aws.String(c.cfg.S3Bucket), Key: aws.String(objectName)})
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to start upload for %s", objectName)
+		log.Error(err)
+		return err
+	}
+	uploadID := multiOut.UploadId
+	abort := func() {
+		// using new context because ctx may be canceled and it will fail the operation
+		_, abortErr := c.client.AbortMultipartUploadWithContext(context.Background(), &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(c.cfg.S3Bucket),
+			Key:      aws.String(objectName),
+			UploadId: uploadID,
+		})
+		if abortErr != nil {
+			log.WithError(abortErr).Warnf("Failed to abort failed multipart upload with ID %s", *uploadID)
+		}
+	}
+
+	parts := make([]*s3.CompletedPart, 0, len(sourceObjects))
+	for i, sourceObject := range sourceObjects {
+		partNumber := aws.Int64(int64(i + 1))
+		partOut, err := c.client.UploadPartCopyWithContext(ctx, &s3.UploadPartCopyInput{
+			Bucket:     aws.String(c.cfg.S3Bucket),
+			Key:        aws.String(objectName),
+			CopySource: aws.String(fmt.Sprintf("/%s/%s", c.cfg.S3Bucket, sourceObject)),
+			PartNumber: partNumber,
+			UploadId:   uploadID,
+		})
+		if err != nil {
+			abort()
+			err = errors.Wrapf(err, "Failed to copy %s to %s", sourceObject, objectName)
+			log.Error(err)
+			return err
+		}
+		parts = append(parts, &s3.CompletedPart{ETag: partOut.CopyPartResult.ETag, PartNumber: partNumber})
+	}
+	_, err = c.client.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(c.cfg.S3Bucket),
+		Key:             aws.String(objectName),
+		UploadId:        uploadID,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+	})
+	if err != nil {
+		abort()
+		err = errors.Wrapf(err, "Failed to complete upload for %s", objectName)
+		log.Error(err)
+		return err
+	}
+	log.Infof("Successfully composed %s of %d objects", objectName, len(sourceObjects))
+	return nil
+}
+
 func (c *S3Client) UploadISO(ctx context.Context, ignitionConfig, srcObject, destObjectPrefix string) error {
 	destObjectName := fmt.Sprintf("%s.iso", destObjectPrefix)
 	return c.isoUploader.UploadISO(ctx, ignitionConfig, srcObject, destObjectName)
 }
 
+func (c *S3Client) StreamISO(ctx context.Context, ignitionConfig string, ramDisk []byte, kernelArguments models.KernelArguments, srcObject string) (ReadSeekCloser, int64, error) {
+	return nil, 0, errors.New("Streaming ISOs is only supported with filesystem storage")
+}
+
 func (c *S3Client) Upload(ctx context.Context, data []byte, objectName string) error {
 	reader := bytes.NewReader(data)
 	return c.UploadStream(ctx, reader, objectName)
@@ -391,6 +498,12 @@ func (c *S3Client) GeneratePresignedDownloadURL(ctx context.Context, objectName
 	return urlStr, nil
 }
 
+// SupportsPresignedDownloadURLs returns whether clients can download objects with presigned URLs, which is only
+// assumed for AWS S3 because Scality is not exposed
+func (c *S3Client) SupportsPresignedDownloadURLs() bool {
+	return c.IsAwsS3()
+}
+
 func (c S3Client) transformErrorIfNeeded(err error, objectName string) (bool, error) {
 	if aerr, ok := err.(awserr.Error); ok {
 		if aerr.Code() == s3.ErrCodeNoSuchKey || aerr.Code() == "NotFound" {
@@ -401,6 +514,20 @@ func (c S3Client) transformErrorIfNeeded(err error, objectName string) (bool, er
 }
 
 func (c *S3Client) ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	c.expireObjects(ctx, prefix, deleteTime, nil, callback)
+}
+
+// ExpireObjectsUnlessReferenced deletes the expired objects with the given prefix, keeping those that are still referenced,
+// and calls the callback with every deleted object
+func (c *S3Client) ExpireObjectsUnlessReferenced(ctx context.Context, prefix string, deleteTime time.Duration,
+	isReferenced func(ctx context.Context, log logrus.FieldLogger, objectName string) bool,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	c.expireObjects(ctx, prefix, deleteTime, isReferenced, callback)
+}
+
+func (c *S3Client) expireObjects(ctx context.Context, prefix string, deleteTime time.Duration,
+	isReferenced func(ctx context.Context, log logrus.FieldLogger, objectName string) bool,
 	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
 	log := logutil.FromContext(ctx, c.log)
 	now := time.Now()
@@ -409,7 +536,7 @@ func (c *S3Client) ExpireObjects(ctx context.Context, prefix string, deleteTime
 	err := c.client.ListObjectsPages(&s3.ListObjectsInput{Bucket: &c.cfg.S3Bucket, Prefix: &prefix},
 		func(page *s3.ListObjectsOutput, lastPage bool) bool {
 			for _, object := range page.Contents {
-				c.handleObject(ctx, log, object, now, deleteTime, callback)
+				c.handleObject(ctx, log, object, now, deleteTime, isReferenced, callback)
 			}
 			return !lastPage
 		})
@@ -419,8 +546,12 @@ func (c *S3Client) ExpireObjects(ctx context.Context, prefix string, deleteTime
 	}
 }
 
-func (c *S3Client) handleObject(ctx context.Context, log logrus.FieldLogger, object *s3.Object, now time.Time,
-	deleteTime time.Duration, callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+func (c *S3Client) handleObject(ctx context.Context, log logrus.FieldLogger, object *s3.Object, now time.Time, deleteTime time.Duration,
+	isReferenced func(ctx context.Context, log logrus.FieldLogger, objectName string) bool,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	if isChecksumObject(*object.Key) {
+		return
+	}
 	// By default we use the object creation time - tags only exist if the same image was created more than once
 	creationTime := *object.LastModified
 	// If this is too new, there is no point in checking tags
@@ -443,12 +574,17 @@ func (c *S3Client) handleObject(ctx context.Context, log logrus.FieldLogger, obj
 	}
 
 	if now.After(creationTime.Add(deleteTime)) {
+		if isReferenced != nil && isReferenced(ctx, log, *object.Key) {
+			log.Infof("Keeping expired object %s since it is still referenced", *object.Key)
+			return
+		}
 		_, err := c.DeleteObject(ctx, *object.Key)
 		if err != nil {
 			log.WithError(err).Errorf("Error deleting expired object %s", *object.Key)
 			return
 		}
 		log.Infof("Deleted expired object %s", *object.Key)
+		deleteChecksum(ctx, log, c, *object.Key)
 		callback(ctx, log, *object.Key)
 	}
 }
@@ -478,69 +614,28 @@ func (c *S3Client) UploadISOs(ctx context.Context, openshiftVersion string, have
 		return err
 	}
 
-	baseIsoObject, err := c.GetBaseIsoObject(openshiftVersion)
-	if err != nil {
-		return err
-	}
-
-	minimalIsoObject, err := c.GetMinimalIsoObjectName(openshiftVersion)
+	rhcosImageSHA256, err := c.versionsHandler.GetRHCOSImageSHA256(openshiftVersion)
 	if err != nil {
 		return err
 	}
 
-	return c.uploadISOs(ctx, baseIsoObject, minimalIsoObject, rhcosImage, openshiftVersion, haveLatestMinimalTemplate)
-}
-
-func (c *S3Client) uploadISOs(ctx context.Context, isoObjectName, minimalIsoObject, isoURL, openshiftVersion string, haveLatestMinimalTemplate bool) error {
-	log := logutil.FromContext(ctx, c.log)
-
-	baseExists, err := c.DoesPublicObjectExist(ctx, isoObjectName)
+	baseIsoObject, err := c.GetBaseIsoObject(openshiftVersion)
 	if err != nil {
 		return err
 	}
 
-	var minimalExists bool
-	if !haveLatestMinimalTemplate {
-		// Should update minimal ISO template
-		minimalExists = false
-	} else {
-		minimalExists, err = c.DoesPublicObjectExist(ctx, minimalIsoObject)
-		if err != nil {
-			return err
-		}
-	}
-
-	if baseExists && minimalExists {
-		return nil
-	}
-
-	log.Infof("Starting Base ISO download for %s", isoObjectName)
-	baseIsoPath, err := DownloadURLToTemporaryFile(isoURL)
+	minimalIsoObject, err := c.GetMinimalIsoObjectName(openshiftVersion)
 	if err != nil {
-		log.Error(err)
 		return err
 	}
-	defer os.Remove(baseIsoPath)
 
-	if !baseExists {
-		err = c.UploadFileToPublicBucket(ctx, baseIsoPath, isoObjectName)
-		if err != nil {
-			return err
-		}
-		log.Infof("Successfully uploaded object %s", isoObjectName)
-	}
-
-	if !minimalExists {
-		rootFSURL, err := c.versionsHandler.GetRHCOSRootFS(openshiftVersion)
-		if err != nil {
-			return err
-		}
-		if err = CreateAndUploadMinimalIso(ctx, log, baseIsoPath, minimalIsoObject, rootFSURL, c, c.isoEditorFactory); err != nil {
-			return err
-		}
-	}
+	return c.uploadISOs(ctx, baseIsoObject, minimalIsoObject, rhcosImage, rhcosImageSHA256, openshiftVersion, haveLatestMinimalTemplate)
+}
 
-	return nil
+func (c *S3Client) uploadISOs(ctx context.Context, isoObjectName, minimalIsoObject, isoURL, isoSHA256, openshiftVersion string, haveLatestMinimalTemplate bool) error {
+	log := logutil.FromContext(ctx, c.log)
+	return uploadBaseAndMinimalISOs(ctx, log, c, c.versionsHandler, c.isoEditorFactory, isoObjectName, minimalIsoObject, isoURL, isoSHA256,
+		openshiftVersion, haveLatestMinimalTemplate)
 }
 
 func (c *S3Client) GetBaseIsoObject(openshiftVersion string) (string, error) {
@@ -13,11 +13,13 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -69,7 +71,7 @@ var _ = Describe("s3client", func() {
 		imgCreatedAt, _ := time.Parse(time.RFC3339, "2020-01-01T09:30:00+00:00") // 30 minutes ago
 		obj := s3.Object{Key: &objKey, LastModified: &imgCreatedAt}
 		called := false
-		client.handleObject(ctx, log, &obj, now, deleteTime, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
+		client.handleObject(ctx, log, &obj, now, deleteTime, nil, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
 		Expect(called).To(Equal(false))
 	})
 	It("expired_image_not_reused", func() {
@@ -81,10 +83,20 @@ var _ = Describe("s3client", func() {
 		mockAPI.EXPECT().GetObjectTagging(&taggingInput).Return(&taggingOutput, nil)
 		deleteInput := s3.DeleteObjectInput{Bucket: &bucket, Key: &objKey}
 		mockAPI.EXPECT().DeleteObject(&deleteInput).Return(nil, nil)
+		checksumKey := ChecksumObjectName(objKey)
+		mockAPI.EXPECT().DeleteObject(&s3.DeleteObjectInput{Bucket: &bucket, Key: &checksumKey}).Return(nil, nil)
 		called := false
-		client.handleObject(ctx, log, &obj, now, deleteTime, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
+		client.handleObject(ctx, log, &obj, now, deleteTime, nil, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
 		Expect(called).To(Equal(true))
 	})
+	It("expired_checksum_kept_with_its_image", func() {
+		imgCreatedAt, _ := time.Parse(time.RFC3339, "2020-01-01T08:00:00+00:00") // Two hours ago
+		checksumKey := ChecksumObjectName(objKey)
+		obj := s3.Object{Key: &checksumKey, LastModified: &imgCreatedAt}
+		called := false
+		client.handleObject(ctx, log, &obj, now, deleteTime, nil, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
+		Expect(called).To(Equal(false))
+	})
 	It("not_expired_image_reused", func() {
 		imgCreatedAt, _ := time.Parse(time.RFC3339, "2020-01-01T08:00:00+00:00") // Two hours ago
 		durationToAdd, _ := time.ParseDuration("90m")
@@ -97,7 +109,7 @@ var _ = Describe("s3client", func() {
 		taggingOutput := s3.GetObjectTaggingOutput{TagSet: tagSet}
 		mockAPI.EXPECT().GetObjectTagging(&taggingInput).Return(&taggingOutput, nil)
 		called := false
-		client.handleObject(ctx, log, &obj, now, deleteTime, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
+		client.handleObject(ctx, log, &obj, now, deleteTime, nil, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
 		Expect(called).To(Equal(false))
 	})
 	It("expired_image_reused", func() {
@@ -113,8 +125,10 @@ var _ = Describe("s3client", func() {
 		mockAPI.EXPECT().GetObjectTagging(&taggingInput).Return(&taggingOutput, nil)
 		deleteInput := s3.DeleteObjectInput{Bucket: &bucket, Key: &objKey}
 		mockAPI.EXPECT().DeleteObject(&deleteInput).Return(nil, nil)
+		checksumKey := ChecksumObjectName(objKey)
+		mockAPI.EXPECT().DeleteObject(&s3.DeleteObjectInput{Bucket: &bucket, Key: &checksumKey}).Return(nil, nil)
 		called := false
-		client.handleObject(ctx, log, &obj, now, deleteTime, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
+		client.handleObject(ctx, log, &obj, now, deleteTime, nil, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
 		Expect(called).To(Equal(true))
 	})
 	It("expired_image_deletion_failed", func() {
@@ -130,7 +144,7 @@ var _ = Describe("s3client", func() {
 		deleteInput := s3.DeleteObjectInput{Bucket: &bucket, Key: &objKey}
 		mockAPI.EXPECT().DeleteObject(&deleteInput).Return(nil, awserr.New("UnknownError", "UnknownError", errors.New("UnknownError")))
 		called := false
-		client.handleObject(ctx, log, &obj, now, deleteTime, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
+		client.handleObject(ctx, log, &obj, now, deleteTime, nil, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
 		Expect(called).To(Equal(false))
 	})
 	Context("upload iso", func() {
@@ -308,7 +322,12 @@ var _ = Describe("s3client", func() {
 				Return(&s3.HeadObjectOutput{}, nil)
 			publicMockAPI.EXPECT().HeadObject(&s3.HeadObjectInput{Bucket: &publicBucket, Key: aws.String(defaultTestRhcosObject)}).
 				Return(&s3.HeadObjectOutput{}, nil)
+			mockAPI.EXPECT().HeadObject(&s3.HeadObjectInput{Bucket: &publicBucket, Key: aws.String(ChecksumObjectName(defaultTestRhcosObject))}).
+				Return(&s3.HeadObjectOutput{ContentLength: aws.Int64(int64(len(defaultTestRhcosSHA256)))}, nil)
+			mockAPI.EXPECT().GetObject(&s3.GetObjectInput{Bucket: &publicBucket, Key: aws.String(ChecksumObjectName(defaultTestRhcosObject))}).
+				Return(&s3.GetObjectOutput{Body: ioutil.NopCloser(strings.NewReader(defaultTestRhcosSHA256))}, nil)
 			mockVersions.EXPECT().GetRHCOSImage(defaultTestOpenShiftVersion).Return(defaultTestRhcosURL, nil).Times(1)
+			mockVersions.EXPECT().GetRHCOSImageSHA256(defaultTestOpenShiftVersion).Return(defaultTestRhcosSHA256, nil).Times(1)
 
 			// Called once for GetBaseIsoObject and once for GetMinimalIsoObjectName
 			mockVersions.EXPECT().GetRHCOSVersion(defaultTestOpenShiftVersion).Return(defaultTestRhcosVersion, nil).Times(2)
@@ -358,15 +377,98 @@ var _ = Describe("s3client", func() {
 				Bucket: &publicBucket,
 				Key:    aws.String(defaultTestRhcosObject)}).
 				Return(nil, awserr.New("NotFound", "NotFound", errors.New("NotFound")))
-			publicUploader.EXPECT().Upload(gomock.Any()).Return(nil, nil).Times(2)
+			// Should upload the base ISO, its checksum and the minimal ISO
+			publicUploader.EXPECT().Upload(gomock.Any()).Return(nil, nil).Times(3)
 
 			// Should upload version file
 			uploader.EXPECT().Upload(gomock.Any()).Return(nil, nil).Times(1)
 			mockVersions.EXPECT().GetRHCOSRootFS(defaultTestOpenShiftVersion).Return("https://example.com/rootfs/url", nil)
 
-			err := client.uploadISOs(ctx, defaultTestRhcosObject, defaultTestRhcosObjectMinimal, ts.URL, defaultTestOpenShiftVersion, false)
+			err := client.uploadISOs(ctx, defaultTestRhcosObject, defaultTestRhcosObjectMinimal, ts.URL, "", defaultTestOpenShiftVersion, false)
 			Expect(err).ToNot(HaveOccurred())
 		})
+		It("base iso with an unexpected checksum", func() {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, err := w.Write([]byte("not the expected iso"))
+				Expect(err).ToNot(HaveOccurred())
+			}))
+			defer ts.Close()
+
+			publicMockAPI.EXPECT().HeadObject(&s3.HeadObjectInput{
+				Bucket: &publicBucket,
+				Key:    aws.String(defaultTestRhcosObject)}).
+				Return(nil, awserr.New("NotFound", "NotFound", errors.New("NotFound")))
+			publicUploader.EXPECT().Upload(gomock.Any()).Times(0)
+
+			err := client.uploadISOs(ctx, defaultTestRhcosObject, defaultTestRhcosObjectMinimal, ts.URL, defaultTestRhcosSHA256, defaultTestOpenShiftVersion, false)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("compose objects", func() {
+		var (
+			sources  = []string{"chunk-1", "chunk-2"}
+			destName = "composed"
+		)
+		expectSize := func(objectName string, size int64) {
+			mockAPI.EXPECT().HeadObject(&s3.HeadObjectInput{Bucket: &bucket, Key: aws.String(objectName)}).
+				Return(&s3.HeadObjectOutput{ContentLength: aws.Int64(size)}, nil)
+		}
+		It("copies the objects as the parts of the composed object", func() {
+			expectSize("chunk-1", minimumPartSizeBytes)
+			uploadID := "upload1"
+			mockAPI.EXPECT().CreateMultipartUploadWithContext(gomock.Any(), &s3.CreateMultipartUploadInput{Bucket: &bucket, Key: aws.String(destName)}).
+				Return(&s3.CreateMultipartUploadOutput{UploadId: aws.String(uploadID)}, nil)
+			for i, source := range sources {
+				mockAPI.EXPECT().UploadPartCopyWithContext(gomock.Any(), &s3.UploadPartCopyInput{Bucket: &bucket, Key: aws.String(destName),
+					CopySource: aws.String(fmt.Sprintf("/%s/%s", bucket, source)), PartNumber: aws.Int64(int64(i + 1)), UploadId: aws.String(uploadID)}).
+					Return(&s3.UploadPartCopyOutput{CopyPartResult: &s3.CopyPartResult{ETag: aws.String(source)}}, nil)
+			}
+			mockAPI.EXPECT().CompleteMultipartUploadWithContext(gomock.Any(), &s3.CompleteMultipartUploadInput{
+				Bucket:   &bucket,
+				Key:      aws.String(destName),
+				UploadId: aws.String(uploadID),
+				MultipartUpload: &s3.CompletedMultipartUpload{Parts: []*s3.CompletedPart{
+					{ETag: aws.String("chunk-1"), PartNumber: aws.Int64(1)},
+					{ETag: aws.String("chunk-2"), PartNumber: aws.Int64(2)},
+				}},
+			}).Return(&s3.CompleteMultipartUploadOutput{}, nil)
+			uploader.EXPECT().Upload(gomock.Any()).Times(0)
+			Expect(client.ComposeObjects(ctx, sources, destName)).To(Succeed())
+		})
+		It("aborts the upload when an object fails to be copied", func() {
+			expectSize("chunk-1", minimumPartSizeBytes)
+			uploadID := "upload1"
+			mockAPI.EXPECT().CreateMultipartUploadWithContext(gomock.Any(), gomock.Any()).
+				Return(&s3.CreateMultipartUploadOutput{UploadId: aws.String(uploadID)}, nil)
+			mockAPI.EXPECT().UploadPartCopyWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("copy failed"))
+			mockAPI.EXPECT().AbortMultipartUploadWithContext(gomock.Any(), &s3.AbortMultipartUploadInput{Bucket: &bucket, Key: aws.String(destName), UploadId: aws.String(uploadID)})
+			Expect(client.ComposeObjects(ctx, sources, destName)).ToNot(Succeed())
+		})
+		It("streams the objects when one of them is smaller than a part", func() {
+			expectSize("chunk-1", 5)
+			for _, source := range sources {
+				expectSize(source, 5)
+				mockAPI.EXPECT().GetObject(&s3.GetObjectInput{Bucket: &bucket, Key: aws.String(source)}).
+					Return(&s3.GetObjectOutput{Body: ioutil.NopCloser(strings.NewReader(source))}, nil)
+			}
+			mockAPI.EXPECT().CreateMultipartUploadWithContext(gomock.Any(), gomock.Any()).Times(0)
+			uploader.EXPECT().Upload(gomock.Any()).DoAndReturn(func(input *s3manager.UploadInput, _ ...func(*s3manager.Uploader)) (*s3manager.UploadOutput, error) {
+				Expect(*input.Key).To(Equal(destName))
+				content, err := ioutil.ReadAll(input.Body)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(content)).To(Equal("chunk-1chunk-2"))
+				return &s3manager.UploadOutput{}, nil
+			})
+			Expect(client.ComposeObjects(ctx, sources, destName)).To(Succeed())
+		})
+		It("fails to compose no objects", func() {
+			Expect(client.ComposeObjects(ctx, nil, destName)).ToNot(Succeed())
+		})
+		It("fails to compose more objects than the parts of a multipart upload", func() {
+			mockAPI.EXPECT().CreateMultipartUploadWithContext(gomock.Any(), gomock.Any()).Times(0)
+			Expect(client.ComposeObjects(ctx, make([]string, MaxComposedObjects+1), destName)).ToNot(Succeed())
+		})
 	})
 
 	AfterEach(func() {
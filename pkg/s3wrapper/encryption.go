@@ -0,0 +1,559 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/transaction"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type EncryptionConfig struct {
+	// MasterKeys are base64 encoded 256 bit master keys by their IDs, e.g. "key1:<key>,key2:<key>"
+	MasterKeys  map[string]string `envconfig:"STORAGE_ENCRYPTION_MASTER_KEYS" default:""`
+	MasterKeyID string            `envconfig:"STORAGE_ENCRYPTION_MASTER_KEY_ID" default:""`
+	LocalKMSDir string            `envconfig:"STORAGE_ENCRYPTION_LOCAL_KMS_DIR" default:""`
+	// Data keys, and the master keys of the local KMS, are rotated once they are older than the rotation interval
+	KeyRotationInterval  time.Duration `envconfig:"STORAGE_ENCRYPTION_KEY_ROTATION_INTERVAL" default:"2160h"`
+	ReencryptionInterval time.Duration `envconfig:"STORAGE_ENCRYPTION_REENCRYPTION_INTERVAL" default:"1h"`
+}
+
+// Enabled returns whether sensitive objects are encrypted, which requires master keys or a local KMS
+func (c *EncryptionConfig) Enabled() bool {
+	return len(c.MasterKeys) > 0 || c.LocalKMSDir != ""
+}
+
+// NewKMS creates the KMS of the configured master keys, or the local KMS
+func (c *EncryptionConfig) NewKMS() (KMS, error) {
+	if len(c.MasterKeys) > 0 {
+		return NewStaticKMS(c.MasterKeys, c.MasterKeyID)
+	}
+	return NewLocalKMS(c.LocalKMSDir, c.KeyRotationInterval)
+}
+
+const (
+	// encryptedObjectMagic starts the content of encrypted objects, followed by the length of the data key ID,
+	// the data key ID, and the sealed content
+	encryptedObjectMagic = "AIENC1"
+	dataKeySizeBytes     = 32
+	dataKeysPrefix       = "data-keys/"
+	// plaintextSizeSuffix names the object that stores the size of the plaintext of an encrypted object
+	plaintextSizeSuffix = ".size"
+)
+
+// sensitiveFileNames are the names of the sensitive files in the directory of a cluster, in addition to ignition files
+var sensitiveFileNames = map[string]bool{
+	"kubeconfig":           true,
+	"kubeconfig-noingress": true,
+	"kubeadmin-password":   true,
+	"install-config.yaml":  true,
+	// The pull secret and the other secrets of an archived cluster
+	"archive-secrets.json": true,
+	// The RAM disk of a streamed minimal ISO, which holds the proxy settings
+	"discovery-ramdisk.img": true,
+}
+
+// sensitiveObjectCluster returns the ID of the cluster of a sensitive object
+func sensitiveObjectCluster(objectName string) (string, bool) {
+	parts := strings.Split(objectName, "/")
+	if len(parts) != 2 || !strfmt.IsUUID(parts[0]) {
+		return "", false
+	}
+	if sensitiveFileNames[parts[1]] || strings.HasSuffix(parts[1], ".ign") {
+		return parts[0], true
+	}
+	return "", false
+}
+
+// DataKeysObjectName returns the name of the object that stores the data keys of a cluster
+func DataKeysObjectName(clusterID string) string {
+	return fmt.Sprintf("%s%s.json", dataKeysPrefix, clusterID)
+}
+
+// dataKey encrypts the sensitive objects of a cluster, and is stored wrapped by a master key
+type dataKey struct {
+	ID          string     `json:"id"`
+	MasterKeyID string     `json:"master_key_id"`
+	WrappedKey  []byte     `json:"wrapped_key"`
+	CreatedAt   time.Time  `json:"created_at"`
+	RetiredAt   *time.Time `json:"retired_at,omitempty"`
+}
+
+// clusterDataKeys are the data keys of a cluster, the last of which encrypts new objects
+type clusterDataKeys struct {
+	Keys []dataKey `json:"keys"`
+}
+
+func (k *clusterDataKeys) current() *dataKey {
+	if len(k.Keys) == 0 {
+		return nil
+	}
+	return &k.Keys[len(k.Keys)-1]
+}
+
+func (k *clusterDataKeys) find(id string) *dataKey {
+	for i := range k.Keys {
+		if k.Keys[i].ID == id {
+			return &k.Keys[i]
+		}
+	}
+	return nil
+}
+
+// EncryptedClient encrypts the kubeconfigs, kubeadmin password, install config, ignition files and archived secrets of
+// clusters with envelope encryption: each cluster has its own data keys, which are stored wrapped by a master key of the KMS.
+// Objects are decrypted transparently when downloaded, and objects that were stored before encryption was enabled
+// are downloaded as is. All other objects are passed to the wrapped API.
+// The data keys and the sensitive objects of a cluster are changed while holding a lock on the data keys row of the
+// cluster in the DB, which serializes the changes of all the replicas of the service.
+type EncryptedClient struct {
+	API
+	db               *gorm.DB
+	log              logrus.FieldLogger
+	kms              KMS
+	rotationInterval time.Duration
+}
+
+var _ API = &EncryptedClient{}
+
+func NewEncryptedClient(api API, db *gorm.DB, kms KMS, rotationInterval time.Duration, logger logrus.FieldLogger) *EncryptedClient {
+	return &EncryptedClient{
+		API:              api,
+		db:               db,
+		log:              logger,
+		kms:              kms,
+		rotationInterval: rotationInterval,
+	}
+}
+
+func (e *EncryptedClient) Upload(ctx context.Context, data []byte, objectName string) error {
+	clusterID, sensitive := sensitiveObjectCluster(objectName)
+	if !sensitive {
+		return e.API.Upload(ctx, data, objectName)
+	}
+	err := e.withDataKeysLock(clusterID, func() error {
+		key, err := e.currentDataKey(ctx, clusterID)
+		if err != nil {
+			return err
+		}
+		return e.uploadEncrypted(ctx, key, data, objectName)
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to encrypt object %s", objectName)
+		logutil.FromContext(ctx, e.log).Error(err)
+		return err
+	}
+	return nil
+}
+
+func (e *EncryptedClient) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	if _, sensitive := sensitiveObjectCluster(objectName); !sensitive {
+		return e.API.UploadStream(ctx, reader, objectName)
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to read data of object %s", objectName)
+	}
+	return e.Upload(ctx, data, objectName)
+}
+
+func (e *EncryptedClient) UploadFile(ctx context.Context, filePath, objectName string) error {
+	if _, sensitive := sensitiveObjectCluster(objectName); !sensitive {
+		return e.API.UploadFile(ctx, filePath, objectName)
+	}
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to open file %s for upload", filePath)
+	}
+	return e.Upload(ctx, data, objectName)
+}
+
+// ComposeObjects composes sensitive objects by their decrypted content, which is encrypted again
+func (e *EncryptedClient) ComposeObjects(ctx context.Context, sourceObjects []string, objectName string) error {
+	sensitive := false
+	for _, name := range append([]string{objectName}, sourceObjects...) {
+		if _, ok := sensitiveObjectCluster(name); ok {
+			sensitive = true
+		}
+	}
+	if !sensitive {
+		return e.API.ComposeObjects(ctx, sourceObjects, objectName)
+	}
+	reader := newObjectsReader(ctx, e, sourceObjects)
+	defer reader.Close()
+	return e.UploadStream(ctx, reader, objectName)
+}
+
+func (e *EncryptedClient) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	clusterID, sensitive := sensitiveObjectCluster(objectName)
+	if !sensitive {
+		return e.API.Download(ctx, objectName)
+	}
+	data, err := e.downloadAll(ctx, objectName)
+	if err != nil {
+		return nil, 0, err
+	}
+	data, err = e.decrypt(ctx, clusterID, data)
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to decrypt object %s", objectName)
+		logutil.FromContext(ctx, e.log).Error(err)
+		return nil, 0, err
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), int64(len(data)), nil
+}
+
+func (e *EncryptedClient) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	if _, sensitive := sensitiveObjectCluster(objectName); !sensitive {
+		return e.API.GetObjectSizeBytes(ctx, objectName)
+	}
+	data, err := e.downloadAll(ctx, objectName+plaintextSizeSuffix)
+	if err != nil {
+		// Objects that were stored before encryption was enabled have no plaintext size
+		if _, ok := err.(common.NotFound); ok {
+			return e.API.GetObjectSizeBytes(ctx, objectName)
+		}
+		return 0, err
+	}
+	size, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Invalid plaintext size of object %s", objectName)
+	}
+	return size, nil
+}
+
+// GeneratePresignedDownloadURL fails for sensitive objects, whose stored content is encrypted
+func (e *EncryptedClient) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
+	if _, sensitive := sensitiveObjectCluster(objectName); sensitive {
+		return "", common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("%s is encrypted at rest and can only be downloaded through the API", path.Base(objectName)))
+	}
+	return e.API.GeneratePresignedDownloadURL(ctx, objectName, downloadFilename, duration)
+}
+
+// DeleteObject deletes the data keys of a cluster together with the directory of the cluster, and the plaintext size
+// together with a sensitive object
+func (e *EncryptedClient) DeleteObject(ctx context.Context, objectName string) (bool, error) {
+	if strfmt.IsUUID(objectName) {
+		if _, err := e.API.DeleteObject(ctx, DataKeysObjectName(objectName)); err != nil {
+			return false, err
+		}
+		if err := e.db.Where("cluster_id = ?", objectName).Delete(&common.DataKeysLock{}).Error; err != nil {
+			return false, errors.Wrapf(err, "failed to delete the data keys lock of cluster %s", objectName)
+		}
+	}
+	if _, sensitive := sensitiveObjectCluster(objectName); sensitive {
+		if _, err := e.API.DeleteObject(ctx, objectName+plaintextSizeSuffix); err != nil {
+			return false, err
+		}
+	}
+	return e.API.DeleteObject(ctx, objectName)
+}
+
+// withDataKeysLock calls f while holding the lock of the data keys of a cluster
+func (e *EncryptedClient) withDataKeysLock(clusterID string, f func() error) error {
+	success := false
+	tx := e.db.Begin()
+	defer func() {
+		if !success {
+			tx.Rollback()
+		}
+	}()
+	// Concurrent inserts of the row of a new cluster wait for each other, and all but one of them insert nothing
+	if err := tx.Exec("INSERT INTO data_keys_locks (cluster_id) VALUES (?) ON CONFLICT DO NOTHING", clusterID).Error; err != nil {
+		return errors.Wrapf(err, "failed to create the data keys lock of cluster %s", clusterID)
+	}
+	var lock common.DataKeysLock
+	if err := transaction.AddForUpdateQueryOption(tx).Take(&lock, "cluster_id = ?", clusterID).Error; err != nil {
+		return errors.Wrapf(err, "failed to lock the data keys of cluster %s", clusterID)
+	}
+	if err := f(); err != nil {
+		return err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+	success = true
+	return nil
+}
+
+// uploadEncrypted uploads an object encrypted with a data key, followed by the size of its plaintext
+func (e *EncryptedClient) uploadEncrypted(ctx context.Context, key *dataKey, data []byte, objectName string) error {
+	encrypted, err := e.encryptWithKey(ctx, key, data)
+	if err != nil {
+		return err
+	}
+	if err = e.API.Upload(ctx, encrypted, objectName); err != nil {
+		return err
+	}
+	return e.API.Upload(ctx, []byte(strconv.Itoa(len(data))), objectName+plaintextSizeSuffix)
+}
+
+func (e *EncryptedClient) downloadAll(ctx context.Context, objectName string) ([]byte, error) {
+	reader, _, err := e.API.Download(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to read object %s", objectName)
+	}
+	return data, nil
+}
+
+func (e *EncryptedClient) encryptWithKey(ctx context.Context, key *dataKey, data []byte) ([]byte, error) {
+	plainKey, err := e.kms.UnwrapKey(ctx, key.MasterKeyID, key.WrappedKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unwrap data key %s", key.ID)
+	}
+	sealed, err := seal(plainKey, data)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString(encryptedObjectMagic)
+	buf.WriteByte(byte(len(key.ID)))
+	buf.WriteString(key.ID)
+	buf.Write(sealed)
+	return buf.Bytes(), nil
+}
+
+// parseEncryptedObject returns the ID of the data key and the sealed content of an encrypted object, or false for
+// an object that was stored before encryption was enabled
+func parseEncryptedObject(data []byte) (string, []byte, bool) {
+	if !bytes.HasPrefix(data, []byte(encryptedObjectMagic)) || len(data) < len(encryptedObjectMagic)+1 {
+		return "", nil, false
+	}
+	idLength := int(data[len(encryptedObjectMagic)])
+	start := len(encryptedObjectMagic) + 1
+	if len(data) < start+idLength {
+		return "", nil, false
+	}
+	return string(data[start : start+idLength]), data[start+idLength:], true
+}
+
+func (e *EncryptedClient) decrypt(ctx context.Context, clusterID string, data []byte) ([]byte, error) {
+	keyID, sealed, encrypted := parseEncryptedObject(data)
+	if !encrypted {
+		return data, nil
+	}
+	keys, err := e.loadDataKeys(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	key := keys.find(keyID)
+	if key == nil {
+		return nil, errors.Errorf("data key %s of cluster %s not found", keyID, clusterID)
+	}
+	plainKey, err := e.kms.UnwrapKey(ctx, key.MasterKeyID, key.WrappedKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unwrap data key %s", keyID)
+	}
+	return open(plainKey, sealed)
+}
+
+func (e *EncryptedClient) loadDataKeys(ctx context.Context, clusterID string) (*clusterDataKeys, error) {
+	keys := &clusterDataKeys{}
+	data, err := e.downloadAll(ctx, DataKeysObjectName(clusterID))
+	if err != nil {
+		if _, ok := err.(common.NotFound); ok {
+			return keys, nil
+		}
+		return nil, errors.Wrapf(err, "failed to download data keys of cluster %s", clusterID)
+	}
+	if err = json.Unmarshal(data, keys); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse data keys of cluster %s", clusterID)
+	}
+	return keys, nil
+}
+
+// storeDataKeys stores the data keys of a cluster, and reads them back to make sure that the stored keys are the ones
+// that objects are encrypted with
+func (e *EncryptedClient) storeDataKeys(ctx context.Context, clusterID string, keys *clusterDataKeys) error {
+	data, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+	if err = e.API.Upload(ctx, data, DataKeysObjectName(clusterID)); err != nil {
+		return errors.Wrapf(err, "failed to store data keys of cluster %s", clusterID)
+	}
+	stored, err := e.loadDataKeys(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+	if len(stored.Keys) != len(keys.Keys) || (len(keys.Keys) > 0 && stored.current().ID != keys.current().ID) {
+		return errors.Errorf("the stored data keys of cluster %s were changed concurrently", clusterID)
+	}
+	return nil
+}
+
+func (e *EncryptedClient) newDataKey(ctx context.Context) (*dataKey, error) {
+	plainKey := make([]byte, dataKeySizeBytes)
+	if _, err := io.ReadFull(rand.Reader, plainKey); err != nil {
+		return nil, errors.Wrap(err, "failed to generate data key")
+	}
+	id := make([]byte, 8)
+	if _, err := io.ReadFull(rand.Reader, id); err != nil {
+		return nil, errors.Wrap(err, "failed to generate data key ID")
+	}
+	masterKeyID, wrapped, err := e.kms.WrapKey(ctx, plainKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to wrap data key")
+	}
+	return &dataKey{ID: hex.EncodeToString(id), MasterKeyID: masterKeyID, WrappedKey: wrapped, CreatedAt: time.Now().UTC()}, nil
+}
+
+// currentDataKey returns the data key that encrypts new objects of a cluster, which is created for the first object.
+// It is called while holding the lock of the data keys of the cluster.
+func (e *EncryptedClient) currentDataKey(ctx context.Context, clusterID string) (*dataKey, error) {
+	keys, err := e.loadDataKeys(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if current := keys.current(); current != nil {
+		return current, nil
+	}
+	key, err := e.newDataKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys.Keys = append(keys.Keys, *key)
+	if err = e.storeDataKeys(ctx, clusterID, keys); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// ReencryptObjects rotates the data keys of clusters that are older than the rotation interval or that were wrapped by
+// a master key that is no longer current, and re-encrypts the sensitive objects of the clusters with their current data
+// key, including objects that were stored before encryption was enabled. Retired data keys are deleted once no object
+// uses them, after a grace period for uploads that started before they were retired.
+func (e *EncryptedClient) ReencryptObjects(ctx context.Context) {
+	log := logutil.FromContext(ctx, e.log)
+	masterKeyID, err := e.kms.CurrentKeyID(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to get the current master key")
+		return
+	}
+	objects, err := e.API.ListObjectsByPrefix(ctx, "")
+	if err != nil {
+		log.WithError(err).Error("Failed to list objects to re-encrypt")
+		return
+	}
+	sensitiveObjects := make(map[string][]string)
+	for _, objectName := range objects {
+		if clusterID, sensitive := sensitiveObjectCluster(objectName); sensitive {
+			sensitiveObjects[clusterID] = append(sensitiveObjects[clusterID], objectName)
+		}
+	}
+	for clusterID, clusterObjects := range sensitiveObjects {
+		clusterID, clusterObjects := clusterID, clusterObjects
+		err := e.withDataKeysLock(clusterID, func() error {
+			return e.reencryptCluster(ctx, clusterID, masterKeyID, clusterObjects)
+		})
+		if err != nil {
+			log.WithError(err).Errorf("Failed to re-encrypt the objects of cluster %s", clusterID)
+		}
+	}
+}
+
+func (e *EncryptedClient) rotateDataKey(ctx context.Context, clusterID, masterKeyID string) (*clusterDataKeys, error) {
+	keys, err := e.loadDataKeys(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	current := keys.current()
+	if current != nil && current.MasterKeyID == masterKeyID && time.Since(current.CreatedAt) < e.rotationInterval {
+		return keys, nil
+	}
+	key, err := e.newDataKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil {
+		retiredAt := time.Now().UTC()
+		current.RetiredAt = &retiredAt
+	}
+	keys.Keys = append(keys.Keys, *key)
+	if err = e.storeDataKeys(ctx, clusterID, keys); err != nil {
+		return nil, err
+	}
+	logutil.FromContext(ctx, e.log).Infof("Rotated the data key of cluster %s", clusterID)
+	return keys, nil
+}
+
+// reencryptCluster is called while holding the lock of the data keys of the cluster, such that objects that are
+// uploaded concurrently are not overwritten by their previous content
+func (e *EncryptedClient) reencryptCluster(ctx context.Context, clusterID, masterKeyID string, objects []string) error {
+	log := logutil.FromContext(ctx, e.log)
+	keys, err := e.rotateDataKey(ctx, clusterID, masterKeyID)
+	if err != nil {
+		return err
+	}
+	current := keys.current()
+	inUse := make(map[string]bool)
+	for _, objectName := range objects {
+		data, err := e.downloadAll(ctx, objectName)
+		if err != nil {
+			if _, ok := err.(common.NotFound); ok {
+				continue
+			}
+			return err
+		}
+		keyID, _, encrypted := parseEncryptedObject(data)
+		if encrypted && keyID == current.ID {
+			continue
+		}
+		plaintext, err := e.decrypt(ctx, clusterID, data)
+		if err != nil {
+			inUse[keyID] = true
+			log.WithError(err).Errorf("Failed to decrypt object %s", objectName)
+			continue
+		}
+		if err = e.uploadEncrypted(ctx, current, plaintext, objectName); err != nil {
+			return err
+		}
+		log.Infof("Re-encrypted object %s with data key %s", objectName, current.ID)
+	}
+	return e.deleteRetiredDataKeys(ctx, clusterID, inUse)
+}
+
+// deleteRetiredDataKeys deletes the retired data keys of a cluster that are not in use, and that were retired before
+// the last re-encryption
+func (e *EncryptedClient) deleteRetiredDataKeys(ctx context.Context, clusterID string, inUse map[string]bool) error {
+	keys, err := e.loadDataKeys(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+	remaining := keys.Keys[:0]
+	for _, key := range keys.Keys {
+		if key.RetiredAt == nil || inUse[key.ID] || time.Since(*key.RetiredAt) < dataKeyRetirementGracePeriod {
+			remaining = append(remaining, key)
+		}
+	}
+	if len(remaining) == len(keys.Keys) {
+		return nil
+	}
+	keys.Keys = remaining
+	return e.storeDataKeys(ctx, clusterID, keys)
+}
+
+// dataKeyRetirementGracePeriod is the time that retired data keys are kept for objects that were being encrypted while
+// they were retired
+var dataKeyRetirementGracePeriod = 10 * time.Minute
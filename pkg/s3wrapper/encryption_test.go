@@ -0,0 +1,345 @@
+package s3wrapper
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/sirupsen/logrus"
+)
+
+func newMasterKey() string {
+	key := make([]byte, masterKeySizeBytes)
+	_, err := rand.Read(key)
+	Expect(err).ToNot(HaveOccurred())
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+var _ = Describe("EncryptedClient", func() {
+	var (
+		ctx        = context.Background()
+		log        = logrus.New()
+		baseDir    string
+		storage    *FSClient
+		client     *EncryptedClient
+		masterKeys map[string]string
+		clusterID  string
+		db         *gorm.DB
+		dbName     string
+	)
+
+	BeforeEach(func() {
+		log.SetOutput(ioutil.Discard)
+		var err error
+		baseDir, err = ioutil.TempDir("", "encryption")
+		Expect(err).ToNot(HaveOccurred())
+		storage = &FSClient{basedir: baseDir, log: log}
+		masterKeys = map[string]string{"key1": newMasterKey()}
+		kms, err := NewStaticKMS(masterKeys, "")
+		Expect(err).ToNot(HaveOccurred())
+		db, dbName = common.PrepareTestDB()
+		client = NewEncryptedClient(storage, db, kms, time.Hour, log)
+		clusterID = uuid.New().String()
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		Expect(os.RemoveAll(baseDir)).To(Succeed())
+	})
+
+	stored := func(objectName string) []byte {
+		content, err := ioutil.ReadFile(filepath.Join(baseDir, objectName))
+		Expect(err).ToNot(HaveOccurred())
+		return content
+	}
+
+	download := func(c API, objectName string) []byte {
+		reader, size, err := c.Download(ctx, objectName)
+		Expect(err).ToNot(HaveOccurred())
+		content, err := ioutil.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reader.Close()).To(Succeed())
+		Expect(int64(len(content))).To(Equal(size))
+		return content
+	}
+
+	storedKeys := func() clusterDataKeys {
+		var keys clusterDataKeys
+		Expect(json.Unmarshal(stored(DataKeysObjectName(clusterID)), &keys)).To(Succeed())
+		return keys
+	}
+
+	It("encrypts sensitive objects", func() {
+		for _, name := range []string{"kubeconfig", "kubeconfig-noingress", "kubeadmin-password", "install-config.yaml", "bootstrap.ign",
+			"archive-secrets.json", "discovery-ramdisk.img", "master-" + uuid.New().String() + ".ign"} {
+			objectName := clusterID + "/" + name
+			Expect(client.Upload(ctx, []byte("secret"), objectName)).To(Succeed())
+			Expect(stored(objectName)).To(HavePrefix(encryptedObjectMagic))
+			Expect(string(stored(objectName))).ToNot(ContainSubstring("secret"))
+			Expect(download(client, objectName)).To(Equal([]byte("secret")))
+			size, err := client.GetObjectSizeBytes(ctx, objectName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(size).To(Equal(int64(6)))
+		}
+		Expect(storedKeys().Keys).To(HaveLen(1))
+	})
+
+	It("encrypts sensitive streams and files", func() {
+		file := filepath.Join(baseDir, "upload")
+		Expect(ioutil.WriteFile(file, []byte("file secret"), 0600)).To(Succeed())
+		Expect(client.UploadFile(ctx, file, clusterID+"/worker.ign")).To(Succeed())
+		Expect(stored(clusterID + "/worker.ign")).To(HavePrefix(encryptedObjectMagic))
+		Expect(download(client, clusterID+"/worker.ign")).To(Equal([]byte("file secret")))
+	})
+
+	It("stores other objects as is", func() {
+		for _, objectName := range []string{clusterID + "/metadata.json", clusterID + "/logs/kubeconfig", "discovery-image-" + clusterID + ".iso"} {
+			Expect(client.Upload(ctx, []byte("public"), objectName)).To(Succeed())
+			Expect(stored(objectName)).To(Equal([]byte("public")))
+			Expect(download(client, objectName)).To(Equal([]byte("public")))
+		}
+		_, err := os.Stat(filepath.Join(baseDir, DataKeysObjectName(clusterID)))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("uses a data key per cluster", func() {
+		otherClusterID := uuid.New().String()
+		Expect(client.Upload(ctx, []byte("secret"), clusterID+"/kubeconfig")).To(Succeed())
+		Expect(client.Upload(ctx, []byte("secret"), otherClusterID+"/kubeconfig")).To(Succeed())
+		keyID, _, _ := parseEncryptedObject(stored(clusterID + "/kubeconfig"))
+		otherKeyID, _, _ := parseEncryptedObject(stored(otherClusterID + "/kubeconfig"))
+		Expect(keyID).ToNot(Equal(otherKeyID))
+
+		// The object of a cluster can't be decrypted with the data key of another cluster
+		Expect(os.Rename(filepath.Join(baseDir, otherClusterID, "kubeconfig"), filepath.Join(baseDir, clusterID, "kubeconfig"))).To(Succeed())
+		_, _, err := client.Download(ctx, clusterID+"/kubeconfig")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("downloads objects stored before encryption was enabled", func() {
+		Expect(storage.Upload(ctx, []byte("plaintext"), clusterID+"/kubeconfig")).To(Succeed())
+		Expect(download(client, clusterID+"/kubeconfig")).To(Equal([]byte("plaintext")))
+		size, err := client.GetObjectSizeBytes(ctx, clusterID+"/kubeconfig")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(size).To(Equal(int64(9)))
+	})
+
+	It("gets the size of sensitive objects without decrypting them", func() {
+		Expect(client.Upload(ctx, []byte("secret"), clusterID+"/kubeconfig")).To(Succeed())
+		kms, err := NewStaticKMS(map[string]string{"key2": newMasterKey()}, "")
+		Expect(err).ToNot(HaveOccurred())
+		size, err := NewEncryptedClient(storage, db, kms, time.Hour, log).GetObjectSizeBytes(ctx, clusterID+"/kubeconfig")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(size).To(Equal(int64(6)))
+	})
+
+	It("creates a single data key for concurrent uploads of replicas", func() {
+		kms, err := NewStaticKMS(masterKeys, "")
+		Expect(err).ToNot(HaveOccurred())
+		replicas := []*EncryptedClient{client, NewEncryptedClient(storage, db, kms, time.Hour, log)}
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				Expect(replicas[i%2].Upload(ctx, []byte("secret"), fmt.Sprintf("%s/master-%d.ign", clusterID, i))).To(Succeed())
+			}(i)
+		}
+		wg.Wait()
+		Expect(storedKeys().Keys).To(HaveLen(1))
+		for i := 0; i < 10; i++ {
+			Expect(download(client, fmt.Sprintf("%s/master-%d.ign", clusterID, i))).To(Equal([]byte("secret")))
+		}
+	})
+
+	It("returns not found for a missing object", func() {
+		_, _, err := client.Download(ctx, clusterID+"/kubeconfig")
+		Expect(err).To(BeAssignableToTypeOf(common.NotFound("")))
+	})
+
+	It("fails to decrypt without the master key", func() {
+		Expect(client.Upload(ctx, []byte("secret"), clusterID+"/kubeconfig")).To(Succeed())
+		kms, err := NewStaticKMS(map[string]string{"key2": newMasterKey()}, "")
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = NewEncryptedClient(storage, db, kms, time.Hour, log).Download(ctx, clusterID+"/kubeconfig")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("does not presign URLs of sensitive objects", func() {
+		_, err := client.GeneratePresignedDownloadURL(ctx, clusterID+"/kubeconfig", "kubeconfig", time.Minute)
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+	})
+
+	It("deletes the data keys with the directory of the cluster", func() {
+		Expect(client.Upload(ctx, []byte("secret"), clusterID+"/kubeconfig")).To(Succeed())
+		Expect(client.DeleteObject(ctx, clusterID+"/kubeconfig")).To(BeTrue())
+		Expect(client.DeleteObject(ctx, clusterID)).To(BeTrue())
+		exists, err := storage.DoesObjectExist(ctx, DataKeysObjectName(clusterID))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+		var count int
+		Expect(db.Model(&common.DataKeysLock{}).Where("cluster_id = ?", clusterID).Count(&count).Error).To(Succeed())
+		Expect(count).To(BeZero())
+	})
+
+	Context("re-encryption", func() {
+		rotateMasterKey := func() {
+			masterKeys["key2"] = newMasterKey()
+			kms, err := NewStaticKMS(masterKeys, "key2")
+			Expect(err).ToNot(HaveOccurred())
+			client = NewEncryptedClient(storage, db, kms, time.Hour, log)
+		}
+
+		It("does nothing while the data key is current", func() {
+			Expect(client.Upload(ctx, []byte("secret"), clusterID+"/kubeconfig")).To(Succeed())
+			before := stored(clusterID + "/kubeconfig")
+			client.ReencryptObjects(ctx)
+			Expect(stored(clusterID + "/kubeconfig")).To(Equal(before))
+			Expect(storedKeys().Keys).To(HaveLen(1))
+		})
+
+		It("rotates the data key when the master key is rotated", func() {
+			Expect(client.Upload(ctx, []byte("secret"), clusterID+"/kubeconfig")).To(Succeed())
+			Expect(client.Upload(ctx, []byte("password"), clusterID+"/kubeadmin-password")).To(Succeed())
+			rotateMasterKey()
+
+			client.ReencryptObjects(ctx)
+			keys := storedKeys()
+			Expect(keys.Keys).To(HaveLen(2))
+			Expect(keys.Keys[0].RetiredAt).ToNot(BeNil())
+			Expect(keys.current().MasterKeyID).To(Equal("key2"))
+			for _, name := range []string{"kubeconfig", "kubeadmin-password"} {
+				keyID, _, encrypted := parseEncryptedObject(stored(clusterID + "/" + name))
+				Expect(encrypted).To(BeTrue())
+				Expect(keyID).To(Equal(keys.current().ID))
+			}
+			Expect(download(client, clusterID+"/kubeconfig")).To(Equal([]byte("secret")))
+
+			// The retired data key is deleted after the grace period, and the previous master key is then unused
+			keys.Keys[0].RetiredAt = timePtr(time.Now().Add(-time.Hour))
+			data, err := json.Marshal(keys)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(storage.Upload(ctx, data, DataKeysObjectName(clusterID))).To(Succeed())
+			client.ReencryptObjects(ctx)
+			Expect(storedKeys().Keys).To(HaveLen(1))
+
+			delete(masterKeys, "key1")
+			kms, err := NewStaticKMS(masterKeys, "key2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(download(NewEncryptedClient(storage, db, kms, time.Hour, log), clusterID+"/kubeconfig")).To(Equal([]byte("secret")))
+		})
+
+		It("rotates data keys that are older than the rotation interval", func() {
+			Expect(client.Upload(ctx, []byte("secret"), clusterID+"/kubeconfig")).To(Succeed())
+			kms, err := NewStaticKMS(masterKeys, "")
+			Expect(err).ToNot(HaveOccurred())
+			client = NewEncryptedClient(storage, db, kms, 0, log)
+			client.ReencryptObjects(ctx)
+			keys := storedKeys()
+			Expect(keys.Keys).To(HaveLen(2))
+			keyID, _, _ := parseEncryptedObject(stored(clusterID + "/kubeconfig"))
+			Expect(keyID).To(Equal(keys.current().ID))
+		})
+
+		It("encrypts objects stored before encryption was enabled", func() {
+			Expect(storage.Upload(ctx, []byte("plaintext"), clusterID+"/install-config.yaml")).To(Succeed())
+			client.ReencryptObjects(ctx)
+			Expect(stored(clusterID + "/install-config.yaml")).To(HavePrefix(encryptedObjectMagic))
+			Expect(download(client, clusterID+"/install-config.yaml")).To(Equal([]byte("plaintext")))
+		})
+	})
+})
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+var _ = Describe("KMS", func() {
+	var ctx = context.Background()
+
+	It("validates the configured master keys", func() {
+		_, err := NewStaticKMS(map[string]string{}, "")
+		Expect(err).To(HaveOccurred())
+		_, err = NewStaticKMS(map[string]string{"key1": "not base64!"}, "")
+		Expect(err).To(HaveOccurred())
+		_, err = NewStaticKMS(map[string]string{"key1": base64.StdEncoding.EncodeToString([]byte("short"))}, "")
+		Expect(err).To(HaveOccurred())
+		_, err = NewStaticKMS(map[string]string{"key1": newMasterKey(), "key2": newMasterKey()}, "")
+		Expect(err).To(HaveOccurred())
+		_, err = NewStaticKMS(map[string]string{"key1": newMasterKey()}, "key2")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("wraps keys with the current master key", func() {
+		kms, err := NewStaticKMS(map[string]string{"key1": newMasterKey(), "key2": newMasterKey()}, "key2")
+		Expect(err).ToNot(HaveOccurred())
+		id, wrapped, err := kms.WrapKey(ctx, []byte("data key"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(id).To(Equal("key2"))
+		Expect(kms.UnwrapKey(ctx, "key2", wrapped)).To(Equal([]byte("data key")))
+		_, err = kms.UnwrapKey(ctx, "key1", wrapped)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("local", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "kms")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("keeps its master key", func() {
+			kms, err := NewLocalKMS(dir, time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			id, wrapped, err := kms.WrapKey(ctx, []byte("data key"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kms.CurrentKeyID(ctx)).To(Equal(id))
+
+			kms, err = NewLocalKMS(dir, time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kms.CurrentKeyID(ctx)).To(Equal(id))
+			Expect(kms.UnwrapKey(ctx, id, wrapped)).To(Equal([]byte("data key")))
+		})
+
+		It("rotates its master key", func() {
+			kms, err := NewLocalKMS(dir, time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			id, wrapped, err := kms.WrapKey(ctx, []byte("data key"))
+			Expect(err).ToNot(HaveOccurred())
+
+			kms, err = NewLocalKMS(dir, 0)
+			Expect(err).ToNot(HaveOccurred())
+			newID, err := kms.CurrentKeyID(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(newID).ToNot(Equal(id))
+			Expect(kms.UnwrapKey(ctx, id, wrapped)).To(Equal([]byte("data key")))
+		})
+
+		It("rejects invalid master key IDs", func() {
+			kms, err := NewLocalKMS(dir, time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = kms.UnwrapKey(ctx, "../key", []byte("wrapped"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -43,17 +43,7 @@ func (l *fileList) clear() {
 }
 
 func downloadFile(ctx context.Context, objectHandler API, objectName string, cacheDir string, public bool) (string, error) {
-	var (
-		reader io.ReadCloser
-		err    error
-		size   int64
-	)
-
-	if public {
-		reader, size, err = objectHandler.DownloadPublic(ctx, objectName)
-	} else {
-		reader, size, err = objectHandler.Download(ctx, objectName)
-	}
+	reader, size, err := download(ctx, objectHandler, objectName, public)
 	if err != nil {
 		return "", err
 	}
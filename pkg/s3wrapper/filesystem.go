@@ -15,9 +15,11 @@ import (
 	"github.com/google/renameio"
 	"github.com/moby/moby/pkg/ioutils"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/isoeditor"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/versions"
+	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -29,9 +31,12 @@ type FSClient struct {
 	basedir          string
 	versionsHandler  versions.Handler
 	isoEditorFactory isoeditor.Factory
+	// serviceBaseURL is the URL of the service that signed download URLs point to
+	serviceBaseURL string
 }
 
-func NewFSClient(basedir string, logger logrus.FieldLogger, versionsHandler versions.Handler, isoEditorFactory isoeditor.Factory, metricsAPI metrics.API, fsThreshold int) *FSClientDecorator {
+func NewFSClient(basedir string, logger logrus.FieldLogger, versionsHandler versions.Handler, isoEditorFactory isoeditor.Factory, metricsAPI metrics.API,
+	fsThreshold int, serviceBaseURL string) *FSClientDecorator {
 	return &FSClientDecorator{
 		log:        logger,
 		metricsAPI: metricsAPI,
@@ -40,6 +45,7 @@ func NewFSClient(basedir string, logger logrus.FieldLogger, versionsHandler vers
 			basedir:          basedir,
 			versionsHandler:  versionsHandler,
 			isoEditorFactory: isoEditorFactory,
+			serviceBaseURL:   serviceBaseURL,
 		},
 		fsUsageThreshold:              fsThreshold,
 		timeFSUsageLog:                time.Now().Add(-1 * time.Hour),
@@ -95,6 +101,13 @@ func (f *FSClient) UploadFileToPublicBucket(ctx context.Context, filePath, objec
 	return f.UploadFile(ctx, filePath, objectName)
 }
 
+// ComposeObjects appends the files to a temporary file, which replaces the file of the object once it is complete
+func (f *FSClient) ComposeObjects(ctx context.Context, sourceObjects []string, objectName string) error {
+	reader := newObjectsReader(ctx, f, sourceObjects)
+	defer reader.Close()
+	return f.UploadStream(ctx, reader, objectName)
+}
+
 func (f *FSClient) UploadISO(ctx context.Context, ignitionConfig, srcObject, destObjectPrefix string) error {
 	log := logutil.FromContext(ctx, f.log)
 	resultFile := filepath.Join(f.basedir, fmt.Sprintf("%s.iso", destObjectPrefix))
@@ -108,6 +121,20 @@ func (f *FSClient) UploadISO(ctx context.Context, ignitionConfig, srcObject, des
 	return isoeditor.EmbedIgnition(baseFile, resultFile, ignitionConfig)
 }
 
+// StreamISO reads the ISO generated from the base ISO with the given ignition config, RAM disk and kernel arguments, without writing it
+func (f *FSClient) StreamISO(ctx context.Context, ignitionConfig string, ramDisk []byte, kernelArguments models.KernelArguments, srcObject string) (ReadSeekCloser, int64, error) {
+	log := logutil.FromContext(ctx, f.log)
+	reader, err := isoeditor.NewClusterISOReader(filepath.Join(f.basedir, srcObject), ignitionConfig, ramDisk, kernelArguments)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, 0, common.NotFound(srcObject)
+		}
+		log.WithError(err).Errorf("Failed to stream ISO from %s", srcObject)
+		return nil, 0, err
+	}
+	return reader, reader.Size(), nil
+}
+
 func (f *FSClient) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
 	log := logutil.FromContext(ctx, f.log)
 	filePath := filepath.Join(f.basedir, objectName)
@@ -232,8 +259,29 @@ func (f *FSClient) GetObjectSizeBytes(ctx context.Context, objectName string) (i
 	return info.Size(), nil
 }
 
+// GeneratePresignedDownloadURL returns a URL of the service that downloads the object until the duration passes,
+// signed with the private key of the service
 func (f *FSClient) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
-	return "", nil
+	log := logutil.FromContext(ctx, f.log)
+	if f.serviceBaseURL == "" {
+		err := errors.Errorf("Failed to create presigned download URL for object %s: service base URL is not set", objectName)
+		log.Error(err)
+		return "", err
+	}
+	expires := time.Now().Add(duration)
+	keyID, signature, err := gencrypto.ObjectURLSignature(objectName, downloadFilename, expires)
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to create presigned download URL for object %s", objectName)
+		log.Error(err)
+		return "", err
+	}
+	return signedObjectURL(f.serviceBaseURL, objectName, downloadFilename, expires, keyID, signature), nil
+}
+
+// SupportsPresignedDownloadURLs returns whether the service can sign download URLs, which requires its base URL and
+// private key
+func (f *FSClient) SupportsPresignedDownloadURLs() bool {
+	return f.serviceBaseURL != "" && gencrypto.ObjectURLSigningEnabled()
 }
 
 func (f *FSClient) UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error) {
@@ -251,6 +299,18 @@ func (f *FSClient) UpdateObjectTimestamp(ctx context.Context, objectName string)
 }
 
 func (f *FSClient) ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration, callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	f.expireObjects(ctx, prefix, deleteTime, nil, callback)
+}
+
+func (f *FSClient) ExpireObjectsUnlessReferenced(ctx context.Context, prefix string, deleteTime time.Duration,
+	isReferenced func(ctx context.Context, log logrus.FieldLogger, objectName string) bool,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	f.expireObjects(ctx, prefix, deleteTime, isReferenced, callback)
+}
+
+func (f *FSClient) expireObjects(ctx context.Context, prefix string, deleteTime time.Duration,
+	isReferenced func(ctx context.Context, log logrus.FieldLogger, objectName string) bool,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
 	log := logutil.FromContext(ctx, f.log)
 	now := time.Now()
 
@@ -260,7 +320,7 @@ func (f *FSClient) ExpireObjects(ctx context.Context, prefix string, deleteTime
 			return err
 		}
 		if strings.HasPrefix(filepath.Base(path), prefix) && !info.IsDir() {
-			f.handleFile(ctx, log, path, info, now, deleteTime, callback)
+			f.handleFile(ctx, log, path, info, now, deleteTime, isReferenced, callback)
 		}
 		return nil
 	})
@@ -270,12 +330,23 @@ func (f *FSClient) ExpireObjects(ctx context.Context, prefix string, deleteTime
 	}
 }
 
-func (f *FSClient) handleFile(ctx context.Context, log logrus.FieldLogger, filePath string, fileInfo os.FileInfo, now time.Time,
-	deleteTime time.Duration, callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
-	if now.Before(fileInfo.ModTime().Add(deleteTime)) {
+func (f *FSClient) handleFile(ctx context.Context, log logrus.FieldLogger, filePath string, fileInfo os.FileInfo, now time.Time, deleteTime time.Duration,
+	isReferenced func(ctx context.Context, log logrus.FieldLogger, objectName string) bool,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	if isChecksumObject(filePath) || now.Before(fileInfo.ModTime().Add(deleteTime)) {
 		return
 	}
-	err := os.Remove(filePath)
+	// Callers know the file by its object name, such as the name under which its quota is charged
+	objectName, err := filepath.Rel(f.basedir, filePath)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to get the object name of file %s", filePath)
+		return
+	}
+	if isReferenced != nil && isReferenced(ctx, log, objectName) {
+		log.Infof("Keeping expired file %s since it is still referenced", filePath)
+		return
+	}
+	err = os.Remove(filePath)
 	if err != nil {
 		if !os.IsNotExist(err) {
 			log.WithError(err).Errorf("Failed to delete file %s", filePath)
@@ -283,7 +354,10 @@ func (f *FSClient) handleFile(ctx context.Context, log logrus.FieldLogger, fileP
 		return
 	}
 	log.Infof("Deleted expired file %s", filePath)
-	callback(ctx, log, filePath)
+	if err = os.Remove(ChecksumObjectName(filePath)); err != nil && !os.IsNotExist(err) {
+		log.WithError(err).Errorf("Failed to delete the checksum of file %s", filePath)
+	}
+	callback(ctx, log, objectName)
 }
 
 func (f *FSClient) ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
@@ -327,6 +401,11 @@ func (f *FSClient) UploadISOs(ctx context.Context, openshiftVersion string, have
 		return err
 	}
 
+	rhcosImageSHA256, err := f.versionsHandler.GetRHCOSImageSHA256(openshiftVersion)
+	if err != nil {
+		return err
+	}
+
 	baseIsoObject, err := f.GetBaseIsoObject(openshiftVersion)
 	if err != nil {
 		return err
@@ -341,10 +420,15 @@ func (f *FSClient) UploadISOs(ctx context.Context, openshiftVersion string, have
 	if err != nil {
 		return err
 	}
+	if baseExists {
+		if baseExists, err = isBaseISOVerified(ctx, log, f, baseIsoObject, rhcosImageSHA256); err != nil {
+			return err
+		}
+	}
 
 	var minimalExists bool
-	if !haveLatestMinimalTemplate {
-		// Should update minimal ISO template
+	if !haveLatestMinimalTemplate || !baseExists {
+		// Should update minimal ISO template, which is also the case when the base ISO it was created from is replaced
 		minimalExists = false
 	} else {
 		minimalExists, err = f.DoesPublicObjectExist(ctx, minimalIsoObject)
@@ -358,10 +442,18 @@ func (f *FSClient) UploadISOs(ctx context.Context, openshiftVersion string, have
 	}
 
 	if !baseExists {
-		err = UploadFromURLToPublicBucket(ctx, baseIsoObject, rhcosImage, f)
+		log.Infof("Starting Base ISO download for %s", baseIsoObject)
+		baseIsoPath, checksum, err := downloadVerifiedBaseISO(log, rhcosImage, rhcosImageSHA256)
 		if err != nil {
 			return err
 		}
+		defer os.Remove(baseIsoPath)
+		if err = f.UploadFileToPublicBucket(ctx, baseIsoPath, baseIsoObject); err != nil {
+			return err
+		}
+		if err = UploadChecksum(ctx, f, baseIsoObject, checksum, true); err != nil {
+			return err
+		}
 		log.Infof("Successfully uploaded object %s", baseIsoObject)
 	}
 
@@ -482,6 +574,14 @@ func (d *FSClientDecorator) UploadFile(ctx context.Context, filePath, objectName
 	return err
 }
 
+func (d *FSClientDecorator) ComposeObjects(ctx context.Context, sourceObjects []string, objectName string) error {
+	err := d.fsClient.ComposeObjects(ctx, sourceObjects, objectName)
+	if err == nil {
+		d.reportFilesystemUsageMetrics()
+	}
+	return err
+}
+
 func (d *FSClientDecorator) UploadISO(ctx context.Context, ignitionConfig, srcObject, destObjectPrefix string) error {
 	err := d.fsClient.UploadISO(ctx, ignitionConfig, srcObject, destObjectPrefix)
 	if err == nil {
@@ -490,6 +590,10 @@ func (d *FSClientDecorator) UploadISO(ctx context.Context, ignitionConfig, srcOb
 	return err
 }
 
+func (d *FSClientDecorator) StreamISO(ctx context.Context, ignitionConfig string, ramDisk []byte, kernelArguments models.KernelArguments, srcObject string) (ReadSeekCloser, int64, error) {
+	return d.fsClient.StreamISO(ctx, ignitionConfig, ramDisk, kernelArguments, srcObject)
+}
+
 func (d *FSClientDecorator) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
 	return d.fsClient.Download(ctx, objectName)
 }
@@ -514,6 +618,10 @@ func (d *FSClientDecorator) GeneratePresignedDownloadURL(ctx context.Context, ob
 	return d.fsClient.GeneratePresignedDownloadURL(ctx, objectName, downloadFilename, duration)
 }
 
+func (d *FSClientDecorator) SupportsPresignedDownloadURLs() bool {
+	return d.fsClient.SupportsPresignedDownloadURLs()
+}
+
 func (d *FSClientDecorator) UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error) {
 	return d.fsClient.UpdateObjectTimestamp(ctx, objectName)
 }
@@ -523,6 +631,13 @@ func (d *FSClientDecorator) ExpireObjects(ctx context.Context, prefix string, de
 	d.reportFilesystemUsageMetrics()
 }
 
+func (d *FSClientDecorator) ExpireObjectsUnlessReferenced(ctx context.Context, prefix string, deleteTime time.Duration,
+	isReferenced func(ctx context.Context, log logrus.FieldLogger, objectName string) bool,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	d.fsClient.ExpireObjectsUnlessReferenced(ctx, prefix, deleteTime, isReferenced, callback)
+	d.reportFilesystemUsageMetrics()
+}
+
 func (d *FSClientDecorator) ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
 	return d.fsClient.ListObjectsByPrefix(ctx, prefix)
 }
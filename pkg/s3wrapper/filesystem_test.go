@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -110,6 +112,21 @@ var _ = Describe("s3filesystem", func() {
 		Expect(length).To(Equal(expLen))
 		Expect(downloadLength).To(Equal(int64(expLen)))
 	})
+	It("compose_download", func() {
+		Expect(client.Upload(ctx, []byte("hello "), "chunk-1")).To(Succeed())
+		Expect(client.Upload(ctx, []byte("world"), "chunk-2")).To(Succeed())
+
+		err := client.ComposeObjects(ctx, []string{"chunk-1", "chunk-2"}, objKey)
+		Expect(err).Should(BeNil())
+
+		reader, downloadLength, err := client.Download(ctx, objKey)
+		Expect(err).Should(BeNil())
+		defer reader.Close()
+		content, err := ioutil.ReadAll(reader)
+		Expect(err).Should(BeNil())
+		Expect(string(content)).To(Equal(dataStr))
+		Expect(downloadLength).To(Equal(int64(len(dataStr))))
+	})
 	It("doesobjectexist_delete", func() {
 		mockMetricsAPI.EXPECT().FileSystemUsage(gomock.Any()).Times(2)
 		err := client.Upload(ctx, []byte(dataStr), objKey)
@@ -145,12 +162,34 @@ var _ = Describe("s3filesystem", func() {
 		Expect(err).Should(BeNil())
 		Expect(exists).To(Equal(false))
 	})
+	It("expiration_unless_referenced", func() {
+		imgCreatedAt, _ := time.Parse(time.RFC3339, "2020-01-01T09:30:00+00:00") // Long ago
+		createFileObject(client.basedir, objKey, imgCreatedAt)
+		createFileObject(client.basedir, objKey2, imgCreatedAt)
+
+		mockMetricsAPI.EXPECT().FileSystemUsage(gomock.Any()).Times(1)
+		var deleted []string
+		client.ExpireObjectsUnlessReferenced(ctx, "discovery-image-", deleteTime, func(ctx context.Context, log logrus.FieldLogger, objectName string) bool {
+			return objectName == objKey
+		}, func(ctx context.Context, log logrus.FieldLogger, objectName string) {
+			deleted = append(deleted, objectName)
+		})
+		Expect(deleted).To(Equal([]string{objKey2}))
+
+		exists, err := client.DoesObjectExist(ctx, objKey)
+		Expect(err).Should(BeNil())
+		Expect(exists).To(Equal(true))
+
+		exists, err = client.DoesObjectExist(ctx, objKey2)
+		Expect(err).Should(BeNil())
+		Expect(exists).To(Equal(false))
+	})
 	It("expire_not_expired_image", func() {
 		imgCreatedAt, _ := time.Parse(time.RFC3339, "2020-01-01T09:30:00+00:00") // 30 minutes ago
 		filePath, info := createFileObject(client.basedir, objKey, imgCreatedAt)
 		called := false
 		mockMetricsAPI.EXPECT().FileSystemUsage(gomock.Any()).Times(1)
-		client.handleFile(ctx, log, filePath, info, now, deleteTime, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
+		client.handleFile(ctx, log, filePath, info, now, deleteTime, nil, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
 		Expect(called).To(Equal(false))
 	})
 	It("expire_expired_image", func() {
@@ -158,15 +197,27 @@ var _ = Describe("s3filesystem", func() {
 		filePath, info := createFileObject(client.basedir, objKey, imgCreatedAt)
 		called := false
 		mockMetricsAPI.EXPECT().FileSystemUsage(gomock.Any()).Times(1)
-		client.handleFile(ctx, log, filePath, info, now, deleteTime, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
+		client.handleFile(ctx, log, filePath, info, now, deleteTime, nil, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
+		Expect(called).To(Equal(true))
+	})
+	It("expire_with_checksum", func() {
+		imgCreatedAt, _ := time.Parse(time.RFC3339, "2020-01-01T08:00:00+00:00") // Two hours ago
+		filePath, info := createFileObject(client.basedir, objKey, imgCreatedAt)
+		checksumPath, checksumInfo := createFileObject(client.basedir, ChecksumObjectName(objKey), imgCreatedAt)
+		called := false
+		client.handleFile(ctx, log, checksumPath, checksumInfo, now, deleteTime, nil, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
+		Expect(called).To(Equal(false))
+		Expect(checksumPath).To(BeAnExistingFile())
+		client.handleFile(ctx, log, filePath, info, now, deleteTime, nil, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
 		Expect(called).To(Equal(true))
+		Expect(checksumPath).NotTo(BeAnExistingFile())
 	})
 	It("expire_delete_error", func() {
 		imgCreatedAt, _ := time.Parse(time.RFC3339, "2020-01-01T08:00:00+00:00") // Two hours ago
 		filePath, info := createFileObject(client.basedir, objKey, imgCreatedAt)
 		os.Remove(filePath)
 		called := false
-		client.handleFile(ctx, log, filePath, info, now, deleteTime, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
+		client.handleFile(ctx, log, filePath, info, now, deleteTime, nil, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
 		Expect(called).To(Equal(false))
 	})
 	Context("upload isos", func() {
@@ -210,6 +261,7 @@ var _ = Describe("s3filesystem", func() {
 			Expect(err).Should(BeNil())
 
 			mockVersions.EXPECT().GetRHCOSImage(defaultTestOpenShiftVersion).Return(defaultTestRhcosURL, nil).Times(1)
+			mockVersions.EXPECT().GetRHCOSImageSHA256(defaultTestOpenShiftVersion).Return("", nil).Times(1)
 
 			// Called once for GetBaseIsoObject and once for GetMinimalIsoObjectName
 			mockVersions.EXPECT().GetRHCOSVersion(defaultTestOpenShiftVersion).Return(defaultTestRhcosVersion, nil).Times(2)
@@ -224,6 +276,23 @@ var _ = Describe("s3filesystem", func() {
 			err := client.UploadISOs(ctx, unsupportedVersion, false)
 			Expect(err).To(HaveOccurred())
 		})
+		It("does not store a base ISO failing verification", func() {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte("tampered iso"))
+			}))
+			defer ts.Close()
+			mockVersions.EXPECT().GetRHCOSImage(defaultTestOpenShiftVersion).Return(ts.URL, nil).Times(1)
+			mockVersions.EXPECT().GetRHCOSImageSHA256(defaultTestOpenShiftVersion).Return(defaultTestRhcosSHA256, nil).Times(1)
+			mockVersions.EXPECT().GetRHCOSVersion(defaultTestOpenShiftVersion).Return(defaultTestRhcosVersion, nil).Times(3)
+
+			err := client.UploadISOs(ctx, defaultTestOpenShiftVersion, false)
+			Expect(err).To(HaveOccurred())
+			srcObject, err := client.GetBaseIsoObject(defaultTestOpenShiftVersion)
+			Expect(err).ToNot(HaveOccurred())
+			exists, err := client.DoesPublicObjectExist(ctx, srcObject)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
 		It("iso exists", func() {
 			err := os.MkdirAll(filepath.Join(baseDir, "files/images/pxeboot"), 0755)
 			Expect(err).ToNot(HaveOccurred())
@@ -256,6 +325,7 @@ var _ = Describe("s3filesystem", func() {
 			Expect(err).ToNot(HaveOccurred())
 
 			mockVersions.EXPECT().GetRHCOSImage(defaultTestOpenShiftVersion).Return(defaultTestRhcosURL, nil).Times(1)
+			mockVersions.EXPECT().GetRHCOSImageSHA256(defaultTestOpenShiftVersion).Return("", nil).Times(1)
 			mockVersions.EXPECT().GetRHCOSRootFS(defaultTestOpenShiftVersion).Return(defaultTestRhcosRootFSURL, nil).Times(1)
 
 			// Called once for GetBaseIsoObject and once for GetMinimalIsoObjectName
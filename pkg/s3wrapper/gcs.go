@@ -0,0 +1,553 @@
+package s3wrapper
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/isoeditor"
+	"github.com/openshift/assisted-service/internal/versions"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+// The parts of base ISOs that ISOs are composed of are kept in the private bucket, since objects can only be composed
+// from objects of the same bucket
+const gcsISOPartTemplate = "iso-parts/%s-%d-%s"
+
+// gcsMaxComposeSources is the maximal number of objects that are composed at once
+const gcsMaxComposeSources = 32
+
+type GCSConfig struct {
+	ProjectID string `envconfig:"GCS_PROJECT_ID"`
+	// CredentialsFile is a service account key file, which is also used to sign download URLs.
+	// The application default credentials are used when it is not set.
+	CredentialsFile string `envconfig:"GCS_CREDENTIALS_FILE"`
+	// EndpointURL overrides the storage API endpoint, e.g. https://127.0.0.1:4443/storage/v1/ for fake-gcs-server
+	EndpointURL string `envconfig:"GCS_ENDPOINT_URL"`
+	Bucket      string `envconfig:"GCS_BUCKET"`
+
+	// Warning - the files stored in this bucket should only be RHCOS image files that are readily available
+	// on the Internet, see the public S3 bucket
+	PublicBucket string `envconfig:"GCS_BUCKET_PUBLIC"`
+}
+
+var _ API = &GCSClient{}
+
+// GCSClient stores objects in Google Cloud Storage
+type GCSClient struct {
+	log              logrus.FieldLogger
+	cfg              *GCSConfig
+	client           *storage.Client
+	bucket           *storage.BucketHandle
+	publicBucket     *storage.BucketHandle
+	signingEmail     string
+	signingKey       []byte
+	areaCache        embeddedAreaCache
+	versionsHandler  versions.Handler
+	isoEditorFactory isoeditor.Factory
+}
+
+type gcsServiceAccountKey struct {
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+}
+
+// NewGCSClient creates a client of the storage buckets
+func NewGCSClient(cfg *GCSConfig, logger logrus.FieldLogger, versionsHandler versions.Handler, isoEditorFactory isoeditor.Factory) *GCSClient {
+	var opts []option.ClientOption
+	gcsClient := &GCSClient{log: logger, cfg: cfg, versionsHandler: versionsHandler, isoEditorFactory: isoEditorFactory}
+	if cfg.CredentialsFile != "" {
+		data, err := ioutil.ReadFile(cfg.CredentialsFile)
+		if err != nil {
+			logger.WithError(err).Errorf("failed to read GCS credentials file %s", cfg.CredentialsFile)
+			return nil
+		}
+		var key gcsServiceAccountKey
+		if err = json.Unmarshal(data, &key); err != nil {
+			logger.WithError(err).Errorf("failed to parse GCS credentials file %s", cfg.CredentialsFile)
+			return nil
+		}
+		gcsClient.signingEmail = key.ClientEmail
+		gcsClient.signingKey = []byte(key.PrivateKey)
+		opts = append(opts, option.WithCredentialsJSON(data))
+	}
+	if cfg.EndpointURL != "" {
+		// Emulators are accessed without authentication, and usually with a self signed certificate
+		opts = append(opts, option.WithEndpoint(cfg.EndpointURL), option.WithHTTPClient(&http.Client{
+			Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+		}))
+	}
+
+	client, err := storage.NewClient(context.Background(), opts...)
+	if err != nil {
+		logger.WithError(err).Error("failed to create GCS client")
+		return nil
+	}
+	gcsClient.client = client
+	gcsClient.bucket = client.Bucket(cfg.Bucket)
+	gcsClient.publicBucket = client.Bucket(cfg.PublicBucket)
+	return gcsClient
+}
+
+// IsAwsS3 returns whether the storage is reachable by clients, which is only assumed for the Google Cloud Storage endpoint
+func (c *GCSClient) IsAwsS3() bool {
+	return c.cfg.EndpointURL == ""
+}
+
+func (c *GCSClient) createBucket(bucket *storage.BucketHandle, name string) error {
+	err := bucket.Create(context.Background(), c.cfg.ProjectID, nil)
+	if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == http.StatusConflict {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "Failed to create GCS bucket %s", name)
+	}
+	return nil
+}
+
+func (c *GCSClient) CreateBucket() error {
+	return c.createBucket(c.bucket, c.cfg.Bucket)
+}
+
+func (c *GCSClient) CreatePublicBucket() error {
+	return c.createBucket(c.publicBucket, c.cfg.PublicBucket)
+}
+
+func (c *GCSClient) uploadStream(ctx context.Context, reader io.Reader, objectName string, bucket *storage.BucketHandle, bucketName string) error {
+	log := logutil.FromContext(ctx, c.log)
+	// The upload is aborted by canceling its context
+	uploadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	w := bucket.Object(objectName).NewWriter(uploadCtx)
+	_, err := io.Copy(w, reader)
+	if err == nil {
+		err = w.Close()
+	}
+	if err != nil {
+		err = errors.Wrapf(err, "Unable to upload %s to bucket %s", objectName, bucketName)
+		log.Error(err)
+		return err
+	}
+	log.Infof("Successfully uploaded %s to bucket %s", objectName, bucketName)
+	return nil
+}
+
+func (c *GCSClient) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	return c.uploadStream(ctx, reader, objectName, c.bucket, c.cfg.Bucket)
+}
+
+func (c *GCSClient) UploadStreamToPublicBucket(ctx context.Context, reader io.Reader, objectName string) error {
+	return c.uploadStream(ctx, reader, objectName, c.publicBucket, c.cfg.PublicBucket)
+}
+
+func (c *GCSClient) uploadFile(ctx context.Context, filePath, objectName string, bucket *storage.BucketHandle, bucketName string) error {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Uploading file %s as object %s to bucket %s", filePath, objectName, bucketName)
+	file, err := os.Open(filePath)
+	if err != nil {
+		err = errors.Wrapf(err, "Unable to open file %s for upload", filePath)
+		log.Error(err)
+		return err
+	}
+	defer file.Close()
+
+	return c.uploadStream(ctx, bufio.NewReader(file), objectName, bucket, bucketName)
+}
+
+func (c *GCSClient) UploadFile(ctx context.Context, filePath, objectName string) error {
+	return c.uploadFile(ctx, filePath, objectName, c.bucket, c.cfg.Bucket)
+}
+
+func (c *GCSClient) UploadFileToPublicBucket(ctx context.Context, filePath, objectName string) error {
+	return c.uploadFile(ctx, filePath, objectName, c.publicBucket, c.cfg.PublicBucket)
+}
+
+func (c *GCSClient) Upload(ctx context.Context, data []byte, objectName string) error {
+	return c.UploadStream(ctx, bytes.NewReader(data), objectName)
+}
+
+// UploadISO composes the ISO from the parts of the base ISO around its embedded area and an object with the embedded area
+// that contains the ignition config. The parts are copied to the private bucket once for every base ISO.
+// ComposeObjects composes the objects by the storage service. More objects than can be composed at once are composed
+// into intermediate objects first.
+func (c *GCSClient) ComposeObjects(ctx context.Context, sourceObjects []string, objectName string) error {
+	log := logutil.FromContext(ctx, c.log)
+	sources := make([]*storage.ObjectHandle, 0, len(sourceObjects))
+	for _, sourceObject := range sourceObjects {
+		sources = append(sources, c.bucket.Object(sourceObject))
+	}
+	var intermediates []*storage.ObjectHandle
+	defer func() {
+		for _, intermediate := range intermediates {
+			// using new context because ctx may be canceled and the intermediate objects should still be removed
+			if err := intermediate.Delete(context.Background()); err != nil {
+				log.WithError(err).Warnf("Failed to delete object %s", intermediate.ObjectName())
+			}
+		}
+	}()
+	for len(sources) > gcsMaxComposeSources {
+		var composed []*storage.ObjectHandle
+		for start := 0; start < len(sources); start += gcsMaxComposeSources {
+			end := start + gcsMaxComposeSources
+			if end > len(sources) {
+				end = len(sources)
+			}
+			intermediate := c.bucket.Object(fmt.Sprintf("%s.compose-%d", objectName, len(intermediates)))
+			if _, err := intermediate.ComposerFrom(sources[start:end]...).Run(ctx); err != nil {
+				err = errors.Wrapf(err, "Failed to compose %s", intermediate.ObjectName())
+				log.Error(err)
+				return err
+			}
+			intermediates = append(intermediates, intermediate)
+			composed = append(composed, intermediate)
+		}
+		sources = composed
+	}
+	if _, err := c.bucket.Object(objectName).ComposerFrom(sources...).Run(ctx); err != nil {
+		err = errors.Wrapf(err, "Failed to compose %s", objectName)
+		log.Error(err)
+		return err
+	}
+	log.Infof("Successfully composed %s of %d objects", objectName, len(sourceObjects))
+	return nil
+}
+
+func (c *GCSClient) UploadISO(ctx context.Context, ignitionConfig, srcObject, destObjectPrefix string) error {
+	log := logutil.FromContext(ctx, c.log)
+	destObjectName := fmt.Sprintf("%s.iso", destObjectPrefix)
+	log.Debugf("Started upload of ISO %s", destObjectName)
+
+	src := c.publicBucket.Object(srcObject)
+	attrs, err := src.Attrs(ctx)
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to fetch metadata for base object %s", srcObject)
+		log.Error(err)
+		return err
+	}
+	// Pin the generation so that all the reads are of the same content
+	src = src.Generation(attrs.Generation)
+	info, area, err := c.areaCache.get(fmt.Sprintf("%s-%d", srcObject, attrs.Generation), attrs.Size, func(offset, length int64) ([]byte, error) {
+		return c.readRange(ctx, src, offset, length)
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to fetch base ISO information")
+		log.Error(err)
+		return err
+	}
+	if err = embedIgnition(area, info.areaLengthBytes, ignitionConfig); err != nil {
+		log.Error(err)
+		return err
+	}
+
+	head, err := c.getISOPart(ctx, log, src, srcObject, attrs.Generation, "head", 0, info.areaOffsetBytes)
+	if err != nil {
+		return err
+	}
+	tail, err := c.getISOPart(ctx, log, src, srcObject, attrs.Generation, "tail",
+		info.areaOffsetBytes+minimumPartSizeBytes, info.baseObjectSize-info.areaOffsetBytes-minimumPartSizeBytes)
+	if err != nil {
+		return err
+	}
+
+	areaObjectName := fmt.Sprintf("%s.area", destObjectName)
+	if err = c.uploadStream(ctx, bytes.NewReader(area), areaObjectName, c.bucket, c.cfg.Bucket); err != nil {
+		return errors.Wrapf(err, "Failed to upload ignition for file %s", destObjectName)
+	}
+	areaObject := c.bucket.Object(areaObjectName)
+	defer func() {
+		// using new context because ctx may be canceled and the area object should still be removed
+		if err := areaObject.Delete(context.Background()); err != nil {
+			log.WithError(err).Warnf("Failed to delete object %s", areaObjectName)
+		}
+	}()
+
+	sources := []*storage.ObjectHandle{head, areaObject}
+	if tail != nil {
+		sources = append(sources, tail)
+	}
+	if _, err = c.bucket.Object(destObjectName).ComposerFrom(sources...).Run(ctx); err != nil {
+		err = errors.Wrapf(err, "Failed to create ISO %s", destObjectName)
+		log.Error(err)
+		return err
+	}
+	log.Debugf("Completed upload of ISO %s", destObjectName)
+	return nil
+}
+
+// getISOPart returns the object in the private bucket with a range of a base ISO, which is copied if it does not exist yet.
+// No object is returned for an empty range.
+func (c *GCSClient) getISOPart(ctx context.Context, log logrus.FieldLogger, src *storage.ObjectHandle, srcObject string, generation int64,
+	part string, offset, length int64) (*storage.ObjectHandle, error) {
+	if length <= 0 {
+		return nil, nil
+	}
+	partName := fmt.Sprintf(gcsISOPartTemplate, srcObject, generation, part)
+	partObject := c.bucket.Object(partName)
+	exists, err := c.doesObjectExist(ctx, partName, c.bucket, c.cfg.Bucket)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if exists {
+		return partObject, nil
+	}
+
+	log.Infof("Copying range %d-%d of base ISO %s to %s", offset, offset+length-1, srcObject, partName)
+	r, err := src.NewRangeReader(ctx, offset, length)
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to read range %d-%d of %s", offset, offset+length-1, srcObject)
+		log.Error(err)
+		return nil, err
+	}
+	defer r.Close()
+	if err = c.uploadStream(ctx, r, partName, c.bucket, c.cfg.Bucket); err != nil {
+		return nil, err
+	}
+	return partObject, nil
+}
+
+func (c *GCSClient) readRange(ctx context.Context, object *storage.ObjectHandle, offset, length int64) ([]byte, error) {
+	r, err := object.NewRangeReader(ctx, offset, length)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+func (c *GCSClient) StreamISO(ctx context.Context, ignitionConfig string, ramDisk []byte, kernelArguments models.KernelArguments, srcObject string) (ReadSeekCloser, int64, error) {
+	return nil, 0, errors.New("Streaming ISOs is only supported with filesystem storage")
+}
+
+func (c *GCSClient) download(ctx context.Context, objectName string, bucket *storage.BucketHandle, bucketName string) (io.ReadCloser, int64, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Downloading %s from bucket %s", objectName, bucketName)
+
+	r, err := bucket.Object(objectName).NewReader(ctx)
+	if err != nil {
+		if err == storage.ErrObjectNotExist {
+			return nil, 0, common.NotFound(objectName)
+		}
+		log.WithError(err).Errorf("Failed to get %s object from bucket %s", objectName, bucketName)
+		return nil, 0, err
+	}
+	return r, r.Attrs.Size, nil
+}
+
+func (c *GCSClient) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	return c.download(ctx, objectName, c.bucket, c.cfg.Bucket)
+}
+
+func (c *GCSClient) DownloadPublic(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	return c.download(ctx, objectName, c.publicBucket, c.cfg.PublicBucket)
+}
+
+func (c *GCSClient) doesObjectExist(ctx context.Context, objectName string, bucket *storage.BucketHandle, bucketName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Debugf("Verifying if %s exists in %s", objectName, bucketName)
+	_, err := bucket.Object(objectName).Attrs(ctx)
+	if err != nil {
+		if err == storage.ErrObjectNotExist {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to get %s from bucket %s", objectName, bucketName)
+	}
+	return true, nil
+}
+
+func (c *GCSClient) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
+	return c.doesObjectExist(ctx, objectName, c.bucket, c.cfg.Bucket)
+}
+
+func (c *GCSClient) DoesPublicObjectExist(ctx context.Context, objectName string) (bool, error) {
+	return c.doesObjectExist(ctx, objectName, c.publicBucket, c.cfg.PublicBucket)
+}
+
+func (c *GCSClient) DeleteObject(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Deleting object %s from %s", objectName, c.cfg.Bucket)
+
+	if err := c.bucket.Object(objectName).Delete(ctx); err != nil {
+		if err == storage.ErrObjectNotExist {
+			log.Infof("Object %s does not exist in bucket %s", objectName, c.cfg.Bucket)
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "Failed to delete object %s from bucket %s", objectName, c.cfg.Bucket)
+	}
+
+	log.Infof("Deleted object %s from bucket %s", objectName, c.cfg.Bucket)
+	return true, nil
+}
+
+// UpdateObjectTimestamp keeps the timestamp in the custom metadata of the object
+func (c *GCSClient) UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Updating timestamp of object %s", objectName)
+	_, err := c.bucket.Object(objectName).Update(ctx, storage.ObjectAttrsToUpdate{
+		Metadata: map[string]string{timestampTagKey: strconv.FormatInt(time.Now().Unix(), 10)},
+	})
+	if err != nil {
+		if err == storage.ErrObjectNotExist {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "Failed to update metadata of object %s in bucket %s", objectName, c.cfg.Bucket)
+	}
+	return true, nil
+}
+
+func (c *GCSClient) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	log := logutil.FromContext(ctx, c.log)
+	attrs, err := c.bucket.Object(objectName).Attrs(ctx)
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to fetch metadata for object %s in bucket %s", objectName, c.cfg.Bucket)
+		log.Error(err)
+		return 0, err
+	}
+	return attrs.Size, nil
+}
+
+func (c *GCSClient) SupportsPresignedDownloadURLs() bool {
+	return c.IsAwsS3()
+}
+
+func (c *GCSClient) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
+	log := logutil.FromContext(ctx, c.log)
+	if c.signingKey == nil {
+		err := errors.New("Signing download URLs requires the key of a service account")
+		log.Error(err)
+		return "", err
+	}
+	urlStr, err := storage.SignedURL(c.cfg.Bucket, objectName, &storage.SignedURLOptions{
+		GoogleAccessID: c.signingEmail,
+		PrivateKey:     c.signingKey,
+		Method:         http.MethodGet,
+		Expires:        time.Now().Add(duration),
+		Scheme:         storage.SigningSchemeV4,
+		QueryParameters: url.Values{
+			"response-content-disposition": []string{fmt.Sprintf("attachment;filename=%s", downloadFilename)},
+		},
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to create presigned download URL for object %s in bucket %s", objectName, c.cfg.Bucket)
+		log.Error(err)
+		return "", err
+	}
+	return urlStr, nil
+}
+
+func (c *GCSClient) ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	c.expireObjects(ctx, prefix, deleteTime, nil, callback)
+}
+
+// ExpireObjectsUnlessReferenced deletes the expired objects with the given prefix, keeping those that are still referenced,
+// and calls the callback with every deleted object
+func (c *GCSClient) ExpireObjectsUnlessReferenced(ctx context.Context, prefix string, deleteTime time.Duration,
+	isReferenced func(ctx context.Context, log logrus.FieldLogger, objectName string) bool,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	c.expireObjects(ctx, prefix, deleteTime, isReferenced, callback)
+}
+
+func (c *GCSClient) expireObjects(ctx context.Context, prefix string, deleteTime time.Duration,
+	isReferenced func(ctx context.Context, log logrus.FieldLogger, objectName string) bool,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	log := logutil.FromContext(ctx, c.log)
+	now := time.Now()
+
+	log.Info("Checking for expired objects...")
+	err := c.listObjects(ctx, prefix, func(attrs *storage.ObjectAttrs) {
+		c.handleObject(ctx, log, attrs, now, deleteTime, isReferenced, callback)
+	})
+	if err != nil {
+		log.WithError(err).Error("Error listing objects")
+	}
+}
+
+func (c *GCSClient) handleObject(ctx context.Context, log logrus.FieldLogger, attrs *storage.ObjectAttrs, now time.Time, deleteTime time.Duration,
+	isReferenced func(ctx context.Context, log logrus.FieldLogger, objectName string) bool,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	if isChecksumObject(attrs.Name) {
+		return
+	}
+	// The metadata timestamp only exists if the same image was created more than once
+	creationTime := attrs.Created
+	if value, ok := attrs.Metadata[timestampTagKey]; ok {
+		objTime, _ := strconv.ParseInt(value, 10, 64)
+		creationTime = time.Unix(objTime, 0)
+	}
+
+	if now.After(creationTime.Add(deleteTime)) {
+		if isReferenced != nil && isReferenced(ctx, log, attrs.Name) {
+			log.Infof("Keeping expired object %s since it is still referenced", attrs.Name)
+			return
+		}
+		_, err := c.DeleteObject(ctx, attrs.Name)
+		if err != nil {
+			log.WithError(err).Errorf("Error deleting expired object %s", attrs.Name)
+			return
+		}
+		log.Infof("Deleted expired object %s", attrs.Name)
+		deleteChecksum(ctx, log, c, attrs.Name)
+		callback(ctx, log, attrs.Name)
+	}
+}
+
+func (c *GCSClient) listObjects(ctx context.Context, prefix string, fn func(attrs *storage.ObjectAttrs)) error {
+	it := c.bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		fn(attrs)
+	}
+}
+
+func (c *GCSClient) ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	log := logutil.FromContext(ctx, c.log)
+	var objects []string
+	log.Infof("Listing objects by with prefix %s", prefix)
+	err := c.listObjects(ctx, prefix, func(attrs *storage.ObjectAttrs) {
+		objects = append(objects, attrs.Name)
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "Error listing objects for prefix %s", prefix)
+		log.Error(err)
+		return nil, err
+	}
+	return objects, nil
+}
+
+func (c *GCSClient) UploadISOs(ctx context.Context, openshiftVersion string, haveLatestMinimalTemplate bool) error {
+	log := logutil.FromContext(ctx, c.log)
+	return uploadISOsForVersion(ctx, log, c, c.versionsHandler, c.isoEditorFactory, openshiftVersion, haveLatestMinimalTemplate)
+}
+
+func (c *GCSClient) GetBaseIsoObject(openshiftVersion string) (string, error) {
+	return rhcosObjectName(c.versionsHandler, rhcosObjectTemplate, openshiftVersion)
+}
+
+func (c *GCSClient) GetMinimalIsoObjectName(openshiftVersion string) (string, error) {
+	return rhcosObjectName(c.versionsHandler, rhcosMinimalObjectTemplate, openshiftVersion)
+}
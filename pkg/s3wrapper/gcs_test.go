@@ -0,0 +1,123 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// createGCSCredentialsFile writes a service account key file to dir
+func createGCSCredentialsFile(dir string) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).ToNot(HaveOccurred())
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	data, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"project_id":   "test",
+		"client_email": "assisted-service@test.iam.gserviceaccount.com",
+		"client_id":    "1",
+		"private_key":  string(keyPEM),
+		"token_uri":    "https://oauth2.googleapis.com/token",
+	})
+	Expect(err).ToNot(HaveOccurred())
+	path := filepath.Join(dir, "credentials.json")
+	Expect(ioutil.WriteFile(path, data, 0600)).To(Succeed())
+	return path
+}
+
+var _ = Describe("GCSClient", func() {
+	var (
+		client *GCSClient
+	)
+
+	BeforeEach(func() {
+		// Set GCS_EMULATOR_ENDPOINT, e.g. to https://127.0.0.1:4443/storage/v1/, to run against fake-gcs-server
+		endpoint := os.Getenv("GCS_EMULATOR_ENDPOINT")
+		if endpoint == "" {
+			Skip("GCS_EMULATOR_ENDPOINT is not set")
+		}
+		id := uuid.New().String()
+		client = NewGCSClient(&GCSConfig{
+			ProjectID:    "test",
+			EndpointURL:  endpoint,
+			Bucket:       fmt.Sprintf("private-%s", id),
+			PublicBucket: fmt.Sprintf("public-%s", id),
+		}, discardLogger(), nil, nil)
+		Expect(client).ToNot(BeNil())
+		Expect(client.CreateBucket()).To(Succeed())
+		Expect(client.CreatePublicBucket()).To(Succeed())
+	})
+
+	storageBackendSpecs(func() API { return client })
+
+	It("is not reachable by clients through the emulator", func() {
+		Expect(client.IsAwsS3()).To(BeFalse())
+	})
+
+	It("keeps the parts of the base ISO in the private bucket", func() {
+		ctx := context.Background()
+		iso := createTestISO(testISOSize, testISOAreaOffset, testISOAreaLength)
+		Expect(client.UploadStreamToPublicBucket(ctx, bytes.NewReader(iso), "rhcos.iso")).To(Succeed())
+		Expect(client.UploadISO(ctx, "ignition", "rhcos.iso", "discovery-image")).To(Succeed())
+
+		objects, err := client.ListObjectsByPrefix(ctx, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(ConsistOf("discovery-image.iso", HavePrefix("iso-parts/rhcos.iso-"), HavePrefix("iso-parts/rhcos.iso-")))
+	})
+})
+
+var _ = Describe("GCSClient configuration", func() {
+	var (
+		ctx = context.Background()
+		dir string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "gcs-test")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("signs download URLs with the service account key", func() {
+		client := NewGCSClient(&GCSConfig{CredentialsFile: createGCSCredentialsFile(dir), Bucket: "images"}, discardLogger(), nil, nil)
+		Expect(client).ToNot(BeNil())
+		Expect(client.IsAwsS3()).To(BeTrue())
+
+		downloadURL, err := client.GeneratePresignedDownloadURL(ctx, "discovery-image.iso", "cluster-discovery.iso", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		u, err := url.Parse(downloadURL)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(u.Path).To(Equal("/images/discovery-image.iso"))
+		Expect(u.Query().Get("response-content-disposition")).To(Equal("attachment;filename=cluster-discovery.iso"))
+		Expect(u.Query().Get("X-Goog-Signature")).ToNot(BeEmpty())
+	})
+
+	It("does not sign download URLs without a service account key", func() {
+		client := NewGCSClient(&GCSConfig{EndpointURL: "https://127.0.0.1:4443/storage/v1/", Bucket: "images"}, discardLogger(), nil, nil)
+		Expect(client).ToNot(BeNil())
+		_, err := client.GeneratePresignedDownloadURL(ctx, "discovery-image.iso", "cluster-discovery.iso", time.Hour)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails with a missing credentials file", func() {
+		Expect(NewGCSClient(&GCSConfig{CredentialsFile: filepath.Join(dir, "missing.json")}, discardLogger(), nil, nil)).To(BeNil())
+	})
+})
@@ -0,0 +1,225 @@
+package s3wrapper
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/renameio"
+	"github.com/pkg/errors"
+)
+
+// KMS wraps the data keys that encrypt stored objects with master keys that never leave it
+type KMS interface {
+	// WrapKey encrypts a data key with the current master key, and returns the ID of that master key
+	WrapKey(ctx context.Context, dataKey []byte) (string, []byte, error)
+	// UnwrapKey decrypts a data key that was wrapped with the given master key
+	UnwrapKey(ctx context.Context, masterKeyID string, wrappedKey []byte) ([]byte, error)
+	// CurrentKeyID returns the ID of the master key that wraps new data keys
+	CurrentKeyID(ctx context.Context) (string, error)
+}
+
+const masterKeySizeBytes = 32
+
+// StaticKMS wraps data keys with master keys from the service configuration
+type StaticKMS struct {
+	keys         map[string][]byte
+	currentKeyID string
+}
+
+// NewStaticKMS creates a KMS from base64 encoded 256 bit master keys by their IDs. currentKeyID may be omitted
+// when a single master key is configured.
+func NewStaticKMS(masterKeys map[string]string, currentKeyID string) (*StaticKMS, error) {
+	if len(masterKeys) == 0 {
+		return nil, errors.New("no master keys are configured")
+	}
+	kms := &StaticKMS{keys: make(map[string][]byte), currentKeyID: currentKeyID}
+	for id, encoded := range masterKeys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.Wrapf(err, "master key %s is not base64 encoded", id)
+		}
+		if len(key) != masterKeySizeBytes {
+			return nil, errors.Errorf("master key %s has %d bytes rather than %d", id, len(key), masterKeySizeBytes)
+		}
+		kms.keys[id] = key
+		if currentKeyID == "" {
+			kms.currentKeyID = id
+		}
+	}
+	if currentKeyID == "" && len(masterKeys) > 1 {
+		return nil, errors.New("the ID of the current master key must be set when several master keys are configured")
+	}
+	if _, ok := kms.keys[kms.currentKeyID]; !ok {
+		return nil, errors.Errorf("current master key %s is not configured", kms.currentKeyID)
+	}
+	return kms, nil
+}
+
+func (k *StaticKMS) WrapKey(_ context.Context, dataKey []byte) (string, []byte, error) {
+	wrapped, err := seal(k.keys[k.currentKeyID], dataKey)
+	return k.currentKeyID, wrapped, err
+}
+
+func (k *StaticKMS) UnwrapKey(_ context.Context, masterKeyID string, wrappedKey []byte) ([]byte, error) {
+	key, ok := k.keys[masterKeyID]
+	if !ok {
+		return nil, errors.Errorf("master key %s is not configured", masterKeyID)
+	}
+	return open(key, wrappedKey)
+}
+
+func (k *StaticKMS) CurrentKeyID(_ context.Context) (string, error) {
+	return k.currentKeyID, nil
+}
+
+// LocalKMS is a stand-in for a key management service, which keeps its master keys in files of a local directory
+// and creates a new master key once the current one is older than the rotation interval. Previous master keys are
+// kept to unwrap the data keys that they wrapped.
+type LocalKMS struct {
+	dir              string
+	rotationInterval time.Duration
+	mutex            sync.Mutex
+}
+
+const localKMSKeyPrefix = "local-"
+
+func NewLocalKMS(dir string, rotationInterval time.Duration) (*LocalKMS, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create local KMS directory %s", dir)
+	}
+	return &LocalKMS{dir: dir, rotationInterval: rotationInterval}, nil
+}
+
+// keyCreationTime returns the creation time encoded in the ID of a master key of the local KMS
+func keyCreationTime(keyID string) (time.Time, bool) {
+	nanos, err := strconv.ParseInt(strings.TrimPrefix(keyID, localKMSKeyPrefix), 10, 64)
+	if err != nil || !strings.HasPrefix(keyID, localKMSKeyPrefix) {
+		return time.Time{}, false
+	}
+	return time.Unix(0, nanos), true
+}
+
+func (k *LocalKMS) CurrentKeyID(_ context.Context) (string, error) {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+	files, err := ioutil.ReadDir(k.dir)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to list master keys in %s", k.dir)
+	}
+	var ids []string
+	for _, file := range files {
+		id := strings.TrimSuffix(file.Name(), ".key")
+		if _, ok := keyCreationTime(id); ok && strings.HasSuffix(file.Name(), ".key") {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		ti, _ := keyCreationTime(ids[i])
+		tj, _ := keyCreationTime(ids[j])
+		return ti.Before(tj)
+	})
+	if len(ids) > 0 {
+		if created, _ := keyCreationTime(ids[len(ids)-1]); time.Since(created) < k.rotationInterval {
+			return ids[len(ids)-1], nil
+		}
+	}
+	return k.createKey()
+}
+
+func (k *LocalKMS) createKey() (string, error) {
+	key := make([]byte, masterKeySizeBytes)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return "", errors.Wrap(err, "failed to generate master key")
+	}
+	id := fmt.Sprintf("%s%d", localKMSKeyPrefix, time.Now().UnixNano())
+	if err := renameio.WriteFile(k.keyPath(id), []byte(base64.StdEncoding.EncodeToString(key)), 0600); err != nil {
+		return "", errors.Wrapf(err, "failed to write master key %s", id)
+	}
+	return id, nil
+}
+
+func (k *LocalKMS) keyPath(keyID string) string {
+	return filepath.Join(k.dir, keyID+".key")
+}
+
+func (k *LocalKMS) readKey(keyID string) ([]byte, error) {
+	if _, ok := keyCreationTime(keyID); !ok {
+		return nil, errors.Errorf("invalid master key ID %s", keyID)
+	}
+	encoded, err := ioutil.ReadFile(k.keyPath(keyID))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read master key %s", keyID)
+	}
+	return base64.StdEncoding.DecodeString(string(encoded))
+}
+
+func (k *LocalKMS) WrapKey(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	id, err := k.CurrentKeyID(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	key, err := k.readKey(id)
+	if err != nil {
+		return "", nil, err
+	}
+	wrapped, err := seal(key, dataKey)
+	return id, wrapped, err
+}
+
+func (k *LocalKMS) UnwrapKey(_ context.Context, masterKeyID string, wrappedKey []byte) ([]byte, error) {
+	key, err := k.readKey(masterKeyID)
+	if err != nil {
+		return nil, err
+	}
+	return open(key, wrappedKey)
+}
+
+// seal encrypts plaintext with AES-GCM, and returns the nonce followed by the ciphertext
+func seal(key, plaintext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Wrap(err, "failed to generate nonce")
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// open decrypts the output of seal
+func open(key, sealed []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt")
+	}
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -7,6 +7,7 @@ package s3wrapper
 import (
 	context "context"
 	gomock "github.com/golang/mock/gomock"
+	models "github.com/openshift/assisted-service/models"
 	logrus "github.com/sirupsen/logrus"
 	io "io"
 	reflect "reflect"
@@ -36,6 +37,20 @@ func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
 	return m.recorder
 }
 
+// ComposeObjects mocks base method
+func (m *MockAPI) ComposeObjects(arg0 context.Context, arg1 []string, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComposeObjects", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ComposeObjects indicates an expected call of ComposeObjects
+func (mr *MockAPIMockRecorder) ComposeObjects(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComposeObjects", reflect.TypeOf((*MockAPI)(nil).ComposeObjects), arg0, arg1, arg2)
+}
+
 // CreateBucket mocks base method
 func (m *MockAPI) CreateBucket() error {
 	m.ctrl.T.Helper()
@@ -153,6 +168,18 @@ func (mr *MockAPIMockRecorder) ExpireObjects(arg0, arg1, arg2, arg3 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireObjects", reflect.TypeOf((*MockAPI)(nil).ExpireObjects), arg0, arg1, arg2, arg3)
 }
 
+// ExpireObjectsUnlessReferenced mocks base method
+func (m *MockAPI) ExpireObjectsUnlessReferenced(arg0 context.Context, arg1 string, arg2 time.Duration, arg3 func(context.Context, logrus.FieldLogger, string) bool, arg4 func(context.Context, logrus.FieldLogger, string)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ExpireObjectsUnlessReferenced", arg0, arg1, arg2, arg3, arg4)
+}
+
+// ExpireObjectsUnlessReferenced indicates an expected call of ExpireObjectsUnlessReferenced
+func (mr *MockAPIMockRecorder) ExpireObjectsUnlessReferenced(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireObjectsUnlessReferenced", reflect.TypeOf((*MockAPI)(nil).ExpireObjectsUnlessReferenced), arg0, arg1, arg2, arg3, arg4)
+}
+
 // GeneratePresignedDownloadURL mocks base method
 func (m *MockAPI) GeneratePresignedDownloadURL(arg0 context.Context, arg1, arg2 string, arg3 time.Duration) (string, error) {
 	m.ctrl.T.Helper()
@@ -242,6 +269,36 @@ func (mr *MockAPIMockRecorder) ListObjectsByPrefix(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectsByPrefix", reflect.TypeOf((*MockAPI)(nil).ListObjectsByPrefix), arg0, arg1)
 }
 
+// StreamISO mocks base method
+func (m *MockAPI) StreamISO(arg0 context.Context, arg1 string, arg2 []byte, arg3 models.KernelArguments, arg4 string) (ReadSeekCloser, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamISO", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(ReadSeekCloser)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// StreamISO indicates an expected call of StreamISO
+func (mr *MockAPIMockRecorder) StreamISO(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamISO", reflect.TypeOf((*MockAPI)(nil).StreamISO), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SupportsPresignedDownloadURLs mocks base method
+func (m *MockAPI) SupportsPresignedDownloadURLs() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupportsPresignedDownloadURLs")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// SupportsPresignedDownloadURLs indicates an expected call of SupportsPresignedDownloadURLs
+func (mr *MockAPIMockRecorder) SupportsPresignedDownloadURLs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsPresignedDownloadURLs", reflect.TypeOf((*MockAPI)(nil).SupportsPresignedDownloadURLs))
+}
+
 // UpdateObjectTimestamp mocks base method
 func (m *MockAPI) UpdateObjectTimestamp(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,91 @@
+package s3wrapper
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/gencrypto"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+)
+
+// signedObjectsPathPrefix prefixes the paths of signed object download URLs, followed by the object name
+const signedObjectsPathPrefix = "/signed-objects/"
+
+func signedObjectURL(serviceBaseURL, objectName, downloadFilename string, expires time.Time, keyID, signature string) string {
+	u := url.URL{Path: signedObjectsPathPrefix + objectName}
+	q := url.Values{}
+	q.Set("filename", downloadFilename)
+	q.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	q.Set("kid", keyID)
+	q.Set("signature", signature)
+	return strings.TrimSuffix(strings.TrimSpace(serviceBaseURL), "/") + u.EscapedPath() + "?" + q.Encode()
+}
+
+// SignedURLMiddleware wraps an http handler.
+// Requests under the path prefix of signed object URLs download the object if their signature is valid and has not
+// expired, honoring the Range header of the request. The signature is verified with the key the URL names, which is
+// either the current key of the service or, after a rotation, its previous key. Other requests are passed to the inner handler.
+func (d *FSClientDecorator) SignedURLMiddleware(inner http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, signedObjectsPathPrefix) {
+			inner.ServeHTTP(w, r)
+			return
+		}
+		d.fsClient.serveSignedObject(w, r)
+	})
+}
+
+func (f *FSClient) serveSignedObject(w http.ResponseWriter, r *http.Request) {
+	log := logutil.FromContext(r.Context(), f.log)
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	objectName := strings.TrimPrefix(r.URL.Path, signedObjectsPathPrefix)
+	query := r.URL.Query()
+	fileName := query.Get("filename")
+	expiresUnix, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if objectName == "" || path.Clean("/"+objectName) != "/"+objectName || err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	if err = gencrypto.VerifyObjectURLSignature(objectName, fileName, time.Unix(expiresUnix, 0), query.Get("kid"), query.Get("signature")); err != nil {
+		log.WithError(err).Infof("Rejected signed download URL of object %s", objectName)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	filePath := filepath.Join(f.basedir, objectName)
+	fp, err := os.Open(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			http.NotFound(w, r)
+			return
+		}
+		log.WithError(err).Errorf("Unable to open file %s", filePath)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer fp.Close()
+	info, err := fp.Stat()
+	if err != nil {
+		log.WithError(err).Errorf("Unable to stat file %s", filePath)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if fileName != "" {
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	http.ServeContent(w, r, fileName, info.ModTime(), fp)
+}
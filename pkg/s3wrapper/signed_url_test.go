@@ -0,0 +1,159 @@
+package s3wrapper
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/gencrypto"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("Signed URLs", func() {
+	const (
+		serviceBaseURL = "http://example.org:8090/"
+		objectName     = "d183c403-d27b-42e1-b0a4-1274ea1a5d77/logs/controller_logs.tar.gz"
+	)
+
+	var (
+		ctx           = context.Background()
+		log           = logrus.New()
+		baseDir       string
+		client        *FSClientDecorator
+		inner         http.Handler
+		innerCalled   bool
+		h             http.Handler
+		privateKeyPEM string
+	)
+
+	BeforeEach(func() {
+		log.SetOutput(ioutil.Discard)
+		var err error
+		baseDir, err = ioutil.TempDir("", "signed-url")
+		Expect(err).ToNot(HaveOccurred())
+		_, privateKeyPEM, err = gencrypto.ECDSAKeyPairPEM()
+		Expect(err).ToNot(HaveOccurred())
+		os.Setenv("EC_PRIVATE_KEY_PEM", privateKeyPEM)
+
+		ctrl := gomock.NewController(GinkgoT())
+		mockMetricsAPI := metrics.NewMockAPI(ctrl)
+		mockMetricsAPI.EXPECT().FileSystemUsage(gomock.Any()).AnyTimes()
+		client = NewFSClient(baseDir, log, nil, nil, mockMetricsAPI, 80, serviceBaseURL)
+		Expect(client.Upload(ctx, []byte("0123456789"), objectName)).To(Succeed())
+
+		innerCalled = false
+		inner = http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) { innerCalled = true })
+		h = client.SignedURLMiddleware(inner)
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("EC_PRIVATE_KEY_PEM")
+		Expect(os.RemoveAll(baseDir)).To(Succeed())
+	})
+
+	get := func(rawURL string, header http.Header) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, rawURL, nil)
+		for key, values := range header {
+			request.Header[key] = values
+		}
+		h.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	It("downloads an object with a signed URL", func() {
+		Expect(client.SupportsPresignedDownloadURLs()).To(BeTrue())
+		signedURL, err := client.GeneratePresignedDownloadURL(ctx, objectName, "logs.tar.gz", time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(signedURL).To(HavePrefix("http://example.org:8090/signed-objects/" + objectName + "?"))
+
+		recorder := get(signedURL, nil)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(Equal("0123456789"))
+		Expect(recorder.Header().Get("Content-Disposition")).To(Equal(`attachment; filename="logs.tar.gz"`))
+		Expect(innerCalled).To(BeFalse())
+	})
+
+	It("downloads a range of an object", func() {
+		signedURL, err := client.GeneratePresignedDownloadURL(ctx, objectName, "logs.tar.gz", time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+
+		recorder := get(signedURL, http.Header{"Range": []string{"bytes=2-5"}})
+		Expect(recorder.Code).To(Equal(http.StatusPartialContent))
+		Expect(recorder.Body.String()).To(Equal("2345"))
+		Expect(recorder.Header().Get("Content-Range")).To(Equal("bytes 2-5/10"))
+	})
+
+	It("rejects a URL whose object name was changed", func() {
+		Expect(client.Upload(ctx, []byte("other"), "other/object")).To(Succeed())
+		signedURL, err := client.GeneratePresignedDownloadURL(ctx, objectName, "logs.tar.gz", time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+		u, err := url.Parse(signedURL)
+		Expect(err).ToNot(HaveOccurred())
+		u.Path = signedObjectsPathPrefix + "other/object"
+
+		Expect(get(u.String(), nil).Code).To(Equal(http.StatusForbidden))
+	})
+
+	It("rejects an expired URL", func() {
+		signedURL, err := client.GeneratePresignedDownloadURL(ctx, objectName, "logs.tar.gz", -time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(get(signedURL, nil).Code).To(Equal(http.StatusForbidden))
+	})
+
+	It("rejects a URL signed with a rotated key", func() {
+		signedURL, err := client.GeneratePresignedDownloadURL(ctx, objectName, "logs.tar.gz", time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+		_, rotatedKeyPEM, err := gencrypto.ECDSAKeyPairPEM()
+		Expect(err).ToNot(HaveOccurred())
+		os.Setenv("EC_PRIVATE_KEY_PEM", rotatedKeyPEM)
+		Expect(get(signedURL, nil).Code).To(Equal(http.StatusForbidden))
+	})
+
+	It("accepts a URL signed with the previous key after a rotation", func() {
+		signedURL, err := client.GeneratePresignedDownloadURL(ctx, objectName, "logs.tar.gz", time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+		_, rotatedKeyPEM, err := gencrypto.ECDSAKeyPairPEM()
+		Expect(err).ToNot(HaveOccurred())
+		os.Setenv("EC_PRIVATE_KEY_PEM", rotatedKeyPEM)
+		os.Setenv("EC_PREVIOUS_PRIVATE_KEY_PEM", privateKeyPEM)
+		os.Setenv("EC_PREVIOUS_PRIVATE_KEY_VALID_UNTIL", time.Now().Add(time.Hour).Format(time.RFC3339))
+		defer os.Unsetenv("EC_PREVIOUS_PRIVATE_KEY_PEM")
+		defer os.Unsetenv("EC_PREVIOUS_PRIVATE_KEY_VALID_UNTIL")
+		Expect(get(signedURL, nil).Code).To(Equal(http.StatusOK))
+	})
+
+	It("returns not found for a deleted object", func() {
+		signedURL, err := client.GeneratePresignedDownloadURL(ctx, objectName, "logs.tar.gz", time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.DeleteObject(ctx, objectName)).To(BeTrue())
+		Expect(get(signedURL, nil).Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("passes other requests to the inner handler", func() {
+		get("http://example.org:8090/api/assisted-install/v1/clusters", nil)
+		Expect(innerCalled).To(BeTrue())
+	})
+
+	It("does not sign URLs without a private key", func() {
+		os.Unsetenv("EC_PRIVATE_KEY_PEM")
+		Expect(client.SupportsPresignedDownloadURLs()).To(BeFalse())
+		_, err := client.GeneratePresignedDownloadURL(ctx, objectName, "logs.tar.gz", time.Minute)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("does not sign URLs without the service base URL", func() {
+		client = NewFSClient(baseDir, log, nil, nil, nil, 80, "")
+		Expect(client.SupportsPresignedDownloadURLs()).To(BeFalse())
+		_, err := client.GeneratePresignedDownloadURL(ctx, objectName, "logs.tar.gz", time.Minute)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,236 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io/ioutil"
+	"sort"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/isoeditor"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	testISOSize       = testISOAreaOffset + minimumPartSizeBytes + 1024*1024
+	testISOAreaOffset = copyPartChunkSizeBytes + 4096
+	testISOAreaLength = 256 * 1024
+)
+
+// createTestISO returns the content of a live ISO with an embedded area at areaOffset
+func createTestISO(size, areaOffset, areaLength int64) []byte {
+	content := make([]byte, size)
+	for i := range content {
+		content[i] = byte(i % 251)
+	}
+	header := bytes.NewBuffer([]byte("coreiso+"))
+	Expect(binary.Write(header, binary.LittleEndian, uint64(areaOffset))).To(Succeed())
+	Expect(binary.Write(header, binary.LittleEndian, uint64(areaLength))).To(Succeed())
+	copy(content[32744:], header.Bytes())
+	return content
+}
+
+func readTestRange(content []byte, reads *int) func(offset, length int64) ([]byte, error) {
+	return func(offset, length int64) ([]byte, error) {
+		*reads++
+		ret := make([]byte, length)
+		copy(ret, content[offset:offset+length])
+		return ret, nil
+	}
+}
+
+var _ = Describe("embeddedAreaCache", func() {
+	var (
+		cache embeddedAreaCache
+		iso   []byte
+		reads int
+	)
+
+	BeforeEach(func() {
+		cache = embeddedAreaCache{}
+		iso = createTestISO(16*1024*1024, 8*1024*1024, testISOAreaLength)
+		reads = 0
+	})
+
+	It("reads the embedded area once", func() {
+		info, area, err := cache.get("etag", int64(len(iso)), readTestRange(iso, &reads))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.areaOffsetBytes).To(Equal(int64(8 * 1024 * 1024)))
+		Expect(info.areaLengthBytes).To(Equal(int64(testISOAreaLength)))
+		Expect(area).To(Equal(iso[8*1024*1024 : 8*1024*1024+minimumPartSizeBytes]))
+		Expect(reads).To(Equal(2))
+
+		Expect(embedIgnition(area, info.areaLengthBytes, "ignition")).To(Succeed())
+		_, cached, err := cache.get("etag", int64(len(iso)), readTestRange(iso, &reads))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reads).To(Equal(2))
+		Expect(cached).To(Equal(iso[8*1024*1024 : 8*1024*1024+minimumPartSizeBytes]))
+	})
+
+	It("reads a base ISO with another ETag", func() {
+		_, _, err := cache.get("etag", int64(len(iso)), readTestRange(iso, &reads))
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = cache.get("other", int64(len(iso)), readTestRange(iso, &reads))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reads).To(Equal(4))
+	})
+
+	It("fails for an embedded area too close to the end of the ISO", func() {
+		iso = createTestISO(16*1024*1024, 12*1024*1024, testISOAreaLength)
+		_, _, err := cache.get("etag", int64(len(iso)), readTestRange(iso, &reads))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails to embed an ignition that is too long", func() {
+		area := make([]byte, minimumPartSizeBytes)
+		Expect(embedIgnition(area, 10, "ignition")).ToNot(Succeed())
+	})
+})
+
+// storageBackendSpecs are the specs of an API implementation that stores objects in a storage service
+func storageBackendSpecs(getClient func() API) {
+	var (
+		ctx    = context.Background()
+		client API
+	)
+
+	BeforeEach(func() {
+		client = getClient()
+	})
+
+	download := func(objectName string) []byte {
+		reader, size, err := client.Download(ctx, objectName)
+		Expect(err).ToNot(HaveOccurred())
+		defer reader.Close()
+		content, err := ioutil.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(int64(len(content))).To(Equal(size))
+		return content
+	}
+
+	It("uploads, downloads and deletes objects", func() {
+		Expect(client.Upload(ctx, []byte("content"), "dir/object")).To(Succeed())
+
+		exists, err := client.DoesObjectExist(ctx, "dir/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+		size, err := client.GetObjectSizeBytes(ctx, "dir/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(size).To(Equal(int64(7)))
+		Expect(download("dir/object")).To(Equal([]byte("content")))
+
+		deleted, err := client.DeleteObject(ctx, "dir/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+		exists, err = client.DoesObjectExist(ctx, "dir/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+		deleted, err = client.DeleteObject(ctx, "dir/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeFalse())
+	})
+
+	It("returns not found for a missing object", func() {
+		_, _, err := client.Download(ctx, "missing")
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(BeAssignableToTypeOf(common.NotFound("")))
+	})
+
+	It("uploads streams larger than a block", func() {
+		content := bytes.Repeat([]byte("0123456789"), 2*1024*1024)
+		Expect(client.UploadStream(ctx, bytes.NewReader(content), "stream")).To(Succeed())
+		Expect(download("stream")).To(Equal(content))
+	})
+
+	It("keeps public objects apart", func() {
+		Expect(client.UploadStreamToPublicBucket(ctx, bytes.NewReader([]byte("public")), "object")).To(Succeed())
+		exists, err := client.DoesPublicObjectExist(ctx, "object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+		exists, err = client.DoesObjectExist(ctx, "object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+
+		reader, _, err := client.DownloadPublic(ctx, "object")
+		Expect(err).ToNot(HaveOccurred())
+		content, err := ioutil.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reader.Close()).To(Succeed())
+		Expect(content).To(Equal([]byte("public")))
+	})
+
+	It("lists objects by prefix", func() {
+		for _, name := range []string{"cluster/a", "cluster/b", "other/c"} {
+			Expect(client.Upload(ctx, []byte(name), name)).To(Succeed())
+		}
+		objects, err := client.ListObjectsByPrefix(ctx, "cluster/")
+		Expect(err).ToNot(HaveOccurred())
+		sort.Strings(objects)
+		Expect(objects).To(Equal([]string{"cluster/a", "cluster/b"}))
+	})
+
+	It("updates the timestamp of existing objects", func() {
+		Expect(client.Upload(ctx, []byte("content"), "object")).To(Succeed())
+		updated, err := client.UpdateObjectTimestamp(ctx, "object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).To(BeTrue())
+		updated, err = client.UpdateObjectTimestamp(ctx, "missing")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).To(BeFalse())
+	})
+
+	It("expires objects", func() {
+		for _, name := range []string{"image-a", "image-b", "other"} {
+			Expect(client.Upload(ctx, []byte(name), name)).To(Succeed())
+		}
+		Expect(client.UpdateObjectTimestamp(ctx, "image-a")).To(BeTrue())
+
+		var expired []string
+		callback := func(ctx context.Context, log logrus.FieldLogger, objectName string) {
+			expired = append(expired, objectName)
+		}
+		client.ExpireObjects(ctx, "image-", time.Hour, callback)
+		Expect(expired).To(BeEmpty())
+
+		client.ExpireObjectsUnlessReferenced(ctx, "image-", -time.Hour, func(ctx context.Context, log logrus.FieldLogger, objectName string) bool {
+			return objectName == "image-a"
+		}, callback)
+		Expect(expired).To(Equal([]string{"image-b"}))
+		objects, err := client.ListObjectsByPrefix(ctx, "")
+		Expect(err).ToNot(HaveOccurred())
+		sort.Strings(objects)
+		Expect(objects).To(Equal([]string{"image-a", "other"}))
+
+		client.ExpireObjects(ctx, "image-", -time.Hour, callback)
+		Expect(expired).To(Equal([]string{"image-b", "image-a"}))
+	})
+
+	It("overlays the ignition on the base ISO", func() {
+		iso := createTestISO(testISOSize, testISOAreaOffset, testISOAreaLength)
+		Expect(client.UploadStreamToPublicBucket(ctx, bytes.NewReader(iso), "rhcos.iso")).To(Succeed())
+
+		for _, ignition := range []string{"first ignition", "second ignition"} {
+			Expect(client.UploadISO(ctx, ignition, "rhcos.iso", "discovery-image")).To(Succeed())
+
+			expected := make([]byte, len(iso))
+			copy(expected, iso)
+			ignitionBytes, err := isoeditor.IgnitionImageArchive(ignition)
+			Expect(err).ToNot(HaveOccurred())
+			copy(expected[testISOAreaOffset:], ignitionBytes)
+			Expect(bytes.Equal(download("discovery-image.iso"), expected)).To(BeTrue())
+		}
+	})
+
+	It("fails to create an ISO from a missing base ISO", func() {
+		Expect(client.UploadISO(ctx, "ignition", "missing.iso", "discovery-image")).ToNot(Succeed())
+	})
+
+	It("does not stream ISOs", func() {
+		_, _, err := client.StreamISO(ctx, "ignition", nil, nil, "rhcos.iso")
+		Expect(err).To(HaveOccurred())
+	})
+}
@@ -169,7 +169,13 @@ func (u *ISOUploader) getISOHeaderInfo(log logrus.FieldLogger, baseObjectName st
 		return 0, 0, err
 	}
 
-	ignOffsetInfo, err := isoeditor.GetIgnitionArea(headerString)
+	return getIgnitionArea(headerString, baseObjectSize)
+}
+
+// getIgnitionArea returns the offset and length of the embedded area of a live ISO from its header,
+// which are the last 24 bytes of the first 32KB of the ISO
+func getIgnitionArea(header []byte, baseObjectSize int64) (int64, int64, error) {
+	ignOffsetInfo, err := isoeditor.GetIgnitionArea(header)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -185,6 +191,72 @@ func (u *ISOUploader) getISOHeaderInfo(log logrus.FieldLogger, baseObjectName st
 	return int64(ignOffsetInfo.Offset), int64(ignOffsetInfo.Length), nil
 }
 
+// embedIgnition overwrites the start of the embedded area of a live ISO with the ignition config
+func embedIgnition(area []byte, areaLengthBytes int64, ignitionConfig string) error {
+	ignitionBytes, err := isoeditor.IgnitionImageArchive(ignitionConfig)
+	if err != nil {
+		return err
+	}
+
+	if int64(len(ignitionBytes)) > areaLengthBytes {
+		return errors.New(fmt.Sprintf("Ignition is too long to be embedded (%d > %d)", len(ignitionBytes), areaLengthBytes))
+	}
+
+	copy(area, ignitionBytes)
+	return nil
+}
+
+// embeddedAreaCache keeps the location and the original content of the embedded area of base ISOs in memory,
+// for storage backends that overlay the area on a server-side copy of the base ISO
+type embeddedAreaCache struct {
+	mutex sync.Mutex
+	areas map[string]*embeddedArea
+}
+
+type embeddedArea struct {
+	info     isoInfo
+	contents []byte
+}
+
+// get returns the embedded area of the base ISO with the given ETag, which is read with readRange if it is not cached.
+// The returned content is a copy that can be modified.
+func (c *embeddedAreaCache) get(etag string, baseObjectSize int64, readRange func(offset, length int64) ([]byte, error)) (*isoInfo, []byte, error) {
+	c.mutex.Lock()
+	area, found := c.areas[etag]
+	c.mutex.Unlock()
+
+	if !found {
+		header, err := readRange(32744, 24)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to read ISO header")
+		}
+		offset, length, err := getIgnitionArea(header, baseObjectSize)
+		if err != nil {
+			return nil, nil, err
+		}
+		contents, err := readRange(offset, minimumPartSizeBytes)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to read ISO embedded area")
+		}
+		area = &embeddedArea{
+			info:     isoInfo{etag: etag, baseObjectSize: baseObjectSize, areaOffsetBytes: offset, areaLengthBytes: length},
+			contents: contents,
+		}
+
+		c.mutex.Lock()
+		if c.areas == nil {
+			c.areas = make(map[string]*embeddedArea)
+		}
+		c.areas[etag] = area
+		c.mutex.Unlock()
+	}
+
+	contents := make([]byte, len(area.contents))
+	copy(contents, area.contents)
+	info := area.info
+	return &info, contents, nil
+}
+
 type multiUpload struct {
 	ctx             context.Context
 	log             logrus.FieldLogger
@@ -374,20 +446,11 @@ func (m *multiUpload) uploadPartCopy(c chunk) error {
 }
 
 func (m *multiUpload) uploadIgnition(log logrus.FieldLogger, partNum int64, ignitionConfig string) error {
-	ignitionBytes, err := isoeditor.IgnitionImageArchive(ignitionConfig)
-	if err != nil {
+	if err := embedIgnition(*m.origContents, m.isoInfo.areaLengthBytes, ignitionConfig); err != nil {
 		m.log.Error(err)
 		return err
 	}
 
-	if int64(len(ignitionBytes)) > m.isoInfo.areaLengthBytes {
-		err = errors.New(fmt.Sprintf("Ignition is too long to be embedded (%d > %d)", len(ignitionBytes), m.isoInfo.areaLengthBytes))
-		m.log.Error(err)
-		return err
-	}
-
-	copy(*m.origContents, ignitionBytes)
-
 	contentLength := int64(len(*m.origContents))
 	completedPartCopy, err := m.uploader.s3client.UploadPart(&s3.UploadPartInput{
 		Bucket:        aws.String(m.uploader.bucket),
@@ -11,17 +11,19 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/openshift/assisted-service/internal/isoeditor"
+	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 const (
 	minimalTemplatesVersionFileName = "minimal_templates_version.json"
-	minimalTemplatesVersionLatest   = 3 // increase if templates update is needed
+	minimalTemplatesVersionLatest   = 4 // increase if templates update is needed
 )
 
 type templatesVersion struct {
@@ -87,6 +89,147 @@ func UploadFromURLToPublicBucket(ctx context.Context, objectName, url string, ap
 	return nil
 }
 
+// isBaseISOVerified returns whether the base ISO has the checksum configured for its version, which is required before it
+// is used as a template
+func isBaseISOVerified(ctx context.Context, log logrus.FieldLogger, api API, baseIsoObject, expectedChecksum string) (bool, error) {
+	checksum, err := GetChecksum(ctx, log, api, baseIsoObject, true)
+	if err != nil {
+		return false, err
+	}
+	if err = verifyBaseISOChecksum(log, baseIsoObject, expectedChecksum, checksum); err != nil {
+		log.WithError(err).Warnf("Base ISO %s will be replaced", baseIsoObject)
+		return false, nil
+	}
+	return true, nil
+}
+
+// verifyBaseISOChecksum verifies the checksum of a base ISO against the one configured for its version. The base ISOs
+// of versions without a configured checksum are used unverified.
+func verifyBaseISOChecksum(log logrus.FieldLogger, name, expected, checksum string) error {
+	if expected == "" {
+		log.Warnf("No checksum is configured for base ISO %s, it is used WITHOUT being verified", name)
+		return nil
+	}
+	return VerifyChecksum(name, expected, checksum)
+}
+
+// downloadVerifiedBaseISO downloads a base ISO to a temporary file and verifies it before it is uploaded anywhere. It
+// returns the path of the file and its checksum.
+func downloadVerifiedBaseISO(log logrus.FieldLogger, isoURL, isoSHA256 string) (string, string, error) {
+	baseIsoPath, err := DownloadURLToTemporaryFile(isoURL)
+	if err != nil {
+		log.Error(err)
+		return "", "", err
+	}
+	checksum, err := FileSHA256Sum(baseIsoPath)
+	if err == nil {
+		err = verifyBaseISOChecksum(log, isoURL, isoSHA256, checksum)
+	}
+	if err != nil {
+		log.Error(err)
+		os.Remove(baseIsoPath)
+		return "", "", err
+	}
+	return baseIsoPath, checksum, nil
+}
+
+// uploadISOsForVersion uploads the base and minimal ISO templates of an OpenShift version to the public bucket
+func uploadISOsForVersion(ctx context.Context, log logrus.FieldLogger, api API, versionsHandler versions.Handler, isoEditorFactory isoeditor.Factory,
+	openshiftVersion string, haveLatestMinimalTemplate bool) error {
+	rhcosImage, err := versionsHandler.GetRHCOSImage(openshiftVersion)
+	if err != nil {
+		return err
+	}
+
+	rhcosImageSHA256, err := versionsHandler.GetRHCOSImageSHA256(openshiftVersion)
+	if err != nil {
+		return err
+	}
+
+	baseIsoObject, err := api.GetBaseIsoObject(openshiftVersion)
+	if err != nil {
+		return err
+	}
+
+	minimalIsoObject, err := api.GetMinimalIsoObjectName(openshiftVersion)
+	if err != nil {
+		return err
+	}
+
+	return uploadBaseAndMinimalISOs(ctx, log, api, versionsHandler, isoEditorFactory, baseIsoObject, minimalIsoObject, rhcosImage, rhcosImageSHA256,
+		openshiftVersion, haveLatestMinimalTemplate)
+}
+
+func rhcosObjectName(versionsHandler versions.Handler, template, openshiftVersion string) (string, error) {
+	rhcosVersion, err := versionsHandler.GetRHCOSVersion(openshiftVersion)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(template, rhcosVersion), nil
+}
+
+// uploadBaseAndMinimalISOs uploads the base ISO of an OpenShift version and the minimal ISO template created from it
+// to the public bucket, unless they are already there
+func uploadBaseAndMinimalISOs(ctx context.Context, log logrus.FieldLogger, api API, versionsHandler versions.Handler, isoEditorFactory isoeditor.Factory,
+	isoObjectName, minimalIsoObject, isoURL, isoSHA256, openshiftVersion string, haveLatestMinimalTemplate bool) error {
+
+	baseExists, err := api.DoesPublicObjectExist(ctx, isoObjectName)
+	if err != nil {
+		return err
+	}
+	if baseExists {
+		if baseExists, err = isBaseISOVerified(ctx, log, api, isoObjectName, isoSHA256); err != nil {
+			return err
+		}
+	}
+
+	var minimalExists bool
+	if !haveLatestMinimalTemplate || !baseExists {
+		// Should update minimal ISO template, which is also the case when the base ISO it was created from is replaced
+		minimalExists = false
+	} else {
+		minimalExists, err = api.DoesPublicObjectExist(ctx, minimalIsoObject)
+		if err != nil {
+			return err
+		}
+	}
+
+	if baseExists && minimalExists {
+		return nil
+	}
+
+	log.Infof("Starting Base ISO download for %s", isoObjectName)
+	baseIsoPath, checksum, err := downloadVerifiedBaseISO(log, isoURL, isoSHA256)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(baseIsoPath)
+
+	if !baseExists {
+		err = api.UploadFileToPublicBucket(ctx, baseIsoPath, isoObjectName)
+		if err != nil {
+			return err
+		}
+		if err = UploadChecksum(ctx, api, isoObjectName, checksum, true); err != nil {
+			return err
+		}
+		log.Infof("Successfully uploaded object %s", isoObjectName)
+	}
+
+	if !minimalExists {
+		rootFSURL, err := versionsHandler.GetRHCOSRootFS(openshiftVersion)
+		if err != nil {
+			return err
+		}
+		if err = CreateAndUploadMinimalIso(ctx, log, baseIsoPath, minimalIsoObject, rootFSURL, api, isoEditorFactory); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func CreateAndUploadMinimalIso(ctx context.Context, log logrus.FieldLogger, isoPath, minimalIsoObject, rootFSURL string,
 	api API, editorFactory isoeditor.Factory) error {
 
@@ -113,6 +256,56 @@ func CreateAndUploadMinimalIso(ctx context.Context, log logrus.FieldLogger, isoP
 	return updateISOTemplatesVersion(ctx, log, api)
 }
 
+// PXEArtifactObjectName returns the name of the object holding a network boot artifact of an image,
+// where imageObject is either the name of a base ISO object or a cluster discovery image prefix
+func PXEArtifactObjectName(imageObject, artifact string) string {
+	return fmt.Sprintf(pxeArtifactTemplate, strings.TrimSuffix(imageObject, ".iso"), artifact)
+}
+
+// UploadPXEArtifacts extracts the kernel, initrd and rootfs from the base ISO and uploads them,
+// unless they were already uploaded for this ISO
+func UploadPXEArtifacts(ctx context.Context, log logrus.FieldLogger, api API, editorFactory isoeditor.Factory,
+	baseIsoObject, cacheDir string) error {
+	missing := false
+	for _, artifact := range []string{PXEKernel, PXEInitrd, PXERootFS} {
+		exists, err := api.DoesObjectExist(ctx, PXEArtifactObjectName(baseIsoObject, artifact))
+		if err != nil {
+			return err
+		}
+		missing = missing || !exists
+	}
+	if !missing {
+		return nil
+	}
+
+	isoPath, err := GetFile(ctx, api, baseIsoObject, cacheDir, true)
+	if err != nil {
+		return errors.Wrapf(err, "failed to download base ISO %s", baseIsoObject)
+	}
+
+	log.Infof("Extracting network boot artifacts from rhcos ISO (%s)", isoPath)
+	var artifacts *isoeditor.PXEArtifacts
+	err = editorFactory.WithEditor(ctx, isoPath, log, func(editor isoeditor.Editor) error {
+		var extractError error
+		artifacts, extractError = editor.ExtractPXEArtifacts()
+		return extractError
+	})
+	if err != nil {
+		log.Errorf("Error extracting network boot artifacts from rhcos ISO (%v)", err)
+		return err
+	}
+	defer artifacts.Remove()
+
+	for artifact, path := range map[string]string{PXEKernel: artifacts.KernelPath, PXEInitrd: artifacts.InitrdPath, PXERootFS: artifacts.RootFSPath} {
+		objectName := PXEArtifactObjectName(baseIsoObject, artifact)
+		log.Infof("Uploading network boot artifact %s", objectName)
+		if err = api.UploadFile(ctx, path, objectName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // HaveLatestMinimalTemplate Returns true if latest version already exists in bucket; otherwise, false.
 func HaveLatestMinimalTemplate(ctx context.Context, log logrus.FieldLogger, api API) bool {
 	versionFromBucket, err := getISOTemplatesVersion(ctx, log, api)
@@ -251,3 +444,47 @@ func TarAwsFiles(ctx context.Context, tarName string, files, tarredFilenames []s
 	wg.Wait()
 	return err
 }
+
+// objectsReader reads objects one after the other
+type objectsReader struct {
+	ctx         context.Context
+	api         API
+	objectNames []string
+	current     io.ReadCloser
+}
+
+func newObjectsReader(ctx context.Context, api API, objectNames []string) *objectsReader {
+	return &objectsReader{ctx: ctx, api: api, objectNames: objectNames}
+}
+
+func (r *objectsReader) Read(p []byte) (int, error) {
+	for {
+		if r.current == nil {
+			if len(r.objectNames) == 0 {
+				return 0, io.EOF
+			}
+			reader, _, err := r.api.Download(r.ctx, r.objectNames[0])
+			if err != nil {
+				return 0, errors.Wrapf(err, "failed to download %s", r.objectNames[0])
+			}
+			r.current = reader
+			r.objectNames = r.objectNames[1:]
+		}
+		n, err := r.current.Read(p)
+		if err == io.EOF {
+			r.current.Close()
+			r.current = nil
+			err = nil
+		}
+		if n > 0 || err != nil {
+			return n, err
+		}
+	}
+}
+
+func (r *objectsReader) Close() error {
+	if r.current != nil {
+		return r.current.Close()
+	}
+	return nil
+}
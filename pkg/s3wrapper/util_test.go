@@ -18,6 +18,7 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/isoeditor"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/pkg/errors"
@@ -26,6 +27,8 @@ import (
 
 func TestJob(t *testing.T) {
 	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
 	RunSpecs(t, "Util")
 }
 
@@ -33,6 +36,7 @@ const (
 	defaultTestOpenShiftVersion = "4.6"
 	defaultTestRhcosURL         = "rhcosURL"
 	defaultTestRhcosRootFSURL   = "rhcosRootFSURL"
+	defaultTestRhcosSHA256      = "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
 )
 
 var (
@@ -30,6 +30,8 @@ type StaticNetworkConfig interface {
 	GenerateStaticNetworkConfigData(hostsYAMLS string) ([]StaticNetworkConfigData, error)
 	FormatStaticNetworkConfigForDB(staticNetworkConfig []*models.HostStaticNetworkConfig) string
 	ValidateStaticConfigParams(staticNetworkConfig []*models.HostStaticNetworkConfig) error
+	ValidateStaticIPPool(pool *models.StaticIPPool) error
+	GenerateStaticIPPoolConfig(pool *models.StaticIPPool, previousAllocations map[string]string) ([]*models.HostStaticNetworkConfig, map[string]string, error)
 }
 
 type StaticNetworkConfigGenerator struct {
@@ -0,0 +1,398 @@
+package staticnetworkconfig
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Upper limit of the number of hosts in a single MAC range, to protect against typos in the range ends
+const maxMacRangeSize = 4096
+
+type nmstateAddress struct {
+	IP           string `yaml:"ip"`
+	PrefixLength int    `yaml:"prefix-length"`
+}
+
+type nmstateIP struct {
+	Enabled  bool             `yaml:"enabled"`
+	DHCP     *bool            `yaml:"dhcp,omitempty"`
+	Autoconf *bool            `yaml:"autoconf,omitempty"`
+	Address  []nmstateAddress `yaml:"address,omitempty"`
+}
+
+type nmstateLinkAggregation struct {
+	Mode string   `yaml:"mode"`
+	Port []string `yaml:"port"`
+}
+
+type nmstateVlan struct {
+	BaseIface string `yaml:"base-iface"`
+	ID        int64  `yaml:"id"`
+}
+
+type nmstateInterface struct {
+	Name            string                  `yaml:"name"`
+	Type            string                  `yaml:"type"`
+	State           string                  `yaml:"state"`
+	IPv4            *nmstateIP              `yaml:"ipv4,omitempty"`
+	IPv6            *nmstateIP              `yaml:"ipv6,omitempty"`
+	LinkAggregation *nmstateLinkAggregation `yaml:"link-aggregation,omitempty"`
+	Vlan            *nmstateVlan            `yaml:"vlan,omitempty"`
+}
+
+type nmstateRoute struct {
+	Destination      string `yaml:"destination"`
+	NextHopAddress   string `yaml:"next-hop-address"`
+	NextHopInterface string `yaml:"next-hop-interface"`
+	TableID          int    `yaml:"table-id"`
+}
+
+type nmstateRoutes struct {
+	Config []nmstateRoute `yaml:"config"`
+}
+
+type nmstateDNSConfig struct {
+	Server []string `yaml:"server"`
+}
+
+type nmstateDNSResolver struct {
+	Config nmstateDNSConfig `yaml:"config"`
+}
+
+type nmstateConfig struct {
+	DNSResolver *nmstateDNSResolver `yaml:"dns-resolver,omitempty"`
+	Interfaces  []nmstateInterface  `yaml:"interfaces"`
+	Routes      nmstateRoutes       `yaml:"routes"`
+}
+
+// ipPool is the parsed form of a static IP pool
+type ipPool struct {
+	network    *net.IPNet
+	isIPv4     bool
+	gateway    net.IP
+	rangeStart *big.Int
+	rangeEnd   *big.Int
+	reserved   map[string]bool
+	// The MAC addresses of each host, the first one identifies the host
+	hosts [][]string
+}
+
+func ipToInt(ip net.IP) *big.Int {
+	if ip4 := ip.To4(); ip4 != nil {
+		return new(big.Int).SetBytes(ip4)
+	}
+	return new(big.Int).SetBytes(ip.To16())
+}
+
+func intToIP(i *big.Int, isIPv4 bool) net.IP {
+	size := net.IPv6len
+	if isIPv4 {
+		size = net.IPv4len
+	}
+	b := i.Bytes()
+	ip := make(net.IP, size)
+	copy(ip[size-len(b):], b)
+	return ip
+}
+
+func parsePoolAddress(name, address string, pool *ipPool) (net.IP, error) {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return nil, errors.Errorf("Invalid %s %s", name, address)
+	}
+	if (ip.To4() != nil) != pool.isIPv4 || !pool.network.Contains(ip) {
+		return nil, errors.Errorf("The %s %s is not in the static IP pool network %s", name, address, pool.network.String())
+	}
+	return ip, nil
+}
+
+func macToInt(mac net.HardwareAddr) uint64 {
+	var ret uint64
+	for _, b := range mac {
+		ret = ret<<8 | uint64(b)
+	}
+	return ret
+}
+
+func intToMac(i uint64) string {
+	mac := make(net.HardwareAddr, 6)
+	for j := 5; j >= 0; j-- {
+		mac[j] = byte(i)
+		i >>= 8
+	}
+	return mac.String()
+}
+
+func parseMac(mac string) (net.HardwareAddr, error) {
+	hw, err := net.ParseMAC(mac)
+	if err != nil || len(hw) != 6 {
+		return nil, errors.Errorf("Invalid MAC address %s", mac)
+	}
+	return hw, nil
+}
+
+func parsePoolHosts(pool *models.StaticIPPool) ([][]string, error) {
+	ret := make([][]string, 0)
+	seen := make(map[string]bool)
+	addMac := func(mac string) error {
+		if seen[mac] {
+			return errors.Errorf("MAC address %s appears more than once in the static IP pool", mac)
+		}
+		seen[mac] = true
+		return nil
+	}
+	for i, host := range pool.Hosts {
+		if host == nil || len(host.MacAddresses) == 0 {
+			return nil, errors.Errorf("Host %d of the static IP pool has no MAC addresses", i)
+		}
+		if len(host.MacAddresses) > 1 && pool.BondMode == "" {
+			return nil, errors.Errorf("Host %d of the static IP pool has several MAC addresses, a bond mode is required", i)
+		}
+		macs := make([]string, 0, len(host.MacAddresses))
+		for _, mac := range host.MacAddresses {
+			hw, err := parseMac(mac)
+			if err != nil {
+				return nil, err
+			}
+			if err = addMac(hw.String()); err != nil {
+				return nil, err
+			}
+			macs = append(macs, hw.String())
+		}
+		ret = append(ret, macs)
+	}
+	for _, macRange := range pool.MacRanges {
+		if macRange == nil {
+			continue
+		}
+		start, err := parseMac(macRange.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseMac(macRange.End)
+		if err != nil {
+			return nil, err
+		}
+		first, last := macToInt(start), macToInt(end)
+		if first > last {
+			return nil, errors.Errorf("MAC range %s-%s is empty", macRange.Start, macRange.End)
+		}
+		if last-first >= maxMacRangeSize {
+			return nil, errors.Errorf("MAC range %s-%s has more than %d addresses", macRange.Start, macRange.End, maxMacRangeSize)
+		}
+		for i := first; i <= last; i++ {
+			mac := intToMac(i)
+			if err = addMac(mac); err != nil {
+				return nil, err
+			}
+			ret = append(ret, []string{mac})
+		}
+	}
+	if len(ret) == 0 {
+		return nil, errors.New("The static IP pool has no hosts")
+	}
+	return ret, nil
+}
+
+func parseIPPool(pool *models.StaticIPPool) (*ipPool, error) {
+	if pool.Cidr == "" {
+		return nil, errors.New("The static IP pool network is required")
+	}
+	_, network, err := net.ParseCIDR(pool.Cidr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Invalid static IP pool network %s", pool.Cidr)
+	}
+	ret := &ipPool{
+		network:  network,
+		isIPv4:   network.IP.To4() != nil,
+		reserved: make(map[string]bool),
+	}
+	if pool.Gateway == "" {
+		return nil, errors.New("The static IP pool gateway is required")
+	}
+	if ret.gateway, err = parsePoolAddress("gateway", pool.Gateway, ret); err != nil {
+		return nil, err
+	}
+	ret.reserved[ret.gateway.String()] = true
+	for _, server := range pool.DNSServers {
+		ip := net.ParseIP(server)
+		if ip == nil {
+			return nil, errors.Errorf("Invalid DNS server %s", server)
+		}
+		ret.reserved[ip.String()] = true
+	}
+
+	// The network address, and the broadcast address for IPv4, are not allocated by default
+	ones, bits := network.Mask.Size()
+	networkStart := ipToInt(network.IP)
+	networkEnd := new(big.Int).Add(networkStart, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(bits-ones)), big.NewInt(1)))
+	ret.rangeStart = new(big.Int).Add(networkStart, big.NewInt(1))
+	ret.rangeEnd = networkEnd
+	if ret.isIPv4 {
+		ret.rangeEnd = new(big.Int).Sub(networkEnd, big.NewInt(1))
+	}
+	if pool.RangeStart != "" {
+		ip, err := parsePoolAddress("range start", pool.RangeStart, ret)
+		if err != nil {
+			return nil, err
+		}
+		ret.rangeStart = ipToInt(ip)
+	}
+	if pool.RangeEnd != "" {
+		ip, err := parsePoolAddress("range end", pool.RangeEnd, ret)
+		if err != nil {
+			return nil, err
+		}
+		ret.rangeEnd = ipToInt(ip)
+	}
+	if ret.rangeStart.Cmp(ret.rangeEnd) > 0 {
+		return nil, errors.Errorf("The static IP pool range %s-%s is empty", intToIP(ret.rangeStart, ret.isIPv4), intToIP(ret.rangeEnd, ret.isIPv4))
+	}
+
+	if ret.hosts, err = parsePoolHosts(pool); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (p *ipPool) isAllocatable(ip net.IP) bool {
+	if ip == nil || (ip.To4() != nil) != p.isIPv4 || p.reserved[ip.String()] {
+		return false
+	}
+	i := ipToInt(ip)
+	return i.Cmp(p.rangeStart) >= 0 && i.Cmp(p.rangeEnd) <= 0
+}
+
+/*
+ * Allocate an address to each host of the pool.  Hosts keep their previous allocation when it is still valid, so that
+ * regenerating the image does not change the host addresses.  The other hosts get the lowest free addresses of the range,
+ * in the order they appear in the pool.
+ */
+func (p *ipPool) allocate(previous map[string]string) (map[string]string, error) {
+	ret := make(map[string]string)
+	used := make(map[string]bool)
+	for _, macs := range p.hosts {
+		ip := net.ParseIP(previous[macs[0]])
+		if p.isAllocatable(ip) && !used[ip.String()] {
+			ret[macs[0]] = ip.String()
+			used[ip.String()] = true
+		}
+	}
+	next := new(big.Int).Set(p.rangeStart)
+	for _, macs := range p.hosts {
+		if _, ok := ret[macs[0]]; ok {
+			continue
+		}
+		for ; next.Cmp(p.rangeEnd) <= 0; next.Add(next, big.NewInt(1)) {
+			ip := intToIP(next, p.isIPv4).String()
+			if !used[ip] && !p.reserved[ip] {
+				break
+			}
+		}
+		if next.Cmp(p.rangeEnd) > 0 {
+			return nil, errors.Errorf("The static IP pool has no free address for host %s", macs[0])
+		}
+		ip := intToIP(next, p.isIPv4).String()
+		ret[macs[0]] = ip
+		used[ip] = true
+	}
+	return ret, nil
+}
+
+// hostConfig renders the NMState configuration of a single host and the mapping of its MAC addresses to the interface names
+func (p *ipPool) hostConfig(pool *models.StaticIPPool, macs []string, ip string) (*models.HostStaticNetworkConfig, error) {
+	disabled := &nmstateIP{Enabled: false}
+	interfaces := make([]nmstateInterface, 0, len(macs)+2)
+	macInterfaceMap := make(models.MacInterfaceMap, 0, len(macs))
+	nics := make([]string, 0, len(macs))
+	for i, mac := range macs {
+		name := fmt.Sprintf("eth%d", i)
+		nics = append(nics, name)
+		interfaces = append(interfaces, nmstateInterface{Name: name, Type: "ethernet", State: "up", IPv4: disabled, IPv6: disabled})
+		macInterfaceMap = append(macInterfaceMap, &models.MacInterfaceMapItems0{MacAddress: mac, LogicalNicName: name})
+	}
+
+	addressInterface := nics[0]
+	if pool.BondMode != "" {
+		addressInterface = "bond0"
+		interfaces = append(interfaces, nmstateInterface{
+			Name:            addressInterface,
+			Type:            "bond",
+			State:           "up",
+			IPv4:            disabled,
+			IPv6:            disabled,
+			LinkAggregation: &nmstateLinkAggregation{Mode: pool.BondMode, Port: nics},
+		})
+	}
+	if pool.VlanID != 0 {
+		baseIface := addressInterface
+		addressInterface = fmt.Sprintf("%s.%d", baseIface, pool.VlanID)
+		interfaces = append(interfaces, nmstateInterface{
+			Name:  addressInterface,
+			Type:  "vlan",
+			State: "up",
+			IPv4:  disabled,
+			IPv6:  disabled,
+			Vlan:  &nmstateVlan{BaseIface: baseIface, ID: pool.VlanID},
+		})
+	}
+
+	ones, _ := p.network.Mask.Size()
+	f := false
+	address := &nmstateIP{Enabled: true, DHCP: &f, Address: []nmstateAddress{{IP: ip, PrefixLength: ones}}}
+	route := nmstateRoute{NextHopAddress: p.gateway.String(), NextHopInterface: addressInterface, TableID: 254}
+	last := &interfaces[len(interfaces)-1]
+	if p.isIPv4 {
+		last.IPv4 = address
+		route.Destination = "0.0.0.0/0"
+	} else {
+		address.Autoconf = &f
+		last.IPv6 = address
+		route.Destination = "::/0"
+	}
+
+	config := nmstateConfig{
+		Interfaces: interfaces,
+		Routes:     nmstateRoutes{Config: []nmstateRoute{route}},
+	}
+	if len(pool.DNSServers) > 0 {
+		config.DNSResolver = &nmstateDNSResolver{Config: nmstateDNSConfig{Server: pool.DNSServers}}
+	}
+	b, err := yaml.Marshal(&config)
+	if err != nil {
+		return nil, err
+	}
+	return &models.HostStaticNetworkConfig{NetworkYaml: string(b), MacInterfaceMap: macInterfaceMap}, nil
+}
+
+func (s *StaticNetworkConfigGenerator) ValidateStaticIPPool(pool *models.StaticIPPool) error {
+	_, err := parseIPPool(pool)
+	return err
+}
+
+func (s *StaticNetworkConfigGenerator) GenerateStaticIPPoolConfig(pool *models.StaticIPPool, previousAllocations map[string]string) ([]*models.HostStaticNetworkConfig, map[string]string, error) {
+	p, err := parseIPPool(pool)
+	if err != nil {
+		return nil, nil, err
+	}
+	allocations, err := p.allocate(previousAllocations)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.log.Infof("Allocated addresses from static IP pool %s to %d hosts", pool.Cidr, len(allocations))
+	ret := make([]*models.HostStaticNetworkConfig, 0, len(p.hosts))
+	for _, macs := range p.hosts {
+		hostConfig, err := p.hostConfig(pool, macs, allocations[macs[0]])
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to render the static network config of host %s", strings.Join(macs, ","))
+		}
+		ret = append(ret, hostConfig)
+	}
+	return ret, allocations, nil
+}
@@ -0,0 +1,143 @@
+package staticnetworkconfig
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+var _ = Describe("Static IP pool", func() {
+	var (
+		staticNetworkGenerator = StaticNetworkConfigGenerator{log: logrus.New()}
+		pool                   *models.StaticIPPool
+	)
+
+	BeforeEach(func() {
+		pool = &models.StaticIPPool{
+			Cidr:       "192.168.126.0/24",
+			Gateway:    "192.168.126.1",
+			DNSServers: []string{"192.168.126.2"},
+			Hosts: []*models.StaticIPPoolHost{
+				{MacAddresses: []string{"02:00:00:00:00:10"}},
+				{MacAddresses: []string{"02:00:00:00:00:11"}},
+			},
+		}
+	})
+
+	It("allocates the lowest free addresses in host order", func() {
+		configs, allocations, err := staticNetworkGenerator.GenerateStaticIPPoolConfig(pool, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(configs).To(HaveLen(2))
+		Expect(allocations).To(Equal(map[string]string{
+			"02:00:00:00:00:10": "192.168.126.3",
+			"02:00:00:00:00:11": "192.168.126.4",
+		}))
+		Expect(configs[0].MacInterfaceMap).To(Equal(models.MacInterfaceMap{
+			{MacAddress: "02:00:00:00:00:10", LogicalNicName: "eth0"},
+		}))
+
+		var config nmstateConfig
+		Expect(yaml.Unmarshal([]byte(configs[1].NetworkYaml), &config)).To(Succeed())
+		Expect(config.Interfaces).To(HaveLen(1))
+		Expect(config.Interfaces[0].IPv4.Address).To(Equal([]nmstateAddress{{IP: "192.168.126.4", PrefixLength: 24}}))
+		Expect(config.Routes.Config).To(Equal([]nmstateRoute{
+			{Destination: "0.0.0.0/0", NextHopAddress: "192.168.126.1", NextHopInterface: "eth0", TableID: 254},
+		}))
+		Expect(config.DNSResolver.Config.Server).To(Equal([]string{"192.168.126.2"}))
+	})
+
+	It("keeps previous allocations", func() {
+		pool.Hosts = append([]*models.StaticIPPoolHost{{MacAddresses: []string{"02:00:00:00:00:0f"}}}, pool.Hosts...)
+		previous := map[string]string{
+			"02:00:00:00:00:10": "192.168.126.3",
+			"02:00:00:00:00:11": "192.168.126.4",
+			"02:00:00:00:00:99": "192.168.126.5",
+		}
+		_, allocations, err := staticNetworkGenerator.GenerateStaticIPPoolConfig(pool, previous)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocations).To(Equal(map[string]string{
+			"02:00:00:00:00:0f": "192.168.126.5",
+			"02:00:00:00:00:10": "192.168.126.3",
+			"02:00:00:00:00:11": "192.168.126.4",
+		}))
+	})
+
+	It("reallocates previous addresses that are out of the range", func() {
+		pool.RangeStart = "192.168.126.100"
+		pool.RangeEnd = "192.168.126.110"
+		previous := map[string]string{"02:00:00:00:00:10": "192.168.126.3"}
+		_, allocations, err := staticNetworkGenerator.GenerateStaticIPPoolConfig(pool, previous)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocations).To(Equal(map[string]string{
+			"02:00:00:00:00:10": "192.168.126.100",
+			"02:00:00:00:00:11": "192.168.126.101",
+		}))
+	})
+
+	It("expands MAC ranges", func() {
+		pool.Hosts = nil
+		pool.MacRanges = []*models.MacRange{{Start: "02:00:00:00:00:fe", End: "02:00:00:00:01:01"}}
+		configs, allocations, err := staticNetworkGenerator.GenerateStaticIPPoolConfig(pool, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(configs).To(HaveLen(4))
+		Expect(allocations).To(HaveKeyWithValue("02:00:00:00:01:01", "192.168.126.6"))
+	})
+
+	It("fails when the pool is exhausted", func() {
+		pool.RangeStart = "192.168.126.3"
+		pool.RangeEnd = "192.168.126.3"
+		_, _, err := staticNetworkGenerator.GenerateStaticIPPoolConfig(pool, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("renders bonds and VLANs", func() {
+		pool.Cidr = "2001:db8::/64"
+		pool.Gateway = "2001:db8::1"
+		pool.DNSServers = nil
+		pool.BondMode = models.StaticIPPoolBondModeActiveBackup
+		pool.VlanID = 100
+		pool.Hosts = []*models.StaticIPPoolHost{{MacAddresses: []string{"02:00:00:00:00:10", "02:00:00:00:00:11"}}}
+		configs, allocations, err := staticNetworkGenerator.GenerateStaticIPPoolConfig(pool, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocations).To(Equal(map[string]string{"02:00:00:00:00:10": "2001:db8::2"}))
+		Expect(configs[0].MacInterfaceMap).To(HaveLen(2))
+
+		var config nmstateConfig
+		Expect(yaml.Unmarshal([]byte(configs[0].NetworkYaml), &config)).To(Succeed())
+		Expect(config.DNSResolver).To(BeNil())
+		Expect(config.Interfaces).To(HaveLen(4))
+		Expect(config.Interfaces[2].LinkAggregation).To(Equal(&nmstateLinkAggregation{Mode: "active-backup", Port: []string{"eth0", "eth1"}}))
+		Expect(config.Interfaces[3].Name).To(Equal("bond0.100"))
+		Expect(config.Interfaces[3].Vlan).To(Equal(&nmstateVlan{BaseIface: "bond0", ID: 100}))
+		Expect(config.Interfaces[3].IPv6.Address).To(Equal([]nmstateAddress{{IP: "2001:db8::2", PrefixLength: 64}}))
+		Expect(config.Routes.Config[0].Destination).To(Equal("::/0"))
+		Expect(config.Routes.Config[0].NextHopInterface).To(Equal("bond0.100"))
+	})
+
+	DescribeTable("validation",
+		func(modify func(pool *models.StaticIPPool)) {
+			modify(pool)
+			Expect(staticNetworkGenerator.ValidateStaticIPPool(pool)).To(HaveOccurred())
+		},
+		Entry("missing network", func(pool *models.StaticIPPool) { pool.Cidr = "" }),
+		Entry("gateway out of the network", func(pool *models.StaticIPPool) { pool.Gateway = "192.168.127.1" }),
+		Entry("range out of the network", func(pool *models.StaticIPPool) { pool.RangeEnd = "192.168.127.10" }),
+		Entry("empty range", func(pool *models.StaticIPPool) {
+			pool.RangeStart = "192.168.126.20"
+			pool.RangeEnd = "192.168.126.10"
+		}),
+		Entry("duplicate MAC address", func(pool *models.StaticIPPool) {
+			pool.MacRanges = []*models.MacRange{{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:10"}}
+		}),
+		Entry("several MAC addresses without bond mode", func(pool *models.StaticIPPool) {
+			pool.Hosts[0].MacAddresses = append(pool.Hosts[0].MacAddresses, "02:00:00:00:00:20")
+		}),
+		Entry("MAC range too large", func(pool *models.StaticIPPool) {
+			pool.MacRanges = []*models.MacRange{{Start: "02:00:00:00:00:00", End: "02:00:00:ff:00:00"}}
+		}),
+		Entry("no hosts", func(pool *models.StaticIPPool) { pool.Hosts = nil }),
+	)
+})
@@ -33,6 +33,36 @@ func (m *MockStaticNetworkConfig) EXPECT() *MockStaticNetworkConfigMockRecorder
 	return m.recorder
 }
 
+// FormatStaticNetworkConfigForDB mocks base method
+func (m *MockStaticNetworkConfig) FormatStaticNetworkConfigForDB(staticNetworkConfig []*models.HostStaticNetworkConfig) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FormatStaticNetworkConfigForDB", staticNetworkConfig)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// FormatStaticNetworkConfigForDB indicates an expected call of FormatStaticNetworkConfigForDB
+func (mr *MockStaticNetworkConfigMockRecorder) FormatStaticNetworkConfigForDB(staticNetworkConfig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FormatStaticNetworkConfigForDB", reflect.TypeOf((*MockStaticNetworkConfig)(nil).FormatStaticNetworkConfigForDB), staticNetworkConfig)
+}
+
+// GenerateStaticIPPoolConfig mocks base method
+func (m *MockStaticNetworkConfig) GenerateStaticIPPoolConfig(pool *models.StaticIPPool, previousAllocations map[string]string) ([]*models.HostStaticNetworkConfig, map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateStaticIPPoolConfig", pool, previousAllocations)
+	ret0, _ := ret[0].([]*models.HostStaticNetworkConfig)
+	ret1, _ := ret[1].(map[string]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateStaticIPPoolConfig indicates an expected call of GenerateStaticIPPoolConfig
+func (mr *MockStaticNetworkConfigMockRecorder) GenerateStaticIPPoolConfig(pool, previousAllocations interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateStaticIPPoolConfig", reflect.TypeOf((*MockStaticNetworkConfig)(nil).GenerateStaticIPPoolConfig), pool, previousAllocations)
+}
+
 // GenerateStaticNetworkConfigData mocks base method
 func (m *MockStaticNetworkConfig) GenerateStaticNetworkConfigData(hostsYAMLS string) ([]StaticNetworkConfigData, error) {
 	m.ctrl.T.Helper()
@@ -48,30 +78,30 @@ func (mr *MockStaticNetworkConfigMockRecorder) GenerateStaticNetworkConfigData(h
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateStaticNetworkConfigData", reflect.TypeOf((*MockStaticNetworkConfig)(nil).GenerateStaticNetworkConfigData), hostsYAMLS)
 }
 
-// FormatStaticNetworkConfigForDB mocks base method
-func (m *MockStaticNetworkConfig) FormatStaticNetworkConfigForDB(staticNetworkConfig []*models.HostStaticNetworkConfig) string {
+// ValidateStaticConfigParams mocks base method
+func (m *MockStaticNetworkConfig) ValidateStaticConfigParams(staticNetworkConfig []*models.HostStaticNetworkConfig) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FormatStaticNetworkConfigForDB", staticNetworkConfig)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ValidateStaticConfigParams", staticNetworkConfig)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// FormatStaticNetworkConfigForDB indicates an expected call of FormatStaticNetworkConfigForDB
-func (mr *MockStaticNetworkConfigMockRecorder) FormatStaticNetworkConfigForDB(staticNetworkConfig interface{}) *gomock.Call {
+// ValidateStaticConfigParams indicates an expected call of ValidateStaticConfigParams
+func (mr *MockStaticNetworkConfigMockRecorder) ValidateStaticConfigParams(staticNetworkConfig interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FormatStaticNetworkConfigForDB", reflect.TypeOf((*MockStaticNetworkConfig)(nil).FormatStaticNetworkConfigForDB), staticNetworkConfig)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateStaticConfigParams", reflect.TypeOf((*MockStaticNetworkConfig)(nil).ValidateStaticConfigParams), staticNetworkConfig)
 }
 
-// ValidateStaticConfigParams mocks base method
-func (m *MockStaticNetworkConfig) ValidateStaticConfigParams(staticNetworkConfig []*models.HostStaticNetworkConfig) error {
+// ValidateStaticIPPool mocks base method
+func (m *MockStaticNetworkConfig) ValidateStaticIPPool(pool *models.StaticIPPool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateStaticConfigParams", staticNetworkConfig)
+	ret := m.ctrl.Call(m, "ValidateStaticIPPool", pool)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ValidateStaticConfigParams indicates an expected call of ValidateStaticConfigParams
-func (mr *MockStaticNetworkConfigMockRecorder) ValidateStaticConfigParams(staticNetworkConfig interface{}) *gomock.Call {
+// ValidateStaticIPPool indicates an expected call of ValidateStaticIPPool
+func (mr *MockStaticNetworkConfigMockRecorder) ValidateStaticIPPool(pool interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateStaticConfigParams", reflect.TypeOf((*MockStaticNetworkConfig)(nil).ValidateStaticConfigParams), staticNetworkConfig)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateStaticIPPool", reflect.TypeOf((*MockStaticNetworkConfig)(nil).ValidateStaticIPPool), pool)
 }
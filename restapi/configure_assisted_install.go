@@ -54,15 +54,27 @@ type EventsAPI interface {
 
 /* InstallerAPI  */
 type InstallerAPI interface {
+	/* AbortLogsUpload Agent API to abort a logs upload and delete the chunks that were received. */
+	AbortLogsUpload(ctx context.Context, params installer.AbortLogsUploadParams) middleware.Responder
+
 	/* CancelInstallation Cancels an ongoing installation. */
 	CancelInstallation(ctx context.Context, params installer.CancelInstallationParams) middleware.Responder
 
 	/* CompleteInstallation Agent API to mark a finalizing installation as complete. */
 	CompleteInstallation(ctx context.Context, params installer.CompleteInstallationParams) middleware.Responder
 
+	/* CompleteLogsUpload Agent API to complete a logs upload once all of its chunks were received. The chunks are assembled into the logs archive, which is then handled like logs that were uploaded at once. */
+	CompleteLogsUpload(ctx context.Context, params installer.CompleteLogsUploadParams) middleware.Responder
+
+	/* CreateLogsUpload Agent API to start a resumable upload of a logs archive, which is then uploaded in chunks. */
+	CreateLogsUpload(ctx context.Context, params installer.CreateLogsUploadParams) middleware.Responder
+
 	/* DeregisterCluster Deletes an OpenShift cluster definition. */
 	DeregisterCluster(ctx context.Context, params installer.DeregisterClusterParams) middleware.Responder
 
+	/* DeregisterDeclaredHost Deletes a declared host and its discovery image. */
+	DeregisterDeclaredHost(ctx context.Context, params installer.DeregisterDeclaredHostParams) middleware.Responder
+
 	/* DeregisterHost Deregisters an OpenShift host. */
 	DeregisterHost(ctx context.Context, params installer.DeregisterHostParams) middleware.Responder
 
@@ -78,24 +90,48 @@ type InstallerAPI interface {
 	/* DownloadClusterISOHeaders Downloads the OpenShift per-cluster Discovery ISO Headers only. */
 	DownloadClusterISOHeaders(ctx context.Context, params installer.DownloadClusterISOHeadersParams) middleware.Responder
 
+	/* DownloadClusterISOSignature Downloads the detached signature of the SHA-256 digest of the OpenShift per-cluster Discovery image, signed with the image signing key of the service. The checksum of streamed images is computed when their signature is first downloaded. */
+	DownloadClusterISOSignature(ctx context.Context, params installer.DownloadClusterISOSignatureParams) middleware.Responder
+
 	/* DownloadClusterKubeconfig Downloads the kubeconfig file for this cluster. */
 	DownloadClusterKubeconfig(ctx context.Context, params installer.DownloadClusterKubeconfigParams) middleware.Responder
 
 	/* DownloadClusterLogs Download cluster logs. */
 	DownloadClusterLogs(ctx context.Context, params installer.DownloadClusterLogsParams) middleware.Responder
 
+	/* DownloadClusterPxeArtifact Downloads the OpenShift per-cluster network boot artifacts and the iPXE script that boots them. */
+	DownloadClusterPxeArtifact(ctx context.Context, params installer.DownloadClusterPxeArtifactParams) middleware.Responder
+
+	/* DownloadClusterSupportBundle Downloads an archive of the cluster, its hosts, events, validations history, install config, manifests, operators and logs to be attached to a support case. Pull secrets, SSH keys and passwords are redacted from the records of the archive, while the log archives are included as is and flagged as not redacted. */
+	DownloadClusterSupportBundle(ctx context.Context, params installer.DownloadClusterSupportBundleParams) middleware.Responder
+
+	/* DownloadDeclaredHostISO Downloads the Discovery ISO of a declared host. */
+	DownloadDeclaredHostISO(ctx context.Context, params installer.DownloadDeclaredHostISOParams) middleware.Responder
+
 	/* DownloadHostIgnition Downloads the customized ignition file for this host */
 	DownloadHostIgnition(ctx context.Context, params installer.DownloadHostIgnitionParams) middleware.Responder
 
 	/* DownloadHostLogs Download host logs. */
 	DownloadHostLogs(ctx context.Context, params installer.DownloadHostLogsParams) middleware.Responder
 
+	/* DownloadImageSigningKey Downloads the PEM encoded public key that the signatures of the discovery images are verified with. */
+	DownloadImageSigningKey(ctx context.Context, params installer.DownloadImageSigningKeyParams) middleware.Responder
+
+	/* DownloadLogsArchiveEntry Downloads a single file, or a range of its lines, from a stored host or controller logs archive. */
+	DownloadLogsArchiveEntry(ctx context.Context, params installer.DownloadLogsArchiveEntryParams) middleware.Responder
+
 	/* EnableHost Enables a host for inclusion in the cluster. */
 	EnableHost(ctx context.Context, params installer.EnableHostParams) middleware.Responder
 
 	/* GenerateClusterISO Creates a new OpenShift per-cluster Discovery ISO. */
 	GenerateClusterISO(ctx context.Context, params installer.GenerateClusterISOParams) middleware.Responder
 
+	/* GenerateDeclaredHostISO Creates a Discovery ISO for a single declared host. The ISO only carries the network configuration and the hostname of the host, and binds the host that boots it to the declared host. */
+	GenerateDeclaredHostISO(ctx context.Context, params installer.GenerateDeclaredHostISOParams) middleware.Responder
+
+	/* GetArchivedCluster Retrieves a cluster that was archived after its installation, together with its hosts, monitored operators and events. */
+	GetArchivedCluster(ctx context.Context, params installer.GetArchivedClusterParams) middleware.Responder
+
 	/* GetCluster Retrieves the details of the OpenShift cluster. */
 	GetCluster(ctx context.Context, params installer.GetClusterParams) middleware.Responder
 
@@ -108,6 +144,9 @@ type InstallerAPI interface {
 	/* GetClusterInstallConfig Get the cluster's install config YAML. */
 	GetClusterInstallConfig(ctx context.Context, params installer.GetClusterInstallConfigParams) middleware.Responder
 
+	/* GetClusterLogsAnalysis Retrieves the known failure signatures that were found in the uploaded logs of the cluster and its hosts. */
+	GetClusterLogsAnalysis(ctx context.Context, params installer.GetClusterLogsAnalysisParams) middleware.Responder
+
 	/* GetCredentials Get the cluster admin credentials. */
 	GetCredentials(ctx context.Context, params installer.GetCredentialsParams) middleware.Responder
 
@@ -126,9 +165,18 @@ type InstallerAPI interface {
 	/* GetHostIgnition Get the customized ignition file for this host */
 	GetHostIgnition(ctx context.Context, params installer.GetHostIgnitionParams) middleware.Responder
 
+	/* GetHostIgnitionPreview Preview the ignition configs a host will boot with, before the installation starts.
+	   Renders the effective discovery ignition and the projected ignition of the host's role, along with the changes each layer applied. Secrets are redacted.
+	   
+	*/
+	GetHostIgnitionPreview(ctx context.Context, params installer.GetHostIgnitionPreviewParams) middleware.Responder
+
 	/* GetHostRequirements Get minimum host requirements. */
 	GetHostRequirements(ctx context.Context, params installer.GetHostRequirementsParams) middleware.Responder
 
+	/* GetLogsUpload Agent API to retrieve a logs upload with the byte ranges that were received, in order to resume it. */
+	GetLogsUpload(ctx context.Context, params installer.GetLogsUploadParams) middleware.Responder
+
 	/* GetNextSteps Retrieves the next operations that the host agent needs to perform. */
 	GetNextSteps(ctx context.Context, params installer.GetNextStepsParams) middleware.Responder
 
@@ -138,6 +186,9 @@ type InstallerAPI interface {
 	/* GetPresignedForClusterFiles Retrieves a pre-signed S3 URL for downloading cluster files. */
 	GetPresignedForClusterFiles(ctx context.Context, params installer.GetPresignedForClusterFilesParams) middleware.Responder
 
+	/* GetQuota Retrieves the usage and limits of an organization or a user. */
+	GetQuota(ctx context.Context, params installer.GetQuotaParams) middleware.Responder
+
 	/* InstallCluster Installs the OpenShift cluster. */
 	InstallCluster(ctx context.Context, params installer.InstallClusterParams) middleware.Responder
 
@@ -150,9 +201,18 @@ type InstallerAPI interface {
 	/* ListClusters Retrieves the list of OpenShift clusters. */
 	ListClusters(ctx context.Context, params installer.ListClustersParams) middleware.Responder
 
+	/* ListDeclaredHosts Retrieves the list of declared hosts of the cluster. */
+	ListDeclaredHosts(ctx context.Context, params installer.ListDeclaredHostsParams) middleware.Responder
+
 	/* ListHosts Retrieves the list of OpenShift hosts. */
 	ListHosts(ctx context.Context, params installer.ListHostsParams) middleware.Responder
 
+	/* ListLogsArchiveEntries Lists the files inside a stored host or controller logs archive. */
+	ListLogsArchiveEntries(ctx context.Context, params installer.ListLogsArchiveEntriesParams) middleware.Responder
+
+	/* ListQuotas Lists the usage and limits of the organizations and users that own clusters or have limits of their own. */
+	ListQuotas(ctx context.Context, params installer.ListQuotasParams) middleware.Responder
+
 	/* PostStepReply Posts the result of the operations from the host agent. */
 	PostStepReply(ctx context.Context, params installer.PostStepReplyParams) middleware.Responder
 
@@ -162,6 +222,9 @@ type InstallerAPI interface {
 	/* RegisterCluster Creates a new OpenShift cluster definition. */
 	RegisterCluster(ctx context.Context, params installer.RegisterClusterParams) middleware.Responder
 
+	/* RegisterDeclaredHost Declares a host before it boots, so that a discovery image can be generated for it alone. */
+	RegisterDeclaredHost(ctx context.Context, params installer.RegisterDeclaredHostParams) middleware.Responder
+
 	/* RegisterHost Registers a new OpenShift host. */
 	RegisterHost(ctx context.Context, params installer.RegisterHostParams) middleware.Responder
 
@@ -174,6 +237,12 @@ type InstallerAPI interface {
 	/* ResetHostValidation Reset failed host validation. */
 	ResetHostValidation(ctx context.Context, params installer.ResetHostValidationParams) middleware.Responder
 
+	/* RestoreArchivedCluster Restores an archived cluster with its hosts, monitored operators and events, and deletes the archive. */
+	RestoreArchivedCluster(ctx context.Context, params installer.RestoreArchivedClusterParams) middleware.Responder
+
+	/* SearchLogsArchive Searches the files of a stored host or controller logs archive for lines that match a regular expression. */
+	SearchLogsArchive(ctx context.Context, params installer.SearchLogsArchiveParams) middleware.Responder
+
 	/* UpdateCluster Updates an OpenShift cluster definition. */
 	UpdateCluster(ctx context.Context, params installer.UpdateClusterParams) middleware.Responder
 
@@ -198,6 +267,9 @@ type InstallerAPI interface {
 	/* UpdateHostLogsProgress Update log collection state and progress. */
 	UpdateHostLogsProgress(ctx context.Context, params installer.UpdateHostLogsProgressParams) middleware.Responder
 
+	/* UpdateQuotaLimits Sets the limits of an organization or a user. Limits that are not set fall back to the default limits of the service. */
+	UpdateQuotaLimits(ctx context.Context, params installer.UpdateQuotaLimitsParams) middleware.Responder
+
 	/* UploadClusterIngressCert Transfer the ingress certificate for the cluster. */
 	UploadClusterIngressCert(ctx context.Context, params installer.UploadClusterIngressCertParams) middleware.Responder
 
@@ -206,6 +278,9 @@ type InstallerAPI interface {
 
 	/* UploadLogs Agent API to upload logs. */
 	UploadLogs(ctx context.Context, params installer.UploadLogsParams) middleware.Responder
+
+	/* UploadLogsChunk Agent API to upload a chunk of a logs archive. Chunk N holds the bytes of the archive starting at N times the chunk size of the upload. A chunk that was already received is replaced. */
+	UploadLogsChunk(ctx context.Context, params installer.UploadLogsChunkParams) middleware.Responder
 }
 
 //go:generate mockery -name ManagedDomainsAPI -inpkg
@@ -351,6 +426,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 	}
 
 	api.APIAuthorizer = authorizer(c.Authorizer)
+	api.InstallerAbortLogsUploadHandler = installer.AbortLogsUploadHandlerFunc(func(params installer.AbortLogsUploadParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.AbortLogsUpload(ctx, params)
+	})
 	api.InstallerCancelInstallationHandler = installer.CancelInstallationHandlerFunc(func(params installer.CancelInstallationParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -361,6 +441,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.CompleteInstallation(ctx, params)
 	})
+	api.InstallerCompleteLogsUploadHandler = installer.CompleteLogsUploadHandlerFunc(func(params installer.CompleteLogsUploadParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.CompleteLogsUpload(ctx, params)
+	})
 	api.ManifestsCreateClusterManifestHandler = manifests.CreateClusterManifestHandlerFunc(func(params manifests.CreateClusterManifestParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -371,6 +456,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.AssistedServiceIsoAPI.CreateISOAndUploadToS3(ctx, params)
 	})
+	api.InstallerCreateLogsUploadHandler = installer.CreateLogsUploadHandlerFunc(func(params installer.CreateLogsUploadParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.CreateLogsUpload(ctx, params)
+	})
 	api.ManifestsDeleteClusterManifestHandler = manifests.DeleteClusterManifestHandlerFunc(func(params manifests.DeleteClusterManifestParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -381,6 +471,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.DeregisterCluster(ctx, params)
 	})
+	api.InstallerDeregisterDeclaredHostHandler = installer.DeregisterDeclaredHostHandlerFunc(func(params installer.DeregisterDeclaredHostParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.DeregisterDeclaredHost(ctx, params)
+	})
 	api.InstallerDeregisterHostHandler = installer.DeregisterHostHandlerFunc(func(params installer.DeregisterHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -406,6 +501,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.DownloadClusterISOHeaders(ctx, params)
 	})
+	api.InstallerDownloadClusterISOSignatureHandler = installer.DownloadClusterISOSignatureHandlerFunc(func(params installer.DownloadClusterISOSignatureParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.DownloadClusterISOSignature(ctx, params)
+	})
 	api.InstallerDownloadClusterKubeconfigHandler = installer.DownloadClusterKubeconfigHandlerFunc(func(params installer.DownloadClusterKubeconfigParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -421,6 +521,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.ManifestsAPI.DownloadClusterManifest(ctx, params)
 	})
+	api.InstallerDownloadClusterPxeArtifactHandler = installer.DownloadClusterPxeArtifactHandlerFunc(func(params installer.DownloadClusterPxeArtifactParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.DownloadClusterPxeArtifact(ctx, params)
+	})
+	api.InstallerDownloadClusterSupportBundleHandler = installer.DownloadClusterSupportBundleHandlerFunc(func(params installer.DownloadClusterSupportBundleParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.DownloadClusterSupportBundle(ctx, params)
+	})
+	api.InstallerDownloadDeclaredHostISOHandler = installer.DownloadDeclaredHostISOHandlerFunc(func(params installer.DownloadDeclaredHostISOParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.DownloadDeclaredHostISO(ctx, params)
+	})
 	api.InstallerDownloadHostIgnitionHandler = installer.DownloadHostIgnitionHandlerFunc(func(params installer.DownloadHostIgnitionParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -436,6 +551,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.AssistedServiceIsoAPI.DownloadISO(ctx, params)
 	})
+	api.InstallerDownloadImageSigningKeyHandler = installer.DownloadImageSigningKeyHandlerFunc(func(params installer.DownloadImageSigningKeyParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.DownloadImageSigningKey(ctx, params)
+	})
+	api.InstallerDownloadLogsArchiveEntryHandler = installer.DownloadLogsArchiveEntryHandlerFunc(func(params installer.DownloadLogsArchiveEntryParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.DownloadLogsArchiveEntry(ctx, params)
+	})
 	api.InstallerEnableHostHandler = installer.EnableHostHandlerFunc(func(params installer.EnableHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -446,6 +571,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GenerateClusterISO(ctx, params)
 	})
+	api.InstallerGenerateDeclaredHostISOHandler = installer.GenerateDeclaredHostISOHandlerFunc(func(params installer.GenerateDeclaredHostISOParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GenerateDeclaredHostISO(ctx, params)
+	})
+	api.InstallerGetArchivedClusterHandler = installer.GetArchivedClusterHandlerFunc(func(params installer.GetArchivedClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetArchivedCluster(ctx, params)
+	})
 	api.InstallerGetClusterHandler = installer.GetClusterHandlerFunc(func(params installer.GetClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -466,6 +601,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetClusterInstallConfig(ctx, params)
 	})
+	api.InstallerGetClusterLogsAnalysisHandler = installer.GetClusterLogsAnalysisHandlerFunc(func(params installer.GetClusterLogsAnalysisParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetClusterLogsAnalysis(ctx, params)
+	})
 	api.InstallerGetCredentialsHandler = installer.GetCredentialsHandlerFunc(func(params installer.GetCredentialsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -491,11 +631,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetHostIgnition(ctx, params)
 	})
+	api.InstallerGetHostIgnitionPreviewHandler = installer.GetHostIgnitionPreviewHandlerFunc(func(params installer.GetHostIgnitionPreviewParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetHostIgnitionPreview(ctx, params)
+	})
 	api.InstallerGetHostRequirementsHandler = installer.GetHostRequirementsHandlerFunc(func(params installer.GetHostRequirementsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetHostRequirements(ctx, params)
 	})
+	api.InstallerGetLogsUploadHandler = installer.GetLogsUploadHandlerFunc(func(params installer.GetLogsUploadParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetLogsUpload(ctx, params)
+	})
 	api.InstallerGetNextStepsHandler = installer.GetNextStepsHandlerFunc(func(params installer.GetNextStepsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -516,6 +666,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetPresignedForClusterFiles(ctx, params)
 	})
+	api.InstallerGetQuotaHandler = installer.GetQuotaHandlerFunc(func(params installer.GetQuotaParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetQuota(ctx, params)
+	})
 	api.InstallerInstallClusterHandler = installer.InstallClusterHandlerFunc(func(params installer.InstallClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -546,6 +701,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.VersionsAPI.ListComponentVersions(ctx, params)
 	})
+	api.InstallerListDeclaredHostsHandler = installer.ListDeclaredHostsHandlerFunc(func(params installer.ListDeclaredHostsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListDeclaredHosts(ctx, params)
+	})
 	api.EventsListEventsHandler = events.ListEventsHandlerFunc(func(params events.ListEventsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -556,6 +716,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.ListHosts(ctx, params)
 	})
+	api.InstallerListLogsArchiveEntriesHandler = installer.ListLogsArchiveEntriesHandlerFunc(func(params installer.ListLogsArchiveEntriesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListLogsArchiveEntries(ctx, params)
+	})
 	api.ManagedDomainsListManagedDomainsHandler = managed_domains.ListManagedDomainsHandlerFunc(func(params managed_domains.ListManagedDomainsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -571,6 +736,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.OperatorsAPI.ListOperatorProperties(ctx, params)
 	})
+	api.InstallerListQuotasHandler = installer.ListQuotasHandlerFunc(func(params installer.ListQuotasParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListQuotas(ctx, params)
+	})
 	api.VersionsListSupportedOpenshiftVersionsHandler = versions.ListSupportedOpenshiftVersionsHandlerFunc(func(params versions.ListSupportedOpenshiftVersionsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -596,6 +766,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.RegisterCluster(ctx, params)
 	})
+	api.InstallerRegisterDeclaredHostHandler = installer.RegisterDeclaredHostHandlerFunc(func(params installer.RegisterDeclaredHostParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.RegisterDeclaredHost(ctx, params)
+	})
 	api.InstallerRegisterHostHandler = installer.RegisterHostHandlerFunc(func(params installer.RegisterHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -621,6 +796,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.ResetHostValidation(ctx, params)
 	})
+	api.InstallerRestoreArchivedClusterHandler = installer.RestoreArchivedClusterHandlerFunc(func(params installer.RestoreArchivedClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.RestoreArchivedCluster(ctx, params)
+	})
+	api.InstallerSearchLogsArchiveHandler = installer.SearchLogsArchiveHandlerFunc(func(params installer.SearchLogsArchiveParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.SearchLogsArchive(ctx, params)
+	})
 	api.InstallerUpdateClusterHandler = installer.UpdateClusterHandlerFunc(func(params installer.UpdateClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -661,6 +846,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.UpdateHostLogsProgress(ctx, params)
 	})
+	api.InstallerUpdateQuotaLimitsHandler = installer.UpdateQuotaLimitsHandlerFunc(func(params installer.UpdateQuotaLimitsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.UpdateQuotaLimits(ctx, params)
+	})
 	api.InstallerUploadClusterIngressCertHandler = installer.UploadClusterIngressCertHandlerFunc(func(params installer.UploadClusterIngressCertParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -676,6 +866,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.UploadLogs(ctx, params)
 	})
+	api.InstallerUploadLogsChunkHandler = installer.UploadLogsChunkHandlerFunc(func(params installer.UploadLogsChunkParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.UploadLogsChunk(ctx, params)
+	})
 	api.ServerShutdown = func() {}
 	return api.Serve(c.InnerMiddleware), api, nil
 }
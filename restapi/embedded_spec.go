@@ -93,6 +93,130 @@ func init() {
         }
       }
     },
+    "/archived-clusters/{cluster_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves a cluster that was archived after its installation, together with its hosts, monitored operators and events.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetArchivedCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The archived cluster to be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/archived-cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/archived-clusters/{cluster_id}/actions/restore": {
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Restores an archived cluster with its hosts, monitored operators and events, and deletes the archive.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RestoreArchivedCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The archived cluster to be restored.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/assisted-service-iso": {
       "post": {
         "description": "Creates ISO for the user and uploads to S3.",
@@ -1074,7 +1198,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/discovery-ignition": {
+    "/clusters/{cluster_id}/declared-hosts": {
       "get": {
         "security": [
           {
@@ -1085,16 +1209,16 @@ func init() {
             ]
           }
         ],
-        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
+        "description": "Retrieves the list of declared hosts of the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetDiscoveryIgnition",
+        "operationId": "ListDeclaredHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be retrieved.",
+            "description": "The cluster whose declared hosts should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -1104,7 +1228,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
+              "$ref": "#/definitions/declared-host-list"
             }
           },
           "401": {
@@ -1139,34 +1263,37 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Override values in the discovery ignition config.",
+      "post": {
+        "description": "Declares a host before it boots, so that a discovery image can be generated for it alone.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateDiscoveryIgnition",
+        "operationId": "RegisterDeclaredHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be updated.",
+            "description": "The cluster that the host is declared in.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Overrides for the discovery ignition config.",
-            "name": "discovery-ignition-params",
+            "description": "The description of the declared host.",
+            "name": "new-declared-host-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
+              "$ref": "#/definitions/declared-host-create-params"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/declared_host"
+            }
           },
           "400": {
             "description": "Error.",
@@ -1207,72 +1334,34 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads files relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/clusters/{cluster_id}/declared-hosts/{declared_host_id}": {
+      "delete": {
+        "description": "Deletes a declared host and its discovery image.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterFiles",
+        "operationId": "DeregisterDeclaredHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster that the host is declared in.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "discovery.ign",
-              "custom_manifests.yaml"
-            ],
             "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The declared host that should be deleted.",
+            "name": "declared_host_id",
+            "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -1298,28 +1387,16 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files-presigned": {
+    "/clusters/{cluster_id}/declared-hosts/{declared_host_id}/downloads/image": {
       "get": {
         "security": [
           {
@@ -1328,81 +1405,47 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+        "description": "Downloads the Discovery ISO of a declared host.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "GetPresignedForClusterFiles",
+        "operationId": "DownloadDeclaredHostISO",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster that the host is declared in.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
+            "description": "The declared host whose ISO should be downloaded.",
+            "name": "declared_host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned"
+              "type": "string",
+              "format": "binary"
             }
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
               "$ref": "#/definitions/infra_error"
             }
@@ -1425,12 +1468,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1438,46 +1475,36 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/downloads/image": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "post": {
+        "description": "Creates a Discovery ISO for a single declared host. The ISO only carries the network configuration and the hostname of the host, and binds the host that boots it to the declared host.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterISO",
+        "operationId": "GenerateDeclaredHostISO",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be downloaded.",
+            "description": "The cluster that the host is declared in.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The declared host whose ISO should be generated.",
+            "name": "declared_host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/declared_host"
             }
           },
           "400": {
@@ -1505,10 +1532,7 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed."
-          },
-          "409": {
-            "description": "Error.",
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -1520,43 +1544,39 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
+      }
+    },
+    "/clusters/{cluster_id}/discovery-ignition": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
         "tags": [
           "installer"
         ],
-        "operationId": "GenerateClusterISO",
+        "operationId": "GetDiscoveryIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be generated.",
+            "description": "The cluster for which the discovery ignition config should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The parameters for the generated ISO.",
-            "name": "image-create-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/image-create-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/discovery-ignition-params"
             }
           },
           "401": {
@@ -1583,12 +1603,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1597,43 +1611,34 @@ func init() {
           }
         }
       },
-      "head": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
+      "patch": {
+        "description": "Override values in the discovery ignition config.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterISOHeaders",
+        "operationId": "UpdateDiscoveryIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO headers should be downloaded.",
+            "description": "The cluster for which the discovery ignition config should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Overrides for the discovery ignition config.",
+            "name": "discovery-ignition-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/discovery-ignition-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "headers": {
-              "Content-Length": {
-                "type": "integer",
-                "description": "Size of the ISO in bytes"
-              }
-            }
+          "201": {
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -1660,10 +1665,7 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed."
-          },
-          "409": {
-            "description": "Error.",
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -1677,7 +1679,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/kubeconfig": {
+    "/clusters/{cluster_id}/downloads/files": {
       "get": {
         "security": [
           {
@@ -1687,34 +1689,61 @@ func init() {
               "user"
             ]
           },
+          {
+            "agentAuth": []
+          },
           {
             "urlAuth": []
           }
         ],
-        "description": "Downloads the kubeconfig file for this cluster.",
+        "description": "Downloads files relating to the installed/installing cluster.",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterKubeconfig",
+        "operationId": "DownloadClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose kubeconfig should be downloaded.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "discovery.ign",
+              "custom_manifests.yaml"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "type": "file"
             }
           },
           "401": {
@@ -1752,11 +1781,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/events": {
+    "/clusters/{cluster_id}/downloads/files-presigned": {
       "get": {
         "security": [
           {
@@ -1765,39 +1800,63 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Lists events for a cluster.",
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "ListEvents",
+        "operationId": "GetPresignedForClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "A host in the specified cluster to return events for.",
+            "description": "If downloading a file related to a host, the relevant host.",
             "name": "host_id",
             "in": "query"
           },
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
             "in": "query"
           }
         ],
@@ -1805,7 +1864,13 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/event-list"
+              "$ref": "#/definitions/presigned"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -1832,6 +1897,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1841,7 +1912,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/free_addresses": {
+    "/clusters/{cluster_id}/downloads/image": {
       "get": {
         "security": [
           {
@@ -1850,51 +1921,41 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          }
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
+        "produces": [
+          "application/octet-stream"
         ],
-        "description": "Retrieves the free address list for a network.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetFreeAddresses",
+        "operationId": "DownloadClusterISO",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return free addresses for.",
+            "description": "The cluster whose ISO should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
-            "type": "string",
-            "description": "The cluster network to return free addresses for.",
-            "name": "network",
-            "in": "query",
-            "required": true
-          },
-          {
-            "maximum": 8000,
-            "minimum": 1,
-            "type": "integer",
-            "default": 8000,
-            "description": "The maximum number of free addresses to return.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "A prefix for the free addresses to return.",
-            "name": "prefix",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/free-addresses-list"
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -1916,7 +1977,10 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed.",
+            "description": "Method Not Allowed."
+          },
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -1928,39 +1992,43 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/host-requirements": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get host requirements of a cluster.",
+      },
+      "post": {
+        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterHostRequirements",
+        "operationId": "GenerateClusterISO",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster whose ISO should be generated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The parameters for the generated ISO.",
+            "name": "image-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/image-create-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-host-requirements-list"
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -1987,6 +2055,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1994,10 +2068,8 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts": {
-      "get": {
+      },
+      "head": {
         "security": [
           {
             "userAuth": [
@@ -2007,35 +2079,38 @@ func init() {
             ]
           },
           {
-            "agentAuth": []
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift hosts.",
+        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
         "tags": [
           "installer"
         ],
-        "operationId": "ListHosts",
+        "operationId": "DownloadClusterISOHeaders",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose hosts should be listed.",
+            "description": "The cluster whose ISO headers should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is listing hosts.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
+            "headers": {
+              "Content-Length": {
+                "type": "integer",
+                "description": "Size of the ISO in bytes"
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -2050,73 +2125,67 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "405": {
+            "description": "Method Not Allowed."
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/clusters/{cluster_id}/downloads/image-signature": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Registers a new OpenShift host.",
+        "description": "Downloads the detached signature of the SHA-256 digest of the OpenShift per-cluster Discovery image, signed with the image signing key of the service. The checksum of streamed images is computed when their signature is first downloaded.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterHost",
+        "operationId": "DownloadClusterISOSignature",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster into which the host should be registered.",
+            "description": "The cluster whose image signature should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The description of the host being registered.",
-            "name": "new-host-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-create-params"
-            }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is registering the host.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host_registration_response"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -2143,28 +2212,16 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Cluster cannot accept new hosts due to its current state.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}": {
+    "/clusters/{cluster_id}/downloads/kubeconfig": {
       "get": {
         "security": [
           {
@@ -2173,36 +2230,35 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift host.",
+        "description": "Downloads the kubeconfig file for this cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "GetHost",
+        "operationId": "DownloadClusterKubeconfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be retrieved.",
+            "description": "The cluster whose kubeconfig should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that should be retrieved.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "string",
+              "format": "binary"
             }
           },
           "401": {
@@ -2229,6 +2285,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2236,34 +2298,59 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deregisters an OpenShift host.",
+      }
+    },
+    "/clusters/{cluster_id}/downloads/pxe-artifacts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads the OpenShift per-cluster network boot artifacts and the iPXE script that boots them.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterHost",
+        "operationId": "DownloadClusterPxeArtifact",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be deregistered.",
+            "description": "The cluster whose network boot artifact should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "ipxe-script",
+              "vmlinuz",
+              "initrd.img",
+              "rootfs.img"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host that should be deregistered.",
-            "name": "host_id",
-            "in": "path",
+            "description": "The network boot artifact to be downloaded.",
+            "name": "file_name",
+            "in": "query",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
           },
           "400": {
             "description": "Error.",
@@ -2304,18 +2391,30 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
-      "post": {
-        "description": "Enables a host for inclusion in the cluster.",
+    "/clusters/{cluster_id}/events": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Lists events for a cluster.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "EnableHost",
+        "operationId": "ListEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being enabled.",
+            "description": "The cluster to return events for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2323,17 +2422,25 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being enabled.",
+            "description": "A host in the specified cluster to return events for.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/event-list"
             }
           },
           "401": {
@@ -2360,12 +2467,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2373,36 +2474,62 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Disables a host for inclusion in the cluster.",
+      }
+    },
+    "/clusters/{cluster_id}/free_addresses": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the free address list for a network.",
         "tags": [
           "installer"
         ],
-        "operationId": "DisableHost",
+        "operationId": "GetFreeAddresses",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being disabled.",
+            "description": "The cluster to return free addresses for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
             "type": "string",
-            "format": "uuid",
-            "description": "The host that is being disabled.",
-            "name": "host_id",
-            "in": "path",
+            "description": "The cluster network to return free addresses for.",
+            "name": "network",
+            "in": "query",
             "required": true
+          },
+          {
+            "maximum": 8000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 8000,
+            "description": "The maximum number of free addresses to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A prefix for the free addresses to return.",
+            "name": "prefix",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/free-addresses-list"
             }
           },
           "401": {
@@ -2429,12 +2556,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2444,36 +2565,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
-      "post": {
-        "description": "install specific host for day2 cluster.",
+    "/clusters/{cluster_id}/host-requirements": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get host requirements of a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallHost",
+        "operationId": "GetClusterHostRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being installed.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being installed.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/cluster-host-requirements-list"
             }
           },
           "401": {
@@ -2494,8 +2616,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2509,36 +2631,46 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
-      "post": {
-        "description": "reset a failed host for day2 cluster.",
+    "/clusters/{cluster_id}/hosts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the list of OpenShift hosts.",
         "tags": [
           "installer"
         ],
-        "operationId": "ResetHost",
+        "operationId": "ListHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being reset.",
+            "description": "The cluster whose hosts should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host that is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The software version of the discovery agent that is listing hosts.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -2553,69 +2685,71 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
+      },
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Registers a new OpenShift host.",
         "tags": [
           "installer"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "ResetHostValidation",
+        "operationId": "RegisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that its validation is being reset.",
+            "description": "The cluster into which the host should be registered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that its validation is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The description of the host being registered.",
+            "name": "new-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-create-params"
+            }
           },
           {
             "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
-            "required": true
+            "description": "The software version of the discovery agent that is registering the host.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/host_registration_response"
             }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2638,8 +2772,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
-            "description": "Error.",
+            "description": "Cluster cannot accept new hosts due to its current state.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2649,11 +2789,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
+    "/clusters/{cluster_id}/hosts/{host_id}": {
       "get": {
         "security": [
           {
@@ -2662,24 +2808,18 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this host",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Retrieves the details of the OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadHostIgnition",
+        "operationId": "GetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be downloaded.",
+            "description": "The cluster of the host that should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2687,7 +2827,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
+            "description": "The host that should be retrieved.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -2697,7 +2837,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -2724,20 +2864,74 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deregisters an OpenShift host.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DeregisterHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster of the host that should be deregistered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
-          "500": {
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be deregistered.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2745,18 +2939,18 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/ignition": {
-      "get": {
-        "description": "Get the customized ignition file for this host",
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
+      "post": {
+        "description": "Enables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetHostIgnition",
+        "operationId": "EnableHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose customized ignition file should be retrieved.",
+            "description": "The cluster of the host that is being enabled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2764,7 +2958,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose customized ignition file should be retrieved.",
+            "description": "The host that is being enabled.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -2774,7 +2968,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -2815,17 +3009,17 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Patch the ignition file for this host",
+      "delete": {
+        "description": "Disables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostIgnition",
+        "operationId": "DisableHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be updated.",
+            "description": "The cluster of the host that is being disabled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2833,29 +3027,17 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
+            "description": "The host that is being disabled.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-ignition-params"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -2882,6 +3064,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2891,18 +3079,18 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
+      "post": {
+        "description": "install specific host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostInstallerArgs",
+        "operationId": "InstallHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose installer arguments should be updated.",
+            "description": "The cluster of the host that is being installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2910,34 +3098,19 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
+            "description": "The host that is being installed.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/installer-args-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "202": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/host"
             }
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -2956,12 +3129,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -2977,23 +3144,18 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
-      "get": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Retrieves the next operations that the host agent needs to perform.",
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
+      "post": {
+        "description": "reset a failed host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetNextSteps",
+        "operationId": "ResetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is retrieving instructions.",
+            "description": "The cluster of the host that is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3001,23 +3163,17 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is retrieving instructions.",
+            "description": "The host that is being reset.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving instructions.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -3038,8 +3194,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3049,37 +3205,23 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Posts the result of the operations from the host agent.",
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
+      "patch": {
+        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
         "tags": [
           "installer"
         ],
-        "operationId": "PostStepReply",
+        "summary": "Reset failed host validation.",
+        "operationId": "ResetHostValidation",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is posting results.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is posting results.",
+            "description": "The cluster of the host that its validation is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3087,26 +3229,28 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is posting results.",
+            "description": "The host that its validation is being reset.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The results to be posted.",
-            "name": "reply",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/step-reply"
-            }
+            "type": "string",
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3129,8 +3273,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3140,17 +3284,11 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
+    "/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
       "get": {
         "security": [
           {
@@ -3161,23 +3299,22 @@ func init() {
             ]
           },
           {
-            "urlAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Download host logs.",
+        "description": "Downloads the customized ignition file for this host",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadHostLogs",
-        "deprecated": true,
+        "operationId": "DownloadHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose logs should be downloaded.",
+            "description": "The cluster of the host whose ignition file should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3185,7 +3322,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose logs should be downloaded.",
+            "description": "The host whose ignition file should be downloaded.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -3233,29 +3370,28 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        }
-      },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        }
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/ignition": {
+      "get": {
+        "description": "Get the customized ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "UploadHostLogs",
-        "deprecated": true,
+        "operationId": "GetHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose logs should be uploaded.",
+            "description": "The cluster of the host whose customized ignition file should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3263,28 +3399,18 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
+            "description": "The host whose customized ignition file should be retrieved.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the logs.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3304,38 +3430,37 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update log collection state and progress.",
+      },
+      "patch": {
+        "description": "Patch the ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostLogsProgress",
+        "operationId": "UpdateHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster of the host whose ignition file should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3343,24 +3468,30 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose log progress is being updated.",
+            "description": "The host whose ignition file should be updated.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "$ref": "#/definitions/host-ignition-params"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3386,50 +3517,27 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update installation progress.",
+    "/clusters/{cluster_id}/hosts/{host_id}/ignition-preview": {
+      "get": {
+        "description": "Preview the ignition configs a host will boot with, before the installation starts.\nRenders the effective discovery ignition and the projected ignition of the host's role, along with the changes each layer applied. Secrets are redacted.\n",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostInstallProgress",
+        "operationId": "GetHostIgnitionPreview",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the cluster to retrieve.",
+            "description": "The cluster of the host whose ignition should be previewed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3437,24 +3545,18 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the host to retrieve.",
+            "description": "The host whose ignition should be previewed.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "New progress value.",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/ignition-preview"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3480,14 +3582,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3495,37 +3597,51 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/install-config": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster's install config YAML.",
+    "/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterInstallConfig",
+        "operationId": "UpdateHostInstallerArgs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
+            "description": "The cluster of the host whose installer arguments should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose installer arguments should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installer-args-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -3552,6 +3668,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -3559,40 +3681,49 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Override values in the install config.",
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
+      "get": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the next operations that the host agent needs to perform.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateClusterInstallConfig",
+        "operationId": "GetNextSteps",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
+            "description": "The cluster of the host that is retrieving instructions.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "string"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is retrieving instructions.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving instructions.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/steps"
             }
           },
           "401": {
@@ -3624,16 +3755,113 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/logs": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "admin",
+            "agentAuth": []
+          }
+        ],
+        "description": "Posts the result of the operations from the host agent.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "PostStepReply",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is posting results.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster of the host that is posting results.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is posting results.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The results to be posted.",
+            "name": "reply",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/step-reply"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
               "read-only-admin",
               "user"
             ]
@@ -3642,40 +3870,31 @@ func init() {
             "urlAuth": []
           }
         ],
-        "description": "Download cluster logs.",
+        "description": "Download host logs.",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterLogs",
+        "operationId": "DownloadHostLogs",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
+            "description": "The cluster of the host whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "description": "The host whose logs should be downloaded.",
             "name": "host_id",
-            "in": "query"
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
@@ -3736,41 +3955,37 @@ func init() {
         "tags": [
           "installer"
         ],
-        "operationId": "UploadLogs",
+        "operationId": "UploadHostLogs",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster of the host whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "maxLength": 104857600,
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
             "type": "file",
             "x-mimetype": "application/zip",
             "description": "The log file to be uploaded.",
             "name": "upfile",
             "in": "formData"
           },
-          {
-            "enum": [
-              "host",
-              "controller"
-            ],
-            "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
-            "in": "query",
-            "required": true
-          },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "query"
+            "description": "The software version of the discovery agent that is uploading the logs.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
@@ -3810,7 +4025,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/logs_progress": {
+    "/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
       "put": {
         "security": [
           {
@@ -3821,7 +4036,7 @@ func init() {
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateClusterLogsProgress",
+        "operationId": "UpdateHostLogsProgress",
         "parameters": [
           {
             "type": "string",
@@ -3831,6 +4046,14 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose log progress is being updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
           {
             "description": "Parameters for updating log progress.",
             "name": "logs-progress-params",
@@ -3890,34 +4113,54 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/manifests": {
-      "get": {
+    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
+      "put": {
         "security": [
           {
-            "userAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Lists manifests for customizing cluster installation.",
+        "description": "Update installation progress.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "ListClusterManifests",
+        "operationId": "UpdateHostInstallProgress",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
+            "description": "The ID of the cluster to retrieve.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The ID of the host to retrieve.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "New progress value.",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-manifests"
-            }
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -3943,61 +4186,52 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/clusters/{cluster_id}/install-config": {
+      "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Creates a manifest for customizing cluster installation.",
+        "description": "Get the cluster's install config YAML.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "CreateClusterManifest",
+        "operationId": "GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The cluster whose install config is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/create-manifest-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "string"
             }
           },
           "401": {
@@ -4024,12 +4258,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4038,44 +4266,41 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
+      "patch": {
+        "description": "Override values in the install config.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "DeleteClusterManifest",
+        "operationId": "UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
+            "description": "The cluster whose install config is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "Install config overrides.",
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success."
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -4100,12 +4325,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4115,47 +4334,54 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/manifests/files": {
+    "/clusters/{cluster_id}/logs": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Downloads cluster manifest.",
+        "description": "Download cluster logs.",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "DownloadClusterManifest",
+        "operationId": "DownloadClusterLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "manifests",
-              "openshift"
+              "host",
+              "controller",
+              "all"
             ],
             "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "format": "uuid",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
@@ -4202,43 +4428,60 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/monitored_operators": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Lists operators to be monitored for a cluster.",
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "ListOfClusterOperators",
+        "operationId": "UploadLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "maxLength": 104857600,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
             "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
             "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -4258,59 +4501,70 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/clusters/{cluster_id}/logs/analysis": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Controller API to report of monitored operators.",
+        "description": "Retrieves the known failure signatures that were found in the uploaded logs of the cluster and its hosts.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "ReportMonitoredOperatorStatus",
+        "operationId": "GetClusterLogsAnalysis",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
+            "description": "The cluster whose logs analysis should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The operators monitor report.",
-            "name": "report-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
-            }
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "The type of logs whose findings should be retrieved.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific host in the cluster whose findings should be retrieved.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Bad Request",
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/log-finding-list"
             }
           },
           "401": {
@@ -4337,28 +4591,16 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/preflight-requirements": {
+    "/clusters/{cluster_id}/logs/entries": {
       "get": {
         "security": [
           {
@@ -4369,26 +4611,56 @@ func init() {
             ]
           }
         ],
-        "description": "Get preflight requirements for a cluster.",
+        "description": "Lists the files inside a stored host or controller logs archive.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetPreflightRequirements",
+        "operationId": "ListLogsArchiveEntries",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return preflight requrements for.",
+            "description": "The cluster whose logs should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of the logs archive.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs archive should be listed, required for host logs.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
+            "headers": {
+              "X-Logs-Archive-Truncated": {
+                "type": "boolean",
+                "description": "Whether the archive was only listed up to the size limit of the service."
+              }
+            },
             "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
+              "$ref": "#/definitions/logs-archive-entry-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -4415,6 +4687,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4424,46 +4702,83 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
+    "/clusters/{cluster_id}/logs/entries/download": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Downloads a single file, or a range of its lines, from a stored host or controller logs archive.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "UploadClusterIngressCert",
+        "operationId": "DownloadLogsArchiveEntry",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
-            }
-          },
-          {
+            "enum": [
+              "host",
+              "controller"
+            ],
             "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "The type of the logs archive.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs archive should be read, required for host logs.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The name of the file inside the logs archive, as listed by ListLogsArchiveEntries.",
+            "name": "name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "The first line to download, starting from 1.",
+            "name": "start_line",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "The last line to download. Lines are downloaded until the end of the file if not set.",
+            "name": "end_line",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
           },
           "400": {
             "description": "Error.",
@@ -4495,14 +4810,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4510,7 +4825,7 @@ func init() {
         }
       }
     },
-    "/component_versions": {
+    "/clusters/{cluster_id}/logs/search": {
       "get": {
         "security": [
           {
@@ -4521,84 +4836,67 @@ func init() {
             ]
           }
         ],
-        "description": "List of component versions.",
+        "description": "Searches the files of a stored host or controller logs archive for lines that match a regular expression.",
         "tags": [
-          "versions"
+          "installer"
         ],
-        "operationId": "ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
-          }
-        }
-      }
-    },
-    "/domains": {
-      "get": {
-        "security": [
+        "operationId": "SearchLogsArchive",
+        "parameters": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose logs should be searched.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of the logs archive.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs archive should be searched, required for host logs.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The regular expression to search for.",
+            "name": "pattern",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximal number of matching lines to return.",
+            "name": "max_results",
+            "in": "query"
           }
         ],
-        "description": "List of managed DNS domains.",
-        "tags": [
-          "managed_domains"
-        ],
-        "operationId": "ListManagedDomains",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-managed-domains"
+              "$ref": "#/definitions/logs_search_result"
             }
           },
-          "500": {
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/host_requirements": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get minimum host requirements.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "GetHostRequirements",
-        "parameters": [
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Get hw requirements for single node.",
-            "name": "single_node",
-            "in": "header"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host-requirements"
-            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -4612,12 +4910,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4627,53 +4937,48 @@ func init() {
         }
       }
     },
-    "/openshift_versions": {
-      "get": {
+    "/clusters/{cluster_id}/logs/uploads": {
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift supported versions.",
+        "description": "Agent API to start a resumable upload of a logs archive, which is then uploaded in chunks.",
         "tags": [
-          "versions"
+          "installer"
         ],
-        "operationId": "ListSupportedOpenshiftVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/openshift-versions"
-            }
+        "operationId": "CreateLogsUpload",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose logs are uploaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
-          "503": {
-            "description": "Unavailable.",
+          {
+            "description": "The logs archive to upload.",
+            "name": "new-logs-upload-params",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/new-logs-upload-params"
             }
           }
-        }
-      }
-    },
-    "/supported-operators": {
-      "get": {
-        "description": "Retrieves the list of supported operators.",
-        "tags": [
-          "operators"
         ],
-        "operationId": "ListSupportedOperators",
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/logs_upload"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -4688,36 +4993,53 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/supported-operators/{operator_name}": {
+    "/clusters/{cluster_id}/logs/uploads/{upload_id}": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Lists properties for an operator.",
+        "description": "Agent API to retrieve a logs upload with the byte ranges that were received, in order to resume it.",
         "tags": [
-          "operators"
+          "installer"
         ],
-        "operationId": "ListOperatorProperties",
+        "operationId": "GetLogsUpload",
         "parameters": [
           {
             "type": "string",
-            "description": "The operator name.",
-            "name": "operator_name",
+            "format": "uuid",
+            "description": "The cluster whose logs are uploaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The logs upload.",
+            "name": "upload_id",
             "in": "path",
             "required": true
           }
@@ -4726,7 +5048,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/operator-properties"
+              "$ref": "#/definitions/logs_upload"
             }
           },
           "401": {
@@ -4752,1398 +5074,4126 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    }
-  },
-  "definitions": {
-    "add-hosts-cluster-create-params": {
-      "type": "object",
-      "required": [
-        "id",
-        "name",
-        "api_vip_dnsname",
-        "openshift_version"
-      ],
-      "properties": {
-        "api_vip_dnsname": {
-          "description": "api vip domain.",
-          "type": "string"
-        },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
+      },
+      "delete": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to abort a logs upload and delete the chunks that were received.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "AbortLogsUpload",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose logs are uploaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The logs upload.",
+            "name": "upload_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "api_vip_connectivity_request": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "url": {
-          "description": "URL address of the API.",
-          "type": "string"
-        },
-        "verify_cidr": {
-          "description": "Whether to verify if the API VIP belongs to one of the interfaces.",
-          "type": "boolean"
+    "/clusters/{cluster_id}/logs/uploads/{upload_id}/actions/complete": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to complete a logs upload once all of its chunks were received. The chunks are assembled into the logs archive, which is then handled like logs that were uploaded at once.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "CompleteLogsUpload",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose logs are uploaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The logs upload.",
+            "name": "upload_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "api_vip_connectivity_response": {
-      "type": "object",
-      "properties": {
-        "is_success": {
-          "description": "API VIP connectivity check result.",
-          "type": "boolean"
+    "/clusters/{cluster_id}/logs/uploads/{upload_id}/chunks/{chunk_number}": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to upload a chunk of a logs archive. Chunk N holds the bytes of the archive starting at N times the chunk size of the upload. A chunk that was already received is replaced.",
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UploadLogsChunk",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose logs are uploaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The logs upload.",
+            "name": "upload_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The number of the chunk, starting from 0.",
+            "name": "chunk_number",
+            "in": "path",
+            "required": true
+          },
+          {
+            "pattern": "^[0-9a-fA-F]{64}$",
+            "type": "string",
+            "description": "The hex encoded SHA-256 digest of the chunk.",
+            "name": "sha256",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maxLength": 104857600,
+            "type": "file",
+            "description": "The content of the chunk.",
+            "name": "upfile",
+            "in": "formData",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "assisted-service-iso-create-params": {
-      "type": "object",
-      "properties": {
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+    "/clusters/{cluster_id}/logs_progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateClusterLogsProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose log progress is being updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Update cluster install progress."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/manifests": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Lists manifests for customizing cluster installation.",
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "ListClusterManifests",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which the manifests should be listed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-manifests"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Creates a manifest for customizing cluster installation.",
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "CreateClusterManifest",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which a new manifest should be created.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "DeleteClusterManifest",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose manifest should be deleted.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to delete from the cluster.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/manifests/files": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Downloads cluster manifest.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "DownloadClusterManifest",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose manifest should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/monitored_operators": {
+      "get": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Lists operators to be monitored for a cluster.",
+        "tags": [
+          "operators",
+          "installer"
+        ],
+        "operationId": "ListOfClusterOperators",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return operators for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/monitored-operators-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Controller API to report of monitored operators.",
+        "tags": [
+          "operators",
+          "installer"
+        ],
+        "operationId": "ReportMonitoredOperatorStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose operators are being monitored.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The operators monitor report.",
+            "name": "report-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/operator-monitor-report"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/preflight-requirements": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get preflight requirements for a cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetPreflightRequirements",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return preflight requrements for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/preflight-hardware-requirements"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/support-bundle": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Downloads an archive of the cluster, its hosts, events, validations history, install config, manifests, operators and logs to be attached to a support case. Pull secrets, SSH keys and passwords are redacted from the records of the archive, while the log archives are included as is and flagged as not redacted.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DownloadClusterSupportBundle",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose support bundle should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Transfer the ingress certificate for the cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UploadClusterIngressCert",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to associate with the ingress certificate.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ingress-cert-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/component_versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of component versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "ListComponentVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/domains": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of managed DNS domains.",
+        "tags": [
+          "managed_domains"
+        ],
+        "operationId": "ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-managed-domains"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/host_requirements": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get minimum host requirements.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetHostRequirements",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Get hw requirements for single node.",
+            "name": "single_node",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-requirements"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/image-signing-key": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Downloads the PEM encoded public key that the signatures of the discovery images are verified with.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DownloadImageSigningKey",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/openshift_versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift supported versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "ListSupportedOpenshiftVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/openshift-versions"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/quotas": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Lists the usage and limits of the organizations and users that own clusters or have limits of their own.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ListQuotas",
+        "parameters": [
+          {
+            "enum": [
+              "org",
+              "user"
+            ],
+            "type": "string",
+            "description": "Only list the quotas of organizations or of users.",
+            "name": "scope",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/quota-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/quotas/{scope}/{owner}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Retrieves the usage and limits of an organization or a user.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetQuota",
+        "parameters": [
+          {
+            "enum": [
+              "org",
+              "user"
+            ],
+            "type": "string",
+            "description": "Whether the owner is an organization or a user.",
+            "name": "scope",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of the organization or the name of the user.",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/quota"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "userAuth": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Sets the limits of an organization or a user. Limits that are not set fall back to the default limits of the service.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateQuotaLimits",
+        "parameters": [
+          {
+            "enum": [
+              "org",
+              "user"
+            ],
+            "type": "string",
+            "description": "Whether the owner is an organization or a user.",
+            "name": "scope",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of the organization or the name of the user.",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The limits of the owner.",
+            "name": "limits",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/quota-limits"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/quota"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/supported-operators": {
+      "get": {
+        "description": "Retrieves the list of supported operators.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "ListSupportedOperators",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/supported-operators/{operator_name}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists properties for an operator.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "ListOperatorProperties",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The operator name.",
+            "name": "operator_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/operator-properties"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "add-hosts-cluster-create-params": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "api_vip_dnsname",
+        "openshift_version"
+      ],
+      "properties": {
+        "api_vip_dnsname": {
+          "description": "api vip domain.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        }
+      }
+    },
+    "api_vip_connectivity_request": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "url": {
+          "description": "URL address of the API.",
+          "type": "string"
+        },
+        "verify_cidr": {
+          "description": "Whether to verify if the API VIP belongs to one of the interfaces.",
+          "type": "boolean"
+        }
+      }
+    },
+    "api_vip_connectivity_response": {
+      "type": "object",
+      "properties": {
+        "is_success": {
+          "description": "API VIP connectivity check result.",
+          "type": "boolean"
+        }
+      }
+    },
+    "archived-cluster": {
+      "description": "A cluster that was moved from the database to the storage after its installation.",
+      "type": "object",
+      "required": [
+        "archived_at",
+        "cluster"
+      ],
+      "properties": {
+        "archived_at": {
+          "description": "The time at which the cluster was archived.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "cluster": {
+          "$ref": "#/definitions/cluster"
+        },
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/event"
+          }
+        }
+      }
+    },
+    "assisted-service-iso-create-params": {
+      "type": "object",
+      "properties": {
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        }
+      }
+    },
+    "boot": {
+      "type": "object",
+      "properties": {
+        "current_boot_mode": {
+          "type": "string"
+        },
+        "pxe_interface": {
+          "type": "string"
+        }
+      }
+    },
+    "cluster": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "image_info",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by the service and the installed cluster trust the certificates in the bundle.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "ams_subscription_id": {
+          "description": "Unique identifier of the AMS subscription in OCM.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "Cluster networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
+        "connectivity_majority_groups": {
+          "description": "Json formatted string containing the majority groups for connectivity checks.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "controller_logs_collected_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "controller_logs_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "created_at": {
+          "description": "The time that this cluster was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "deleted_at": {
+          "description": "The time that the cluster was deleted.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "email_domain": {
+          "type": "string"
+        },
+        "enabled_host_count": {
+          "description": "hosts associated to this cluster that are not in 'disabled' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "feature_usage": {
+          "description": "JSON-formatted string containing the usage information by feature name",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "host_networks": {
+          "description": "List of host networks to be filled during query.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_network"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "hosts": {
+          "description": "Hosts that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/host"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"column:https_proxy\""
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "all",
+            "none"
+          ]
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "ignition_config_overrides": {
+          "description": "Json formatted string containing the user overrides for the initial ignition config",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
+        },
+        "image_info": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:image_\"",
+          "$ref": "#/definitions/image_info"
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "install_completed_at": {
+          "description": "The time that this cluster completed installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"networking\":{\"networkType\": \"OVN-Kubernetes\"},\"fips\":true}"
+        },
+        "install_started_at": {
+          "description": "The time that this cluster started installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n",
+          "type": "string",
+          "enum": [
+            "Cluster",
+            "AddHostsCluster"
+          ]
+        },
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
+          "$ref": "#/definitions/logs_state"
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "machine_networks": {
+          "description": "Machine networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
+        "monitored_operators": {
+          "description": "Operators that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/monitored-operator"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "no_proxy": {
+          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string"
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "openshift_cluster_id": {
+          "description": "Cluster ID on OCP system.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "pull_secret_set": {
+          "description": "True if the pull secret has been added to the cluster.",
+          "type": "boolean"
+        },
+        "ready_host_count": {
+          "description": "hosts associated to this cluster that are in 'known' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Status of the OpenShift cluster.",
+          "type": "string",
+          "enum": [
+            "insufficient",
+            "ready",
+            "error",
+            "preparing-for-installation",
+            "pending-for-input",
+            "installing",
+            "finalizing",
+            "installed",
+            "adding-hosts",
+            "cancelled",
+            "installing-pending-user-action"
+          ]
+        },
+        "status_info": {
+          "description": "Additional information pertaining to the status of the OpenShift cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "status_updated_at": {
+          "description": "The last time that the cluster status was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "total_host_count": {
+          "description": "All hosts associated to this cluster.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "updated_at": {
+          "description": "The last time that this cluster was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "user_name": {
+          "type": "string"
+        },
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "openshift_version",
+        "pull_secret"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by the service and the installed cluster trust the certificates in the bundle.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "10.128.0.0/14",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "default": 23,
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "Cluster networks, one per IP address family. When two networks are set the cluster is dual-stack and the first one is IPv4.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          }
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "default": "all",
+          "enum": [
+            "masters",
+            "workers",
+            "none",
+            "all"
+          ]
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "machine_networks": {
+          "description": "Machine networks, one per IP address family. When two networks are set the cluster is dual-stack and the first one is IPv4. With user managed networking, the hosts may be spread over any number of routed machine networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine_network"
+          }
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "172.30.0.0/16",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "Service networks, one per IP address family. When two networks are set the cluster is dual-stack and the first one is IPv4.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          }
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "default": true,
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-host-requirements": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "description": "Unique identifier of the host the requirements relate to.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "ocp": {
+          "description": "Host requirements for the OCP installation",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "operators": {
+          "description": "Host requirements related to requested operators",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-host-requirements"
+          }
+        },
+        "total": {
+          "description": "Total host requirements for the cluster configuration",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "cluster-host-requirements-details": {
+      "type": "object",
+      "properties": {
+        "cpu_cores": {
+          "description": "Required number of CPU cores",
+          "type": "integer"
+        },
+        "disk_size_gb": {
+          "description": "Required disk size in GB",
+          "type": "integer"
+        },
+        "installation_disk_speed_threshold_ms": {
+          "description": "Required installation disk speed in ms",
+          "type": "integer"
+        },
+        "network_latency_threshold_ms": {
+          "description": "Maximum network average latency (RTT) at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "packet_loss_percentage": {
+          "description": "Maximum packet loss allowed at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "ram_mib": {
+          "description": "Required number of RAM in MiB",
+          "type": "integer"
+        }
+      }
+    },
+    "cluster-host-requirements-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-host-requirements"
+      }
+    },
+    "cluster-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster"
+      }
+    },
+    "cluster-update-params": {
+      "type": "object",
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by the service and the installed cluster trust the certificates in the bundle.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+          "x-nullable": true
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "cluster_networks": {
+          "description": "Cluster networks, one per IP address family. When two networks are set the cluster is dual-stack and the first one is IPv4.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          }
+        },
+        "disks_selected_config": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "disks_config": {
+                "description": "The desired disks parameters (such as the disk's role).",
+                "type": "array",
+                "items": {
+                  "$ref": "#/definitions/disk-config-params"
+                }
+              },
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_machine_config_pool_names": {
+          "description": "The desired machine config pool for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              },
+              "machine_config_pool_name": {
+                "type": "string"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_names": {
+          "description": "The desired hostname for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "hostname": {
+                "type": "string"
+              },
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_roles": {
+          "description": "The desired role for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              },
+              "role": {
+                "$ref": "#/definitions/host-role-update-params"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "all",
+            "none"
+          ],
+          "x-nullable": true
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+          "x-nullable": true
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "machine_networks": {
+          "description": "Machine networks, one per IP address family. When two networks are set the cluster is dual-stack and the first one is IPv4. With user managed networking, the hosts may be spread over any number of routed machine networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine_network"
+          }
+        },
+        "name": {
+          "description": "OpenShift cluster name.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "service_networks": {
+          "description": "Service networks, one per IP address family. When two networks are set the cluster is dual-stack and the first one is IPv4.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          }
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-validation-id": {
+      "type": "string",
+      "enum": [
+        "machine-cidr-defined",
+        "cluster-cidr-defined",
+        "service-cidr-defined",
+        "no-cidrs-overlapping",
+        "network-prefix-valid",
+        "machine-cidr-equals-to-calculated-cidr",
+        "api-vip-defined",
+        "api-vip-valid",
+        "ingress-vip-defined",
+        "ingress-vip-valid",
+        "all-hosts-are-ready-to-install",
+        "sufficient-masters-count",
+        "dns-domain-defined",
+        "pull-secret-set",
+        "ntp-server-configured",
+        "lso-requirements-satisfied",
+        "ocs-requirements-satisfied",
+        "cnv-requirements-satisfied"
+      ]
+    },
+    "cluster_default_config": {
+      "type": "object",
+      "properties": {
+        "cluster_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        },
+        "cluster_network_host_prefix": {
+          "type": "integer",
+          "maximum": 32,
+          "minimum": 1
+        },
+        "ntp_source": {
+          "type": "string",
+          "x-omitempty": false
+        },
+        "service_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        }
+      }
+    },
+    "cluster_network": {
+      "description": "IP address block from which Pod IPs are allocated.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "The IP block address pool.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "cluster_id": {
+          "description": "The cluster that this network is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
+        },
+        "host_prefix": {
+          "description": "The prefix size to allocate to each node from the CIDR. For example, 24 would allocate 2^8=256 addresses to each node.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "index": {
+          "description": "The position of the network in the cluster networks of the cluster. The network at position 0 is the primary one. Set by the service.",
+          "type": "integer"
+        }
+      }
+    },
+    "completion-params": {
+      "type": "object",
+      "required": [
+        "is_success"
+      ],
+      "properties": {
+        "error_info": {
+          "type": "string"
+        },
+        "is_success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "connectivity-check-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "nics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-check-nic"
+          }
+        }
+      }
+    },
+    "connectivity-check-nic": {
+      "type": "object",
+      "properties": {
+        "ip_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mac": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "connectivity-check-params": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/connectivity-check-host"
+      }
+    },
+    "connectivity-remote-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "l2_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l2-connectivity"
+          }
+        },
+        "l3_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l3-connectivity"
+          }
+        }
+      }
+    },
+    "connectivity-report": {
+      "type": "object",
+      "properties": {
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-remote-host"
+          }
+        }
+      }
+    },
+    "container_image_availability": {
+      "type": "object",
+      "properties": {
+        "download_rate": {
+          "description": "The rate of size/time in seconds MBps.",
+          "type": "number"
+        },
+        "name": {
+          "description": "A fully qualified image name (FQIN).",
+          "type": "string"
+        },
+        "result": {
+          "$ref": "#/definitions/container_image_availability_result"
+        },
+        "size_bytes": {
+          "description": "Size of the image in bytes.",
+          "type": "number"
+        },
+        "time": {
+          "description": "Seconds it took to pull the image.",
+          "type": "number"
+        }
+      }
+    },
+    "container_image_availability_request": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of image names to be checked.",
+          "type": "array",
+          "items": {
+            "description": "A fully qualified image name (FQIN).",
+            "type": "string"
+          }
+        },
+        "timeout": {
+          "description": "Positive number represents a timeout in seconds for a pull operation.",
+          "type": "integer"
+        }
+      }
+    },
+    "container_image_availability_response": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of images that were checked.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/container_image_availability"
+          }
+        }
+      }
+    },
+    "container_image_availability_result": {
+      "description": "Image availability result.",
+      "type": "string",
+      "enum": [
+        "success",
+        "failure"
+      ]
+    },
+    "cpu": {
+      "type": "object",
+      "properties": {
+        "architecture": {
+          "type": "string"
+        },
+        "count": {
+          "type": "integer"
+        },
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "frequency": {
+          "type": "number"
+        },
+        "model_name": {
+          "type": "string"
+        }
+      }
+    },
+    "create-manifest-params": {
+      "type": "object",
+      "required": [
+        "file_name",
+        "content"
+      ],
+      "properties": {
+        "content": {
+          "description": "base64 encoded manifest content.",
+          "type": "string"
+        },
+        "file_name": {
+          "description": "The name of the manifest to customize the installed OCP cluster.",
+          "type": "string",
+          "pattern": "^[^/]*\\.(yaml|yml|json)$"
+        },
+        "folder": {
+          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        }
+      }
+    },
+    "credentials": {
+      "type": "object",
+      "properties": {
+        "console_url": {
+          "type": "string"
+        },
+        "password": {
+          "type": "string"
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "declared-host-create-params": {
+      "description": "A host that is declared before it boots its own discovery image.",
+      "type": "object",
+      "properties": {
+        "hostname": {
+          "description": "The hostname of the host.",
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role-update-params"
+        },
+        "static_network_config": {
+          "$ref": "#/definitions/host_static_network_config"
+        }
+      }
+    },
+    "declared-host-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/declared_host"
+      }
+    },
+    "declared_host": {
+      "description": "A host that was declared before it boots its own discovery image. The host that registers with the image is bound to the declared host, and gets its hostname and role.",
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "host_id": {
+          "description": "The host that registered with the discovery image of the declared host.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "description": "The hostname of the host.",
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "image_download_url": {
+          "description": "The URL of the discovery image of the declared host.",
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role-update-params"
+        },
+        "static_network_config": {
+          "description": "The static network config of the host, in the format of the image info of clusters.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        }
+      }
+    },
+    "dhcp_allocation_request": {
+      "type": "object",
+      "required": [
+        "interface",
+        "api_vip_mac",
+        "ingress_vip_mac"
+      ],
+      "properties": {
+        "api_vip_lease": {
+          "description": "Contents of lease file to be used for API virtual IP.",
+          "type": "string"
+        },
+        "api_vip_mac": {
+          "description": "MAC address for the API virtual IP.",
+          "type": "string",
+          "format": "mac"
+        },
+        "ingress_vip_lease": {
+          "description": "Contents of lease file to be used for for Ingress virtual IP.",
+          "type": "string"
+        },
+        "ingress_vip_mac": {
+          "description": "MAC address for the Ingress virtual IP.",
+          "type": "string",
+          "format": "mac"
+        },
+        "interface": {
+          "description": "The network interface (NIC) to run the DHCP requests on.",
+          "type": "string"
+        }
+      }
+    },
+    "dhcp_allocation_response": {
+      "type": "object",
+      "required": [
+        "api_vip_address",
+        "ingress_vip_address"
+      ],
+      "properties": {
+        "api_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "api_vip_lease": {
+          "description": "Contents of last acquired lease for API virtual IP.",
+          "type": "string"
+        },
+        "ingress_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "ingress_vip_lease": {
+          "description": "Contents of last acquired lease for Ingress virtual IP.",
+          "type": "string"
+        }
+      }
+    },
+    "discovery-ignition-params": {
+      "properties": {
+        "config": {
+          "type": "string"
+        }
+      }
+    },
+    "disk": {
+      "type": "object",
+      "properties": {
+        "bootable": {
+          "type": "boolean"
+        },
+        "by_id": {
+          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
+          "type": "string"
+        },
+        "by_path": {
+          "description": "by-path is the shortest physical path to the device",
+          "type": "string"
+        },
+        "drive_type": {
+          "type": "string"
+        },
+        "hctl": {
+          "type": "string"
+        },
+        "id": {
+          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
+          "type": "string"
+        },
+        "installation_eligibility": {
+          "type": "object",
+          "properties": {
+            "eligible": {
+              "description": "Whether the disk is eligible for installation or not.",
+              "type": "boolean"
+            },
+            "not_eligible_reasons": {
+              "description": "Reasons for why this disk is not eligible for installation.",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "x-nullable": false
+        },
+        "io_perf": {
+          "$ref": "#/definitions/io_perf"
+        },
+        "is_installation_media": {
+          "description": "Whether the disk appears to be an installation media or not",
+          "type": "boolean"
+        },
+        "model": {
           "type": "string"
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+        "name": {
           "type": "string"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
+        "path": {
+          "type": "string"
+        },
+        "serial": {
+          "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "smart": {
+          "type": "string"
+        },
+        "vendor": {
+          "type": "string"
+        },
+        "wwn": {
           "type": "string"
         }
       }
     },
-    "boot": {
+    "disk-config-params": {
       "type": "object",
+      "required": [
+        "id"
+      ],
       "properties": {
-        "current_boot_mode": {
+        "id": {
           "type": "string"
         },
-        "pxe_interface": {
-          "type": "string"
+        "role": {
+          "$ref": "#/definitions/disk-role"
         }
       }
     },
-    "cluster": {
+    "disk-role": {
+      "type": "string",
+      "enum": [
+        "none",
+        "install"
+      ]
+    },
+    "disk_info": {
       "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "image_info",
-        "status",
-        "status_info"
-      ],
       "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
+        "disk_speed": {
+          "$ref": "#/definitions/disk_speed"
         },
-        "ams_subscription_id": {
-          "description": "Unique identifier of the AMS subscription in OCM.",
+        "id": {
           "type": "string",
           "format": "uuid"
         },
-        "api_vip": {
-          "description": "The virtual IP used to reach the OpenShift cluster's API.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
-        },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+        "path": {
           "type": "string"
+        }
+      }
+    },
+    "disk_speed": {
+      "type": "object",
+      "properties": {
+        "exit_code": {
+          "type": "integer"
         },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1
-        },
-        "connectivity_majority_groups": {
-          "description": "Json formatted string containing the majority groups for connectivity checks.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "controller_logs_collected_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "controller_logs_started_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "created_at": {
-          "description": "The time that this cluster was created.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "deleted_at": {
-          "description": "The time that the cluster was deleted.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-nullable": true
+        "speed_ms": {
+          "type": "integer"
         },
-        "email_domain": {
+        "tested": {
+          "type": "boolean"
+        }
+      }
+    },
+    "disk_speed_check_request": {
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "path": {
+          "description": "--filename argument for fio (expects a file or a block device path).",
           "type": "string"
+        }
+      }
+    },
+    "disk_speed_check_response": {
+      "type": "object",
+      "properties": {
+        "io_sync_duration": {
+          "description": "The 99th percentile of fdatasync durations in milliseconds.",
+          "type": "integer"
         },
-        "enabled_host_count": {
-          "description": "hosts associated to this cluster that are not in 'disabled' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "feature_usage": {
-          "description": "JSON-formatted string containing the usage information by feature name",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "high_availability_mode": {
-          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
-          "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
-        },
-        "host_networks": {
-          "description": "List of host networks to be filled during query.",
+        "path": {
+          "description": "The device path.",
+          "type": "string"
+        }
+      }
+    },
+    "domain_resolution_request": {
+      "type": "object",
+      "required": [
+        "domains"
+      ],
+      "properties": {
+        "domains": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host_network"
-          },
-          "x-go-custom-tag": "gorm:\"-\""
+            "type": "object",
+            "required": [
+              "domain_name"
+            ],
+            "properties": {
+              "domain_name": {
+                "description": "The domain name that should be resolved",
+                "type": "string"
+              }
+            },
+            "x-go-name": "DomainResolutionRequestDomain"
+          }
+        }
+      }
+    },
+    "domain_resolution_response": {
+      "type": "object",
+      "required": [
+        "resolutions"
+      ],
+      "properties": {
+        "resolutions": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": [
+              "domain_name"
+            ],
+            "properties": {
+              "domain_name": {
+                "description": "The domain that was resolved",
+                "type": "string"
+              },
+              "ipv4_addresses": {
+                "description": "The IPv4 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv4"
+                }
+              },
+              "ipv6_addresses": {
+                "description": "The IPv6 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv6"
+                }
+              }
+            },
+            "x-go-name": "DomainResolutionResponseDomain"
+          }
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "code",
+        "reason"
+      ],
+      "properties": {
+        "code": {
+          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
+          "type": "string"
         },
-        "hosts": {
-          "description": "Hosts that are associated with this cluster.",
+        "field_errors": {
+          "description": "The problems found in specific fields of the request, when they can be pinpointed.",
           "type": "array",
           "items": {
-            "type": "object",
-            "$ref": "#/definitions/host"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+            "$ref": "#/definitions/field-error"
+          }
         },
         "href": {
           "description": "Self link.",
           "type": "string"
         },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string"
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\""
+        "id": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 504,
+          "minimum": 400
         },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes",
+        "kind": {
+          "description": "Indicates the type of this object. Will always be 'Error'.",
           "type": "string",
           "enum": [
-            "masters",
-            "workers",
-            "all",
-            "none"
+            "Error"
           ]
         },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key\""
-        },
-        "ignition_config_overrides": {
-          "description": "Json formatted string containing the user overrides for the initial ignition config",
+        "reason": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
+    "event": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "severity",
+        "message",
+        "event_time"
+      ],
+      "properties": {
+        "category": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
-        },
-        "image_info": {
-          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:image_\"",
-          "$ref": "#/definitions/image_info"
+          "enum": [
+            "user",
+            "metrics"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'user'\""
         },
-        "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
+        "cluster_id": {
+          "description": "Unique identifier of the cluster this event relates to.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "install_completed_at": {
-          "description": "The time that this cluster completed installation.",
+        "event_time": {
           "type": "string",
           "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
-        },
-        "install_config_overrides": {
-          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"networking\":{\"networkType\": \"OVN-Kubernetes\"},\"fips\":true}"
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "install_started_at": {
-          "description": "The time that this cluster started installation.",
+        "host_id": {
+          "description": "Unique identifier of the host this event relates to.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+          "format": "uuid"
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n",
+        "message": {
           "type": "string",
-          "enum": [
-            "Cluster",
-            "AddHostsCluster"
-          ]
-        },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
-          "$ref": "#/definitions/logs_state"
+          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
         },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+        "props": {
+          "description": "Additional properties for the event in JSON format.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "monitored_operators": {
-          "description": "Operators that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/monitored-operator"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
-        },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string"
-        },
-        "no_proxy": {
-          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string"
-        },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
-          "type": "string"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "openshift_cluster_id": {
-          "description": "Cluster ID on OCP system.",
+        "request_id": {
+          "description": "Unique identifier of the request that caused this event to occur.",
           "type": "string",
           "format": "uuid"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
-        },
-        "org_id": {
-          "type": "string"
-        },
-        "pull_secret_set": {
-          "description": "True if the pull secret has been added to the cluster.",
-          "type": "boolean"
-        },
-        "ready_host_count": {
-          "description": "hosts associated to this cluster that are in 'known' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string"
-        },
-        "status": {
-          "description": "Status of the OpenShift cluster.",
+        "severity": {
           "type": "string",
           "enum": [
-            "insufficient",
-            "ready",
+            "info",
+            "warning",
             "error",
-            "preparing-for-installation",
-            "pending-for-input",
-            "installing",
-            "finalizing",
-            "installed",
-            "adding-hosts",
-            "cancelled",
-            "installing-pending-user-action"
+            "critical"
           ]
+        }
+      }
+    },
+    "event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event"
+      }
+    },
+    "field-error": {
+      "type": "object",
+      "properties": {
+        "field": {
+          "description": "The path of the field within the request document, such as storage.files.0.path.",
+          "type": "string"
         },
-        "status_info": {
-          "description": "Additional information pertaining to the status of the OpenShift cluster.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
-        },
-        "status_updated_at": {
-          "description": "The last time that the cluster status was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "total_host_count": {
-          "description": "All hosts associated to this cluster.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
+        "message": {
+          "description": "Human-readable description of the problem.",
+          "type": "string"
+        }
+      }
+    },
+    "free-addresses-list": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "format": "ipv4"
+      }
+    },
+    "free_addresses_request": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+      }
+    },
+    "free_network_addresses": {
+      "type": "object",
+      "properties": {
+        "free_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "ipv4"
+          }
         },
-        "updated_at": {
-          "description": "The last time that this cluster was updated.",
+        "network": {
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+        }
+      }
+    },
+    "free_networks_addresses": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/free_network_addresses"
+      }
+    },
+    "gpu": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "description": "Device address (for example \"0000:00:02.0\")",
+          "type": "string"
         },
-        "user_managed_networking": {
-          "description": "Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "x-nullable": true
+        "device_id": {
+          "description": "ID of the device (for example \"3ea0\")",
+          "type": "string"
         },
-        "user_name": {
+        "name": {
+          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
           "type": "string"
         },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "vendor": {
+          "description": "The name of the device vendor (for example \"Intel Corporation\")",
+          "type": "string"
         },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
+        "vendor_id": {
+          "description": "ID of the vendor (for example \"8086\")",
+          "type": "string"
         }
       }
     },
-    "cluster-create-params": {
+    "host": {
       "type": "object",
       "required": [
-        "name",
-        "openshift_version",
-        "pull_secret"
+        "kind",
+        "id",
+        "href",
+        "status",
+        "status_info"
       ],
       "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+        "agent_upgrade_status": {
+          "description": "Json formatted string containing the result of the last attempt to upgrade the discovery agent of the host.",
           "type": "string",
-          "default": "10.128.0.0/14",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "default": 23,
-          "maximum": 128,
-          "minimum": 1
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "high_availability_mode": {
-          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+        "api_vip_connectivity": {
           "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
+        "bootstrap": {
+          "type": "boolean"
         },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+        "checked_in_at": {
+          "description": "The last time the host's agent communicated with the service.",
           "type": "string",
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+        "cluster_id": {
+          "description": "The cluster that this host is associated with.",
           "type": "string",
-          "default": "all",
-          "enum": [
-            "masters",
-            "workers",
-            "none",
-            "all"
-          ]
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
         },
-        "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
+        "connectivity": {
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
+        "created_at": {
           "type": "string",
-          "maxLength": 54,
-          "minLength": 1
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+        "deleted_at": {
+          "description": "The time that the host was deleted.",
           "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
           "x-nullable": true
         },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
-          "type": "string"
-        },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-create-params"
-          }
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "discovery_agent_version": {
           "type": "string"
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
-          "type": "string"
+        "disks_info": {
+          "description": "Additional information about disks, formatted as JSON.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+        "free_addresses": {
           "type": "string",
-          "default": "172.30.0.0/16",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+        "href": {
+          "description": "Self link.",
           "type": "string"
         },
-        "user_managed_networking": {
-          "description": "Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "default": true,
-          "x-nullable": true
-        }
-      }
-    },
-    "cluster-host-requirements": {
-      "type": "object",
-      "properties": {
-        "host_id": {
-          "description": "Unique identifier of the host the requirements relate to.",
+        "id": {
+          "description": "Unique identifier of the object.",
           "type": "string",
-          "format": "uuid"
-        },
-        "ocp": {
-          "description": "Host requirements for the OCP installation",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "operators": {
-          "description": "Host requirements related to requested operators",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-host-requirements"
-          }
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "total": {
-          "description": "Total host requirements for the cluster configuration",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        }
-      }
-    },
-    "cluster-host-requirements-details": {
-      "type": "object",
-      "properties": {
-        "cpu_cores": {
-          "description": "Required number of CPU cores",
-          "type": "integer"
+        "ignition_config_overrides": {
+          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
         },
-        "disk_size_gb": {
-          "description": "Required disk size in GB",
-          "type": "integer"
+        "images_status": {
+          "description": "Array of image statuses.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "installation_disk_speed_threshold_ms": {
-          "description": "Required installation disk speed in ms",
-          "type": "integer"
+        "installation_disk_id": {
+          "description": "Contains the inventory disk id to install on.",
+          "type": "string"
         },
-        "network_latency_threshold_ms": {
-          "description": "Maximum network average latency (RTT) at L3 for role.",
-          "type": "number",
-          "format": "double",
-          "x-nullable": true
+        "installation_disk_path": {
+          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
+          "type": "string",
+          "example": "/dev/sda"
         },
-        "packet_loss_percentage": {
-          "description": "Maximum packet loss allowed at L3 for role.",
-          "type": "number",
-          "format": "double",
-          "x-nullable": true
+        "installer_args": {
+          "type": "string"
         },
-        "ram_mib": {
-          "description": "Required number of RAM in MiB",
-          "type": "integer"
-        }
-      }
-    },
-    "cluster-host-requirements-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster-host-requirements"
-      }
-    },
-    "cluster-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster"
-      }
-    },
-    "cluster-update-params": {
-      "type": "object",
-      "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
+        "installer_version": {
+          "description": "Installer version.",
+          "type": "string"
         },
-        "api_vip": {
-          "description": "The virtual IP used to reach the OpenShift cluster's API.",
+        "inventory": {
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
           "type": "string",
-          "x-nullable": true
+          "enum": [
+            "Host",
+            "AddToExistingClusterHost"
+          ]
         },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+        "logs_collected_at": {
           "type": "string",
-          "x-nullable": true
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
+          "$ref": "#/definitions/logs_state"
+        },
+        "logs_started_at": {
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1,
-          "x-nullable": true
+        "machine_config_pool_name": {
+          "type": "string"
         },
-        "disks_selected_config": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "disks_config": {
-                "description": "The desired disks parameters (such as the disk's role).",
-                "type": "array",
-                "items": {
-                  "$ref": "#/definitions/disk-config-params"
-                }
-              },
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              }
-            }
-          },
-          "x-nullable": true
+        "ntp_sources": {
+          "description": "The configured NTP sources on the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "hosts_machine_config_pool_names": {
-          "description": "The desired machine config pool for hosts associated with the cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              },
-              "machine_config_pool_name": {
-                "type": "string"
-              }
-            }
-          },
-          "x-nullable": true
+        "progress": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:progress_\"",
+          "$ref": "#/definitions/host-progress-info"
         },
-        "hosts_names": {
-          "description": "The desired hostname for hosts associated with the cluster.",
+        "progress_stages": {
           "type": "array",
           "items": {
-            "type": "object",
-            "properties": {
-              "hostname": {
-                "type": "string"
-              },
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              }
-            }
+            "$ref": "#/definitions/host-stage"
           },
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"-\""
         },
-        "hosts_roles": {
-          "description": "The desired role for hosts associated with the cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              },
-              "role": {
-                "$ref": "#/definitions/host-role-update-params"
-              }
-            }
-          },
-          "x-nullable": true
+        "requested_hostname": {
+          "type": "string"
         },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
+        "role": {
+          "$ref": "#/definitions/host-role"
         },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
           "type": "string",
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
           "type": "string",
-          "enum": [
-            "masters",
-            "workers",
-            "all",
-            "none"
-          ],
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
+        "status": {
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
-          "x-nullable": true
+          "enum": [
+            "discovering",
+            "known",
+            "disconnected",
+            "insufficient",
+            "disabled",
+            "preparing-for-installation",
+            "preparing-successful",
+            "pending-for-input",
+            "installing",
+            "installing-in-progress",
+            "installing-pending-user-action",
+            "resetting-pending-user-action",
+            "installed",
+            "error",
+            "resetting",
+            "added-to-existing-cluster",
+            "cancelled"
+          ]
         },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+        "status_info": {
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
-        "name": {
-          "description": "OpenShift cluster name.",
+        "status_updated_at": {
+          "description": "The last time that the host status was updated.",
           "type": "string",
-          "maxLength": 54,
-          "minLength": 1,
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+        "updated_at": {
           "type": "string",
-          "x-nullable": true
-        },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-create-params"
-          }
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
-          "type": "string",
-          "x-nullable": true
+        "user_name": {
+          "type": "string"
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"type:text\""
+        }
+      }
+    },
+    "host-create-params": {
+      "type": "object",
+      "required": [
+        "host_id"
+      ],
+      "properties": {
+        "discovery_agent_version": {
+          "type": "string"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+        "host_id": {
           "type": "string",
-          "x-nullable": true
-        },
-        "user_managed_networking": {
-          "description": "Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
+          "format": "uuid"
         }
       }
     },
-    "cluster-validation-id": {
-      "type": "string",
-      "enum": [
-        "machine-cidr-defined",
-        "cluster-cidr-defined",
-        "service-cidr-defined",
-        "no-cidrs-overlapping",
-        "network-prefix-valid",
-        "machine-cidr-equals-to-calculated-cidr",
-        "api-vip-defined",
-        "api-vip-valid",
-        "ingress-vip-defined",
-        "ingress-vip-valid",
-        "all-hosts-are-ready-to-install",
-        "sufficient-masters-count",
-        "dns-domain-defined",
-        "pull-secret-set",
-        "ntp-server-configured",
-        "lso-requirements-satisfied",
-        "ocs-requirements-satisfied",
-        "cnv-requirements-satisfied"
-      ]
+    "host-ignition-params": {
+      "properties": {
+        "config": {
+          "type": "string"
+        }
+      }
     },
-    "cluster_default_config": {
+    "host-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host"
+      }
+    },
+    "host-progress": {
       "type": "object",
+      "required": [
+        "current_stage"
+      ],
       "properties": {
-        "cluster_network_cidr": {
+        "current_stage": {
           "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+          "$ref": "#/definitions/host-stage"
         },
-        "cluster_network_host_prefix": {
-          "type": "integer",
-          "maximum": 32,
-          "minimum": 1
+        "progress_info": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        }
+      }
+    },
+    "host-progress-info": {
+      "type": "object",
+      "required": [
+        "current_stage"
+      ],
+      "properties": {
+        "current_stage": {
+          "type": "string",
+          "$ref": "#/definitions/host-stage"
         },
-        "ntp_source": {
+        "progress_info": {
           "type": "string",
-          "x-omitempty": false
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
-        "service_network_cidr": {
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
           "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         }
       }
     },
-    "completion-params": {
+    "host-requirements": {
       "type": "object",
-      "required": [
-        "is_success"
-      ],
       "properties": {
-        "error_info": {
-          "type": "string"
+        "master": {
+          "$ref": "#/definitions/host-requirements-role"
         },
-        "is_success": {
-          "type": "boolean"
+        "worker": {
+          "$ref": "#/definitions/host-requirements-role"
         }
       }
     },
-    "connectivity-check-host": {
+    "host-requirements-role": {
       "type": "object",
       "properties": {
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
+        "cpu_cores": {
+          "type": "integer"
         },
-        "nics": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-check-nic"
-          }
+        "disk_size_gb": {
+          "type": "integer"
+        },
+        "installation_disk_speed_threshold_ms": {
+          "type": "integer"
+        },
+        "ram_gib": {
+          "type": "integer"
         }
       }
     },
-    "connectivity-check-nic": {
+    "host-role": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "worker",
+        "bootstrap"
+      ]
+    },
+    "host-role-update-params": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "worker"
+      ]
+    },
+    "host-stage": {
+      "type": "string",
+      "enum": [
+        "Starting installation",
+        "Waiting for control plane",
+        "Waiting for bootkube",
+        "Waiting for controller",
+        "Installing",
+        "Writing image to disk",
+        "Rebooting",
+        "Waiting for ignition",
+        "Configuring",
+        "Joined",
+        "Done",
+        "Failed"
+      ]
+    },
+    "host-type-hardware-requirements": {
       "type": "object",
       "properties": {
-        "ip_addresses": {
+        "qualitative": {
+          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "mac": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
+        "quantitative": {
+          "description": "Host requirements that can be quantified",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "connectivity-check-params": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/connectivity-check-host"
+    "host-type-hardware-requirements-wrapper": {
+      "type": "object",
+      "properties": {
+        "master": {
+          "description": "Requirements towards a master node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
+        },
+        "worker": {
+          "description": "Requirements towards a worker node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
+        }
       }
     },
-    "connectivity-remote-host": {
+    "host-validation-id": {
+      "type": "string",
+      "enum": [
+        "connected",
+        "has-inventory",
+        "has-min-cpu-cores",
+        "has-min-valid-disks",
+        "has-min-memory",
+        "machine-cidr-defined",
+        "has-cpu-cores-for-role",
+        "has-memory-for-role",
+        "hostname-unique",
+        "hostname-valid",
+        "belongs-to-machine-cidr",
+        "api-vip-connected",
+        "belongs-to-majority-group",
+        "valid-platform",
+        "ntp-synced",
+        "container-images-available",
+        "lso-requirements-satisfied",
+        "ocs-requirements-satisfied",
+        "sufficient-installation-disk-speed",
+        "cnv-requirements-satisfied",
+        "sufficient-network-latency-requirement-for-role",
+        "sufficient-packet-loss-requirement-for-role",
+        "compatible-agent"
+      ]
+    },
+    "host_network": {
       "type": "object",
       "properties": {
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
+        "cidr": {
+          "type": "string"
         },
-        "l2_connectivity": {
+        "host_ids": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/l2-connectivity"
+            "type": "string",
+            "format": "uuid"
           }
+        }
+      }
+    },
+    "host_registration_response": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/host"
         },
-        "l3_connectivity": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/l3-connectivity"
+        {
+          "properties": {
+            "next_step_runner_command": {
+              "description": "Command for starting the next step runner",
+              "type": "object",
+              "properties": {
+                "args": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "command": {
+                  "type": "string"
+                },
+                "retry_seconds": {
+                  "description": "How long in seconds to wait before retrying registration if the command fails",
+                  "type": "integer"
+                }
+              }
+            }
           }
         }
-      }
+      ]
     },
-    "connectivity-report": {
+    "host_static_network_config": {
       "type": "object",
       "properties": {
-        "remote_hosts": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-remote-host"
-          }
+        "mac_interface_map": {
+          "description": "mapping of host macs to logical interfaces used in the network yaml",
+          "$ref": "#/definitions/mac_interface_map"
+        },
+        "network_yaml": {
+          "description": "yaml string that can be processed by nmstate",
+          "type": "string"
         }
       }
     },
-    "container_image_availability": {
+    "ignition-change": {
       "type": "object",
       "properties": {
-        "download_rate": {
-          "description": "The rate of size/time in seconds MBps.",
-          "type": "number"
-        },
-        "name": {
-          "description": "A fully qualified image name (FQIN).",
-          "type": "string"
-        },
-        "result": {
-          "$ref": "#/definitions/container_image_availability_result"
-        },
-        "size_bytes": {
-          "description": "Size of the image in bytes.",
-          "type": "number"
+        "action": {
+          "type": "string",
+          "enum": [
+            "added",
+            "modified"
+          ]
         },
-        "time": {
-          "description": "Seconds it took to pull the image.",
-          "type": "number"
+        "kind": {
+          "description": "The kind of ignition entry that was changed.",
+          "type": "string",
+          "enum": [
+            "config",
+            "file",
+            "directory",
+            "link",
+            "unit",
+            "dropin",
+            "user",
+            "group"
+          ]
+        },
+        "name": {
+          "description": "The path, name or key identifying the changed entry.",
+          "type": "string"
         }
       }
     },
-    "container_image_availability_request": {
+    "ignition-layer": {
       "type": "object",
-      "required": [
-        "images"
-      ],
       "properties": {
-        "images": {
-          "description": "List of image names to be checked.",
+        "changes": {
+          "description": "The ignition entries added or modified by the layer.",
           "type": "array",
           "items": {
-            "description": "A fully qualified image name (FQIN).",
-            "type": "string"
+            "$ref": "#/definitions/ignition-change"
           }
         },
-        "timeout": {
-          "description": "Positive number represents a timeout in seconds for a pull operation.",
-          "type": "integer"
+        "name": {
+          "description": "The name of the layer.",
+          "type": "string",
+          "enum": [
+            "template",
+            "cluster-overrides",
+            "pointer",
+            "service-ca",
+            "trust-bundle",
+            "vip-dhcp",
+            "ipv6",
+            "static-network",
+            "etc-hosts",
+            "hostname",
+            "host-overrides"
+          ]
         }
       }
     },
-    "container_image_availability_response": {
+    "ignition-preview": {
       "type": "object",
-      "required": [
-        "images"
-      ],
       "properties": {
-        "images": {
-          "description": "List of images that were checked.",
+        "discovery_ignition": {
+          "description": "The effective discovery ignition of the cluster, with secrets redacted.",
+          "type": "string"
+        },
+        "discovery_layers": {
+          "description": "The layers composing the discovery ignition, in the order they are applied.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/container_image_availability"
+            "$ref": "#/definitions/ignition-layer"
           }
-        }
-      }
-    },
-    "container_image_availability_result": {
-      "description": "Image availability result.",
-      "type": "string",
-      "enum": [
-        "success",
-        "failure"
-      ]
-    },
-    "cpu": {
-      "type": "object",
-      "properties": {
-        "architecture": {
-          "type": "string"
         },
-        "count": {
-          "type": "integer"
+        "node_ignition": {
+          "description": "The projected pointer ignition the host will boot with after it is written to disk, with secrets redacted.",
+          "type": "string"
         },
-        "flags": {
+        "node_layers": {
+          "description": "The layers composing the node ignition, in the order they are applied.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/ignition-layer"
           }
         },
-        "frequency": {
-          "type": "number"
-        },
-        "model_name": {
-          "type": "string"
+        "role": {
+          "$ref": "#/definitions/host-role"
         }
       }
     },
-    "create-manifest-params": {
+    "image-create-params": {
       "type": "object",
-      "required": [
-        "file_name",
-        "content"
-      ],
       "properties": {
-        "content": {
-          "description": "base64 encoded manifest content.",
-          "type": "string"
+        "image_type": {
+          "description": "Type of image that should be generated.",
+          "$ref": "#/definitions/image_type"
         },
-        "file_name": {
-          "description": "The name of the manifest to customize the installed OCP cluster.",
-          "type": "string",
-          "pattern": "^[^/]*\\.(yaml|yml|json)$"
+        "kernel_arguments": {
+          "description": "Kernel argument operations applied to the discovery image.",
+          "$ref": "#/definitions/kernel_arguments"
         },
-        "folder": {
-          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-          "type": "string",
-          "default": "manifests",
-          "enum": [
-            "manifests",
-            "openshift"
-          ]
-        }
-      }
-    },
-    "credentials": {
-      "type": "object",
-      "properties": {
-        "console_url": {
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
           "type": "string"
         },
-        "password": {
-          "type": "string"
+        "static_ip_pool": {
+          "description": "Pool of static IP addresses from which the per-host static network configuration is generated. Cannot be set together with static_network_config.",
+          "$ref": "#/definitions/static_ip_pool"
         },
-        "username": {
-          "type": "string"
+        "static_network_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
         }
       }
     },
-    "dhcp_allocation_request": {
+    "image_info": {
       "type": "object",
-      "required": [
-        "interface",
-        "api_vip_mac",
-        "ingress_vip_mac"
-      ],
       "properties": {
-        "api_vip_lease": {
-          "description": "Contents of lease file to be used for API virtual IP.",
+        "checksum": {
+          "description": "Hex encoded SHA-256 digest of the discovery image.",
           "type": "string"
         },
-        "api_vip_mac": {
-          "description": "MAC address for the API virtual IP.",
+        "created_at": {
           "type": "string",
-          "format": "mac"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "ingress_vip_lease": {
-          "description": "Contents of lease file to be used for for Ingress virtual IP.",
+        "download_url": {
           "type": "string"
         },
-        "ingress_vip_mac": {
-          "description": "MAC address for the Ingress virtual IP.",
+        "expires_at": {
           "type": "string",
-          "format": "mac"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "interface": {
-          "description": "The network interface (NIC) to run the DHCP requests on.",
+        "generator_version": {
+          "description": "Image generator version.",
+          "type": "string"
+        },
+        "kernel_arguments": {
+          "description": "JSON formatted list of the kernel argument operations applied to the discovery image",
           "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        },
+        "static_network_config": {
+          "description": "static network configuration string in the format expected by discovery ignition generation",
+          "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/image_type"
         }
       }
     },
-    "dhcp_allocation_response": {
+    "image_type": {
+      "type": "string",
+      "enum": [
+        "full-iso",
+        "minimal-iso",
+        "pxe",
+        "raw-disk",
+        "qcow2"
+      ]
+    },
+    "infra_error": {
       "type": "object",
       "required": [
-        "api_vip_address",
-        "ingress_vip_address"
+        "code",
+        "message"
       ],
       "properties": {
-        "api_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
-          "type": "string",
-          "format": "ipv4"
-        },
-        "api_vip_lease": {
-          "description": "Contents of last acquired lease for API virtual IP.",
-          "type": "string"
-        },
-        "ingress_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
-          "type": "string",
-          "format": "ipv4"
+        "code": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 403,
+          "minimum": 401
         },
-        "ingress_vip_lease": {
-          "description": "Contents of last acquired lease for Ingress virtual IP.",
+        "message": {
+          "description": "Human-readable description of the error.",
           "type": "string"
         }
       }
     },
-    "discovery-ignition-params": {
+    "ingress-cert-params": {
+      "type": "string"
+    },
+    "installer-args-params": {
+      "type": "object",
       "properties": {
-        "config": {
-          "type": "string"
+        "args": {
+          "description": "List of additional arguments passed to coreos-installer",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "--append-karg",
+            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
+            "--save-partindex",
+            "1",
+            "-n"
+          ]
         }
       }
     },
-    "disk": {
+    "interface": {
       "type": "object",
       "properties": {
-        "bootable": {
-          "type": "boolean"
-        },
-        "by_id": {
-          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
-          "type": "string"
-        },
-        "by_path": {
-          "description": "by-path is the shortest physical path to the device",
-          "type": "string"
-        },
-        "drive_type": {
+        "biosdevname": {
           "type": "string"
         },
-        "hctl": {
+        "client_id": {
           "type": "string"
         },
-        "id": {
-          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
-          "type": "string"
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "installation_eligibility": {
-          "type": "object",
-          "properties": {
-            "eligible": {
-              "description": "Whether the disk is eligible for installation or not.",
-              "type": "boolean"
-            },
-            "not_eligible_reasons": {
-              "description": "Reasons for why this disk is not eligible for installation.",
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
-          },
-          "x-nullable": false
+        "has_carrier": {
+          "type": "boolean"
         },
-        "io_perf": {
-          "$ref": "#/definitions/io_perf"
+        "ipv4_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "is_installation_media": {
-          "description": "Whether the disk appears to be an installation media or not",
-          "type": "boolean"
+        "ipv6_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "model": {
+        "mac_address": {
           "type": "string"
         },
-        "name": {
-          "type": "string"
+        "mtu": {
+          "type": "integer"
         },
-        "path": {
+        "name": {
           "type": "string"
         },
-        "serial": {
+        "product": {
           "type": "string"
         },
-        "size_bytes": {
+        "speed_mbps": {
           "type": "integer"
         },
-        "smart": {
-          "type": "string"
-        },
         "vendor": {
           "type": "string"
-        },
-        "wwn": {
-          "type": "string"
         }
       }
     },
-    "disk-config-params": {
+    "inventory": {
       "type": "object",
-      "required": [
-        "id"
-      ],
       "properties": {
-        "id": {
+        "bmc_address": {
           "type": "string"
         },
-        "role": {
-          "$ref": "#/definitions/disk-role"
-        }
-      }
-    },
-    "disk-role": {
-      "type": "string",
-      "enum": [
-        "none",
-        "install"
-      ]
-    },
-    "disk_info": {
-      "type": "object",
-      "properties": {
-        "disk_speed": {
-          "$ref": "#/definitions/disk_speed"
+        "bmc_v6address": {
+          "type": "string"
         },
-        "id": {
-          "type": "string",
-          "format": "uuid"
+        "boot": {
+          "$ref": "#/definitions/boot"
         },
-        "path": {
+        "cpu": {
+          "$ref": "#/definitions/cpu"
+        },
+        "disks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk"
+          }
+        },
+        "gpus": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/gpu"
+          }
+        },
+        "hostname": {
           "type": "string"
+        },
+        "interfaces": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/interface"
+          }
+        },
+        "memory": {
+          "$ref": "#/definitions/memory"
+        },
+        "routes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/route"
+          }
+        },
+        "system_vendor": {
+          "$ref": "#/definitions/system_vendor"
+        },
+        "timestamp": {
+          "type": "integer"
         }
       }
     },
-    "disk_speed": {
+    "io_perf": {
       "type": "object",
       "properties": {
-        "exit_code": {
-          "type": "integer"
-        },
-        "speed_ms": {
+        "sync_duration": {
+          "description": "99th percentile of fsync duration in milliseconds",
           "type": "integer"
-        },
-        "tested": {
-          "type": "boolean"
         }
       }
     },
-    "disk_speed_check_request": {
+    "kernel_argument": {
       "type": "object",
-      "required": [
-        "path"
-      ],
       "properties": {
-        "path": {
-          "description": "--filename argument for fio (expects a file or a block device path).",
+        "operation": {
+          "description": "The operation to apply on the kernel arguments of the discovery image.",
+          "type": "string",
+          "enum": [
+            "append",
+            "replace",
+            "delete"
+          ]
+        },
+        "value": {
+          "description": "Kernel argument, such as console=ttyS1,115200. A replace operation replaces the arguments with the same key. A delete operation removes the arguments with the same key when only a key is given, and the identical arguments otherwise.",
           "type": "string"
         }
       }
     },
-    "disk_speed_check_response": {
+    "kernel_arguments": {
+      "description": "List of kernel argument operations, which are applied in order to the kernel arguments of the discovery image.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/kernel_argument"
+      }
+    },
+    "l2-connectivity": {
       "type": "object",
       "properties": {
-        "io_sync_duration": {
-          "description": "The 99th percentile of fdatasync durations in milliseconds.",
-          "type": "integer"
+        "outgoing_ip_address": {
+          "type": "string"
         },
-        "path": {
-          "description": "The device path.",
+        "outgoing_nic": {
+          "type": "string"
+        },
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "remote_mac": {
           "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "domain_resolution_request": {
+    "l3-connectivity": {
       "type": "object",
-      "required": [
-        "domains"
-      ],
       "properties": {
-        "domains": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "required": [
-              "domain_name"
-            ],
-            "properties": {
-              "domain_name": {
-                "description": "The domain name that should be resolved",
-                "type": "string"
-              }
-            },
-            "x-go-name": "DomainResolutionRequestDomain"
-          }
+        "average_rtt_ms": {
+          "description": "Average round trip time in milliseconds.",
+          "type": "number",
+          "format": "double",
+          "x-go-name": "AverageRTTMs"
+        },
+        "outgoing_nic": {
+          "type": "string"
+        },
+        "packet_loss_percentage": {
+          "description": "Percentage of packets lost during connectivity check.",
+          "type": "number",
+          "format": "double"
+        },
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "domain_resolution_response": {
-      "type": "object",
-      "required": [
-        "resolutions"
-      ],
-      "properties": {
-        "resolutions": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "required": [
-              "domain_name"
-            ],
-            "properties": {
-              "domain_name": {
-                "description": "The domain that was resolved",
-                "type": "string"
-              },
-              "ipv4_addresses": {
-                "description": "The IPv4 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv4"
-                }
-              },
-              "ipv6_addresses": {
-                "description": "The IPv6 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv6"
-                }
-              }
-            },
-            "x-go-name": "DomainResolutionResponseDomain"
-          }
-        }
+    "list-managed-domains": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/managed-domain"
       }
     },
-    "error": {
+    "list-manifests": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/manifest"
+      }
+    },
+    "list-versions": {
       "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "code",
-        "reason"
-      ],
       "properties": {
-        "code": {
-          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
-          "type": "string"
-        },
-        "href": {
-          "description": "Self link.",
+        "release_tag": {
           "type": "string"
         },
-        "id": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 504,
-          "minimum": 400
-        },
-        "kind": {
-          "description": "Indicates the type of this object. Will always be 'Error'.",
-          "type": "string",
-          "enum": [
-            "Error"
-          ]
-        },
-        "reason": {
-          "description": "Human-readable description of the error.",
-          "type": "string"
+        "versions": {
+          "$ref": "#/definitions/versions"
         }
       }
     },
-    "event": {
+    "log-finding-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/log_finding"
+      }
+    },
+    "log_finding": {
+      "description": "A known failure signature that was found in the uploaded logs of a cluster or of one of its hosts.",
       "type": "object",
-      "required": [
-        "cluster_id",
-        "severity",
-        "message",
-        "event_time"
-      ],
       "properties": {
-        "category": {
+        "analyzed_at": {
+          "description": "The time the logs were analyzed.",
           "type": "string",
-          "enum": [
-            "user",
-            "metrics"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'user'\""
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
         "cluster_id": {
-          "description": "Unique identifier of the cluster this event relates to.",
+          "description": "Unique identifier of the cluster whose logs contain the failure.",
           "type": "string",
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"index\""
         },
-        "event_time": {
+        "description": {
+          "description": "Human readable description of the failure.",
+          "type": "string"
+        },
+        "excerpt": {
+          "description": "The first log line that matched the failure signature.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "file": {
+          "description": "The file in the logs archive that contains the first matching line.",
+          "type": "string"
         },
         "host_id": {
-          "description": "Unique identifier of the host this event relates to.",
+          "description": "Unique identifier of the host whose logs contain the failure. Empty for controller logs.",
           "type": "string",
           "format": "uuid"
         },
-        "message": {
+        "id": {
+          "description": "Unique identifier of the finding.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "props": {
-          "description": "Additional properties for the event in JSON format.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "logs_type": {
+          "$ref": "#/definitions/logs_type"
         },
-        "request_id": {
-          "description": "Unique identifier of the request that caused this event to occur.",
-          "type": "string",
-          "format": "uuid"
+        "match_count": {
+          "description": "Number of log lines that matched the failure signature.",
+          "type": "integer"
         },
         "severity": {
           "type": "string",
@@ -6153,1490 +9203,2575 @@ func init() {
             "error",
             "critical"
           ]
+        },
+        "signature_id": {
+          "description": "Identifier of the failure signature in the signature catalogue.",
+          "type": "string"
         }
       }
     },
-    "event-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/event"
-      }
-    },
-    "free-addresses-list": {
+    "logs-archive-entry-list": {
       "type": "array",
       "items": {
-        "type": "string",
-        "format": "ipv4"
+        "$ref": "#/definitions/logs_archive_entry"
       }
     },
-    "free_addresses_request": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+    "logs-progress-params": {
+      "type": "object",
+      "required": [
+        "logs_state"
+      ],
+      "properties": {
+        "logs_state": {
+          "description": "The state of collecting logs.",
+          "$ref": "#/definitions/logs_state"
+        }
       }
     },
-    "free_network_addresses": {
+    "logs_archive_entry": {
+      "description": "A file inside a logs archive.",
       "type": "object",
       "properties": {
-        "free_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string",
-            "format": "ipv4"
-          }
-        },
-        "network": {
+        "modified_at": {
+          "description": "The modification time of the file.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "format": "date-time"
+        },
+        "name": {
+          "description": "The path of the file inside the logs archive. Files of nested archives are prefixed by the path of the nested archive, and compressed files are named without their .gz suffix.",
+          "type": "string"
+        },
+        "size": {
+          "description": "The uncompressed size of the file in bytes.",
+          "type": "integer"
         }
       }
     },
-    "free_networks_addresses": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/free_network_addresses"
-      }
-    },
-    "gpu": {
+    "logs_search_match": {
+      "description": "A line of a log file that matched a search.",
       "type": "object",
       "properties": {
-        "address": {
-          "description": "Device address (for example \"0000:00:02.0\")",
+        "line": {
+          "description": "The matching line.",
           "type": "string"
         },
-        "device_id": {
-          "description": "ID of the device (for example \"3ea0\")",
-          "type": "string"
+        "line_number": {
+          "description": "The number of the matching line in the file, starting from 1.",
+          "type": "integer"
         },
         "name": {
-          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
-          "type": "string"
-        },
-        "vendor": {
-          "description": "The name of the device vendor (for example \"Intel Corporation\")",
-          "type": "string"
-        },
-        "vendor_id": {
-          "description": "ID of the vendor (for example \"8086\")",
+          "description": "The name of the file inside the logs archive.",
           "type": "string"
         }
       }
     },
-    "host": {
+    "logs_search_result": {
       "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "status",
-        "status_info"
-      ],
       "properties": {
-        "api_vip_connectivity": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "bootstrap": {
+        "archive_truncated": {
+          "description": "Whether the archive was only searched up to the size limit of the service.",
           "type": "boolean"
         },
-        "checked_in_at": {
-          "description": "The last time the host's agent communicated with the service.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "matches": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/logs_search_match"
+          }
+        },
+        "truncated": {
+          "description": "Whether there were more matching lines than the requested maximum.",
+          "type": "boolean"
+        }
+      }
+    },
+    "logs_state": {
+      "type": "string",
+      "enum": [
+        "requested",
+        "collecting",
+        "completed",
+        "timeout",
+        ""
+      ]
+    },
+    "logs_type": {
+      "type": "string",
+      "enum": [
+        "host",
+        "controller",
+        "all",
+        ""
+      ]
+    },
+    "logs_upload": {
+      "description": "A resumable upload of a logs archive of a cluster or of one of its hosts.",
+      "type": "object",
+      "properties": {
+        "chunk_size": {
+          "description": "The size in bytes of every chunk but the last one.",
+          "type": "integer"
         },
         "cluster_id": {
-          "description": "The cluster that this host is associated with.",
+          "description": "The cluster whose logs are uploaded.",
           "type": "string",
           "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
-        },
-        "connectivity": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "x-go-custom-tag": "gorm:\"index\""
         },
         "created_at": {
+          "description": "The time the upload was started.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "deleted_at": {
-          "description": "The time that the host was deleted.",
+        "host_id": {
+          "description": "The host whose logs are uploaded. Empty for controller logs.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-nullable": true
-        },
-        "discovery_agent_version": {
-          "type": "string"
+          "format": "uuid"
         },
-        "disks_info": {
-          "description": "Additional information about disks, formatted as JSON.",
+        "id": {
+          "description": "Unique identifier of the upload.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "free_addresses": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "logs_type": {
+          "$ref": "#/definitions/logs_type"
         },
-        "href": {
-          "description": "Self link.",
+        "received_ranges": {
+          "description": "The byte ranges of the logs archive that were received, in ascending order.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/logs_upload_range"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "sha256": {
+          "description": "The hex encoded SHA-256 digest of the logs archive.",
           "type": "string"
         },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key\""
+        "total_size": {
+          "description": "The size of the logs archive in bytes.",
+          "type": "integer"
         },
-        "ignition_config_overrides": {
-          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
+        "updated_at": {
+          "description": "The last time a chunk was received. Uploads that are not updated are deleted after a while.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        }
+      }
+    },
+    "logs_upload_range": {
+      "description": "A range of bytes of a logs archive.",
+      "type": "object",
+      "properties": {
+        "end": {
+          "description": "The offset of the last byte of the range.",
+          "type": "integer"
         },
-        "images_status": {
-          "description": "Array of image statuses.",
+        "start": {
+          "description": "The offset of the first byte of the range.",
+          "type": "integer"
+        }
+      }
+    },
+    "mac_interface_map": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "logical_nic_name": {
+            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
+            "type": "string"
+          },
+          "mac_address": {
+            "description": "mac address present on the host",
+            "type": "string",
+            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
+          }
+        }
+      }
+    },
+    "mac_range": {
+      "type": "object",
+      "properties": {
+        "end": {
+          "description": "The last MAC address of the range.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "installation_disk_id": {
-          "description": "Contains the inventory disk id to install on.",
-          "type": "string"
+          "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
         },
-        "installation_disk_path": {
-          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
+        "start": {
+          "description": "The first MAC address of the range.",
           "type": "string",
-          "example": "/dev/sda"
+          "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
+        }
+      }
+    },
+    "machine_network": {
+      "description": "A network that all hosts belonging to the cluster should have an interface with IP address in.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "The IP block address pool for machines within the cluster.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "installer_args": {
-          "type": "string"
+        "cluster_id": {
+          "description": "The cluster that this network is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
         },
-        "installer_version": {
-          "description": "Installer version.",
+        "index": {
+          "description": "The position of the network in the machine networks of the cluster. The network at position 0 is the primary one. Set by the service.",
+          "type": "integer"
+        }
+      }
+    },
+    "managed-domain": {
+      "type": "object",
+      "properties": {
+        "domain": {
           "type": "string"
         },
-        "inventory": {
+        "provider": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "enum": [
+            "route53"
+          ]
+        }
+      }
+    },
+    "manifest": {
+      "type": "object",
+      "properties": {
+        "file_name": {
+          "description": "The file name prefaced by the folder that contains it.",
+          "type": "string"
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
+        "folder": {
+          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
           "type": "string",
           "enum": [
-            "Host",
-            "AddToExistingClusterHost"
+            "manifests",
+            "openshift"
           ]
+        }
+      }
+    },
+    "memory": {
+      "type": "object",
+      "properties": {
+        "physical_bytes": {
+          "type": "integer"
         },
-        "logs_collected_at": {
+        "usable_bytes": {
+          "type": "integer"
+        }
+      }
+    },
+    "monitored-operator": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that this operator is associated with.",
           "type": "string",
-          "format": "datetime",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
-          "$ref": "#/definitions/logs_state"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
         },
-        "logs_started_at": {
+        "name": {
+          "description": "Unique name of the operator.",
           "type": "string",
-          "format": "datetime",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "machine_config_pool_name": {
+        "namespace": {
+          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
           "type": "string"
         },
-        "ntp_sources": {
-          "description": "The configured NTP sources on the host.",
+        "operator_type": {
+          "$ref": "#/definitions/operator-type"
+        },
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "progress": {
-          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:progress_\"",
-          "$ref": "#/definitions/host-progress-info"
-        },
-        "progress_stages": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host-stage"
-          },
-          "x-go-custom-tag": "gorm:\"-\""
+        "status": {
+          "$ref": "#/definitions/operator-status"
         },
-        "requested_hostname": {
+        "status_info": {
+          "description": "Detailed information about the operator state.",
           "type": "string"
         },
-        "role": {
-          "$ref": "#/definitions/host-role"
-        },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
+        "status_updated_at": {
+          "description": "Time at which the operator was last updated.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "subscription_name": {
+          "description": "The name of the subscription of the operator.",
+          "type": "string"
         },
-        "status": {
-          "type": "string",
-          "enum": [
-            "discovering",
-            "known",
-            "disconnected",
-            "insufficient",
-            "disabled",
-            "preparing-for-installation",
-            "preparing-successful",
-            "pending-for-input",
-            "installing",
-            "installing-in-progress",
-            "installing-pending-user-action",
-            "resetting-pending-user-action",
-            "installed",
-            "error",
-            "resetting",
-            "added-to-existing-cluster",
-            "cancelled"
-          ]
+        "timeout_seconds": {
+          "description": "Positive number represents a timeout in seconds for the operator to be available.",
+          "type": "integer"
+        }
+      }
+    },
+    "monitored-operators-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/monitored-operator"
+      }
+    },
+    "new-logs-upload-params": {
+      "type": "object",
+      "required": [
+        "logs_type",
+        "total_size",
+        "chunk_size"
+      ],
+      "properties": {
+        "chunk_size": {
+          "description": "The size in bytes of every chunk but the last one.",
+          "type": "integer",
+          "maximum": 104857600,
+          "minimum": 1
         },
-        "status_info": {
+        "host_id": {
+          "description": "The host whose logs are uploaded, required for host logs.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+          "format": "uuid"
         },
-        "status_updated_at": {
-          "description": "The last time that the host status was updated.",
+        "logs_type": {
+          "description": "The type of the logs archive.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "enum": [
+            "host",
+            "controller"
+          ]
         },
-        "updated_at": {
+        "sha256": {
+          "description": "The hex encoded SHA-256 digest of the logs archive, verified when the upload is completed.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "pattern": "^[0-9a-fA-F]{64}$"
         },
-        "user_name": {
+        "total_size": {
+          "description": "The size of the logs archive in bytes.",
+          "type": "integer",
+          "minimum": 1
+        }
+      }
+    },
+    "ntp_source": {
+      "type": "object",
+      "properties": {
+        "source_name": {
+          "description": "NTP source name or IP.",
           "type": "string"
         },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "source_state": {
+          "description": "Indication of state of an NTP source.",
+          "$ref": "#/definitions/source_state"
         }
       }
     },
-    "host-create-params": {
+    "ntp_synchronization_request": {
       "type": "object",
       "required": [
-        "host_id"
+        "ntp_source"
       ],
       "properties": {
-        "discovery_agent_version": {
+        "ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
           "type": "string"
-        },
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
         }
       }
     },
-    "host-ignition-params": {
+    "ntp_synchronization_response": {
       "properties": {
-        "config": {
+        "ntp_sources": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ntp_source"
+          }
+        }
+      }
+    },
+    "openshift-version": {
+      "type": "object",
+      "required": [
+        "display_name",
+        "release_image",
+        "release_version",
+        "rhcos_image",
+        "rhcos_rootfs",
+        "rhcos_version",
+        "support_level"
+      ],
+      "properties": {
+        "default": {
+          "description": "Indication that the version is the recommended one.",
+          "type": "boolean"
+        },
+        "display_name": {
+          "description": "Name of the version to be presented to the user.",
+          "type": "string"
+        },
+        "release_image": {
+          "description": "The installation image of the OpenShift cluster.",
+          "type": "string"
+        },
+        "release_version": {
+          "description": "OCP version from the release metadata.",
+          "type": "string"
+        },
+        "rhcos_image": {
+          "description": "The base RHCOS image used for the discovery iso.",
+          "type": "string"
+        },
+        "rhcos_image_sha256": {
+          "description": "SHA-256 digest of the base RHCOS image, which is verified before the image is used.",
+          "type": "string",
+          "pattern": "^[a-f0-9]{64}$"
+        },
+        "rhcos_rootfs": {
+          "description": "The RHCOS rootfs url.",
+          "type": "string"
+        },
+        "rhcos_version": {
+          "description": "Build ID of the RHCOS image.",
           "type": "string"
+        },
+        "support_level": {
+          "description": "Level of support of the version.",
+          "type": "string",
+          "enum": [
+            "beta",
+            "production",
+            "custom"
+          ]
         }
       }
     },
-    "host-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/host"
+    "openshift-versions": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/openshift-version"
       }
     },
-    "host-progress": {
+    "operator-create-params": {
       "type": "object",
-      "required": [
-        "current_stage"
-      ],
       "properties": {
-        "current_stage": {
-          "type": "string",
-          "$ref": "#/definitions/host-stage"
+        "name": {
+          "type": "string"
         },
-        "progress_info": {
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
-    "host-progress-info": {
+    "operator-hardware-requirements": {
       "type": "object",
-      "required": [
-        "current_stage"
-      ],
       "properties": {
-        "current_stage": {
-          "type": "string",
-          "$ref": "#/definitions/host-stage"
+        "dependencies": {
+          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "progress_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "operator_name": {
+          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
+          "type": "string"
         },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "requirements": {
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        }
+      }
+    },
+    "operator-host-requirements": {
+      "type": "object",
+      "properties": {
+        "operator_name": {
+          "description": "Name of the operator",
+          "type": "string"
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "requirements": {
+          "description": "Host requirements for the operator",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "host-requirements": {
+    "operator-monitor-report": {
       "type": "object",
       "properties": {
-        "master": {
-          "$ref": "#/definitions/host-requirements-role"
+        "name": {
+          "description": "Unique name of the operator.",
+          "type": "string"
         },
-        "worker": {
-          "$ref": "#/definitions/host-requirements-role"
+        "status": {
+          "$ref": "#/definitions/operator-status"
+        },
+        "status_info": {
+          "description": "Detailed information about the operator state.",
+          "type": "string"
         }
       }
     },
-    "host-requirements-role": {
+    "operator-properties": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/operator-property"
+      }
+    },
+    "operator-property": {
       "type": "object",
       "properties": {
-        "cpu_cores": {
-          "type": "integer"
+        "data_type": {
+          "description": "Type of the property",
+          "type": "string",
+          "enum": [
+            "boolean",
+            "string",
+            "integer",
+            "float"
+          ]
         },
-        "disk_size_gb": {
-          "type": "integer"
+        "default_value": {
+          "description": "Default value for the property",
+          "type": "string"
         },
-        "installation_disk_speed_threshold_ms": {
-          "type": "integer"
+        "description": {
+          "description": "Description of a property",
+          "type": "string"
         },
-        "ram_gib": {
-          "type": "integer"
+        "mandatory": {
+          "description": "Indicates whether the property is reqired",
+          "type": "boolean"
+        },
+        "name": {
+          "description": "Name of the property",
+          "type": "string"
+        },
+        "options": {
+          "description": "Values to select from",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "host-role": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "worker",
-        "bootstrap"
-      ]
-    },
-    "host-role-update-params": {
+    "operator-status": {
+      "description": "Represents the operator state.",
       "type": "string",
       "enum": [
-        "auto-assign",
-        "master",
-        "worker"
+        "failed",
+        "progressing",
+        "available"
       ]
     },
-    "host-stage": {
+    "operator-type": {
+      "description": "Kind of operator. Different types are monitored by the service differently.",
       "type": "string",
       "enum": [
-        "Starting installation",
-        "Waiting for control plane",
-        "Waiting for bootkube",
-        "Waiting for controller",
-        "Installing",
-        "Writing image to disk",
-        "Rebooting",
-        "Waiting for ignition",
-        "Configuring",
-        "Joined",
-        "Done",
-        "Failed"
+        "builtin",
+        "olm"
       ]
     },
-    "host-type-hardware-requirements": {
+    "preflight-hardware-requirements": {
       "type": "object",
       "properties": {
-        "qualitative": {
-          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
+        "ocp": {
+          "description": "Preflight OCP requirements",
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        },
+        "operators": {
+          "description": "Preflight operators hardware requirements",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/operator-hardware-requirements"
           }
-        },
-        "quantitative": {
-          "description": "Host requirements that can be quantified",
-          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "host-type-hardware-requirements-wrapper": {
+    "presigned": {
       "type": "object",
+      "required": [
+        "url"
+      ],
       "properties": {
-        "master": {
-          "description": "Requirements towards a master node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
-        },
-        "worker": {
-          "description": "Requirements towards a worker node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
+        "url": {
+          "type": "string"
         }
       }
     },
-    "host-validation-id": {
-      "type": "string",
-      "enum": [
-        "connected",
-        "has-inventory",
-        "has-min-cpu-cores",
-        "has-min-valid-disks",
-        "has-min-memory",
-        "machine-cidr-defined",
-        "has-cpu-cores-for-role",
-        "has-memory-for-role",
-        "hostname-unique",
-        "hostname-valid",
-        "belongs-to-machine-cidr",
-        "api-vip-connected",
-        "belongs-to-majority-group",
-        "valid-platform",
-        "ntp-synced",
-        "container-images-available",
-        "lso-requirements-satisfied",
-        "ocs-requirements-satisfied",
-        "sufficient-installation-disk-speed",
-        "cnv-requirements-satisfied",
-        "sufficient-network-latency-requirement-for-role",
-        "sufficient-packet-loss-requirement-for-role"
-      ]
-    },
-    "host_network": {
+    "quota": {
+      "description": "The usage and limits of an organization or a user. The limits are the default limits of the service unless the owner has limits of its own.",
       "type": "object",
+      "required": [
+        "scope",
+        "owner",
+        "usage",
+        "limits"
+      ],
       "properties": {
-        "cidr": {
+        "limits": {
+          "$ref": "#/definitions/quota-limits"
+        },
+        "owner": {
+          "description": "The ID of the organization or the name of the user.",
           "type": "string"
         },
-        "host_ids": {
-          "type": "array",
-          "items": {
-            "type": "string",
-            "format": "uuid"
-          }
+        "scope": {
+          "description": "Whether the owner is an organization or a user.",
+          "type": "string",
+          "enum": [
+            "org",
+            "user"
+          ]
+        },
+        "usage": {
+          "$ref": "#/definitions/quota-usage"
         }
       }
     },
-    "host_registration_response": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/host"
+    "quota-limits": {
+      "type": "object",
+      "properties": {
+        "max_clusters": {
+          "description": "The maximal number of active clusters, 0 for unlimited.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
         },
-        {
-          "properties": {
-            "next_step_runner_command": {
-              "description": "Command for starting the next step runner",
-              "type": "object",
-              "properties": {
-                "args": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                },
-                "command": {
-                  "type": "string"
-                },
-                "retry_seconds": {
-                  "description": "How long in seconds to wait before retrying registration if the command fails",
-                  "type": "integer"
-                }
-              }
-            }
-          }
+        "max_images": {
+          "description": "The maximal number of stored discovery images, 0 for unlimited.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "max_storage_bytes": {
+          "description": "The maximal size of the stored discovery images and logs, 0 for unlimited.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
         }
-      ]
+      }
     },
-    "host_static_network_config": {
+    "quota-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/quota"
+      }
+    },
+    "quota-usage": {
       "type": "object",
       "properties": {
-        "mac_interface_map": {
-          "description": "mapping of host macs to logical interfaces used in the network yaml",
-          "$ref": "#/definitions/mac_interface_map"
+        "clusters": {
+          "description": "The number of active clusters, i.e. the clusters that were not installed yet.",
+          "type": "integer",
+          "format": "int64"
         },
-        "network_yaml": {
-          "description": "yaml string that can be processed by nmstate",
-          "type": "string"
+        "images": {
+          "description": "The number of stored discovery images, including the images of declared hosts.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "storage_bytes": {
+          "description": "The size of the stored discovery images and logs.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "image-create-params": {
+    "route": {
       "type": "object",
       "properties": {
-        "image_type": {
-          "description": "Type of image that should be generated.",
-          "$ref": "#/definitions/image_type"
+        "destination": {
+          "description": "The destination network or destination host",
+          "type": "string"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
+        "family": {
+          "description": "Defines whether this is an IPv4 (4) or IPv6 route (6)",
+          "type": "integer",
+          "format": "int32"
+        },
+        "gateway": {
+          "description": "Gateway address where the packets are sent",
           "type": "string"
         },
-        "static_network_config": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_static_network_config"
-          }
+        "interface": {
+          "description": "Interface to which packets for this route will be sent",
+          "type": "string"
         }
       }
     },
-    "image_info": {
+    "service_network": {
+      "description": "IP address block for service IP blocks.",
       "type": "object",
       "properties": {
-        "created_at": {
+        "cidr": {
+          "description": "The IP block address pool.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "download_url": {
-          "type": "string"
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "expires_at": {
+        "cluster_id": {
+          "description": "The cluster that this network is associated with.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "generator_version": {
-          "description": "Image generator version.",
-          "type": "string"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
         },
-        "size_bytes": {
+        "index": {
+          "description": "The position of the network in the service networks of the cluster. The network at position 0 is the primary one. Set by the service.",
           "type": "integer"
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string"
-        },
-        "static_network_config": {
-          "description": "static network configuration string in the format expected by discovery ignition generation",
-          "type": "string"
-        },
-        "type": {
-          "$ref": "#/definitions/image_type"
         }
       }
     },
-    "image_type": {
+    "source_state": {
       "type": "string",
       "enum": [
-        "full-iso",
-        "minimal-iso"
+        "synced",
+        "combined",
+        "not_combined",
+        "error",
+        "variable",
+        "unreachable"
       ]
     },
-    "infra_error": {
+    "static_ip_allocation": {
+      "description": "An address that was allocated to a host from the static IP pool of a cluster.",
       "type": "object",
-      "required": [
-        "code",
-        "message"
-      ],
       "properties": {
-        "code": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 403,
-          "minimum": 401
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "message": {
-          "description": "Human-readable description of the error.",
+        "ip": {
+          "description": "The address allocated to the host.",
           "type": "string"
+        },
+        "mac_address": {
+          "description": "The MAC address that identifies the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
         }
       }
     },
-    "ingress-cert-params": {
-      "type": "string"
-    },
-    "installer-args-params": {
+    "static_ip_pool": {
       "type": "object",
       "properties": {
-        "args": {
-          "description": "List of additional arguments passed to coreos-installer",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "--append-karg",
-            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
-            "--save-partindex",
-            "1",
-            "-n"
+        "bond_mode": {
+          "description": "When set, the NICs of each host are bonded with this mode.",
+          "type": "string",
+          "enum": [
+            "balance-rr",
+            "active-backup",
+            "balance-xor",
+            "broadcast",
+            "802.3ad",
+            "balance-tlb",
+            "balance-alb"
           ]
-        }
-      }
-    },
-    "interface": {
-      "type": "object",
-      "properties": {
-        "biosdevname": {
-          "type": "string"
         },
-        "client_id": {
-          "type": "string"
+        "cidr": {
+          "description": "The network from which the host addresses are allocated.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
-        "flags": {
+        "dns_servers": {
+          "description": "The DNS servers of the hosts.",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "has_carrier": {
-          "type": "boolean"
+        "gateway": {
+          "description": "The default gateway of the hosts.",
+          "type": "string"
         },
-        "ipv4_addresses": {
+        "hosts": {
+          "description": "The hosts that get an address from the pool.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/static_ip_pool_host"
           }
         },
-        "ipv6_addresses": {
+        "mac_ranges": {
+          "description": "Ranges of MAC addresses of single NIC hosts that get an address from the pool, one host per MAC address.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/mac_range"
           }
         },
-        "mac_address": {
-          "type": "string"
-        },
-        "mtu": {
-          "type": "integer"
-        },
-        "name": {
+        "range_end": {
+          "description": "The last address that may be allocated. Defaults to the last host address of the network.",
           "type": "string"
         },
-        "product": {
+        "range_start": {
+          "description": "The first address that may be allocated. Defaults to the first host address of the network.",
           "type": "string"
         },
-        "speed_mbps": {
-          "type": "integer"
-        },
-        "vendor": {
-          "type": "string"
+        "vlan_id": {
+          "description": "When set, the host addresses are configured on a VLAN interface with this ID.",
+          "type": "integer",
+          "maximum": 4094,
+          "minimum": 1
         }
       }
     },
-    "inventory": {
+    "static_ip_pool_host": {
       "type": "object",
       "properties": {
-        "bmc_address": {
-          "type": "string"
-        },
-        "bmc_v6address": {
-          "type": "string"
-        },
-        "boot": {
-          "$ref": "#/definitions/boot"
-        },
-        "cpu": {
-          "$ref": "#/definitions/cpu"
-        },
-        "disks": {
+        "mac_addresses": {
+          "description": "The MAC addresses of the host NICs. The first one identifies the host in the address allocation.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/disk"
+            "type": "string",
+            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
           }
-        },
-        "gpus": {
+        }
+      }
+    },
+    "step": {
+      "type": "object",
+      "properties": {
+        "args": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/gpu"
+            "type": "string"
           }
         },
-        "hostname": {
+        "command": {
           "type": "string"
         },
-        "interfaces": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/interface"
-          }
+        "step_id": {
+          "type": "string"
         },
-        "memory": {
-          "$ref": "#/definitions/memory"
+        "step_type": {
+          "$ref": "#/definitions/step-type"
+        }
+      }
+    },
+    "step-reply": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string"
         },
-        "routes": {
+        "exit_code": {
+          "type": "integer"
+        },
+        "output": {
+          "type": "string"
+        },
+        "step_id": {
+          "type": "string"
+        },
+        "step_type": {
+          "$ref": "#/definitions/step-type"
+        }
+      }
+    },
+    "step-type": {
+      "type": "string",
+      "enum": [
+        "connectivity-check",
+        "execute",
+        "inventory",
+        "install",
+        "free-network-addresses",
+        "reset-installation",
+        "dhcp-lease-allocate",
+        "api-vip-connectivity-check",
+        "ntp-synchronizer",
+        "installation-disk-speed-check",
+        "container-image-availability",
+        "domain-resolution",
+        "upgrade-agent"
+      ]
+    },
+    "steps": {
+      "type": "object",
+      "properties": {
+        "instructions": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/route"
-          }
-        },
-        "system_vendor": {
-          "$ref": "#/definitions/system_vendor"
+            "$ref": "#/definitions/step"
+          }
         },
-        "timestamp": {
+        "next_instruction_seconds": {
           "type": "integer"
+        },
+        "post_step_action": {
+          "description": "What to do after finishing to run step instructions",
+          "type": "string",
+          "default": "continue",
+          "enum": [
+            "exit",
+            "continue"
+          ]
         }
       }
     },
-    "io_perf": {
-      "type": "object",
-      "properties": {
-        "sync_duration": {
-          "description": "99th percentile of fsync duration in milliseconds",
-          "type": "integer"
-        }
+    "steps-reply": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/step-reply"
       }
     },
-    "l2-connectivity": {
+    "system_vendor": {
       "type": "object",
       "properties": {
-        "outgoing_ip_address": {
-          "type": "string"
-        },
-        "outgoing_nic": {
+        "manufacturer": {
           "type": "string"
         },
-        "remote_ip_address": {
+        "product_name": {
           "type": "string"
         },
-        "remote_mac": {
+        "serial_number": {
           "type": "string"
         },
-        "successful": {
+        "virtual": {
+          "description": "Whether the machine appears to be a virtual machine or not",
           "type": "boolean"
         }
       }
     },
-    "l3-connectivity": {
+    "upgrade_agent_request": {
       "type": "object",
+      "required": [
+        "agent_image"
+      ],
       "properties": {
-        "average_rtt_ms": {
-          "description": "Average round trip time in milliseconds.",
-          "type": "number",
-          "format": "double",
-          "x-go-name": "AverageRTTMs"
-        },
-        "outgoing_nic": {
+        "agent_image": {
+          "description": "Full image reference of the image that the agent should use.",
           "type": "string"
-        },
-        "packet_loss_percentage": {
-          "description": "Percentage of packets lost during connectivity check.",
-          "type": "number",
-          "format": "double"
-        },
-        "remote_ip_address": {
+        }
+      }
+    },
+    "upgrade_agent_response": {
+      "type": "object",
+      "properties": {
+        "agent_image": {
+          "description": "Full image reference of the image that the agent has upgraded to.",
           "type": "string"
         },
-        "successful": {
-          "type": "boolean"
+        "result": {
+          "$ref": "#/definitions/upgrade_agent_result"
         }
       }
     },
-    "list-managed-domains": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/managed-domain"
-      }
+    "upgrade_agent_result": {
+      "description": "Agent upgrade result.",
+      "type": "string",
+      "enum": [
+        "success",
+        "failure"
+      ]
     },
-    "list-manifests": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/manifest"
+    "usage": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "description": "additional properties of the feature",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
+        },
+        "name": {
+          "description": "name of the feature to track",
+          "type": "string"
+        }
       }
     },
-    "list-versions": {
+    "versioned-host-requirements": {
       "type": "object",
       "properties": {
-        "release_tag": {
+        "master": {
+          "description": "Master node requirements",
+          "x-go-name": "MasterRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "sno": {
+          "description": "Single node OpenShift node requirements",
+          "x-go-name": "SNORequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "version": {
+          "description": "Version of the component for which requirements are defined",
           "type": "string"
         },
-        "versions": {
-          "$ref": "#/definitions/versions"
+        "worker": {
+          "description": "Worker node requirements",
+          "x-go-name": "WorkerRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "logs-progress-params": {
+    "versions": {
       "type": "object",
-      "required": [
-        "logs_state"
-      ],
-      "properties": {
-        "logs_state": {
-          "description": "The state of collecting logs.",
-          "$ref": "#/definitions/logs_state"
-        }
+      "additionalProperties": {
+        "type": "string"
       }
+    }
+  },
+  "securityDefinitions": {
+    "agentAuth": {
+      "type": "apiKey",
+      "name": "X-Secret-Key",
+      "in": "header"
     },
-    "logs_state": {
-      "type": "string",
-      "enum": [
-        "requested",
-        "collecting",
-        "completed",
-        "timeout",
-        ""
-      ]
+    "urlAuth": {
+      "type": "apiKey",
+      "name": "api_key",
+      "in": "query"
     },
-    "logs_type": {
-      "type": "string",
-      "enum": [
-        "host",
-        "controller",
-        "all",
-        ""
+    "userAuth": {
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "userAuth": [
+        "admin",
+        "user"
       ]
+    }
+  ],
+  "tags": [
+    {
+      "description": "Agent-driven installation",
+      "name": "Assisted installation"
     },
-    "mac_interface_map": {
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "logical_nic_name": {
-            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
-            "type": "string"
+    {
+      "description": "ISO that contains the Assisted Service.",
+      "name": "assisted-service-iso"
+    },
+    {
+      "description": "Events related to a cluster installation.",
+      "name": "events"
+    },
+    {
+      "description": "General OpenShift cluster installation APIs.",
+      "name": "installer"
+    },
+    {
+      "description": "Managed dns domains for a cluster installation.",
+      "name": "managed_domains"
+    },
+    {
+      "description": "Manifests for customizing a cluster installation.",
+      "name": "manifests"
+    },
+    {
+      "description": "Information regarding supported operators.",
+      "name": "operators"
+    },
+    {
+      "description": "Information regarding versions.",
+      "name": "versions"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Assisted installation",
+    "title": "AssistedInstall",
+    "license": {
+      "name": "Apache 2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    },
+    "version": "1.0.0"
+  },
+  "host": "api.openshift.com",
+  "basePath": "/api/assisted-install/v1",
+  "paths": {
+    "/add_hosts_clusters": {
+      "post": {
+        "description": "Creates a new OpenShift cluster definition for adding nodes to and existing OCP cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RegisterAddHostsCluster",
+        "parameters": [
+          {
+            "description": "Parameters for creating a new cluster for adding nodes.",
+            "name": "new-add-hosts-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/add-hosts-cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
-          "mac_address": {
-            "description": "mac address present on the host",
-            "type": "string",
-            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "managed-domain": {
-      "type": "object",
-      "properties": {
-        "domain": {
-          "type": "string"
-        },
-        "provider": {
-          "type": "string",
-          "enum": [
-            "route53"
-          ]
-        }
-      }
-    },
-    "manifest": {
-      "type": "object",
-      "properties": {
-        "file_name": {
-          "description": "The file name prefaced by the folder that contains it.",
-          "type": "string"
-        },
-        "folder": {
-          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-          "type": "string",
-          "enum": [
-            "manifests",
-            "openshift"
-          ]
+    "/archived-clusters/{cluster_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves a cluster that was archived after its installation, together with its hosts, monitored operators and events.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetArchivedCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The archived cluster to be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/archived-cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "memory": {
-      "type": "object",
-      "properties": {
-        "physical_bytes": {
-          "type": "integer"
-        },
-        "usable_bytes": {
-          "type": "integer"
+    "/archived-clusters/{cluster_id}/actions/restore": {
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Restores an archived cluster with its hosts, monitored operators and events, and deletes the archive.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RestoreArchivedCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The archived cluster to be restored.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "monitored-operator": {
-      "type": "object",
-      "properties": {
-        "cluster_id": {
-          "description": "The cluster that this operator is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
-        },
-        "name": {
-          "description": "Unique name of the operator.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"primary_key\""
-        },
-        "namespace": {
-          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
-          "type": "string"
-        },
-        "operator_type": {
-          "$ref": "#/definitions/operator-type"
-        },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "status": {
-          "$ref": "#/definitions/operator-status"
-        },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
-        },
-        "status_updated_at": {
-          "description": "Time at which the operator was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "subscription_name": {
-          "description": "The name of the subscription of the operator.",
-          "type": "string"
-        },
-        "timeout_seconds": {
-          "description": "Positive number represents a timeout in seconds for the operator to be available.",
-          "type": "integer"
+    "/assisted-service-iso": {
+      "post": {
+        "description": "Creates ISO for the user and uploads to S3.",
+        "tags": [
+          "assisted-service-iso"
+        ],
+        "operationId": "CreateISOAndUploadToS3",
+        "parameters": [
+          {
+            "description": "Parameters for creating an Assisted Service ISO.",
+            "name": "assisted-service-iso-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/assisted-service-iso-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "monitored-operators-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/monitored-operator"
-      }
-    },
-    "ntp_source": {
-      "type": "object",
-      "properties": {
-        "source_name": {
-          "description": "NTP source name or IP.",
-          "type": "string"
-        },
-        "source_state": {
-          "description": "Indication of state of an NTP source.",
-          "$ref": "#/definitions/source_state"
+    "/assisted-service-iso/data": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Downloads the Assisted Service ISO.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "assisted-service-iso"
+        ],
+        "operationId": "DownloadISO",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "ntp_synchronization_request": {
-      "type": "object",
-      "required": [
-        "ntp_source"
-      ],
-      "properties": {
-        "ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
+    "/assisted-service-iso/presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves a pre-signed S3 URL for downloading assisted-service ISO.",
+        "tags": [
+          "assisted-service-iso"
+        ],
+        "operationId": "GetPresignedForAssistedServiceISO",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "ntp_synchronization_response": {
-      "properties": {
-        "ntp_sources": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ntp_source"
+    "/clusters": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift clusters.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ListClusters",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific cluster to retrieve.",
+            "name": "openshift_cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
+            "name": "ams_subscription_ids",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Creates a new OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RegisterCluster",
+        "parameters": [
+          {
+            "description": "The properties describing the new cluster.",
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "openshift-version": {
-      "type": "object",
-      "required": [
-        "display_name",
-        "release_image",
-        "release_version",
-        "rhcos_image",
-        "rhcos_rootfs",
-        "rhcos_version",
-        "support_level"
-      ],
-      "properties": {
-        "default": {
-          "description": "Indication that the version is the recommended one.",
-          "type": "boolean"
-        },
-        "display_name": {
-          "description": "Name of the version to be presented to the user.",
-          "type": "string"
-        },
-        "release_image": {
-          "description": "The installation image of the OpenShift cluster.",
-          "type": "string"
-        },
-        "release_version": {
-          "description": "OCP version from the release metadata.",
-          "type": "string"
-        },
-        "rhcos_image": {
-          "description": "The base RHCOS image used for the discovery iso.",
-          "type": "string"
-        },
-        "rhcos_rootfs": {
-          "description": "The RHCOS rootfs url.",
-          "type": "string"
-        },
-        "rhcos_version": {
-          "description": "Build ID of the RHCOS image.",
-          "type": "string"
-        },
-        "support_level": {
-          "description": "Level of support of the version.",
-          "type": "string",
-          "enum": [
-            "beta",
-            "production",
-            "custom"
-          ]
+    "/clusters/default-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the default values for various cluster properties.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetClusterDefaultConfig",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster_default_config"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "openshift-versions": {
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/openshift-version"
-      }
-    },
-    "operator-create-params": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "type": "string"
-        },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+    "/clusters/{cluster_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the details of the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving the cluster details.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "operator-hardware-requirements": {
-      "type": "object",
-      "properties": {
-        "dependencies": {
-          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "delete": {
+        "description": "Deletes an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DeregisterCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be deregistered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "operator_name": {
-          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
-          "type": "string"
-        },
-        "requirements": {
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
         }
-      }
-    },
-    "operator-host-requirements": {
-      "type": "object",
-      "properties": {
-        "operator_name": {
-          "description": "Name of the operator",
-          "type": "string"
-        },
-        "requirements": {
-          "description": "Host requirements for the operator",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+      },
+      "patch": {
+        "description": "Updates an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "operator-monitor-report": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "description": "Unique name of the operator.",
-          "type": "string"
-        },
-        "status": {
-          "$ref": "#/definitions/operator-status"
-        },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
+    "/clusters/{cluster_id}/actions/cancel": {
+      "post": {
+        "description": "Cancels an ongoing installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "CancelInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be canceled.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "operator-properties": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/operator-property"
-      }
-    },
-    "operator-property": {
-      "type": "object",
-      "properties": {
-        "data_type": {
-          "description": "Type of the property",
-          "type": "string",
-          "enum": [
-            "boolean",
-            "string",
-            "integer",
-            "float"
-          ]
-        },
-        "default_value": {
-          "description": "Default value for the property",
-          "type": "string"
-        },
-        "description": {
-          "description": "Description of a property",
-          "type": "string"
-        },
-        "mandatory": {
-          "description": "Indicates whether the property is reqired",
-          "type": "boolean"
-        },
-        "name": {
-          "description": "Name of the property",
-          "type": "string"
-        },
-        "options": {
-          "description": "Values to select from",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/clusters/{cluster_id}/actions/complete_installation": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to mark a finalizing installation as complete.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "CompleteInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is being completing.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The final status of the cluster installation.",
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is completing the installation.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "operator-status": {
-      "description": "Represents the operator state.",
-      "type": "string",
-      "enum": [
-        "failed",
-        "progressing",
-        "available"
-      ]
-    },
-    "operator-type": {
-      "description": "Kind of operator. Different types are monitored by the service differently.",
-      "type": "string",
-      "enum": [
-        "builtin",
-        "olm"
-      ]
-    },
-    "preflight-hardware-requirements": {
-      "type": "object",
-      "properties": {
-        "ocp": {
-          "description": "Preflight OCP requirements",
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
-        },
-        "operators": {
-          "description": "Preflight operators hardware requirements",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-hardware-requirements"
+    "/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "InstallCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be installed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "presigned": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "url": {
-          "type": "string"
-        }
-      }
-    },
-    "route": {
-      "type": "object",
-      "properties": {
-        "destination": {
-          "description": "The destination network or destination host",
-          "type": "string"
-        },
-        "family": {
-          "description": "Defines whether this is an IPv4 (4) or IPv6 route (6)",
-          "type": "integer",
-          "format": "int32"
-        },
-        "gateway": {
-          "description": "Gateway address where the packets are sent",
-          "type": "string"
-        },
-        "interface": {
-          "description": "Interface to which packets for this route will be sent",
-          "type": "string"
-        }
-      }
-    },
-    "source_state": {
-      "type": "string",
-      "enum": [
-        "synced",
-        "combined",
-        "not_combined",
-        "error",
-        "variable",
-        "unreachable"
-      ]
-    },
-    "step": {
-      "type": "object",
-      "properties": {
-        "args": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/clusters/{cluster_id}/actions/install_hosts": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "InstallHosts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The existing cluster whose hosts should be added.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "command": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
-        }
-      }
-    },
-    "step-reply": {
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "string"
-        },
-        "exit_code": {
-          "type": "integer"
-        },
-        "output": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "step-type": {
-      "type": "string",
-      "enum": [
-        "connectivity-check",
-        "execute",
-        "inventory",
-        "install",
-        "free-network-addresses",
-        "reset-installation",
-        "dhcp-lease-allocate",
-        "api-vip-connectivity-check",
-        "ntp-synchronizer",
-        "installation-disk-speed-check",
-        "container-image-availability",
-        "domain-resolution"
-      ]
-    },
-    "steps": {
-      "type": "object",
-      "properties": {
-        "instructions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/step"
+    "/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "description": "Resets a failed installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ResetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be reset.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "next_instruction_seconds": {
-          "type": "integer"
-        },
-        "post_step_action": {
-          "description": "What to do after finishing to run step instructions",
-          "type": "string",
-          "default": "continue",
-          "enum": [
-            "exit",
-            "continue"
-          ]
         }
       }
     },
-    "steps-reply": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/step-reply"
-      }
-    },
-    "system_vendor": {
-      "type": "object",
-      "properties": {
-        "manufacturer": {
-          "type": "string"
-        },
-        "product_name": {
-          "type": "string"
-        },
-        "serial_number": {
-          "type": "string"
-        },
-        "virtual": {
-          "description": "Whether the machine appears to be a virtual machine or not",
-          "type": "boolean"
+    "/clusters/{cluster_id}/credentials": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster admin credentials.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetCredentials",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose admin credentials should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/credentials"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "usage": {
-      "type": "object",
-      "properties": {
-        "data": {
-          "description": "additional properties of the feature",
-          "type": "object",
-          "additionalProperties": {
-            "type": "object"
+    "/clusters/{cluster_id}/declared-hosts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of declared hosts of the cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ListDeclaredHosts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose declared hosts should be listed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/declared-host-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "name": {
-          "description": "name of the feature to track",
-          "type": "string"
         }
-      }
-    },
-    "versioned-host-requirements": {
-      "type": "object",
-      "properties": {
-        "master": {
-          "description": "Master node requirements",
-          "x-go-name": "MasterRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "sno": {
-          "description": "Single node OpenShift node requirements",
-          "x-go-name": "SNORequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "version": {
-          "description": "Version of the component for which requirements are defined",
-          "type": "string"
-        },
-        "worker": {
-          "description": "Worker node requirements",
-          "x-go-name": "WorkerRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+      },
+      "post": {
+        "description": "Declares a host before it boots, so that a discovery image can be generated for it alone.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RegisterDeclaredHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that the host is declared in.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The description of the declared host.",
+            "name": "new-declared-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/declared-host-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/declared_host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "versions": {
-      "type": "object",
-      "additionalProperties": {
-        "type": "string"
-      }
-    }
-  },
-  "securityDefinitions": {
-    "agentAuth": {
-      "type": "apiKey",
-      "name": "X-Secret-Key",
-      "in": "header"
-    },
-    "urlAuth": {
-      "type": "apiKey",
-      "name": "api_key",
-      "in": "query"
-    },
-    "userAuth": {
-      "type": "apiKey",
-      "name": "Authorization",
-      "in": "header"
-    }
-  },
-  "security": [
-    {
-      "userAuth": [
-        "admin",
-        "user"
-      ]
-    }
-  ],
-  "tags": [
-    {
-      "description": "Agent-driven installation",
-      "name": "Assisted installation"
-    },
-    {
-      "description": "ISO that contains the Assisted Service.",
-      "name": "assisted-service-iso"
-    },
-    {
-      "description": "Events related to a cluster installation.",
-      "name": "events"
-    },
-    {
-      "description": "General OpenShift cluster installation APIs.",
-      "name": "installer"
-    },
-    {
-      "description": "Managed dns domains for a cluster installation.",
-      "name": "managed_domains"
-    },
-    {
-      "description": "Manifests for customizing a cluster installation.",
-      "name": "manifests"
-    },
-    {
-      "description": "Information regarding supported operators.",
-      "name": "operators"
-    },
-    {
-      "description": "Information regarding versions.",
-      "name": "versions"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Assisted installation",
-    "title": "AssistedInstall",
-    "license": {
-      "name": "Apache 2.0",
-      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
-    },
-    "version": "1.0.0"
-  },
-  "host": "api.openshift.com",
-  "basePath": "/api/assisted-install/v1",
-  "paths": {
-    "/add_hosts_clusters": {
-      "post": {
-        "description": "Creates a new OpenShift cluster definition for adding nodes to and existing OCP cluster.",
+    "/clusters/{cluster_id}/declared-hosts/{declared_host_id}": {
+      "delete": {
+        "description": "Deletes a declared host and its discovery image.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DeregisterDeclaredHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that the host is declared in.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The declared host that should be deleted.",
+            "name": "declared_host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/declared-hosts/{declared_host_id}/downloads/image": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads the Discovery ISO of a declared host.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterAddHostsCluster",
+        "operationId": "DownloadDeclaredHostISO",
         "parameters": [
           {
-            "description": "Parameters for creating a new cluster for adding nodes.",
-            "name": "new-add-hosts-cluster-params",
-            "in": "body",
-            "required": true,
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that the host is declared in.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The declared host whose ISO should be downloaded.",
+            "name": "declared_host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/add-hosts-cluster-create-params"
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
+        }
+      },
+      "post": {
+        "description": "Creates a Discovery ISO for a single declared host. The ISO only carries the network configuration and the hostname of the host, and binds the host that boots it to the declared host.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GenerateDeclaredHostISO",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that the host is declared in.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The declared host whose ISO should be generated.",
+            "name": "declared_host_id",
+            "in": "path",
+            "required": true
+          }
         ],
         "responses": {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/declared_host"
             }
           },
           "400": {
@@ -7657,6 +11792,18 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -7666,21 +11813,93 @@ func init() {
         }
       }
     },
-    "/assisted-service-iso": {
-      "post": {
-        "description": "Creates ISO for the user and uploads to S3.",
+    "/clusters/{cluster_id}/discovery-ignition": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
         "tags": [
-          "assisted-service-iso"
+          "installer"
         ],
-        "operationId": "CreateISOAndUploadToS3",
+        "operationId": "GetDiscoveryIgnition",
         "parameters": [
           {
-            "description": "Parameters for creating an Assisted Service ISO.",
-            "name": "assisted-service-iso-create-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which the discovery ignition config should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/discovery-ignition-params"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Override values in the discovery ignition config.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateDiscoveryIgnition",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which the discovery ignition config should be updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Overrides for the discovery ignition config.",
+            "name": "discovery-ignition-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/assisted-service-iso-create-params"
+              "$ref": "#/definitions/discovery-ignition-params"
             }
           }
         ],
@@ -7706,6 +11925,18 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -7715,31 +11946,71 @@ func init() {
         }
       }
     },
-    "/assisted-service-iso/data": {
+    "/clusters/{cluster_id}/downloads/files": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads files relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DownloadClusterFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "discovery.ign",
+              "custom_manifests.yaml"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
-        "description": "Downloads the Assisted Service ISO.",
-        "produces": [
-          "application/octet-stream"
-        ],
-        "tags": [
-          "assisted-service-iso"
-        ],
-        "operationId": "DownloadISO",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "type": "file"
             }
           },
           "401": {
@@ -7760,16 +12031,34 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/assisted-service-iso/presigned": {
+    "/clusters/{cluster_id}/downloads/files-presigned": {
       "get": {
         "security": [
           {
@@ -7780,11 +12069,64 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves a pre-signed S3 URL for downloading assisted-service ISO.",
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
         "tags": [
-          "assisted-service-iso"
+          "installer"
+        ],
+        "operationId": "GetPresignedForClusterFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "If downloading a file related to a host, the relevant host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
+            "in": "query"
+          }
         ],
-        "operationId": "GetPresignedForAssistedServiceISO",
         "responses": {
           "200": {
             "description": "Success.",
@@ -7792,6 +12134,12 @@ func init() {
               "$ref": "#/definitions/presigned"
             }
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -7810,6 +12158,18 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -7819,7 +12179,7 @@ func init() {
         }
       }
     },
-    "/clusters": {
+    "/clusters/{cluster_id}/downloads/image": {
       "get": {
         "security": [
           {
@@ -7828,43 +12188,41 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift clusters.",
+        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "ListClusters",
+        "operationId": "DownloadClusterISO",
         "parameters": [
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "A specific cluster to retrieve.",
-            "name": "openshift_cluster_id",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
-            "name": "ams_subscription_ids",
-            "in": "query"
+            "description": "The cluster whose ISO should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -7879,20 +12237,23 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "405": {
+            "description": "Method Not Allowed."
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -7900,19 +12261,27 @@ func init() {
         }
       },
       "post": {
-        "description": "Creates a new OpenShift cluster definition.",
+        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterCluster",
+        "operationId": "GenerateClusterISO",
         "parameters": [
           {
-            "description": "The properties describing the new cluster.",
-            "name": "new-cluster-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose ISO should be generated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The parameters for the generated ISO.",
+            "name": "image-create-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/cluster-create-params"
+              "$ref": "#/definitions/image-create-params"
             }
           }
         ],
@@ -7941,12 +12310,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -7954,10 +12335,8 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/default-config": {
-      "get": {
+      },
+      "head": {
         "security": [
           {
             "userAuth": [
@@ -7965,18 +12344,40 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Get the default values for various cluster properties.",
+        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterDefaultConfig",
+        "operationId": "DownloadClusterISOHeaders",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose ISO headers should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "Success.",
+            "headers": {
+              "Content-Length": {
+                "type": "integer",
+                "description": "Size of the ISO in bytes"
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/cluster_default_config"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -7985,10 +12386,25 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "403": {
-            "description": "Forbidden.",
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed."
+          },
+          "409": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -8000,7 +12416,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}": {
+    "/clusters/{cluster_id}/downloads/image-signature": {
       "get": {
         "security": [
           {
@@ -8011,42 +12427,32 @@ func init() {
             ]
           },
           {
-            "agentAuth": []
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift cluster.",
+        "description": "Downloads the detached signature of the SHA-256 digest of the OpenShift per-cluster Discovery image, signed with the image signing key of the service. The checksum of streamed images is computed when their signature is first downloaded.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "GetCluster",
+        "operationId": "DownloadClusterISOSignature",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be retrieved.",
+            "description": "The cluster whose image signature should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving the cluster details.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "file"
             }
           },
           "401": {
@@ -8078,34 +12484,49 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes an OpenShift cluster definition.",
+      }
+    },
+    "/clusters/{cluster_id}/downloads/kubeconfig": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads the kubeconfig file for this cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterCluster",
+        "operationId": "DownloadClusterKubeconfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be deregistered.",
+            "description": "The cluster whose kubeconfig should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -8144,37 +12565,58 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Updates an OpenShift cluster definition.",
+      }
+    },
+    "/clusters/{cluster_id}/downloads/pxe-artifacts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads the OpenShift per-cluster network boot artifacts and the iPXE script that boots them.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateCluster",
+        "operationId": "DownloadClusterPxeArtifact",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be updated.",
+            "description": "The cluster whose network boot artifact should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "cluster-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-update-params"
-            }
+            "enum": [
+              "ipxe-script",
+              "vmlinuz",
+              "initrd.img",
+              "rootfs.img"
+            ],
+            "type": "string",
+            "description": "The network boot artifact to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "file"
             }
           },
           "400": {
@@ -8207,12 +12649,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8222,28 +12658,56 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/cancel": {
-      "post": {
-        "description": "Cancels an ongoing installation.",
+    "/clusters/{cluster_id}/events": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Lists events for a cluster.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "CancelInstallation",
+        "operationId": "ListEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be canceled.",
+            "description": "The cluster to return events for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A host in the specified cluster to return events for.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/event-list"
             }
           },
           "401": {
@@ -8270,12 +12734,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8285,48 +12743,60 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/complete_installation": {
-      "post": {
+    "/clusters/{cluster_id}/free_addresses": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Agent API to mark a finalizing installation as complete.",
+        "description": "Retrieves the free address list for a network.",
         "tags": [
           "installer"
         ],
-        "operationId": "CompleteInstallation",
+        "operationId": "GetFreeAddresses",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is being completing.",
+            "description": "The cluster to return free addresses for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The final status of the cluster installation.",
-            "name": "completion-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/completion-params"
-            }
+            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
+            "type": "string",
+            "description": "The cluster network to return free addresses for.",
+            "name": "network",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 8000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 8000,
+            "description": "The maximum number of free addresses to return.",
+            "name": "limit",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is completing the installation.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "A prefix for the free addresses to return.",
+            "name": "prefix",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/free-addresses-list"
             }
           },
           "401": {
@@ -8353,55 +12823,46 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/actions/install": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
+    "/clusters/{cluster_id}/host-requirements": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get host requirements of a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallCluster",
+        "operationId": "GetClusterHostRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be installed.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster-host-requirements-list"
             }
           },
           "401": {
@@ -8428,12 +12889,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8443,34 +12898,46 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/install_hosts": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
+    "/clusters/{cluster_id}/hosts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the list of OpenShift hosts.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallHosts",
+        "operationId": "ListHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The existing cluster whose hosts should be added.",
+            "description": "The cluster whose hosts should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is listing hosts.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -8485,55 +12952,73 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/actions/reset": {
+      },
       "post": {
-        "description": "Resets a failed installation.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Registers a new OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "ResetCluster",
+        "operationId": "RegisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
+            "description": "The cluster into which the host should be registered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The description of the host being registered.",
+            "name": "new-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-create-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is registering the host.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host_registration_response"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -8561,7 +13046,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Error.",
+            "description": "Cluster cannot accept new hosts due to its current state.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -8571,11 +13056,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/credentials": {
+    "/clusters/{cluster_id}/hosts/{host_id}": {
       "get": {
         "security": [
           {
@@ -8586,26 +13077,34 @@ func init() {
             ]
           }
         ],
-        "description": "Get the cluster admin credentials.",
+        "description": "Retrieves the details of the OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetCredentials",
+        "operationId": "GetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster of the host that should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be retrieved.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/credentials"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -8632,12 +13131,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8645,39 +13138,39 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/discovery-ignition": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
+      },
+      "delete": {
+        "description": "Deregisters an OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetDiscoveryIgnition",
+        "operationId": "DeregisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be retrieved.",
+            "description": "The cluster of the host that should be deregistered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be deregistered.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -8711,40 +13204,38 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Override values in the discovery ignition config.",
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
+      "post": {
+        "description": "Enables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateDiscoveryIgnition",
+        "operationId": "EnableHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be updated.",
+            "description": "The cluster of the host that is being enabled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Overrides for the discovery ignition config.",
-            "name": "discovery-ignition-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being enabled.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -8771,6 +13262,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8778,73 +13275,36 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/downloads/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads files relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "delete": {
+        "description": "Disables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterFiles",
+        "operationId": "DisableHost",
         "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "discovery.ign",
-              "custom_manifests.yaml"
-            ],
+          {
             "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The cluster of the host that is being disabled.",
+            "name": "cluster_id",
+            "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "format": "uuid",
+            "description": "The host that is being disabled.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -8882,96 +13342,40 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files-presigned": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
+      "post": {
+        "description": "install specific host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetPresignedForClusterFiles",
+        "operationId": "InstallHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster of the host that is being installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
+            "description": "The host that is being installed.",
             "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -8992,12 +13396,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -9013,50 +13411,36 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/image": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
+      "post": {
+        "description": "reset a failed host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterISO",
+        "operationId": "ResetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be downloaded.",
+            "description": "The cluster of the host that is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -9077,9 +13461,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed."
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -9093,41 +13474,50 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
+      "patch": {
+        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
         "tags": [
           "installer"
         ],
-        "operationId": "GenerateClusterISO",
+        "summary": "Reset failed host validation.",
+        "operationId": "ResetHostValidation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be generated.",
+            "description": "The cluster of the host that its validation is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The parameters for the generated ISO.",
-            "name": "image-create-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/image-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that its validation is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -9150,12 +13540,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -9169,8 +13553,10 @@ func init() {
             }
           }
         }
-      },
-      "head": {
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
+      "get": {
         "security": [
           {
             "userAuth": [
@@ -9180,38 +13566,40 @@ func init() {
             ]
           },
           {
-            "urlAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
+        "description": "Downloads the customized ignition file for this host",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterISOHeaders",
+        "operationId": "DownloadHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO headers should be downloaded.",
+            "description": "The cluster of the host whose ignition file should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be downloaded.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
-            "headers": {
-              "Content-Length": {
-                "type": "integer",
-                "description": "Size of the ISO in bytes"
-              }
-            }
-          },
-          "400": {
-            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -9233,7 +13621,10 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed."
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "409": {
             "description": "Error.",
@@ -9246,48 +13637,46 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/clusters/{cluster_id}/downloads/kubeconfig": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
           },
-          {
-            "urlAuth": []
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        ],
-        "description": "Downloads the kubeconfig file for this cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        }
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/ignition": {
+      "get": {
+        "description": "Get the customized ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterKubeconfig",
+        "operationId": "GetHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose kubeconfig should be downloaded.",
+            "description": "The cluster of the host whose customized ignition file should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose customized ignition file should be retrieved.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/host-ignition-params"
             }
           },
           "401": {
@@ -9327,32 +13716,18 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/events": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Lists events for a cluster.",
+      },
+      "patch": {
+        "description": "Patch the ignition file for this host",
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "ListEvents",
+        "operationId": "UpdateHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
+            "description": "The cluster of the host whose ignition file should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -9360,25 +13735,29 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "A host in the specified cluster to return events for.",
+            "description": "The host whose ignition file should be updated.",
             "name": "host_id",
-            "in": "query"
+            "in": "path",
+            "required": true
           },
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
-            "in": "query"
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/event-list"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -9414,60 +13793,36 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/free_addresses": {
+    "/clusters/{cluster_id}/hosts/{host_id}/ignition-preview": {
       "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the free address list for a network.",
+        "description": "Preview the ignition configs a host will boot with, before the installation starts.\nRenders the effective discovery ignition and the projected ignition of the host's role, along with the changes each layer applied. Secrets are redacted.\n",
         "tags": [
           "installer"
         ],
-        "operationId": "GetFreeAddresses",
+        "operationId": "GetHostIgnitionPreview",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return free addresses for.",
+            "description": "The cluster of the host whose ignition should be previewed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
             "type": "string",
-            "description": "The cluster network to return free addresses for.",
-            "name": "network",
-            "in": "query",
+            "format": "uuid",
+            "description": "The host whose ignition should be previewed.",
+            "name": "host_id",
+            "in": "path",
             "required": true
-          },
-          {
-            "maximum": 8000,
-            "minimum": 1,
-            "type": "integer",
-            "default": 8000,
-            "description": "The maximum number of free addresses to return.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "A prefix for the free addresses to return.",
-            "name": "prefix",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/free-addresses-list"
+              "$ref": "#/definitions/ignition-preview"
             }
           },
           "401": {
@@ -9494,6 +13849,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -9503,37 +13864,51 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/host-requirements": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get host requirements of a cluster.",
+    "/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterHostRequirements",
+        "operationId": "UpdateHostInstallerArgs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster of the host whose installer arguments should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose installer arguments should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installer-args-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-host-requirements-list"
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -9560,6 +13935,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -9569,37 +13950,38 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts": {
+    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
       "get": {
         "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift hosts.",
+        "description": "Retrieves the next operations that the host agent needs to perform.",
         "tags": [
           "installer"
         ],
-        "operationId": "ListHosts",
+        "operationId": "GetNextSteps",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose hosts should be listed.",
+            "description": "The cluster of the host that is retrieving instructions.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is listing hosts.",
+            "format": "uuid",
+            "description": "The host that is retrieving instructions.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving instructions.",
             "name": "discovery_agent_version",
             "in": "header"
           }
@@ -9608,7 +13990,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/steps"
             }
           },
           "401": {
@@ -9623,6 +14005,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -9649,42 +14037,46 @@ func init() {
             "agentAuth": []
           }
         ],
-        "description": "Registers a new OpenShift host.",
+        "description": "Posts the result of the operations from the host agent.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterHost",
+        "operationId": "PostStepReply",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is posting results.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster into which the host should be registered.",
+            "description": "The cluster of the host that is posting results.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The description of the host being registered.",
-            "name": "new-host-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is posting results.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The results to be posted.",
+            "name": "reply",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/host-create-params"
+              "$ref": "#/definitions/step-reply"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is registering the host.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host_registration_response"
-            }
+          "204": {
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -9716,12 +14108,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Cluster cannot accept new hosts due to its current state.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -9737,7 +14123,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}": {
+    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
       "get": {
         "security": [
           {
@@ -9746,18 +14132,25 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift host.",
+        "description": "Download host logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "GetHost",
+        "operationId": "DownloadHostLogs",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be retrieved.",
+            "description": "The cluster of the host whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -9765,7 +14158,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be retrieved.",
+            "description": "The host whose logs should be downloaded.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -9775,7 +14168,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "file"
             }
           },
           "401": {
@@ -9802,6 +14195,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -9810,17 +14209,26 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Deregisters an OpenShift host.",
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterHost",
+        "operationId": "UploadHostLogs",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be deregistered.",
+            "description": "The cluster of the host whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -9828,22 +14236,29 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be deregistered.",
+            "description": "The host whose logs should be uploaded.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the logs.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "204": {
             "description": "Success."
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -9862,14 +14277,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -9877,18 +14292,23 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
-      "post": {
-        "description": "Enables a host for inclusion in the cluster.",
+    "/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "EnableHost",
+        "operationId": "UpdateHostLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being enabled.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -9896,18 +14316,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being enabled.",
+            "description": "The host whose log progress is being updated.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -9944,20 +14370,39 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "delete": {
-        "description": "Disables a host for inclusion in the cluster.",
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update installation progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "DisableHost",
+        "operationId": "UpdateHostInstallProgress",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being disabled.",
+            "description": "The ID of the cluster to retrieve.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -9965,18 +14410,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being disabled.",
+            "description": "The ID of the host to retrieve.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "New progress value.",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -10002,14 +14453,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -10017,36 +14468,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
-      "post": {
-        "description": "install specific host for day2 cluster.",
+    "/clusters/{cluster_id}/install-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster's install config YAML.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallHost",
+        "operationId": "GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being installed.",
+            "description": "The cluster whose install config is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being installed.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "string"
             }
           },
           "401": {
@@ -10067,8 +14519,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -10080,38 +14532,40 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
-      "post": {
-        "description": "reset a failed host for day2 cluster.",
+      },
+      "patch": {
+        "description": "Override values in the install config.",
         "tags": [
           "installer"
         ],
-        "operationId": "ResetHost",
+        "operationId": "UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being reset.",
+            "description": "The cluster whose install config is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "Install config overrides.",
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -10132,8 +14586,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -10147,50 +14601,61 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
+    "/clusters/{cluster_id}/logs": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Download cluster logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "ResetHostValidation",
+        "operationId": "DownloadClusterLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that its validation is being reset.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
+            "in": "query"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that its validation is being reset.",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
             "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Bad Request",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -10211,6 +14676,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -10224,54 +14695,60 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
-      "get": {
+      },
+      "post": {
         "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this host",
-        "produces": [
-          "application/octet-stream"
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadHostIgnition",
+        "operationId": "UploadLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be downloaded.",
+            "description": "The cluster whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "maxLength": 104857600,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
+            "description": "The host whose logs should be uploaded.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -10291,18 +14768,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -10318,36 +14783,55 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/ignition": {
+    "/clusters/{cluster_id}/logs/analysis": {
       "get": {
-        "description": "Get the customized ignition file for this host",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the known failure signatures that were found in the uploaded logs of the cluster and its hosts.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetHostIgnition",
+        "operationId": "GetClusterLogsAnalysis",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose customized ignition file should be retrieved.",
+            "description": "The cluster whose logs analysis should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "The type of logs whose findings should be retrieved.",
+            "name": "logs_type",
+            "in": "query"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose customized ignition file should be retrieved.",
+            "description": "A specific host in the cluster whose findings should be retrieved.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/log-finding-list"
             }
           },
           "401": {
@@ -10374,12 +14858,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -10387,43 +14865,64 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Patch the ignition file for this host",
+      }
+    },
+    "/clusters/{cluster_id}/logs/entries": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the files inside a stored host or controller logs archive.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostIgnition",
+        "operationId": "ListLogsArchiveEntries",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be updated.",
+            "description": "The cluster whose logs should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "host",
+              "controller"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
-            "name": "host_id",
-            "in": "path",
+            "description": "The type of the logs archive.",
+            "name": "logs_type",
+            "in": "query",
             "required": true
           },
           {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-ignition-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs archive should be listed, required for host logs.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "headers": {
+              "X-Logs-Archive-Truncated": {
+                "type": "boolean",
+                "description": "Whether the archive was only listed up to the size limit of the service."
+              }
+            },
+            "schema": {
+              "$ref": "#/definitions/logs-archive-entry-list"
+            }
           },
           "400": {
             "description": "Error.",
@@ -10455,6 +14954,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -10464,45 +14969,82 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+    "/clusters/{cluster_id}/logs/entries/download": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads a single file, or a range of its lines, from a stored host or controller logs archive.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostInstallerArgs",
+        "operationId": "DownloadLogsArchiveEntry",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose installer arguments should be updated.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of the logs archive.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
+            "description": "The host whose logs archive should be read, required for host logs.",
             "name": "host_id",
-            "in": "path",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The name of the file inside the logs archive, as listed by ListLogsArchiveEntries.",
+            "name": "name",
+            "in": "query",
             "required": true
           },
           {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/installer-args-params"
-            }
+            "minimum": 1,
+            "type": "integer",
+            "description": "The first line to download, starting from 1.",
+            "name": "start_line",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "The last line to download. Lines are downloaded until the end of the file if not set.",
+            "name": "end_line",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "file"
             }
           },
           "400": {
@@ -10550,47 +15092,77 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
+    "/clusters/{cluster_id}/logs/search": {
       "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Retrieves the next operations that the host agent needs to perform.",
+        "description": "Searches the files of a stored host or controller logs archive for lines that match a regular expression.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetNextSteps",
+        "operationId": "SearchLogsArchive",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is retrieving instructions.",
+            "description": "The cluster whose logs should be searched.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of the logs archive.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is retrieving instructions.",
+            "description": "The host whose logs archive should be searched, required for host logs.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is retrieving instructions.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "The regular expression to search for.",
+            "name": "pattern",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximal number of matching lines to return.",
+            "name": "max_results",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "$ref": "#/definitions/logs_search_result"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -10617,66 +15189,58 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
+      }
+    },
+    "/clusters/{cluster_id}/logs/uploads": {
       "post": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Posts the result of the operations from the host agent.",
+        "description": "Agent API to start a resumable upload of a logs archive, which is then uploaded in chunks.",
         "tags": [
           "installer"
         ],
-        "operationId": "PostStepReply",
+        "operationId": "CreateLogsUpload",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is posting results.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is posting results.",
+            "description": "The cluster whose logs are uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is posting results.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The results to be posted.",
-            "name": "reply",
+            "description": "The logs archive to upload.",
+            "name": "new-logs-upload-params",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/step-reply"
+              "$ref": "#/definitions/new-logs-upload-params"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/logs_upload"
+            }
           },
           "400": {
             "description": "Error.",
@@ -10702,12 +15266,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -10723,34 +15281,23 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
+    "/clusters/{cluster_id}/logs/uploads/{upload_id}": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Download host logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Agent API to retrieve a logs upload with the byte ranges that were received, in order to resume it.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadHostLogs",
-        "deprecated": true,
+        "operationId": "GetLogsUpload",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose logs should be downloaded.",
+            "description": "The cluster whose logs are uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -10758,8 +15305,8 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose logs should be downloaded.",
-            "name": "host_id",
+            "description": "The logs upload.",
+            "name": "upload_id",
             "in": "path",
             "required": true
           }
@@ -10768,7 +15315,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/logs_upload"
             }
           },
           "401": {
@@ -10789,46 +15336,36 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "post": {
+      "delete": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "Agent API to abort a logs upload and delete the chunks that were received.",
         "tags": [
           "installer"
         ],
-        "operationId": "UploadHostLogs",
-        "deprecated": true,
+        "operationId": "AbortLogsUpload",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose logs should be uploaded.",
+            "description": "The cluster whose logs are uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -10836,23 +15373,10 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
+            "description": "The logs upload.",
+            "name": "upload_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the logs.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
@@ -10892,23 +15416,23 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
-      "put": {
+    "/clusters/{cluster_id}/logs/uploads/{upload_id}/actions/complete": {
+      "post": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Agent API to complete a logs upload once all of its chunks were received. The chunks are assembled into the logs archive, which is then handled like logs that were uploaded at once.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostLogsProgress",
+        "operationId": "CompleteLogsUpload",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster whose logs are uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -10916,24 +15440,21 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose log progress is being updated.",
-            "name": "host_id",
+            "description": "The logs upload.",
+            "name": "upload_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logs-progress-params"
-            }
           }
         ],
         "responses": {
           "204": {
-            "description": "Update cluster install progress."
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -10953,12 +15474,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -10980,29 +15495,26 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
+    "/clusters/{cluster_id}/logs/uploads/{upload_id}/chunks/{chunk_number}": {
       "put": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Update installation progress.",
+        "description": "Agent API to upload a chunk of a logs archive. Chunk N holds the bytes of the archive starting at N times the chunk size of the upload. A chunk that was already received is replaced.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostInstallProgress",
+        "operationId": "UploadLogsChunk",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the cluster to retrieve.",
+            "description": "The cluster whose logs are uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -11010,95 +15522,43 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the host to retrieve.",
-            "name": "host_id",
+            "description": "The logs upload.",
+            "name": "upload_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "New progress value.",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Update install progress."
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+            "type": "integer",
+            "description": "The number of the chunk, starting from 0.",
+            "name": "chunk_number",
+            "in": "path",
+            "required": true
           },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/clusters/{cluster_id}/install-config": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster's install config YAML.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "GetClusterInstallConfig",
-        "parameters": [
           {
+            "pattern": "^[0-9a-fA-F]{64}$",
             "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
-            "name": "cluster_id",
-            "in": "path",
+            "description": "The hex encoded SHA-256 digest of the chunk.",
+            "name": "sha256",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maxLength": 104857600,
+            "type": "file",
+            "description": "The content of the chunk.",
+            "name": "upfile",
+            "in": "formData",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -11119,54 +15579,55 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "patch": {
-        "description": "Override values in the install config.",
+      }
+    },
+    "/clusters/{cluster_id}/logs_progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateClusterInstallConfig",
+        "operationId": "UpdateClusterLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/logs-progress-params"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -11192,70 +15653,54 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/logs": {
+    "/clusters/{cluster_id}/manifests": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Download cluster logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Lists manifests for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "DownloadClusterLogs",
+        "operationId": "ListClusterManifests",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
+            "description": "The cluster for which the manifests should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
-            "name": "host_id",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/list-manifests"
             }
           },
           "401": {
@@ -11299,56 +15744,45 @@ func init() {
       "post": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "Creates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "UploadLogs",
+        "operationId": "CreateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster for which a new manifest should be created.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "maxLength": 104857600,
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "enum": [
-              "host",
-              "controller"
-            ],
-            "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "query"
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -11368,55 +15802,63 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/logs_progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update log collection state and progress.",
+      },
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "UpdateClusterLogsProgress",
+        "operationId": "DeleteClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster whose manifest should be deleted.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logs-progress-params"
-            }
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to delete from the cluster.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "200": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -11453,43 +15895,58 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/manifests": {
+    "/clusters/{cluster_id}/manifests/files": {
       "get": {
         "security": [
           {
             "userAuth": []
           }
         ],
-        "description": "Lists manifests for customizing cluster installation.",
+        "description": "Downloads cluster manifest.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "manifests"
         ],
-        "operationId": "ListClusterManifests",
+        "operationId": "DownloadClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
+            "description": "The cluster whose manifest should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-manifests"
+              "type": "file"
             }
           },
           "401": {
@@ -11529,48 +15986,42 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/clusters/{cluster_id}/monitored_operators": {
+      "get": {
         "security": [
           {
-            "userAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Creates a manifest for customizing cluster installation.",
+        "description": "Lists operators to be monitored for a cluster.",
         "tags": [
-          "manifests"
+          "operators",
+          "installer"
         ],
-        "operationId": "CreateClusterManifest",
+        "operationId": "ListOfClusterOperators",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/create-manifest-params"
-            }
+            "type": "string",
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/monitored-operators-list"
             }
           },
           "401": {
@@ -11597,12 +16048,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11611,44 +16056,47 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Controller API to report of monitored operators.",
         "tags": [
-          "manifests"
+          "operators",
+          "installer"
         ],
-        "operationId": "DeleteClusterManifest",
+        "operationId": "ReportMonitoredOperatorStatus",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
+            "description": "The cluster whose operators are being monitored.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The operators monitor report.",
+            "name": "report-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/operator-monitor-report"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success."
           },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -11684,58 +16132,47 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/manifests/files": {
+    "/clusters/{cluster_id}/preflight-requirements": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Downloads cluster manifest.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Get preflight requirements for a cluster.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "DownloadClusterManifest",
+        "operationId": "GetPreflightRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
+            "description": "The cluster to return preflight requrements for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/preflight-hardware-requirements"
             }
           },
           "401": {
@@ -11762,12 +16199,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11777,40 +16208,40 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/monitored_operators": {
+    "/clusters/{cluster_id}/support-bundle": {
       "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists operators to be monitored for a cluster.",
+        "description": "Downloads an archive of the cluster, its hosts, events, validations history, install config, manifests, operators and logs to be attached to a support case. Pull secrets, SSH keys and passwords are redacted from the records of the archive, while the log archives are included as is and flagged as not redacted.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "ListOfClusterOperators",
+        "operationId": "DownloadClusterSupportBundle",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster whose support bundle should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "type": "file"
             }
           },
           "401": {
@@ -11831,12 +16262,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11844,44 +16269,51 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Controller API to report of monitored operators.",
+        "description": "Transfer the ingress certificate for the cluster.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "ReportMonitoredOperatorStatus",
+        "operationId": "UploadClusterIngressCert",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
+            "description": "The cluster to associate with the ingress certificate.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The operators monitor report.",
-            "name": "report-params",
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
+              "$ref": "#/definitions/ingress-cert-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success."
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -11910,20 +16342,72 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/component_versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of component versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "ListComponentVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/domains": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of managed DNS domains.",
+        "tags": [
+          "managed_domains"
+        ],
+        "operationId": "ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-managed-domains"
+            }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -11931,7 +16415,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/preflight-requirements": {
+    "/host_requirements": {
       "get": {
         "security": [
           {
@@ -11942,26 +16426,25 @@ func init() {
             ]
           }
         ],
-        "description": "Get preflight requirements for a cluster.",
+        "description": "Get minimum host requirements.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetPreflightRequirements",
+        "operationId": "GetHostRequirements",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster to return preflight requrements for.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "type": "boolean",
+            "default": false,
+            "description": "Get hw requirements for single node.",
+            "name": "single_node",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
+              "$ref": "#/definitions/host-requirements"
             }
           },
           "401": {
@@ -11976,12 +16459,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -11997,51 +16474,30 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
+    "/image-signing-key": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Downloads the PEM encoded public key that the signatures of the discovery images are verified with.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "UploadClusterIngressCert",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
-            }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          }
-        ],
+        "operationId": "DownloadImageSigningKey",
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -12062,28 +16518,16 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/component_versions": {
+    "/openshift_versions": {
       "get": {
         "security": [
           {
@@ -12094,42 +16538,71 @@ func init() {
             ]
           }
         ],
-        "description": "List of component versions.",
+        "description": "Retrieves the list of OpenShift supported versions.",
         "tags": [
           "versions"
         ],
-        "operationId": "ListComponentVersions",
+        "operationId": "ListSupportedOpenshiftVersions",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-versions"
+              "$ref": "#/definitions/openshift-versions"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
         }
       }
     },
-    "/domains": {
+    "/quotas": {
       "get": {
         "security": [
           {
             "userAuth": [
               "admin",
-              "read-only-admin",
-              "user"
+              "read-only-admin"
             ]
           }
         ],
-        "description": "List of managed DNS domains.",
+        "description": "Lists the usage and limits of the organizations and users that own clusters or have limits of their own.",
         "tags": [
-          "managed_domains"
+          "installer"
+        ],
+        "operationId": "ListQuotas",
+        "parameters": [
+          {
+            "enum": [
+              "org",
+              "user"
+            ],
+            "type": "string",
+            "description": "Only list the quotas of organizations or of users.",
+            "name": "scope",
+            "in": "query"
+          }
         ],
-        "operationId": "ListManagedDomains",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-managed-domains"
+              "$ref": "#/definitions/quota-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
             }
           },
           "500": {
@@ -12141,36 +16614,46 @@ func init() {
         }
       }
     },
-    "/host_requirements": {
+    "/quotas/{scope}/{owner}": {
       "get": {
         "security": [
           {
             "userAuth": [
               "admin",
-              "read-only-admin",
-              "user"
+              "read-only-admin"
             ]
           }
         ],
-        "description": "Get minimum host requirements.",
+        "description": "Retrieves the usage and limits of an organization or a user.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetHostRequirements",
+        "operationId": "GetQuota",
         "parameters": [
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Get hw requirements for single node.",
-            "name": "single_node",
-            "in": "header"
+            "enum": [
+              "org",
+              "user"
+            ],
+            "type": "string",
+            "description": "Whether the owner is an organization or a user.",
+            "name": "scope",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of the organization or the name of the user.",
+            "name": "owner",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-requirements"
+              "$ref": "#/definitions/quota"
             }
           },
           "401": {
@@ -12185,12 +16668,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -12198,33 +16675,76 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/openshift_versions": {
-      "get": {
+      },
+      "put": {
         "security": [
           {
             "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
+              "admin"
             ]
           }
         ],
-        "description": "Retrieves the list of OpenShift supported versions.",
+        "description": "Sets the limits of an organization or a user. Limits that are not set fall back to the default limits of the service.",
         "tags": [
-          "versions"
+          "installer"
+        ],
+        "operationId": "UpdateQuotaLimits",
+        "parameters": [
+          {
+            "enum": [
+              "org",
+              "user"
+            ],
+            "type": "string",
+            "description": "Whether the owner is an organization or a user.",
+            "name": "scope",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of the organization or the name of the user.",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The limits of the owner.",
+            "name": "limits",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/quota-limits"
+            }
+          }
         ],
-        "operationId": "ListSupportedOpenshiftVersions",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/openshift-versions"
+              "$ref": "#/definitions/quota"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -12528,6 +17048,30 @@ func init() {
         }
       }
     },
+    "archived-cluster": {
+      "description": "A cluster that was moved from the database to the storage after its installation.",
+      "type": "object",
+      "required": [
+        "archived_at",
+        "cluster"
+      ],
+      "properties": {
+        "archived_at": {
+          "description": "The time at which the cluster was archived.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "cluster": {
+          "$ref": "#/definitions/cluster"
+        },
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/event"
+          }
+        }
+      }
+    },
     "assisted-service-iso-create-params": {
       "type": "object",
       "properties": {
@@ -12571,6 +17115,11 @@ func init() {
           "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
           "type": "string"
         },
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by the service and the installed cluster trust the certificates in the bundle.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "ams_subscription_id": {
           "description": "Unique identifier of the AMS subscription in OCM.",
           "type": "string",
@@ -12601,6 +17150,14 @@ func init() {
           "maximum": 128,
           "minimum": 1
         },
+        "cluster_networks": {
+          "description": "Cluster networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
         "connectivity_majority_groups": {
           "description": "Json formatted string containing the majority groups for connectivity checks.",
           "type": "string",
@@ -12749,6 +17306,14 @@ func init() {
           "type": "string",
           "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
+        "machine_networks": {
+          "description": "Machine networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
         "monitored_operators": {
           "description": "Operators that are associated with this cluster.",
           "type": "array",
@@ -12797,6 +17362,14 @@ func init() {
           "type": "string",
           "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
         "ssh_public_key": {
           "description": "SSH public key for debugging OpenShift nodes.",
           "type": "string"
@@ -12874,6 +17447,11 @@ func init() {
           "type": "string",
           "x-nullable": true
         },
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by the service and the installed cluster trust the certificates in the bundle.",
+          "type": "string",
+          "x-nullable": true
+        },
         "base_dns_domain": {
           "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
           "type": "string"
@@ -12891,6 +17469,13 @@ func init() {
           "maximum": 128,
           "minimum": 1
         },
+        "cluster_networks": {
+          "description": "Cluster networks, one per IP address family. When two networks are set the cluster is dual-stack and the first one is IPv4.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          }
+        },
         "high_availability_mode": {
           "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
           "type": "string",
@@ -12926,6 +17511,13 @@ func init() {
           "type": "string",
           "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
         },
+        "machine_networks": {
+          "description": "Machine networks, one per IP address family. When two networks are set the cluster is dual-stack and the first one is IPv4. With user managed networking, the hosts may be spread over any number of routed machine networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine_network"
+          }
+        },
         "name": {
           "description": "Name of the OpenShift cluster.",
           "type": "string",
@@ -12962,6 +17554,13 @@ func init() {
           "default": "172.30.0.0/16",
           "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
+        "service_networks": {
+          "description": "Service networks, one per IP address family. When two networks are set the cluster is dual-stack and the first one is IPv4.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          }
+        },
         "ssh_public_key": {
           "description": "SSH public key for debugging OpenShift nodes.",
           "type": "string"
@@ -13058,6 +17657,11 @@ func init() {
           "type": "string",
           "x-nullable": true
         },
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by the service and the installed cluster trust the certificates in the bundle.",
+          "type": "string",
+          "x-nullable": true
+        },
         "api_vip": {
           "description": "The virtual IP used to reach the OpenShift cluster's API.",
           "type": "string",
@@ -13087,6 +17691,13 @@ func init() {
           "minimum": 1,
           "x-nullable": true
         },
+        "cluster_networks": {
+          "description": "Cluster networks, one per IP address family. When two networks are set the cluster is dual-stack and the first one is IPv4.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          }
+        },
         "disks_selected_config": {
           "type": "array",
           "items": {
@@ -13151,6 +17762,13 @@ func init() {
           "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
           "x-nullable": true
         },
+        "machine_networks": {
+          "description": "Machine networks, one per IP address family. When two networks are set the cluster is dual-stack and the first one is IPv4. With user managed networking, the hosts may be spread over any number of routed machine networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine_network"
+          }
+        },
         "name": {
           "description": "OpenShift cluster name.",
           "type": "string",
@@ -13181,6 +17799,13 @@ func init() {
           "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
           "x-nullable": true
         },
+        "service_networks": {
+          "description": "Service networks, one per IP address family. When two networks are set the cluster is dual-stack and the first one is IPv4.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          }
+        },
         "ssh_public_key": {
           "description": "SSH public key for debugging OpenShift nodes.",
           "type": "string",
@@ -13243,6 +17868,34 @@ func init() {
         }
       }
     },
+    "cluster_network": {
+      "description": "IP address block from which Pod IPs are allocated.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "The IP block address pool.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "cluster_id": {
+          "description": "The cluster that this network is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
+        },
+        "host_prefix": {
+          "description": "The prefix size to allocate to each node from the CIDR. For example, 24 would allocate 2^8=256 addresses to each node.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "index": {
+          "description": "The position of the network in the cluster networks of the cluster. The network at position 0 is the primary one. Set by the service.",
+          "type": "integer"
+        }
+      }
+    },
     "completion-params": {
       "type": "object",
       "required": [
@@ -13458,6 +18111,70 @@ func init() {
         }
       }
     },
+    "declared-host-create-params": {
+      "description": "A host that is declared before it boots its own discovery image.",
+      "type": "object",
+      "properties": {
+        "hostname": {
+          "description": "The hostname of the host.",
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role-update-params"
+        },
+        "static_network_config": {
+          "$ref": "#/definitions/host_static_network_config"
+        }
+      }
+    },
+    "declared-host-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/declared_host"
+      }
+    },
+    "declared_host": {
+      "description": "A host that was declared before it boots its own discovery image. The host that registers with the image is bound to the declared host, and gets its hostname and role.",
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "host_id": {
+          "description": "The host that registered with the discovery image of the declared host.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "description": "The hostname of the host.",
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "image_download_url": {
+          "description": "The URL of the discovery image of the declared host.",
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role-update-params"
+        },
+        "static_network_config": {
+          "description": "The static network config of the host, in the format of the image info of clusters.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        }
+      }
+    },
     "dhcp_allocation_request": {
       "type": "object",
       "required": [
@@ -13715,6 +18432,13 @@ func init() {
           "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
           "type": "string"
         },
+        "field_errors": {
+          "description": "The problems found in specific fields of the request, when they can be pinpointed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/field-error"
+          }
+        },
         "href": {
           "description": "Self link.",
           "type": "string"
@@ -13803,6 +18527,19 @@ func init() {
         "$ref": "#/definitions/event"
       }
     },
+    "field-error": {
+      "type": "object",
+      "properties": {
+        "field": {
+          "description": "The path of the field within the request document, such as storage.files.0.path.",
+          "type": "string"
+        },
+        "message": {
+          "description": "Human-readable description of the problem.",
+          "type": "string"
+        }
+      }
+    },
     "free-addresses-list": {
       "type": "array",
       "items": {
@@ -13874,6 +18611,11 @@ func init() {
         "status_info"
       ],
       "properties": {
+        "agent_upgrade_status": {
+          "description": "Json formatted string containing the result of the last attempt to upgrade the discovery agent of the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "api_vip_connectivity": {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
@@ -14256,7 +18998,8 @@ func init() {
         "sufficient-installation-disk-speed",
         "cnv-requirements-satisfied",
         "sufficient-network-latency-requirement-for-role",
-        "sufficient-packet-loss-requirement-for-role"
+        "sufficient-packet-loss-requirement-for-role",
+        "compatible-agent"
       ]
     },
     "host_network": {
@@ -14317,6 +19060,95 @@ func init() {
         }
       }
     },
+    "ignition-change": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "added",
+            "modified"
+          ]
+        },
+        "kind": {
+          "description": "The kind of ignition entry that was changed.",
+          "type": "string",
+          "enum": [
+            "config",
+            "file",
+            "directory",
+            "link",
+            "unit",
+            "dropin",
+            "user",
+            "group"
+          ]
+        },
+        "name": {
+          "description": "The path, name or key identifying the changed entry.",
+          "type": "string"
+        }
+      }
+    },
+    "ignition-layer": {
+      "type": "object",
+      "properties": {
+        "changes": {
+          "description": "The ignition entries added or modified by the layer.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-change"
+          }
+        },
+        "name": {
+          "description": "The name of the layer.",
+          "type": "string",
+          "enum": [
+            "template",
+            "cluster-overrides",
+            "pointer",
+            "service-ca",
+            "trust-bundle",
+            "vip-dhcp",
+            "ipv6",
+            "static-network",
+            "etc-hosts",
+            "hostname",
+            "host-overrides"
+          ]
+        }
+      }
+    },
+    "ignition-preview": {
+      "type": "object",
+      "properties": {
+        "discovery_ignition": {
+          "description": "The effective discovery ignition of the cluster, with secrets redacted.",
+          "type": "string"
+        },
+        "discovery_layers": {
+          "description": "The layers composing the discovery ignition, in the order they are applied.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-layer"
+          }
+        },
+        "node_ignition": {
+          "description": "The projected pointer ignition the host will boot with after it is written to disk, with secrets redacted.",
+          "type": "string"
+        },
+        "node_layers": {
+          "description": "The layers composing the node ignition, in the order they are applied.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-layer"
+          }
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        }
+      }
+    },
     "image-create-params": {
       "type": "object",
       "properties": {
@@ -14324,10 +19156,18 @@ func init() {
           "description": "Type of image that should be generated.",
           "$ref": "#/definitions/image_type"
         },
+        "kernel_arguments": {
+          "description": "Kernel argument operations applied to the discovery image.",
+          "$ref": "#/definitions/kernel_arguments"
+        },
         "ssh_public_key": {
           "description": "SSH public key for debugging the installation.",
           "type": "string"
         },
+        "static_ip_pool": {
+          "description": "Pool of static IP addresses from which the per-host static network configuration is generated. Cannot be set together with static_network_config.",
+          "$ref": "#/definitions/static_ip_pool"
+        },
         "static_network_config": {
           "type": "array",
           "items": {
@@ -14339,6 +19179,10 @@ func init() {
     "image_info": {
       "type": "object",
       "properties": {
+        "checksum": {
+          "description": "Hex encoded SHA-256 digest of the discovery image.",
+          "type": "string"
+        },
         "created_at": {
           "type": "string",
           "format": "date-time",
@@ -14356,6 +19200,10 @@ func init() {
           "description": "Image generator version.",
           "type": "string"
         },
+        "kernel_arguments": {
+          "description": "JSON formatted list of the kernel argument operations applied to the discovery image",
+          "type": "string"
+        },
         "size_bytes": {
           "type": "integer",
           "minimum": 0
@@ -14377,7 +19225,10 @@ func init() {
       "type": "string",
       "enum": [
         "full-iso",
-        "minimal-iso"
+        "minimal-iso",
+        "pxe",
+        "raw-disk",
+        "qcow2"
       ]
     },
     "infra_error": {
@@ -14534,6 +19385,31 @@ func init() {
         }
       }
     },
+    "kernel_argument": {
+      "type": "object",
+      "properties": {
+        "operation": {
+          "description": "The operation to apply on the kernel arguments of the discovery image.",
+          "type": "string",
+          "enum": [
+            "append",
+            "replace",
+            "delete"
+          ]
+        },
+        "value": {
+          "description": "Kernel argument, such as console=ttyS1,115200. A replace operation replaces the arguments with the same key. A delete operation removes the arguments with the same key when only a key is given, and the identical arguments otherwise.",
+          "type": "string"
+        }
+      }
+    },
+    "kernel_arguments": {
+      "description": "List of kernel argument operations, which are applied in order to the kernel arguments of the discovery image.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/kernel_argument"
+      }
+    },
     "l2-connectivity": {
       "type": "object",
       "properties": {
@@ -14602,15 +19478,145 @@ func init() {
         }
       }
     },
-    "logs-progress-params": {
+    "log-finding-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/log_finding"
+      }
+    },
+    "log_finding": {
+      "description": "A known failure signature that was found in the uploaded logs of a cluster or of one of its hosts.",
+      "type": "object",
+      "properties": {
+        "analyzed_at": {
+          "description": "The time the logs were analyzed.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "cluster_id": {
+          "description": "Unique identifier of the cluster whose logs contain the failure.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "description": {
+          "description": "Human readable description of the failure.",
+          "type": "string"
+        },
+        "excerpt": {
+          "description": "The first log line that matched the failure signature.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "file": {
+          "description": "The file in the logs archive that contains the first matching line.",
+          "type": "string"
+        },
+        "host_id": {
+          "description": "Unique identifier of the host whose logs contain the failure. Empty for controller logs.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "id": {
+          "description": "Unique identifier of the finding.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "logs_type": {
+          "$ref": "#/definitions/logs_type"
+        },
+        "match_count": {
+          "description": "Number of log lines that matched the failure signature.",
+          "type": "integer"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "error",
+            "critical"
+          ]
+        },
+        "signature_id": {
+          "description": "Identifier of the failure signature in the signature catalogue.",
+          "type": "string"
+        }
+      }
+    },
+    "logs-archive-entry-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/logs_archive_entry"
+      }
+    },
+    "logs-progress-params": {
+      "type": "object",
+      "required": [
+        "logs_state"
+      ],
+      "properties": {
+        "logs_state": {
+          "description": "The state of collecting logs.",
+          "$ref": "#/definitions/logs_state"
+        }
+      }
+    },
+    "logs_archive_entry": {
+      "description": "A file inside a logs archive.",
+      "type": "object",
+      "properties": {
+        "modified_at": {
+          "description": "The modification time of the file.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "name": {
+          "description": "The path of the file inside the logs archive. Files of nested archives are prefixed by the path of the nested archive, and compressed files are named without their .gz suffix.",
+          "type": "string"
+        },
+        "size": {
+          "description": "The uncompressed size of the file in bytes.",
+          "type": "integer"
+        }
+      }
+    },
+    "logs_search_match": {
+      "description": "A line of a log file that matched a search.",
+      "type": "object",
+      "properties": {
+        "line": {
+          "description": "The matching line.",
+          "type": "string"
+        },
+        "line_number": {
+          "description": "The number of the matching line in the file, starting from 1.",
+          "type": "integer"
+        },
+        "name": {
+          "description": "The name of the file inside the logs archive.",
+          "type": "string"
+        }
+      }
+    },
+    "logs_search_result": {
       "type": "object",
-      "required": [
-        "logs_state"
-      ],
       "properties": {
-        "logs_state": {
-          "description": "The state of collecting logs.",
-          "$ref": "#/definitions/logs_state"
+        "archive_truncated": {
+          "description": "Whether the archive was only searched up to the size limit of the service.",
+          "type": "boolean"
+        },
+        "matches": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/logs_search_match"
+          }
+        },
+        "truncated": {
+          "description": "Whether there were more matching lines than the requested maximum.",
+          "type": "boolean"
         }
       }
     },
@@ -14633,12 +19639,121 @@ func init() {
         ""
       ]
     },
+    "logs_upload": {
+      "description": "A resumable upload of a logs archive of a cluster or of one of its hosts.",
+      "type": "object",
+      "properties": {
+        "chunk_size": {
+          "description": "The size in bytes of every chunk but the last one.",
+          "type": "integer"
+        },
+        "cluster_id": {
+          "description": "The cluster whose logs are uploaded.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "created_at": {
+          "description": "The time the upload was started.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "host_id": {
+          "description": "The host whose logs are uploaded. Empty for controller logs.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "id": {
+          "description": "Unique identifier of the upload.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "logs_type": {
+          "$ref": "#/definitions/logs_type"
+        },
+        "received_ranges": {
+          "description": "The byte ranges of the logs archive that were received, in ascending order.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/logs_upload_range"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "sha256": {
+          "description": "The hex encoded SHA-256 digest of the logs archive.",
+          "type": "string"
+        },
+        "total_size": {
+          "description": "The size of the logs archive in bytes.",
+          "type": "integer"
+        },
+        "updated_at": {
+          "description": "The last time a chunk was received. Uploads that are not updated are deleted after a while.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        }
+      }
+    },
+    "logs_upload_range": {
+      "description": "A range of bytes of a logs archive.",
+      "type": "object",
+      "properties": {
+        "end": {
+          "description": "The offset of the last byte of the range.",
+          "type": "integer"
+        },
+        "start": {
+          "description": "The offset of the first byte of the range.",
+          "type": "integer"
+        }
+      }
+    },
     "mac_interface_map": {
       "type": "array",
       "items": {
         "$ref": "#/definitions/MacInterfaceMapItems0"
       }
     },
+    "mac_range": {
+      "type": "object",
+      "properties": {
+        "end": {
+          "description": "The last MAC address of the range.",
+          "type": "string",
+          "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
+        },
+        "start": {
+          "description": "The first MAC address of the range.",
+          "type": "string",
+          "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
+        }
+      }
+    },
+    "machine_network": {
+      "description": "A network that all hosts belonging to the cluster should have an interface with IP address in.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "The IP block address pool for machines within the cluster.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "cluster_id": {
+          "description": "The cluster that this network is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
+        },
+        "index": {
+          "description": "The position of the network in the machine networks of the cluster. The network at position 0 is the primary one. Set by the service.",
+          "type": "integer"
+        }
+      }
+    },
     "managed-domain": {
       "type": "object",
       "properties": {
@@ -14736,6 +19851,45 @@ func init() {
         "$ref": "#/definitions/monitored-operator"
       }
     },
+    "new-logs-upload-params": {
+      "type": "object",
+      "required": [
+        "logs_type",
+        "total_size",
+        "chunk_size"
+      ],
+      "properties": {
+        "chunk_size": {
+          "description": "The size in bytes of every chunk but the last one.",
+          "type": "integer",
+          "maximum": 104857600,
+          "minimum": 1
+        },
+        "host_id": {
+          "description": "The host whose logs are uploaded, required for host logs.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "logs_type": {
+          "description": "The type of the logs archive.",
+          "type": "string",
+          "enum": [
+            "host",
+            "controller"
+          ]
+        },
+        "sha256": {
+          "description": "The hex encoded SHA-256 digest of the logs archive, verified when the upload is completed.",
+          "type": "string",
+          "pattern": "^[0-9a-fA-F]{64}$"
+        },
+        "total_size": {
+          "description": "The size of the logs archive in bytes.",
+          "type": "integer",
+          "minimum": 1
+        }
+      }
+    },
     "ntp_source": {
       "type": "object",
       "properties": {
@@ -14803,6 +19957,11 @@ func init() {
           "description": "The base RHCOS image used for the discovery iso.",
           "type": "string"
         },
+        "rhcos_image_sha256": {
+          "description": "SHA-256 digest of the base RHCOS image, which is verified before the image is used.",
+          "type": "string",
+          "pattern": "^[a-f0-9]{64}$"
+        },
         "rhcos_rootfs": {
           "description": "The RHCOS rootfs url.",
           "type": "string"
@@ -14977,6 +20136,85 @@ func init() {
         }
       }
     },
+    "quota": {
+      "description": "The usage and limits of an organization or a user. The limits are the default limits of the service unless the owner has limits of its own.",
+      "type": "object",
+      "required": [
+        "scope",
+        "owner",
+        "usage",
+        "limits"
+      ],
+      "properties": {
+        "limits": {
+          "$ref": "#/definitions/quota-limits"
+        },
+        "owner": {
+          "description": "The ID of the organization or the name of the user.",
+          "type": "string"
+        },
+        "scope": {
+          "description": "Whether the owner is an organization or a user.",
+          "type": "string",
+          "enum": [
+            "org",
+            "user"
+          ]
+        },
+        "usage": {
+          "$ref": "#/definitions/quota-usage"
+        }
+      }
+    },
+    "quota-limits": {
+      "type": "object",
+      "properties": {
+        "max_clusters": {
+          "description": "The maximal number of active clusters, 0 for unlimited.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "max_images": {
+          "description": "The maximal number of stored discovery images, 0 for unlimited.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "max_storage_bytes": {
+          "description": "The maximal size of the stored discovery images and logs, 0 for unlimited.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        }
+      }
+    },
+    "quota-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/quota"
+      }
+    },
+    "quota-usage": {
+      "type": "object",
+      "properties": {
+        "clusters": {
+          "description": "The number of active clusters, i.e. the clusters that were not installed yet.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "images": {
+          "description": "The number of stored discovery images, including the images of declared hosts.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "storage_bytes": {
+          "description": "The size of the stored discovery images and logs.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "route": {
       "type": "object",
       "properties": {
@@ -14999,6 +20237,28 @@ func init() {
         }
       }
     },
+    "service_network": {
+      "description": "IP address block for service IP blocks.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "The IP block address pool.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "cluster_id": {
+          "description": "The cluster that this network is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
+        },
+        "index": {
+          "description": "The position of the network in the service networks of the cluster. The network at position 0 is the primary one. Set by the service.",
+          "type": "integer"
+        }
+      }
+    },
     "source_state": {
       "type": "string",
       "enum": [
@@ -15010,6 +20270,101 @@ func init() {
         "unreachable"
       ]
     },
+    "static_ip_allocation": {
+      "description": "An address that was allocated to a host from the static IP pool of a cluster.",
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "ip": {
+          "description": "The address allocated to the host.",
+          "type": "string"
+        },
+        "mac_address": {
+          "description": "The MAC address that identifies the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        }
+      }
+    },
+    "static_ip_pool": {
+      "type": "object",
+      "properties": {
+        "bond_mode": {
+          "description": "When set, the NICs of each host are bonded with this mode.",
+          "type": "string",
+          "enum": [
+            "balance-rr",
+            "active-backup",
+            "balance-xor",
+            "broadcast",
+            "802.3ad",
+            "balance-tlb",
+            "balance-alb"
+          ]
+        },
+        "cidr": {
+          "description": "The network from which the host addresses are allocated.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "dns_servers": {
+          "description": "The DNS servers of the hosts.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "gateway": {
+          "description": "The default gateway of the hosts.",
+          "type": "string"
+        },
+        "hosts": {
+          "description": "The hosts that get an address from the pool.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static_ip_pool_host"
+          }
+        },
+        "mac_ranges": {
+          "description": "Ranges of MAC addresses of single NIC hosts that get an address from the pool, one host per MAC address.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/mac_range"
+          }
+        },
+        "range_end": {
+          "description": "The last address that may be allocated. Defaults to the last host address of the network.",
+          "type": "string"
+        },
+        "range_start": {
+          "description": "The first address that may be allocated. Defaults to the first host address of the network.",
+          "type": "string"
+        },
+        "vlan_id": {
+          "description": "When set, the host addresses are configured on a VLAN interface with this ID.",
+          "type": "integer",
+          "maximum": 4094,
+          "minimum": 1
+        }
+      }
+    },
+    "static_ip_pool_host": {
+      "type": "object",
+      "properties": {
+        "mac_addresses": {
+          "description": "The MAC addresses of the host NICs. The first one identifies the host in the address allocation.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
+          }
+        }
+      }
+    },
     "step": {
       "type": "object",
       "properties": {
@@ -15064,7 +20419,8 @@ func init() {
         "ntp-synchronizer",
         "installation-disk-speed-check",
         "container-image-availability",
-        "domain-resolution"
+        "domain-resolution",
+        "upgrade-agent"
       ]
     },
     "steps": {
@@ -15114,6 +20470,38 @@ func init() {
         }
       }
     },
+    "upgrade_agent_request": {
+      "type": "object",
+      "required": [
+        "agent_image"
+      ],
+      "properties": {
+        "agent_image": {
+          "description": "Full image reference of the image that the agent should use.",
+          "type": "string"
+        }
+      }
+    },
+    "upgrade_agent_response": {
+      "type": "object",
+      "properties": {
+        "agent_image": {
+          "description": "Full image reference of the image that the agent has upgraded to.",
+          "type": "string"
+        },
+        "result": {
+          "$ref": "#/definitions/upgrade_agent_result"
+        }
+      }
+    },
+    "upgrade_agent_result": {
+      "description": "Agent upgrade result.",
+      "type": "string",
+      "enum": [
+        "success",
+        "failure"
+      ]
+    },
     "usage": {
       "type": "object",
       "properties": {
@@ -52,24 +52,36 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
 
+		InstallerAbortLogsUploadHandler: installer.AbortLogsUploadHandlerFunc(func(params installer.AbortLogsUploadParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.AbortLogsUpload has not yet been implemented")
+		}),
 		InstallerCancelInstallationHandler: installer.CancelInstallationHandlerFunc(func(params installer.CancelInstallationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.CancelInstallation has not yet been implemented")
 		}),
 		InstallerCompleteInstallationHandler: installer.CompleteInstallationHandlerFunc(func(params installer.CompleteInstallationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.CompleteInstallation has not yet been implemented")
 		}),
+		InstallerCompleteLogsUploadHandler: installer.CompleteLogsUploadHandlerFunc(func(params installer.CompleteLogsUploadParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.CompleteLogsUpload has not yet been implemented")
+		}),
 		ManifestsCreateClusterManifestHandler: manifests.CreateClusterManifestHandlerFunc(func(params manifests.CreateClusterManifestParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.CreateClusterManifest has not yet been implemented")
 		}),
 		AssistedServiceIsoCreateISOAndUploadToS3Handler: assisted_service_iso.CreateISOAndUploadToS3HandlerFunc(func(params assisted_service_iso.CreateISOAndUploadToS3Params, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation assisted_service_iso.CreateISOAndUploadToS3 has not yet been implemented")
 		}),
+		InstallerCreateLogsUploadHandler: installer.CreateLogsUploadHandlerFunc(func(params installer.CreateLogsUploadParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.CreateLogsUpload has not yet been implemented")
+		}),
 		ManifestsDeleteClusterManifestHandler: manifests.DeleteClusterManifestHandlerFunc(func(params manifests.DeleteClusterManifestParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.DeleteClusterManifest has not yet been implemented")
 		}),
 		InstallerDeregisterClusterHandler: installer.DeregisterClusterHandlerFunc(func(params installer.DeregisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DeregisterCluster has not yet been implemented")
 		}),
+		InstallerDeregisterDeclaredHostHandler: installer.DeregisterDeclaredHostHandlerFunc(func(params installer.DeregisterDeclaredHostParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.DeregisterDeclaredHost has not yet been implemented")
+		}),
 		InstallerDeregisterHostHandler: installer.DeregisterHostHandlerFunc(func(params installer.DeregisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DeregisterHost has not yet been implemented")
 		}),
@@ -85,6 +97,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerDownloadClusterISOHeadersHandler: installer.DownloadClusterISOHeadersHandlerFunc(func(params installer.DownloadClusterISOHeadersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DownloadClusterISOHeaders has not yet been implemented")
 		}),
+		InstallerDownloadClusterISOSignatureHandler: installer.DownloadClusterISOSignatureHandlerFunc(func(params installer.DownloadClusterISOSignatureParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.DownloadClusterISOSignature has not yet been implemented")
+		}),
 		InstallerDownloadClusterKubeconfigHandler: installer.DownloadClusterKubeconfigHandlerFunc(func(params installer.DownloadClusterKubeconfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DownloadClusterKubeconfig has not yet been implemented")
 		}),
@@ -94,6 +109,15 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		ManifestsDownloadClusterManifestHandler: manifests.DownloadClusterManifestHandlerFunc(func(params manifests.DownloadClusterManifestParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.DownloadClusterManifest has not yet been implemented")
 		}),
+		InstallerDownloadClusterPxeArtifactHandler: installer.DownloadClusterPxeArtifactHandlerFunc(func(params installer.DownloadClusterPxeArtifactParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.DownloadClusterPxeArtifact has not yet been implemented")
+		}),
+		InstallerDownloadClusterSupportBundleHandler: installer.DownloadClusterSupportBundleHandlerFunc(func(params installer.DownloadClusterSupportBundleParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.DownloadClusterSupportBundle has not yet been implemented")
+		}),
+		InstallerDownloadDeclaredHostISOHandler: installer.DownloadDeclaredHostISOHandlerFunc(func(params installer.DownloadDeclaredHostISOParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.DownloadDeclaredHostISO has not yet been implemented")
+		}),
 		InstallerDownloadHostIgnitionHandler: installer.DownloadHostIgnitionHandlerFunc(func(params installer.DownloadHostIgnitionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DownloadHostIgnition has not yet been implemented")
 		}),
@@ -103,12 +127,24 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		AssistedServiceIsoDownloadISOHandler: assisted_service_iso.DownloadISOHandlerFunc(func(params assisted_service_iso.DownloadISOParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation assisted_service_iso.DownloadISO has not yet been implemented")
 		}),
+		InstallerDownloadImageSigningKeyHandler: installer.DownloadImageSigningKeyHandlerFunc(func(params installer.DownloadImageSigningKeyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.DownloadImageSigningKey has not yet been implemented")
+		}),
+		InstallerDownloadLogsArchiveEntryHandler: installer.DownloadLogsArchiveEntryHandlerFunc(func(params installer.DownloadLogsArchiveEntryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.DownloadLogsArchiveEntry has not yet been implemented")
+		}),
 		InstallerEnableHostHandler: installer.EnableHostHandlerFunc(func(params installer.EnableHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.EnableHost has not yet been implemented")
 		}),
 		InstallerGenerateClusterISOHandler: installer.GenerateClusterISOHandlerFunc(func(params installer.GenerateClusterISOParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GenerateClusterISO has not yet been implemented")
 		}),
+		InstallerGenerateDeclaredHostISOHandler: installer.GenerateDeclaredHostISOHandlerFunc(func(params installer.GenerateDeclaredHostISOParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GenerateDeclaredHostISO has not yet been implemented")
+		}),
+		InstallerGetArchivedClusterHandler: installer.GetArchivedClusterHandlerFunc(func(params installer.GetArchivedClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetArchivedCluster has not yet been implemented")
+		}),
 		InstallerGetClusterHandler: installer.GetClusterHandlerFunc(func(params installer.GetClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetCluster has not yet been implemented")
 		}),
@@ -121,6 +157,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerGetClusterInstallConfigHandler: installer.GetClusterInstallConfigHandlerFunc(func(params installer.GetClusterInstallConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetClusterInstallConfig has not yet been implemented")
 		}),
+		InstallerGetClusterLogsAnalysisHandler: installer.GetClusterLogsAnalysisHandlerFunc(func(params installer.GetClusterLogsAnalysisParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetClusterLogsAnalysis has not yet been implemented")
+		}),
 		InstallerGetCredentialsHandler: installer.GetCredentialsHandlerFunc(func(params installer.GetCredentialsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetCredentials has not yet been implemented")
 		}),
@@ -136,9 +175,15 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerGetHostIgnitionHandler: installer.GetHostIgnitionHandlerFunc(func(params installer.GetHostIgnitionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetHostIgnition has not yet been implemented")
 		}),
+		InstallerGetHostIgnitionPreviewHandler: installer.GetHostIgnitionPreviewHandlerFunc(func(params installer.GetHostIgnitionPreviewParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetHostIgnitionPreview has not yet been implemented")
+		}),
 		InstallerGetHostRequirementsHandler: installer.GetHostRequirementsHandlerFunc(func(params installer.GetHostRequirementsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetHostRequirements has not yet been implemented")
 		}),
+		InstallerGetLogsUploadHandler: installer.GetLogsUploadHandlerFunc(func(params installer.GetLogsUploadParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetLogsUpload has not yet been implemented")
+		}),
 		InstallerGetNextStepsHandler: installer.GetNextStepsHandlerFunc(func(params installer.GetNextStepsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetNextSteps has not yet been implemented")
 		}),
@@ -151,6 +196,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerGetPresignedForClusterFilesHandler: installer.GetPresignedForClusterFilesHandlerFunc(func(params installer.GetPresignedForClusterFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetPresignedForClusterFiles has not yet been implemented")
 		}),
+		InstallerGetQuotaHandler: installer.GetQuotaHandlerFunc(func(params installer.GetQuotaParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetQuota has not yet been implemented")
+		}),
 		InstallerInstallClusterHandler: installer.InstallClusterHandlerFunc(func(params installer.InstallClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.InstallCluster has not yet been implemented")
 		}),
@@ -169,12 +217,18 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		VersionsListComponentVersionsHandler: versions.ListComponentVersionsHandlerFunc(func(params versions.ListComponentVersionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation versions.ListComponentVersions has not yet been implemented")
 		}),
+		InstallerListDeclaredHostsHandler: installer.ListDeclaredHostsHandlerFunc(func(params installer.ListDeclaredHostsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListDeclaredHosts has not yet been implemented")
+		}),
 		EventsListEventsHandler: events.ListEventsHandlerFunc(func(params events.ListEventsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation events.ListEvents has not yet been implemented")
 		}),
 		InstallerListHostsHandler: installer.ListHostsHandlerFunc(func(params installer.ListHostsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ListHosts has not yet been implemented")
 		}),
+		InstallerListLogsArchiveEntriesHandler: installer.ListLogsArchiveEntriesHandlerFunc(func(params installer.ListLogsArchiveEntriesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListLogsArchiveEntries has not yet been implemented")
+		}),
 		ManagedDomainsListManagedDomainsHandler: managed_domains.ListManagedDomainsHandlerFunc(func(params managed_domains.ListManagedDomainsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation managed_domains.ListManagedDomains has not yet been implemented")
 		}),
@@ -184,6 +238,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		OperatorsListOperatorPropertiesHandler: operators.ListOperatorPropertiesHandlerFunc(func(params operators.ListOperatorPropertiesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.ListOperatorProperties has not yet been implemented")
 		}),
+		InstallerListQuotasHandler: installer.ListQuotasHandlerFunc(func(params installer.ListQuotasParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListQuotas has not yet been implemented")
+		}),
 		VersionsListSupportedOpenshiftVersionsHandler: versions.ListSupportedOpenshiftVersionsHandlerFunc(func(params versions.ListSupportedOpenshiftVersionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation versions.ListSupportedOpenshiftVersions has not yet been implemented")
 		}),
@@ -199,6 +256,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerRegisterClusterHandler: installer.RegisterClusterHandlerFunc(func(params installer.RegisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.RegisterCluster has not yet been implemented")
 		}),
+		InstallerRegisterDeclaredHostHandler: installer.RegisterDeclaredHostHandlerFunc(func(params installer.RegisterDeclaredHostParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.RegisterDeclaredHost has not yet been implemented")
+		}),
 		InstallerRegisterHostHandler: installer.RegisterHostHandlerFunc(func(params installer.RegisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.RegisterHost has not yet been implemented")
 		}),
@@ -214,6 +274,12 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerResetHostValidationHandler: installer.ResetHostValidationHandlerFunc(func(params installer.ResetHostValidationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ResetHostValidation has not yet been implemented")
 		}),
+		InstallerRestoreArchivedClusterHandler: installer.RestoreArchivedClusterHandlerFunc(func(params installer.RestoreArchivedClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.RestoreArchivedCluster has not yet been implemented")
+		}),
+		InstallerSearchLogsArchiveHandler: installer.SearchLogsArchiveHandlerFunc(func(params installer.SearchLogsArchiveParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.SearchLogsArchive has not yet been implemented")
+		}),
 		InstallerUpdateClusterHandler: installer.UpdateClusterHandlerFunc(func(params installer.UpdateClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateCluster has not yet been implemented")
 		}),
@@ -238,6 +304,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerUpdateHostLogsProgressHandler: installer.UpdateHostLogsProgressHandlerFunc(func(params installer.UpdateHostLogsProgressParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateHostLogsProgress has not yet been implemented")
 		}),
+		InstallerUpdateQuotaLimitsHandler: installer.UpdateQuotaLimitsHandlerFunc(func(params installer.UpdateQuotaLimitsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.UpdateQuotaLimits has not yet been implemented")
+		}),
 		InstallerUploadClusterIngressCertHandler: installer.UploadClusterIngressCertHandlerFunc(func(params installer.UploadClusterIngressCertParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UploadClusterIngressCert has not yet been implemented")
 		}),
@@ -247,6 +316,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerUploadLogsHandler: installer.UploadLogsHandlerFunc(func(params installer.UploadLogsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UploadLogs has not yet been implemented")
 		}),
+		InstallerUploadLogsChunkHandler: installer.UploadLogsChunkHandlerFunc(func(params installer.UploadLogsChunkParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.UploadLogsChunk has not yet been implemented")
+		}),
 
 		// Applies when the "X-Secret-Key" header is set
 		AgentAuthAuth: func(token string) (interface{}, error) {
@@ -317,18 +389,26 @@ type AssistedInstallAPI struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// InstallerAbortLogsUploadHandler sets the operation handler for the abort logs upload operation
+	InstallerAbortLogsUploadHandler installer.AbortLogsUploadHandler
 	// InstallerCancelInstallationHandler sets the operation handler for the cancel installation operation
 	InstallerCancelInstallationHandler installer.CancelInstallationHandler
 	// InstallerCompleteInstallationHandler sets the operation handler for the complete installation operation
 	InstallerCompleteInstallationHandler installer.CompleteInstallationHandler
+	// InstallerCompleteLogsUploadHandler sets the operation handler for the complete logs upload operation
+	InstallerCompleteLogsUploadHandler installer.CompleteLogsUploadHandler
 	// ManifestsCreateClusterManifestHandler sets the operation handler for the create cluster manifest operation
 	ManifestsCreateClusterManifestHandler manifests.CreateClusterManifestHandler
 	// AssistedServiceIsoCreateISOAndUploadToS3Handler sets the operation handler for the create i s o and upload to s3 operation
 	AssistedServiceIsoCreateISOAndUploadToS3Handler assisted_service_iso.CreateISOAndUploadToS3Handler
+	// InstallerCreateLogsUploadHandler sets the operation handler for the create logs upload operation
+	InstallerCreateLogsUploadHandler installer.CreateLogsUploadHandler
 	// ManifestsDeleteClusterManifestHandler sets the operation handler for the delete cluster manifest operation
 	ManifestsDeleteClusterManifestHandler manifests.DeleteClusterManifestHandler
 	// InstallerDeregisterClusterHandler sets the operation handler for the deregister cluster operation
 	InstallerDeregisterClusterHandler installer.DeregisterClusterHandler
+	// InstallerDeregisterDeclaredHostHandler sets the operation handler for the deregister declared host operation
+	InstallerDeregisterDeclaredHostHandler installer.DeregisterDeclaredHostHandler
 	// InstallerDeregisterHostHandler sets the operation handler for the deregister host operation
 	InstallerDeregisterHostHandler installer.DeregisterHostHandler
 	// InstallerDisableHostHandler sets the operation handler for the disable host operation
@@ -339,22 +419,38 @@ type AssistedInstallAPI struct {
 	InstallerDownloadClusterISOHandler installer.DownloadClusterISOHandler
 	// InstallerDownloadClusterISOHeadersHandler sets the operation handler for the download cluster i s o headers operation
 	InstallerDownloadClusterISOHeadersHandler installer.DownloadClusterISOHeadersHandler
+	// InstallerDownloadClusterISOSignatureHandler sets the operation handler for the download cluster i s o signature operation
+	InstallerDownloadClusterISOSignatureHandler installer.DownloadClusterISOSignatureHandler
 	// InstallerDownloadClusterKubeconfigHandler sets the operation handler for the download cluster kubeconfig operation
 	InstallerDownloadClusterKubeconfigHandler installer.DownloadClusterKubeconfigHandler
 	// InstallerDownloadClusterLogsHandler sets the operation handler for the download cluster logs operation
 	InstallerDownloadClusterLogsHandler installer.DownloadClusterLogsHandler
 	// ManifestsDownloadClusterManifestHandler sets the operation handler for the download cluster manifest operation
 	ManifestsDownloadClusterManifestHandler manifests.DownloadClusterManifestHandler
+	// InstallerDownloadClusterPxeArtifactHandler sets the operation handler for the download cluster pxe artifact operation
+	InstallerDownloadClusterPxeArtifactHandler installer.DownloadClusterPxeArtifactHandler
+	// InstallerDownloadClusterSupportBundleHandler sets the operation handler for the download cluster support bundle operation
+	InstallerDownloadClusterSupportBundleHandler installer.DownloadClusterSupportBundleHandler
+	// InstallerDownloadDeclaredHostISOHandler sets the operation handler for the download declared host i s o operation
+	InstallerDownloadDeclaredHostISOHandler installer.DownloadDeclaredHostISOHandler
 	// InstallerDownloadHostIgnitionHandler sets the operation handler for the download host ignition operation
 	InstallerDownloadHostIgnitionHandler installer.DownloadHostIgnitionHandler
 	// InstallerDownloadHostLogsHandler sets the operation handler for the download host logs operation
 	InstallerDownloadHostLogsHandler installer.DownloadHostLogsHandler
 	// AssistedServiceIsoDownloadISOHandler sets the operation handler for the download i s o operation
 	AssistedServiceIsoDownloadISOHandler assisted_service_iso.DownloadISOHandler
+	// InstallerDownloadImageSigningKeyHandler sets the operation handler for the download image signing key operation
+	InstallerDownloadImageSigningKeyHandler installer.DownloadImageSigningKeyHandler
+	// InstallerDownloadLogsArchiveEntryHandler sets the operation handler for the download logs archive entry operation
+	InstallerDownloadLogsArchiveEntryHandler installer.DownloadLogsArchiveEntryHandler
 	// InstallerEnableHostHandler sets the operation handler for the enable host operation
 	InstallerEnableHostHandler installer.EnableHostHandler
 	// InstallerGenerateClusterISOHandler sets the operation handler for the generate cluster i s o operation
 	InstallerGenerateClusterISOHandler installer.GenerateClusterISOHandler
+	// InstallerGenerateDeclaredHostISOHandler sets the operation handler for the generate declared host i s o operation
+	InstallerGenerateDeclaredHostISOHandler installer.GenerateDeclaredHostISOHandler
+	// InstallerGetArchivedClusterHandler sets the operation handler for the get archived cluster operation
+	InstallerGetArchivedClusterHandler installer.GetArchivedClusterHandler
 	// InstallerGetClusterHandler sets the operation handler for the get cluster operation
 	InstallerGetClusterHandler installer.GetClusterHandler
 	// InstallerGetClusterDefaultConfigHandler sets the operation handler for the get cluster default config operation
@@ -363,6 +459,8 @@ type AssistedInstallAPI struct {
 	InstallerGetClusterHostRequirementsHandler installer.GetClusterHostRequirementsHandler
 	// InstallerGetClusterInstallConfigHandler sets the operation handler for the get cluster install config operation
 	InstallerGetClusterInstallConfigHandler installer.GetClusterInstallConfigHandler
+	// InstallerGetClusterLogsAnalysisHandler sets the operation handler for the get cluster logs analysis operation
+	InstallerGetClusterLogsAnalysisHandler installer.GetClusterLogsAnalysisHandler
 	// InstallerGetCredentialsHandler sets the operation handler for the get credentials operation
 	InstallerGetCredentialsHandler installer.GetCredentialsHandler
 	// InstallerGetDiscoveryIgnitionHandler sets the operation handler for the get discovery ignition operation
@@ -373,8 +471,12 @@ type AssistedInstallAPI struct {
 	InstallerGetHostHandler installer.GetHostHandler
 	// InstallerGetHostIgnitionHandler sets the operation handler for the get host ignition operation
 	InstallerGetHostIgnitionHandler installer.GetHostIgnitionHandler
+	// InstallerGetHostIgnitionPreviewHandler sets the operation handler for the get host ignition preview operation
+	InstallerGetHostIgnitionPreviewHandler installer.GetHostIgnitionPreviewHandler
 	// InstallerGetHostRequirementsHandler sets the operation handler for the get host requirements operation
 	InstallerGetHostRequirementsHandler installer.GetHostRequirementsHandler
+	// InstallerGetLogsUploadHandler sets the operation handler for the get logs upload operation
+	InstallerGetLogsUploadHandler installer.GetLogsUploadHandler
 	// InstallerGetNextStepsHandler sets the operation handler for the get next steps operation
 	InstallerGetNextStepsHandler installer.GetNextStepsHandler
 	// InstallerGetPreflightRequirementsHandler sets the operation handler for the get preflight requirements operation
@@ -383,6 +485,8 @@ type AssistedInstallAPI struct {
 	AssistedServiceIsoGetPresignedForAssistedServiceISOHandler assisted_service_iso.GetPresignedForAssistedServiceISOHandler
 	// InstallerGetPresignedForClusterFilesHandler sets the operation handler for the get presigned for cluster files operation
 	InstallerGetPresignedForClusterFilesHandler installer.GetPresignedForClusterFilesHandler
+	// InstallerGetQuotaHandler sets the operation handler for the get quota operation
+	InstallerGetQuotaHandler installer.GetQuotaHandler
 	// InstallerInstallClusterHandler sets the operation handler for the install cluster operation
 	InstallerInstallClusterHandler installer.InstallClusterHandler
 	// InstallerInstallHostHandler sets the operation handler for the install host operation
@@ -395,16 +499,22 @@ type AssistedInstallAPI struct {
 	InstallerListClustersHandler installer.ListClustersHandler
 	// VersionsListComponentVersionsHandler sets the operation handler for the list component versions operation
 	VersionsListComponentVersionsHandler versions.ListComponentVersionsHandler
+	// InstallerListDeclaredHostsHandler sets the operation handler for the list declared hosts operation
+	InstallerListDeclaredHostsHandler installer.ListDeclaredHostsHandler
 	// EventsListEventsHandler sets the operation handler for the list events operation
 	EventsListEventsHandler events.ListEventsHandler
 	// InstallerListHostsHandler sets the operation handler for the list hosts operation
 	InstallerListHostsHandler installer.ListHostsHandler
+	// InstallerListLogsArchiveEntriesHandler sets the operation handler for the list logs archive entries operation
+	InstallerListLogsArchiveEntriesHandler installer.ListLogsArchiveEntriesHandler
 	// ManagedDomainsListManagedDomainsHandler sets the operation handler for the list managed domains operation
 	ManagedDomainsListManagedDomainsHandler managed_domains.ListManagedDomainsHandler
 	// OperatorsListOfClusterOperatorsHandler sets the operation handler for the list of cluster operators operation
 	OperatorsListOfClusterOperatorsHandler operators.ListOfClusterOperatorsHandler
 	// OperatorsListOperatorPropertiesHandler sets the operation handler for the list operator properties operation
 	OperatorsListOperatorPropertiesHandler operators.ListOperatorPropertiesHandler
+	// InstallerListQuotasHandler sets the operation handler for the list quotas operation
+	InstallerListQuotasHandler installer.ListQuotasHandler
 	// VersionsListSupportedOpenshiftVersionsHandler sets the operation handler for the list supported openshift versions operation
 	VersionsListSupportedOpenshiftVersionsHandler versions.ListSupportedOpenshiftVersionsHandler
 	// OperatorsListSupportedOperatorsHandler sets the operation handler for the list supported operators operation
@@ -415,6 +525,8 @@ type AssistedInstallAPI struct {
 	InstallerRegisterAddHostsClusterHandler installer.RegisterAddHostsClusterHandler
 	// InstallerRegisterClusterHandler sets the operation handler for the register cluster operation
 	InstallerRegisterClusterHandler installer.RegisterClusterHandler
+	// InstallerRegisterDeclaredHostHandler sets the operation handler for the register declared host operation
+	InstallerRegisterDeclaredHostHandler installer.RegisterDeclaredHostHandler
 	// InstallerRegisterHostHandler sets the operation handler for the register host operation
 	InstallerRegisterHostHandler installer.RegisterHostHandler
 	// OperatorsReportMonitoredOperatorStatusHandler sets the operation handler for the report monitored operator status operation
@@ -425,6 +537,10 @@ type AssistedInstallAPI struct {
 	InstallerResetHostHandler installer.ResetHostHandler
 	// InstallerResetHostValidationHandler sets the operation handler for the reset host validation operation
 	InstallerResetHostValidationHandler installer.ResetHostValidationHandler
+	// InstallerRestoreArchivedClusterHandler sets the operation handler for the restore archived cluster operation
+	InstallerRestoreArchivedClusterHandler installer.RestoreArchivedClusterHandler
+	// InstallerSearchLogsArchiveHandler sets the operation handler for the search logs archive operation
+	InstallerSearchLogsArchiveHandler installer.SearchLogsArchiveHandler
 	// InstallerUpdateClusterHandler sets the operation handler for the update cluster operation
 	InstallerUpdateClusterHandler installer.UpdateClusterHandler
 	// InstallerUpdateClusterInstallConfigHandler sets the operation handler for the update cluster install config operation
@@ -441,12 +557,16 @@ type AssistedInstallAPI struct {
 	InstallerUpdateHostInstallerArgsHandler installer.UpdateHostInstallerArgsHandler
 	// InstallerUpdateHostLogsProgressHandler sets the operation handler for the update host logs progress operation
 	InstallerUpdateHostLogsProgressHandler installer.UpdateHostLogsProgressHandler
+	// InstallerUpdateQuotaLimitsHandler sets the operation handler for the update quota limits operation
+	InstallerUpdateQuotaLimitsHandler installer.UpdateQuotaLimitsHandler
 	// InstallerUploadClusterIngressCertHandler sets the operation handler for the upload cluster ingress cert operation
 	InstallerUploadClusterIngressCertHandler installer.UploadClusterIngressCertHandler
 	// InstallerUploadHostLogsHandler sets the operation handler for the upload host logs operation
 	InstallerUploadHostLogsHandler installer.UploadHostLogsHandler
 	// InstallerUploadLogsHandler sets the operation handler for the upload logs operation
 	InstallerUploadLogsHandler installer.UploadLogsHandler
+	// InstallerUploadLogsChunkHandler sets the operation handler for the upload logs chunk operation
+	InstallerUploadLogsChunkHandler installer.UploadLogsChunkHandler
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
 	ServeError func(http.ResponseWriter, *http.Request, error)
@@ -539,24 +659,36 @@ func (o *AssistedInstallAPI) Validate() error {
 		unregistered = append(unregistered, "AuthorizationAuth")
 	}
 
+	if o.InstallerAbortLogsUploadHandler == nil {
+		unregistered = append(unregistered, "installer.AbortLogsUploadHandler")
+	}
 	if o.InstallerCancelInstallationHandler == nil {
 		unregistered = append(unregistered, "installer.CancelInstallationHandler")
 	}
 	if o.InstallerCompleteInstallationHandler == nil {
 		unregistered = append(unregistered, "installer.CompleteInstallationHandler")
 	}
+	if o.InstallerCompleteLogsUploadHandler == nil {
+		unregistered = append(unregistered, "installer.CompleteLogsUploadHandler")
+	}
 	if o.ManifestsCreateClusterManifestHandler == nil {
 		unregistered = append(unregistered, "manifests.CreateClusterManifestHandler")
 	}
 	if o.AssistedServiceIsoCreateISOAndUploadToS3Handler == nil {
 		unregistered = append(unregistered, "assisted_service_iso.CreateISOAndUploadToS3Handler")
 	}
+	if o.InstallerCreateLogsUploadHandler == nil {
+		unregistered = append(unregistered, "installer.CreateLogsUploadHandler")
+	}
 	if o.ManifestsDeleteClusterManifestHandler == nil {
 		unregistered = append(unregistered, "manifests.DeleteClusterManifestHandler")
 	}
 	if o.InstallerDeregisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.DeregisterClusterHandler")
 	}
+	if o.InstallerDeregisterDeclaredHostHandler == nil {
+		unregistered = append(unregistered, "installer.DeregisterDeclaredHostHandler")
+	}
 	if o.InstallerDeregisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.DeregisterHostHandler")
 	}
@@ -572,6 +704,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerDownloadClusterISOHeadersHandler == nil {
 		unregistered = append(unregistered, "installer.DownloadClusterISOHeadersHandler")
 	}
+	if o.InstallerDownloadClusterISOSignatureHandler == nil {
+		unregistered = append(unregistered, "installer.DownloadClusterISOSignatureHandler")
+	}
 	if o.InstallerDownloadClusterKubeconfigHandler == nil {
 		unregistered = append(unregistered, "installer.DownloadClusterKubeconfigHandler")
 	}
@@ -581,6 +716,15 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.ManifestsDownloadClusterManifestHandler == nil {
 		unregistered = append(unregistered, "manifests.DownloadClusterManifestHandler")
 	}
+	if o.InstallerDownloadClusterPxeArtifactHandler == nil {
+		unregistered = append(unregistered, "installer.DownloadClusterPxeArtifactHandler")
+	}
+	if o.InstallerDownloadClusterSupportBundleHandler == nil {
+		unregistered = append(unregistered, "installer.DownloadClusterSupportBundleHandler")
+	}
+	if o.InstallerDownloadDeclaredHostISOHandler == nil {
+		unregistered = append(unregistered, "installer.DownloadDeclaredHostISOHandler")
+	}
 	if o.InstallerDownloadHostIgnitionHandler == nil {
 		unregistered = append(unregistered, "installer.DownloadHostIgnitionHandler")
 	}
@@ -590,12 +734,24 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.AssistedServiceIsoDownloadISOHandler == nil {
 		unregistered = append(unregistered, "assisted_service_iso.DownloadISOHandler")
 	}
+	if o.InstallerDownloadImageSigningKeyHandler == nil {
+		unregistered = append(unregistered, "installer.DownloadImageSigningKeyHandler")
+	}
+	if o.InstallerDownloadLogsArchiveEntryHandler == nil {
+		unregistered = append(unregistered, "installer.DownloadLogsArchiveEntryHandler")
+	}
 	if o.InstallerEnableHostHandler == nil {
 		unregistered = append(unregistered, "installer.EnableHostHandler")
 	}
 	if o.InstallerGenerateClusterISOHandler == nil {
 		unregistered = append(unregistered, "installer.GenerateClusterISOHandler")
 	}
+	if o.InstallerGenerateDeclaredHostISOHandler == nil {
+		unregistered = append(unregistered, "installer.GenerateDeclaredHostISOHandler")
+	}
+	if o.InstallerGetArchivedClusterHandler == nil {
+		unregistered = append(unregistered, "installer.GetArchivedClusterHandler")
+	}
 	if o.InstallerGetClusterHandler == nil {
 		unregistered = append(unregistered, "installer.GetClusterHandler")
 	}
@@ -608,6 +764,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerGetClusterInstallConfigHandler == nil {
 		unregistered = append(unregistered, "installer.GetClusterInstallConfigHandler")
 	}
+	if o.InstallerGetClusterLogsAnalysisHandler == nil {
+		unregistered = append(unregistered, "installer.GetClusterLogsAnalysisHandler")
+	}
 	if o.InstallerGetCredentialsHandler == nil {
 		unregistered = append(unregistered, "installer.GetCredentialsHandler")
 	}
@@ -623,9 +782,15 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerGetHostIgnitionHandler == nil {
 		unregistered = append(unregistered, "installer.GetHostIgnitionHandler")
 	}
+	if o.InstallerGetHostIgnitionPreviewHandler == nil {
+		unregistered = append(unregistered, "installer.GetHostIgnitionPreviewHandler")
+	}
 	if o.InstallerGetHostRequirementsHandler == nil {
 		unregistered = append(unregistered, "installer.GetHostRequirementsHandler")
 	}
+	if o.InstallerGetLogsUploadHandler == nil {
+		unregistered = append(unregistered, "installer.GetLogsUploadHandler")
+	}
 	if o.InstallerGetNextStepsHandler == nil {
 		unregistered = append(unregistered, "installer.GetNextStepsHandler")
 	}
@@ -638,6 +803,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerGetPresignedForClusterFilesHandler == nil {
 		unregistered = append(unregistered, "installer.GetPresignedForClusterFilesHandler")
 	}
+	if o.InstallerGetQuotaHandler == nil {
+		unregistered = append(unregistered, "installer.GetQuotaHandler")
+	}
 	if o.InstallerInstallClusterHandler == nil {
 		unregistered = append(unregistered, "installer.InstallClusterHandler")
 	}
@@ -656,12 +824,18 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.VersionsListComponentVersionsHandler == nil {
 		unregistered = append(unregistered, "versions.ListComponentVersionsHandler")
 	}
+	if o.InstallerListDeclaredHostsHandler == nil {
+		unregistered = append(unregistered, "installer.ListDeclaredHostsHandler")
+	}
 	if o.EventsListEventsHandler == nil {
 		unregistered = append(unregistered, "events.ListEventsHandler")
 	}
 	if o.InstallerListHostsHandler == nil {
 		unregistered = append(unregistered, "installer.ListHostsHandler")
 	}
+	if o.InstallerListLogsArchiveEntriesHandler == nil {
+		unregistered = append(unregistered, "installer.ListLogsArchiveEntriesHandler")
+	}
 	if o.ManagedDomainsListManagedDomainsHandler == nil {
 		unregistered = append(unregistered, "managed_domains.ListManagedDomainsHandler")
 	}
@@ -671,6 +845,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.OperatorsListOperatorPropertiesHandler == nil {
 		unregistered = append(unregistered, "operators.ListOperatorPropertiesHandler")
 	}
+	if o.InstallerListQuotasHandler == nil {
+		unregistered = append(unregistered, "installer.ListQuotasHandler")
+	}
 	if o.VersionsListSupportedOpenshiftVersionsHandler == nil {
 		unregistered = append(unregistered, "versions.ListSupportedOpenshiftVersionsHandler")
 	}
@@ -686,6 +863,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerRegisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.RegisterClusterHandler")
 	}
+	if o.InstallerRegisterDeclaredHostHandler == nil {
+		unregistered = append(unregistered, "installer.RegisterDeclaredHostHandler")
+	}
 	if o.InstallerRegisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.RegisterHostHandler")
 	}
@@ -701,6 +881,12 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerResetHostValidationHandler == nil {
 		unregistered = append(unregistered, "installer.ResetHostValidationHandler")
 	}
+	if o.InstallerRestoreArchivedClusterHandler == nil {
+		unregistered = append(unregistered, "installer.RestoreArchivedClusterHandler")
+	}
+	if o.InstallerSearchLogsArchiveHandler == nil {
+		unregistered = append(unregistered, "installer.SearchLogsArchiveHandler")
+	}
 	if o.InstallerUpdateClusterHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateClusterHandler")
 	}
@@ -725,6 +911,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerUpdateHostLogsProgressHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateHostLogsProgressHandler")
 	}
+	if o.InstallerUpdateQuotaLimitsHandler == nil {
+		unregistered = append(unregistered, "installer.UpdateQuotaLimitsHandler")
+	}
 	if o.InstallerUploadClusterIngressCertHandler == nil {
 		unregistered = append(unregistered, "installer.UploadClusterIngressCertHandler")
 	}
@@ -734,6 +923,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerUploadLogsHandler == nil {
 		unregistered = append(unregistered, "installer.UploadLogsHandler")
 	}
+	if o.InstallerUploadLogsChunkHandler == nil {
+		unregistered = append(unregistered, "installer.UploadLogsChunkHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -843,6 +1035,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/clusters/{cluster_id}/logs/uploads/{upload_id}"] = installer.NewAbortLogsUpload(o.context, o.InstallerAbortLogsUploadHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -854,11 +1050,19 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/clusters/{cluster_id}/logs/uploads/{upload_id}/actions/complete"] = installer.NewCompleteLogsUpload(o.context, o.InstallerCompleteLogsUploadHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/clusters/{cluster_id}/manifests"] = manifests.NewCreateClusterManifest(o.context, o.ManifestsCreateClusterManifestHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/assisted-service-iso"] = assisted_service_iso.NewCreateISOAndUploadToS3(o.context, o.AssistedServiceIsoCreateISOAndUploadToS3Handler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clusters/{cluster_id}/logs/uploads"] = installer.NewCreateLogsUpload(o.context, o.InstallerCreateLogsUploadHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -870,6 +1074,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/clusters/{cluster_id}/declared-hosts/{declared_host_id}"] = installer.NewDeregisterDeclaredHost(o.context, o.InstallerDeregisterDeclaredHostHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/clusters/{cluster_id}/hosts/{host_id}"] = installer.NewDeregisterHost(o.context, o.InstallerDeregisterHostHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -890,6 +1098,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/downloads/image-signature"] = installer.NewDownloadClusterISOSignature(o.context, o.InstallerDownloadClusterISOSignatureHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/downloads/kubeconfig"] = installer.NewDownloadClusterKubeconfig(o.context, o.InstallerDownloadClusterKubeconfigHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -902,6 +1114,18 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/downloads/pxe-artifacts"] = installer.NewDownloadClusterPxeArtifact(o.context, o.InstallerDownloadClusterPxeArtifactHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/support-bundle"] = installer.NewDownloadClusterSupportBundle(o.context, o.InstallerDownloadClusterSupportBundleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/declared-hosts/{declared_host_id}/downloads/image"] = installer.NewDownloadDeclaredHostISO(o.context, o.InstallerDownloadDeclaredHostISOHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition"] = installer.NewDownloadHostIgnition(o.context, o.InstallerDownloadHostIgnitionHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -911,6 +1135,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/assisted-service-iso/data"] = assisted_service_iso.NewDownloadISO(o.context, o.AssistedServiceIsoDownloadISOHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/image-signing-key"] = installer.NewDownloadImageSigningKey(o.context, o.InstallerDownloadImageSigningKeyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/logs/entries/download"] = installer.NewDownloadLogsArchiveEntry(o.context, o.InstallerDownloadLogsArchiveEntryHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -919,6 +1151,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/clusters/{cluster_id}/downloads/image"] = installer.NewGenerateClusterISO(o.context, o.InstallerGenerateClusterISOHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clusters/{cluster_id}/declared-hosts/{declared_host_id}/downloads/image"] = installer.NewGenerateDeclaredHostISO(o.context, o.InstallerGenerateDeclaredHostISOHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/archived-clusters/{cluster_id}"] = installer.NewGetArchivedCluster(o.context, o.InstallerGetArchivedClusterHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -938,6 +1178,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/logs/analysis"] = installer.NewGetClusterLogsAnalysis(o.context, o.InstallerGetClusterLogsAnalysisHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/credentials"] = installer.NewGetCredentials(o.context, o.InstallerGetCredentialsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -958,10 +1202,18 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}/ignition-preview"] = installer.NewGetHostIgnitionPreview(o.context, o.InstallerGetHostIgnitionPreviewHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/host_requirements"] = installer.NewGetHostRequirements(o.context, o.InstallerGetHostRequirementsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/logs/uploads/{upload_id}"] = installer.NewGetLogsUpload(o.context, o.InstallerGetLogsUploadHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}/instructions"] = installer.NewGetNextSteps(o.context, o.InstallerGetNextStepsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -975,6 +1227,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/clusters/{cluster_id}/downloads/files-presigned"] = installer.NewGetPresignedForClusterFiles(o.context, o.InstallerGetPresignedForClusterFilesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/quotas/{scope}/{owner}"] = installer.NewGetQuota(o.context, o.InstallerGetQuotaHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1002,6 +1258,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/declared-hosts"] = installer.NewListDeclaredHosts(o.context, o.InstallerListDeclaredHostsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/events"] = events.NewListEvents(o.context, o.EventsListEventsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1010,6 +1270,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/logs/entries"] = installer.NewListLogsArchiveEntries(o.context, o.InstallerListLogsArchiveEntriesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/domains"] = managed_domains.NewListManagedDomains(o.context, o.ManagedDomainsListManagedDomainsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1022,6 +1286,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/quotas"] = installer.NewListQuotas(o.context, o.InstallerListQuotasHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/openshift_versions"] = versions.NewListSupportedOpenshiftVersions(o.context, o.VersionsListSupportedOpenshiftVersionsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1042,6 +1310,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/clusters/{cluster_id}/declared-hosts"] = installer.NewRegisterDeclaredHost(o.context, o.InstallerRegisterDeclaredHostHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/clusters/{cluster_id}/hosts"] = installer.NewRegisterHost(o.context, o.InstallerRegisterHostHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
@@ -1059,6 +1331,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
 	o.handlers["PATCH"]["/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}"] = installer.NewResetHostValidation(o.context, o.InstallerResetHostValidationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/archived-clusters/{cluster_id}/actions/restore"] = installer.NewRestoreArchivedCluster(o.context, o.InstallerRestoreArchivedClusterHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/logs/search"] = installer.NewSearchLogsArchive(o.context, o.InstallerSearchLogsArchiveHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -1091,6 +1371,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/clusters/{cluster_id}/hosts/{host_id}/logs_progress"] = installer.NewUpdateHostLogsProgress(o.context, o.InstallerUpdateHostLogsProgressHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/quotas/{scope}/{owner}"] = installer.NewUpdateQuotaLimits(o.context, o.InstallerUpdateQuotaLimitsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1103,6 +1387,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/clusters/{cluster_id}/logs"] = installer.NewUploadLogs(o.context, o.InstallerUploadLogsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/clusters/{cluster_id}/logs/uploads/{upload_id}/chunks/{chunk_number}"] = installer.NewUploadLogsChunk(o.context, o.InstallerUploadLogsChunkHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP